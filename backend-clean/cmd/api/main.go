@@ -3,23 +3,51 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 
 	initializer "immortal-architecture-clean/backend/internal/driver/initializer/api"
+	"immortal-architecture-clean/backend/internal/driver/lifecycle"
 )
 
 func main() {
-	ctx := context.Background()
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run serves until SIGINT or SIGTERM and returns after the server has drained and
+// the dependencies are closed.
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	e, cfg, cleanup, err := initializer.BuildServer(ctx)
 	if err != nil {
-		log.Fatalf("failed to initialize server: %v", err)
+		return fmt.Errorf("failed to initialize server: %w", err)
 	}
 	defer cleanup()
 
 	addr := ":" + strconv.Itoa(cfg.ServerPort)
 	log.Printf("starting HTTP server at %s\n", addr)
-	if err := e.Start(addr); err != nil {
-		log.Fatalf("server exited: %v", err)
+	serve := func() error {
+		if err := e.Start(addr); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+	shutdown := func(ctx context.Context) error {
+		return initializer.Shutdown(ctx, e)
+	}
+	if err := lifecycle.Run(ctx, serve, shutdown, cfg.ShutdownDelay, cfg.ShutdownTimeout); err != nil {
+		return fmt.Errorf("server exited: %w", err)
 	}
+	log.Println("HTTP server stopped")
+	return nil
 }
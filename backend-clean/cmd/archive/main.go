@@ -0,0 +1,126 @@
+// Package main exports an account's templates and notes to an archive file
+// and imports such archives into another database.
+//
+// Usage:
+//
+//	archive export (-owner ID | -owner-email EMAIL) [-workspace ID] [-format json|zip] [-out FILE]
+//	archive import (-owner ID | -owner-email EMAIL) [-workspace ID] [-in FILE]
+//
+// Both work in one workspace of the account, the one it joined first unless -workspace is given.
+// The database is taken from DATABASE_URL. Export writes to stdout unless -out is given;
+// import reads stdin unless -in is given and prints the archived-to-new ID mapping.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"immortal-architecture-clean/backend/internal/adapter/cli/archivefile"
+	initializer "immortal-architecture-clean/backend/internal/driver/initializer/archive"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+const usage = `usage:
+  archive export (-owner ID | -owner-email EMAIL) [-workspace ID] [-format json|zip] [-out FILE]
+  archive import (-owner ID | -owner-email EMAIL) [-workspace ID] [-in FILE]`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	ctx := context.Background()
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(ctx, os.Args[2:])
+	case "import":
+		err = runImport(ctx, os.Args[2:])
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("archive %s failed: %v", os.Args[1], err)
+	}
+}
+
+func runExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	owner := ownerFlags(fs)
+	formatName := fs.String("format", string(archivefile.FormatJSON), "archive format: json or zip")
+	out := fs.String("out", "", "output file (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	format, err := archivefile.ParseFormat(*formatName)
+	if err != nil {
+		return err
+	}
+	if owner.ID == "" && owner.Email == "" {
+		return errors.New("-owner or -owner-email is required")
+	}
+
+	controller, cleanup, err := initializer.BuildController(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if *out == "" {
+		return controller.Export(ctx, *owner, format, os.Stdout)
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := controller.Export(ctx, *owner, format, f); err != nil {
+		return errors.Join(err, f.Close(), os.Remove(*out))
+	}
+	return f.Close()
+}
+
+func runImport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	owner := ownerFlags(fs)
+	in := fs.String("in", "", "archive file to import (default stdin)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if owner.ID == "" && owner.Email == "" {
+		return errors.New("-owner or -owner-email is required")
+	}
+
+	var r io.Reader = os.Stdin
+	if *in != "" {
+		f, err := os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer f.Close() //nolint:errcheck
+		r = f
+	}
+
+	controller, cleanup, err := initializer.BuildController(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	return controller.Import(ctx, *owner, r, os.Stdout)
+}
+
+// ownerFlags registers the flags naming the account to export or to own the imported data,
+// and its workspace to work in.
+func ownerFlags(fs *flag.FlagSet) *port.ArchiveAccountInput {
+	owner := &port.ArchiveAccountInput{}
+	fs.StringVar(&owner.ID, "owner", "", "account ID")
+	fs.StringVar(&owner.Email, "owner-email", "", "account email, used when -owner is not given")
+	fs.StringVar(&owner.WorkspaceID, "workspace", "", "workspace ID of the account (default the workspace it joined first)")
+	return owner
+}
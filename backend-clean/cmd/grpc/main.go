@@ -5,27 +5,47 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 
 	initializer "immortal-architecture-clean/backend/internal/driver/initializer/grpc"
+	"immortal-architecture-clean/backend/internal/driver/lifecycle"
 )
 
 func main() {
-	ctx := context.Background()
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run serves until SIGINT or SIGTERM and returns after the server has drained and
+// the dependencies are closed.
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	s, cfg, cleanup, err := initializer.BuildServer(ctx)
 	if err != nil {
-		log.Fatalf("failed to initialize gRPC server: %v", err)
+		return fmt.Errorf("failed to initialize gRPC server: %w", err)
 	}
 	defer cleanup()
 
 	lis, err := initializer.GetListener(cfg)
 	if err != nil {
-		log.Fatalf("failed to create listener: %v", err)
+		return fmt.Errorf("failed to create listener: %w", err)
 	}
 
-	grpcPort := cfg.ServerPort + 1
-	addr := fmt.Sprintf(":%d", grpcPort)
-	log.Printf("starting gRPC server at %s\n", addr)
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("server exited: %v", err)
+	log.Printf("starting gRPC server at %s\n", lis.Addr())
+	serve := func() error {
+		return s.Serve(lis)
+	}
+	shutdown := func(ctx context.Context) error {
+		return initializer.Shutdown(ctx, s)
+	}
+	if err := lifecycle.Run(ctx, serve, shutdown, cfg.ShutdownDelay, cfg.ShutdownTimeout); err != nil {
+		return fmt.Errorf("server exited: %w", err)
 	}
+	log.Println("gRPC server stopped")
+	return nil
 }
@@ -0,0 +1,29 @@
+// Package main runs the trash purge worker, which deletes notes and templates
+// that stayed in the trash longer than TRASH_RETENTION_DAYS.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	initializer "immortal-architecture-clean/backend/internal/driver/initializer/trash"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	worker, cleanup, err := initializer.BuildWorker(ctx)
+	if err != nil {
+		log.Fatalf("failed to initialize trash purge worker: %v", err)
+	}
+	defer cleanup()
+
+	log.Println("starting trash purge worker")
+	if err := worker.Run(ctx); err != nil {
+		log.Fatalf("trash purge worker exited: %v", err)
+	}
+}
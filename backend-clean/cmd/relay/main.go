@@ -0,0 +1,29 @@
+// Package main runs the outbox relay, which delivers domain events recorded by the
+// API and gRPC servers to subscribers. Several relays may run against one database.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	initializer "immortal-architecture-clean/backend/internal/driver/initializer/relay"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	relay, cleanup, err := initializer.BuildRelay(ctx)
+	if err != nil {
+		log.Fatalf("failed to initialize relay: %v", err)
+	}
+	defer cleanup()
+
+	log.Println("starting outbox relay")
+	if err := relay.Run(ctx); err != nil {
+		log.Fatalf("relay exited: %v", err)
+	}
+}
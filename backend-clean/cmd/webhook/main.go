@@ -0,0 +1,29 @@
+// Package main runs the webhook worker, which sends the deliveries the outbox relay
+// enqueues and retries failed ones. Several workers may run against one database.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	initializer "immortal-architecture-clean/backend/internal/driver/initializer/webhook"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	worker, cleanup, err := initializer.BuildWorker(ctx)
+	if err != nil {
+		log.Fatalf("failed to initialize webhook worker: %v", err)
+	}
+	defer cleanup()
+
+	log.Println("starting webhook worker")
+	if err := worker.Run(ctx); err != nil {
+		log.Fatalf("webhook worker exited: %v", err)
+	}
+}
@@ -0,0 +1,295 @@
+// Package archivefile reads and writes account archives as JSON documents or zip files.
+package archivefile
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// Format is the file format of an archive.
+type Format string
+
+// Supported formats.
+const (
+	FormatJSON Format = "json"
+	FormatZip  Format = "zip"
+)
+
+// zipEntry is the name of the JSON document inside a zip archive.
+const zipEntry = "archive.json"
+
+// zipMagic starts every zip file; Decode uses it to tell the formats apart.
+var zipMagic = []byte("PK\x03\x04")
+
+// ParseFormat parses a format name.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatJSON, FormatZip:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown archive format %q (want json or zip)", s)
+	}
+}
+
+// Document is the JSON representation of an archive.
+type Document struct {
+	FormatVersion int        `json:"formatVersion"`
+	ExportedAt    time.Time  `json:"exportedAt"`
+	Owner         Owner      `json:"owner"`
+	Templates     []Template `json:"templates"`
+	Notes         []Note     `json:"notes"`
+}
+
+// Owner is the exported account.
+type Owner struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+// Template is an archived template with its field versions.
+type Template struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	OwnerID       string         `json:"ownerId"`
+	FieldVersions []FieldVersion `json:"fieldVersions"`
+}
+
+// FieldVersion is the field set of one template version.
+type FieldVersion struct {
+	Version int     `json:"version"`
+	Fields  []Field `json:"fields"`
+}
+
+// Field is an archived template field.
+type Field struct {
+	ID         string   `json:"id"`
+	Label      string   `json:"label"`
+	Order      int      `json:"order"`
+	IsRequired bool     `json:"isRequired"`
+	Type       string   `json:"type"`
+	Options    []string `json:"options"`
+}
+
+// Note is an archived note.
+type Note struct {
+	ID              string    `json:"id"`
+	Title           string    `json:"title"`
+	TemplateID      string    `json:"templateId"`
+	TemplateVersion int       `json:"templateVersion"`
+	Status          string    `json:"status"`
+	Sections        []Section `json:"sections"`
+	Tags            []string  `json:"tags,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// Section is the content of one field of an archived note.
+type Section struct {
+	FieldID string `json:"fieldId"`
+	Content string `json:"content"`
+}
+
+// ImportSummary maps archived IDs to the IDs assigned by an import.
+type ImportSummary struct {
+	OwnerID   string            `json:"ownerId"`
+	Templates map[string]string `json:"templates"`
+	Notes     map[string]string `json:"notes"`
+}
+
+// FromDomain converts an archive to its document.
+func FromDomain(a archive.Archive) Document {
+	doc := Document{
+		FormatVersion: a.FormatVersion,
+		ExportedAt:    a.ExportedAt,
+		Owner:         Owner(a.Owner),
+		Templates:     make([]Template, 0, len(a.Templates)),
+		Notes:         make([]Note, 0, len(a.Notes)),
+	}
+	for _, t := range a.Templates {
+		versions := make([]FieldVersion, 0, len(t.FieldVersions))
+		for _, fv := range t.FieldVersions {
+			fields := make([]Field, 0, len(fv.Fields))
+			for _, f := range fv.Fields {
+				options := f.Options
+				if options == nil {
+					options = []string{}
+				}
+				fields = append(fields, Field{
+					ID:         f.ID,
+					Label:      f.Label,
+					Order:      f.Order,
+					IsRequired: f.IsRequired,
+					Type:       string(f.Type),
+					Options:    options,
+				})
+			}
+			versions = append(versions, FieldVersion{Version: fv.Version, Fields: fields})
+		}
+		doc.Templates = append(doc.Templates, Template{ID: t.ID, Name: t.Name, OwnerID: t.OwnerID, FieldVersions: versions})
+	}
+	for _, n := range a.Notes {
+		sections := make([]Section, 0, len(n.Sections))
+		for _, s := range n.Sections {
+			sections = append(sections, Section(s))
+		}
+		tags := make([]string, 0, len(n.Tags))
+		for _, t := range n.Tags {
+			tags = append(tags, string(t))
+		}
+		doc.Notes = append(doc.Notes, Note{
+			ID:              n.ID,
+			Title:           n.Title,
+			TemplateID:      n.TemplateID,
+			TemplateVersion: n.TemplateVersion,
+			Status:          string(n.Status),
+			Sections:        sections,
+			Tags:            tags,
+			CreatedAt:       n.CreatedAt,
+			UpdatedAt:       n.UpdatedAt,
+		})
+	}
+	return doc
+}
+
+// ToDomain converts the document to an archive.
+func (d Document) ToDomain() archive.Archive {
+	a := archive.Archive{
+		FormatVersion: d.FormatVersion,
+		ExportedAt:    d.ExportedAt,
+		Owner:         archive.Owner(d.Owner),
+		Templates:     make([]archive.Template, 0, len(d.Templates)),
+		Notes:         make([]archive.Note, 0, len(d.Notes)),
+	}
+	for _, t := range d.Templates {
+		versions := make([]archive.FieldVersion, 0, len(t.FieldVersions))
+		for _, fv := range t.FieldVersions {
+			fields := make([]template.Field, 0, len(fv.Fields))
+			for _, f := range fv.Fields {
+				fields = append(fields, template.Field{
+					ID:         f.ID,
+					Label:      f.Label,
+					Order:      f.Order,
+					IsRequired: f.IsRequired,
+					Type:       template.FieldType(f.Type),
+					Options:    f.Options,
+				})
+			}
+			versions = append(versions, archive.FieldVersion{Version: fv.Version, Fields: fields})
+		}
+		a.Templates = append(a.Templates, archive.Template{ID: t.ID, Name: t.Name, OwnerID: t.OwnerID, FieldVersions: versions})
+	}
+	for _, n := range d.Notes {
+		sections := make([]archive.Section, 0, len(n.Sections))
+		for _, s := range n.Sections {
+			sections = append(sections, archive.Section(s))
+		}
+		tags := make([]note.Tag, 0, len(n.Tags))
+		for _, t := range n.Tags {
+			tags = append(tags, note.Tag(t))
+		}
+		a.Notes = append(a.Notes, archive.Note{
+			ID:              n.ID,
+			Title:           n.Title,
+			TemplateID:      n.TemplateID,
+			TemplateVersion: n.TemplateVersion,
+			Status:          note.NoteStatus(n.Status),
+			Sections:        sections,
+			Tags:            tags,
+			CreatedAt:       n.CreatedAt,
+			UpdatedAt:       n.UpdatedAt,
+		})
+	}
+	return a
+}
+
+// NewImportSummary converts an import result to its summary.
+func NewImportSummary(r archive.ImportResult) ImportSummary {
+	return ImportSummary{OwnerID: r.OwnerID, Templates: r.TemplateIDs, Notes: r.NoteIDs}
+}
+
+// Encode writes the document in the given format.
+func Encode(w io.Writer, doc Document, format Format) error {
+	if format != FormatZip {
+		return writeJSON(w, doc)
+	}
+	zw := zip.NewWriter(w)
+	entry, err := zw.Create(zipEntry)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(entry, doc); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// Decode reads a document written by Encode in either format.
+// Documents of another format version are rejected before their contents are read.
+func Decode(data []byte) (Document, error) {
+	if bytes.HasPrefix(data, zipMagic) {
+		raw, err := readZipEntry(data)
+		if err != nil {
+			return Document{}, err
+		}
+		data = raw
+	}
+	var header struct {
+		FormatVersion int `json:"formatVersion"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return Document{}, fmt.Errorf("%w: %w", domainerr.ErrInvalidArchive, err)
+	}
+	if header.FormatVersion != archive.FormatVersion {
+		return Document{}, fmt.Errorf("%w: %d", domainerr.ErrUnsupportedArchiveVersion, header.FormatVersion)
+	}
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return Document{}, fmt.Errorf("%w: %w", domainerr.ErrInvalidArchive, err)
+	}
+	return doc, nil
+}
+
+// WriteSummary writes an import summary as JSON.
+func WriteSummary(w io.Writer, s ImportSummary) error {
+	return writeJSON(w, s)
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func readZipEntry(data []byte) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainerr.ErrInvalidArchive, err)
+	}
+	f, err := zr.Open(zipEntry)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s not found in zip", domainerr.ErrInvalidArchive, zipEntry)
+	}
+	raw, err := io.ReadAll(f)
+	if closeErr := f.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if errors.Is(err, zip.ErrFormat) || errors.Is(err, zip.ErrChecksum) {
+			return nil, fmt.Errorf("%w: %w", domainerr.ErrInvalidArchive, err)
+		}
+		return nil, err
+	}
+	return raw, nil
+}
@@ -0,0 +1,87 @@
+package archivefile
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func sampleArchive() archive.Archive {
+	at := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	return archive.Archive{
+		FormatVersion: archive.FormatVersion,
+		ExportedAt:    at,
+		Owner:         archive.Owner{ID: "o1", Email: "owner@example.com", FirstName: "Taro", LastName: "Yamada"},
+		Templates: []archive.Template{{
+			ID:      "t1",
+			Name:    "ADR",
+			OwnerID: "o1",
+			FieldVersions: []archive.FieldVersion{{Version: 2, Fields: []template.Field{
+				{ID: "f1", Label: "Status", Order: 1, IsRequired: true, Type: template.FieldSingleSelect, Options: []string{"open", "closed"}},
+				{ID: "f2", Label: "Body", Order: 2, Type: template.FieldMarkdown, Options: []string{}},
+			}}},
+		}},
+		Notes: []archive.Note{{
+			ID: "n1", Title: "Cache", TemplateID: "t1", TemplateVersion: 2, Status: note.StatusPublish,
+			Sections:  []archive.Section{{FieldID: "f1", Content: "open"}, {FieldID: "f2", Content: "# body"}},
+			Tags:      []note.Tag{"cache", "performance"},
+			CreatedAt: at, UpdatedAt: at,
+		}},
+	}
+}
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	for _, format := range []Format{FormatJSON, FormatZip} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Encode(&buf, FromDomain(sampleArchive()), format); err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			if got := bytes.HasPrefix(buf.Bytes(), zipMagic); got != (format == FormatZip) {
+				t.Fatalf("zip magic present = %v for %s", got, format)
+			}
+			doc, err := Decode(buf.Bytes())
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if got := doc.ToDomain(); !reflect.DeepEqual(got, sampleArchive()) {
+				t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", got, sampleArchive())
+			}
+		})
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{name: "[Fail] newer format version", data: []byte(`{"formatVersion":2,"templates":"not-read"}`), wantErr: domainerr.ErrUnsupportedArchiveVersion},
+		{name: "[Fail] not json", data: []byte(`not json`), wantErr: domainerr.ErrInvalidArchive},
+		{name: "[Fail] broken zip", data: append(append([]byte{}, zipMagic...), "garbage"...), wantErr: domainerr.ErrInvalidArchive},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.data); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	if f, err := ParseFormat("zip"); err != nil || f != FormatZip {
+		t.Fatalf("ParseFormat(zip) = %q, %v", f, err)
+	}
+	if _, err := ParseFormat("tar"); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
@@ -0,0 +1,79 @@
+// Package controller contains command-line controllers that call input ports.
+package controller
+
+import (
+	"context"
+	"io"
+
+	"immortal-architecture-clean/backend/internal/adapter/cli/archivefile"
+	"immortal-architecture-clean/backend/internal/adapter/cli/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ArchiveController runs account archive export and import for the archive command.
+type ArchiveController struct {
+	inputFactory         func(accountRepo port.AccountRepository, workspaceRepo port.WorkspaceRepository, tplRepo port.TemplateRepository, noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort
+	outputFactory        func() *presenter.ArchivePresenter
+	accountRepoFactory   func() port.AccountRepository
+	workspaceRepoFactory func() port.WorkspaceRepository
+	tplRepoFactory       func() port.TemplateRepository
+	noteRepoFactory      func() port.NoteRepository
+	revRepoFactory       func() port.NoteRevisionRepository
+	txFactory            func() port.TxManager
+}
+
+// NewArchiveController creates an ArchiveController.
+func NewArchiveController(
+	inputFactory func(accountRepo port.AccountRepository, workspaceRepo port.WorkspaceRepository, tplRepo port.TemplateRepository, noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort,
+	outputFactory func() *presenter.ArchivePresenter,
+	accountRepoFactory func() port.AccountRepository,
+	workspaceRepoFactory func() port.WorkspaceRepository,
+	tplRepoFactory func() port.TemplateRepository,
+	noteRepoFactory func() port.NoteRepository,
+	revRepoFactory func() port.NoteRevisionRepository,
+	txFactory func() port.TxManager,
+) *ArchiveController {
+	return &ArchiveController{
+		inputFactory:         inputFactory,
+		outputFactory:        outputFactory,
+		accountRepoFactory:   accountRepoFactory,
+		workspaceRepoFactory: workspaceRepoFactory,
+		tplRepoFactory:       tplRepoFactory,
+		noteRepoFactory:      noteRepoFactory,
+		revRepoFactory:       revRepoFactory,
+		txFactory:            txFactory,
+	}
+}
+
+// Export writes the account's archive to w in the given format.
+func (c *ArchiveController) Export(ctx context.Context, owner port.ArchiveAccountInput, format archivefile.Format, w io.Writer) error {
+	input, p := c.newIO()
+	if err := input.Export(ctx, owner); err != nil {
+		return err
+	}
+	return archivefile.Encode(w, *p.Document(), format)
+}
+
+// Import reads an archive in either format from r, imports it for the owner
+// and writes a summary mapping archived IDs to new IDs to w.
+func (c *ArchiveController) Import(ctx context.Context, owner port.ArchiveAccountInput, r io.Reader, w io.Writer) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	doc, err := archivefile.Decode(data)
+	if err != nil {
+		return err
+	}
+	input, p := c.newIO()
+	if err := input.Import(ctx, port.ArchiveImportInput{Owner: owner, Archive: doc.ToDomain()}); err != nil {
+		return err
+	}
+	return archivefile.WriteSummary(w, *p.Summary())
+}
+
+func (c *ArchiveController) newIO() (port.ArchiveInputPort, *presenter.ArchivePresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.accountRepoFactory(), c.workspaceRepoFactory(), c.tplRepoFactory(), c.noteRepoFactory(), c.revRepoFactory(), c.txFactory(), output)
+	return input, output
+}
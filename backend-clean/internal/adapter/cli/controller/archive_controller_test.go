@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/adapter/cli/archivefile"
+	"immortal-architecture-clean/backend/internal/adapter/cli/presenter"
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// archiveInputStub presents a fixed archive and records the imported input.
+type archiveInputStub struct {
+	output   port.ArchiveOutputPort
+	err      error
+	imported port.ArchiveImportInput
+}
+
+func (s *archiveInputStub) Export(ctx context.Context, input port.ArchiveAccountInput) error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.output.PresentArchive(ctx, &archive.Archive{FormatVersion: archive.FormatVersion, Owner: archive.Owner{ID: input.ID}})
+}
+
+func (s *archiveInputStub) Import(ctx context.Context, input port.ArchiveImportInput) error {
+	s.imported = input
+	if s.err != nil {
+		return s.err
+	}
+	return s.output.PresentArchiveImported(ctx, &archive.ImportResult{
+		OwnerID:     "new-owner",
+		TemplateIDs: map[string]string{"t1": "t9"},
+		NoteIDs:     map[string]string{},
+	})
+}
+
+func newArchiveController(input *archiveInputStub) *ArchiveController {
+	return NewArchiveController(
+		func(_ port.AccountRepository, _ port.WorkspaceRepository, _ port.TemplateRepository, _ port.NoteRepository, _ port.NoteRevisionRepository, _ port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort {
+			input.output = output
+			return input
+		},
+		presenter.NewArchivePresenter,
+		func() port.AccountRepository { return nil },
+		func() port.WorkspaceRepository { return nil },
+		func() port.TemplateRepository { return nil },
+		func() port.NoteRepository { return nil },
+		func() port.NoteRevisionRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestArchiveController_Export(t *testing.T) {
+	tests := []struct {
+		name    string
+		inErr   error
+		wantErr error
+	}{
+		{name: "[Success] export archive"},
+		{name: "[Fail] account not found", inErr: domainerr.ErrNotFound, wantErr: domainerr.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := newArchiveController(&archiveInputStub{err: tt.inErr}).Export(context.Background(), port.ArchiveAccountInput{ID: "o1"}, archivefile.FormatJSON, &out)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			doc, err := archivefile.Decode(out.Bytes())
+			if err != nil || doc.Owner.ID != "o1" {
+				t.Fatalf("unexpected document %+v, err %v", doc, err)
+			}
+		})
+	}
+}
+
+func TestArchiveController_Import(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{name: "[Success] import archive", body: `{"formatVersion":1,"templates":[{"id":"t1","name":"ADR","fieldVersions":[]}],"notes":[]}`},
+		{name: "[Fail] unsupported version", body: `{"formatVersion":7}`, wantErr: domainerr.ErrUnsupportedArchiveVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &archiveInputStub{}
+			var out bytes.Buffer
+			err := newArchiveController(input).Import(context.Background(), port.ArchiveAccountInput{Email: "new@example.com"}, strings.NewReader(tt.body), &out)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if input.imported.Owner.Email != "new@example.com" || len(input.imported.Archive.Templates) != 1 {
+				t.Fatalf("unexpected input: %+v", input.imported)
+			}
+			var summary archivefile.ImportSummary
+			if err := json.Unmarshal(out.Bytes(), &summary); err != nil || summary.Templates["t1"] != "t9" {
+				t.Fatalf("unexpected summary %s, err %v", out.String(), err)
+			}
+		})
+	}
+}
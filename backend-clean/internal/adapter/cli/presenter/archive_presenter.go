@@ -0,0 +1,47 @@
+// Package presenter contains command-line presenters that implement output ports.
+package presenter
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/adapter/cli/archivefile"
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ArchivePresenter converts archives to archive file documents.
+type ArchivePresenter struct {
+	document *archivefile.Document
+	summary  *archivefile.ImportSummary
+}
+
+var _ port.ArchiveOutputPort = (*ArchivePresenter)(nil)
+
+// NewArchivePresenter creates an ArchivePresenter.
+func NewArchivePresenter() *ArchivePresenter {
+	return &ArchivePresenter{}
+}
+
+// PresentArchive stores the exported archive document.
+func (p *ArchivePresenter) PresentArchive(_ context.Context, a *archive.Archive) error {
+	doc := archivefile.FromDomain(*a)
+	p.document = &doc
+	return nil
+}
+
+// PresentArchiveImported stores the import summary.
+func (p *ArchivePresenter) PresentArchiveImported(_ context.Context, result *archive.ImportResult) error {
+	summary := archivefile.NewImportSummary(*result)
+	p.summary = &summary
+	return nil
+}
+
+// Document returns the exported archive document.
+func (p *ArchivePresenter) Document() *archivefile.Document {
+	return p.document
+}
+
+// Summary returns the import summary.
+func (p *ArchivePresenter) Summary() *archivefile.ImportSummary {
+	return p.summary
+}
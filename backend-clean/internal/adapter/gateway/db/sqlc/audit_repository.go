@@ -0,0 +1,135 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AuditRepository implements the hash-chained audit log.
+type AuditRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.AuditRepository = (*AuditRepository)(nil)
+
+// NewAuditRepository creates AuditRepository.
+func NewAuditRepository(pool *pgxpool.Pool) *AuditRepository {
+	return &AuditRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Record appends entries to the chain using the transaction in ctx. The advisory
+// lock it takes is held until that transaction ends, so concurrent writers append
+// one after another instead of forking the chain.
+func (r *AuditRepository) Record(ctx context.Context, entries ...audit.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	q := queriesForContext(ctx, r.queries)
+	if err := q.LockAuditLog(ctx); err != nil {
+		return err
+	}
+	prevHash, err := q.GetAuditLogHead(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	requestID := port.RequestIDFromContext(ctx)
+	for _, e := range entries {
+		e.RequestID = requestID
+		e = e.Seal(prevHash, time.Now())
+		if _, err := q.InsertAuditLog(ctx, &generated.InsertAuditLogParams{
+			ActorID:       e.ActorID,
+			Action:        string(e.Action),
+			AggregateType: string(e.AggregateType),
+			AggregateID:   e.AggregateID,
+			Before:        e.Before,
+			After:         e.After,
+			RequestID:     e.RequestID,
+			OccurredAt:    pgtype.Timestamptz{Time: e.OccurredAt, Valid: true},
+			PrevHash:      e.PrevHash,
+			Hash:          e.Hash,
+		}); err != nil {
+			return err
+		}
+		prevHash = e.Hash
+	}
+	return nil
+}
+
+// List returns entries matching the filters, newest first.
+func (r *AuditRepository) List(ctx context.Context, filters audit.Filters) ([]audit.Entry, error) {
+	var beforeSequence int64
+	if filters.After != nil {
+		seq, err := strconv.ParseInt(filters.After.ID, 10, 64)
+		if err != nil || seq <= 0 {
+			return nil, domainerr.ErrInvalidCursor
+		}
+		beforeSequence = seq
+	}
+	params := &generated.ListAuditLogParams{
+		Column4: pgNullableTime(filters.From),
+		Column5: pgNullableTime(filters.To),
+		Column6: beforeSequence,
+		Limit:   int32(filters.Limit), //nolint:gosec
+	}
+	if filters.ActorID != nil {
+		params.Column1 = *filters.ActorID
+	}
+	if filters.AggregateType != nil {
+		params.Column2 = string(*filters.AggregateType)
+	}
+	if filters.AggregateID != nil {
+		params.Column3 = *filters.AggregateID
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListAuditLog(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return toAuditEntries(rows), nil
+}
+
+// ListChain returns up to limit entries after the given sequence, oldest first.
+func (r *AuditRepository) ListChain(ctx context.Context, afterSequence int64, limit int) ([]audit.Entry, error) {
+	rows, err := queriesForContext(ctx, r.queries).ListAuditLogChain(ctx, &generated.ListAuditLogChainParams{
+		Sequence: afterSequence,
+		Limit:    int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAuditEntries(rows), nil
+}
+
+func toAuditEntries(rows []*generated.AuditLog) []audit.Entry {
+	entries := make([]audit.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, audit.Entry{
+			Sequence:      row.Sequence,
+			ActorID:       row.ActorID,
+			Action:        audit.Action(row.Action),
+			AggregateType: audit.AggregateType(row.AggregateType),
+			AggregateID:   row.AggregateID,
+			Before:        row.Before,
+			After:         row.After,
+			RequestID:     row.RequestID,
+			OccurredAt:    timestamptzToTime(row.OccurredAt),
+			PrevHash:      row.PrevHash,
+			Hash:          row.Hash,
+		})
+	}
+	return entries
+}
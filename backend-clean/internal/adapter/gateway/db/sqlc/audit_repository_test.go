@@ -0,0 +1,147 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newAuditRow(seq int64, prevHash string) *generated.AuditLog {
+	e := audit.Entry{
+		ActorID:       "a1",
+		Action:        audit.ActionCreate,
+		AggregateType: audit.AggregateNote,
+		AggregateID:   "n1",
+		After:         []byte(`{"title":"t"}`),
+	}.Seal(prevHash, time.Now())
+	return &generated.AuditLog{
+		Sequence:      seq,
+		ActorID:       e.ActorID,
+		Action:        string(e.Action),
+		AggregateType: string(e.AggregateType),
+		AggregateID:   e.AggregateID,
+		After:         e.After,
+		OccurredAt:    pgtype.Timestamptz{Time: e.OccurredAt, Valid: true},
+		PrevHash:      e.PrevHash,
+		Hash:          e.Hash,
+	}
+}
+
+func TestAuditRepository_Record(t *testing.T) {
+	entry := audit.Entry{ActorID: "a1", Action: audit.ActionUpdate, AggregateType: audit.AggregateNote, AggregateID: "n1"}
+	tests := []struct {
+		name      string
+		head      string
+		headErr   error
+		insertErr error
+		execErr   error
+		wantPrev  string
+		wantErr   bool
+	}{
+		{name: "[Success] chain onto the head", head: "h0", wantPrev: "h0"},
+		{name: "[Success] first entry of an empty log", headErr: pgx.ErrNoRows, wantPrev: ""},
+		{name: "[Fail] lock error", execErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] head error", headErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] insert error", head: "h0", insertErr: errors.New("db error"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewAuditDBTX(tt.head, tt.headErr, tt.insertErr, tt.execErr)
+			repo := &AuditRepository{queries: generated.New(mock)}
+			ctx := port.WithRequestID(context.Background(), "req-1")
+			err := repo.Record(ctx, entry, entry)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if mock.Locked != 1 || len(mock.Inserted) != 2 {
+				t.Fatalf("expected one lock and two inserts, got %d and %d", mock.Locked, len(mock.Inserted))
+			}
+			first, second := mock.Inserted[0], mock.Inserted[1]
+			if first[6] != "req-1" || first[8] != tt.wantPrev {
+				t.Fatalf("unexpected first insert: %+v", first)
+			}
+			// The second entry chains onto the first one inserted in the same call.
+			if second[8] != first[9] {
+				t.Fatalf("second entry not chained: prev %v, want %v", second[8], first[9])
+			}
+		})
+	}
+}
+
+func TestAuditRepository_List(t *testing.T) {
+	actor := "a1"
+	aggType := audit.AggregateNote
+	tests := []struct {
+		name      string
+		filters   audit.Filters
+		queryErr  error
+		wantActor string
+		wantSeq   int64
+		wantErr   bool
+		wantIs    error
+	}{
+		{name: "[Success] first page", filters: audit.Filters{ActorID: &actor, AggregateType: &aggType, Limit: 10}, wantActor: "a1"},
+		{name: "[Success] after cursor", filters: audit.Filters{Limit: 10, After: &page.Cursor{ID: "42"}}, wantSeq: 42},
+		{name: "[Fail] invalid cursor", filters: audit.Filters{After: &page.Cursor{ID: "abc"}}, wantErr: true, wantIs: domainerr.ErrInvalidCursor},
+		{name: "[Fail] query error", queryErr: errors.New("db error"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewAuditDBTX("", nil, nil, nil).WithList([]*generated.AuditLog{newAuditRow(2, "h1")}, tt.queryErr)
+			repo := &AuditRepository{queries: generated.New(mock)}
+			got, err := repo.List(context.Background(), tt.filters)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+				t.Fatalf("want %v, got %v", tt.wantIs, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			args := mock.Queried[0]
+			if args[0] != tt.wantActor {
+				t.Fatalf("unexpected actor arg: %v", args[0])
+			}
+			if args[5] != tt.wantSeq {
+				t.Fatalf("unexpected sequence arg: %v, want %v", args[5], tt.wantSeq)
+			}
+			if len(got) != 1 || got[0].Sequence != 2 || got[0].PrevHash != "h1" || got[0].Hash != got[0].ComputeHash() {
+				t.Fatalf("unexpected entries: %+v", got)
+			}
+		})
+	}
+}
+
+func TestAuditRepository_ListChain(t *testing.T) {
+	first := newAuditRow(1, "")
+	second := newAuditRow(2, first.Hash)
+	mock := mockdb.NewAuditDBTX("", nil, nil, nil).WithList([]*generated.AuditLog{first, second}, nil)
+	repo := &AuditRepository{queries: generated.New(mock)}
+	got, err := repo.ListChain(context.Background(), 0, 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, brokenAt := audit.VerifyChain("", got); brokenAt != 0 {
+		t.Fatalf("chain read back broken at %d", brokenAt)
+	}
+
+	failing := &AuditRepository{queries: generated.New(mockdb.NewAuditDBTX("", nil, nil, nil).WithList(nil, errors.New("db error")))}
+	if _, err := failing.ListChain(context.Background(), 0, 100); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
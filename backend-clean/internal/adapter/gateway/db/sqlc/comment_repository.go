@@ -0,0 +1,192 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/comment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// CommentRepository implements comment persistence.
+type CommentRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.CommentRepository = (*CommentRepository)(nil)
+
+// NewCommentRepository creates CommentRepository.
+func NewCommentRepository(pool *pgxpool.Pool) *CommentRepository {
+	return &CommentRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// List returns the note's comments with their authors, oldest first.
+func (r *CommentRepository) List(ctx context.Context, noteID string) ([]comment.WithMeta, error) {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListCommentsByNote(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]comment.WithMeta, 0, len(rows))
+	for _, row := range rows {
+		comments = append(comments, toCommentWithMeta((*generated.GetCommentByIDRow)(row)))
+	}
+	return comments, nil
+}
+
+// Get returns a comment with its author by ID.
+func (r *CommentRepository) Get(ctx context.Context, id string) (*comment.WithMeta, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetCommentByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	c := toCommentWithMeta(row)
+	return &c, nil
+}
+
+// Create stores a comment.
+func (r *CommentRepository) Create(ctx context.Context, c comment.Comment) (*comment.Comment, error) {
+	noteID, err := toUUID(c.NoteID)
+	if err != nil {
+		return nil, err
+	}
+	authorID, err := toUUID(c.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	sectionID, err := toNullableUUID(c.SectionID)
+	if err != nil {
+		return nil, domainerr.ErrInvalidCommentSection
+	}
+	parentID, err := toNullableUUID(c.ParentID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateComment(ctx, &generated.CreateCommentParams{
+		NoteID:    noteID,
+		SectionID: sectionID,
+		ParentID:  parentID,
+		AuthorID:  authorID,
+		Body:      c.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := toComment(row)
+	return &created, nil
+}
+
+// Update stores the body of a comment.
+func (r *CommentRepository) Update(ctx context.Context, c comment.Comment) (*comment.Comment, error) {
+	pgID, err := toUUID(c.ID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateCommentBody(ctx, &generated.UpdateCommentBodyParams{
+		ID:   pgID,
+		Body: c.Body,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	updated := toComment(row)
+	return &updated, nil
+}
+
+// UpdateResolution stores who resolved the thread and when; both are NULL while it is open.
+func (r *CommentRepository) UpdateResolution(ctx context.Context, c comment.Comment) (*comment.Comment, error) {
+	pgID, err := toUUID(c.ID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	resolvedBy, err := toNullableUUID(c.ResolvedBy)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateCommentResolution(ctx, &generated.UpdateCommentResolutionParams{
+		ID:         pgID,
+		ResolvedBy: resolvedBy,
+		ResolvedAt: pgNullableTime(c.ResolvedAt),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	updated := toComment(row)
+	return &updated, nil
+}
+
+// Delete removes a comment; the database removes its replies with it.
+func (r *CommentRepository) Delete(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	n, err := queriesForContext(ctx, r.queries).DeleteComment(ctx, pgID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+func toComment(row *generated.Comment) comment.Comment {
+	return comment.Comment{
+		ID:         uuidToString(row.ID),
+		NoteID:     uuidToString(row.NoteID),
+		SectionID:  uuidToString(row.SectionID),
+		ParentID:   uuidToString(row.ParentID),
+		AuthorID:   uuidToString(row.AuthorID),
+		Body:       row.Body,
+		ResolvedBy: uuidToString(row.ResolvedBy),
+		ResolvedAt: timestamptzToTimePtr(row.ResolvedAt),
+		CreatedAt:  timestamptzToTime(row.CreatedAt),
+		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+func toCommentWithMeta(row *generated.GetCommentByIDRow) comment.WithMeta {
+	return comment.WithMeta{
+		Comment: toComment(&generated.Comment{
+			ID:         row.ID,
+			NoteID:     row.NoteID,
+			SectionID:  row.SectionID,
+			ParentID:   row.ParentID,
+			AuthorID:   row.AuthorID,
+			Body:       row.Body,
+			ResolvedBy: row.ResolvedBy,
+			ResolvedAt: row.ResolvedAt,
+			CreatedAt:  row.CreatedAt,
+			UpdatedAt:  row.UpdatedAt,
+		}),
+		AuthorFirstName: row.FirstName,
+		AuthorLastName:  row.LastName,
+		AuthorThumbnail: textToStringPtr(row.AuthorThumbnail),
+	}
+}
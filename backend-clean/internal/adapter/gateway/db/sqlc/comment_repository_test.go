@@ -0,0 +1,266 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/comment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func newCommentRow() *generated.GetCommentByIDRow {
+	now := pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Second), Valid: true}
+	return &generated.GetCommentByIDRow{
+		ID:              pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		NoteID:          pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		SectionID:       pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		AuthorID:        pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
+		Body:            "why?",
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		FirstName:       "Taro",
+		LastName:        "Yamada",
+		AuthorThumbnail: pgtype.Text{String: "https://example.com/t.png", Valid: true},
+	}
+}
+
+func TestCommentRepository_Get(t *testing.T) {
+	row := newCommentRow()
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] get comment", id: row.ID.String()},
+		{name: "[Fail] invalid uuid is not found", id: "bad-uuid", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] no rows is not found", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] query error", id: row.ID.String(), rowErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CommentRepository{queries: generated.New(mockdb.NewCommentDBTX(row, tt.rowErr, nil, 0))}
+			got, err := repo.Get(context.Background(), tt.id)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Comment.ID != row.ID.String() || got.Comment.SectionID != row.SectionID.String() || got.Comment.ParentID != "" {
+				t.Fatalf("unexpected comment: %+v", got.Comment)
+			}
+			if got.AuthorFirstName != "Taro" || got.AuthorThumbnail == nil || got.Comment.IsResolved() {
+				t.Fatalf("unexpected author: %+v", got)
+			}
+		})
+	}
+}
+
+func TestCommentRepository_List(t *testing.T) {
+	noteID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}.String()
+	reply := newCommentRow()
+	reply.ID = pgtype.UUID{Bytes: [16]byte{5}, Valid: true}
+	reply.ParentID = pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	reply.AuthorThumbnail = pgtype.Text{}
+	tests := []struct {
+		name     string
+		noteID   string
+		queryErr error
+		wantErr  bool
+	}{
+		{name: "[Success] list comments", noteID: noteID},
+		{name: "[Fail] invalid uuid", noteID: "bad-uuid", wantErr: true},
+		{name: "[Fail] query error", noteID: noteID, queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewCommentDBTX(nil, nil, nil, 0).WithList([]*generated.GetCommentByIDRow{newCommentRow(), reply}, tt.queryErr)
+			repo := &CommentRepository{queries: generated.New(mock)}
+			got, err := repo.List(context.Background(), tt.noteID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 2 || got[1].Comment.ParentID != got[0].Comment.ID || got[1].AuthorThumbnail != nil {
+				t.Fatalf("unexpected comments: %+v", got)
+			}
+		})
+	}
+}
+
+func TestCommentRepository_Create(t *testing.T) {
+	row := newCommentRow()
+	valid := comment.Comment{NoteID: row.NoteID.String(), SectionID: row.SectionID.String(), AuthorID: row.AuthorID.String(), Body: "why?"}
+	tests := []struct {
+		name        string
+		comment     comment.Comment
+		rowErr      error
+		wantErr     error
+		wantSection bool
+	}{
+		{name: "[Success] thread on a section", comment: valid, wantSection: true},
+		{name: "[Success] thread on the whole note", comment: comment.Comment{NoteID: valid.NoteID, AuthorID: valid.AuthorID, Body: "hi"}},
+		{name: "[Fail] invalid note uuid", comment: comment.Comment{NoteID: "bad-uuid", AuthorID: valid.AuthorID}, wantErr: errors.New("invalid")},
+		{name: "[Fail] invalid section uuid", comment: comment.Comment{NoteID: valid.NoteID, AuthorID: valid.AuthorID, SectionID: "bad-uuid"}, wantErr: domainerr.ErrInvalidCommentSection},
+		{name: "[Fail] invalid parent uuid is not found", comment: comment.Comment{NoteID: valid.NoteID, AuthorID: valid.AuthorID, ParentID: "bad-uuid"}, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] query error", comment: valid, rowErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewCommentDBTX(row, tt.rowErr, nil, 0)
+			repo := &CommentRepository{queries: generated.New(mock)}
+			got, err := repo.Create(context.Background(), tt.comment)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if errors.Is(tt.wantErr, domainerr.ErrNotFound) || errors.Is(tt.wantErr, domainerr.ErrInvalidCommentSection) {
+					if !errors.Is(err, tt.wantErr) {
+						t.Fatalf("want %v, got %v", tt.wantErr, err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if section := mock.Args[1].(pgtype.UUID); section.Valid != tt.wantSection {
+				t.Fatalf("unexpected section arg: %+v", section)
+			}
+			if parent := mock.Args[2].(pgtype.UUID); parent.Valid {
+				t.Fatalf("thread should have no parent: %+v", parent)
+			}
+			if got.ID != row.ID.String() || got.Body != "why?" {
+				t.Fatalf("unexpected comment: %+v", got)
+			}
+		})
+	}
+}
+
+func TestCommentRepository_Update(t *testing.T) {
+	row := newCommentRow()
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] update body", id: row.ID.String()},
+		{name: "[Fail] invalid uuid is not found", id: "bad-uuid", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] no rows is not found", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewCommentDBTX(row, tt.rowErr, nil, 0)
+			repo := &CommentRepository{queries: generated.New(mock)}
+			got, err := repo.Update(context.Background(), comment.Comment{ID: tt.id, Body: "edited"})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mock.Args[1] != "edited" || got.ID != row.ID.String() {
+				t.Fatalf("unexpected update: %+v / %+v", mock.Args, got)
+			}
+		})
+	}
+}
+
+func TestCommentRepository_UpdateResolution(t *testing.T) {
+	row := newCommentRow()
+	at := time.Now().UTC().Truncate(time.Second)
+	resolver := pgtype.UUID{Bytes: [16]byte{6}, Valid: true}.String()
+	tests := []struct {
+		name    string
+		comment comment.Comment
+		rowErr  error
+		want    bool
+		wantErr error
+	}{
+		{name: "[Success] resolve", comment: comment.Comment{ID: row.ID.String(), ResolvedBy: resolver, ResolvedAt: &at}, want: true},
+		{name: "[Success] reopen", comment: comment.Comment{ID: row.ID.String()}},
+		{name: "[Fail] invalid resolver uuid", comment: comment.Comment{ID: row.ID.String(), ResolvedBy: "bad-uuid", ResolvedAt: &at}, wantErr: errors.New("invalid")},
+		{name: "[Fail] no rows is not found", comment: comment.Comment{ID: row.ID.String()}, rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewCommentDBTX(row, tt.rowErr, nil, 0)
+			repo := &CommentRepository{queries: generated.New(mock)}
+			_, err := repo.UpdateResolution(context.Background(), tt.comment)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if errors.Is(tt.wantErr, domainerr.ErrNotFound) && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want ErrNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			by, byOK := mock.Args[1].(pgtype.UUID)
+			resolvedAt, atOK := mock.Args[2].(pgtype.Timestamptz)
+			if !byOK || !atOK || by.Valid != tt.want || resolvedAt.Valid != tt.want {
+				t.Fatalf("unexpected resolution args: %+v", mock.Args)
+			}
+		})
+	}
+}
+
+func TestCommentRepository_Delete(t *testing.T) {
+	id := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	tests := []struct {
+		name     string
+		id       string
+		affected int64
+		execErr  error
+		wantErr  error
+	}{
+		{name: "[Success] delete comment", id: id, affected: 1},
+		{name: "[Fail] invalid uuid is not found", id: "bad-uuid", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] no rows is not found", id: id, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] exec error", id: id, execErr: errors.New("exec"), wantErr: errors.New("exec")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &CommentRepository{queries: generated.New(mockdb.NewCommentDBTX(nil, nil, tt.execErr, tt.affected))}
+			err := repo.Delete(context.Background(), tt.id)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr.Error() {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit_log.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getAuditLogHead = `-- name: GetAuditLogHead :one
+SELECT hash FROM audit_log ORDER BY sequence DESC LIMIT 1
+`
+
+func (q *Queries) GetAuditLogHead(ctx context.Context) (string, error) {
+	row := q.db.QueryRow(ctx, getAuditLogHead)
+	var hash string
+	err := row.Scan(&hash)
+	return hash, err
+}
+
+const insertAuditLog = `-- name: InsertAuditLog :one
+INSERT INTO audit_log (actor_id, action, aggregate_type, aggregate_id, before, after, request_id, occurred_at, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING sequence
+`
+
+type InsertAuditLogParams struct {
+	ActorID       string             `db:"actor_id" json:"actor_id"`
+	Action        string             `db:"action" json:"action"`
+	AggregateType string             `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   string             `db:"aggregate_id" json:"aggregate_id"`
+	Before        []byte             `db:"before" json:"before"`
+	After         []byte             `db:"after" json:"after"`
+	RequestID     string             `db:"request_id" json:"request_id"`
+	OccurredAt    pgtype.Timestamptz `db:"occurred_at" json:"occurred_at"`
+	PrevHash      string             `db:"prev_hash" json:"prev_hash"`
+	Hash          string             `db:"hash" json:"hash"`
+}
+
+func (q *Queries) InsertAuditLog(ctx context.Context, arg *InsertAuditLogParams) (int64, error) {
+	row := q.db.QueryRow(ctx, insertAuditLog,
+		arg.ActorID,
+		arg.Action,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.Before,
+		arg.After,
+		arg.RequestID,
+		arg.OccurredAt,
+		arg.PrevHash,
+		arg.Hash,
+	)
+	var sequence int64
+	err := row.Scan(&sequence)
+	return sequence, err
+}
+
+const listAuditLog = `-- name: ListAuditLog :many
+SELECT sequence, actor_id, action, aggregate_type, aggregate_id, before, after, request_id, occurred_at, prev_hash, hash
+FROM audit_log
+WHERE (NULLIF($1::text, '') IS NULL OR actor_id = $1)
+  AND (NULLIF($2::text, '') IS NULL OR aggregate_type = $2)
+  AND (NULLIF($3::text, '') IS NULL OR aggregate_id = $3)
+  AND ($4::timestamptz IS NULL OR occurred_at >= $4)
+  AND ($5::timestamptz IS NULL OR occurred_at < $5)
+  AND (NULLIF($6::bigint, 0) IS NULL OR sequence < $6)
+ORDER BY sequence DESC
+LIMIT $7
+`
+
+type ListAuditLogParams struct {
+	Column1 string             `db:"column_1" json:"column_1"`
+	Column2 string             `db:"column_2" json:"column_2"`
+	Column3 string             `db:"column_3" json:"column_3"`
+	Column4 pgtype.Timestamptz `db:"column_4" json:"column_4"`
+	Column5 pgtype.Timestamptz `db:"column_5" json:"column_5"`
+	Column6 int64              `db:"column_6" json:"column_6"`
+	Limit   int32              `db:"limit" json:"limit"`
+}
+
+// Newest first. $1-$3 filter by actor and aggregate and $4/$5 bound occurred_at
+// (all optional; empty strings and NULLs match everything); $6 is the sequence to
+// continue after (0 for the first page) and $7 the page size.
+func (q *Queries) ListAuditLog(ctx context.Context, arg *ListAuditLogParams) ([]*AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditLog,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		arg.Column5,
+		arg.Column6,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.Sequence,
+			&i.ActorID,
+			&i.Action,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.Before,
+			&i.After,
+			&i.RequestID,
+			&i.OccurredAt,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditLogChain = `-- name: ListAuditLogChain :many
+SELECT sequence, actor_id, action, aggregate_type, aggregate_id, before, after, request_id, occurred_at, prev_hash, hash
+FROM audit_log
+WHERE sequence > $1
+ORDER BY sequence
+LIMIT $2
+`
+
+type ListAuditLogChainParams struct {
+	Sequence int64 `db:"sequence" json:"sequence"`
+	Limit    int32 `db:"limit" json:"limit"`
+}
+
+// Oldest first, for verifying the chain in batches.
+func (q *Queries) ListAuditLogChain(ctx context.Context, arg *ListAuditLogChainParams) ([]*AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditLogChain, arg.Sequence, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.Sequence,
+			&i.ActorID,
+			&i.Action,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.Before,
+			&i.After,
+			&i.RequestID,
+			&i.OccurredAt,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockAuditLog = `-- name: LockAuditLog :exec
+SELECT pg_advisory_xact_lock(hashtext('audit_log'))
+`
+
+// Serializes appends until the transaction ends, so each entry chains onto the last.
+func (q *Queries) LockAuditLog(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockAuditLog)
+	return err
+}
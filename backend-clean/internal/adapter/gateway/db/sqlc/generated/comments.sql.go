@@ -0,0 +1,238 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: comments.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createComment = `-- name: CreateComment :one
+INSERT INTO comments (note_id, section_id, parent_id, author_id, body)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, note_id, section_id, parent_id, author_id, body, resolved_by, resolved_at, created_at, updated_at
+`
+
+type CreateCommentParams struct {
+	NoteID    pgtype.UUID `db:"note_id" json:"note_id"`
+	SectionID pgtype.UUID `db:"section_id" json:"section_id"`
+	ParentID  pgtype.UUID `db:"parent_id" json:"parent_id"`
+	AuthorID  pgtype.UUID `db:"author_id" json:"author_id"`
+	Body      string      `db:"body" json:"body"`
+}
+
+func (q *Queries) CreateComment(ctx context.Context, arg *CreateCommentParams) (*Comment, error) {
+	row := q.db.QueryRow(ctx, createComment,
+		arg.NoteID,
+		arg.SectionID,
+		arg.ParentID,
+		arg.AuthorID,
+		arg.Body,
+	)
+	var i Comment
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.SectionID,
+		&i.ParentID,
+		&i.AuthorID,
+		&i.Body,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const deleteComment = `-- name: DeleteComment :execrows
+DELETE FROM comments
+WHERE id = $1
+`
+
+func (q *Queries) DeleteComment(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteComment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCommentByID = `-- name: GetCommentByID :one
+SELECT
+    c.id, c.note_id, c.section_id, c.parent_id, c.author_id, c.body, c.resolved_by, c.resolved_at, c.created_at, c.updated_at,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS author_thumbnail
+FROM comments c
+JOIN accounts a ON a.id = c.author_id
+WHERE c.id = $1
+`
+
+type GetCommentByIDRow struct {
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	NoteID          pgtype.UUID        `db:"note_id" json:"note_id"`
+	SectionID       pgtype.UUID        `db:"section_id" json:"section_id"`
+	ParentID        pgtype.UUID        `db:"parent_id" json:"parent_id"`
+	AuthorID        pgtype.UUID        `db:"author_id" json:"author_id"`
+	Body            string             `db:"body" json:"body"`
+	ResolvedBy      pgtype.UUID        `db:"resolved_by" json:"resolved_by"`
+	ResolvedAt      pgtype.Timestamptz `db:"resolved_at" json:"resolved_at"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	FirstName       string             `db:"first_name" json:"first_name"`
+	LastName        string             `db:"last_name" json:"last_name"`
+	AuthorThumbnail pgtype.Text        `db:"author_thumbnail" json:"author_thumbnail"`
+}
+
+func (q *Queries) GetCommentByID(ctx context.Context, id pgtype.UUID) (*GetCommentByIDRow, error) {
+	row := q.db.QueryRow(ctx, getCommentByID, id)
+	var i GetCommentByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.SectionID,
+		&i.ParentID,
+		&i.AuthorID,
+		&i.Body,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FirstName,
+		&i.LastName,
+		&i.AuthorThumbnail,
+	)
+	return &i, err
+}
+
+const listCommentsByNote = `-- name: ListCommentsByNote :many
+SELECT
+    c.id, c.note_id, c.section_id, c.parent_id, c.author_id, c.body, c.resolved_by, c.resolved_at, c.created_at, c.updated_at,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS author_thumbnail
+FROM comments c
+JOIN accounts a ON a.id = c.author_id
+WHERE c.note_id = $1
+ORDER BY c.created_at ASC, c.id ASC
+`
+
+type ListCommentsByNoteRow struct {
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	NoteID          pgtype.UUID        `db:"note_id" json:"note_id"`
+	SectionID       pgtype.UUID        `db:"section_id" json:"section_id"`
+	ParentID        pgtype.UUID        `db:"parent_id" json:"parent_id"`
+	AuthorID        pgtype.UUID        `db:"author_id" json:"author_id"`
+	Body            string             `db:"body" json:"body"`
+	ResolvedBy      pgtype.UUID        `db:"resolved_by" json:"resolved_by"`
+	ResolvedAt      pgtype.Timestamptz `db:"resolved_at" json:"resolved_at"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	FirstName       string             `db:"first_name" json:"first_name"`
+	LastName        string             `db:"last_name" json:"last_name"`
+	AuthorThumbnail pgtype.Text        `db:"author_thumbnail" json:"author_thumbnail"`
+}
+
+// Oldest first, so replies follow the comment they answer.
+func (q *Queries) ListCommentsByNote(ctx context.Context, noteID pgtype.UUID) ([]*ListCommentsByNoteRow, error) {
+	rows, err := q.db.Query(ctx, listCommentsByNote, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListCommentsByNoteRow
+	for rows.Next() {
+		var i ListCommentsByNoteRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.SectionID,
+			&i.ParentID,
+			&i.AuthorID,
+			&i.Body,
+			&i.ResolvedBy,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FirstName,
+			&i.LastName,
+			&i.AuthorThumbnail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCommentBody = `-- name: UpdateCommentBody :one
+UPDATE comments
+SET body = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, note_id, section_id, parent_id, author_id, body, resolved_by, resolved_at, created_at, updated_at
+`
+
+type UpdateCommentBodyParams struct {
+	ID   pgtype.UUID `db:"id" json:"id"`
+	Body string      `db:"body" json:"body"`
+}
+
+func (q *Queries) UpdateCommentBody(ctx context.Context, arg *UpdateCommentBodyParams) (*Comment, error) {
+	row := q.db.QueryRow(ctx, updateCommentBody, arg.ID, arg.Body)
+	var i Comment
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.SectionID,
+		&i.ParentID,
+		&i.AuthorID,
+		&i.Body,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const updateCommentResolution = `-- name: UpdateCommentResolution :one
+UPDATE comments
+SET resolved_by = $2,
+    resolved_at = $3
+WHERE id = $1
+RETURNING id, note_id, section_id, parent_id, author_id, body, resolved_by, resolved_at, created_at, updated_at
+`
+
+type UpdateCommentResolutionParams struct {
+	ID         pgtype.UUID        `db:"id" json:"id"`
+	ResolvedBy pgtype.UUID        `db:"resolved_by" json:"resolved_by"`
+	ResolvedAt pgtype.Timestamptz `db:"resolved_at" json:"resolved_at"`
+}
+
+// Resolving does not count as an edit, so updated_at stays.
+func (q *Queries) UpdateCommentResolution(ctx context.Context, arg *UpdateCommentResolutionParams) (*Comment, error) {
+	row := q.db.QueryRow(ctx, updateCommentResolution, arg.ID, arg.ResolvedBy, arg.ResolvedAt)
+	var i Comment
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.SectionID,
+		&i.ParentID,
+		&i.AuthorID,
+		&i.Body,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
@@ -22,22 +22,111 @@ type Account struct {
 	UpdatedAt         pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
 
+type AuditLog struct {
+	Sequence      int64              `db:"sequence" json:"sequence"`
+	ActorID       string             `db:"actor_id" json:"actor_id"`
+	Action        string             `db:"action" json:"action"`
+	AggregateType string             `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   string             `db:"aggregate_id" json:"aggregate_id"`
+	Before        []byte             `db:"before" json:"before"`
+	After         []byte             `db:"after" json:"after"`
+	RequestID     string             `db:"request_id" json:"request_id"`
+	OccurredAt    pgtype.Timestamptz `db:"occurred_at" json:"occurred_at"`
+	PrevHash      string             `db:"prev_hash" json:"prev_hash"`
+	Hash          string             `db:"hash" json:"hash"`
+}
+
+type Comment struct {
+	ID         pgtype.UUID        `db:"id" json:"id"`
+	NoteID     pgtype.UUID        `db:"note_id" json:"note_id"`
+	SectionID  pgtype.UUID        `db:"section_id" json:"section_id"`
+	ParentID   pgtype.UUID        `db:"parent_id" json:"parent_id"`
+	AuthorID   pgtype.UUID        `db:"author_id" json:"author_id"`
+	Body       string             `db:"body" json:"body"`
+	ResolvedBy pgtype.UUID        `db:"resolved_by" json:"resolved_by"`
+	ResolvedAt pgtype.Timestamptz `db:"resolved_at" json:"resolved_at"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
 type Field struct {
 	ID         pgtype.UUID `db:"id" json:"id"`
 	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
 	Label      string      `db:"label" json:"label"`
 	Order      int32       `db:"order" json:"order"`
 	IsRequired bool        `db:"is_required" json:"is_required"`
+	Version    int32       `db:"version" json:"version"`
+	Type       string      `db:"type" json:"type"`
+	Options    []string    `db:"options" json:"options"`
 }
 
 type Note struct {
-	ID         pgtype.UUID        `db:"id" json:"id"`
-	Title      string             `db:"title" json:"title"`
-	TemplateID pgtype.UUID        `db:"template_id" json:"template_id"`
-	OwnerID    pgtype.UUID        `db:"owner_id" json:"owner_id"`
-	Status     string             `db:"status" json:"status"`
-	CreatedAt  pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status          string             `db:"status" json:"status"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version         int32              `db:"version" json:"version"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+	DeletedAt       pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID     pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+}
+
+type NoteCollaborator struct {
+	NoteID    pgtype.UUID        `db:"note_id" json:"note_id"`
+	AccountID pgtype.UUID        `db:"account_id" json:"account_id"`
+	Role      string             `db:"role" json:"role"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type NoteLink struct {
+	ID           pgtype.UUID        `db:"id" json:"id"`
+	SourceNoteID pgtype.UUID        `db:"source_note_id" json:"source_note_id"`
+	TargetNoteID pgtype.UUID        `db:"target_note_id" json:"target_note_id"`
+	LinkType     string             `db:"link_type" json:"link_type"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type NoteRevision struct {
+	ID        pgtype.UUID        `db:"id" json:"id"`
+	NoteID    pgtype.UUID        `db:"note_id" json:"note_id"`
+	Revision  int32              `db:"revision" json:"revision"`
+	Title     string             `db:"title" json:"title"`
+	Status    string             `db:"status" json:"status"`
+	ActorID   pgtype.UUID        `db:"actor_id" json:"actor_id"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type NoteTag struct {
+	NoteID    pgtype.UUID        `db:"note_id" json:"note_id"`
+	Tag       string             `db:"tag" json:"tag"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+}
+
+type Outbox struct {
+	ID            int64              `db:"id" json:"id"`
+	EventID       pgtype.UUID        `db:"event_id" json:"event_id"`
+	EventType     string             `db:"event_type" json:"event_type"`
+	AggregateType string             `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   string             `db:"aggregate_id" json:"aggregate_id"`
+	ActorID       string             `db:"actor_id" json:"actor_id"`
+	Payload       []byte             `db:"payload" json:"payload"`
+	OccurredAt    pgtype.Timestamptz `db:"occurred_at" json:"occurred_at"`
+	Attempts      int32              `db:"attempts" json:"attempts"`
+	LastError     string             `db:"last_error" json:"last_error"`
+	NextAttemptAt pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	DeliveredAt   pgtype.Timestamptz `db:"delivered_at" json:"delivered_at"`
+}
+
+type RateLimitBucket struct {
+	Key       string             `db:"key" json:"key"`
+	Tokens    float64            `db:"tokens" json:"tokens"`
+	Version   int64              `db:"version" json:"version"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	FullAt    pgtype.Timestamptz `db:"full_at" json:"full_at"`
 }
 
 type Section struct {
@@ -47,9 +136,73 @@ type Section struct {
 	Content string      `db:"content" json:"content"`
 }
 
+type SectionRevision struct {
+	ID             pgtype.UUID `db:"id" json:"id"`
+	NoteRevisionID pgtype.UUID `db:"note_revision_id" json:"note_revision_id"`
+	FieldID        pgtype.UUID `db:"field_id" json:"field_id"`
+	FieldLabel     string      `db:"field_label" json:"field_label"`
+	FieldOrder     int32       `db:"field_order" json:"field_order"`
+	Content        string      `db:"content" json:"content"`
+}
+
 type Template struct {
+	ID          pgtype.UUID        `db:"id" json:"id"`
+	Name        string             `db:"name" json:"name"`
+	OwnerID     pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version     int32              `db:"version" json:"version"`
+	DeletedAt   pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+}
+
+type Webhook struct {
 	ID        pgtype.UUID        `db:"id" json:"id"`
-	Name      string             `db:"name" json:"name"`
 	OwnerID   pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Url       string             `db:"url" json:"url"`
+	Secret    string             `db:"secret" json:"secret"`
+	Events    []string           `db:"events" json:"events"`
+	IsActive  bool               `db:"is_active" json:"is_active"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             pgtype.UUID        `db:"id" json:"id"`
+	WebhookID      pgtype.UUID        `db:"webhook_id" json:"webhook_id"`
+	EventID        pgtype.UUID        `db:"event_id" json:"event_id"`
+	EventType      string             `db:"event_type" json:"event_type"`
+	Payload        []byte             `db:"payload" json:"payload"`
+	Status         string             `db:"status" json:"status"`
+	Attempts       int32              `db:"attempts" json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	LastStatusCode int32              `db:"last_status_code" json:"last_status_code"`
+	LastError      string             `db:"last_error" json:"last_error"`
+	RedeliveryOf   pgtype.UUID        `db:"redelivery_of" json:"redelivery_of"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz `db:"delivered_at" json:"delivered_at"`
+}
+
+type Workspace struct {
+	ID        pgtype.UUID        `db:"id" json:"id"`
+	Name      string             `db:"name" json:"name"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 }
+
+type WorkspaceInvite struct {
+	ID          pgtype.UUID        `db:"id" json:"id"`
+	WorkspaceID pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	Email       string             `db:"email" json:"email"`
+	Role        string             `db:"role" json:"role"`
+	InvitedBy   pgtype.UUID        `db:"invited_by" json:"invited_by"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	AccountID   pgtype.UUID        `db:"account_id" json:"account_id"`
+	Role        string             `db:"role" json:"role"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+}
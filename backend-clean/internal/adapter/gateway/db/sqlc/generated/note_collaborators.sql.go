@@ -0,0 +1,110 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: note_collaborators.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteNoteCollaborator = `-- name: DeleteNoteCollaborator :execrows
+DELETE FROM note_collaborators
+WHERE note_id = $1 AND account_id = $2
+`
+
+type DeleteNoteCollaboratorParams struct {
+	NoteID    pgtype.UUID `db:"note_id" json:"note_id"`
+	AccountID pgtype.UUID `db:"account_id" json:"account_id"`
+}
+
+func (q *Queries) DeleteNoteCollaborator(ctx context.Context, arg *DeleteNoteCollaboratorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNoteCollaborator, arg.NoteID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listNoteCollaborators = `-- name: ListNoteCollaborators :many
+SELECT
+    nc.note_id, nc.account_id, nc.role, nc.created_at, nc.updated_at,
+    a.first_name,
+    a.last_name,
+    a.thumbnail
+FROM note_collaborators nc
+JOIN accounts a ON a.id = nc.account_id
+WHERE nc.note_id = $1
+ORDER BY nc.created_at ASC, nc.account_id ASC
+`
+
+type ListNoteCollaboratorsRow struct {
+	NoteID    pgtype.UUID        `db:"note_id" json:"note_id"`
+	AccountID pgtype.UUID        `db:"account_id" json:"account_id"`
+	Role      string             `db:"role" json:"role"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	FirstName string             `db:"first_name" json:"first_name"`
+	LastName  string             `db:"last_name" json:"last_name"`
+	Thumbnail pgtype.Text        `db:"thumbnail" json:"thumbnail"`
+}
+
+func (q *Queries) ListNoteCollaborators(ctx context.Context, noteID pgtype.UUID) ([]*ListNoteCollaboratorsRow, error) {
+	rows, err := q.db.Query(ctx, listNoteCollaborators, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListNoteCollaboratorsRow
+	for rows.Next() {
+		var i ListNoteCollaboratorsRow
+		if err := rows.Scan(
+			&i.NoteID,
+			&i.AccountID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FirstName,
+			&i.LastName,
+			&i.Thumbnail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertNoteCollaborator = `-- name: UpsertNoteCollaborator :one
+INSERT INTO note_collaborators (note_id, account_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (note_id, account_id)
+DO UPDATE SET role = EXCLUDED.role, updated_at = NOW()
+RETURNING note_id, account_id, role, created_at, updated_at
+`
+
+type UpsertNoteCollaboratorParams struct {
+	NoteID    pgtype.UUID `db:"note_id" json:"note_id"`
+	AccountID pgtype.UUID `db:"account_id" json:"account_id"`
+	Role      string      `db:"role" json:"role"`
+}
+
+// Adding an account that already collaborates changes its role.
+func (q *Queries) UpsertNoteCollaborator(ctx context.Context, arg *UpsertNoteCollaboratorParams) (*NoteCollaborator, error) {
+	row := q.db.QueryRow(ctx, upsertNoteCollaborator, arg.NoteID, arg.AccountID, arg.Role)
+	var i NoteCollaborator
+	err := row.Scan(
+		&i.NoteID,
+		&i.AccountID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,181 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: note_links.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createNoteLink = `-- name: CreateNoteLink :one
+INSERT INTO note_links (source_note_id, target_note_id, link_type)
+VALUES ($1, $2, $3)
+ON CONFLICT (source_note_id, target_note_id, link_type)
+DO UPDATE SET link_type = EXCLUDED.link_type
+RETURNING id, source_note_id, target_note_id, link_type, created_at
+`
+
+type CreateNoteLinkParams struct {
+	SourceNoteID pgtype.UUID `db:"source_note_id" json:"source_note_id"`
+	TargetNoteID pgtype.UUID `db:"target_note_id" json:"target_note_id"`
+	LinkType     string      `db:"link_type" json:"link_type"`
+}
+
+// Adding an existing link is a no-op that returns the stored row.
+func (q *Queries) CreateNoteLink(ctx context.Context, arg *CreateNoteLinkParams) (*NoteLink, error) {
+	row := q.db.QueryRow(ctx, createNoteLink, arg.SourceNoteID, arg.TargetNoteID, arg.LinkType)
+	var i NoteLink
+	err := row.Scan(
+		&i.ID,
+		&i.SourceNoteID,
+		&i.TargetNoteID,
+		&i.LinkType,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const deleteNoteLink = `-- name: DeleteNoteLink :execrows
+DELETE FROM note_links
+WHERE id = $1 AND source_note_id = $2
+`
+
+type DeleteNoteLinkParams struct {
+	ID           pgtype.UUID `db:"id" json:"id"`
+	SourceNoteID pgtype.UUID `db:"source_note_id" json:"source_note_id"`
+}
+
+func (q *Queries) DeleteNoteLink(ctx context.Context, arg *DeleteNoteLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNoteLink, arg.ID, arg.SourceNoteID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteNoteLinksByNote = `-- name: DeleteNoteLinksByNote :exec
+DELETE FROM note_links
+WHERE source_note_id = $1 OR target_note_id = $1
+`
+
+func (q *Queries) DeleteNoteLinksByNote(ctx context.Context, sourceNoteID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteNoteLinksByNote, sourceNoteID)
+	return err
+}
+
+const listBacklinkNoteLinks = `-- name: ListBacklinkNoteLinks :many
+SELECT
+    l.id,
+    l.link_type,
+    n.id AS note_id,
+    n.title,
+    n.status,
+    n.owner_id,
+    ARRAY(
+        SELECT nc.account_id FROM note_collaborators nc WHERE nc.note_id = n.id
+    )::uuid[] AS collaborator_ids
+FROM note_links l
+JOIN notes n ON n.id = l.source_note_id
+WHERE l.target_note_id = $1 AND n.deleted_at IS NULL
+ORDER BY l.created_at ASC
+`
+
+type ListBacklinkNoteLinksRow struct {
+	ID              pgtype.UUID   `db:"id" json:"id"`
+	LinkType        string        `db:"link_type" json:"link_type"`
+	NoteID          pgtype.UUID   `db:"note_id" json:"note_id"`
+	Title           string        `db:"title" json:"title"`
+	Status          string        `db:"status" json:"status"`
+	OwnerID         pgtype.UUID   `db:"owner_id" json:"owner_id"`
+	CollaboratorIds []pgtype.UUID `db:"collaborator_ids" json:"collaborator_ids"`
+}
+
+// Same columns as ListOutgoingNoteLinks, for the notes linking here.
+func (q *Queries) ListBacklinkNoteLinks(ctx context.Context, targetNoteID pgtype.UUID) ([]*ListBacklinkNoteLinksRow, error) {
+	rows, err := q.db.Query(ctx, listBacklinkNoteLinks, targetNoteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListBacklinkNoteLinksRow
+	for rows.Next() {
+		var i ListBacklinkNoteLinksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LinkType,
+			&i.NoteID,
+			&i.Title,
+			&i.Status,
+			&i.OwnerID,
+			&i.CollaboratorIds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutgoingNoteLinks = `-- name: ListOutgoingNoteLinks :many
+SELECT
+    l.id,
+    l.link_type,
+    n.id AS note_id,
+    n.title,
+    n.status,
+    n.owner_id,
+    ARRAY(
+        SELECT nc.account_id FROM note_collaborators nc WHERE nc.note_id = n.id
+    )::uuid[] AS collaborator_ids
+FROM note_links l
+JOIN notes n ON n.id = l.target_note_id
+WHERE l.source_note_id = $1 AND n.deleted_at IS NULL
+ORDER BY l.created_at ASC
+`
+
+type ListOutgoingNoteLinksRow struct {
+	ID              pgtype.UUID   `db:"id" json:"id"`
+	LinkType        string        `db:"link_type" json:"link_type"`
+	NoteID          pgtype.UUID   `db:"note_id" json:"note_id"`
+	Title           string        `db:"title" json:"title"`
+	Status          string        `db:"status" json:"status"`
+	OwnerID         pgtype.UUID   `db:"owner_id" json:"owner_id"`
+	CollaboratorIds []pgtype.UUID `db:"collaborator_ids" json:"collaborator_ids"`
+}
+
+// Links to trashed notes are kept for a restore but not shown. The collaborators of the
+// other note decide, with its owner and status, who may see the link.
+func (q *Queries) ListOutgoingNoteLinks(ctx context.Context, sourceNoteID pgtype.UUID) ([]*ListOutgoingNoteLinksRow, error) {
+	rows, err := q.db.Query(ctx, listOutgoingNoteLinks, sourceNoteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListOutgoingNoteLinksRow
+	for rows.Next() {
+		var i ListOutgoingNoteLinksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LinkType,
+			&i.NoteID,
+			&i.Title,
+			&i.Status,
+			&i.OwnerID,
+			&i.CollaboratorIds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: note_revisions.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createNoteRevision = `-- name: CreateNoteRevision :one
+INSERT INTO note_revisions (note_id, revision, title, status, actor_id)
+VALUES (
+    $1,
+    (SELECT COALESCE(MAX(r.revision), 0) + 1 FROM note_revisions r WHERE r.note_id = $1),
+    $2,
+    $3,
+    $4
+)
+RETURNING id, note_id, revision, title, status, actor_id, created_at
+`
+
+type CreateNoteRevisionParams struct {
+	NoteID  pgtype.UUID `db:"note_id" json:"note_id"`
+	Title   string      `db:"title" json:"title"`
+	Status  string      `db:"status" json:"status"`
+	ActorID pgtype.UUID `db:"actor_id" json:"actor_id"`
+}
+
+// The next number is derived from existing rows. Callers hold the notes row lock
+// (the note was just updated in the same transaction), so numbers cannot race.
+func (q *Queries) CreateNoteRevision(ctx context.Context, arg *CreateNoteRevisionParams) (*NoteRevision, error) {
+	row := q.db.QueryRow(ctx, createNoteRevision,
+		arg.NoteID,
+		arg.Title,
+		arg.Status,
+		arg.ActorID,
+	)
+	var i NoteRevision
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.Revision,
+		&i.Title,
+		&i.Status,
+		&i.ActorID,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const createSectionRevision = `-- name: CreateSectionRevision :exec
+INSERT INTO section_revisions (note_revision_id, field_id, field_label, field_order, content)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateSectionRevisionParams struct {
+	NoteRevisionID pgtype.UUID `db:"note_revision_id" json:"note_revision_id"`
+	FieldID        pgtype.UUID `db:"field_id" json:"field_id"`
+	FieldLabel     string      `db:"field_label" json:"field_label"`
+	FieldOrder     int32       `db:"field_order" json:"field_order"`
+	Content        string      `db:"content" json:"content"`
+}
+
+func (q *Queries) CreateSectionRevision(ctx context.Context, arg *CreateSectionRevisionParams) error {
+	_, err := q.db.Exec(ctx, createSectionRevision,
+		arg.NoteRevisionID,
+		arg.FieldID,
+		arg.FieldLabel,
+		arg.FieldOrder,
+		arg.Content,
+	)
+	return err
+}
+
+const getNoteRevision = `-- name: GetNoteRevision :one
+SELECT id, note_id, revision, title, status, actor_id, created_at
+FROM note_revisions
+WHERE note_id = $1 AND revision = $2
+`
+
+type GetNoteRevisionParams struct {
+	NoteID   pgtype.UUID `db:"note_id" json:"note_id"`
+	Revision int32       `db:"revision" json:"revision"`
+}
+
+func (q *Queries) GetNoteRevision(ctx context.Context, arg *GetNoteRevisionParams) (*NoteRevision, error) {
+	row := q.db.QueryRow(ctx, getNoteRevision, arg.NoteID, arg.Revision)
+	var i NoteRevision
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.Revision,
+		&i.Title,
+		&i.Status,
+		&i.ActorID,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const listNoteRevisions = `-- name: ListNoteRevisions :many
+SELECT id, note_id, revision, title, status, actor_id, created_at
+FROM note_revisions
+WHERE note_id = $1
+ORDER BY revision DESC
+`
+
+func (q *Queries) ListNoteRevisions(ctx context.Context, noteID pgtype.UUID) ([]*NoteRevision, error) {
+	rows, err := q.db.Query(ctx, listNoteRevisions, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*NoteRevision
+	for rows.Next() {
+		var i NoteRevision
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.Revision,
+			&i.Title,
+			&i.Status,
+			&i.ActorID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSectionRevisions = `-- name: ListSectionRevisions :many
+SELECT id, note_revision_id, field_id, field_label, field_order, content
+FROM section_revisions
+WHERE note_revision_id = $1
+ORDER BY field_order ASC
+`
+
+func (q *Queries) ListSectionRevisions(ctx context.Context, noteRevisionID pgtype.UUID) ([]*SectionRevision, error) {
+	rows, err := q.db.Query(ctx, listSectionRevisions, noteRevisionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SectionRevision
+	for rows.Next() {
+		var i SectionRevision
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteRevisionID,
+			&i.FieldID,
+			&i.FieldLabel,
+			&i.FieldOrder,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
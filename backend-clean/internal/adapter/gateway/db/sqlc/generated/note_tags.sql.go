@@ -0,0 +1,130 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: note_tags.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addNoteTags = `-- name: AddNoteTags :exec
+INSERT INTO note_tags (note_id, tag)
+SELECT $1, unnest($2::text[])
+ON CONFLICT (note_id, tag) DO NOTHING
+`
+
+type AddNoteTagsParams struct {
+	NoteID  pgtype.UUID `db:"note_id" json:"note_id"`
+	Column2 []string    `db:"column_2" json:"column_2"`
+}
+
+// Tags the note already has are kept as they are.
+func (q *Queries) AddNoteTags(ctx context.Context, arg *AddNoteTagsParams) error {
+	_, err := q.db.Exec(ctx, addNoteTags, arg.NoteID, arg.Column2)
+	return err
+}
+
+const deleteNoteTagsExcept = `-- name: DeleteNoteTagsExcept :exec
+DELETE FROM note_tags
+WHERE note_id = $1 AND NOT (tag = ANY($2::text[]))
+`
+
+type DeleteNoteTagsExceptParams struct {
+	NoteID  pgtype.UUID `db:"note_id" json:"note_id"`
+	Column2 []string    `db:"column_2" json:"column_2"`
+}
+
+func (q *Queries) DeleteNoteTagsExcept(ctx context.Context, arg *DeleteNoteTagsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteNoteTagsExcept, arg.NoteID, arg.Column2)
+	return err
+}
+
+const listNoteTags = `-- name: ListNoteTags :many
+SELECT tag
+FROM note_tags
+WHERE note_id = $1
+ORDER BY tag ASC
+`
+
+func (q *Queries) ListNoteTags(ctx context.Context, noteID pgtype.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, listNoteTags, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		items = append(items, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTagCounts = `-- name: ListTagCounts :many
+SELECT
+    nt.tag,
+    COUNT(*)::int AS note_count
+FROM note_tags nt
+JOIN notes n ON n.id = nt.note_id
+WHERE n.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR n.owner_id = $1)
+  AND (
+    n.status = 'Publish' OR n.owner_id = $2::uuid
+    OR EXISTS (SELECT 1 FROM note_collaborators nc WHERE nc.note_id = n.id AND nc.account_id = $2)
+  )
+  AND ($4::uuid IS NULL OR n.workspace_id = $4)
+GROUP BY nt.tag
+ORDER BY note_count DESC, nt.tag ASC
+LIMIT $3
+`
+
+type ListTagCountsParams struct {
+	Column1 pgtype.UUID `db:"column_1" json:"column_1"`
+	Column2 pgtype.UUID `db:"column_2" json:"column_2"`
+	Limit   int32       `db:"limit" json:"limit"`
+	Column4 pgtype.UUID `db:"column_4" json:"column_4"`
+}
+
+type ListTagCountsRow struct {
+	Tag       string `db:"tag" json:"tag"`
+	NoteCount int32  `db:"note_count" json:"note_count"`
+}
+
+// Counts notes per tag. $1 filters by owner, $2 limits the counted notes to those
+// the viewer may read (published, owned or shared; only published for a NULL
+// viewer), $4 to a workspace and $3 caps the tags, most used first. Trashed notes
+// are not counted.
+func (q *Queries) ListTagCounts(ctx context.Context, arg *ListTagCountsParams) ([]*ListTagCountsRow, error) {
+	rows, err := q.db.Query(ctx, listTagCounts,
+		arg.Column1,
+		arg.Column2,
+		arg.Limit,
+		arg.Column4,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTagCountsRow
+	for rows.Next() {
+		var i ListTagCountsRow
+		if err := rows.Scan(&i.Tag, &i.NoteCount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
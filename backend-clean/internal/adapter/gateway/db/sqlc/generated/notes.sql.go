@@ -12,16 +12,18 @@ import (
 )
 
 const createNote = `-- name: CreateNote :one
-INSERT INTO notes (title, template_id, owner_id, status)
-VALUES ($1, $2, $3, $4)
-RETURNING id, title, template_id, owner_id, status, created_at, updated_at
+INSERT INTO notes (title, template_id, owner_id, status, template_version, workspace_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, template_version, deleted_at, workspace_id
 `
 
 type CreateNoteParams struct {
-	Title      string      `db:"title" json:"title"`
-	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
-	OwnerID    pgtype.UUID `db:"owner_id" json:"owner_id"`
-	Status     string      `db:"status" json:"status"`
+	Title           string      `db:"title" json:"title"`
+	TemplateID      pgtype.UUID `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID `db:"owner_id" json:"owner_id"`
+	Status          string      `db:"status" json:"status"`
+	TemplateVersion int32       `db:"template_version" json:"template_version"`
+	WorkspaceID     pgtype.UUID `db:"workspace_id" json:"workspace_id"`
 }
 
 func (q *Queries) CreateNote(ctx context.Context, arg *CreateNoteParams) (*Note, error) {
@@ -30,6 +32,8 @@ func (q *Queries) CreateNote(ctx context.Context, arg *CreateNoteParams) (*Note,
 		arg.TemplateID,
 		arg.OwnerID,
 		arg.Status,
+		arg.TemplateVersion,
+		arg.WorkspaceID,
 	)
 	var i Note
 	err := row.Scan(
@@ -40,6 +44,10 @@ func (q *Queries) CreateNote(ctx context.Context, arg *CreateNoteParams) (*Note,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.TemplateVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
 	)
 	return &i, err
 }
@@ -73,6 +81,7 @@ DELETE FROM notes
 WHERE id = $1
 `
 
+// Purges the note for good; sections and revisions go with it.
 func (q *Queries) DeleteNote(ctx context.Context, id pgtype.UUID) error {
 	_, err := q.db.Exec(ctx, deleteNote, id)
 	return err
@@ -90,7 +99,7 @@ func (q *Queries) DeleteSectionsByNote(ctx context.Context, noteID pgtype.UUID)
 
 const getNoteByID = `-- name: GetNoteByID :one
 SELECT
-    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at,
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.version, n.template_version, n.deleted_at, n.workspace_id,
     t.name AS template_name,
     a.first_name,
     a.last_name,
@@ -98,25 +107,35 @@ SELECT
 FROM notes n
 JOIN templates t ON t.id = n.template_id
 JOIN accounts a ON a.id = n.owner_id
-WHERE n.id = $1
+WHERE n.id = $1 AND n.deleted_at IS NULL
+  AND ($2::uuid IS NULL OR n.workspace_id = $2)
 `
 
+type GetNoteByIDParams struct {
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Column2 pgtype.UUID `db:"column_2" json:"column_2"`
+}
+
 type GetNoteByIDRow struct {
-	ID             pgtype.UUID        `db:"id" json:"id"`
-	Title          string             `db:"title" json:"title"`
-	TemplateID     pgtype.UUID        `db:"template_id" json:"template_id"`
-	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
-	Status         string             `db:"status" json:"status"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-	TemplateName   string             `db:"template_name" json:"template_name"`
-	FirstName      string             `db:"first_name" json:"first_name"`
-	LastName       string             `db:"last_name" json:"last_name"`
-	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
-}
-
-func (q *Queries) GetNoteByID(ctx context.Context, id pgtype.UUID) (*GetNoteByIDRow, error) {
-	row := q.db.QueryRow(ctx, getNoteByID, id)
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status          string             `db:"status" json:"status"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version         int32              `db:"version" json:"version"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+	DeletedAt       pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID     pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	TemplateName    string             `db:"template_name" json:"template_name"`
+	FirstName       string             `db:"first_name" json:"first_name"`
+	LastName        string             `db:"last_name" json:"last_name"`
+	OwnerThumbnail  pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+}
+
+func (q *Queries) GetNoteByID(ctx context.Context, arg *GetNoteByIDParams) (*GetNoteByIDRow, error) {
+	row := q.db.QueryRow(ctx, getNoteByID, arg.ID, arg.Column2)
 	var i GetNoteByIDRow
 	err := row.Scan(
 		&i.ID,
@@ -126,6 +145,70 @@ func (q *Queries) GetNoteByID(ctx context.Context, id pgtype.UUID) (*GetNoteByID
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.TemplateVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+		&i.TemplateName,
+		&i.FirstName,
+		&i.LastName,
+		&i.OwnerThumbnail,
+	)
+	return &i, err
+}
+
+const getTrashedNoteByID = `-- name: GetTrashedNoteByID :one
+SELECT
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.version, n.template_version, n.deleted_at, n.workspace_id,
+    t.name AS template_name,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS owner_thumbnail
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+JOIN accounts a ON a.id = n.owner_id
+WHERE n.id = $1 AND n.deleted_at IS NOT NULL
+  AND ($2::uuid IS NULL OR n.workspace_id = $2)
+`
+
+type GetTrashedNoteByIDParams struct {
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Column2 pgtype.UUID `db:"column_2" json:"column_2"`
+}
+
+type GetTrashedNoteByIDRow struct {
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status          string             `db:"status" json:"status"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version         int32              `db:"version" json:"version"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+	DeletedAt       pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID     pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	TemplateName    string             `db:"template_name" json:"template_name"`
+	FirstName       string             `db:"first_name" json:"first_name"`
+	LastName        string             `db:"last_name" json:"last_name"`
+	OwnerThumbnail  pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+}
+
+func (q *Queries) GetTrashedNoteByID(ctx context.Context, arg *GetTrashedNoteByIDParams) (*GetTrashedNoteByIDRow, error) {
+	row := q.db.QueryRow(ctx, getTrashedNoteByID, arg.ID, arg.Column2)
+	var i GetTrashedNoteByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.TemplateID,
+		&i.OwnerID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.TemplateVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
 		&i.TemplateName,
 		&i.FirstName,
 		&i.LastName,
@@ -136,7 +219,7 @@ func (q *Queries) GetNoteByID(ctx context.Context, id pgtype.UUID) (*GetNoteByID
 
 const listNotes = `-- name: ListNotes :many
 SELECT
-    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at,
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.version, n.template_version, n.deleted_at, n.workspace_id,
     t.name AS template_name,
     a.first_name,
     a.last_name,
@@ -144,40 +227,94 @@ SELECT
 FROM notes n
 JOIN templates t ON t.id = n.template_id
 JOIN accounts a ON a.id = n.owner_id
-WHERE (NULLIF($1::text, '') IS NULL OR n.status = $1)
+WHERE n.deleted_at IS NULL
+  AND (NULLIF($1::text, '') IS NULL OR n.status = $1)
   AND ($2::uuid IS NULL OR n.template_id = $2)
   AND ($3::uuid IS NULL OR n.owner_id = $3)
-  AND (NULLIF($4::text, '') IS NULL OR n.title ILIKE '%' || $4 || '%')
-ORDER BY n.updated_at DESC
+  AND (
+    NULLIF($4::text, '') IS NULL
+    OR ($6::boolean AND n.title ILIKE $4)
+    OR ($7::boolean AND n.id IN (SELECT s.note_id FROM sections s WHERE s.content ILIKE $4))
+    OR ($8::boolean AND n.id IN (
+        SELECT s.note_id FROM sections s JOIN fields f ON f.id = s.field_id WHERE f.label ILIKE $4
+    ))
+  )
+  AND (
+    n.status = 'Publish' OR n.owner_id = $5::uuid
+    OR EXISTS (SELECT 1 FROM note_collaborators nc WHERE nc.note_id = n.id AND nc.account_id = $5)
+  )
+  AND (
+    COALESCE(cardinality($12::text[]), 0) = 0
+    OR n.id IN (
+        SELECT nt.note_id FROM note_tags nt
+        WHERE nt.tag = ANY($12::text[])
+        GROUP BY nt.note_id
+        HAVING NOT $13::boolean OR COUNT(*) = cardinality($12::text[])
+    )
+  )
+  AND ($14::uuid IS NULL OR n.workspace_id = $14)
+  AND ($9::timestamptz IS NULL OR (n.updated_at, n.id) < ($9::timestamptz, $10::uuid))
+ORDER BY n.updated_at DESC, n.id DESC
+LIMIT NULLIF($11::int, 0)
 `
 
 type ListNotesParams struct {
-	Column1 string      `db:"column_1" json:"column_1"`
-	Column2 pgtype.UUID `db:"column_2" json:"column_2"`
-	Column3 pgtype.UUID `db:"column_3" json:"column_3"`
-	Column4 string      `db:"column_4" json:"column_4"`
+	Column1  string             `db:"column_1" json:"column_1"`
+	Column2  pgtype.UUID        `db:"column_2" json:"column_2"`
+	Column3  pgtype.UUID        `db:"column_3" json:"column_3"`
+	Column4  string             `db:"column_4" json:"column_4"`
+	Column5  pgtype.UUID        `db:"column_5" json:"column_5"`
+	Column6  bool               `db:"column_6" json:"column_6"`
+	Column7  bool               `db:"column_7" json:"column_7"`
+	Column8  bool               `db:"column_8" json:"column_8"`
+	Column9  pgtype.Timestamptz `db:"column_9" json:"column_9"`
+	Column10 pgtype.UUID        `db:"column_10" json:"column_10"`
+	Column11 int32              `db:"column_11" json:"column_11"`
+	Column12 []string           `db:"column_12" json:"column_12"`
+	Column13 bool               `db:"column_13" json:"column_13"`
+	Column14 pgtype.UUID        `db:"column_14" json:"column_14"`
 }
 
 type ListNotesRow struct {
-	ID             pgtype.UUID        `db:"id" json:"id"`
-	Title          string             `db:"title" json:"title"`
-	TemplateID     pgtype.UUID        `db:"template_id" json:"template_id"`
-	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
-	Status         string             `db:"status" json:"status"`
-	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-	TemplateName   string             `db:"template_name" json:"template_name"`
-	FirstName      string             `db:"first_name" json:"first_name"`
-	LastName       string             `db:"last_name" json:"last_name"`
-	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status          string             `db:"status" json:"status"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version         int32              `db:"version" json:"version"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+	DeletedAt       pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID     pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	TemplateName    string             `db:"template_name" json:"template_name"`
+	FirstName       string             `db:"first_name" json:"first_name"`
+	LastName        string             `db:"last_name" json:"last_name"`
+	OwnerThumbnail  pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
 }
 
+// $4 is an escaped ILIKE pattern; $6-$8 select whether it matches the title,
+// section contents and field labels. $9/$10 are the keyset cursor and $11 the
+// page size (0 means no limit). $12 are tags, of which a note needs any or, when
+// $13 is true, all. $5 limits the notes to those the viewer may read: published,
+// owned or shared with the viewer; a NULL viewer only sees published notes. $14
+// limits them to a workspace. Trashed notes are never listed.
 func (q *Queries) ListNotes(ctx context.Context, arg *ListNotesParams) ([]*ListNotesRow, error) {
 	rows, err := q.db.Query(ctx, listNotes,
 		arg.Column1,
 		arg.Column2,
 		arg.Column3,
 		arg.Column4,
+		arg.Column5,
+		arg.Column6,
+		arg.Column7,
+		arg.Column8,
+		arg.Column9,
+		arg.Column10,
+		arg.Column11,
+		arg.Column12,
+		arg.Column13,
+		arg.Column14,
 	)
 	if err != nil {
 		return nil, err
@@ -194,6 +331,10 @@ func (q *Queries) ListNotes(ctx context.Context, arg *ListNotesParams) ([]*ListN
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.TemplateVersion,
+			&i.DeletedAt,
+			&i.WorkspaceID,
 			&i.TemplateName,
 			&i.FirstName,
 			&i.LastName,
@@ -214,7 +355,9 @@ SELECT
     s.id, s.note_id, s.field_id, s.content,
     f.label,
     f."order",
-    f.is_required
+    f.is_required,
+    f.type,
+    f.options
 FROM sections s
 JOIN fields f ON f.id = s.field_id
 WHERE s.note_id = $1
@@ -229,6 +372,8 @@ type ListSectionsByNoteRow struct {
 	Label      string      `db:"label" json:"label"`
 	Order      int32       `db:"order" json:"order"`
 	IsRequired bool        `db:"is_required" json:"is_required"`
+	Type       string      `db:"type" json:"type"`
+	Options    []string    `db:"options" json:"options"`
 }
 
 func (q *Queries) ListSectionsByNote(ctx context.Context, noteID pgtype.UUID) ([]*ListSectionsByNoteRow, error) {
@@ -248,6 +393,100 @@ func (q *Queries) ListSectionsByNote(ctx context.Context, noteID pgtype.UUID) ([
 			&i.Label,
 			&i.Order,
 			&i.IsRequired,
+			&i.Type,
+			&i.Options,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedNotes = `-- name: ListTrashedNotes :many
+SELECT
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.version, n.template_version, n.deleted_at, n.workspace_id,
+    t.name AS template_name,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS owner_thumbnail
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+JOIN accounts a ON a.id = n.owner_id
+WHERE n.deleted_at IS NOT NULL
+  AND ($1::uuid IS NULL OR n.owner_id = $1)
+  AND ($2::timestamptz IS NULL OR n.deleted_at < $2)
+  AND ($6::uuid IS NULL OR n.workspace_id = $6)
+  AND ($3::timestamptz IS NULL OR (n.deleted_at, n.id) < ($3::timestamptz, $4::uuid))
+ORDER BY n.deleted_at DESC, n.id DESC
+LIMIT $5
+`
+
+type ListTrashedNotesParams struct {
+	Column1 pgtype.UUID        `db:"column_1" json:"column_1"`
+	Column2 pgtype.Timestamptz `db:"column_2" json:"column_2"`
+	Column3 pgtype.Timestamptz `db:"column_3" json:"column_3"`
+	Column4 pgtype.UUID        `db:"column_4" json:"column_4"`
+	Limit   int32              `db:"limit" json:"limit"`
+	Column6 pgtype.UUID        `db:"column_6" json:"column_6"`
+}
+
+type ListTrashedNotesRow struct {
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status          string             `db:"status" json:"status"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version         int32              `db:"version" json:"version"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+	DeletedAt       pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID     pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	TemplateName    string             `db:"template_name" json:"template_name"`
+	FirstName       string             `db:"first_name" json:"first_name"`
+	LastName        string             `db:"last_name" json:"last_name"`
+	OwnerThumbnail  pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+}
+
+// Most recently trashed first. $1 limits the notes to an owner and $2 to notes
+// trashed before that time (both optional); $3/$4 are the (deleted_at, id) keyset
+// cursor and $5 the page size. $6 limits the notes to a workspace.
+func (q *Queries) ListTrashedNotes(ctx context.Context, arg *ListTrashedNotesParams) ([]*ListTrashedNotesRow, error) {
+	rows, err := q.db.Query(ctx, listTrashedNotes,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		arg.Limit,
+		arg.Column6,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTrashedNotesRow
+	for rows.Next() {
+		var i ListTrashedNotesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.TemplateID,
+			&i.OwnerID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.TemplateVersion,
+			&i.DeletedAt,
+			&i.WorkspaceID,
+			&i.TemplateName,
+			&i.FirstName,
+			&i.LastName,
+			&i.OwnerThumbnail,
 		); err != nil {
 			return nil, err
 		}
@@ -259,22 +498,205 @@ func (q *Queries) ListSectionsByNote(ctx context.Context, noteID pgtype.UUID) ([
 	return items, nil
 }
 
+const restoreNote = `-- name: RestoreNote :execrows
+UPDATE notes
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreNote(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreNote, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const searchNotes = `-- name: SearchNotes :many
+SELECT
+    n.id, n.title, n.template_id, n.owner_id, n.status, n.created_at, n.updated_at, n.version, n.template_version, n.deleted_at, n.workspace_id,
+    t.name AS template_name,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS owner_thumbnail,
+    m.matched_content::text AS matched_content,
+    m.matched_label::text AS matched_label,
+    (
+        (CASE WHEN $6::boolean AND n.title ILIKE $4::text THEN 3 ELSE 0 END)
+        + 2 * m.content_hits
+        + m.label_hits
+        + similarity(n.title, $9::text)
+    )::float8 AS rank
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+JOIN accounts a ON a.id = n.owner_id
+CROSS JOIN LATERAL (
+    SELECT
+        COUNT(*) FILTER (WHERE $7::boolean AND s.content ILIKE $4) AS content_hits,
+        COUNT(*) FILTER (WHERE $8::boolean AND f.label ILIKE $4) AS label_hits,
+        COALESCE((ARRAY_AGG(s.content ORDER BY f."order") FILTER (WHERE $7::boolean AND s.content ILIKE $4))[1], '') AS matched_content,
+        COALESCE((ARRAY_AGG(f.label ORDER BY f."order") FILTER (WHERE $8::boolean AND f.label ILIKE $4))[1], '') AS matched_label
+    FROM sections s
+    JOIN fields f ON f.id = s.field_id
+    WHERE s.note_id = n.id
+) m
+WHERE n.deleted_at IS NULL
+  AND (NULLIF($1::text, '') IS NULL OR n.status = $1)
+  AND ($2::uuid IS NULL OR n.template_id = $2)
+  AND ($3::uuid IS NULL OR n.owner_id = $3)
+  AND (
+    ($6::boolean AND n.title ILIKE $4)
+    OR ($7::boolean AND n.id IN (SELECT s.note_id FROM sections s WHERE s.content ILIKE $4))
+    OR ($8::boolean AND n.id IN (
+        SELECT s.note_id FROM sections s JOIN fields f ON f.id = s.field_id WHERE f.label ILIKE $4
+    ))
+  )
+  AND (
+    n.status = 'Publish' OR n.owner_id = $5::uuid
+    OR EXISTS (SELECT 1 FROM note_collaborators nc WHERE nc.note_id = n.id AND nc.account_id = $5)
+  )
+  AND (
+    COALESCE(cardinality($11::text[]), 0) = 0
+    OR n.id IN (
+        SELECT nt.note_id FROM note_tags nt
+        WHERE nt.tag = ANY($11::text[])
+        GROUP BY nt.note_id
+        HAVING NOT $12::boolean OR COUNT(*) = cardinality($11::text[])
+    )
+  )
+  AND ($13::uuid IS NULL OR n.workspace_id = $13)
+ORDER BY rank DESC, n.updated_at DESC
+LIMIT $10
+`
+
+type SearchNotesParams struct {
+	Column1  string      `db:"column_1" json:"column_1"`
+	Column2  pgtype.UUID `db:"column_2" json:"column_2"`
+	Column3  pgtype.UUID `db:"column_3" json:"column_3"`
+	Column4  string      `db:"column_4" json:"column_4"`
+	Column5  pgtype.UUID `db:"column_5" json:"column_5"`
+	Column6  bool        `db:"column_6" json:"column_6"`
+	Column7  bool        `db:"column_7" json:"column_7"`
+	Column8  bool        `db:"column_8" json:"column_8"`
+	Column9  string      `db:"column_9" json:"column_9"`
+	Limit    int32       `db:"limit" json:"limit"`
+	Column11 []string    `db:"column_11" json:"column_11"`
+	Column12 bool        `db:"column_12" json:"column_12"`
+	Column13 pgtype.UUID `db:"column_13" json:"column_13"`
+}
+
+type SearchNotesRow struct {
+	ID              pgtype.UUID        `db:"id" json:"id"`
+	Title           string             `db:"title" json:"title"`
+	TemplateID      pgtype.UUID        `db:"template_id" json:"template_id"`
+	OwnerID         pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	Status          string             `db:"status" json:"status"`
+	CreatedAt       pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version         int32              `db:"version" json:"version"`
+	TemplateVersion int32              `db:"template_version" json:"template_version"`
+	DeletedAt       pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID     pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	TemplateName    string             `db:"template_name" json:"template_name"`
+	FirstName       string             `db:"first_name" json:"first_name"`
+	LastName        string             `db:"last_name" json:"last_name"`
+	OwnerThumbnail  pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	MatchedContent  string             `db:"matched_content" json:"matched_content"`
+	MatchedLabel    string             `db:"matched_label" json:"matched_label"`
+	Rank            float64            `db:"rank" json:"rank"`
+}
+
+// Same filters as ListNotes, but a keyword is required and results are ranked:
+// a title hit weighs 3, each matching section 2 and each matching field label 1,
+// with trigram similarity of the title ($9 is the raw keyword) as a tie-breaker.
+// The first matching content and label (in field order) are returned for snippets.
+// $11/$12 filter by tags as $12/$13 of ListNotes do, and $13 by workspace.
+func (q *Queries) SearchNotes(ctx context.Context, arg *SearchNotesParams) ([]*SearchNotesRow, error) {
+	rows, err := q.db.Query(ctx, searchNotes,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		arg.Column5,
+		arg.Column6,
+		arg.Column7,
+		arg.Column8,
+		arg.Column9,
+		arg.Limit,
+		arg.Column11,
+		arg.Column12,
+		arg.Column13,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SearchNotesRow
+	for rows.Next() {
+		var i SearchNotesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.TemplateID,
+			&i.OwnerID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.TemplateVersion,
+			&i.DeletedAt,
+			&i.WorkspaceID,
+			&i.TemplateName,
+			&i.FirstName,
+			&i.LastName,
+			&i.OwnerThumbnail,
+			&i.MatchedContent,
+			&i.MatchedLabel,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trashNote = `-- name: TrashNote :execrows
+UPDATE notes
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) TrashNote(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, trashNote, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateNote = `-- name: UpdateNote :one
 UPDATE notes
 SET
     title = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, title, template_id, owner_id, status, created_at, updated_at
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, template_version, deleted_at, workspace_id
 `
 
 type UpdateNoteParams struct {
-	ID    pgtype.UUID `db:"id" json:"id"`
-	Title string      `db:"title" json:"title"`
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Title   string      `db:"title" json:"title"`
+	Version int32       `db:"version" json:"version"`
 }
 
+// Only updates when the stored version still matches $3; no row means a concurrent update won.
 func (q *Queries) UpdateNote(ctx context.Context, arg *UpdateNoteParams) (*Note, error) {
-	row := q.db.QueryRow(ctx, updateNote, arg.ID, arg.Title)
+	row := q.db.QueryRow(ctx, updateNote, arg.ID, arg.Title, arg.Version)
 	var i Note
 	err := row.Scan(
 		&i.ID,
@@ -284,6 +706,10 @@ func (q *Queries) UpdateNote(ctx context.Context, arg *UpdateNoteParams) (*Note,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.TemplateVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
 	)
 	return &i, err
 }
@@ -292,9 +718,10 @@ const updateNoteStatus = `-- name: UpdateNoteStatus :one
 UPDATE notes
 SET
     status = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, title, template_id, owner_id, status, created_at, updated_at
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, template_version, deleted_at, workspace_id
 `
 
 type UpdateNoteStatusParams struct {
@@ -313,6 +740,46 @@ func (q *Queries) UpdateNoteStatus(ctx context.Context, arg *UpdateNoteStatusPar
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.TemplateVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+	)
+	return &i, err
+}
+
+const updateNoteTemplateVersion = `-- name: UpdateNoteTemplateVersion :one
+UPDATE notes
+SET
+    template_version = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING id, title, template_id, owner_id, status, created_at, updated_at, version, template_version, deleted_at, workspace_id
+`
+
+type UpdateNoteTemplateVersionParams struct {
+	ID              pgtype.UUID `db:"id" json:"id"`
+	TemplateVersion int32       `db:"template_version" json:"template_version"`
+	Version         int32       `db:"version" json:"version"`
+}
+
+// Pins the note to another template version; conditional on the version like UpdateNote.
+func (q *Queries) UpdateNoteTemplateVersion(ctx context.Context, arg *UpdateNoteTemplateVersionParams) (*Note, error) {
+	row := q.db.QueryRow(ctx, updateNoteTemplateVersion, arg.ID, arg.TemplateVersion, arg.Version)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.TemplateID,
+		&i.OwnerID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.TemplateVersion,
+		&i.DeletedAt,
+		&i.WorkspaceID,
 	)
 	return &i, err
 }
@@ -0,0 +1,137 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: outbox.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimPendingOutboxEvents = `-- name: ClaimPendingOutboxEvents :many
+SELECT o.id, o.event_id, o.event_type, o.aggregate_type, o.aggregate_id, o.actor_id, o.payload, o.occurred_at, o.attempts, o.last_error, o.next_attempt_at, o.delivered_at
+FROM outbox o
+WHERE o.delivered_at IS NULL
+  AND o.next_attempt_at <= NOW()
+  AND NOT EXISTS (
+    SELECT 1
+    FROM outbox p
+    WHERE p.aggregate_type = o.aggregate_type
+      AND p.aggregate_id = o.aggregate_id
+      AND p.delivered_at IS NULL
+      AND p.id < o.id
+  )
+ORDER BY o.id
+LIMIT $1
+FOR UPDATE SKIP LOCKED
+`
+
+// Only the oldest undelivered event of each aggregate is claimable, so a later event
+// is never delivered before an earlier one of the same aggregate, even across relays.
+func (q *Queries) ClaimPendingOutboxEvents(ctx context.Context, limit int32) ([]*Outbox, error) {
+	rows, err := q.db.Query(ctx, claimPendingOutboxEvents, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Outbox
+	for rows.Next() {
+		var i Outbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.EventType,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.ActorID,
+			&i.Payload,
+			&i.OccurredAt,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertOutboxEvent = `-- name: InsertOutboxEvent :one
+INSERT INTO outbox (event_type, aggregate_type, aggregate_id, actor_id, payload)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, event_id, event_type, aggregate_type, aggregate_id, actor_id, payload, occurred_at, attempts, last_error, next_attempt_at, delivered_at
+`
+
+type InsertOutboxEventParams struct {
+	EventType     string `db:"event_type" json:"event_type"`
+	AggregateType string `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   string `db:"aggregate_id" json:"aggregate_id"`
+	ActorID       string `db:"actor_id" json:"actor_id"`
+	Payload       []byte `db:"payload" json:"payload"`
+}
+
+func (q *Queries) InsertOutboxEvent(ctx context.Context, arg *InsertOutboxEventParams) (*Outbox, error) {
+	row := q.db.QueryRow(ctx, insertOutboxEvent,
+		arg.EventType,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.ActorID,
+		arg.Payload,
+	)
+	var i Outbox
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.EventType,
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.ActorID,
+		&i.Payload,
+		&i.OccurredAt,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+	)
+	return &i, err
+}
+
+const markOutboxEventDelivered = `-- name: MarkOutboxEventDelivered :exec
+UPDATE outbox
+SET delivered_at = NOW(),
+    attempts = attempts + 1,
+    last_error = ''
+WHERE id = $1
+`
+
+func (q *Queries) MarkOutboxEventDelivered(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markOutboxEventDelivered, id)
+	return err
+}
+
+const markOutboxEventFailed = `-- name: MarkOutboxEventFailed :exec
+UPDATE outbox
+SET attempts = attempts + 1,
+    last_error = $2,
+    next_attempt_at = $3
+WHERE id = $1
+`
+
+type MarkOutboxEventFailedParams struct {
+	ID            int64              `db:"id" json:"id"`
+	LastError     string             `db:"last_error" json:"last_error"`
+	NextAttemptAt pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+}
+
+func (q *Queries) MarkOutboxEventFailed(ctx context.Context, arg *MarkOutboxEventFailedParams) error {
+	_, err := q.db.Exec(ctx, markOutboxEventFailed, arg.ID, arg.LastError, arg.NextAttemptAt)
+	return err
+}
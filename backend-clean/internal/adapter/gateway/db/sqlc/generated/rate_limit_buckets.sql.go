@@ -0,0 +1,101 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: rate_limit_buckets.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteFullRateLimitBuckets = `-- name: DeleteFullRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets
+WHERE full_at < $1
+`
+
+func (q *Queries) DeleteFullRateLimitBuckets(ctx context.Context, fullAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFullRateLimitBuckets, fullAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getRateLimitBucket = `-- name: GetRateLimitBucket :one
+SELECT key, tokens, version, updated_at, full_at
+FROM rate_limit_buckets
+WHERE key = $1
+`
+
+func (q *Queries) GetRateLimitBucket(ctx context.Context, key string) (*RateLimitBucket, error) {
+	row := q.db.QueryRow(ctx, getRateLimitBucket, key)
+	var i RateLimitBucket
+	err := row.Scan(
+		&i.Key,
+		&i.Tokens,
+		&i.Version,
+		&i.UpdatedAt,
+		&i.FullAt,
+	)
+	return &i, err
+}
+
+const insertRateLimitBucket = `-- name: InsertRateLimitBucket :execrows
+INSERT INTO rate_limit_buckets (key, tokens, updated_at, full_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (key) DO NOTHING
+`
+
+type InsertRateLimitBucketParams struct {
+	Key       string             `db:"key" json:"key"`
+	Tokens    float64            `db:"tokens" json:"tokens"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	FullAt    pgtype.Timestamptz `db:"full_at" json:"full_at"`
+}
+
+func (q *Queries) InsertRateLimitBucket(ctx context.Context, arg *InsertRateLimitBucketParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertRateLimitBucket,
+		arg.Key,
+		arg.Tokens,
+		arg.UpdatedAt,
+		arg.FullAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateRateLimitBucket = `-- name: UpdateRateLimitBucket :execrows
+UPDATE rate_limit_buckets
+SET tokens = $2,
+    updated_at = $3,
+    full_at = $4,
+    version = version + 1
+WHERE key = $1 AND version = $5
+`
+
+type UpdateRateLimitBucketParams struct {
+	Key       string             `db:"key" json:"key"`
+	Tokens    float64            `db:"tokens" json:"tokens"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	FullAt    pgtype.Timestamptz `db:"full_at" json:"full_at"`
+	Version   int64              `db:"version" json:"version"`
+}
+
+func (q *Queries) UpdateRateLimitBucket(ctx context.Context, arg *UpdateRateLimitBucketParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRateLimitBucket,
+		arg.Key,
+		arg.Tokens,
+		arg.UpdatedAt,
+		arg.FullAt,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -17,6 +17,7 @@ SELECT EXISTS (
 ) AS is_used
 `
 
+// Notes in the trash count as uses: they still need the template to be restored.
 func (q *Queries) CheckTemplateInUse(ctx context.Context, templateID pgtype.UUID) (bool, error) {
 	row := q.db.QueryRow(ctx, checkTemplateInUse, templateID)
 	var is_used bool
@@ -25,9 +26,9 @@ func (q *Queries) CheckTemplateInUse(ctx context.Context, templateID pgtype.UUID
 }
 
 const createField = `-- name: CreateField :one
-INSERT INTO fields (template_id, label, "order", is_required)
-VALUES ($1, $2, $3, $4)
-RETURNING id, template_id, label, "order", is_required
+INSERT INTO fields (template_id, label, "order", is_required, version, type, options)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, template_id, label, "order", is_required, version, type, options
 `
 
 type CreateFieldParams struct {
@@ -35,6 +36,9 @@ type CreateFieldParams struct {
 	Label      string      `db:"label" json:"label"`
 	Order      int32       `db:"order" json:"order"`
 	IsRequired bool        `db:"is_required" json:"is_required"`
+	Version    int32       `db:"version" json:"version"`
+	Type       string      `db:"type" json:"type"`
+	Options    []string    `db:"options" json:"options"`
 }
 
 func (q *Queries) CreateField(ctx context.Context, arg *CreateFieldParams) (*Field, error) {
@@ -43,6 +47,9 @@ func (q *Queries) CreateField(ctx context.Context, arg *CreateFieldParams) (*Fie
 		arg.Label,
 		arg.Order,
 		arg.IsRequired,
+		arg.Version,
+		arg.Type,
+		arg.Options,
 	)
 	var i Field
 	err := row.Scan(
@@ -51,29 +58,51 @@ func (q *Queries) CreateField(ctx context.Context, arg *CreateFieldParams) (*Fie
 		&i.Label,
 		&i.Order,
 		&i.IsRequired,
+		&i.Version,
+		&i.Type,
+		&i.Options,
 	)
 	return &i, err
 }
 
 const createTemplate = `-- name: CreateTemplate :one
-INSERT INTO templates (name, owner_id)
-VALUES ($1, $2)
-RETURNING id, name, owner_id, updated_at
+INSERT INTO templates (name, owner_id, workspace_id)
+VALUES (
+    $1,
+    $2,
+    COALESCE(
+        $3::uuid,
+        (
+            SELECT m.workspace_id
+            FROM workspace_members m
+            WHERE m.account_id = $2
+            ORDER BY m.created_at ASC, m.workspace_id ASC
+            LIMIT 1
+        )
+    )
+)
+RETURNING id, name, owner_id, updated_at, version, deleted_at, workspace_id
 `
 
 type CreateTemplateParams struct {
 	Name    string      `db:"name" json:"name"`
 	OwnerID pgtype.UUID `db:"owner_id" json:"owner_id"`
+	Column3 pgtype.UUID `db:"column_3" json:"column_3"`
 }
 
+// Without a workspace ($3) the template goes to the owner's default workspace,
+// the one the owner joined first.
 func (q *Queries) CreateTemplate(ctx context.Context, arg *CreateTemplateParams) (*Template, error) {
-	row := q.db.QueryRow(ctx, createTemplate, arg.Name, arg.OwnerID)
+	row := q.db.QueryRow(ctx, createTemplate, arg.Name, arg.OwnerID, arg.Column3)
 	var i Template
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.OwnerID,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+		&i.WorkspaceID,
 	)
 	return &i, err
 }
@@ -88,77 +117,167 @@ func (q *Queries) DeleteField(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
-const deleteFieldsByTemplate = `-- name: DeleteFieldsByTemplate :exec
-DELETE FROM fields
-WHERE template_id = $1
-`
-
-func (q *Queries) DeleteFieldsByTemplate(ctx context.Context, templateID pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteFieldsByTemplate, templateID)
-	return err
-}
-
 const deleteTemplate = `-- name: DeleteTemplate :exec
 DELETE FROM templates
 WHERE id = $1
 `
 
+// Purges the template for good; its fields go with it.
 func (q *Queries) DeleteTemplate(ctx context.Context, id pgtype.UUID) error {
 	_, err := q.db.Exec(ctx, deleteTemplate, id)
 	return err
 }
 
+const deleteUnpinnedFieldVersions = `-- name: DeleteUnpinnedFieldVersions :exec
+DELETE FROM fields f
+WHERE f.template_id = $1
+  AND f.version < (SELECT MAX(latest.version) FROM fields latest WHERE latest.template_id = f.template_id)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM notes n
+    WHERE n.template_id = f.template_id AND n.template_version = f.version
+  )
+`
+
+// Removes earlier field versions that no note is pinned to any more.
+func (q *Queries) DeleteUnpinnedFieldVersions(ctx context.Context, templateID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUnpinnedFieldVersions, templateID)
+	return err
+}
+
 const getTemplateByID = `-- name: GetTemplateByID :one
 SELECT
-    t.id, t.name, t.owner_id, t.updated_at,
+    t.id, t.name, t.owner_id, t.updated_at, t.version, t.deleted_at, t.workspace_id,
     a.first_name AS owner_first_name,
     a.last_name AS owner_last_name,
     a.thumbnail AS owner_thumbnail,
     EXISTS (
         SELECT 1
         FROM notes n
-        WHERE n.template_id = t.id
-        LIMIT 1
-    ) AS is_used
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
+    ) AS is_used,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NOT NULL
+    ) AS is_used_in_trash
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
-WHERE t.id = $1
+WHERE t.id = $1 AND t.deleted_at IS NULL
+  AND ($2::uuid IS NULL OR t.workspace_id = $2)
 `
 
+type GetTemplateByIDParams struct {
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Column2 pgtype.UUID `db:"column_2" json:"column_2"`
+}
+
 type GetTemplateByIDRow struct {
 	ID             pgtype.UUID        `db:"id" json:"id"`
 	Name           string             `db:"name" json:"name"`
 	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
 	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version        int32              `db:"version" json:"version"`
+	DeletedAt      pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID    pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
 	OwnerFirstName string             `db:"owner_first_name" json:"owner_first_name"`
 	OwnerLastName  string             `db:"owner_last_name" json:"owner_last_name"`
 	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
 	IsUsed         bool               `db:"is_used" json:"is_used"`
+	IsUsedInTrash  bool               `db:"is_used_in_trash" json:"is_used_in_trash"`
 }
 
-func (q *Queries) GetTemplateByID(ctx context.Context, id pgtype.UUID) (*GetTemplateByIDRow, error) {
-	row := q.db.QueryRow(ctx, getTemplateByID, id)
+func (q *Queries) GetTemplateByID(ctx context.Context, arg *GetTemplateByIDParams) (*GetTemplateByIDRow, error) {
+	row := q.db.QueryRow(ctx, getTemplateByID, arg.ID, arg.Column2)
 	var i GetTemplateByIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.OwnerID,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+		&i.WorkspaceID,
 		&i.OwnerFirstName,
 		&i.OwnerLastName,
 		&i.OwnerThumbnail,
 		&i.IsUsed,
+		&i.IsUsedInTrash,
+	)
+	return &i, err
+}
+
+const getTrashedTemplateByID = `-- name: GetTrashedTemplateByID :one
+SELECT
+    t.id, t.name, t.owner_id, t.updated_at, t.version, t.deleted_at, t.workspace_id,
+    a.first_name AS owner_first_name,
+    a.last_name AS owner_last_name,
+    a.thumbnail AS owner_thumbnail,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
+    ) AS is_used,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NOT NULL
+    ) AS is_used_in_trash
+FROM templates t
+JOIN accounts a ON a.id = t.owner_id
+WHERE t.id = $1 AND t.deleted_at IS NOT NULL
+  AND ($2::uuid IS NULL OR t.workspace_id = $2)
+`
+
+type GetTrashedTemplateByIDParams struct {
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Column2 pgtype.UUID `db:"column_2" json:"column_2"`
+}
+
+type GetTrashedTemplateByIDRow struct {
+	ID             pgtype.UUID        `db:"id" json:"id"`
+	Name           string             `db:"name" json:"name"`
+	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version        int32              `db:"version" json:"version"`
+	DeletedAt      pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID    pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	OwnerFirstName string             `db:"owner_first_name" json:"owner_first_name"`
+	OwnerLastName  string             `db:"owner_last_name" json:"owner_last_name"`
+	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	IsUsed         bool               `db:"is_used" json:"is_used"`
+	IsUsedInTrash  bool               `db:"is_used_in_trash" json:"is_used_in_trash"`
+}
+
+func (q *Queries) GetTrashedTemplateByID(ctx context.Context, arg *GetTrashedTemplateByIDParams) (*GetTrashedTemplateByIDRow, error) {
+	row := q.db.QueryRow(ctx, getTrashedTemplateByID, arg.ID, arg.Column2)
+	var i GetTrashedTemplateByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OwnerID,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+		&i.OwnerFirstName,
+		&i.OwnerLastName,
+		&i.OwnerThumbnail,
+		&i.IsUsed,
+		&i.IsUsedInTrash,
 	)
 	return &i, err
 }
 
 const listFieldsByTemplate = `-- name: ListFieldsByTemplate :many
-SELECT id, template_id, label, "order", is_required
-FROM fields
-WHERE template_id = $1
-ORDER BY "order" ASC
+SELECT f.id, f.template_id, f.label, f."order", f.is_required, f.version, f.type, f.options
+FROM fields f
+WHERE f.template_id = $1
+  AND f.version = (SELECT MAX(latest.version) FROM fields latest WHERE latest.template_id = f.template_id)
+ORDER BY f."order" ASC
 `
 
+// Returns the fields of the latest field version.
 func (q *Queries) ListFieldsByTemplate(ctx context.Context, templateID pgtype.UUID) ([]*Field, error) {
 	rows, err := q.db.Query(ctx, listFieldsByTemplate, templateID)
 	if err != nil {
@@ -174,6 +293,50 @@ func (q *Queries) ListFieldsByTemplate(ctx context.Context, templateID pgtype.UU
 			&i.Label,
 			&i.Order,
 			&i.IsRequired,
+			&i.Version,
+			&i.Type,
+			&i.Options,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFieldsByTemplateVersion = `-- name: ListFieldsByTemplateVersion :many
+SELECT id, template_id, label, "order", is_required, version, type, options
+FROM fields
+WHERE template_id = $1 AND version = $2
+ORDER BY "order" ASC
+`
+
+type ListFieldsByTemplateVersionParams struct {
+	TemplateID pgtype.UUID `db:"template_id" json:"template_id"`
+	Version    int32       `db:"version" json:"version"`
+}
+
+func (q *Queries) ListFieldsByTemplateVersion(ctx context.Context, arg *ListFieldsByTemplateVersionParams) ([]*Field, error) {
+	rows, err := q.db.Query(ctx, listFieldsByTemplateVersion, arg.TemplateID, arg.Version)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Field
+	for rows.Next() {
+		var i Field
+		if err := rows.Scan(
+			&i.ID,
+			&i.TemplateID,
+			&i.Label,
+			&i.Order,
+			&i.IsRequired,
+			&i.Version,
+			&i.Type,
+			&i.Options,
 		); err != nil {
 			return nil, err
 		}
@@ -187,26 +350,38 @@ func (q *Queries) ListFieldsByTemplate(ctx context.Context, templateID pgtype.UU
 
 const listTemplates = `-- name: ListTemplates :many
 SELECT
-    t.id, t.name, t.owner_id, t.updated_at,
+    t.id, t.name, t.owner_id, t.updated_at, t.version, t.deleted_at, t.workspace_id,
     a.first_name AS owner_first_name,
     a.last_name AS owner_last_name,
     a.thumbnail AS owner_thumbnail,
     EXISTS (
         SELECT 1
         FROM notes n
-        WHERE n.template_id = t.id
-        LIMIT 1
-    ) AS is_used
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
+    ) AS is_used,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NOT NULL
+    ) AS is_used_in_trash
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
-WHERE ($1::uuid IS NULL OR t.owner_id = $1)
+WHERE t.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR t.owner_id = $1)
   AND ($2::text IS NULL OR t.name ILIKE '%' || $2 || '%')
-ORDER BY t.updated_at DESC
+  AND ($6::uuid IS NULL OR t.workspace_id = $6)
+  AND ($3::timestamptz IS NULL OR (t.updated_at, t.id) < ($3::timestamptz, $4::uuid))
+ORDER BY t.updated_at DESC, t.id DESC
+LIMIT NULLIF($5::int, 0)
 `
 
 type ListTemplatesParams struct {
-	Column1 pgtype.UUID `db:"column_1" json:"column_1"`
-	Column2 string      `db:"column_2" json:"column_2"`
+	Column1 pgtype.UUID        `db:"column_1" json:"column_1"`
+	Column2 string             `db:"column_2" json:"column_2"`
+	Column3 pgtype.Timestamptz `db:"column_3" json:"column_3"`
+	Column4 pgtype.UUID        `db:"column_4" json:"column_4"`
+	Column5 int32              `db:"column_5" json:"column_5"`
+	Column6 pgtype.UUID        `db:"column_6" json:"column_6"`
 }
 
 type ListTemplatesRow struct {
@@ -214,14 +389,27 @@ type ListTemplatesRow struct {
 	Name           string             `db:"name" json:"name"`
 	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
 	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version        int32              `db:"version" json:"version"`
+	DeletedAt      pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID    pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
 	OwnerFirstName string             `db:"owner_first_name" json:"owner_first_name"`
 	OwnerLastName  string             `db:"owner_last_name" json:"owner_last_name"`
 	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
 	IsUsed         bool               `db:"is_used" json:"is_used"`
+	IsUsedInTrash  bool               `db:"is_used_in_trash" json:"is_used_in_trash"`
 }
 
+// $3/$4 are the keyset cursor and $5 the page size (0 means no limit).
+// $6 limits the templates to a workspace. Trashed templates are never listed.
 func (q *Queries) ListTemplates(ctx context.Context, arg *ListTemplatesParams) ([]*ListTemplatesRow, error) {
-	rows, err := q.db.Query(ctx, listTemplates, arg.Column1, arg.Column2)
+	rows, err := q.db.Query(ctx, listTemplates,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		arg.Column5,
+		arg.Column6,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -234,10 +422,106 @@ func (q *Queries) ListTemplates(ctx context.Context, arg *ListTemplatesParams) (
 			&i.Name,
 			&i.OwnerID,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.DeletedAt,
+			&i.WorkspaceID,
+			&i.OwnerFirstName,
+			&i.OwnerLastName,
+			&i.OwnerThumbnail,
+			&i.IsUsed,
+			&i.IsUsedInTrash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedTemplates = `-- name: ListTrashedTemplates :many
+SELECT
+    t.id, t.name, t.owner_id, t.updated_at, t.version, t.deleted_at, t.workspace_id,
+    a.first_name AS owner_first_name,
+    a.last_name AS owner_last_name,
+    a.thumbnail AS owner_thumbnail,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
+    ) AS is_used,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NOT NULL
+    ) AS is_used_in_trash
+FROM templates t
+JOIN accounts a ON a.id = t.owner_id
+WHERE t.deleted_at IS NOT NULL
+  AND ($1::uuid IS NULL OR t.owner_id = $1)
+  AND ($2::timestamptz IS NULL OR t.deleted_at < $2)
+  AND ($6::uuid IS NULL OR t.workspace_id = $6)
+  AND ($3::timestamptz IS NULL OR (t.deleted_at, t.id) < ($3::timestamptz, $4::uuid))
+ORDER BY t.deleted_at DESC, t.id DESC
+LIMIT $5
+`
+
+type ListTrashedTemplatesParams struct {
+	Column1 pgtype.UUID        `db:"column_1" json:"column_1"`
+	Column2 pgtype.Timestamptz `db:"column_2" json:"column_2"`
+	Column3 pgtype.Timestamptz `db:"column_3" json:"column_3"`
+	Column4 pgtype.UUID        `db:"column_4" json:"column_4"`
+	Limit   int32              `db:"limit" json:"limit"`
+	Column6 pgtype.UUID        `db:"column_6" json:"column_6"`
+}
+
+type ListTrashedTemplatesRow struct {
+	ID             pgtype.UUID        `db:"id" json:"id"`
+	Name           string             `db:"name" json:"name"`
+	OwnerID        pgtype.UUID        `db:"owner_id" json:"owner_id"`
+	UpdatedAt      pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Version        int32              `db:"version" json:"version"`
+	DeletedAt      pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	WorkspaceID    pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	OwnerFirstName string             `db:"owner_first_name" json:"owner_first_name"`
+	OwnerLastName  string             `db:"owner_last_name" json:"owner_last_name"`
+	OwnerThumbnail pgtype.Text        `db:"owner_thumbnail" json:"owner_thumbnail"`
+	IsUsed         bool               `db:"is_used" json:"is_used"`
+	IsUsedInTrash  bool               `db:"is_used_in_trash" json:"is_used_in_trash"`
+}
+
+// Most recently trashed first; parameters as in ListTrashedNotes.
+func (q *Queries) ListTrashedTemplates(ctx context.Context, arg *ListTrashedTemplatesParams) ([]*ListTrashedTemplatesRow, error) {
+	rows, err := q.db.Query(ctx, listTrashedTemplates,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		arg.Limit,
+		arg.Column6,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTrashedTemplatesRow
+	for rows.Next() {
+		var i ListTrashedTemplatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OwnerID,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.DeletedAt,
+			&i.WorkspaceID,
 			&i.OwnerFirstName,
 			&i.OwnerLastName,
 			&i.OwnerThumbnail,
 			&i.IsUsed,
+			&i.IsUsedInTrash,
 		); err != nil {
 			return nil, err
 		}
@@ -249,14 +533,44 @@ func (q *Queries) ListTemplates(ctx context.Context, arg *ListTemplatesParams) (
 	return items, nil
 }
 
+const restoreTemplate = `-- name: RestoreTemplate :execrows
+UPDATE templates
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreTemplate(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreTemplate, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const trashTemplate = `-- name: TrashTemplate :execrows
+UPDATE templates
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) TrashTemplate(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, trashTemplate, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateField = `-- name: UpdateField :one
 UPDATE fields
 SET
     label = $2,
     "order" = $3,
-    is_required = $4
+    is_required = $4,
+    type = $5,
+    options = $6
 WHERE id = $1
-RETURNING id, template_id, label, "order", is_required
+RETURNING id, template_id, label, "order", is_required, version, type, options
 `
 
 type UpdateFieldParams struct {
@@ -264,6 +578,8 @@ type UpdateFieldParams struct {
 	Label      string      `db:"label" json:"label"`
 	Order      int32       `db:"order" json:"order"`
 	IsRequired bool        `db:"is_required" json:"is_required"`
+	Type       string      `db:"type" json:"type"`
+	Options    []string    `db:"options" json:"options"`
 }
 
 func (q *Queries) UpdateField(ctx context.Context, arg *UpdateFieldParams) (*Field, error) {
@@ -272,6 +588,8 @@ func (q *Queries) UpdateField(ctx context.Context, arg *UpdateFieldParams) (*Fie
 		arg.Label,
 		arg.Order,
 		arg.IsRequired,
+		arg.Type,
+		arg.Options,
 	)
 	var i Field
 	err := row.Scan(
@@ -280,6 +598,9 @@ func (q *Queries) UpdateField(ctx context.Context, arg *UpdateFieldParams) (*Fie
 		&i.Label,
 		&i.Order,
 		&i.IsRequired,
+		&i.Version,
+		&i.Type,
+		&i.Options,
 	)
 	return &i, err
 }
@@ -288,24 +609,30 @@ const updateTemplate = `-- name: UpdateTemplate :one
 UPDATE templates
 SET
     name = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
-RETURNING id, name, owner_id, updated_at
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING id, name, owner_id, updated_at, version, deleted_at, workspace_id
 `
 
 type UpdateTemplateParams struct {
-	ID   pgtype.UUID `db:"id" json:"id"`
-	Name string      `db:"name" json:"name"`
+	ID      pgtype.UUID `db:"id" json:"id"`
+	Name    string      `db:"name" json:"name"`
+	Version int32       `db:"version" json:"version"`
 }
 
+// Only updates when the stored version still matches $3; no row means a concurrent update won.
 func (q *Queries) UpdateTemplate(ctx context.Context, arg *UpdateTemplateParams) (*Template, error) {
-	row := q.db.QueryRow(ctx, updateTemplate, arg.ID, arg.Name)
+	row := q.db.QueryRow(ctx, updateTemplate, arg.ID, arg.Name, arg.Version)
 	var i Template
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.OwnerID,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.DeletedAt,
+		&i.WorkspaceID,
 	)
 	return &i, err
 }
@@ -0,0 +1,394 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: webhooks.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+WITH due AS (
+    SELECT d.id
+    FROM webhook_deliveries d
+    JOIN webhooks w ON w.id = d.webhook_id
+    WHERE d.status = 'pending' AND d.next_attempt_at <= NOW() AND w.is_active
+    ORDER BY d.next_attempt_at, d.id
+    LIMIT $1
+    FOR UPDATE OF d SKIP LOCKED
+)
+UPDATE webhook_deliveries wd
+SET next_attempt_at = $2
+FROM due, webhooks w
+WHERE wd.id = due.id AND w.id = wd.webhook_id
+RETURNING wd.id, wd.webhook_id, wd.event_id, wd.event_type, wd.payload, wd.status, wd.attempts, wd.next_attempt_at, wd.last_status_code, wd.last_error, wd.redelivery_of, wd.created_at, wd.delivered_at, w.url, w.secret
+`
+
+type ClaimDueWebhookDeliveriesParams struct {
+	Limit         int32              `db:"limit" json:"limit"`
+	NextAttemptAt pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+}
+
+type ClaimDueWebhookDeliveriesRow struct {
+	ID             pgtype.UUID        `db:"id" json:"id"`
+	WebhookID      pgtype.UUID        `db:"webhook_id" json:"webhook_id"`
+	EventID        pgtype.UUID        `db:"event_id" json:"event_id"`
+	EventType      string             `db:"event_type" json:"event_type"`
+	Payload        []byte             `db:"payload" json:"payload"`
+	Status         string             `db:"status" json:"status"`
+	Attempts       int32              `db:"attempts" json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	LastStatusCode int32              `db:"last_status_code" json:"last_status_code"`
+	LastError      string             `db:"last_error" json:"last_error"`
+	RedeliveryOf   pgtype.UUID        `db:"redelivery_of" json:"redelivery_of"`
+	CreatedAt      pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz `db:"delivered_at" json:"delivered_at"`
+	Url            string             `db:"url" json:"url"`
+	Secret         string             `db:"secret" json:"secret"`
+}
+
+// Leases due deliveries of active webhooks by moving next_attempt_at to $2, so other
+// workers skip them while they are being sent. If a worker dies mid-send, the
+// delivery becomes due again when the lease runs out.
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, arg *ClaimDueWebhookDeliveriesParams) ([]*ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveries, arg.Limit, arg.NextAttemptAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ClaimDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.RedeliveryOf,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO webhooks (owner_id, url, secret, events, is_active)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, owner_id, url, secret, events, is_active, created_at, updated_at
+`
+
+type CreateWebhookParams struct {
+	OwnerID  pgtype.UUID `db:"owner_id" json:"owner_id"`
+	Url      string      `db:"url" json:"url"`
+	Secret   string      `db:"secret" json:"secret"`
+	Events   []string    `db:"events" json:"events"`
+	IsActive bool        `db:"is_active" json:"is_active"`
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, arg *CreateWebhookParams) (*Webhook, error) {
+	row := q.db.QueryRow(ctx, createWebhook,
+		arg.OwnerID,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+		arg.IsActive,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, redelivery_of)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (webhook_id, event_id) WHERE redelivery_of IS NULL
+DO UPDATE SET event_type = EXCLUDED.event_type
+RETURNING id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, redelivery_of, created_at, delivered_at
+`
+
+type CreateWebhookDeliveryParams struct {
+	WebhookID    pgtype.UUID `db:"webhook_id" json:"webhook_id"`
+	EventID      pgtype.UUID `db:"event_id" json:"event_id"`
+	EventType    string      `db:"event_type" json:"event_type"`
+	Payload      []byte      `db:"payload" json:"payload"`
+	RedeliveryOf pgtype.UUID `db:"redelivery_of" json:"redelivery_of"`
+}
+
+// Enqueuing an event the webhook already has is a no-op that returns the stored row,
+// so a relay redelivering the event does not send it twice.
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg *CreateWebhookDeliveryParams) (*WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, createWebhookDelivery,
+		arg.WebhookID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+		arg.RedeliveryOf,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.RedeliveryOf,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+	)
+	return &i, err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhook(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhook, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWebhookByID = `-- name: GetWebhookByID :one
+SELECT id, owner_id, url, secret, events, is_active, created_at, updated_at FROM webhooks
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhookByID(ctx context.Context, id pgtype.UUID) (*Webhook, error) {
+	row := q.db.QueryRow(ctx, getWebhookByID, id)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const getWebhookDeliveryByID = `-- name: GetWebhookDeliveryByID :one
+SELECT id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, redelivery_of, created_at, delivered_at FROM webhook_deliveries
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhookDeliveryByID(ctx context.Context, id pgtype.UUID) (*WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, getWebhookDeliveryByID, id)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.RedeliveryOf,
+		&i.CreatedAt,
+		&i.DeliveredAt,
+	)
+	return &i, err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, redelivery_of, created_at, delivered_at FROM webhook_deliveries
+WHERE webhook_id = $1
+  AND ($2::timestamptz IS NULL OR (created_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
+`
+
+type ListWebhookDeliveriesParams struct {
+	WebhookID pgtype.UUID        `db:"webhook_id" json:"webhook_id"`
+	Column2   pgtype.Timestamptz `db:"column_2" json:"column_2"`
+	Column3   pgtype.UUID        `db:"column_3" json:"column_3"`
+	Limit     int32              `db:"limit" json:"limit"`
+}
+
+// Newest first. $2/$3 are the keyset cursor (created_at, id) and $4 the page size.
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg *ListWebhookDeliveriesParams) ([]*WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries,
+		arg.WebhookID,
+		arg.Column2,
+		arg.Column3,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.RedeliveryOf,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhooksByOwner = `-- name: ListWebhooksByOwner :many
+SELECT id, owner_id, url, secret, events, is_active, created_at, updated_at FROM webhooks
+WHERE owner_id = $1
+ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) ListWebhooksByOwner(ctx context.Context, ownerID pgtype.UUID) ([]*Webhook, error) {
+	rows, err := q.db.Query(ctx, listWebhooksByOwner, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveWebhookDeliveryAttempt = `-- name: SaveWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = $2,
+    attempts = $3,
+    next_attempt_at = $4,
+    last_status_code = $5,
+    last_error = $6,
+    delivered_at = $7
+WHERE id = $1
+`
+
+type SaveWebhookDeliveryAttemptParams struct {
+	ID             pgtype.UUID        `db:"id" json:"id"`
+	Status         string             `db:"status" json:"status"`
+	Attempts       int32              `db:"attempts" json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `db:"next_attempt_at" json:"next_attempt_at"`
+	LastStatusCode int32              `db:"last_status_code" json:"last_status_code"`
+	LastError      string             `db:"last_error" json:"last_error"`
+	DeliveredAt    pgtype.Timestamptz `db:"delivered_at" json:"delivered_at"`
+}
+
+func (q *Queries) SaveWebhookDeliveryAttempt(ctx context.Context, arg *SaveWebhookDeliveryAttemptParams) error {
+	_, err := q.db.Exec(ctx, saveWebhookDeliveryAttempt,
+		arg.ID,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.DeliveredAt,
+	)
+	return err
+}
+
+const updateWebhook = `-- name: UpdateWebhook :one
+UPDATE webhooks
+SET url = $2,
+    events = $3,
+    is_active = $4,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, owner_id, url, secret, events, is_active, created_at, updated_at
+`
+
+type UpdateWebhookParams struct {
+	ID       pgtype.UUID `db:"id" json:"id"`
+	Url      string      `db:"url" json:"url"`
+	Events   []string    `db:"events" json:"events"`
+	IsActive bool        `db:"is_active" json:"is_active"`
+}
+
+func (q *Queries) UpdateWebhook(ctx context.Context, arg *UpdateWebhookParams) (*Webhook, error) {
+	row := q.db.QueryRow(ctx, updateWebhook,
+		arg.ID,
+		arg.Url,
+		arg.Events,
+		arg.IsActive,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
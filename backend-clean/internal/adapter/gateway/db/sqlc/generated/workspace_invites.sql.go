@@ -0,0 +1,201 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: workspace_invites.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteWorkspaceInvite = `-- name: DeleteWorkspaceInvite :execrows
+DELETE FROM workspace_invites
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWorkspaceInvite(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWorkspaceInvite, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWorkspaceInviteByID = `-- name: GetWorkspaceInviteByID :one
+SELECT
+    i.id, i.workspace_id, i.email, i.role, i.invited_by, i.created_at, i.updated_at,
+    w.name AS workspace_name
+FROM workspace_invites i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE i.id = $1
+`
+
+type GetWorkspaceInviteByIDRow struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	WorkspaceID   pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	Email         string             `db:"email" json:"email"`
+	Role          string             `db:"role" json:"role"`
+	InvitedBy     pgtype.UUID        `db:"invited_by" json:"invited_by"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	WorkspaceName string             `db:"workspace_name" json:"workspace_name"`
+}
+
+func (q *Queries) GetWorkspaceInviteByID(ctx context.Context, id pgtype.UUID) (*GetWorkspaceInviteByIDRow, error) {
+	row := q.db.QueryRow(ctx, getWorkspaceInviteByID, id)
+	var i GetWorkspaceInviteByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Email,
+		&i.Role,
+		&i.InvitedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.WorkspaceName,
+	)
+	return &i, err
+}
+
+const listWorkspaceInvites = `-- name: ListWorkspaceInvites :many
+SELECT
+    i.id, i.workspace_id, i.email, i.role, i.invited_by, i.created_at, i.updated_at,
+    w.name AS workspace_name
+FROM workspace_invites i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE i.workspace_id = $1
+ORDER BY i.created_at ASC, i.id ASC
+`
+
+type ListWorkspaceInvitesRow struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	WorkspaceID   pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	Email         string             `db:"email" json:"email"`
+	Role          string             `db:"role" json:"role"`
+	InvitedBy     pgtype.UUID        `db:"invited_by" json:"invited_by"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	WorkspaceName string             `db:"workspace_name" json:"workspace_name"`
+}
+
+func (q *Queries) ListWorkspaceInvites(ctx context.Context, workspaceID pgtype.UUID) ([]*ListWorkspaceInvitesRow, error) {
+	rows, err := q.db.Query(ctx, listWorkspaceInvites, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkspaceInvitesRow
+	for rows.Next() {
+		var i ListWorkspaceInvitesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Email,
+			&i.Role,
+			&i.InvitedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WorkspaceName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkspaceInvitesByEmail = `-- name: ListWorkspaceInvitesByEmail :many
+SELECT
+    i.id, i.workspace_id, i.email, i.role, i.invited_by, i.created_at, i.updated_at,
+    w.name AS workspace_name
+FROM workspace_invites i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE i.email = $1
+ORDER BY i.created_at ASC, i.id ASC
+`
+
+type ListWorkspaceInvitesByEmailRow struct {
+	ID            pgtype.UUID        `db:"id" json:"id"`
+	WorkspaceID   pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	Email         string             `db:"email" json:"email"`
+	Role          string             `db:"role" json:"role"`
+	InvitedBy     pgtype.UUID        `db:"invited_by" json:"invited_by"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	WorkspaceName string             `db:"workspace_name" json:"workspace_name"`
+}
+
+// Invites are stored with lower-cased emails; $1 must be lower-cased as well.
+func (q *Queries) ListWorkspaceInvitesByEmail(ctx context.Context, email string) ([]*ListWorkspaceInvitesByEmailRow, error) {
+	rows, err := q.db.Query(ctx, listWorkspaceInvitesByEmail, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkspaceInvitesByEmailRow
+	for rows.Next() {
+		var i ListWorkspaceInvitesByEmailRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Email,
+			&i.Role,
+			&i.InvitedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WorkspaceName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertWorkspaceInvite = `-- name: UpsertWorkspaceInvite :one
+INSERT INTO workspace_invites (workspace_id, email, role, invited_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (workspace_id, email) DO UPDATE
+SET
+    role = EXCLUDED.role,
+    invited_by = EXCLUDED.invited_by,
+    updated_at = NOW()
+RETURNING id, workspace_id, email, role, invited_by, created_at, updated_at
+`
+
+type UpsertWorkspaceInviteParams struct {
+	WorkspaceID pgtype.UUID `db:"workspace_id" json:"workspace_id"`
+	Email       string      `db:"email" json:"email"`
+	Role        string      `db:"role" json:"role"`
+	InvitedBy   pgtype.UUID `db:"invited_by" json:"invited_by"`
+}
+
+// Inviting the same email again updates the role of the pending invite.
+func (q *Queries) UpsertWorkspaceInvite(ctx context.Context, arg *UpsertWorkspaceInviteParams) (*WorkspaceInvite, error) {
+	row := q.db.QueryRow(ctx, upsertWorkspaceInvite,
+		arg.WorkspaceID,
+		arg.Email,
+		arg.Role,
+		arg.InvitedBy,
+	)
+	var i WorkspaceInvite
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Email,
+		&i.Role,
+		&i.InvitedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
@@ -0,0 +1,274 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: workspaces.sql
+
+package generated
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createWorkspace = `-- name: CreateWorkspace :one
+INSERT INTO workspaces (name)
+VALUES ($1)
+RETURNING id, name, created_at, updated_at
+`
+
+func (q *Queries) CreateWorkspace(ctx context.Context, name string) (*Workspace, error) {
+	row := q.db.QueryRow(ctx, createWorkspace, name)
+	var i Workspace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const deleteWorkspace = `-- name: DeleteWorkspace :execrows
+DELETE FROM workspaces
+WHERE id = $1
+`
+
+// Members and invites go with the workspace.
+func (q *Queries) DeleteWorkspace(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWorkspace, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWorkspaceMember = `-- name: DeleteWorkspaceMember :execrows
+DELETE FROM workspace_members
+WHERE workspace_id = $1 AND account_id = $2
+`
+
+type DeleteWorkspaceMemberParams struct {
+	WorkspaceID pgtype.UUID `db:"workspace_id" json:"workspace_id"`
+	AccountID   pgtype.UUID `db:"account_id" json:"account_id"`
+}
+
+func (q *Queries) DeleteWorkspaceMember(ctx context.Context, arg *DeleteWorkspaceMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWorkspaceMember, arg.WorkspaceID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWorkspaceByID = `-- name: GetWorkspaceByID :one
+SELECT id, name, created_at, updated_at
+FROM workspaces
+WHERE id = $1
+`
+
+func (q *Queries) GetWorkspaceByID(ctx context.Context, id pgtype.UUID) (*Workspace, error) {
+	row := q.db.QueryRow(ctx, getWorkspaceByID, id)
+	var i Workspace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const getWorkspaceMember = `-- name: GetWorkspaceMember :one
+SELECT workspace_id, account_id, role, created_at, updated_at
+FROM workspace_members
+WHERE workspace_id = $1 AND account_id = $2
+`
+
+type GetWorkspaceMemberParams struct {
+	WorkspaceID pgtype.UUID `db:"workspace_id" json:"workspace_id"`
+	AccountID   pgtype.UUID `db:"account_id" json:"account_id"`
+}
+
+func (q *Queries) GetWorkspaceMember(ctx context.Context, arg *GetWorkspaceMemberParams) (*WorkspaceMember, error) {
+	row := q.db.QueryRow(ctx, getWorkspaceMember, arg.WorkspaceID, arg.AccountID)
+	var i WorkspaceMember
+	err := row.Scan(
+		&i.WorkspaceID,
+		&i.AccountID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const listWorkspaceMembers = `-- name: ListWorkspaceMembers :many
+SELECT
+    m.workspace_id, m.account_id, m.role, m.created_at, m.updated_at,
+    a.email,
+    a.first_name,
+    a.last_name,
+    a.thumbnail
+FROM workspace_members m
+JOIN accounts a ON a.id = m.account_id
+WHERE m.workspace_id = $1
+ORDER BY m.created_at ASC, m.account_id ASC
+`
+
+type ListWorkspaceMembersRow struct {
+	WorkspaceID pgtype.UUID        `db:"workspace_id" json:"workspace_id"`
+	AccountID   pgtype.UUID        `db:"account_id" json:"account_id"`
+	Role        string             `db:"role" json:"role"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Email       string             `db:"email" json:"email"`
+	FirstName   string             `db:"first_name" json:"first_name"`
+	LastName    string             `db:"last_name" json:"last_name"`
+	Thumbnail   pgtype.Text        `db:"thumbnail" json:"thumbnail"`
+}
+
+func (q *Queries) ListWorkspaceMembers(ctx context.Context, workspaceID pgtype.UUID) ([]*ListWorkspaceMembersRow, error) {
+	rows, err := q.db.Query(ctx, listWorkspaceMembers, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkspaceMembersRow
+	for rows.Next() {
+		var i ListWorkspaceMembersRow
+		if err := rows.Scan(
+			&i.WorkspaceID,
+			&i.AccountID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.Thumbnail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkspacesByAccount = `-- name: ListWorkspacesByAccount :many
+SELECT
+    w.id, w.name, w.created_at, w.updated_at,
+    m.role
+FROM workspaces w
+JOIN workspace_members m ON m.workspace_id = w.id
+WHERE m.account_id = $1
+ORDER BY m.created_at ASC, w.id ASC
+`
+
+type ListWorkspacesByAccountRow struct {
+	ID        pgtype.UUID        `db:"id" json:"id"`
+	Name      string             `db:"name" json:"name"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Role      string             `db:"role" json:"role"`
+}
+
+// The account's workspaces with its role in each, in the order they were joined.
+func (q *Queries) ListWorkspacesByAccount(ctx context.Context, accountID pgtype.UUID) ([]*ListWorkspacesByAccountRow, error) {
+	rows, err := q.db.Query(ctx, listWorkspacesByAccount, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkspacesByAccountRow
+	for rows.Next() {
+		var i ListWorkspacesByAccountRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateWorkspace = `-- name: UpdateWorkspace :one
+UPDATE workspaces
+SET
+    name = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, created_at, updated_at
+`
+
+type UpdateWorkspaceParams struct {
+	ID   pgtype.UUID `db:"id" json:"id"`
+	Name string      `db:"name" json:"name"`
+}
+
+func (q *Queries) UpdateWorkspace(ctx context.Context, arg *UpdateWorkspaceParams) (*Workspace, error) {
+	row := q.db.QueryRow(ctx, updateWorkspace, arg.ID, arg.Name)
+	var i Workspace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const upsertWorkspaceMember = `-- name: UpsertWorkspaceMember :one
+INSERT INTO workspace_members (workspace_id, account_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (workspace_id, account_id) DO UPDATE
+SET
+    role = EXCLUDED.role,
+    updated_at = NOW()
+RETURNING workspace_id, account_id, role, created_at, updated_at
+`
+
+type UpsertWorkspaceMemberParams struct {
+	WorkspaceID pgtype.UUID `db:"workspace_id" json:"workspace_id"`
+	AccountID   pgtype.UUID `db:"account_id" json:"account_id"`
+	Role        string      `db:"role" json:"role"`
+}
+
+func (q *Queries) UpsertWorkspaceMember(ctx context.Context, arg *UpsertWorkspaceMemberParams) (*WorkspaceMember, error) {
+	row := q.db.QueryRow(ctx, upsertWorkspaceMember, arg.WorkspaceID, arg.AccountID, arg.Role)
+	var i WorkspaceMember
+	err := row.Scan(
+		&i.WorkspaceID,
+		&i.AccountID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const workspaceHasContents = `-- name: WorkspaceHasContents :one
+SELECT (
+    EXISTS (SELECT 1 FROM templates t WHERE t.workspace_id = $1)
+    OR EXISTS (SELECT 1 FROM notes n WHERE n.workspace_id = $1)
+)::boolean AS has_contents
+`
+
+// Trashed templates and notes count: they still belong to the workspace.
+func (q *Queries) WorkspaceHasContents(ctx context.Context, workspaceID pgtype.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, workspaceHasContents, workspaceID)
+	var has_contents bool
+	err := row.Scan(&has_contents)
+	return has_contents, err
+}
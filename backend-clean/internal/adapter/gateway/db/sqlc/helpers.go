@@ -2,19 +2,25 @@ package sqlc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
+	"immortal-architecture-clean/backend/internal/port"
 )
 
+// toUUID parses an ID. A malformed ID names no row, so it is reported as not found
+// rather than as a server fault.
 func toUUID(str string) (pgtype.UUID, error) {
 	parsed, err := uuid.Parse(str)
 	if err != nil {
-		return pgtype.UUID{}, err
+		return pgtype.UUID{}, domainerr.ErrNotFound
 	}
 	var id pgtype.UUID
 	id.Bytes = parsed
@@ -22,6 +28,35 @@ func toUUID(str string) (pgtype.UUID, error) {
 	return id, nil
 }
 
+// toNullableUUID maps an empty string to NULL, as for optional references.
+func toNullableUUID(str string) (pgtype.UUID, error) {
+	if str == "" {
+		return pgtype.UUID{}, nil
+	}
+	return toUUID(str)
+}
+
+// errNoWorkspace is returned when templates or notes are read without a workspace scope.
+var errNoWorkspace = errors.New("sqlc: no workspace in context")
+
+// workspaceFromContext converts the active workspace to the scope parameter of the queries.
+func workspaceFromContext(ctx context.Context) (pgtype.UUID, error) {
+	return workspaceScope(ctx, port.WorkspaceIDFromContext(ctx))
+}
+
+// workspaceScope converts workspaceID to the scope parameter of the queries. The scope fails
+// closed: a missing workspace is an error, and so is a malformed ID. Only a context made with
+// port.WithAllWorkspaces gets NULL, which matches every workspace.
+func workspaceScope(ctx context.Context, workspaceID string) (pgtype.UUID, error) {
+	if workspaceID == "" {
+		if port.AllWorkspaces(ctx) {
+			return pgtype.UUID{}, nil
+		}
+		return pgtype.UUID{}, errNoWorkspace
+	}
+	return toUUID(workspaceID)
+}
+
 func uuidToString(id pgtype.UUID) string {
 	if !id.Valid {
 		return ""
@@ -37,6 +72,15 @@ func timestamptzToTime(t pgtype.Timestamptz) time.Time {
 	return t.Time
 }
 
+// timestamptzToTimePtr returns nil for NULL, as for deleted_at of rows outside the trash.
+func timestamptzToTimePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
 func nullableTextToString(t pgtype.Text) string {
 	if !t.Valid {
 		return ""
@@ -44,6 +88,15 @@ func nullableTextToString(t pgtype.Text) string {
 	return t.String
 }
 
+// textToStringPtr returns nil for NULL, as for an account without a thumbnail.
+func textToStringPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	s := t.String
+	return &s
+}
+
 func queriesForContext(ctx context.Context, q *generated.Queries) *generated.Queries {
 	if tx := driverdb.TxFromContext(ctx); tx != nil {
 		return q.WithTx(tx)
@@ -64,3 +117,16 @@ func pgNullableTime(t *time.Time) pgtype.Timestamptz {
 	}
 	return pgtype.Timestamptz{Time: *t, Valid: true}
 }
+
+// toKeyset converts a page cursor to the (updated_at, id) query parameters.
+// A nil cursor yields NULLs, which the list queries treat as "from the start".
+func toKeyset(after *page.Cursor) (pgtype.Timestamptz, pgtype.UUID, error) {
+	if after == nil {
+		return pgtype.Timestamptz{}, pgtype.UUID{}, nil
+	}
+	id, err := toUUID(after.ID)
+	if err != nil {
+		return pgtype.Timestamptz{}, pgtype.UUID{}, domainerr.ErrInvalidCursor
+	}
+	return pgtype.Timestamptz{Time: after.UpdatedAt, Valid: true}, id, nil
+}
@@ -1,8 +1,12 @@
 package sqlc
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
+
+	"immortal-architecture-clean/backend/internal/port"
 )
 
 func TestPgNullableHelpers(t *testing.T) {
@@ -20,4 +24,49 @@ func TestPgNullableHelpers(t *testing.T) {
 	if v := pgNullableTime(&now); !v.Valid || !v.Time.Equal(now) {
 		t.Fatalf("unexpected time: %+v", v)
 	}
+	if v, err := toNullableUUID(""); err != nil || v.Valid {
+		t.Fatalf("expected invalid uuid for empty string, got %+v, %v", v, err)
+	}
+	if v, err := toNullableUUID("00000000-0000-0000-0000-000000000001"); err != nil || !v.Valid {
+		t.Fatalf("unexpected uuid: %+v, %v", v, err)
+	}
+	if _, err := toNullableUUID("bad-uuid"); err == nil {
+		t.Fatalf("expected error for invalid uuid")
+	}
+}
+
+func TestWorkspaceScope(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		workspaceID string
+		wantValid   bool
+		wantErr     error
+	}{
+		{name: "[Success] workspace", ctx: context.Background(), workspaceID: "00000000-0000-0000-0000-000000000001", wantValid: true},
+		{name: "[Success] every workspace for system jobs", ctx: port.WithAllWorkspaces(context.Background())},
+		{name: "[Fail] no workspace", ctx: context.Background(), wantErr: errNoWorkspace},
+		{name: "[Fail] malformed workspace", ctx: port.WithAllWorkspaces(context.Background()), workspaceID: "bad-uuid", wantErr: errors.New("invalid")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := workspaceScope(tt.ctx, tt.workspaceID)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				if tt.wantErr == errNoWorkspace && !errors.Is(err, errNoWorkspace) {
+					t.Fatalf("want errNoWorkspace, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Valid != tt.wantValid {
+				t.Fatalf("valid = %v, want %v", got.Valid, tt.wantValid)
+			}
+		})
+	}
 }
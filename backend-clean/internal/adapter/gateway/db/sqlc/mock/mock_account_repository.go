@@ -77,6 +77,12 @@ func setUUID(ptr interface{}, v pgtype.UUID) {
 	}
 }
 
+func setUUIDs(ptr interface{}, v []pgtype.UUID) {
+	if dest, ok := ptr.(*[]pgtype.UUID); ok {
+		*dest = v
+	}
+}
+
 func setString(ptr interface{}, v string) {
 	if dest, ok := ptr.(*string); ok {
 		*dest = v
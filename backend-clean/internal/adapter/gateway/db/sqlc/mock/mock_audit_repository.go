@@ -0,0 +1,136 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// AuditDBTX is a lightweight mock for sqlc.DBTX used in audit repository tests.
+// Single-row queries are told apart by their scan target: GetAuditLogHead scans a
+// string and InsertAuditLog the new sequence.
+type AuditDBTX struct {
+	head      string
+	headErr   error
+	insertErr error
+	execErr   error
+	queryErr  error
+	rows      []*generated.AuditLog
+	sequence  int64
+	// Locked counts LockAuditLog calls.
+	Locked int
+	// Inserted holds the arguments of every InsertAuditLog call.
+	Inserted [][]interface{}
+	// Queried holds the arguments of every Query call.
+	Queried [][]interface{}
+}
+
+// NewAuditDBTX creates a mock DBTX whose chain head is the given hash. A headErr of
+// pgx.ErrNoRows stands for an empty log.
+func NewAuditDBTX(head string, headErr, insertErr, execErr error) *AuditDBTX {
+	return &AuditDBTX{head: head, headErr: headErr, insertErr: insertErr, execErr: execErr}
+}
+
+// WithList allows configuring rows returned by ListAuditLog and ListAuditLogChain.
+func (m *AuditDBTX) WithList(rows []*generated.AuditLog, queryErr error) *AuditDBTX {
+	m.rows = rows
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *AuditDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	m.Locked++
+	return pgconn.CommandTag{}, m.execErr
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *AuditDBTX) Query(_ context.Context, _ string, args ...interface{}) (pgx.Rows, error) {
+	m.Queried = append(m.Queried, args)
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &auditRows{items: m.rows}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *AuditDBTX) QueryRow(_ context.Context, _ string, args ...interface{}) pgx.Row {
+	return &auditRow{m: m, args: args}
+}
+
+type auditRow struct {
+	m    *AuditDBTX
+	args []interface{}
+}
+
+func (r *auditRow) Scan(dest ...interface{}) error {
+	if len(dest) != 1 {
+		return errors.New("unexpected scan args")
+	}
+	switch d := dest[0].(type) {
+	case *string:
+		if r.m.headErr != nil {
+			return r.m.headErr
+		}
+		*d = r.m.head
+		return nil
+	case *int64:
+		r.m.Inserted = append(r.m.Inserted, r.args)
+		if r.m.insertErr != nil {
+			return r.m.insertErr
+		}
+		r.m.sequence++
+		*d = r.m.sequence
+		return nil
+	default:
+		return errors.New("unexpected scan args")
+	}
+}
+
+type auditRows struct {
+	items []*generated.AuditLog
+	idx   int
+}
+
+func (r *auditRows) Close()                                       {}
+func (r *auditRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *auditRows) Err() error                                   { return nil }
+func (r *auditRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *auditRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *auditRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *auditRows) RawValues() [][]byte                          { return nil }
+func (r *auditRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	return scanAuditLog(r.items[r.idx-1], dest)
+}
+func (r *auditRows) Conn() *pgx.Conn { return nil }
+
+func scanAuditLog(row *generated.AuditLog, dest []interface{}) error {
+	if len(dest) != 11 {
+		return errors.New("unexpected scan args")
+	}
+	if d, ok := dest[0].(*int64); ok {
+		*d = row.Sequence
+	}
+	setString(dest[1], row.ActorID)
+	setString(dest[2], row.Action)
+	setString(dest[3], row.AggregateType)
+	setString(dest[4], row.AggregateID)
+	if d, ok := dest[5].(*[]byte); ok {
+		*d = row.Before
+	}
+	if d, ok := dest[6].(*[]byte); ok {
+		*d = row.After
+	}
+	setString(dest[7], row.RequestID)
+	setTimestamptz(dest[8], row.OccurredAt)
+	setString(dest[9], row.PrevHash)
+	setString(dest[10], row.Hash)
+	return nil
+}
@@ -0,0 +1,121 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// CommentDBTX is a lightweight mock for sqlc.DBTX used in comment repository tests.
+// Single-row queries are told apart by their column count: GetCommentByID adds the
+// author's profile to the comment columns.
+type CommentDBTX struct {
+	row      *generated.GetCommentByIDRow
+	rowErr   error
+	execErr  error
+	queryErr error
+	affected int64
+	rows     []*generated.GetCommentByIDRow
+	// Args holds the arguments of the last QueryRow call.
+	Args []interface{}
+}
+
+// NewCommentDBTX creates a mock DBTX that always returns the given row/err.
+// Exec reports affected rows for DeleteComment.
+func NewCommentDBTX(row *generated.GetCommentByIDRow, rowErr, execErr error, affected int64) *CommentDBTX {
+	return &CommentDBTX{row: row, rowErr: rowErr, execErr: execErr, affected: affected}
+}
+
+// WithList allows configuring rows returned by ListCommentsByNote.
+func (m *CommentDBTX) WithList(rows []*generated.GetCommentByIDRow, queryErr error) *CommentDBTX {
+	m.rows = rows
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *CommentDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	if m.execErr != nil {
+		return pgconn.CommandTag{}, m.execErr
+	}
+	if m.affected > 0 {
+		return pgconn.NewCommandTag("DELETE 1"), nil
+	}
+	return pgconn.NewCommandTag("DELETE 0"), nil
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *CommentDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx.Rows, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &commentRows{items: m.rows}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *CommentDBTX) QueryRow(_ context.Context, _ string, args ...interface{}) pgx.Row {
+	m.Args = args
+	return &commentRow{row: m.row, err: m.rowErr}
+}
+
+type commentRow struct {
+	row *generated.GetCommentByIDRow
+	err error
+}
+
+func (m *commentRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.row == nil {
+		return errors.New("row is nil")
+	}
+	return scanComment(m.row, dest)
+}
+
+type commentRows struct {
+	items []*generated.GetCommentByIDRow
+	idx   int
+}
+
+func (r *commentRows) Close()                                       {}
+func (r *commentRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *commentRows) Err() error                                   { return nil }
+func (r *commentRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *commentRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *commentRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *commentRows) RawValues() [][]byte                          { return nil }
+func (r *commentRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	return scanComment(r.items[r.idx-1], dest)
+}
+func (r *commentRows) Conn() *pgx.Conn { return nil }
+
+// scanComment fills the comment columns, and the author columns when asked for them.
+func scanComment(row *generated.GetCommentByIDRow, dest []interface{}) error {
+	if len(dest) != 10 && len(dest) != 13 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], row.ID)
+	setUUID(dest[1], row.NoteID)
+	setUUID(dest[2], row.SectionID)
+	setUUID(dest[3], row.ParentID)
+	setUUID(dest[4], row.AuthorID)
+	setString(dest[5], row.Body)
+	setUUID(dest[6], row.ResolvedBy)
+	setTimestamptz(dest[7], row.ResolvedAt)
+	setTimestamptz(dest[8], row.CreatedAt)
+	setTimestamptz(dest[9], row.UpdatedAt)
+	if len(dest) == 13 {
+		setString(dest[10], row.FirstName)
+		setString(dest[11], row.LastName)
+		setText(dest[12], row.AuthorThumbnail)
+	}
+	return nil
+}
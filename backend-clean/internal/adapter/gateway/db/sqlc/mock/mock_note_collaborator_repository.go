@@ -0,0 +1,120 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// NoteCollaboratorDBTX is a lightweight mock for sqlc.DBTX used in note collaborator repository tests.
+type NoteCollaboratorDBTX struct {
+	row      *generated.NoteCollaborator
+	rowErr   error
+	execErr  error
+	queryErr error
+	affected int64
+	rows     []*generated.ListNoteCollaboratorsRow
+	// Args holds the arguments of the last QueryRow call.
+	Args []interface{}
+}
+
+// NewNoteCollaboratorDBTX creates a mock DBTX that always returns the given row/err.
+// Exec reports affected rows for DeleteNoteCollaborator.
+func NewNoteCollaboratorDBTX(row *generated.NoteCollaborator, rowErr, execErr error, affected int64) *NoteCollaboratorDBTX {
+	return &NoteCollaboratorDBTX{row: row, rowErr: rowErr, execErr: execErr, affected: affected}
+}
+
+// WithList allows configuring rows returned by ListNoteCollaborators.
+func (m *NoteCollaboratorDBTX) WithList(rows []*generated.ListNoteCollaboratorsRow, queryErr error) *NoteCollaboratorDBTX {
+	m.rows = rows
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *NoteCollaboratorDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	if m.execErr != nil {
+		return pgconn.CommandTag{}, m.execErr
+	}
+	if m.affected > 0 {
+		return pgconn.NewCommandTag("DELETE 1"), nil
+	}
+	return pgconn.NewCommandTag("DELETE 0"), nil
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *NoteCollaboratorDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx.Rows, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &collaboratorRows{items: m.rows}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *NoteCollaboratorDBTX) QueryRow(_ context.Context, _ string, args ...interface{}) pgx.Row {
+	m.Args = args
+	return &collaboratorRow{row: m.row, err: m.rowErr}
+}
+
+type collaboratorRow struct {
+	row *generated.NoteCollaborator
+	err error
+}
+
+func (m *collaboratorRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.row == nil {
+		return errors.New("row is nil")
+	}
+	return scanCollaborator(&generated.ListNoteCollaboratorsRow{
+		NoteID:    m.row.NoteID,
+		AccountID: m.row.AccountID,
+		Role:      m.row.Role,
+		CreatedAt: m.row.CreatedAt,
+		UpdatedAt: m.row.UpdatedAt,
+	}, dest)
+}
+
+type collaboratorRows struct {
+	items []*generated.ListNoteCollaboratorsRow
+	idx   int
+}
+
+func (r *collaboratorRows) Close()                                       {}
+func (r *collaboratorRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *collaboratorRows) Err() error                                   { return nil }
+func (r *collaboratorRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *collaboratorRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *collaboratorRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *collaboratorRows) RawValues() [][]byte                          { return nil }
+func (r *collaboratorRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	return scanCollaborator(r.items[r.idx-1], dest)
+}
+func (r *collaboratorRows) Conn() *pgx.Conn { return nil }
+
+// scanCollaborator fills the collaborator columns, and the profile columns when asked for them.
+func scanCollaborator(row *generated.ListNoteCollaboratorsRow, dest []interface{}) error {
+	if len(dest) != 5 && len(dest) != 8 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], row.NoteID)
+	setUUID(dest[1], row.AccountID)
+	setString(dest[2], row.Role)
+	setTimestamptz(dest[3], row.CreatedAt)
+	setTimestamptz(dest[4], row.UpdatedAt)
+	if len(dest) == 8 {
+		setString(dest[5], row.FirstName)
+		setString(dest[6], row.LastName)
+		setText(dest[7], row.Thumbnail)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// NoteLinkDBTX is a lightweight mock for sqlc.DBTX used in note link repository tests.
+type NoteLinkDBTX struct {
+	row       *generated.NoteLink
+	rowErr    error
+	execErr   error
+	queryErr  error
+	affected  int64
+	outgoing  []*generated.ListOutgoingNoteLinksRow
+	backlinks []*generated.ListOutgoingNoteLinksRow
+}
+
+// NewNoteLinkDBTX creates a mock DBTX that always returns the given row/err.
+// Exec reports affected rows for DeleteNoteLink.
+func NewNoteLinkDBTX(row *generated.NoteLink, rowErr, execErr error, affected int64) *NoteLinkDBTX {
+	return &NoteLinkDBTX{row: row, rowErr: rowErr, execErr: execErr, affected: affected}
+}
+
+// WithList allows configuring rows returned by ListOutgoingNoteLinks/ListBacklinkNoteLinks.
+// Both queries select the same columns, so backlinks reuse the outgoing row type.
+func (m *NoteLinkDBTX) WithList(outgoing, backlinks []*generated.ListOutgoingNoteLinksRow, queryErr error) *NoteLinkDBTX {
+	m.outgoing = outgoing
+	m.backlinks = backlinks
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *NoteLinkDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	if m.execErr != nil {
+		return pgconn.CommandTag{}, m.execErr
+	}
+	if m.affected > 0 {
+		return pgconn.NewCommandTag("DELETE 1"), nil
+	}
+	return pgconn.NewCommandTag("DELETE 0"), nil
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *NoteLinkDBTX) Query(_ context.Context, sql string, _ ...interface{}) (pgx.Rows, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return linkRowsFor(sql, m.outgoing, m.backlinks), nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *NoteLinkDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
+	return &noteLinkRow{row: m.row, err: m.rowErr}
+}
+
+// isNoteLinkQuery reports whether sql is one of the link summary list queries.
+func isNoteLinkQuery(sql string) bool {
+	return strings.Contains(sql, "FROM note_links")
+}
+
+func linkRowsFor(sql string, outgoing, backlinks []*generated.ListOutgoingNoteLinksRow) *noteLinkRows {
+	if strings.Contains(sql, "WHERE l.target_note_id") {
+		return &noteLinkRows{items: backlinks}
+	}
+	return &noteLinkRows{items: outgoing}
+}
+
+type noteLinkRow struct {
+	row *generated.NoteLink
+	err error
+}
+
+func (m *noteLinkRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.row == nil {
+		return errors.New("row is nil")
+	}
+	if len(dest) != 5 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], m.row.ID)
+	setUUID(dest[1], m.row.SourceNoteID)
+	setUUID(dest[2], m.row.TargetNoteID)
+	setString(dest[3], m.row.LinkType)
+	setTimestamptz(dest[4], m.row.CreatedAt)
+	return nil
+}
+
+func (m *noteLinkRow) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (m *noteLinkRow) RawValues() [][]byte                          { return nil }
+func (m *noteLinkRow) Value(_ int) (interface{}, error)             { return nil, nil }
+func (m *noteLinkRow) Err() error                                   { return m.err }
+
+type noteLinkRows struct {
+	items []*generated.ListOutgoingNoteLinksRow
+	idx   int
+}
+
+func (r *noteLinkRows) Close()                                       {}
+func (r *noteLinkRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *noteLinkRows) Err() error                                   { return nil }
+func (r *noteLinkRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *noteLinkRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *noteLinkRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *noteLinkRows) RawValues() [][]byte                          { return nil }
+func (r *noteLinkRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	item := r.items[r.idx-1]
+	if len(dest) != 7 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], item.ID)
+	setString(dest[1], item.LinkType)
+	setUUID(dest[2], item.NoteID)
+	setString(dest[3], item.Title)
+	setString(dest[4], item.Status)
+	setUUID(dest[5], item.OwnerID)
+	setUUIDs(dest[6], item.CollaboratorIds)
+	return nil
+}
+func (r *noteLinkRows) Conn() *pgx.Conn { return nil }
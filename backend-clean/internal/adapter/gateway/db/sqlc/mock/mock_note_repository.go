@@ -4,6 +4,8 @@ package mock
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -18,9 +20,17 @@ type NoteDBTX struct {
 	sectionRow *generated.Section
 	rowErr     error
 	execErr    error
+	execRows   int64
 	queryErr   error
 	listNotes  []*generated.ListNotesRow
+	trashed    []*generated.ListNotesRow
+	search     []*generated.SearchNotesRow
 	sections   []*generated.Section
+	links      []*generated.ListOutgoingNoteLinksRow
+	backlinks  []*generated.ListOutgoingNoteLinksRow
+	tags       []string
+	tagCounts  []*generated.ListTagCountsRow
+	collabs    []*generated.ListNoteCollaboratorsRow
 }
 
 // NewNoteDBTX creates a mock DBTX that always returns the given row/err.
@@ -36,7 +46,55 @@ func (m *NoteDBTX) WithList(notes []*generated.ListNotesRow, sections []*generat
 	return m
 }
 
-// WithGetRow sets a GetNoteByIDRow for QueryRow scans requiring 11 columns.
+// WithTrash sets rows returned by ListTrashedNotes.
+func (m *NoteDBTX) WithTrash(rows []*generated.ListTrashedNotesRow) *NoteDBTX {
+	m.trashed = make([]*generated.ListNotesRow, 0, len(rows))
+	for _, r := range rows {
+		row := generated.ListNotesRow(*r)
+		m.trashed = append(m.trashed, &row)
+	}
+	return m
+}
+
+// WithTags sets tags returned by ListNoteTags for every note.
+func (m *NoteDBTX) WithTags(tags []string) *NoteDBTX {
+	m.tags = tags
+	return m
+}
+
+// WithTagCounts sets rows returned by ListTagCounts.
+func (m *NoteDBTX) WithTagCounts(rows []*generated.ListTagCountsRow) *NoteDBTX {
+	m.tagCounts = rows
+	return m
+}
+
+// WithCollaborators sets rows returned by ListNoteCollaborators for every note.
+func (m *NoteDBTX) WithCollaborators(rows []*generated.ListNoteCollaboratorsRow) *NoteDBTX {
+	m.collabs = rows
+	return m
+}
+
+// WithExecRows sets the rows affected reported by Exec, as read by :execrows queries.
+func (m *NoteDBTX) WithExecRows(n int64) *NoteDBTX {
+	m.execRows = n
+	return m
+}
+
+// WithSearch sets rows returned by SearchNotes.
+func (m *NoteDBTX) WithSearch(rows []*generated.SearchNotesRow) *NoteDBTX {
+	m.search = rows
+	return m
+}
+
+// WithLinks sets rows returned by the link summary queries used in Get.
+func (m *NoteDBTX) WithLinks(links, backlinks []*generated.ListOutgoingNoteLinksRow) *NoteDBTX {
+	m.links = links
+	m.backlinks = backlinks
+	return m
+}
+
+// WithGetRow sets a GetNoteByIDRow for QueryRow scans requiring 14 columns,
+// which GetTrashedNoteByID shares.
 func (m *NoteDBTX) WithGetRow(row *generated.GetNoteByIDRow) *NoteDBTX {
 	m.getRow = row
 	return m
@@ -50,19 +108,33 @@ func (m *NoteDBTX) WithSectionRow(row *generated.Section) *NoteDBTX {
 
 // Exec implements sqlc.DBTX interface.
 func (m *NoteDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
-	return pgconn.CommandTag{}, m.execErr
+	return pgconn.NewCommandTag(fmt.Sprintf("UPDATE %d", m.execRows)), m.execErr
 }
 
 // Query implements sqlc.DBTX interface.
-func (m *NoteDBTX) Query(_ context.Context, _ string, args ...interface{}) (pgx.Rows, error) {
+func (m *NoteDBTX) Query(_ context.Context, sql string, _ ...interface{}) (pgx.Rows, error) {
 	if m.queryErr != nil {
 		return nil, m.queryErr
 	}
-	// Heuristic: ListNotes has 4 args, ListSectionsByNote has 1 arg.
-	if len(args) == 4 {
+	if isNoteLinkQuery(sql) {
+		return linkRowsFor(sql, m.links, m.backlinks), nil
+	}
+	switch {
+	case strings.HasPrefix(sql, "-- name: ListNotes "):
 		return &noteRows{items: m.listNotes}, nil
+	case strings.HasPrefix(sql, "-- name: ListTrashedNotes "):
+		return &noteRows{items: m.trashed}, nil
+	case strings.HasPrefix(sql, "-- name: SearchNotes "):
+		return &searchRows{items: m.search}, nil
+	case strings.HasPrefix(sql, "-- name: ListNoteTags "):
+		return &tagRows{items: m.tags}, nil
+	case strings.HasPrefix(sql, "-- name: ListTagCounts "):
+		return &tagCountRows{items: m.tagCounts}, nil
+	case strings.HasPrefix(sql, "-- name: ListNoteCollaborators "):
+		return &collaboratorRows{items: m.collabs}, nil
+	default:
+		return &sectionRows{items: m.sections}, nil
 	}
-	return &sectionRows{items: m.sections}, nil
 }
 
 // QueryRow implements sqlc.DBTX interface.
@@ -82,7 +154,7 @@ func (m *noteRow) Scan(dest ...interface{}) error {
 		return m.err
 	}
 	switch len(dest) {
-	case 11:
+	case 15:
 		if m.getRow == nil {
 			return errors.New("getRow is nil")
 		}
@@ -93,12 +165,16 @@ func (m *noteRow) Scan(dest ...interface{}) error {
 		setString(dest[4], m.getRow.Status)
 		setTimestamptz(dest[5], m.getRow.CreatedAt)
 		setTimestamptz(dest[6], m.getRow.UpdatedAt)
-		setString(dest[7], m.getRow.TemplateName)
-		setString(dest[8], m.getRow.FirstName)
-		setString(dest[9], m.getRow.LastName)
-		setText(dest[10], m.getRow.OwnerThumbnail)
+		setInt32(dest[7], m.getRow.Version)
+		setInt32(dest[8], m.getRow.TemplateVersion)
+		setTimestamptz(dest[9], m.getRow.DeletedAt)
+		setUUID(dest[10], m.getRow.WorkspaceID)
+		setString(dest[11], m.getRow.TemplateName)
+		setString(dest[12], m.getRow.FirstName)
+		setString(dest[13], m.getRow.LastName)
+		setText(dest[14], m.getRow.OwnerThumbnail)
 		return nil
-	case 7:
+	case 11:
 		if m.row == nil {
 			return errors.New("row is nil")
 		}
@@ -109,6 +185,10 @@ func (m *noteRow) Scan(dest ...interface{}) error {
 		setString(dest[4], m.row.Status)
 		setTimestamptz(dest[5], m.row.CreatedAt)
 		setTimestamptz(dest[6], m.row.UpdatedAt)
+		setInt32(dest[7], m.row.Version)
+		setInt32(dest[8], m.row.TemplateVersion)
+		setTimestamptz(dest[9], m.row.DeletedAt)
+		setUUID(dest[10], m.row.WorkspaceID)
 		return nil
 	case 4:
 		if m.secRow == nil {
@@ -147,7 +227,7 @@ func (r *noteRows) Scan(dest ...interface{}) error {
 		return errors.New("scan called out of range")
 	}
 	item := r.items[r.idx-1]
-	if len(dest) != 11 {
+	if len(dest) != 15 {
 		return errors.New("unexpected scan args")
 	}
 	setUUID(dest[0], item.ID)
@@ -157,14 +237,63 @@ func (r *noteRows) Scan(dest ...interface{}) error {
 	setString(dest[4], item.Status)
 	setTimestamptz(dest[5], item.CreatedAt)
 	setTimestamptz(dest[6], item.UpdatedAt)
-	setString(dest[7], item.TemplateName)
-	setString(dest[8], item.FirstName)
-	setString(dest[9], item.LastName)
-	setText(dest[10], item.OwnerThumbnail)
+	setInt32(dest[7], item.Version)
+	setInt32(dest[8], item.TemplateVersion)
+	setTimestamptz(dest[9], item.DeletedAt)
+	setUUID(dest[10], item.WorkspaceID)
+	setString(dest[11], item.TemplateName)
+	setString(dest[12], item.FirstName)
+	setString(dest[13], item.LastName)
+	setText(dest[14], item.OwnerThumbnail)
 	return nil
 }
 func (r *noteRows) Conn() *pgx.Conn { return nil }
 
+type searchRows struct {
+	items []*generated.SearchNotesRow
+	idx   int
+	err   error
+}
+
+func (r *searchRows) Close()                                       {}
+func (r *searchRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *searchRows) Err() error                                   { return r.err }
+func (r *searchRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *searchRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *searchRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *searchRows) RawValues() [][]byte                          { return nil }
+func (r *searchRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	item := r.items[r.idx-1]
+	if len(dest) != 18 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], item.ID)
+	setString(dest[1], item.Title)
+	setUUID(dest[2], item.TemplateID)
+	setUUID(dest[3], item.OwnerID)
+	setString(dest[4], item.Status)
+	setTimestamptz(dest[5], item.CreatedAt)
+	setTimestamptz(dest[6], item.UpdatedAt)
+	setInt32(dest[7], item.Version)
+	setInt32(dest[8], item.TemplateVersion)
+	setTimestamptz(dest[9], item.DeletedAt)
+	setUUID(dest[10], item.WorkspaceID)
+	setString(dest[11], item.TemplateName)
+	setString(dest[12], item.FirstName)
+	setString(dest[13], item.LastName)
+	setText(dest[14], item.OwnerThumbnail)
+	setString(dest[15], item.MatchedContent)
+	setString(dest[16], item.MatchedLabel)
+	if d, ok := dest[17].(*float64); ok {
+		*d = item.Rank
+	}
+	return nil
+}
+func (r *searchRows) Conn() *pgx.Conn { return nil }
+
 type sectionRows struct {
 	items []*generated.Section
 	idx   int
@@ -183,7 +312,7 @@ func (r *sectionRows) Scan(dest ...interface{}) error {
 		return errors.New("scan called out of range")
 	}
 	item := r.items[r.idx-1]
-	if len(dest) != 7 {
+	if len(dest) != 9 {
 		return errors.New("unexpected scan args")
 	}
 	setUUID(dest[0], item.ID)
@@ -193,10 +322,62 @@ func (r *sectionRows) Scan(dest ...interface{}) error {
 	setString(dest[4], "")      // label
 	setInt32(dest[5], int32(0)) // order
 	setBool(dest[6], false)     // is_required
+	setString(dest[7], "text")  // type
+	setStrings(dest[8], nil)    // options
 	return nil
 }
 func (r *sectionRows) Conn() *pgx.Conn { return nil }
 
+type tagRows struct {
+	items []string
+	idx   int
+}
+
+func (r *tagRows) Close()                                       {}
+func (r *tagRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *tagRows) Err() error                                   { return nil }
+func (r *tagRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *tagRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *tagRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *tagRows) RawValues() [][]byte                          { return nil }
+func (r *tagRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	if len(dest) != 1 {
+		return errors.New("unexpected scan args")
+	}
+	setString(dest[0], r.items[r.idx-1])
+	return nil
+}
+func (r *tagRows) Conn() *pgx.Conn { return nil }
+
+type tagCountRows struct {
+	items []*generated.ListTagCountsRow
+	idx   int
+}
+
+func (r *tagCountRows) Close()                                       {}
+func (r *tagCountRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *tagCountRows) Err() error                                   { return nil }
+func (r *tagCountRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *tagCountRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *tagCountRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *tagCountRows) RawValues() [][]byte                          { return nil }
+func (r *tagCountRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	if len(dest) != 2 {
+		return errors.New("unexpected scan args")
+	}
+	item := r.items[r.idx-1]
+	setString(dest[0], item.Tag)
+	setInt32(dest[1], item.NoteCount)
+	return nil
+}
+func (r *tagCountRows) Conn() *pgx.Conn { return nil }
+
 func setInt32(ptr interface{}, v int32) {
 	if dest, ok := ptr.(*int32); ok {
 		*dest = v
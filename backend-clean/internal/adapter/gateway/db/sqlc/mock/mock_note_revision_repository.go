@@ -0,0 +1,141 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// NoteRevisionDBTX is a lightweight mock for sqlc.DBTX used in note revision repository tests.
+type NoteRevisionDBTX struct {
+	row       *generated.NoteRevision
+	rowErr    error
+	execErr   error
+	queryErr  error
+	revisions []*generated.NoteRevision
+	sections  []*generated.SectionRevision
+}
+
+// NewNoteRevisionDBTX creates a mock DBTX that always returns the given row/err.
+func NewNoteRevisionDBTX(row *generated.NoteRevision, rowErr, execErr error) *NoteRevisionDBTX {
+	return &NoteRevisionDBTX{row: row, rowErr: rowErr, execErr: execErr}
+}
+
+// WithList allows configuring rows returned by ListNoteRevisions/ListSectionRevisions.
+func (m *NoteRevisionDBTX) WithList(revisions []*generated.NoteRevision, sections []*generated.SectionRevision, queryErr error) *NoteRevisionDBTX {
+	m.revisions = revisions
+	m.sections = sections
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *NoteRevisionDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, m.execErr
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *NoteRevisionDBTX) Query(_ context.Context, sql string, _ ...interface{}) (pgx.Rows, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	// Both list queries take one arg, so tell them apart by table name.
+	if strings.Contains(sql, "FROM section_revisions") {
+		return &sectionRevisionRows{items: m.sections}, nil
+	}
+	return &noteRevisionRows{items: m.revisions}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *NoteRevisionDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
+	return &noteRevisionRow{row: m.row, err: m.rowErr}
+}
+
+type noteRevisionRow struct {
+	row *generated.NoteRevision
+	err error
+}
+
+func (m *noteRevisionRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.row == nil {
+		return errors.New("row is nil")
+	}
+	return scanNoteRevision(m.row, dest)
+}
+
+func (m *noteRevisionRow) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (m *noteRevisionRow) RawValues() [][]byte                          { return nil }
+func (m *noteRevisionRow) Value(_ int) (interface{}, error)             { return nil, nil }
+func (m *noteRevisionRow) Err() error                                   { return m.err }
+
+type noteRevisionRows struct {
+	items []*generated.NoteRevision
+	idx   int
+}
+
+func (r *noteRevisionRows) Close()                                       {}
+func (r *noteRevisionRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *noteRevisionRows) Err() error                                   { return nil }
+func (r *noteRevisionRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *noteRevisionRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *noteRevisionRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *noteRevisionRows) RawValues() [][]byte                          { return nil }
+func (r *noteRevisionRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	return scanNoteRevision(r.items[r.idx-1], dest)
+}
+func (r *noteRevisionRows) Conn() *pgx.Conn { return nil }
+
+type sectionRevisionRows struct {
+	items []*generated.SectionRevision
+	idx   int
+}
+
+func (r *sectionRevisionRows) Close()                                       {}
+func (r *sectionRevisionRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *sectionRevisionRows) Err() error                                   { return nil }
+func (r *sectionRevisionRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *sectionRevisionRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *sectionRevisionRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *sectionRevisionRows) RawValues() [][]byte                          { return nil }
+func (r *sectionRevisionRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	item := r.items[r.idx-1]
+	if len(dest) != 6 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], item.ID)
+	setUUID(dest[1], item.NoteRevisionID)
+	setUUID(dest[2], item.FieldID)
+	setString(dest[3], item.FieldLabel)
+	setInt32(dest[4], item.FieldOrder)
+	setString(dest[5], item.Content)
+	return nil
+}
+func (r *sectionRevisionRows) Conn() *pgx.Conn { return nil }
+
+func scanNoteRevision(row *generated.NoteRevision, dest []interface{}) error {
+	if len(dest) != 7 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], row.ID)
+	setUUID(dest[1], row.NoteID)
+	setInt32(dest[2], row.Revision)
+	setString(dest[3], row.Title)
+	setString(dest[4], row.Status)
+	setUUID(dest[5], row.ActorID)
+	setTimestamptz(dest[6], row.CreatedAt)
+	return nil
+}
@@ -0,0 +1,114 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// OutboxDBTX is a lightweight mock for sqlc.DBTX used in outbox repository tests.
+type OutboxDBTX struct {
+	row      *generated.Outbox
+	rowErr   error
+	execErr  error
+	queryErr error
+	rows     []*generated.Outbox
+	// Inserted holds the arguments of every InsertOutboxEvent call.
+	Inserted [][]interface{}
+	// Executed holds the arguments of every Exec call.
+	Executed [][]interface{}
+}
+
+// NewOutboxDBTX creates a mock DBTX that always returns the given row/err.
+func NewOutboxDBTX(row *generated.Outbox, rowErr, execErr error) *OutboxDBTX {
+	return &OutboxDBTX{row: row, rowErr: rowErr, execErr: execErr}
+}
+
+// WithList allows configuring rows returned by ClaimPendingOutboxEvents.
+func (m *OutboxDBTX) WithList(rows []*generated.Outbox, queryErr error) *OutboxDBTX {
+	m.rows = rows
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *OutboxDBTX) Exec(_ context.Context, _ string, args ...interface{}) (pgconn.CommandTag, error) {
+	m.Executed = append(m.Executed, args)
+	return pgconn.CommandTag{}, m.execErr
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *OutboxDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx.Rows, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &outboxRows{items: m.rows}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *OutboxDBTX) QueryRow(_ context.Context, _ string, args ...interface{}) pgx.Row {
+	m.Inserted = append(m.Inserted, args)
+	return &outboxRow{row: m.row, err: m.rowErr}
+}
+
+type outboxRow struct {
+	row *generated.Outbox
+	err error
+}
+
+func (m *outboxRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.row == nil {
+		return errors.New("row is nil")
+	}
+	return scanOutbox(m.row, dest)
+}
+
+type outboxRows struct {
+	items []*generated.Outbox
+	idx   int
+}
+
+func (r *outboxRows) Close()                                       {}
+func (r *outboxRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *outboxRows) Err() error                                   { return nil }
+func (r *outboxRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *outboxRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *outboxRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *outboxRows) RawValues() [][]byte                          { return nil }
+func (r *outboxRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	return scanOutbox(r.items[r.idx-1], dest)
+}
+func (r *outboxRows) Conn() *pgx.Conn { return nil }
+
+func scanOutbox(row *generated.Outbox, dest []interface{}) error {
+	if len(dest) != 12 {
+		return errors.New("unexpected scan args")
+	}
+	if d, ok := dest[0].(*int64); ok {
+		*d = row.ID
+	}
+	setUUID(dest[1], row.EventID)
+	setString(dest[2], row.EventType)
+	setString(dest[3], row.AggregateType)
+	setString(dest[4], row.AggregateID)
+	setString(dest[5], row.ActorID)
+	if d, ok := dest[6].(*[]byte); ok {
+		*d = row.Payload
+	}
+	setTimestamptz(dest[7], row.OccurredAt)
+	setInt32(dest[8], row.Attempts)
+	setString(dest[9], row.LastError)
+	setTimestamptz(dest[10], row.NextAttemptAt)
+	setTimestamptz(dest[11], row.DeliveredAt)
+	return nil
+}
@@ -0,0 +1,80 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// RateLimitDBTX is a lightweight mock for sqlc.DBTX used in rate limit store tests.
+type RateLimitDBTX struct {
+	row     *generated.RateLimitBucket
+	rowErr  error
+	execErr error
+	// affected is consumed by successive Exec calls; once empty, Exec reports one row.
+	affected []int64
+	// ExecArgs holds the arguments of every Exec call.
+	ExecArgs [][]interface{}
+}
+
+// NewRateLimitDBTX creates a mock DBTX returning row (or rowErr) for GetRateLimitBucket.
+func NewRateLimitDBTX(row *generated.RateLimitBucket, rowErr, execErr error, affected ...int64) *RateLimitDBTX {
+	return &RateLimitDBTX{row: row, rowErr: rowErr, execErr: execErr, affected: affected}
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *RateLimitDBTX) Exec(_ context.Context, _ string, args ...interface{}) (pgconn.CommandTag, error) {
+	m.ExecArgs = append(m.ExecArgs, args)
+	if m.execErr != nil {
+		return pgconn.CommandTag{}, m.execErr
+	}
+	n := int64(1)
+	if len(m.affected) > 0 {
+		n, m.affected = m.affected[0], m.affected[1:]
+	}
+	if n > 0 {
+		return pgconn.NewCommandTag("UPDATE 1"), nil
+	}
+	return pgconn.NewCommandTag("UPDATE 0"), nil
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *RateLimitDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx.Rows, error) {
+	return nil, errors.New("not implemented")
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *RateLimitDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
+	return &rateLimitRow{row: m.row, err: m.rowErr}
+}
+
+type rateLimitRow struct {
+	row *generated.RateLimitBucket
+	err error
+}
+
+func (m *rateLimitRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.row == nil {
+		return pgx.ErrNoRows
+	}
+	if len(dest) != 5 {
+		return errors.New("unexpected scan args")
+	}
+	setString(dest[0], m.row.Key)
+	if d, ok := dest[1].(*float64); ok {
+		*d = m.row.Tokens
+	}
+	if d, ok := dest[2].(*int64); ok {
+		*d = m.row.Version
+	}
+	setTimestamptz(dest[3], m.row.UpdatedAt)
+	setTimestamptz(dest[4], m.row.FullAt)
+	return nil
+}
@@ -4,6 +4,7 @@ package mock
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -19,6 +20,8 @@ type TemplateDBTX struct {
 	rowErr      error
 	execErr     error
 	QueryErr    error
+	// ExecRows is the rows affected reported by Exec, as read by :execrows queries.
+	ExecRows int64
 }
 
 // NewTemplateDBTX creates a mock DBTX that always returns the given row/err.
@@ -33,7 +36,7 @@ func NewTemplateDBTX(templateRow *generated.Template, detailRow *generated.GetTe
 
 // Exec implements sqlc.DBTX interface.
 func (m *TemplateDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
-	return pgconn.CommandTag{}, m.execErr
+	return pgconn.NewCommandTag(fmt.Sprintf("UPDATE %d", m.ExecRows)), m.execErr
 }
 
 // Query implements sqlc.DBTX interface.
@@ -46,7 +49,12 @@ func (m *TemplateDBTX) Query(_ context.Context, _ string, _ ...interface{}) (pgx
 
 // QueryRow implements sqlc.DBTX interface.
 func (m *TemplateDBTX) QueryRow(_ context.Context, _ string, _ ...interface{}) pgx.Row {
-	return &templateRow{templateRow: m.templateRow, detailRow: m.detailRow, fieldRow: m.FieldRow, err: m.rowErr}
+	return &templateRow{
+		templateRow: m.templateRow,
+		detailRow:   m.detailRow,
+		fieldRow:    m.FieldRow,
+		err:         m.rowErr,
+	}
 }
 
 type templateRow struct {
@@ -60,8 +68,8 @@ func (m *templateRow) Scan(dest ...interface{}) error {
 	if m.err != nil {
 		return m.err
 	}
-	switch len(dest) {
-	case 5: // Field
+	switch {
+	case len(dest) == 8: // Field
 		if m.fieldRow == nil {
 			return errors.New("fieldRow is nil")
 		}
@@ -70,20 +78,30 @@ func (m *templateRow) Scan(dest ...interface{}) error {
 		setString(dest[2], m.fieldRow.Label)
 		setInt32Field(dest[3], m.fieldRow.Order)
 		setBool(dest[4], m.fieldRow.IsRequired)
-	case 4: // Template
+		setInt32Field(dest[5], m.fieldRow.Version)
+		setString(dest[6], m.fieldRow.Type)
+		setStrings(dest[7], m.fieldRow.Options)
+	case len(dest) == 7: // Template
 		setUUID(dest[0], m.templateRow.ID)
 		setString(dest[1], m.templateRow.Name)
 		setUUID(dest[2], m.templateRow.OwnerID)
 		setTimestamptz(dest[3], m.templateRow.UpdatedAt)
-	case 8: // GetTemplateByIDRow
+		setInt32Field(dest[4], m.templateRow.Version)
+		setTimestamptz(dest[5], m.templateRow.DeletedAt)
+		setUUID(dest[6], m.templateRow.WorkspaceID)
+	case len(dest) == 12: // GetTemplateByIDRow, also GetTrashedTemplateByIDRow
 		setUUID(dest[0], m.detailRow.ID)
 		setString(dest[1], m.detailRow.Name)
 		setUUID(dest[2], m.detailRow.OwnerID)
 		setTimestamptz(dest[3], m.detailRow.UpdatedAt)
-		setString(dest[4], m.detailRow.OwnerFirstName)
-		setString(dest[5], m.detailRow.OwnerLastName)
-		setText(dest[6], m.detailRow.OwnerThumbnail)
-		setBool(dest[7], m.detailRow.IsUsed)
+		setInt32Field(dest[4], m.detailRow.Version)
+		setTimestamptz(dest[5], m.detailRow.DeletedAt)
+		setUUID(dest[6], m.detailRow.WorkspaceID)
+		setString(dest[7], m.detailRow.OwnerFirstName)
+		setString(dest[8], m.detailRow.OwnerLastName)
+		setText(dest[9], m.detailRow.OwnerThumbnail)
+		setBool(dest[10], m.detailRow.IsUsed)
+		setBool(dest[11], m.detailRow.IsUsedInTrash)
 	default:
 		return errors.New("unexpected scan args")
 	}
@@ -107,6 +125,12 @@ func (r *emptyRows) RawValues() [][]byte                          { return nil }
 func (r *emptyRows) Scan(_ ...interface{}) error                  { return nil }
 func (r *emptyRows) Conn() *pgx.Conn                              { return nil }
 
+func setStrings(ptr interface{}, v []string) {
+	if dest, ok := ptr.(*[]string); ok {
+		*dest = v
+	}
+}
+
 func setInt32Field(ptr interface{}, v int32) {
 	if dest, ok := ptr.(*int32); ok {
 		*dest = v
@@ -0,0 +1,182 @@
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// WebhookDBTX is a lightweight mock for sqlc.DBTX used in webhook repository tests.
+// Rows are told apart by their column count: webhooks have 8 columns, deliveries 13
+// and claimed deliveries 15 (the delivery plus the webhook's url and secret).
+type WebhookDBTX struct {
+	webhook    *generated.Webhook
+	delivery   *generated.WebhookDelivery
+	rowErr     error
+	execErr    error
+	affected   int64
+	queryErr   error
+	webhooks   []*generated.Webhook
+	deliveries []*generated.WebhookDelivery
+	claimed    []*generated.ClaimDueWebhookDeliveriesRow
+	// Args holds the arguments of every QueryRow, Query and Exec call.
+	Args [][]interface{}
+}
+
+// NewWebhookDBTX creates a mock DBTX whose single-row queries return the given rows/err.
+// Exec reports affected rows for DeleteWebhook.
+func NewWebhookDBTX(webhook *generated.Webhook, delivery *generated.WebhookDelivery, rowErr, execErr error, affected int64) *WebhookDBTX {
+	return &WebhookDBTX{webhook: webhook, delivery: delivery, rowErr: rowErr, execErr: execErr, affected: affected}
+}
+
+// WithList allows configuring rows returned by ListWebhooksByOwner, ListWebhookDeliveries
+// and ClaimDueWebhookDeliveries.
+func (m *WebhookDBTX) WithList(webhooks []*generated.Webhook, deliveries []*generated.WebhookDelivery, claimed []*generated.ClaimDueWebhookDeliveriesRow, queryErr error) *WebhookDBTX {
+	m.webhooks = webhooks
+	m.deliveries = deliveries
+	m.claimed = claimed
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *WebhookDBTX) Exec(_ context.Context, _ string, args ...interface{}) (pgconn.CommandTag, error) {
+	m.Args = append(m.Args, args)
+	if m.execErr != nil {
+		return pgconn.CommandTag{}, m.execErr
+	}
+	if m.affected > 0 {
+		return pgconn.NewCommandTag("DELETE 1"), nil
+	}
+	return pgconn.NewCommandTag("DELETE 0"), nil
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *WebhookDBTX) Query(_ context.Context, _ string, args ...interface{}) (pgx.Rows, error) {
+	m.Args = append(m.Args, args)
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	items := make([]func([]interface{}) error, 0, len(m.webhooks)+len(m.deliveries)+len(m.claimed))
+	for _, w := range m.webhooks {
+		items = append(items, func(dest []interface{}) error { return scanWebhook(w, dest) })
+	}
+	for _, d := range m.deliveries {
+		items = append(items, func(dest []interface{}) error { return scanDelivery(d, dest) })
+	}
+	for _, c := range m.claimed {
+		items = append(items, func(dest []interface{}) error { return scanClaimedDelivery(c, dest) })
+	}
+	return &webhookRows{items: items}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *WebhookDBTX) QueryRow(_ context.Context, _ string, args ...interface{}) pgx.Row {
+	m.Args = append(m.Args, args)
+	return &webhookRow{m: m}
+}
+
+type webhookRow struct {
+	m *WebhookDBTX
+}
+
+func (r *webhookRow) Scan(dest ...interface{}) error {
+	if r.m.rowErr != nil {
+		return r.m.rowErr
+	}
+	switch {
+	case len(dest) == 8 && r.m.webhook != nil:
+		return scanWebhook(r.m.webhook, dest)
+	case len(dest) == 13 && r.m.delivery != nil:
+		return scanDelivery(r.m.delivery, dest)
+	default:
+		return errors.New("row is nil")
+	}
+}
+
+type webhookRows struct {
+	items []func([]interface{}) error
+	idx   int
+}
+
+func (r *webhookRows) Close()                                       {}
+func (r *webhookRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *webhookRows) Err() error                                   { return nil }
+func (r *webhookRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *webhookRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *webhookRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *webhookRows) RawValues() [][]byte                          { return nil }
+func (r *webhookRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	return r.items[r.idx-1](dest)
+}
+func (r *webhookRows) Conn() *pgx.Conn { return nil }
+
+func scanWebhook(row *generated.Webhook, dest []interface{}) error {
+	if len(dest) != 8 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], row.ID)
+	setUUID(dest[1], row.OwnerID)
+	setString(dest[2], row.Url)
+	setString(dest[3], row.Secret)
+	setStrings(dest[4], row.Events)
+	setBool(dest[5], row.IsActive)
+	setTimestamptz(dest[6], row.CreatedAt)
+	setTimestamptz(dest[7], row.UpdatedAt)
+	return nil
+}
+
+func scanDelivery(row *generated.WebhookDelivery, dest []interface{}) error {
+	if len(dest) != 13 && len(dest) != 15 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], row.ID)
+	setUUID(dest[1], row.WebhookID)
+	setUUID(dest[2], row.EventID)
+	setString(dest[3], row.EventType)
+	if d, ok := dest[4].(*[]byte); ok {
+		*d = row.Payload
+	}
+	setString(dest[5], row.Status)
+	setInt32(dest[6], row.Attempts)
+	setTimestamptz(dest[7], row.NextAttemptAt)
+	setInt32(dest[8], row.LastStatusCode)
+	setString(dest[9], row.LastError)
+	setUUID(dest[10], row.RedeliveryOf)
+	setTimestamptz(dest[11], row.CreatedAt)
+	setTimestamptz(dest[12], row.DeliveredAt)
+	return nil
+}
+
+func scanClaimedDelivery(row *generated.ClaimDueWebhookDeliveriesRow, dest []interface{}) error {
+	if len(dest) != 15 {
+		return errors.New("unexpected scan args")
+	}
+	if err := scanDelivery(&generated.WebhookDelivery{
+		ID:             row.ID,
+		WebhookID:      row.WebhookID,
+		EventID:        row.EventID,
+		EventType:      row.EventType,
+		Payload:        row.Payload,
+		Status:         row.Status,
+		Attempts:       row.Attempts,
+		NextAttemptAt:  row.NextAttemptAt,
+		LastStatusCode: row.LastStatusCode,
+		LastError:      row.LastError,
+		RedeliveryOf:   row.RedeliveryOf,
+		CreatedAt:      row.CreatedAt,
+		DeliveredAt:    row.DeliveredAt,
+	}, dest); err != nil {
+		return err
+	}
+	setString(dest[13], row.Url)
+	setString(dest[14], row.Secret)
+	return nil
+}
@@ -0,0 +1,278 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+)
+
+// WorkspaceDBTX is a lightweight mock for sqlc.DBTX used in workspace repository tests.
+type WorkspaceDBTX struct {
+	workspace   *generated.Workspace
+	member      *generated.WorkspaceMember
+	hasContents bool
+	rowErr      error
+	execErr     error
+	affected    int64
+	queryErr    error
+	workspaces  []*generated.ListWorkspacesByAccountRow
+	members     []*generated.ListWorkspaceMembersRow
+	// Args holds the arguments of the last QueryRow call.
+	Args []interface{}
+}
+
+// NewWorkspaceDBTX creates a mock DBTX. QueryRow returns the workspace or the member,
+// depending on the columns scanned. Exec reports affected rows for the delete queries.
+func NewWorkspaceDBTX(w *generated.Workspace, member *generated.WorkspaceMember, rowErr, execErr error, affected int64) *WorkspaceDBTX {
+	return &WorkspaceDBTX{workspace: w, member: member, rowErr: rowErr, execErr: execErr, affected: affected}
+}
+
+// WithHasContents configures the result of WorkspaceHasContents.
+func (m *WorkspaceDBTX) WithHasContents(hasContents bool) *WorkspaceDBTX {
+	m.hasContents = hasContents
+	return m
+}
+
+// WithLists configures rows returned by ListWorkspacesByAccount and ListWorkspaceMembers.
+func (m *WorkspaceDBTX) WithLists(workspaces []*generated.ListWorkspacesByAccountRow, members []*generated.ListWorkspaceMembersRow, queryErr error) *WorkspaceDBTX {
+	m.workspaces = workspaces
+	m.members = members
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *WorkspaceDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	if m.execErr != nil {
+		return pgconn.CommandTag{}, m.execErr
+	}
+	if m.affected > 0 {
+		return pgconn.NewCommandTag("DELETE 1"), nil
+	}
+	return pgconn.NewCommandTag("DELETE 0"), nil
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *WorkspaceDBTX) Query(_ context.Context, sql string, _ ...interface{}) (pgx.Rows, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	if strings.HasPrefix(sql, "-- name: ListWorkspaceMembers ") {
+		return &workspaceMemberRows{items: m.members}, nil
+	}
+	return &workspaceRows{items: m.workspaces}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *WorkspaceDBTX) QueryRow(_ context.Context, _ string, args ...interface{}) pgx.Row {
+	m.Args = args
+	return &workspaceRow{workspace: m.workspace, member: m.member, hasContents: m.hasContents, err: m.rowErr}
+}
+
+type workspaceRow struct {
+	workspace   *generated.Workspace
+	member      *generated.WorkspaceMember
+	hasContents bool
+	err         error
+}
+
+func (m *workspaceRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	switch len(dest) {
+	case 1: // WorkspaceHasContents
+		setBool(dest[0], m.hasContents)
+	case 4: // Workspace
+		if m.workspace == nil {
+			return errors.New("workspace is nil")
+		}
+		setUUID(dest[0], m.workspace.ID)
+		setString(dest[1], m.workspace.Name)
+		setTimestamptz(dest[2], m.workspace.CreatedAt)
+		setTimestamptz(dest[3], m.workspace.UpdatedAt)
+	case 5: // WorkspaceMember
+		if m.member == nil {
+			return errors.New("member is nil")
+		}
+		setUUID(dest[0], m.member.WorkspaceID)
+		setUUID(dest[1], m.member.AccountID)
+		setString(dest[2], m.member.Role)
+		setTimestamptz(dest[3], m.member.CreatedAt)
+		setTimestamptz(dest[4], m.member.UpdatedAt)
+	default:
+		return errors.New("unexpected scan args")
+	}
+	return nil
+}
+
+type workspaceRows struct {
+	items []*generated.ListWorkspacesByAccountRow
+	idx   int
+}
+
+func (r *workspaceRows) Close()                                       {}
+func (r *workspaceRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *workspaceRows) Err() error                                   { return nil }
+func (r *workspaceRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *workspaceRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *workspaceRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *workspaceRows) RawValues() [][]byte                          { return nil }
+func (r *workspaceRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	if len(dest) != 5 {
+		return errors.New("unexpected scan args")
+	}
+	item := r.items[r.idx-1]
+	setUUID(dest[0], item.ID)
+	setString(dest[1], item.Name)
+	setTimestamptz(dest[2], item.CreatedAt)
+	setTimestamptz(dest[3], item.UpdatedAt)
+	setString(dest[4], item.Role)
+	return nil
+}
+func (r *workspaceRows) Conn() *pgx.Conn { return nil }
+
+type workspaceMemberRows struct {
+	items []*generated.ListWorkspaceMembersRow
+	idx   int
+}
+
+func (r *workspaceMemberRows) Close()                                       {}
+func (r *workspaceMemberRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *workspaceMemberRows) Err() error                                   { return nil }
+func (r *workspaceMemberRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *workspaceMemberRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *workspaceMemberRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *workspaceMemberRows) RawValues() [][]byte                          { return nil }
+func (r *workspaceMemberRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	if len(dest) != 9 {
+		return errors.New("unexpected scan args")
+	}
+	item := r.items[r.idx-1]
+	setUUID(dest[0], item.WorkspaceID)
+	setUUID(dest[1], item.AccountID)
+	setString(dest[2], item.Role)
+	setTimestamptz(dest[3], item.CreatedAt)
+	setTimestamptz(dest[4], item.UpdatedAt)
+	setString(dest[5], item.Email)
+	setString(dest[6], item.FirstName)
+	setString(dest[7], item.LastName)
+	setText(dest[8], item.Thumbnail)
+	return nil
+}
+func (r *workspaceMemberRows) Conn() *pgx.Conn { return nil }
+
+// WorkspaceInviteDBTX is a lightweight mock for sqlc.DBTX used in workspace invite repository tests.
+type WorkspaceInviteDBTX struct {
+	row      *generated.GetWorkspaceInviteByIDRow
+	rowErr   error
+	execErr  error
+	affected int64
+	queryErr error
+	rows     []*generated.GetWorkspaceInviteByIDRow
+	// Args holds the arguments of the last QueryRow or Query call.
+	Args []interface{}
+}
+
+// NewWorkspaceInviteDBTX creates a mock DBTX that always returns the given row/err.
+// Exec reports affected rows for DeleteWorkspaceInvite.
+func NewWorkspaceInviteDBTX(row *generated.GetWorkspaceInviteByIDRow, rowErr, execErr error, affected int64) *WorkspaceInviteDBTX {
+	return &WorkspaceInviteDBTX{row: row, rowErr: rowErr, execErr: execErr, affected: affected}
+}
+
+// WithList allows configuring rows returned by the invite list queries.
+func (m *WorkspaceInviteDBTX) WithList(rows []*generated.GetWorkspaceInviteByIDRow, queryErr error) *WorkspaceInviteDBTX {
+	m.rows = rows
+	m.queryErr = queryErr
+	return m
+}
+
+// Exec implements sqlc.DBTX interface.
+func (m *WorkspaceInviteDBTX) Exec(_ context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	if m.execErr != nil {
+		return pgconn.CommandTag{}, m.execErr
+	}
+	if m.affected > 0 {
+		return pgconn.NewCommandTag("DELETE 1"), nil
+	}
+	return pgconn.NewCommandTag("DELETE 0"), nil
+}
+
+// Query implements sqlc.DBTX interface.
+func (m *WorkspaceInviteDBTX) Query(_ context.Context, _ string, args ...interface{}) (pgx.Rows, error) {
+	m.Args = args
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return &inviteRows{items: m.rows}, nil
+}
+
+// QueryRow implements sqlc.DBTX interface.
+func (m *WorkspaceInviteDBTX) QueryRow(_ context.Context, _ string, args ...interface{}) pgx.Row {
+	m.Args = args
+	return &inviteRow{row: m.row, err: m.rowErr}
+}
+
+type inviteRow struct {
+	row *generated.GetWorkspaceInviteByIDRow
+	err error
+}
+
+func (m *inviteRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.row == nil {
+		return errors.New("row is nil")
+	}
+	return scanInvite(m.row, dest)
+}
+
+type inviteRows struct {
+	items []*generated.GetWorkspaceInviteByIDRow
+	idx   int
+}
+
+func (r *inviteRows) Close()                                       {}
+func (r *inviteRows) Next() bool                                   { r.idx++; return r.idx <= len(r.items) }
+func (r *inviteRows) Err() error                                   { return nil }
+func (r *inviteRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *inviteRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *inviteRows) Values() ([]interface{}, error)               { return nil, nil }
+func (r *inviteRows) RawValues() [][]byte                          { return nil }
+func (r *inviteRows) Scan(dest ...interface{}) error {
+	if r.idx == 0 || r.idx > len(r.items) {
+		return errors.New("scan called out of range")
+	}
+	return scanInvite(r.items[r.idx-1], dest)
+}
+func (r *inviteRows) Conn() *pgx.Conn { return nil }
+
+// scanInvite fills the invite columns, and the workspace name when asked for it.
+func scanInvite(row *generated.GetWorkspaceInviteByIDRow, dest []interface{}) error {
+	if len(dest) != 7 && len(dest) != 8 {
+		return errors.New("unexpected scan args")
+	}
+	setUUID(dest[0], row.ID)
+	setUUID(dest[1], row.WorkspaceID)
+	setString(dest[2], row.Email)
+	setString(dest[3], row.Role)
+	setUUID(dest[4], row.InvitedBy)
+	setTimestamptz(dest[5], row.CreatedAt)
+	setTimestamptz(dest[6], row.UpdatedAt)
+	if len(dest) == 8 {
+		setString(dest[7], row.WorkspaceName)
+	}
+	return nil
+}
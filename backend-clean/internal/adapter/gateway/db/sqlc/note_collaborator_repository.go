@@ -0,0 +1,123 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCollaboratorRepository implements note collaborator persistence.
+type NoteCollaboratorRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.NoteCollaboratorRepository = (*NoteCollaboratorRepository)(nil)
+
+// NewNoteCollaboratorRepository creates NoteCollaboratorRepository.
+func NewNoteCollaboratorRepository(pool *pgxpool.Pool) *NoteCollaboratorRepository {
+	return &NoteCollaboratorRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// List returns the note's collaborators with their profiles, oldest first.
+func (r *NoteCollaboratorRepository) List(ctx context.Context, noteID string) ([]note.CollaboratorWithMeta, error) {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListNoteCollaborators(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	collaborators := make([]note.CollaboratorWithMeta, 0, len(rows))
+	for _, row := range rows {
+		var thumbnail *string
+		if row.Thumbnail.Valid {
+			s := row.Thumbnail.String
+			thumbnail = &s
+		}
+		collaborators = append(collaborators, note.CollaboratorWithMeta{
+			Collaborator: toCollaborator(row.NoteID, row.AccountID, row.Role, row.CreatedAt, row.UpdatedAt),
+			FirstName:    row.FirstName,
+			LastName:     row.LastName,
+			Thumbnail:    thumbnail,
+		})
+	}
+	return collaborators, nil
+}
+
+// Upsert adds a collaborator or changes the role of an existing one.
+func (r *NoteCollaboratorRepository) Upsert(ctx context.Context, c note.Collaborator) (*note.Collaborator, error) {
+	noteID, err := toUUID(c.NoteID)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := toUUID(c.AccountID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).UpsertNoteCollaborator(ctx, &generated.UpsertNoteCollaboratorParams{
+		NoteID:    noteID,
+		AccountID: accountID,
+		Role:      string(c.Role),
+	})
+	if err != nil {
+		return nil, err
+	}
+	collaborator := toCollaborator(row.NoteID, row.AccountID, row.Role, row.CreatedAt, row.UpdatedAt)
+	return &collaborator, nil
+}
+
+// Delete removes an account from the note's collaborators.
+func (r *NoteCollaboratorRepository) Delete(ctx context.Context, noteID, accountID string) error {
+	pgNoteID, err := toUUID(noteID)
+	if err != nil {
+		return err
+	}
+	pgAccountID, err := toUUID(accountID)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	n, err := queriesForContext(ctx, r.queries).DeleteNoteCollaborator(ctx, &generated.DeleteNoteCollaboratorParams{
+		NoteID:    pgNoteID,
+		AccountID: pgAccountID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+func listCollaborators(ctx context.Context, q *generated.Queries, noteID pgtype.UUID) ([]note.Collaborator, error) {
+	rows, err := q.ListNoteCollaborators(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	collaborators := make([]note.Collaborator, 0, len(rows))
+	for _, row := range rows {
+		collaborators = append(collaborators, toCollaborator(row.NoteID, row.AccountID, row.Role, row.CreatedAt, row.UpdatedAt))
+	}
+	return collaborators, nil
+}
+
+func toCollaborator(noteID, accountID pgtype.UUID, role string, createdAt, updatedAt pgtype.Timestamptz) note.Collaborator {
+	return note.Collaborator{
+		NoteID:    uuidToString(noteID),
+		AccountID: uuidToString(accountID),
+		Role:      note.Role(role),
+		CreatedAt: timestamptzToTime(createdAt),
+		UpdatedAt: timestamptzToTime(updatedAt),
+	}
+}
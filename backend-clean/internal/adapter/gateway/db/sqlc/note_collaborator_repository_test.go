@@ -0,0 +1,136 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestNoteCollaboratorRepository_List(t *testing.T) {
+	noteID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	rows := []*generated.ListNoteCollaboratorsRow{
+		{NoteID: noteID, AccountID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Role: "editor", FirstName: "Taro", LastName: "Yamada", Thumbnail: pgtype.Text{String: "https://example.com/t.png", Valid: true}},
+		{NoteID: noteID, AccountID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true}, Role: "viewer", FirstName: "Hanako", LastName: "Suzuki"},
+	}
+	tests := []struct {
+		name     string
+		noteID   string
+		queryErr error
+		wantErr  bool
+	}{
+		{name: "[Success] list collaborators", noteID: noteID.String()},
+		{name: "[Fail] invalid uuid", noteID: "bad-uuid", wantErr: true},
+		{name: "[Fail] query error", noteID: noteID.String(), queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &NoteCollaboratorRepository{queries: generated.New(mockdb.NewNoteCollaboratorDBTX(nil, nil, nil, 0).WithList(rows, tt.queryErr))}
+			got, err := repo.List(context.Background(), tt.noteID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 2 || got[0].Collaborator.Role != note.RoleEditor || got[0].Thumbnail == nil || got[1].Thumbnail != nil || got[1].FirstName != "Hanako" {
+				t.Fatalf("unexpected collaborators: %+v", got)
+			}
+		})
+	}
+}
+
+func TestNoteCollaboratorRepository_Upsert(t *testing.T) {
+	now := pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Second), Valid: true}
+	row := &generated.NoteCollaborator{
+		NoteID:    pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		AccountID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Role:      "viewer",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	valid := note.Collaborator{NoteID: row.NoteID.String(), AccountID: row.AccountID.String(), Role: note.RoleViewer}
+	tests := []struct {
+		name         string
+		collaborator note.Collaborator
+		rowErr       error
+		wantErr      error
+	}{
+		{name: "[Success] add collaborator", collaborator: valid},
+		{name: "[Fail] invalid note uuid", collaborator: note.Collaborator{NoteID: "bad-uuid", AccountID: valid.AccountID}, wantErr: errors.New("invalid")},
+		{name: "[Fail] invalid account uuid is not found", collaborator: note.Collaborator{NoteID: valid.NoteID, AccountID: "bad-uuid"}, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] query error", collaborator: valid, rowErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteCollaboratorDBTX(row, tt.rowErr, nil, 0)
+			repo := &NoteCollaboratorRepository{queries: generated.New(mock)}
+			got, err := repo.Upsert(context.Background(), tt.collaborator)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if errors.Is(tt.wantErr, domainerr.ErrNotFound) && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want ErrNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mock.Args[2] != "viewer" || got.AccountID != valid.AccountID || got.Role != note.RoleViewer || got.CreatedAt.IsZero() {
+				t.Fatalf("unexpected upsert: %+v / %+v", mock.Args, got)
+			}
+		})
+	}
+}
+
+func TestNoteCollaboratorRepository_Delete(t *testing.T) {
+	noteID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	accountID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}.String()
+	tests := []struct {
+		name      string
+		noteID    string
+		accountID string
+		affected  int64
+		execErr   error
+		wantErr   error
+	}{
+		{name: "[Success] remove collaborator", noteID: noteID, accountID: accountID, affected: 1},
+		{name: "[Fail] invalid note uuid", noteID: "bad-uuid", accountID: accountID, wantErr: errors.New("invalid")},
+		{name: "[Fail] invalid account uuid is not found", noteID: noteID, accountID: "bad-uuid", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] not a collaborator", noteID: noteID, accountID: accountID, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] exec error", noteID: noteID, accountID: accountID, execErr: errors.New("exec"), wantErr: errors.New("exec")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &NoteCollaboratorRepository{queries: generated.New(mockdb.NewNoteCollaboratorDBTX(nil, nil, tt.execErr, tt.affected))}
+			err := repo.Delete(context.Background(), tt.noteID, tt.accountID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if errors.Is(tt.wantErr, domainerr.ErrNotFound) && !errors.Is(err, domainerr.ErrNotFound) {
+				t.Fatalf("want ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
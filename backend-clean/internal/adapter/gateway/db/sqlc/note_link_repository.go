@@ -0,0 +1,145 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteLinkRepository implements note link persistence.
+type NoteLinkRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.NoteLinkRepository = (*NoteLinkRepository)(nil)
+
+// NewNoteLinkRepository creates NoteLinkRepository.
+func NewNoteLinkRepository(pool *pgxpool.Pool) *NoteLinkRepository {
+	return &NoteLinkRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Create stores a link, returning the existing row when it already exists.
+func (r *NoteLinkRepository) Create(ctx context.Context, link note.Link) (*note.Link, error) {
+	sourceID, err := toUUID(link.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := toUUID(link.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateNoteLink(ctx, &generated.CreateNoteLinkParams{
+		SourceNoteID: sourceID,
+		TargetNoteID: targetID,
+		LinkType:     string(link.Type),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &note.Link{
+		ID:        uuidToString(row.ID),
+		SourceID:  uuidToString(row.SourceNoteID),
+		TargetID:  uuidToString(row.TargetNoteID),
+		Type:      note.LinkType(row.LinkType),
+		CreatedAt: timestamptzToTime(row.CreatedAt),
+	}, nil
+}
+
+// Delete removes a link that starts at sourceID.
+func (r *NoteLinkRepository) Delete(ctx context.Context, sourceID, linkID string) error {
+	pgSourceID, err := toUUID(sourceID)
+	if err != nil {
+		return err
+	}
+	pgLinkID, err := toUUID(linkID)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	n, err := queriesForContext(ctx, r.queries).DeleteNoteLink(ctx, &generated.DeleteNoteLinkParams{
+		ID:           pgLinkID,
+		SourceNoteID: pgSourceID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteByNote removes every link from or to the note.
+func (r *NoteLinkRepository) DeleteByNote(ctx context.Context, noteID string) error {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).DeleteNoteLinksByNote(ctx, pgID)
+}
+
+// ListOutgoing returns links from the note, summarised by their targets.
+func (r *NoteLinkRepository) ListOutgoing(ctx context.Context, noteID string) ([]note.LinkSummary, error) {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return nil, err
+	}
+	return listOutgoingLinks(ctx, queriesForContext(ctx, r.queries), pgID)
+}
+
+// ListBacklinks returns links to the note, summarised by their sources.
+func (r *NoteLinkRepository) ListBacklinks(ctx context.Context, noteID string) ([]note.LinkSummary, error) {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return nil, err
+	}
+	return listBacklinks(ctx, queriesForContext(ctx, r.queries), pgID)
+}
+
+func listOutgoingLinks(ctx context.Context, q *generated.Queries, noteID pgtype.UUID) ([]note.LinkSummary, error) {
+	rows, err := q.ListOutgoingNoteLinks(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	links := make([]note.LinkSummary, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, toLinkSummary(row.ID, row.LinkType, row.NoteID, row.Title, row.Status, row.OwnerID, row.CollaboratorIds))
+	}
+	return links, nil
+}
+
+func listBacklinks(ctx context.Context, q *generated.Queries, noteID pgtype.UUID) ([]note.LinkSummary, error) {
+	rows, err := q.ListBacklinkNoteLinks(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	links := make([]note.LinkSummary, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, toLinkSummary(row.ID, row.LinkType, row.NoteID, row.Title, row.Status, row.OwnerID, row.CollaboratorIds))
+	}
+	return links, nil
+}
+
+func toLinkSummary(linkID pgtype.UUID, linkType string, noteID pgtype.UUID, title, status string, ownerID pgtype.UUID, collaboratorIDs []pgtype.UUID) note.LinkSummary {
+	summary := note.LinkSummary{
+		LinkID:  uuidToString(linkID),
+		Type:    note.LinkType(linkType),
+		NoteID:  uuidToString(noteID),
+		Title:   title,
+		Status:  note.NoteStatus(status),
+		OwnerID: uuidToString(ownerID),
+	}
+	for _, id := range collaboratorIDs {
+		summary.CollaboratorIDs = append(summary.CollaboratorIDs, uuidToString(id))
+	}
+	return summary
+}
@@ -0,0 +1,182 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestNoteLinkRepository_Create(t *testing.T) {
+	row := &generated.NoteLink{
+		ID:           pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		SourceNoteID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		TargetNoteID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		LinkType:     string(note.LinkSupersedes),
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Second), Valid: true},
+	}
+	valid := note.Link{SourceID: row.SourceNoteID.String(), TargetID: row.TargetNoteID.String(), Type: note.LinkSupersedes}
+	tests := []struct {
+		name    string
+		link    note.Link
+		rowErr  error
+		wantErr bool
+	}{
+		{name: "[Success] create link", link: valid},
+		{name: "[Fail] invalid source uuid", link: note.Link{SourceID: "bad-uuid", TargetID: valid.TargetID}, wantErr: true},
+		{name: "[Fail] invalid target uuid", link: note.Link{SourceID: valid.SourceID, TargetID: "bad-uuid"}, wantErr: true},
+		{name: "[Fail] query error", link: valid, rowErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteLinkDBTX(row, tt.rowErr, nil, 0)
+			repo := &NoteLinkRepository{queries: generated.New(mock)}
+			got, err := repo.Create(context.Background(), tt.link)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != row.ID.String() || got.Type != note.LinkSupersedes || got.TargetID != valid.TargetID {
+				t.Fatalf("unexpected link: %+v", got)
+			}
+		})
+	}
+}
+
+func TestNoteLinkRepository_Delete(t *testing.T) {
+	sourceID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}.String()
+	linkID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	tests := []struct {
+		name     string
+		sourceID string
+		linkID   string
+		affected int64
+		execErr  error
+		wantErr  error
+	}{
+		{name: "[Success] delete link", sourceID: sourceID, linkID: linkID, affected: 1},
+		{name: "[Fail] invalid source uuid", sourceID: "bad-uuid", linkID: linkID, wantErr: errors.New("invalid")},
+		{name: "[Fail] invalid link uuid is not found", sourceID: sourceID, linkID: "bad-uuid", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] no rows is not found", sourceID: sourceID, linkID: linkID, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] exec error", sourceID: sourceID, linkID: linkID, execErr: errors.New("exec"), wantErr: errors.New("exec")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteLinkDBTX(nil, nil, tt.execErr, tt.affected)
+			repo := &NoteLinkRepository{queries: generated.New(mock)}
+			err := repo.Delete(context.Background(), tt.sourceID, tt.linkID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if errors.Is(tt.wantErr, domainerr.ErrNotFound) && !errors.Is(err, domainerr.ErrNotFound) {
+				t.Fatalf("want ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNoteLinkRepository_DeleteByNote(t *testing.T) {
+	noteID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	tests := []struct {
+		name    string
+		noteID  string
+		execErr error
+		wantErr bool
+	}{
+		{name: "[Success] delete links of note", noteID: noteID},
+		{name: "[Fail] invalid uuid", noteID: "bad-uuid", wantErr: true},
+		{name: "[Fail] exec error", noteID: noteID, execErr: errors.New("exec"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteLinkDBTX(nil, nil, tt.execErr, 0)
+			repo := &NoteLinkRepository{queries: generated.New(mock)}
+			err := repo.DeleteByNote(context.Background(), tt.noteID)
+			if tt.wantErr && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNoteLinkRepository_List(t *testing.T) {
+	noteID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}.String()
+	outgoing := []*generated.ListOutgoingNoteLinksRow{
+		{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, LinkType: string(note.LinkRelatesTo), NoteID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true}, Title: "target", Status: string(note.StatusDraft),
+			CollaboratorIds: []pgtype.UUID{{Bytes: [16]byte{8}, Valid: true}}},
+	}
+	backlinks := []*generated.ListOutgoingNoteLinksRow{
+		{ID: pgtype.UUID{Bytes: [16]byte{4}, Valid: true}, LinkType: string(note.LinkDependsOn), NoteID: pgtype.UUID{Bytes: [16]byte{5}, Valid: true}, Title: "source", Status: string(note.StatusPublish)},
+		{ID: pgtype.UUID{Bytes: [16]byte{6}, Valid: true}, LinkType: string(note.LinkSupersedes), NoteID: pgtype.UUID{Bytes: [16]byte{7}, Valid: true}, Title: "newer", Status: string(note.StatusPublish)},
+	}
+	tests := []struct {
+		name              string
+		noteID            string
+		queryErr          error
+		backlinks         bool
+		wantLen           int
+		wantTitle         string
+		wantCollaborators int
+		wantErr           bool
+	}{
+		{name: "[Success] outgoing with collaborators", noteID: noteID, wantLen: 1, wantTitle: "target", wantCollaborators: 1},
+		{name: "[Success] backlinks", noteID: noteID, backlinks: true, wantLen: 2, wantTitle: "source"},
+		{name: "[Fail] invalid uuid", noteID: "bad-uuid", wantErr: true},
+		{name: "[Fail] query error", noteID: noteID, queryErr: errors.New("query"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteLinkDBTX(nil, nil, nil, 0).WithList(outgoing, backlinks, tt.queryErr)
+			repo := &NoteLinkRepository{queries: generated.New(mock)}
+			var (
+				got []note.LinkSummary
+				err error
+			)
+			if tt.backlinks {
+				got, err = repo.ListBacklinks(context.Background(), tt.noteID)
+			} else {
+				got, err = repo.ListOutgoing(context.Background(), tt.noteID)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != tt.wantLen || got[0].Title != tt.wantTitle {
+				t.Fatalf("unexpected links: %+v", got)
+			}
+			if len(got[0].CollaboratorIDs) != tt.wantCollaborators {
+				t.Errorf("collaborators = %v, want %d", got[0].CollaboratorIDs, tt.wantCollaborators)
+			}
+		})
+	}
+}
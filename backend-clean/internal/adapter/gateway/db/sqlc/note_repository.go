@@ -4,6 +4,7 @@ package sqlc
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -12,6 +13,8 @@ import (
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -33,22 +36,29 @@ func NewNoteRepository(pool *pgxpool.Pool) *NoteRepository {
 
 // List returns notes by filters.
 func (r *NoteRepository) List(ctx context.Context, filters note.Filters) ([]note.WithMeta, error) {
-	params := &generated.ListNotesParams{}
-	if filters.Status != nil {
-		params.Column1 = string(*filters.Status)
-	}
-	if filters.TemplateID != nil && *filters.TemplateID != "" {
-		if id, err := toUUID(*filters.TemplateID); err == nil {
-			params.Column2 = id
-		}
+	f, err := toNoteFilterParams(ctx, filters)
+	if err != nil {
+		return nil, err
 	}
-	if filters.OwnerID != nil && *filters.OwnerID != "" {
-		if id, err := toUUID(*filters.OwnerID); err == nil {
-			params.Column3 = id
-		}
+	afterUpdatedAt, afterID, err := toKeyset(filters.After)
+	if err != nil {
+		return nil, err
 	}
-	if filters.Query != nil && *filters.Query != "" {
-		params.Column4 = *filters.Query
+	params := &generated.ListNotesParams{
+		Column1:  f.status,
+		Column2:  f.templateID,
+		Column3:  f.ownerID,
+		Column4:  f.pattern,
+		Column5:  f.viewerID,
+		Column6:  f.inTitle,
+		Column7:  f.inContent,
+		Column8:  f.inLabel,
+		Column9:  afterUpdatedAt,
+		Column10: afterID,
+		Column11: int32(filters.Limit), //nolint:gosec
+		Column12: f.tags,
+		Column13: f.matchAllTags,
+		Column14: f.workspaceID,
 	}
 
 	rows, err := queriesForContext(ctx, r.queries).ListNotes(ctx, params)
@@ -62,6 +72,10 @@ func (r *NoteRepository) List(ctx context.Context, filters note.Filters) ([]note
 		if err != nil {
 			return nil, err
 		}
+		tags, err := r.listTags(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
 		var thumbnail *string
 		if row.OwnerThumbnail.Valid {
 			s := row.OwnerThumbnail.String
@@ -69,31 +83,114 @@ func (r *NoteRepository) List(ctx context.Context, filters note.Filters) ([]note
 		}
 		result = append(result, note.WithMeta{
 			Note: note.Note{
-				ID:         uuidToString(row.ID),
-				Title:      row.Title,
-				TemplateID: uuidToString(row.TemplateID),
-				OwnerID:    uuidToString(row.OwnerID),
-				Status:     note.NoteStatus(row.Status),
-				CreatedAt:  timestamptzToTime(row.CreatedAt),
-				UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+				ID:              uuidToString(row.ID),
+				Title:           row.Title,
+				TemplateID:      uuidToString(row.TemplateID),
+				OwnerID:         uuidToString(row.OwnerID),
+				WorkspaceID:     uuidToString(row.WorkspaceID),
+				Status:          note.NoteStatus(row.Status),
+				TemplateVersion: int(row.TemplateVersion),
+				Version:         int(row.Version),
+				CreatedAt:       timestamptzToTime(row.CreatedAt),
+				UpdatedAt:       timestamptzToTime(row.UpdatedAt),
 			},
 			TemplateName:   row.TemplateName,
 			OwnerFirstName: row.FirstName,
 			OwnerLastName:  row.LastName,
 			OwnerThumbnail: thumbnail,
 			Sections:       sections,
+			Tags:           tags,
+		})
+	}
+	return result, nil
+}
+
+// searchResultLimit caps how many ranked results a search returns.
+const searchResultLimit = 50
+
+// Search returns notes matching filters.Query ranked by relevance, with snippets.
+func (r *NoteRepository) Search(ctx context.Context, filters note.Filters) ([]note.SearchResult, error) {
+	f, err := toNoteFilterParams(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	query := ""
+	if filters.Query != nil {
+		query = *filters.Query
+	}
+	rows, err := queriesForContext(ctx, r.queries).SearchNotes(ctx, &generated.SearchNotesParams{
+		Column1:  f.status,
+		Column2:  f.templateID,
+		Column3:  f.ownerID,
+		Column4:  f.pattern,
+		Column5:  f.viewerID,
+		Column6:  f.inTitle,
+		Column7:  f.inContent,
+		Column8:  f.inLabel,
+		Column9:  query,
+		Column11: f.tags,
+		Column12: f.matchAllTags,
+		Column13: f.workspaceID,
+		Limit:    searchResultLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]note.SearchResult, 0, len(rows))
+	for _, row := range rows {
+		sections, err := r.listSections(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		tags, err := r.listTags(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		var thumbnail *string
+		if row.OwnerThumbnail.Valid {
+			s := row.OwnerThumbnail.String
+			thumbnail = &s
+		}
+		result = append(result, note.SearchResult{
+			Note: note.WithMeta{
+				Note: note.Note{
+					ID:              uuidToString(row.ID),
+					Title:           row.Title,
+					TemplateID:      uuidToString(row.TemplateID),
+					OwnerID:         uuidToString(row.OwnerID),
+					WorkspaceID:     uuidToString(row.WorkspaceID),
+					Status:          note.NoteStatus(row.Status),
+					TemplateVersion: int(row.TemplateVersion),
+					Version:         int(row.Version),
+					CreatedAt:       timestamptzToTime(row.CreatedAt),
+					UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+				},
+				TemplateName:   row.TemplateName,
+				OwnerFirstName: row.FirstName,
+				OwnerLastName:  row.LastName,
+				OwnerThumbnail: thumbnail,
+				Sections:       sections,
+				Tags:           tags,
+			},
+			Rank:    row.Rank,
+			Snippet: note.BestSnippet(query, row.MatchedContent, row.Title, row.MatchedLabel),
 		})
 	}
 	return result, nil
 }
 
-// Get returns a note with sections.
+// Get returns a note with sections and link summaries.
 func (r *NoteRepository) Get(ctx context.Context, id string) (*note.WithMeta, error) {
 	pgID, err := toUUID(id)
 	if err != nil {
 		return nil, err
 	}
-	row, err := queriesForContext(ctx, r.queries).GetNoteByID(ctx, pgID)
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetNoteByID(ctx, &generated.GetNoteByIDParams{ID: pgID, Column2: workspaceID})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domainerr.ErrNotFound
@@ -104,6 +201,23 @@ func (r *NoteRepository) Get(ctx context.Context, id string) (*note.WithMeta, er
 	if err != nil {
 		return nil, err
 	}
+	tags, err := r.listTags(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	q := queriesForContext(ctx, r.queries)
+	links, err := listOutgoingLinks(ctx, q, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	backlinks, err := listBacklinks(ctx, q, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	collaborators, err := listCollaborators(ctx, q, row.ID)
+	if err != nil {
+		return nil, err
+	}
 	var thumbnail *string
 	if row.OwnerThumbnail.Valid {
 		s := row.OwnerThumbnail.String
@@ -111,19 +225,26 @@ func (r *NoteRepository) Get(ctx context.Context, id string) (*note.WithMeta, er
 	}
 	return &note.WithMeta{
 		Note: note.Note{
-			ID:         uuidToString(row.ID),
-			Title:      row.Title,
-			TemplateID: uuidToString(row.TemplateID),
-			OwnerID:    uuidToString(row.OwnerID),
-			Status:     note.NoteStatus(row.Status),
-			CreatedAt:  timestamptzToTime(row.CreatedAt),
-			UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+			ID:              uuidToString(row.ID),
+			Title:           row.Title,
+			TemplateID:      uuidToString(row.TemplateID),
+			OwnerID:         uuidToString(row.OwnerID),
+			WorkspaceID:     uuidToString(row.WorkspaceID),
+			Status:          note.NoteStatus(row.Status),
+			TemplateVersion: int(row.TemplateVersion),
+			Version:         int(row.Version),
+			CreatedAt:       timestamptzToTime(row.CreatedAt),
+			UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+			Collaborators:   collaborators,
 		},
 		TemplateName:   row.TemplateName,
 		OwnerFirstName: row.FirstName,
 		OwnerLastName:  row.LastName,
 		OwnerThumbnail: thumbnail,
 		Sections:       sections,
+		Tags:           tags,
+		Links:          links,
+		Backlinks:      backlinks,
 	}, nil
 }
 
@@ -137,50 +258,64 @@ func (r *NoteRepository) Create(ctx context.Context, n note.Note) (*note.Note, e
 	if err != nil {
 		return nil, err
 	}
+	workspaceID, err := toUUID(n.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
 	row, err := queriesForContext(ctx, r.queries).CreateNote(ctx, &generated.CreateNoteParams{
-		Title:      n.Title,
-		TemplateID: templateID,
-		OwnerID:    ownerID,
-		Status:     string(n.Status),
+		Title:           n.Title,
+		TemplateID:      templateID,
+		OwnerID:         ownerID,
+		Status:          string(n.Status),
+		TemplateVersion: int32(n.TemplateVersion), //nolint:gosec
+		WorkspaceID:     workspaceID,
 	})
 	if err != nil {
 		return nil, err
 	}
 	return &note.Note{
-		ID:         uuidToString(row.ID),
-		Title:      row.Title,
-		TemplateID: uuidToString(row.TemplateID),
-		OwnerID:    uuidToString(row.OwnerID),
-		Status:     note.NoteStatus(row.Status),
-		CreatedAt:  timestamptzToTime(row.CreatedAt),
-		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		OwnerID:         uuidToString(row.OwnerID),
+		WorkspaceID:     uuidToString(row.WorkspaceID),
+		Status:          note.NoteStatus(row.Status),
+		TemplateVersion: int(row.TemplateVersion),
+		Version:         int(row.Version),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
 	}, nil
 }
 
-// Update updates a note title.
+// Update updates a note title if n.Version is still the stored version.
+// A version mismatch is reported as ErrConflict.
 func (r *NoteRepository) Update(ctx context.Context, n note.Note) (*note.Note, error) {
 	pgID, err := toUUID(n.ID)
 	if err != nil {
 		return nil, err
 	}
 	row, err := queriesForContext(ctx, r.queries).UpdateNote(ctx, &generated.UpdateNoteParams{
-		ID:    pgID,
-		Title: n.Title,
+		ID:      pgID,
+		Title:   n.Title,
+		Version: int32(n.Version), //nolint:gosec
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domainerr.ErrNotFound
+			return nil, domainerr.ErrConflict
 		}
 		return nil, err
 	}
 	return &note.Note{
-		ID:         uuidToString(row.ID),
-		Title:      row.Title,
-		TemplateID: uuidToString(row.TemplateID),
-		OwnerID:    uuidToString(row.OwnerID),
-		Status:     note.NoteStatus(row.Status),
-		CreatedAt:  timestamptzToTime(row.CreatedAt),
-		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		OwnerID:         uuidToString(row.OwnerID),
+		WorkspaceID:     uuidToString(row.WorkspaceID),
+		Status:          note.NoteStatus(row.Status),
+		TemplateVersion: int(row.TemplateVersion),
+		Version:         int(row.Version),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
 	}, nil
 }
 
@@ -201,17 +336,212 @@ func (r *NoteRepository) UpdateStatus(ctx context.Context, id string, status not
 		return nil, err
 	}
 	return &note.Note{
-		ID:         uuidToString(row.ID),
-		Title:      row.Title,
-		TemplateID: uuidToString(row.TemplateID),
-		OwnerID:    uuidToString(row.OwnerID),
-		Status:     note.NoteStatus(row.Status),
-		CreatedAt:  timestamptzToTime(row.CreatedAt),
-		UpdatedAt:  timestamptzToTime(row.UpdatedAt),
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		OwnerID:         uuidToString(row.OwnerID),
+		WorkspaceID:     uuidToString(row.WorkspaceID),
+		Status:          note.NoteStatus(row.Status),
+		TemplateVersion: int(row.TemplateVersion),
+		Version:         int(row.Version),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
 	}, nil
 }
 
-// Delete deletes a note.
+// UpdateTemplateVersion pins a note to n.TemplateVersion and recreates its sections
+// for that version's fields if n.Version is still the stored version.
+// A version mismatch is reported as ErrConflict.
+func (r *NoteRepository) UpdateTemplateVersion(ctx context.Context, n note.Note, sections []note.Section) (*note.Note, error) {
+	pgID, err := toUUID(n.ID)
+	if err != nil {
+		return nil, err
+	}
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.UpdateNoteTemplateVersion(ctx, &generated.UpdateNoteTemplateVersionParams{
+		ID:              pgID,
+		TemplateVersion: int32(n.TemplateVersion), //nolint:gosec
+		Version:         int32(n.Version),         //nolint:gosec
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrConflict
+		}
+		return nil, err
+	}
+	if err := q.DeleteSectionsByNote(ctx, pgID); err != nil {
+		return nil, err
+	}
+	for _, s := range sections {
+		fieldID, err := toUUID(s.FieldID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := q.CreateSection(ctx, &generated.CreateSectionParams{
+			NoteID:  pgID,
+			FieldID: fieldID,
+			Content: s.Content,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return &note.Note{
+		ID:              uuidToString(row.ID),
+		Title:           row.Title,
+		TemplateID:      uuidToString(row.TemplateID),
+		OwnerID:         uuidToString(row.OwnerID),
+		WorkspaceID:     uuidToString(row.WorkspaceID),
+		Status:          note.NoteStatus(row.Status),
+		TemplateVersion: int(row.TemplateVersion),
+		Version:         int(row.Version),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+	}, nil
+}
+
+// ListTrash returns trashed notes, most recently trashed first.
+func (r *NoteRepository) ListTrash(ctx context.Context, filters trash.Filters) ([]note.WithMeta, error) {
+	params, err := toTrashParams(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListTrashedNotes(ctx, &generated.ListTrashedNotesParams{
+		Column1: params.ownerID,
+		Column2: params.trashedBefore,
+		Column3: params.afterDeletedAt,
+		Column4: params.afterID,
+		Limit:   params.limit,
+		Column6: params.workspaceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]note.WithMeta, 0, len(rows))
+	for _, row := range rows {
+		sections, err := r.listSections(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		tags, err := r.listTags(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		var thumbnail *string
+		if row.OwnerThumbnail.Valid {
+			s := row.OwnerThumbnail.String
+			thumbnail = &s
+		}
+		result = append(result, note.WithMeta{
+			Note: note.Note{
+				ID:              uuidToString(row.ID),
+				Title:           row.Title,
+				TemplateID:      uuidToString(row.TemplateID),
+				OwnerID:         uuidToString(row.OwnerID),
+				WorkspaceID:     uuidToString(row.WorkspaceID),
+				Status:          note.NoteStatus(row.Status),
+				TemplateVersion: int(row.TemplateVersion),
+				Version:         int(row.Version),
+				CreatedAt:       timestamptzToTime(row.CreatedAt),
+				UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+				DeletedAt:       timestamptzToTimePtr(row.DeletedAt),
+			},
+			TemplateName:   row.TemplateName,
+			OwnerFirstName: row.FirstName,
+			OwnerLastName:  row.LastName,
+			OwnerThumbnail: thumbnail,
+			Sections:       sections,
+			Tags:           tags,
+		})
+	}
+	return result, nil
+}
+
+// GetTrashed returns a trashed note with sections. Links are not loaded.
+func (r *NoteRepository) GetTrashed(ctx context.Context, id string) (*note.WithMeta, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetTrashedNoteByID(ctx, &generated.GetTrashedNoteByIDParams{ID: pgID, Column2: workspaceID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	sections, err := r.listSections(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := r.listTags(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	var thumbnail *string
+	if row.OwnerThumbnail.Valid {
+		s := row.OwnerThumbnail.String
+		thumbnail = &s
+	}
+	return &note.WithMeta{
+		Note: note.Note{
+			ID:              uuidToString(row.ID),
+			Title:           row.Title,
+			TemplateID:      uuidToString(row.TemplateID),
+			OwnerID:         uuidToString(row.OwnerID),
+			WorkspaceID:     uuidToString(row.WorkspaceID),
+			Status:          note.NoteStatus(row.Status),
+			TemplateVersion: int(row.TemplateVersion),
+			Version:         int(row.Version),
+			CreatedAt:       timestamptzToTime(row.CreatedAt),
+			UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+			DeletedAt:       timestamptzToTimePtr(row.DeletedAt),
+		},
+		TemplateName:   row.TemplateName,
+		OwnerFirstName: row.FirstName,
+		OwnerLastName:  row.LastName,
+		OwnerThumbnail: thumbnail,
+		Sections:       sections,
+		Tags:           tags,
+	}, nil
+}
+
+// Trash moves a note to the trash. A note that is missing or already trashed is ErrNotFound.
+func (r *NoteRepository) Trash(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	n, err := queriesForContext(ctx, r.queries).TrashNote(ctx, pgID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// Restore takes a note out of the trash. A note that is not in the trash is ErrNotFound.
+func (r *NoteRepository) Restore(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	n, err := queriesForContext(ctx, r.queries).RestoreNote(ctx, pgID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// Delete deletes a note for good, trashed or not. Links must be removed first.
 func (r *NoteRepository) Delete(ctx context.Context, id string) error {
 	pgID, err := toUUID(id)
 	if err != nil {
@@ -257,6 +587,35 @@ func (r *NoteRepository) ReplaceSections(ctx context.Context, noteID string, sec
 	return nil
 }
 
+// ReplaceTags sets the tags of a note, keeping the rows of tags it already has.
+func (r *NoteRepository) ReplaceTags(ctx context.Context, noteID string, tags []note.Tag) error {
+	nID, err := toUUID(noteID)
+	if err != nil {
+		return err
+	}
+	values := make([]string, 0, len(tags))
+	for _, t := range tags {
+		values = append(values, string(t))
+	}
+	q := queriesForContext(ctx, r.queries)
+	if err := q.DeleteNoteTagsExcept(ctx, &generated.DeleteNoteTagsExceptParams{NoteID: nID, Column2: values}); err != nil {
+		return err
+	}
+	return q.AddNoteTags(ctx, &generated.AddNoteTagsParams{NoteID: nID, Column2: values})
+}
+
+func (r *NoteRepository) listTags(ctx context.Context, noteID pgtype.UUID) ([]note.Tag, error) {
+	rows, err := queriesForContext(ctx, r.queries).ListNoteTags(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]note.Tag, 0, len(rows))
+	for _, t := range rows {
+		tags = append(tags, note.Tag(t))
+	}
+	return tags, nil
+}
+
 func (r *NoteRepository) listSections(ctx context.Context, noteID pgtype.UUID) ([]note.SectionWithField, error) {
 	rows, err := queriesForContext(ctx, r.queries).ListSectionsByNote(ctx, noteID)
 	if err != nil {
@@ -271,10 +630,111 @@ func (r *NoteRepository) listSections(ctx context.Context, noteID pgtype.UUID) (
 				FieldID: uuidToString(row.FieldID),
 				Content: row.Content,
 			},
-			FieldLabel: row.Label,
-			FieldOrder: int(row.Order),
-			IsRequired: row.IsRequired,
+			FieldLabel:   row.Label,
+			FieldOrder:   int(row.Order),
+			IsRequired:   row.IsRequired,
+			FieldType:    template.FieldType(row.Type),
+			FieldOptions: row.Options,
 		})
 	}
 	return sections, nil
 }
+
+// noteFilterParams holds note.Filters converted to the parameters shared by ListNotes and SearchNotes.
+type noteFilterParams struct {
+	status     string
+	templateID pgtype.UUID
+	ownerID    pgtype.UUID
+	pattern    string
+	viewerID   pgtype.UUID
+	inTitle    bool
+	inContent  bool
+	inLabel    bool
+	// tags are matched as stored, so the use case normalizes them first.
+	tags         []string
+	matchAllTags bool
+	workspaceID  pgtype.UUID
+}
+
+func toNoteFilterParams(ctx context.Context, filters note.Filters) (noteFilterParams, error) {
+	p := noteFilterParams{
+		inTitle:      filters.Searches(note.SearchTitle),
+		inContent:    filters.Searches(note.SearchContent),
+		inLabel:      filters.Searches(note.SearchLabel),
+		tags:         make([]string, 0, len(filters.Tags)),
+		matchAllTags: filters.MatchesAllTags(),
+	}
+	for _, t := range filters.Tags {
+		p.tags = append(p.tags, string(t))
+	}
+	if filters.Status != nil {
+		p.status = string(*filters.Status)
+	}
+	if filters.TemplateID != nil && *filters.TemplateID != "" {
+		if id, err := toUUID(*filters.TemplateID); err == nil {
+			p.templateID = id
+		}
+	}
+	if filters.OwnerID != nil && *filters.OwnerID != "" {
+		if id, err := toUUID(*filters.OwnerID); err == nil {
+			p.ownerID = id
+		}
+	}
+	if filters.Query != nil && *filters.Query != "" {
+		p.pattern = "%" + likeEscaper.Replace(*filters.Query) + "%"
+	}
+	if filters.ViewerID != "" {
+		// Visibility must fail closed: an unparsable viewer is an error, not "no filter".
+		id, err := toUUID(filters.ViewerID)
+		if err != nil {
+			return noteFilterParams{}, err
+		}
+		p.viewerID = id
+	}
+	// The workspace scope fails closed like visibility.
+	workspaceID, err := workspaceScope(ctx, filters.WorkspaceID)
+	if err != nil {
+		return noteFilterParams{}, err
+	}
+	p.workspaceID = workspaceID
+	return p, nil
+}
+
+// trashParams holds trash.Filters converted to the parameters shared by the trash list queries.
+type trashParams struct {
+	ownerID        pgtype.UUID
+	trashedBefore  pgtype.Timestamptz
+	afterDeletedAt pgtype.Timestamptz
+	afterID        pgtype.UUID
+	limit          int32
+	workspaceID    pgtype.UUID
+}
+
+func toTrashParams(ctx context.Context, filters trash.Filters) (trashParams, error) {
+	var p trashParams
+	if filters.OwnerID != "" {
+		id, err := toUUID(filters.OwnerID)
+		if err != nil {
+			return trashParams{}, err
+		}
+		p.ownerID = id
+	}
+	workspaceID, err := workspaceScope(ctx, filters.WorkspaceID)
+	if err != nil {
+		return trashParams{}, err
+	}
+	p.workspaceID = workspaceID
+	p.trashedBefore = pgNullableTime(filters.TrashedBefore)
+	// The cursor's UpdatedAt carries deleted_at for trash lists.
+	afterDeletedAt, afterID, err := toKeyset(filters.After)
+	if err != nil {
+		return trashParams{}, err
+	}
+	p.afterDeletedAt = afterDeletedAt
+	p.afterID = afterID
+	p.limit = int32(filters.Limit) //nolint:gosec
+	return p, nil
+}
+
+// likeEscaper makes a keyword match literally inside an ILIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
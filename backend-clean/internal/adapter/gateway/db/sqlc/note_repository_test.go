@@ -9,10 +9,13 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
-	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/trash"
+	"immortal-architecture-clean/backend/internal/port"
 )
 
 func TestNoteRepository_UpdateStatus(t *testing.T) {
@@ -92,13 +95,14 @@ func TestNoteRepository_Delete(t *testing.T) {
 func TestNoteRepository_Create(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Second)
 	row := &generated.Note{
-		ID:         pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
-		Title:      "t",
-		TemplateID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
-		OwnerID:    pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
-		Status:     string(note.StatusDraft),
-		CreatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
-		UpdatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
+		ID:          pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Title:       "t",
+		TemplateID:  pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		OwnerID:     pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		WorkspaceID: pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
+		Status:      string(note.StatusDraft),
+		CreatedAt:   pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt:   pgtype.Timestamptz{Time: now, Valid: true},
 	}
 	tests := []struct {
 		name      string
@@ -110,10 +114,16 @@ func TestNoteRepository_Create(t *testing.T) {
 	}{
 		{
 			name:      "[Success] create note",
-			note:      note.Note{Title: "t", TemplateID: row.TemplateID.String(), OwnerID: row.OwnerID.String(), Status: note.StatusDraft},
+			note:      note.Note{Title: "t", TemplateID: row.TemplateID.String(), OwnerID: row.OwnerID.String(), WorkspaceID: row.WorkspaceID.String(), Status: note.StatusDraft},
 			row:       row,
 			wantTitle: "t",
 		},
+		{
+			name:    "[Fail] missing workspace",
+			note:    note.Note{Title: "t", TemplateID: row.TemplateID.String(), OwnerID: row.OwnerID.String(), Status: note.StatusDraft},
+			row:     row,
+			wantErr: true,
+		},
 		{
 			name:    "[Fail] invalid template uuid",
 			note:    note.Note{Title: "t", TemplateID: "bad-uuid", OwnerID: row.OwnerID.String(), Status: note.StatusDraft},
@@ -121,7 +131,7 @@ func TestNoteRepository_Create(t *testing.T) {
 		},
 		{
 			name:    "[Fail] query error",
-			note:    note.Note{Title: "t", TemplateID: row.TemplateID.String(), OwnerID: row.OwnerID.String(), Status: note.StatusDraft},
+			note:    note.Note{Title: "t", TemplateID: row.TemplateID.String(), OwnerID: row.OwnerID.String(), WorkspaceID: row.WorkspaceID.String(), Status: note.StatusDraft},
 			rowErr:  errors.New("db error"),
 			wantErr: true,
 		},
@@ -170,44 +180,70 @@ func TestNoteRepository_Get(t *testing.T) {
 		Status:         baseRow.Status,
 		CreatedAt:      baseRow.CreatedAt,
 		UpdatedAt:      baseRow.UpdatedAt,
+		WorkspaceID:    pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
 		TemplateName:   "tpl",
 		FirstName:      "Taro",
 		LastName:       "Yamada",
 		OwnerThumbnail: pgtype.Text{String: "thumb", Valid: true},
 	}
+	links := []*generated.ListOutgoingNoteLinksRow{
+		{ID: pgtype.UUID{Bytes: [16]byte{7}, Valid: true}, LinkType: string(note.LinkDependsOn), NoteID: pgtype.UUID{Bytes: [16]byte{6}, Valid: true}, Title: "target", Status: string(note.StatusPublish), OwnerID: baseRow.OwnerID},
+	}
+	collaborators := []*generated.ListNoteCollaboratorsRow{
+		{NoteID: baseRow.ID, AccountID: pgtype.UUID{Bytes: [16]byte{5}, Valid: true}, Role: string(note.RoleEditor), FirstName: "Hanako"},
+	}
 	tests := []struct {
-		name      string
-		id        string
-		row       *generated.Note
-		getRow    *generated.GetNoteByIDRow
-		rowErr    error
-		queryErr  error
-		wantErr   error
-		wantTitle string
+		name        string
+		id          string
+		workspaceID string
+		row         *generated.Note
+		getRow      *generated.GetNoteByIDRow
+		rowErr      error
+		queryErr    error
+		wantErr     error
+		wantTitle   string
 	}{
-		{name: "[Success] get note", id: baseRow.ID.String(), row: baseRow, getRow: detail, wantTitle: baseRow.Title},
-		{name: "[Fail] invalid uuid", id: "bad-uuid", row: baseRow, wantErr: errors.New("invalid")},
-		{name: "[Fail] not found", id: baseRow.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
-		{name: "[Fail] list sections error", id: baseRow.ID.String(), row: baseRow, getRow: detail, queryErr: errors.New("query"), wantErr: errors.New("query")},
+		{name: "[Success] get note in the active workspace", id: baseRow.ID.String(), workspaceID: detail.WorkspaceID.String(), row: baseRow, getRow: detail, wantTitle: baseRow.Title},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", workspaceID: testWorkspaceID, row: baseRow, wantErr: errors.New("invalid")},
+		{name: "[Fail] no active workspace", id: baseRow.ID.String(), row: baseRow, getRow: detail, wantErr: errNoWorkspace},
+		{name: "[Fail] malformed active workspace", id: baseRow.ID.String(), workspaceID: "bad-uuid", row: baseRow, getRow: detail, wantErr: errors.New("invalid")},
+		{name: "[Fail] not found", id: baseRow.ID.String(), workspaceID: testWorkspaceID, rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] list sections error", id: baseRow.ID.String(), workspaceID: testWorkspaceID, row: baseRow, getRow: detail, queryErr: errors.New("query"), wantErr: errors.New("query")},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mock := mockdb.NewNoteDBTX(tt.row, tt.rowErr, nil).WithGetRow(tt.getRow).WithList(nil, sections, tt.queryErr)
+			mock := mockdb.NewNoteDBTX(tt.row, tt.rowErr, nil).WithGetRow(tt.getRow).WithList(nil, sections, tt.queryErr).WithLinks(links, nil).WithCollaborators(collaborators)
 			repo := &NoteRepository{queries: generated.New(mock)}
-			got, err := repo.Get(context.Background(), tt.id)
+			ctx := context.Background()
+			if tt.workspaceID != "" {
+				ctx = port.WithWorkspaceID(ctx, tt.workspaceID)
+			}
+			got, err := repo.Get(ctx, tt.id)
 			if tt.wantErr == nil {
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
 				}
-				if got.Note.Title != tt.wantTitle {
+				if got.Note.Title != tt.wantTitle || got.Note.WorkspaceID != detail.WorkspaceID.String() {
 					t.Fatalf("title = %s, want %s", got.Note.Title, tt.wantTitle)
 				}
+				if len(got.Links) != 1 || got.Links[0].Type != note.LinkDependsOn || got.Links[0].Title != "target" {
+					t.Fatalf("unexpected links: %+v", got.Links)
+				}
+				if got.Backlinks == nil || len(got.Backlinks) != 0 {
+					t.Fatalf("backlinks should be loaded and empty: %+v", got.Backlinks)
+				}
+				if role, ok := got.Note.RoleOf(collaborators[0].AccountID.String()); !ok || role != note.RoleEditor {
+					t.Fatalf("unexpected collaborators: %+v", got.Note.Collaborators)
+				}
 				return
 			}
 			if err == nil {
 				t.Fatalf("expected error, got nil")
 			}
+			if tt.wantErr == errNoWorkspace && !errors.Is(err, errNoWorkspace) {
+				t.Fatalf("want errNoWorkspace, got %v", err)
+			}
 			if tt.wantErr == domainerr.ErrNotFound && !errors.Is(err, domainerr.ErrNotFound) {
 				t.Fatalf("want ErrNotFound, got %v", err)
 			}
@@ -225,6 +261,7 @@ func TestNoteRepository_Update(t *testing.T) {
 		Status:     string(note.StatusDraft),
 		CreatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
 		UpdatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
+		Version:    3,
 	}
 	tests := []struct {
 		name    string
@@ -233,9 +270,9 @@ func TestNoteRepository_Update(t *testing.T) {
 		rowErr  error
 		wantErr error
 	}{
-		{name: "[Success] update note", note: note.Note{ID: row.ID.String(), Title: "t2"}, row: row},
+		{name: "[Success] update note", note: note.Note{ID: row.ID.String(), Title: "t2", Version: 2}, row: row},
 		{name: "[Fail] invalid uuid", note: note.Note{ID: "bad-uuid", Title: "t2"}, wantErr: errors.New("invalid")},
-		{name: "[Fail] not found", note: note.Note{ID: row.ID.String(), Title: "t2"}, rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] version mismatch", note: note.Note{ID: row.ID.String(), Title: "t2", Version: 2}, rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrConflict},
 	}
 
 	for _, tt := range tests {
@@ -247,16 +284,75 @@ func TestNoteRepository_Update(t *testing.T) {
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
 				}
-				if got.Title != tt.note.Title {
-					t.Fatalf("title = %s, want %s", got.Title, tt.note.Title)
+				if got.Title != tt.note.Title || got.Version != 3 {
+					t.Fatalf("got %+v, want title %s and version 3", got, tt.note.Title)
 				}
 				return
 			}
 			if err == nil {
 				t.Fatalf("expected error, got nil")
 			}
-			if tt.wantErr == domainerr.ErrNotFound && !errors.Is(err, domainerr.ErrNotFound) {
-				t.Fatalf("want ErrNotFound, got %v", err)
+			if tt.wantErr == domainerr.ErrConflict && !errors.Is(err, domainerr.ErrConflict) {
+				t.Fatalf("want ErrConflict, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNoteRepository_UpdateTemplateVersion(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	row := &generated.Note{
+		ID:              pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Title:           "t",
+		TemplateID:      pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		OwnerID:         pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		Status:          string(note.StatusDraft),
+		CreatedAt:       pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt:       pgtype.Timestamptz{Time: now, Valid: true},
+		Version:         3,
+		TemplateVersion: 2,
+	}
+	secRow := &generated.Section{
+		ID:      pgtype.UUID{Bytes: [16]byte{9}, Valid: true},
+		NoteID:  row.ID,
+		FieldID: pgtype.UUID{Bytes: [16]byte{8}, Valid: true},
+		Content: "c",
+	}
+	sections := []note.Section{{FieldID: secRow.FieldID.String(), Content: "c"}}
+	tests := []struct {
+		name     string
+		note     note.Note
+		sections []note.Section
+		rowErr   error
+		execErr  error
+		wantErr  error
+	}{
+		{name: "[Success] migrate note", note: note.Note{ID: row.ID.String(), TemplateVersion: 2, Version: 2}, sections: sections},
+		{name: "[Fail] invalid uuid", note: note.Note{ID: "bad-uuid"}, wantErr: errors.New("invalid")},
+		{name: "[Fail] version mismatch", note: note.Note{ID: row.ID.String(), TemplateVersion: 2, Version: 2}, rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrConflict},
+		{name: "[Fail] delete sections error", note: note.Note{ID: row.ID.String(), TemplateVersion: 2, Version: 2}, sections: sections, execErr: errors.New("exec err"), wantErr: errors.New("exec err")},
+		{name: "[Fail] invalid field id", note: note.Note{ID: row.ID.String(), TemplateVersion: 2, Version: 2}, sections: []note.Section{{FieldID: "bad-uuid"}}, wantErr: errors.New("invalid")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteDBTX(row, tt.rowErr, tt.execErr).WithSectionRow(secRow)
+			repo := &NoteRepository{queries: generated.New(mock)}
+			got, err := repo.UpdateTemplateVersion(context.Background(), tt.note, tt.sections)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.TemplateVersion != 2 || got.Version != 3 {
+					t.Fatalf("got %+v, want template version 2 and version 3", got)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.wantErr == domainerr.ErrConflict && !errors.Is(err, domainerr.ErrConflict) {
+				t.Fatalf("want ErrConflict, got %v", err)
 			}
 		})
 	}
@@ -282,20 +378,158 @@ func TestNoteRepository_List(t *testing.T) {
 	}
 	tests := []struct {
 		name     string
+		filters  note.Filters
 		notes    []*generated.ListNotesRow
 		sections []*generated.Section
 		queryErr error
 		wantErr  bool
 	}{
 		{name: "[Success] list notes", notes: []*generated.ListNotesRow{noteRow}, sections: sections},
+		{name: "[Success] list notes visible to viewer", filters: note.Filters{ViewerID: noteRow.OwnerID.String()}, notes: []*generated.ListNotesRow{noteRow}, sections: sections},
+		{name: "[Success] list notes with tags", filters: note.Filters{Tags: []note.Tag{"auth", "api"}, TagMatch: note.TagMatchAll}, notes: []*generated.ListNotesRow{noteRow}},
+		{name: "[Success] list page after cursor", filters: note.Filters{Limit: 2, After: &page.Cursor{UpdatedAt: now, ID: noteRow.ID.String()}}, notes: []*generated.ListNotesRow{noteRow}, sections: sections},
+		{name: "[Fail] invalid viewer uuid", filters: note.Filters{ViewerID: "bad-uuid"}, wantErr: true},
+		{name: "[Fail] invalid cursor id", filters: note.Filters{After: &page.Cursor{UpdatedAt: now, ID: "bad-uuid"}}, wantErr: true},
 		{name: "[Fail] query error", queryErr: errors.New("db error"), wantErr: true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mock := mockdb.NewNoteDBTX(nil, nil, nil).WithList(tt.notes, tt.sections, tt.queryErr)
+			mock := mockdb.NewNoteDBTX(nil, nil, nil).WithList(tt.notes, tt.sections, tt.queryErr).WithTags(tagStrings(tt.filters.Tags))
+			repo := &NoteRepository{queries: generated.New(mock)}
+			tt.filters.WorkspaceID = testWorkspaceID
+			got, err := repo.List(context.Background(), tt.filters)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 || len(got[0].Tags) != len(tt.filters.Tags) {
+				t.Fatalf("unexpected notes: %+v", got)
+			}
+		})
+	}
+}
+
+func TestNoteRepository_Search(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	row := &generated.SearchNotesRow{
+		ID:             pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Title:          "障害の振り返り",
+		TemplateID:     pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		OwnerID:        pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		Status:         string(note.StatusPublish),
+		CreatedAt:      pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt:      pgtype.Timestamptz{Time: now, Valid: true},
+		TemplateName:   "tpl",
+		FirstName:      "Taro",
+		LastName:       "Yamada",
+		MatchedContent: "原因は設定漏れだった",
+		Rank:           2.5,
+	}
+	query := "設定"
+	tests := []struct {
+		name        string
+		filters     note.Filters
+		rows        []*generated.SearchNotesRow
+		queryErr    error
+		wantSnippet string
+		wantErr     bool
+	}{
+		{
+			name:        "[Success] ranked result with snippet",
+			filters:     note.Filters{Query: &query, ViewerID: row.OwnerID.String()},
+			rows:        []*generated.SearchNotesRow{row},
+			wantSnippet: "原因は設定漏れだった",
+		},
+		{name: "[Fail] invalid viewer uuid", filters: note.Filters{Query: &query, ViewerID: "bad-uuid"}, wantErr: true},
+		{name: "[Fail] query error", filters: note.Filters{Query: &query}, queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteDBTX(nil, nil, nil).WithList(nil, nil, tt.queryErr).WithSearch(tt.rows)
 			repo := &NoteRepository{queries: generated.New(mock)}
-			_, err := repo.List(context.Background(), note.Filters{})
+			tt.filters.WorkspaceID = testWorkspaceID
+			results, err := repo.Search(context.Background(), tt.filters)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(results) != 1 || results[0].Rank != row.Rank || results[0].Snippet.Text != tt.wantSnippet {
+				t.Fatalf("unexpected results: %+v", results)
+			}
+			if h := results[0].Snippet.Highlights; len(h) != 1 || h[0] != (note.Highlight{Start: 3, End: 5}) {
+				t.Fatalf("unexpected highlights: %+v", h)
+			}
+		})
+	}
+}
+
+// testWorkspaceID scopes reads in tests; repositories refuse to read without a workspace.
+const testWorkspaceID = "00000000-0000-0000-0000-0000000000aa"
+
+func TestToNoteFilterParams(t *testing.T) {
+	query := `100%_\`
+	p, err := toNoteFilterParams(context.Background(), note.Filters{Query: &query, SearchIn: []note.SearchTarget{note.SearchContent}, WorkspaceID: testWorkspaceID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `%100\%\_\\%`; p.pattern != want {
+		t.Fatalf("want pattern %q, got %q", want, p.pattern)
+	}
+	if p.inTitle || !p.inContent || p.inLabel {
+		t.Fatalf("unexpected targets: %+v", p)
+	}
+}
+
+func TestToNoteFilterParams_Tags(t *testing.T) {
+	p, err := toNoteFilterParams(context.Background(), note.Filters{Tags: []note.Tag{"api", "auth"}, TagMatch: note.TagMatchAll, WorkspaceID: testWorkspaceID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !p.matchAllTags || len(p.tags) != 2 || p.tags[0] != "api" {
+		t.Fatalf("unexpected tag params: %+v", p)
+	}
+
+	// Without tags the query receives an empty array rather than NULL.
+	p, err = toNoteFilterParams(context.Background(), note.Filters{WorkspaceID: testWorkspaceID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.tags == nil || p.matchAllTags {
+		t.Fatalf("unexpected tag params: %+v", p)
+	}
+}
+
+func TestNoteRepository_ReplaceTags(t *testing.T) {
+	noteID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	tests := []struct {
+		name    string
+		noteID  string
+		tags    []note.Tag
+		execErr error
+		wantErr bool
+	}{
+		{name: "[Success] replace tags", noteID: noteID.String(), tags: []note.Tag{"api", "auth"}},
+		{name: "[Success] clear tags", noteID: noteID.String()},
+		{name: "[Fail] invalid note uuid", noteID: "bad-uuid", wantErr: true},
+		{name: "[Fail] exec error", noteID: noteID.String(), tags: []note.Tag{"api"}, execErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &NoteRepository{queries: generated.New(mockdb.NewNoteDBTX(nil, nil, tt.execErr))}
+			err := repo.ReplaceTags(context.Background(), tt.noteID, tt.tags)
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error, got nil")
@@ -307,6 +541,14 @@ func TestNoteRepository_List(t *testing.T) {
 	}
 }
 
+func tagStrings(tags []note.Tag) []string {
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, string(t))
+	}
+	return out
+}
+
 func TestNoteRepository_ReplaceSections(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Second)
 	noteID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
@@ -391,3 +633,142 @@ func TestNoteRepository_ReplaceSections(t *testing.T) {
 		})
 	}
 }
+
+func TestNoteRepository_TrashAndRestore(t *testing.T) {
+	baseID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	tests := []struct {
+		name     string
+		id       string
+		execRows int64
+		execErr  error
+		wantErr  error
+	}{
+		{name: "[Success] one row changed", id: baseID.String(), execRows: 1},
+		{name: "[Fail] no row changed is not found", id: baseID.String(), execRows: 0, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: errors.New("invalid")},
+		{name: "[Fail] exec error", id: baseID.String(), execErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		for _, op := range []string{"trash", "restore"} {
+			t.Run(tt.name+" "+op, func(t *testing.T) {
+				mock := mockdb.NewNoteDBTX(nil, nil, tt.execErr).WithExecRows(tt.execRows)
+				repo := &NoteRepository{queries: generated.New(mock)}
+				var err error
+				if op == "trash" {
+					err = repo.Trash(context.Background(), tt.id)
+				} else {
+					err = repo.Restore(context.Background(), tt.id)
+				}
+				if tt.wantErr == nil {
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					return
+				}
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if tt.wantErr == domainerr.ErrNotFound && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want ErrNotFound, got %v", err)
+				}
+			})
+		}
+	}
+}
+
+func TestNoteRepository_ListTrash(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	row := &generated.ListTrashedNotesRow{
+		ID:         pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Title:      "t",
+		TemplateID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		OwnerID:    pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		Status:     string(note.StatusDraft),
+		UpdatedAt:  pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+		DeletedAt:  pgtype.Timestamptz{Time: now, Valid: true},
+	}
+	tests := []struct {
+		name     string
+		filters  trash.Filters
+		all      bool
+		queryErr error
+		wantErr  bool
+	}{
+		{name: "[Success] list owner's trash", filters: trash.Filters{OwnerID: row.OwnerID.String(), WorkspaceID: testWorkspaceID, Limit: 2}},
+		{name: "[Success] list expired trash of every owner", filters: trash.Filters{TrashedBefore: &now, Limit: 2}, all: true},
+		{name: "[Fail] no workspace", filters: trash.Filters{TrashedBefore: &now, Limit: 2}, wantErr: true},
+		{name: "[Fail] invalid owner uuid", filters: trash.Filters{OwnerID: "bad-uuid", WorkspaceID: testWorkspaceID}, wantErr: true},
+		{name: "[Fail] invalid cursor id", filters: trash.Filters{WorkspaceID: testWorkspaceID, After: &page.Cursor{UpdatedAt: now, ID: "bad-uuid"}}, wantErr: true},
+		{name: "[Fail] query error", filters: trash.Filters{WorkspaceID: testWorkspaceID}, queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteDBTX(nil, nil, nil).WithList(nil, nil, tt.queryErr).WithTrash([]*generated.ListTrashedNotesRow{row})
+			repo := &NoteRepository{queries: generated.New(mock)}
+			ctx := context.Background()
+			if tt.all {
+				ctx = port.WithAllWorkspaces(ctx)
+			}
+			got, err := repo.ListTrash(ctx, tt.filters)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 || got[0].Note.DeletedAt == nil || !got[0].Note.DeletedAt.Equal(now) {
+				t.Fatalf("unexpected notes: %+v", got)
+			}
+		})
+	}
+}
+
+func TestNoteRepository_GetTrashed(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	row := &generated.GetNoteByIDRow{
+		ID:         pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Title:      "t",
+		TemplateID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		OwnerID:    pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		Status:     string(note.StatusDraft),
+		DeletedAt:  pgtype.Timestamptz{Time: now, Valid: true},
+	}
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] get trashed note", id: row.ID.String()},
+		{name: "[Fail] not in trash", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: errors.New("invalid")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteDBTX(nil, tt.rowErr, nil).WithGetRow(row)
+			repo := &NoteRepository{queries: generated.New(mock)}
+			got, err := repo.GetTrashed(port.WithWorkspaceID(context.Background(), testWorkspaceID), tt.id)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.Note.DeletedAt == nil || !got.Note.DeletedAt.Equal(now) {
+					t.Fatalf("deletedAt = %v, want %v", got.Note.DeletedAt, now)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.wantErr == domainerr.ErrNotFound && !errors.Is(err, domainerr.ErrNotFound) {
+				t.Fatalf("want ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteRevisionRepository implements note revision persistence.
+type NoteRevisionRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.NoteRevisionRepository = (*NoteRevisionRepository)(nil)
+
+// NewNoteRevisionRepository creates NoteRevisionRepository.
+func NewNoteRevisionRepository(pool *pgxpool.Pool) *NoteRevisionRepository {
+	return &NoteRevisionRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Create appends a revision with its section snapshots.
+func (r *NoteRevisionRepository) Create(ctx context.Context, rev note.Revision) (*note.Revision, error) {
+	noteID, err := toUUID(rev.NoteID)
+	if err != nil {
+		return nil, err
+	}
+	actorID, err := toUUID(rev.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.CreateNoteRevision(ctx, &generated.CreateNoteRevisionParams{
+		NoteID:  noteID,
+		Title:   rev.Title,
+		Status:  string(rev.Status),
+		ActorID: actorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range rev.Sections {
+		fieldID, err := toUUID(s.FieldID)
+		if err != nil {
+			return nil, err
+		}
+		if err := q.CreateSectionRevision(ctx, &generated.CreateSectionRevisionParams{
+			NoteRevisionID: row.ID,
+			FieldID:        fieldID,
+			FieldLabel:     s.FieldLabel,
+			FieldOrder:     int32(s.FieldOrder), //nolint:gosec
+			Content:        s.Content,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	created := toRevision(row)
+	created.Sections = rev.Sections
+	return &created, nil
+}
+
+// List returns revision headers of a note, newest first. Sections are not loaded.
+func (r *NoteRevisionRepository) List(ctx context.Context, noteID string) ([]note.Revision, error) {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListNoteRevisions(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]note.Revision, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toRevision(row))
+	}
+	return result, nil
+}
+
+// Get returns a revision with its sections.
+func (r *NoteRevisionRepository) Get(ctx context.Context, noteID string, number int) (*note.Revision, error) {
+	pgID, err := toUUID(noteID)
+	if err != nil {
+		return nil, err
+	}
+	q := queriesForContext(ctx, r.queries)
+	row, err := q.GetNoteRevision(ctx, &generated.GetNoteRevisionParams{
+		NoteID:   pgID,
+		Revision: int32(number), //nolint:gosec
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	sections, err := q.ListSectionRevisions(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	rev := toRevision(row)
+	rev.Sections = make([]note.RevisionSection, 0, len(sections))
+	for _, s := range sections {
+		rev.Sections = append(rev.Sections, note.RevisionSection{
+			FieldID:    uuidToString(s.FieldID),
+			FieldLabel: s.FieldLabel,
+			FieldOrder: int(s.FieldOrder),
+			Content:    s.Content,
+		})
+	}
+	return &rev, nil
+}
+
+func toRevision(row *generated.NoteRevision) note.Revision {
+	return note.Revision{
+		ID:        uuidToString(row.ID),
+		NoteID:    uuidToString(row.NoteID),
+		Number:    int(row.Revision),
+		Title:     row.Title,
+		Status:    note.NoteStatus(row.Status),
+		ActorID:   uuidToString(row.ActorID),
+		CreatedAt: timestamptzToTime(row.CreatedAt),
+	}
+}
@@ -0,0 +1,155 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func newRevisionRow(number int32) *generated.NoteRevision {
+	return &generated.NoteRevision{
+		ID:        pgtype.UUID{Bytes: [16]byte{byte(number)}, Valid: true},
+		NoteID:    pgtype.UUID{Bytes: [16]byte{9}, Valid: true},
+		Revision:  number,
+		Title:     "title",
+		Status:    string(note.StatusDraft),
+		ActorID:   pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		CreatedAt: pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Second), Valid: true},
+	}
+}
+
+func TestNoteRevisionRepository_Create(t *testing.T) {
+	row := newRevisionRow(3)
+	valid := note.Revision{
+		NoteID:  row.NoteID.String(),
+		Title:   "title",
+		Status:  note.StatusDraft,
+		ActorID: row.ActorID.String(),
+		Sections: []note.RevisionSection{
+			{FieldID: pgtype.UUID{Bytes: [16]byte{5}, Valid: true}.String(), FieldLabel: "L", FieldOrder: 1, Content: "c"},
+		},
+	}
+	badField := valid
+	badField.Sections = []note.RevisionSection{{FieldID: "bad-uuid"}}
+	tests := []struct {
+		name    string
+		rev     note.Revision
+		rowErr  error
+		execErr error
+		wantErr bool
+	}{
+		{name: "[Success] create revision", rev: valid},
+		{name: "[Fail] invalid note uuid", rev: note.Revision{NoteID: "bad-uuid", ActorID: valid.ActorID}, wantErr: true},
+		{name: "[Fail] invalid actor uuid", rev: note.Revision{NoteID: valid.NoteID, ActorID: "bad-uuid"}, wantErr: true},
+		{name: "[Fail] invalid field uuid", rev: badField, wantErr: true},
+		{name: "[Fail] insert error", rev: valid, rowErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] section insert error", rev: valid, execErr: errors.New("exec error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteRevisionDBTX(row, tt.rowErr, tt.execErr)
+			repo := &NoteRevisionRepository{queries: generated.New(mock)}
+			got, err := repo.Create(context.Background(), tt.rev)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Number != 3 || len(got.Sections) != 1 {
+				t.Fatalf("unexpected revision: %+v", got)
+			}
+		})
+	}
+}
+
+func TestNoteRevisionRepository_List(t *testing.T) {
+	noteID := newRevisionRow(1).NoteID.String()
+	tests := []struct {
+		name     string
+		noteID   string
+		queryErr error
+		wantLen  int
+		wantErr  bool
+	}{
+		{name: "[Success] list revisions", noteID: noteID, wantLen: 2},
+		{name: "[Fail] invalid uuid", noteID: "bad-uuid", wantErr: true},
+		{name: "[Fail] query error", noteID: noteID, queryErr: errors.New("query error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteRevisionDBTX(nil, nil, nil).
+				WithList([]*generated.NoteRevision{newRevisionRow(2), newRevisionRow(1)}, nil, tt.queryErr)
+			repo := &NoteRevisionRepository{queries: generated.New(mock)}
+			got, err := repo.List(context.Background(), tt.noteID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != tt.wantLen || got[0].Number != 2 {
+				t.Fatalf("unexpected revisions: %+v", got)
+			}
+		})
+	}
+}
+
+func TestNoteRevisionRepository_Get(t *testing.T) {
+	row := newRevisionRow(1)
+	sections := []*generated.SectionRevision{
+		{ID: pgtype.UUID{Bytes: [16]byte{7}, Valid: true}, NoteRevisionID: row.ID, FieldID: pgtype.UUID{Bytes: [16]byte{5}, Valid: true}, FieldLabel: "L", FieldOrder: 1, Content: "c"},
+	}
+	tests := []struct {
+		name     string
+		noteID   string
+		rowErr   error
+		queryErr error
+		wantErr  error
+	}{
+		{name: "[Success] get revision", noteID: row.NoteID.String()},
+		{name: "[Fail] invalid uuid", noteID: "bad-uuid", wantErr: errors.New("invalid")},
+		{name: "[Fail] not found", noteID: row.NoteID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] sections query error", noteID: row.NoteID.String(), queryErr: errors.New("query error"), wantErr: errors.New("query error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteRevisionDBTX(row, tt.rowErr, nil).WithList(nil, sections, tt.queryErr)
+			repo := &NoteRevisionRepository{queries: generated.New(mock)}
+			got, err := repo.Get(context.Background(), tt.noteID, 1)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if errors.Is(tt.wantErr, domainerr.ErrNotFound) && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want not found, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Number != 1 || len(got.Sections) != 1 || got.Sections[0].FieldLabel != "L" {
+				t.Fatalf("unexpected revision: %+v", got)
+			}
+		})
+	}
+}
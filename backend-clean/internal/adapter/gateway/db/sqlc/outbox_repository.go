@@ -0,0 +1,103 @@
+package sqlc
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// OutboxRepository implements the transactional outbox.
+type OutboxRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.OutboxRepository = (*OutboxRepository)(nil)
+
+// NewOutboxRepository creates OutboxRepository.
+func NewOutboxRepository(pool *pgxpool.Pool) *OutboxRepository {
+	return &OutboxRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Publish inserts events into the outbox using the transaction in ctx, if any.
+func (r *OutboxRepository) Publish(ctx context.Context, events ...event.Event) error {
+	q := queriesForContext(ctx, r.queries)
+	for _, e := range events {
+		payload, err := json.Marshal(e.Payload)
+		if err != nil {
+			return err
+		}
+		if _, err := q.InsertOutboxEvent(ctx, &generated.InsertOutboxEventParams{
+			EventType:     string(e.Type),
+			AggregateType: string(e.AggregateType),
+			AggregateID:   e.AggregateID,
+			ActorID:       e.ActorID,
+			Payload:       payload,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClaimPending locks due events for delivery. Call it inside a transaction so the
+// locks are held until the events are marked.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, limit int) ([]event.Stored, error) {
+	rows, err := queriesForContext(ctx, r.queries).ClaimPendingOutboxEvents(ctx, int32(limit)) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	events := make([]event.Stored, 0, len(rows))
+	for _, row := range rows {
+		stored, err := toStoredEvent(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, stored)
+	}
+	return events, nil
+}
+
+// MarkDelivered records a successful delivery.
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, sequence int64) error {
+	return queriesForContext(ctx, r.queries).MarkOutboxEventDelivered(ctx, sequence)
+}
+
+// MarkFailed records a failed delivery and schedules the next attempt.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, sequence int64, cause string, retryAt time.Time) error {
+	return queriesForContext(ctx, r.queries).MarkOutboxEventFailed(ctx, &generated.MarkOutboxEventFailedParams{
+		ID:            sequence,
+		LastError:     cause,
+		NextAttemptAt: pgtype.Timestamptz{Time: retryAt, Valid: true},
+	})
+}
+
+func toStoredEvent(row *generated.Outbox) (event.Stored, error) {
+	var payload map[string]any
+	if err := json.Unmarshal(row.Payload, &payload); err != nil {
+		return event.Stored{}, err
+	}
+	return event.Stored{
+		Event: event.Event{
+			ID:            uuidToString(row.EventID),
+			Type:          event.Type(row.EventType),
+			AggregateType: event.AggregateType(row.AggregateType),
+			AggregateID:   row.AggregateID,
+			ActorID:       row.ActorID,
+			Payload:       payload,
+			OccurredAt:    timestamptzToTime(row.OccurredAt),
+		},
+		Sequence: row.ID,
+		Attempts: int(row.Attempts),
+	}, nil
+}
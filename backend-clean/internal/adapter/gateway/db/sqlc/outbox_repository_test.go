@@ -0,0 +1,112 @@
+package sqlc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+func newOutboxRow(id int64, payload string) *generated.Outbox {
+	return &generated.Outbox{
+		ID:            id,
+		EventID:       pgtype.UUID{Bytes: [16]byte{byte(id)}, Valid: true},
+		EventType:     string(event.NoteCreated),
+		AggregateType: string(event.AggregateNote),
+		AggregateID:   "n1",
+		ActorID:       "a1",
+		Payload:       []byte(payload),
+		OccurredAt:    pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Second), Valid: true},
+		Attempts:      2,
+	}
+}
+
+func TestOutboxRepository_Publish(t *testing.T) {
+	e := event.Event{Type: event.NoteCreated, AggregateType: event.AggregateNote, AggregateID: "n1", ActorID: "a1", Payload: map[string]any{"title": "t"}}
+	tests := []struct {
+		name    string
+		event   event.Event
+		rowErr  error
+		wantErr bool
+	}{
+		{name: "[Success] insert event", event: e},
+		{name: "[Fail] payload not marshalable", event: event.Event{Payload: map[string]any{"bad": make(chan int)}}, wantErr: true},
+		{name: "[Fail] insert error", event: e, rowErr: errors.New("db error"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewOutboxDBTX(newOutboxRow(1, "{}"), tt.rowErr, nil)
+			repo := &OutboxRepository{queries: generated.New(mock)}
+			err := repo.Publish(context.Background(), tt.event)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(mock.Inserted) != 1 || mock.Inserted[0][0] != "NoteCreated" || mock.Inserted[0][2] != "n1" {
+				t.Fatalf("unexpected insert args: %+v", mock.Inserted)
+			}
+			var payload map[string]any
+			if err := json.Unmarshal(mock.Inserted[0][4].([]byte), &payload); err != nil || payload["title"] != "t" {
+				t.Fatalf("unexpected payload %s, err %v", mock.Inserted[0][4], err)
+			}
+		})
+	}
+}
+
+func TestOutboxRepository_ClaimPending(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     []*generated.Outbox
+		queryErr error
+		wantErr  bool
+	}{
+		{name: "[Success] claim events", rows: []*generated.Outbox{newOutboxRow(1, `{"title":"a"}`), newOutboxRow(2, `{}`)}},
+		{name: "[Fail] query error", queryErr: errors.New("db error"), wantErr: true},
+		{name: "[Fail] broken payload", rows: []*generated.Outbox{newOutboxRow(1, `not json`)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewOutboxDBTX(nil, nil, nil).WithList(tt.rows, tt.queryErr)
+			repo := &OutboxRepository{queries: generated.New(mock)}
+			got, err := repo.ClaimPending(context.Background(), 10)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != 2 || got[0].Sequence != 1 || got[0].Attempts != 2 || got[0].Event.Payload["title"] != "a" || got[0].Event.ID == "" {
+				t.Fatalf("unexpected events: %+v", got)
+			}
+		})
+	}
+}
+
+func TestOutboxRepository_Mark(t *testing.T) {
+	mock := mockdb.NewOutboxDBTX(nil, nil, nil)
+	repo := &OutboxRepository{queries: generated.New(mock)}
+	if err := repo.MarkDelivered(context.Background(), 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	retryAt := time.Now().Add(time.Minute)
+	if err := repo.MarkFailed(context.Background(), 5, "boom", retryAt); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mock.Executed) != 2 || mock.Executed[0][0] != int64(4) || mock.Executed[1][1] != "boom" {
+		t.Fatalf("unexpected exec args: %+v", mock.Executed)
+	}
+
+	failing := &OutboxRepository{queries: generated.New(mockdb.NewOutboxDBTX(nil, nil, errors.New("exec error")))}
+	if err := failing.MarkFailed(context.Background(), 5, "boom", retryAt); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -0,0 +1,34 @@
+-- name: LockAuditLog :exec
+-- Serializes appends until the transaction ends, so each entry chains onto the last.
+SELECT pg_advisory_xact_lock(hashtext('audit_log'));
+
+-- name: GetAuditLogHead :one
+SELECT hash FROM audit_log ORDER BY sequence DESC LIMIT 1;
+
+-- name: InsertAuditLog :one
+INSERT INTO audit_log (actor_id, action, aggregate_type, aggregate_id, before, after, request_id, occurred_at, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING sequence;
+
+-- name: ListAuditLog :many
+-- Newest first. $1-$3 filter by actor and aggregate and $4/$5 bound occurred_at
+-- (all optional; empty strings and NULLs match everything); $6 is the sequence to
+-- continue after (0 for the first page) and $7 the page size.
+SELECT *
+FROM audit_log
+WHERE (NULLIF($1::text, '') IS NULL OR actor_id = $1)
+  AND (NULLIF($2::text, '') IS NULL OR aggregate_type = $2)
+  AND (NULLIF($3::text, '') IS NULL OR aggregate_id = $3)
+  AND ($4::timestamptz IS NULL OR occurred_at >= $4)
+  AND ($5::timestamptz IS NULL OR occurred_at < $5)
+  AND (NULLIF($6::bigint, 0) IS NULL OR sequence < $6)
+ORDER BY sequence DESC
+LIMIT $7;
+
+-- name: ListAuditLogChain :many
+-- Oldest first, for verifying the chain in batches.
+SELECT *
+FROM audit_log
+WHERE sequence > $1
+ORDER BY sequence
+LIMIT $2;
@@ -0,0 +1,45 @@
+-- name: ListCommentsByNote :many
+-- Oldest first, so replies follow the comment they answer.
+SELECT
+    c.*,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS author_thumbnail
+FROM comments c
+JOIN accounts a ON a.id = c.author_id
+WHERE c.note_id = $1
+ORDER BY c.created_at ASC, c.id ASC;
+
+-- name: GetCommentByID :one
+SELECT
+    c.*,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS author_thumbnail
+FROM comments c
+JOIN accounts a ON a.id = c.author_id
+WHERE c.id = $1;
+
+-- name: CreateComment :one
+INSERT INTO comments (note_id, section_id, parent_id, author_id, body)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateCommentBody :one
+UPDATE comments
+SET body = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateCommentResolution :one
+-- Resolving does not count as an edit, so updated_at stays.
+UPDATE comments
+SET resolved_by = $2,
+    resolved_at = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteComment :execrows
+DELETE FROM comments
+WHERE id = $1;
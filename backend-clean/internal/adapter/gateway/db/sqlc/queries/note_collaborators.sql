@@ -0,0 +1,22 @@
+-- name: ListNoteCollaborators :many
+SELECT
+    nc.*,
+    a.first_name,
+    a.last_name,
+    a.thumbnail
+FROM note_collaborators nc
+JOIN accounts a ON a.id = nc.account_id
+WHERE nc.note_id = $1
+ORDER BY nc.created_at ASC, nc.account_id ASC;
+
+-- name: UpsertNoteCollaborator :one
+-- Adding an account that already collaborates changes its role.
+INSERT INTO note_collaborators (note_id, account_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (note_id, account_id)
+DO UPDATE SET role = EXCLUDED.role, updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteNoteCollaborator :execrows
+DELETE FROM note_collaborators
+WHERE note_id = $1 AND account_id = $2;
@@ -0,0 +1,50 @@
+-- name: CreateNoteLink :one
+-- Adding an existing link is a no-op that returns the stored row.
+INSERT INTO note_links (source_note_id, target_note_id, link_type)
+VALUES ($1, $2, $3)
+ON CONFLICT (source_note_id, target_note_id, link_type)
+DO UPDATE SET link_type = EXCLUDED.link_type
+RETURNING *;
+
+-- name: DeleteNoteLink :execrows
+DELETE FROM note_links
+WHERE id = $1 AND source_note_id = $2;
+
+-- name: ListOutgoingNoteLinks :many
+-- Links to trashed notes are kept for a restore but not shown. The collaborators of the
+-- other note decide, with its owner and status, who may see the link.
+SELECT
+    l.id,
+    l.link_type,
+    n.id AS note_id,
+    n.title,
+    n.status,
+    n.owner_id,
+    ARRAY(
+        SELECT nc.account_id FROM note_collaborators nc WHERE nc.note_id = n.id
+    )::uuid[] AS collaborator_ids
+FROM note_links l
+JOIN notes n ON n.id = l.target_note_id
+WHERE l.source_note_id = $1 AND n.deleted_at IS NULL
+ORDER BY l.created_at ASC;
+
+-- name: ListBacklinkNoteLinks :many
+-- Same columns as ListOutgoingNoteLinks, for the notes linking here.
+SELECT
+    l.id,
+    l.link_type,
+    n.id AS note_id,
+    n.title,
+    n.status,
+    n.owner_id,
+    ARRAY(
+        SELECT nc.account_id FROM note_collaborators nc WHERE nc.note_id = n.id
+    )::uuid[] AS collaborator_ids
+FROM note_links l
+JOIN notes n ON n.id = l.source_note_id
+WHERE l.target_note_id = $1 AND n.deleted_at IS NULL
+ORDER BY l.created_at ASC;
+
+-- name: DeleteNoteLinksByNote :exec
+DELETE FROM note_links
+WHERE source_note_id = $1 OR target_note_id = $1;
@@ -0,0 +1,33 @@
+-- name: CreateNoteRevision :one
+-- The next number is derived from existing rows. Callers hold the notes row lock
+-- (the note was just updated in the same transaction), so numbers cannot race.
+INSERT INTO note_revisions (note_id, revision, title, status, actor_id)
+VALUES (
+    $1,
+    (SELECT COALESCE(MAX(r.revision), 0) + 1 FROM note_revisions r WHERE r.note_id = $1),
+    $2,
+    $3,
+    $4
+)
+RETURNING *;
+
+-- name: CreateSectionRevision :exec
+INSERT INTO section_revisions (note_revision_id, field_id, field_label, field_order, content)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListNoteRevisions :many
+SELECT *
+FROM note_revisions
+WHERE note_id = $1
+ORDER BY revision DESC;
+
+-- name: GetNoteRevision :one
+SELECT *
+FROM note_revisions
+WHERE note_id = $1 AND revision = $2;
+
+-- name: ListSectionRevisions :many
+SELECT *
+FROM section_revisions
+WHERE note_revision_id = $1
+ORDER BY field_order ASC;
@@ -0,0 +1,36 @@
+-- name: ListNoteTags :many
+SELECT tag
+FROM note_tags
+WHERE note_id = $1
+ORDER BY tag ASC;
+
+-- name: AddNoteTags :exec
+-- Tags the note already has are kept as they are.
+INSERT INTO note_tags (note_id, tag)
+SELECT $1, unnest($2::text[])
+ON CONFLICT (note_id, tag) DO NOTHING;
+
+-- name: DeleteNoteTagsExcept :exec
+DELETE FROM note_tags
+WHERE note_id = $1 AND NOT (tag = ANY($2::text[]));
+
+-- name: ListTagCounts :many
+-- Counts notes per tag. $1 filters by owner, $2 limits the counted notes to those
+-- the viewer may read (published, owned or shared; only published for a NULL
+-- viewer), $4 to a workspace and $3 caps the tags, most used first. Trashed notes
+-- are not counted.
+SELECT
+    nt.tag,
+    COUNT(*)::int AS note_count
+FROM note_tags nt
+JOIN notes n ON n.id = nt.note_id
+WHERE n.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR n.owner_id = $1)
+  AND (
+    n.status = 'Publish' OR n.owner_id = $2::uuid
+    OR EXISTS (SELECT 1 FROM note_collaborators nc WHERE nc.note_id = n.id AND nc.account_id = $2)
+  )
+  AND ($4::uuid IS NULL OR n.workspace_id = $4)
+GROUP BY nt.tag
+ORDER BY note_count DESC, nt.tag ASC
+LIMIT $3;
@@ -1,4 +1,10 @@
 -- name: ListNotes :many
+-- $4 is an escaped ILIKE pattern; $6-$8 select whether it matches the title,
+-- section contents and field labels. $9/$10 are the keyset cursor and $11 the
+-- page size (0 means no limit). $12 are tags, of which a note needs any or, when
+-- $13 is true, all. $5 limits the notes to those the viewer may read: published,
+-- owned or shared with the viewer; a NULL viewer only sees published notes. $14
+-- limits them to a workspace. Trashed notes are never listed.
 SELECT
     n.*,
     t.name AS template_name,
@@ -8,11 +14,96 @@ SELECT
 FROM notes n
 JOIN templates t ON t.id = n.template_id
 JOIN accounts a ON a.id = n.owner_id
-WHERE (NULLIF($1::text, '') IS NULL OR n.status = $1)
+WHERE n.deleted_at IS NULL
+  AND (NULLIF($1::text, '') IS NULL OR n.status = $1)
   AND ($2::uuid IS NULL OR n.template_id = $2)
   AND ($3::uuid IS NULL OR n.owner_id = $3)
-  AND (NULLIF($4::text, '') IS NULL OR n.title ILIKE '%' || $4 || '%')
-ORDER BY n.updated_at DESC;
+  AND (
+    NULLIF($4::text, '') IS NULL
+    OR ($6::boolean AND n.title ILIKE $4)
+    OR ($7::boolean AND n.id IN (SELECT s.note_id FROM sections s WHERE s.content ILIKE $4))
+    OR ($8::boolean AND n.id IN (
+        SELECT s.note_id FROM sections s JOIN fields f ON f.id = s.field_id WHERE f.label ILIKE $4
+    ))
+  )
+  AND (
+    n.status = 'Publish' OR n.owner_id = $5::uuid
+    OR EXISTS (SELECT 1 FROM note_collaborators nc WHERE nc.note_id = n.id AND nc.account_id = $5)
+  )
+  AND (
+    COALESCE(cardinality($12::text[]), 0) = 0
+    OR n.id IN (
+        SELECT nt.note_id FROM note_tags nt
+        WHERE nt.tag = ANY($12::text[])
+        GROUP BY nt.note_id
+        HAVING NOT $13::boolean OR COUNT(*) = cardinality($12::text[])
+    )
+  )
+  AND ($14::uuid IS NULL OR n.workspace_id = $14)
+  AND ($9::timestamptz IS NULL OR (n.updated_at, n.id) < ($9::timestamptz, $10::uuid))
+ORDER BY n.updated_at DESC, n.id DESC
+LIMIT NULLIF($11::int, 0);
+
+-- name: SearchNotes :many
+-- Same filters as ListNotes, but a keyword is required and results are ranked:
+-- a title hit weighs 3, each matching section 2 and each matching field label 1,
+-- with trigram similarity of the title ($9 is the raw keyword) as a tie-breaker.
+-- The first matching content and label (in field order) are returned for snippets.
+-- $11/$12 filter by tags as $12/$13 of ListNotes do, and $13 by workspace.
+SELECT
+    n.*,
+    t.name AS template_name,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS owner_thumbnail,
+    m.matched_content::text AS matched_content,
+    m.matched_label::text AS matched_label,
+    (
+        (CASE WHEN $6::boolean AND n.title ILIKE $4::text THEN 3 ELSE 0 END)
+        + 2 * m.content_hits
+        + m.label_hits
+        + similarity(n.title, $9::text)
+    )::float8 AS rank
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+JOIN accounts a ON a.id = n.owner_id
+CROSS JOIN LATERAL (
+    SELECT
+        COUNT(*) FILTER (WHERE $7::boolean AND s.content ILIKE $4) AS content_hits,
+        COUNT(*) FILTER (WHERE $8::boolean AND f.label ILIKE $4) AS label_hits,
+        COALESCE((ARRAY_AGG(s.content ORDER BY f."order") FILTER (WHERE $7::boolean AND s.content ILIKE $4))[1], '') AS matched_content,
+        COALESCE((ARRAY_AGG(f.label ORDER BY f."order") FILTER (WHERE $8::boolean AND f.label ILIKE $4))[1], '') AS matched_label
+    FROM sections s
+    JOIN fields f ON f.id = s.field_id
+    WHERE s.note_id = n.id
+) m
+WHERE n.deleted_at IS NULL
+  AND (NULLIF($1::text, '') IS NULL OR n.status = $1)
+  AND ($2::uuid IS NULL OR n.template_id = $2)
+  AND ($3::uuid IS NULL OR n.owner_id = $3)
+  AND (
+    ($6::boolean AND n.title ILIKE $4)
+    OR ($7::boolean AND n.id IN (SELECT s.note_id FROM sections s WHERE s.content ILIKE $4))
+    OR ($8::boolean AND n.id IN (
+        SELECT s.note_id FROM sections s JOIN fields f ON f.id = s.field_id WHERE f.label ILIKE $4
+    ))
+  )
+  AND (
+    n.status = 'Publish' OR n.owner_id = $5::uuid
+    OR EXISTS (SELECT 1 FROM note_collaborators nc WHERE nc.note_id = n.id AND nc.account_id = $5)
+  )
+  AND (
+    COALESCE(cardinality($11::text[]), 0) = 0
+    OR n.id IN (
+        SELECT nt.note_id FROM note_tags nt
+        WHERE nt.tag = ANY($11::text[])
+        GROUP BY nt.note_id
+        HAVING NOT $12::boolean OR COUNT(*) = cardinality($11::text[])
+    )
+  )
+  AND ($13::uuid IS NULL OR n.workspace_id = $13)
+ORDER BY rank DESC, n.updated_at DESC
+LIMIT $10;
 
 -- name: GetNoteByID :one
 SELECT
@@ -24,22 +115,80 @@ SELECT
 FROM notes n
 JOIN templates t ON t.id = n.template_id
 JOIN accounts a ON a.id = n.owner_id
-WHERE n.id = $1;
+WHERE n.id = $1 AND n.deleted_at IS NULL
+  AND ($2::uuid IS NULL OR n.workspace_id = $2);
+
+-- name: GetTrashedNoteByID :one
+SELECT
+    n.*,
+    t.name AS template_name,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS owner_thumbnail
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+JOIN accounts a ON a.id = n.owner_id
+WHERE n.id = $1 AND n.deleted_at IS NOT NULL
+  AND ($2::uuid IS NULL OR n.workspace_id = $2);
+
+-- name: ListTrashedNotes :many
+-- Most recently trashed first. $1 limits the notes to an owner and $2 to notes
+-- trashed before that time (both optional); $3/$4 are the (deleted_at, id) keyset
+-- cursor and $5 the page size. $6 limits the notes to a workspace.
+SELECT
+    n.*,
+    t.name AS template_name,
+    a.first_name,
+    a.last_name,
+    a.thumbnail AS owner_thumbnail
+FROM notes n
+JOIN templates t ON t.id = n.template_id
+JOIN accounts a ON a.id = n.owner_id
+WHERE n.deleted_at IS NOT NULL
+  AND ($1::uuid IS NULL OR n.owner_id = $1)
+  AND ($2::timestamptz IS NULL OR n.deleted_at < $2)
+  AND ($6::uuid IS NULL OR n.workspace_id = $6)
+  AND ($3::timestamptz IS NULL OR (n.deleted_at, n.id) < ($3::timestamptz, $4::uuid))
+ORDER BY n.deleted_at DESC, n.id DESC
+LIMIT $5;
 
 -- name: CreateNote :one
-INSERT INTO notes (title, template_id, owner_id, status)
-VALUES ($1, $2, $3, $4)
+INSERT INTO notes (title, template_id, owner_id, status, template_version, workspace_id)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: UpdateNote :one
+-- Only updates when the stored version still matches $3; no row means a concurrent update won.
 UPDATE notes
 SET
     title = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: UpdateNoteTemplateVersion :one
+-- Pins the note to another template version; conditional on the version like UpdateNote.
+UPDATE notes
+SET
+    template_version = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: TrashNote :execrows
+UPDATE notes
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: RestoreNote :execrows
+UPDATE notes
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
 -- name: DeleteNote :exec
+-- Purges the note for good; sections and revisions go with it.
 DELETE FROM notes
 WHERE id = $1;
 
@@ -47,8 +196,9 @@ WHERE id = $1;
 UPDATE notes
 SET
     status = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
 -- name: ListSectionsByNote :many
@@ -56,7 +206,9 @@ SELECT
     s.*,
     f.label,
     f."order",
-    f.is_required
+    f.is_required,
+    f.type,
+    f.options
 FROM sections s
 JOIN fields f ON f.id = s.field_id
 WHERE s.note_id = $1
@@ -0,0 +1,37 @@
+-- name: InsertOutboxEvent :one
+INSERT INTO outbox (event_type, aggregate_type, aggregate_id, actor_id, payload)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ClaimPendingOutboxEvents :many
+-- Only the oldest undelivered event of each aggregate is claimable, so a later event
+-- is never delivered before an earlier one of the same aggregate, even across relays.
+SELECT o.*
+FROM outbox o
+WHERE o.delivered_at IS NULL
+  AND o.next_attempt_at <= NOW()
+  AND NOT EXISTS (
+    SELECT 1
+    FROM outbox p
+    WHERE p.aggregate_type = o.aggregate_type
+      AND p.aggregate_id = o.aggregate_id
+      AND p.delivered_at IS NULL
+      AND p.id < o.id
+  )
+ORDER BY o.id
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxEventDelivered :exec
+UPDATE outbox
+SET delivered_at = NOW(),
+    attempts = attempts + 1,
+    last_error = ''
+WHERE id = $1;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox
+SET attempts = attempts + 1,
+    last_error = $2,
+    next_attempt_at = $3
+WHERE id = $1;
@@ -0,0 +1,21 @@
+-- name: GetRateLimitBucket :one
+SELECT key, tokens, version, updated_at, full_at
+FROM rate_limit_buckets
+WHERE key = $1;
+
+-- name: InsertRateLimitBucket :execrows
+INSERT INTO rate_limit_buckets (key, tokens, updated_at, full_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (key) DO NOTHING;
+
+-- name: UpdateRateLimitBucket :execrows
+UPDATE rate_limit_buckets
+SET tokens = $2,
+    updated_at = $3,
+    full_at = $4,
+    version = version + 1
+WHERE key = $1 AND version = $5;
+
+-- name: DeleteFullRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets
+WHERE full_at < $1;
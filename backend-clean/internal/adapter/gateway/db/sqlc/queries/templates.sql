@@ -1,4 +1,6 @@
 -- name: ListTemplates :many
+-- $3/$4 are the keyset cursor and $5 the page size (0 means no limit).
+-- $6 limits the templates to a workspace. Trashed templates are never listed.
 SELECT
     t.*,
     a.first_name AS owner_first_name,
@@ -7,14 +9,22 @@ SELECT
     EXISTS (
         SELECT 1
         FROM notes n
-        WHERE n.template_id = t.id
-        LIMIT 1
-    ) AS is_used
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
+    ) AS is_used,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NOT NULL
+    ) AS is_used_in_trash
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
-WHERE ($1::uuid IS NULL OR t.owner_id = $1)
+WHERE t.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR t.owner_id = $1)
   AND ($2::text IS NULL OR t.name ILIKE '%' || $2 || '%')
-ORDER BY t.updated_at DESC;
+  AND ($6::uuid IS NULL OR t.workspace_id = $6)
+  AND ($3::timestamptz IS NULL OR (t.updated_at, t.id) < ($3::timestamptz, $4::uuid))
+ORDER BY t.updated_at DESC, t.id DESC
+LIMIT NULLIF($5::int, 0);
 
 -- name: GetTemplateByID :one
 SELECT
@@ -25,44 +35,134 @@ SELECT
     EXISTS (
         SELECT 1
         FROM notes n
-        WHERE n.template_id = t.id
-        LIMIT 1
-    ) AS is_used
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
+    ) AS is_used,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NOT NULL
+    ) AS is_used_in_trash
 FROM templates t
 JOIN accounts a ON a.id = t.owner_id
-WHERE t.id = $1;
+WHERE t.id = $1 AND t.deleted_at IS NULL
+  AND ($2::uuid IS NULL OR t.workspace_id = $2);
+
+-- name: GetTrashedTemplateByID :one
+SELECT
+    t.*,
+    a.first_name AS owner_first_name,
+    a.last_name AS owner_last_name,
+    a.thumbnail AS owner_thumbnail,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
+    ) AS is_used,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NOT NULL
+    ) AS is_used_in_trash
+FROM templates t
+JOIN accounts a ON a.id = t.owner_id
+WHERE t.id = $1 AND t.deleted_at IS NOT NULL
+  AND ($2::uuid IS NULL OR t.workspace_id = $2);
+
+-- name: ListTrashedTemplates :many
+-- Most recently trashed first; parameters as in ListTrashedNotes.
+SELECT
+    t.*,
+    a.first_name AS owner_first_name,
+    a.last_name AS owner_last_name,
+    a.thumbnail AS owner_thumbnail,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NULL
+    ) AS is_used,
+    EXISTS (
+        SELECT 1
+        FROM notes n
+        WHERE n.template_id = t.id AND n.deleted_at IS NOT NULL
+    ) AS is_used_in_trash
+FROM templates t
+JOIN accounts a ON a.id = t.owner_id
+WHERE t.deleted_at IS NOT NULL
+  AND ($1::uuid IS NULL OR t.owner_id = $1)
+  AND ($2::timestamptz IS NULL OR t.deleted_at < $2)
+  AND ($6::uuid IS NULL OR t.workspace_id = $6)
+  AND ($3::timestamptz IS NULL OR (t.deleted_at, t.id) < ($3::timestamptz, $4::uuid))
+ORDER BY t.deleted_at DESC, t.id DESC
+LIMIT $5;
 
 -- name: CreateTemplate :one
-INSERT INTO templates (name, owner_id)
-VALUES ($1, $2)
+-- Without a workspace ($3) the template goes to the owner's default workspace,
+-- the one the owner joined first.
+INSERT INTO templates (name, owner_id, workspace_id)
+VALUES (
+    $1,
+    $2,
+    COALESCE(
+        $3::uuid,
+        (
+            SELECT m.workspace_id
+            FROM workspace_members m
+            WHERE m.account_id = $2
+            ORDER BY m.created_at ASC, m.workspace_id ASC
+            LIMIT 1
+        )
+    )
+)
 RETURNING *;
 
 -- name: UpdateTemplate :one
+-- Only updates when the stored version still matches $3; no row means a concurrent update won.
 UPDATE templates
 SET
     name = $2,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND version = $3 AND deleted_at IS NULL
 RETURNING *;
 
+-- name: TrashTemplate :execrows
+UPDATE templates
+SET deleted_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: RestoreTemplate :execrows
+UPDATE templates
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
 -- name: DeleteTemplate :exec
+-- Purges the template for good; its fields go with it.
 DELETE FROM templates
 WHERE id = $1;
 
 -- name: CheckTemplateInUse :one
+-- Notes in the trash count as uses: they still need the template to be restored.
 SELECT EXISTS (
     SELECT 1 FROM notes WHERE template_id = $1
 ) AS is_used;
 
 -- name: ListFieldsByTemplate :many
+-- Returns the fields of the latest field version.
+SELECT f.*
+FROM fields f
+WHERE f.template_id = $1
+  AND f.version = (SELECT MAX(latest.version) FROM fields latest WHERE latest.template_id = f.template_id)
+ORDER BY f."order" ASC;
+
+-- name: ListFieldsByTemplateVersion :many
 SELECT *
 FROM fields
-WHERE template_id = $1
+WHERE template_id = $1 AND version = $2
 ORDER BY "order" ASC;
 
 -- name: CreateField :one
-INSERT INTO fields (template_id, label, "order", is_required)
-VALUES ($1, $2, $3, $4)
+INSERT INTO fields (template_id, label, "order", is_required, version, type, options)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
 -- name: UpdateField :one
@@ -70,13 +170,22 @@ UPDATE fields
 SET
     label = $2,
     "order" = $3,
-    is_required = $4
+    is_required = $4,
+    type = $5,
+    options = $6
 WHERE id = $1
 RETURNING *;
 
--- name: DeleteFieldsByTemplate :exec
-DELETE FROM fields
-WHERE template_id = $1;
+-- name: DeleteUnpinnedFieldVersions :exec
+-- Removes earlier field versions that no note is pinned to any more.
+DELETE FROM fields f
+WHERE f.template_id = $1
+  AND f.version < (SELECT MAX(latest.version) FROM fields latest WHERE latest.template_id = f.template_id)
+  AND NOT EXISTS (
+    SELECT 1
+    FROM notes n
+    WHERE n.template_id = f.template_id AND n.template_version = f.version
+  );
 
 -- name: DeleteField :exec
 DELETE FROM fields
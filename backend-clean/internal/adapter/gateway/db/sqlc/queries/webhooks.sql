@@ -0,0 +1,76 @@
+-- name: ListWebhooksByOwner :many
+SELECT * FROM webhooks
+WHERE owner_id = $1
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetWebhookByID :one
+SELECT * FROM webhooks
+WHERE id = $1;
+
+-- name: CreateWebhook :one
+INSERT INTO webhooks (owner_id, url, secret, events, is_active)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateWebhook :one
+UPDATE webhooks
+SET url = $2,
+    events = $3,
+    is_active = $4,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE id = $1;
+
+-- name: CreateWebhookDelivery :one
+-- Enqueuing an event the webhook already has is a no-op that returns the stored row,
+-- so a relay redelivering the event does not send it twice.
+INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, redelivery_of)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (webhook_id, event_id) WHERE redelivery_of IS NULL
+DO UPDATE SET event_type = EXCLUDED.event_type
+RETURNING *;
+
+-- name: GetWebhookDeliveryByID :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+-- Newest first. $2/$3 are the keyset cursor (created_at, id) and $4 the page size.
+SELECT * FROM webhook_deliveries
+WHERE webhook_id = $1
+  AND ($2::timestamptz IS NULL OR (created_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4;
+
+-- name: ClaimDueWebhookDeliveries :many
+-- Leases due deliveries of active webhooks by moving next_attempt_at to $2, so other
+-- workers skip them while they are being sent. If a worker dies mid-send, the
+-- delivery becomes due again when the lease runs out.
+WITH due AS (
+    SELECT d.id
+    FROM webhook_deliveries d
+    JOIN webhooks w ON w.id = d.webhook_id
+    WHERE d.status = 'pending' AND d.next_attempt_at <= NOW() AND w.is_active
+    ORDER BY d.next_attempt_at, d.id
+    LIMIT $1
+    FOR UPDATE OF d SKIP LOCKED
+)
+UPDATE webhook_deliveries wd
+SET next_attempt_at = $2
+FROM due, webhooks w
+WHERE wd.id = due.id AND w.id = wd.webhook_id
+RETURNING wd.*, w.url, w.secret;
+
+-- name: SaveWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = $2,
+    attempts = $3,
+    next_attempt_at = $4,
+    last_status_code = $5,
+    last_error = $6,
+    delivered_at = $7
+WHERE id = $1;
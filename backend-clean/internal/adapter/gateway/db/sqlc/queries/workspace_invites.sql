@@ -0,0 +1,41 @@
+-- name: ListWorkspaceInvites :many
+SELECT
+    i.*,
+    w.name AS workspace_name
+FROM workspace_invites i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE i.workspace_id = $1
+ORDER BY i.created_at ASC, i.id ASC;
+
+-- name: ListWorkspaceInvitesByEmail :many
+-- Invites are stored with lower-cased emails; $1 must be lower-cased as well.
+SELECT
+    i.*,
+    w.name AS workspace_name
+FROM workspace_invites i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE i.email = $1
+ORDER BY i.created_at ASC, i.id ASC;
+
+-- name: GetWorkspaceInviteByID :one
+SELECT
+    i.*,
+    w.name AS workspace_name
+FROM workspace_invites i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE i.id = $1;
+
+-- name: UpsertWorkspaceInvite :one
+-- Inviting the same email again updates the role of the pending invite.
+INSERT INTO workspace_invites (workspace_id, email, role, invited_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (workspace_id, email) DO UPDATE
+SET
+    role = EXCLUDED.role,
+    invited_by = EXCLUDED.invited_by,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteWorkspaceInvite :execrows
+DELETE FROM workspace_invites
+WHERE id = $1;
@@ -0,0 +1,69 @@
+-- name: ListWorkspacesByAccount :many
+-- The account's workspaces with its role in each, in the order they were joined.
+SELECT
+    w.*,
+    m.role
+FROM workspaces w
+JOIN workspace_members m ON m.workspace_id = w.id
+WHERE m.account_id = $1
+ORDER BY m.created_at ASC, w.id ASC;
+
+-- name: GetWorkspaceByID :one
+SELECT *
+FROM workspaces
+WHERE id = $1;
+
+-- name: CreateWorkspace :one
+INSERT INTO workspaces (name)
+VALUES ($1)
+RETURNING *;
+
+-- name: UpdateWorkspace :one
+UPDATE workspaces
+SET
+    name = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWorkspace :execrows
+-- Members and invites go with the workspace.
+DELETE FROM workspaces
+WHERE id = $1;
+
+-- name: WorkspaceHasContents :one
+-- Trashed templates and notes count: they still belong to the workspace.
+SELECT (
+    EXISTS (SELECT 1 FROM templates t WHERE t.workspace_id = $1)
+    OR EXISTS (SELECT 1 FROM notes n WHERE n.workspace_id = $1)
+)::boolean AS has_contents;
+
+-- name: GetWorkspaceMember :one
+SELECT *
+FROM workspace_members
+WHERE workspace_id = $1 AND account_id = $2;
+
+-- name: ListWorkspaceMembers :many
+SELECT
+    m.*,
+    a.email,
+    a.first_name,
+    a.last_name,
+    a.thumbnail
+FROM workspace_members m
+JOIN accounts a ON a.id = m.account_id
+WHERE m.workspace_id = $1
+ORDER BY m.created_at ASC, m.account_id ASC;
+
+-- name: UpsertWorkspaceMember :one
+INSERT INTO workspace_members (workspace_id, account_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (workspace_id, account_id) DO UPDATE
+SET
+    role = EXCLUDED.role,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteWorkspaceMember :execrows
+DELETE FROM workspace_members
+WHERE workspace_id = $1 AND account_id = $2;
@@ -0,0 +1,95 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+const (
+	// maxTakeAttempts bounds the compare-and-swap retries of one take.
+	maxTakeAttempts = 5
+	// rateLimitSweepEvery is how many takes pass between deletions of full buckets.
+	rateLimitSweepEvery = 1024
+)
+
+// RateLimitStore keeps token buckets in Postgres so every API instance shares them.
+type RateLimitStore struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+	takes   atomic.Int64
+}
+
+var _ port.RateLimitStore = (*RateLimitStore)(nil)
+
+// NewRateLimitStore creates RateLimitStore.
+func NewRateLimitStore(pool *pgxpool.Pool) *RateLimitStore {
+	return &RateLimitStore{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Take reads the bucket, takes a token in memory and writes it back only if nobody
+// else wrote it in between, retrying otherwise. A bucket that stays contended for
+// maxTakeAttempts rounds refuses the request rather than overspend.
+func (s *RateLimitStore) Take(ctx context.Context, key string, policy ratelimit.Policy, now time.Time) (ratelimit.Decision, error) {
+	q := queriesForContext(ctx, s.queries)
+	if s.takes.Add(1)%rateLimitSweepEvery == 0 {
+		if _, err := q.DeleteFullRateLimitBuckets(ctx, pgtype.Timestamptz{Time: now, Valid: true}); err != nil {
+			return ratelimit.Decision{}, err
+		}
+	}
+
+	var decision ratelimit.Decision
+	for range maxTakeAttempts {
+		row, err := q.GetRateLimitBucket(ctx, key)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return ratelimit.Decision{}, err
+		}
+
+		var affected int64
+		if row == nil {
+			var bucket ratelimit.Bucket
+			bucket, decision = policy.Take(policy.Full(now), now)
+			affected, err = q.InsertRateLimitBucket(ctx, &generated.InsertRateLimitBucketParams{
+				Key:       key,
+				Tokens:    bucket.Tokens,
+				UpdatedAt: pgtype.Timestamptz{Time: bucket.UpdatedAt, Valid: true},
+				FullAt:    pgtype.Timestamptz{Time: now.Add(decision.Reset), Valid: true},
+			})
+		} else {
+			var bucket ratelimit.Bucket
+			bucket, decision = policy.Take(ratelimit.Bucket{Tokens: row.Tokens, UpdatedAt: row.UpdatedAt.Time}, now)
+			affected, err = q.UpdateRateLimitBucket(ctx, &generated.UpdateRateLimitBucketParams{
+				Key:       key,
+				Tokens:    bucket.Tokens,
+				UpdatedAt: pgtype.Timestamptz{Time: bucket.UpdatedAt, Valid: true},
+				FullAt:    pgtype.Timestamptz{Time: now.Add(decision.Reset), Valid: true},
+				Version:   row.Version,
+			})
+		}
+		if err != nil {
+			return ratelimit.Decision{}, err
+		}
+		if affected == 1 {
+			return decision, nil
+		}
+	}
+
+	decision.Allowed = false
+	decision.Remaining = 0
+	if decision.RetryAfter == 0 {
+		decision.RetryAfter = time.Second
+	}
+	return decision, nil
+}
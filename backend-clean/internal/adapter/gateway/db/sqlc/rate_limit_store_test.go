@@ -0,0 +1,87 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+)
+
+func TestRateLimitStore_Take(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	policy := ratelimit.Policy{Limit: 60, Window: time.Minute}
+	empty := &generated.RateLimitBucket{Key: "write:account:a", Tokens: 0, Version: 3, UpdatedAt: pgtype.Timestamptz{Time: now, Valid: true}}
+	half := &generated.RateLimitBucket{Key: "write:account:a", Tokens: 30, Version: 3, UpdatedAt: pgtype.Timestamptz{Time: now, Valid: true}}
+	dbErr := errors.New("db down")
+
+	tests := []struct {
+		name        string
+		row         *generated.RateLimitBucket
+		rowErr      error
+		execErr     error
+		affected    []int64
+		wantAllowed bool
+		wantRemain  int
+		wantExecs   int
+		wantVersion int64
+		wantErr     error
+	}{
+		{name: "[Success] new bucket starts full", wantAllowed: true, wantRemain: 59, wantExecs: 1},
+		{name: "[Success] existing bucket", row: half, wantAllowed: true, wantRemain: 29, wantExecs: 1, wantVersion: 3},
+		{name: "[Success] retries after a concurrent write", row: half, affected: []int64{0, 1}, wantAllowed: true, wantRemain: 29, wantExecs: 2, wantVersion: 3},
+		{name: "[Fail] empty bucket", row: empty, wantAllowed: false, wantRemain: 0, wantExecs: 1, wantVersion: 3},
+		{name: "[Fail] refuses while contended", row: half, affected: []int64{0, 0, 0, 0, 0}, wantAllowed: false, wantRemain: 0, wantExecs: maxTakeAttempts, wantVersion: 3},
+		{name: "[Fail] read error", rowErr: dbErr, wantErr: dbErr},
+		{name: "[Fail] write error", row: half, execErr: dbErr, wantExecs: 1, wantVersion: 3, wantErr: dbErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := mockdb.NewRateLimitDBTX(tt.row, tt.rowErr, tt.execErr, tt.affected...)
+			store := &RateLimitStore{queries: generated.New(db)}
+			got, err := store.Take(context.Background(), "write:account:a", policy, now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Allowed != tt.wantAllowed || got.Remaining != tt.wantRemain {
+				t.Fatalf("want allowed=%v remaining=%d, got %+v", tt.wantAllowed, tt.wantRemain, got)
+			}
+			if len(db.ExecArgs) != tt.wantExecs {
+				t.Fatalf("want %d writes, got %d", tt.wantExecs, len(db.ExecArgs))
+			}
+			if tt.wantVersion != 0 {
+				// UpdateRateLimitBucket compares against the version that was read.
+				if v := db.ExecArgs[0][4]; v != tt.wantVersion {
+					t.Errorf("version = %v, want %d", v, tt.wantVersion)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitStore_TakeSweepsFullBuckets(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	db := mockdb.NewRateLimitDBTX(nil, nil, nil)
+	store := &RateLimitStore{queries: generated.New(db)}
+	store.takes.Store(rateLimitSweepEvery - 1)
+
+	if _, err := store.Take(context.Background(), "read:ip:192.0.2.1", ratelimit.Policy{Limit: 1, Window: time.Minute}, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(db.ExecArgs) != 2 {
+		t.Fatalf("want sweep and insert, got %d writes", len(db.ExecArgs))
+	}
+	if cutoff, ok := db.ExecArgs[0][0].(pgtype.Timestamptz); !ok || !cutoff.Time.Equal(now) {
+		t.Fatalf("sweep cutoff = %v, want %v", db.ExecArgs[0][0], now)
+	}
+}
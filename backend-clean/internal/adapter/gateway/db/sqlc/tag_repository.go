@@ -0,0 +1,65 @@
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TagRepository implements tag usage queries.
+type TagRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.TagRepository = (*TagRepository)(nil)
+
+// NewTagRepository creates TagRepository.
+func NewTagRepository(pool *pgxpool.Pool) *TagRepository {
+	return &TagRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// ListCounts returns tags with the number of notes carrying them, most used first.
+func (r *TagRepository) ListCounts(ctx context.Context, filters note.TagFilters) ([]note.TagCount, error) {
+	var ownerID, viewerID pgtype.UUID
+	if filters.OwnerID != nil && *filters.OwnerID != "" {
+		if id, err := toUUID(*filters.OwnerID); err == nil {
+			ownerID = id
+		}
+	}
+	if filters.ViewerID != "" {
+		// Visibility must fail closed: an unparsable viewer is an error, not "no filter".
+		id, err := toUUID(filters.ViewerID)
+		if err != nil {
+			return nil, err
+		}
+		viewerID = id
+	}
+	// The workspace scope fails closed like visibility.
+	workspaceID, err := workspaceScope(ctx, filters.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListTagCounts(ctx, &generated.ListTagCountsParams{
+		Column1: ownerID,
+		Column2: viewerID,
+		Limit:   int32(filters.Limit), //nolint:gosec
+		Column4: workspaceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]note.TagCount, 0, len(rows))
+	for _, row := range rows {
+		counts = append(counts, note.TagCount{Tag: note.Tag(row.Tag), Count: int(row.NoteCount)})
+	}
+	return counts, nil
+}
@@ -0,0 +1,51 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestTagRepository_ListCounts(t *testing.T) {
+	owner := "00000000-0000-0000-0000-000000000003"
+	badOwner := "bad-uuid"
+	rows := []*generated.ListTagCountsRow{{Tag: "auth", NoteCount: 3}, {Tag: "api", NoteCount: 1}}
+	tests := []struct {
+		name     string
+		filters  note.TagFilters
+		queryErr error
+		want     []note.TagCount
+		wantErr  bool
+	}{
+		{name: "[Success] counts for viewer", filters: note.TagFilters{ViewerID: owner, Limit: 10}, want: []note.TagCount{{Tag: "auth", Count: 3}, {Tag: "api", Count: 1}}},
+		{name: "[Success] counts for owner", filters: note.TagFilters{OwnerID: &owner, Limit: 10}, want: []note.TagCount{{Tag: "auth", Count: 3}, {Tag: "api", Count: 1}}},
+		{name: "[Success] invalid owner is ignored", filters: note.TagFilters{OwnerID: &badOwner, Limit: 10}, want: []note.TagCount{{Tag: "auth", Count: 3}, {Tag: "api", Count: 1}}},
+		{name: "[Fail] invalid viewer uuid", filters: note.TagFilters{ViewerID: "bad-uuid"}, wantErr: true},
+		{name: "[Fail] query error", queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewNoteDBTX(nil, nil, nil).WithList(nil, nil, tt.queryErr).WithTagCounts(rows)
+			repo := &TagRepository{queries: generated.New(mock)}
+			tt.filters.WorkspaceID = testWorkspaceID
+			got, err := repo.ListCounts(context.Background(), tt.filters)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) || got[0] != tt.want[0] || got[1] != tt.want[1] {
+				t.Fatalf("want %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -56,6 +57,17 @@ func (r *TemplateRepository) List(ctx context.Context, filters template.Filters)
 	if filters.Query != nil && *filters.Query != "" {
 		params.Column2 = *filters.Query
 	}
+	afterUpdatedAt, afterID, err := toKeyset(filters.After)
+	if err != nil {
+		return nil, err
+	}
+	params.Column3 = afterUpdatedAt
+	params.Column4 = afterID
+	params.Column5 = int32(filters.Limit) //nolint:gosec
+	// The workspace scope fails closed: a missing or malformed ID is an error, not "every workspace".
+	if params.Column6, err = workspaceScope(ctx, filters.WorkspaceID); err != nil {
+		return nil, err
+	}
 
 	rows, err := queriesForContext(ctx, r.queries).ListTemplates(ctx, params)
 	if err != nil {
@@ -64,21 +76,25 @@ func (r *TemplateRepository) List(ctx context.Context, filters template.Filters)
 
 	result := make([]template.WithUsage, 0, len(rows))
 	for _, row := range rows {
-		fields, err := r.listFields(ctx, row.ID)
+		fields, fieldsVersion, err := r.listFields(ctx, row.ID)
 		if err != nil {
 			return nil, err
 		}
 		owner := toTemplateOwner(row.OwnerID, row.OwnerFirstName, row.OwnerLastName, row.OwnerThumbnail)
 		result = append(result, template.WithUsage{
 			Template: template.Template{
-				ID:        uuidToString(row.ID),
-				Name:      row.Name,
-				OwnerID:   uuidToString(row.OwnerID),
-				UpdatedAt: timestamptzToTime(row.UpdatedAt),
-				Fields:    fields,
+				ID:            uuidToString(row.ID),
+				Name:          row.Name,
+				OwnerID:       uuidToString(row.OwnerID),
+				WorkspaceID:   uuidToString(row.WorkspaceID),
+				Version:       int(row.Version),
+				FieldsVersion: fieldsVersion,
+				UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+				Fields:        fields,
 			},
-			IsUsed: row.IsUsed,
-			Owner:  owner,
+			IsUsed:        row.IsUsed,
+			IsUsedInTrash: row.IsUsedInTrash,
+			Owner:         owner,
 		})
 	}
 	return result, nil
@@ -90,28 +106,36 @@ func (r *TemplateRepository) Get(ctx context.Context, id string) (*template.With
 	if err != nil {
 		return nil, err
 	}
-	row, err := queriesForContext(ctx, r.queries).GetTemplateByID(ctx, pgID)
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetTemplateByID(ctx, &generated.GetTemplateByIDParams{ID: pgID, Column2: workspaceID})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domainerr.ErrNotFound
 		}
 		return nil, err
 	}
-	fields, err := r.listFields(ctx, row.ID)
+	fields, fieldsVersion, err := r.listFields(ctx, row.ID)
 	if err != nil {
 		return nil, err
 	}
 	owner := toTemplateOwner(row.OwnerID, row.OwnerFirstName, row.OwnerLastName, row.OwnerThumbnail)
 	return &template.WithUsage{
 		Template: template.Template{
-			ID:        uuidToString(row.ID),
-			Name:      row.Name,
-			OwnerID:   uuidToString(row.OwnerID),
-			UpdatedAt: timestamptzToTime(row.UpdatedAt),
-			Fields:    fields,
+			ID:            uuidToString(row.ID),
+			Name:          row.Name,
+			OwnerID:       uuidToString(row.OwnerID),
+			WorkspaceID:   uuidToString(row.WorkspaceID),
+			Version:       int(row.Version),
+			FieldsVersion: fieldsVersion,
+			UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+			Fields:        fields,
 		},
-		IsUsed: row.IsUsed,
-		Owner:  owner,
+		IsUsed:        row.IsUsed,
+		IsUsedInTrash: row.IsUsedInTrash,
+		Owner:         owner,
 	}, nil
 }
 
@@ -121,46 +145,174 @@ func (r *TemplateRepository) Create(ctx context.Context, tpl template.Template)
 	if err != nil {
 		return nil, err
 	}
+	// Without a workspace the template goes to the owner's default workspace.
+	workspaceID, err := toNullableUUID(tpl.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
 	row, err := queriesForContext(ctx, r.queries).CreateTemplate(ctx, &generated.CreateTemplateParams{
 		Name:    tpl.Name,
 		OwnerID: owner,
+		Column3: workspaceID,
 	})
 	if err != nil {
 		return nil, err
 	}
 	return &template.Template{
-		ID:        uuidToString(row.ID),
-		Name:      row.Name,
-		OwnerID:   uuidToString(row.OwnerID),
-		UpdatedAt: timestamptzToTime(row.UpdatedAt),
+		ID:          uuidToString(row.ID),
+		Name:        row.Name,
+		OwnerID:     uuidToString(row.OwnerID),
+		WorkspaceID: uuidToString(row.WorkspaceID),
+		Version:     int(row.Version),
+		UpdatedAt:   timestamptzToTime(row.UpdatedAt),
 	}, nil
 }
 
-// Update updates template name.
+// Update updates template name if tpl.Version is still the stored version.
+// A version mismatch is reported as ErrConflict.
 func (r *TemplateRepository) Update(ctx context.Context, tpl template.Template) (*template.Template, error) {
 	pgID, err := toUUID(tpl.ID)
 	if err != nil {
 		return nil, err
 	}
 	row, err := queriesForContext(ctx, r.queries).UpdateTemplate(ctx, &generated.UpdateTemplateParams{
-		ID:   pgID,
-		Name: tpl.Name,
+		ID:      pgID,
+		Name:    tpl.Name,
+		Version: int32(tpl.Version), //nolint:gosec
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domainerr.ErrNotFound
+			return nil, domainerr.ErrConflict
 		}
 		return nil, err
 	}
 	return &template.Template{
-		ID:        uuidToString(row.ID),
-		Name:      row.Name,
-		OwnerID:   uuidToString(row.OwnerID),
-		UpdatedAt: timestamptzToTime(row.UpdatedAt),
+		ID:          uuidToString(row.ID),
+		Name:        row.Name,
+		OwnerID:     uuidToString(row.OwnerID),
+		WorkspaceID: uuidToString(row.WorkspaceID),
+		Version:     int(row.Version),
+		UpdatedAt:   timestamptzToTime(row.UpdatedAt),
+	}, nil
+}
+
+// ListTrash returns trashed templates, most recently trashed first.
+func (r *TemplateRepository) ListTrash(ctx context.Context, filters trash.Filters) ([]template.WithUsage, error) {
+	params, err := toTrashParams(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListTrashedTemplates(ctx, &generated.ListTrashedTemplatesParams{
+		Column1: params.ownerID,
+		Column2: params.trashedBefore,
+		Column3: params.afterDeletedAt,
+		Column4: params.afterID,
+		Limit:   params.limit,
+		Column6: params.workspaceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]template.WithUsage, 0, len(rows))
+	for _, row := range rows {
+		fields, fieldsVersion, err := r.listFields(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		owner := toTemplateOwner(row.OwnerID, row.OwnerFirstName, row.OwnerLastName, row.OwnerThumbnail)
+		result = append(result, template.WithUsage{
+			Template: template.Template{
+				ID:            uuidToString(row.ID),
+				Name:          row.Name,
+				OwnerID:       uuidToString(row.OwnerID),
+				WorkspaceID:   uuidToString(row.WorkspaceID),
+				Version:       int(row.Version),
+				FieldsVersion: fieldsVersion,
+				UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+				DeletedAt:     timestamptzToTimePtr(row.DeletedAt),
+				Fields:        fields,
+			},
+			IsUsed:        row.IsUsed,
+			IsUsedInTrash: row.IsUsedInTrash,
+			Owner:         owner,
+		})
+	}
+	return result, nil
+}
+
+// GetTrashed returns a trashed template with usage and fields.
+func (r *TemplateRepository) GetTrashed(ctx context.Context, id string) (*template.WithUsage, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).GetTrashedTemplateByID(ctx, &generated.GetTrashedTemplateByIDParams{ID: pgID, Column2: workspaceID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	fields, fieldsVersion, err := r.listFields(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	owner := toTemplateOwner(row.OwnerID, row.OwnerFirstName, row.OwnerLastName, row.OwnerThumbnail)
+	return &template.WithUsage{
+		Template: template.Template{
+			ID:            uuidToString(row.ID),
+			Name:          row.Name,
+			OwnerID:       uuidToString(row.OwnerID),
+			WorkspaceID:   uuidToString(row.WorkspaceID),
+			Version:       int(row.Version),
+			FieldsVersion: fieldsVersion,
+			UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+			DeletedAt:     timestamptzToTimePtr(row.DeletedAt),
+			Fields:        fields,
+		},
+		IsUsed:        row.IsUsed,
+		IsUsedInTrash: row.IsUsedInTrash,
+		Owner:         owner,
 	}, nil
 }
 
-// Delete deletes a template.
+// Trash moves a template to the trash. A template that is missing or already trashed is ErrNotFound.
+func (r *TemplateRepository) Trash(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	n, err := queriesForContext(ctx, r.queries).TrashTemplate(ctx, pgID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// Restore takes a template out of the trash. A template that is not in the trash is ErrNotFound.
+func (r *TemplateRepository) Restore(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return err
+	}
+	n, err := queriesForContext(ctx, r.queries).RestoreTemplate(ctx, pgID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// Delete deletes a template for good, trashed or not.
 func (r *TemplateRepository) Delete(ctx context.Context, id string) error {
 	pgID, err := toUUID(id)
 	if err != nil {
@@ -169,38 +321,78 @@ func (r *TemplateRepository) Delete(ctx context.Context, id string) error {
 	return queriesForContext(ctx, r.queries).DeleteTemplate(ctx, pgID)
 }
 
-// ReplaceFields replaces template fields.
-func (r *TemplateRepository) ReplaceFields(ctx context.Context, templateID string, fields []template.Field) error {
+// ReplaceFields inserts fields as the field version of the given template version.
+// Fields of earlier versions are never rewritten because note sections refer to them;
+// they are removed once no note is pinned to them.
+func (r *TemplateRepository) ReplaceFields(ctx context.Context, templateID string, version int, fields []template.Field) error {
 	pgID, err := toUUID(templateID)
 	if err != nil {
 		return err
 	}
 	q := queriesForContext(ctx, r.queries)
-	if err := q.DeleteFieldsByTemplate(ctx, pgID); err != nil {
-		return err
-	}
 	for idx, f := range fields {
 		order := f.Order
 		if order == 0 {
 			order = idx + 1
 		}
+		fieldType := f.Type
+		if fieldType == "" {
+			fieldType = template.FieldText
+		}
 		if _, err := q.CreateField(ctx, &generated.CreateFieldParams{
 			TemplateID: pgID,
 			Label:      f.Label,
 			Order:      int32(order), //nolint:gosec
 			IsRequired: f.IsRequired,
+			Version:    int32(version), //nolint:gosec
+			Type:       string(fieldType),
+			Options:    fieldOptions(f.Options),
 		}); err != nil {
 			return err
 		}
 	}
-	return nil
+	return q.DeleteUnpinnedFieldVersions(ctx, pgID)
 }
 
-func (r *TemplateRepository) listFields(ctx context.Context, templateID pgtype.UUID) ([]template.Field, error) {
-	rows, err := queriesForContext(ctx, r.queries).ListFieldsByTemplate(ctx, templateID)
+// GetFields returns the fields of a template version.
+func (r *TemplateRepository) GetFields(ctx context.Context, templateID string, version int) ([]template.Field, error) {
+	pgID, err := toUUID(templateID)
 	if err != nil {
 		return nil, err
 	}
+	rows, err := queriesForContext(ctx, r.queries).ListFieldsByTemplateVersion(ctx, &generated.ListFieldsByTemplateVersionParams{
+		TemplateID: pgID,
+		Version:    int32(version), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toTemplateFields(rows), nil
+}
+
+// PruneFieldVersions removes earlier field versions no note is pinned to.
+func (r *TemplateRepository) PruneFieldVersions(ctx context.Context, templateID string) error {
+	pgID, err := toUUID(templateID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).DeleteUnpinnedFieldVersions(ctx, pgID)
+}
+
+// listFields returns the latest fields of a template and their version.
+func (r *TemplateRepository) listFields(ctx context.Context, templateID pgtype.UUID) ([]template.Field, int, error) {
+	rows, err := queriesForContext(ctx, r.queries).ListFieldsByTemplate(ctx, templateID)
+	if err != nil {
+		return nil, 0, err
+	}
+	version := 0
+	if len(rows) > 0 {
+		version = int(rows[0].Version)
+	}
+	return toTemplateFields(rows), version, nil
+}
+
+func toTemplateFields(rows []*generated.Field) []template.Field {
 	fields := make([]template.Field, 0, len(rows))
 	for _, f := range rows {
 		fields = append(fields, template.Field{
@@ -208,7 +400,17 @@ func (r *TemplateRepository) listFields(ctx context.Context, templateID pgtype.U
 			Label:      f.Label,
 			Order:      int(f.Order),
 			IsRequired: f.IsRequired,
+			Type:       template.FieldType(f.Type),
+			Options:    f.Options,
 		})
 	}
-	return fields, nil
+	return fields
+}
+
+// fieldOptions keeps options non-nil so they are stored as an empty array rather than NULL.
+func fieldOptions(options []string) []string {
+	if options == nil {
+		return []string{}
+	}
+	return options
 }
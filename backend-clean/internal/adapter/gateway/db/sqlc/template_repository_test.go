@@ -9,10 +9,13 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
-	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
+	"immortal-architecture-clean/backend/internal/port"
 )
 
 func TestTemplateRepository_Create(t *testing.T) {
@@ -84,7 +87,7 @@ func TestTemplateRepository_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := mockdb.NewTemplateDBTX(nil, tt.row, tt.rowErr, nil)
 			repo := &TemplateRepository{queries: generated.New(mock)}
-			got, err := repo.Get(context.Background(), tt.id)
+			got, err := repo.Get(port.WithWorkspaceID(context.Background(), testWorkspaceID), tt.id)
 			if tt.wantErr == nil {
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
@@ -111,6 +114,7 @@ func TestTemplateRepository_Update(t *testing.T) {
 		Name:      "tpl2",
 		OwnerID:   pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
 		UpdatedAt: pgtype.Timestamptz{Time: now, Valid: true},
+		Version:   2,
 	}
 	tests := []struct {
 		name    string
@@ -119,9 +123,9 @@ func TestTemplateRepository_Update(t *testing.T) {
 		rowErr  error
 		wantErr error
 	}{
-		{name: "[Success] update template", tpl: template.Template{ID: tplRow.ID.String(), Name: "tpl2"}, row: tplRow},
+		{name: "[Success] update template", tpl: template.Template{ID: tplRow.ID.String(), Name: "tpl2", Version: 1}, row: tplRow},
 		{name: "[Fail] invalid uuid", tpl: template.Template{ID: "bad-uuid", Name: "tpl2"}, wantErr: errors.New("invalid")},
-		{name: "[Fail] not found", tpl: template.Template{ID: tplRow.ID.String(), Name: "tpl2"}, rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] version mismatch", tpl: template.Template{ID: tplRow.ID.String(), Name: "tpl2", Version: 1}, rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrConflict},
 	}
 
 	for _, tt := range tests {
@@ -133,16 +137,16 @@ func TestTemplateRepository_Update(t *testing.T) {
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
 				}
-				if got.Name != tt.tpl.Name {
-					t.Fatalf("name = %s, want %s", got.Name, tt.tpl.Name)
+				if got.Name != tt.tpl.Name || got.Version != 2 {
+					t.Fatalf("got %+v, want name %s and version 2", got, tt.tpl.Name)
 				}
 				return
 			}
 			if err == nil {
 				t.Fatalf("expected error, got nil")
 			}
-			if tt.wantErr == domainerr.ErrNotFound && !errors.Is(err, domainerr.ErrNotFound) {
-				t.Fatalf("want ErrNotFound, got %v", err)
+			if tt.wantErr == domainerr.ErrConflict && !errors.Is(err, domainerr.ErrConflict) {
+				t.Fatalf("want ErrConflict, got %v", err)
 			}
 		})
 	}
@@ -182,10 +186,13 @@ func TestTemplateRepository_List(t *testing.T) {
 	// list returns emptyRows; we assert success and error path
 	tests := []struct {
 		name     string
+		filters  template.Filters
 		queryErr error
 		wantErr  bool
 	}{
 		{name: "[Success] list templates"},
+		{name: "[Success] list page after cursor", filters: template.Filters{Limit: 2, After: &page.Cursor{UpdatedAt: now, ID: "00000000-0000-0000-0000-000000000001"}}},
+		{name: "[Fail] invalid cursor id", filters: template.Filters{After: &page.Cursor{UpdatedAt: now, ID: "bad-uuid"}}, wantErr: true},
 		{name: "[Fail] query error", queryErr: errors.New("db error"), wantErr: true},
 	}
 	for _, tt := range tests {
@@ -193,7 +200,8 @@ func TestTemplateRepository_List(t *testing.T) {
 			mock := mockdb.NewTemplateDBTX(nil, nil, nil, nil)
 			mock.QueryErr = tt.queryErr
 			repo := &TemplateRepository{queries: generated.New(mock)}
-			_, err := repo.List(context.Background(), template.Filters{})
+			tt.filters.WorkspaceID = testWorkspaceID
+			_, err := repo.List(context.Background(), tt.filters)
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error, got nil")
@@ -215,6 +223,7 @@ func TestTemplateRepository_ReplaceFields(t *testing.T) {
 		Label:      "lbl",
 		Order:      1,
 		IsRequired: true,
+		Version:    2,
 	}
 	tests := []struct {
 		name    string
@@ -226,7 +235,7 @@ func TestTemplateRepository_ReplaceFields(t *testing.T) {
 	}{
 		{name: "[Success] replace fields", tplID: tplID.String(), field: template.Field{Label: "lbl", Order: 1, IsRequired: true}},
 		{name: "[Fail] invalid tpl uuid", tplID: "bad-uuid", field: template.Field{Label: "lbl"}, wantErr: true},
-		{name: "[Fail] delete error", tplID: tplID.String(), field: template.Field{Label: "lbl"}, execErr: errors.New("del error"), wantErr: true},
+		{name: "[Fail] prune error", tplID: tplID.String(), field: template.Field{Label: "lbl"}, execErr: errors.New("del error"), wantErr: true},
 		{name: "[Fail] create error", tplID: tplID.String(), field: template.Field{Label: "lbl"}, rowErr: errors.New("create error"), wantErr: true},
 	}
 
@@ -235,7 +244,7 @@ func TestTemplateRepository_ReplaceFields(t *testing.T) {
 			mock := mockdb.NewTemplateDBTX(nil, nil, tt.rowErr, tt.execErr)
 			mock.FieldRow = fieldRow
 			repo := &TemplateRepository{queries: generated.New(mock)}
-			err := repo.ReplaceFields(context.Background(), tt.tplID, []template.Field{tt.field})
+			err := repo.ReplaceFields(context.Background(), tt.tplID, 2, []template.Field{tt.field})
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("expected error, got nil")
@@ -247,3 +256,192 @@ func TestTemplateRepository_ReplaceFields(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateRepository_GetFields(t *testing.T) {
+	tplID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	tests := []struct {
+		name     string
+		tplID    string
+		queryErr error
+		wantErr  bool
+	}{
+		{name: "[Success] get fields of version", tplID: tplID.String()},
+		{name: "[Fail] invalid tpl uuid", tplID: "bad-uuid", wantErr: true},
+		{name: "[Fail] query error", tplID: tplID.String(), queryErr: errors.New("query error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewTemplateDBTX(nil, nil, nil, nil)
+			mock.QueryErr = tt.queryErr
+			repo := &TemplateRepository{queries: generated.New(mock)}
+			fields, err := repo.GetFields(context.Background(), tt.tplID, 1)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fields == nil {
+				t.Fatalf("expected empty fields, got nil")
+			}
+		})
+	}
+}
+
+func TestTemplateRepository_PruneFieldVersions(t *testing.T) {
+	tplID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	tests := []struct {
+		name    string
+		tplID   string
+		execErr error
+		wantErr bool
+	}{
+		{name: "[Success] prune field versions", tplID: tplID.String()},
+		{name: "[Fail] invalid tpl uuid", tplID: "bad-uuid", wantErr: true},
+		{name: "[Fail] exec error", tplID: tplID.String(), execErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewTemplateDBTX(nil, nil, nil, tt.execErr)
+			repo := &TemplateRepository{queries: generated.New(mock)}
+			err := repo.PruneFieldVersions(context.Background(), tt.tplID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestTemplateRepository_TrashAndRestore(t *testing.T) {
+	baseID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	tests := []struct {
+		name     string
+		id       string
+		execRows int64
+		execErr  error
+		wantErr  error
+	}{
+		{name: "[Success] one row changed", id: baseID.String(), execRows: 1},
+		{name: "[Fail] no row changed is not found", id: baseID.String(), execRows: 0, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: errors.New("invalid")},
+		{name: "[Fail] exec error", id: baseID.String(), execErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		for _, op := range []string{"trash", "restore"} {
+			t.Run(tt.name+" "+op, func(t *testing.T) {
+				mock := mockdb.NewTemplateDBTX(nil, nil, nil, tt.execErr)
+				mock.ExecRows = tt.execRows
+				repo := &TemplateRepository{queries: generated.New(mock)}
+				var err error
+				if op == "trash" {
+					err = repo.Trash(context.Background(), tt.id)
+				} else {
+					err = repo.Restore(context.Background(), tt.id)
+				}
+				if tt.wantErr == nil {
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					return
+				}
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if tt.wantErr == domainerr.ErrNotFound && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want ErrNotFound, got %v", err)
+				}
+			})
+		}
+	}
+}
+
+func TestTemplateRepository_ListTrash(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	// list returns emptyRows; we assert success and error path
+	tests := []struct {
+		name     string
+		filters  trash.Filters
+		all      bool
+		queryErr error
+		wantErr  bool
+	}{
+		{name: "[Success] list owner's trash", filters: trash.Filters{OwnerID: "00000000-0000-0000-0000-000000000002", WorkspaceID: testWorkspaceID, Limit: 2}},
+		{name: "[Success] list expired trash of every owner", filters: trash.Filters{TrashedBefore: &now, Limit: 2}, all: true},
+		{name: "[Fail] no workspace", filters: trash.Filters{TrashedBefore: &now, Limit: 2}, wantErr: true},
+		{name: "[Fail] invalid owner uuid", filters: trash.Filters{OwnerID: "bad-uuid", WorkspaceID: testWorkspaceID}, wantErr: true},
+		{name: "[Fail] query error", filters: trash.Filters{WorkspaceID: testWorkspaceID}, queryErr: errors.New("db error"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewTemplateDBTX(nil, nil, nil, nil)
+			mock.QueryErr = tt.queryErr
+			repo := &TemplateRepository{queries: generated.New(mock)}
+			ctx := context.Background()
+			if tt.all {
+				ctx = port.WithAllWorkspaces(ctx)
+			}
+			_, err := repo.ListTrash(ctx, tt.filters)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestTemplateRepository_GetTrashed(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	detail := &generated.GetTemplateByIDRow{
+		ID:            pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		Name:          "tpl",
+		OwnerID:       pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		DeletedAt:     pgtype.Timestamptz{Time: now, Valid: true},
+		IsUsedInTrash: true,
+	}
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] get trashed template", id: detail.ID.String()},
+		{name: "[Fail] not in trash", id: detail.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] invalid uuid", id: "bad-uuid", wantErr: errors.New("invalid")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewTemplateDBTX(nil, detail, tt.rowErr, nil)
+			repo := &TemplateRepository{queries: generated.New(mock)}
+			got, err := repo.GetTrashed(port.WithWorkspaceID(context.Background(), testWorkspaceID), tt.id)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.Template.DeletedAt == nil || !got.Template.DeletedAt.Equal(now) || !got.IsUsedInTrash {
+					t.Fatalf("unexpected template: %+v", got)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if tt.wantErr == domainerr.ErrNotFound && !errors.Is(err, domainerr.ErrNotFound) {
+				t.Fatalf("want ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookRepository implements webhook and delivery log persistence.
+type WebhookRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.WebhookRepository = (*WebhookRepository)(nil)
+
+// NewWebhookRepository creates WebhookRepository.
+func NewWebhookRepository(pool *pgxpool.Pool) *WebhookRepository {
+	return &WebhookRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// List returns the owner's webhooks, oldest first.
+func (r *WebhookRepository) List(ctx context.Context, ownerID string) ([]webhook.Webhook, error) {
+	pgOwnerID, err := toUUID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWebhooksByOwner(ctx, pgOwnerID)
+	if err != nil {
+		return nil, err
+	}
+	webhooks := make([]webhook.Webhook, 0, len(rows))
+	for _, row := range rows {
+		webhooks = append(webhooks, toWebhook(row))
+	}
+	return webhooks, nil
+}
+
+// Get returns a webhook by ID.
+func (r *WebhookRepository) Get(ctx context.Context, id string) (*webhook.Webhook, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWebhookByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	w := toWebhook(row)
+	return &w, nil
+}
+
+// Create stores a webhook.
+func (r *WebhookRepository) Create(ctx context.Context, w webhook.Webhook) (*webhook.Webhook, error) {
+	ownerID, err := toUUID(w.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateWebhook(ctx, &generated.CreateWebhookParams{
+		OwnerID:  ownerID,
+		Url:      w.URL,
+		Secret:   w.Secret,
+		Events:   fromEventTypes(w.Events),
+		IsActive: w.Active,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := toWebhook(row)
+	return &created, nil
+}
+
+// Update stores the URL, events and active flag of a webhook.
+func (r *WebhookRepository) Update(ctx context.Context, w webhook.Webhook) (*webhook.Webhook, error) {
+	pgID, err := toUUID(w.ID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateWebhook(ctx, &generated.UpdateWebhookParams{
+		ID:       pgID,
+		Url:      w.URL,
+		Events:   fromEventTypes(w.Events),
+		IsActive: w.Active,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	updated := toWebhook(row)
+	return &updated, nil
+}
+
+// Delete removes a webhook; its deliveries go with it.
+func (r *WebhookRepository) Delete(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	n, err := queriesForContext(ctx, r.queries).DeleteWebhook(ctx, pgID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// CreateDelivery stores a delivery, returning the existing row when the webhook
+// already has the event.
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+	webhookID, err := toUUID(d.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	eventID, err := toUUID(d.EventID)
+	if err != nil {
+		return nil, err
+	}
+	var redeliveryOf pgtype.UUID
+	if d.RedeliveryOf != "" {
+		if redeliveryOf, err = toUUID(d.RedeliveryOf); err != nil {
+			return nil, err
+		}
+	}
+	row, err := queriesForContext(ctx, r.queries).CreateWebhookDelivery(ctx, &generated.CreateWebhookDeliveryParams{
+		WebhookID:    webhookID,
+		EventID:      eventID,
+		EventType:    string(d.EventType),
+		Payload:      d.Payload,
+		RedeliveryOf: redeliveryOf,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := toDelivery(row)
+	return &created, nil
+}
+
+// GetDelivery returns a delivery by ID.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWebhookDeliveryByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	d := toDelivery(row)
+	return &d, nil
+}
+
+// ListDeliveries returns the webhook's deliveries newest first, after the cursor.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, webhookID string, after *page.Cursor, limit int) ([]webhook.Delivery, error) {
+	pgWebhookID, err := toUUID(webhookID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	afterCreatedAt, afterID, err := toKeyset(after)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWebhookDeliveries(ctx, &generated.ListWebhookDeliveriesParams{
+		WebhookID: pgWebhookID,
+		Column2:   afterCreatedAt,
+		Column3:   afterID,
+		Limit:     int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]webhook.Delivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, toDelivery(row))
+	}
+	return deliveries, nil
+}
+
+// ClaimDue leases due deliveries of active webhooks until leaseUntil.
+func (r *WebhookRepository) ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]webhook.DueDelivery, error) {
+	rows, err := queriesForContext(ctx, r.queries).ClaimDueWebhookDeliveries(ctx, &generated.ClaimDueWebhookDeliveriesParams{
+		Limit:         int32(limit), //nolint:gosec
+		NextAttemptAt: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	due := make([]webhook.DueDelivery, 0, len(rows))
+	for _, row := range rows {
+		due = append(due, webhook.DueDelivery{
+			Delivery: toDelivery(&generated.WebhookDelivery{
+				ID:             row.ID,
+				WebhookID:      row.WebhookID,
+				EventID:        row.EventID,
+				EventType:      row.EventType,
+				Payload:        row.Payload,
+				Status:         row.Status,
+				Attempts:       row.Attempts,
+				NextAttemptAt:  row.NextAttemptAt,
+				LastStatusCode: row.LastStatusCode,
+				LastError:      row.LastError,
+				RedeliveryOf:   row.RedeliveryOf,
+				CreatedAt:      row.CreatedAt,
+				DeliveredAt:    row.DeliveredAt,
+			}),
+			URL:    row.Url,
+			Secret: row.Secret,
+		})
+	}
+	return due, nil
+}
+
+// SaveAttempt stores the outcome of a delivery attempt.
+func (r *WebhookRepository) SaveAttempt(ctx context.Context, d webhook.Delivery) error {
+	pgID, err := toUUID(d.ID)
+	if err != nil {
+		return err
+	}
+	return queriesForContext(ctx, r.queries).SaveWebhookDeliveryAttempt(ctx, &generated.SaveWebhookDeliveryAttemptParams{
+		ID:             pgID,
+		Status:         string(d.Status),
+		Attempts:       int32(d.Attempts), //nolint:gosec
+		NextAttemptAt:  pgtype.Timestamptz{Time: d.NextAttemptAt, Valid: true},
+		LastStatusCode: int32(d.LastStatusCode), //nolint:gosec
+		LastError:      d.LastError,
+		DeliveredAt:    pgNullableTime(d.DeliveredAt),
+	})
+}
+
+func toWebhook(row *generated.Webhook) webhook.Webhook {
+	events := make([]webhook.EventType, 0, len(row.Events))
+	for _, e := range row.Events {
+		events = append(events, webhook.EventType(e))
+	}
+	return webhook.Webhook{
+		ID:        uuidToString(row.ID),
+		OwnerID:   uuidToString(row.OwnerID),
+		URL:       row.Url,
+		Secret:    row.Secret,
+		Events:    events,
+		Active:    row.IsActive,
+		CreatedAt: timestamptzToTime(row.CreatedAt),
+		UpdatedAt: timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+func fromEventTypes(events []webhook.EventType) []string {
+	res := make([]string, 0, len(events))
+	for _, e := range events {
+		res = append(res, string(e))
+	}
+	return res
+}
+
+func toDelivery(row *generated.WebhookDelivery) webhook.Delivery {
+	var deliveredAt *time.Time
+	if row.DeliveredAt.Valid {
+		t := row.DeliveredAt.Time
+		deliveredAt = &t
+	}
+	return webhook.Delivery{
+		ID:             uuidToString(row.ID),
+		WebhookID:      uuidToString(row.WebhookID),
+		EventID:        uuidToString(row.EventID),
+		EventType:      webhook.EventType(row.EventType),
+		Payload:        row.Payload,
+		Status:         webhook.DeliveryStatus(row.Status),
+		Attempts:       int(row.Attempts),
+		NextAttemptAt:  timestamptzToTime(row.NextAttemptAt),
+		LastStatusCode: int(row.LastStatusCode),
+		LastError:      row.LastError,
+		RedeliveryOf:   uuidToString(row.RedeliveryOf),
+		CreatedAt:      timestamptzToTime(row.CreatedAt),
+		DeliveredAt:    deliveredAt,
+	}
+}
@@ -0,0 +1,189 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+const (
+	testWebhookID  = "00000000-0000-0000-0000-0000000000a1"
+	testDeliveryID = "00000000-0000-0000-0000-0000000000d1"
+	testEventID    = "00000000-0000-0000-0000-0000000000e1"
+	testOwnerID    = "00000000-0000-0000-0000-000000000001"
+)
+
+func mustUUID(t *testing.T, s string) pgtype.UUID {
+	t.Helper()
+	id, err := toUUID(s)
+	if err != nil {
+		t.Fatalf("invalid uuid %q: %v", s, err)
+	}
+	return id
+}
+
+func newWebhookRow(t *testing.T) *generated.Webhook {
+	now := pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}
+	return &generated.Webhook{
+		ID:        mustUUID(t, testWebhookID),
+		OwnerID:   mustUUID(t, testOwnerID),
+		Url:       "https://example.com/hook",
+		Secret:    "whsec_x",
+		Events:    []string{"note.published", "note.deleted"},
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func newDeliveryRow(t *testing.T) *generated.WebhookDelivery {
+	now := pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}
+	return &generated.WebhookDelivery{
+		ID:            mustUUID(t, testDeliveryID),
+		WebhookID:     mustUUID(t, testWebhookID),
+		EventID:       mustUUID(t, testEventID),
+		EventType:     "note.published",
+		Payload:       []byte(`{"id":"e1"}`),
+		Status:        "succeeded",
+		Attempts:      2,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		DeliveredAt:   now,
+	}
+}
+
+func TestWebhookRepository_Get(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] get webhook", id: testWebhookID},
+		{name: "[Fail] not found", id: testWebhookID, rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] malformed id", id: "nope", wantErr: domainerr.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WebhookRepository{queries: generated.New(mockdb.NewWebhookDBTX(newWebhookRow(t), nil, tt.rowErr, nil, 0))}
+			got, err := repo.Get(context.Background(), tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.ID != testWebhookID || got.OwnerID != testOwnerID || len(got.Events) != 2 || got.Events[1] != webhook.EventNoteDeleted || !got.Active {
+				t.Fatalf("unexpected webhook: %+v", got)
+			}
+		})
+	}
+}
+
+func TestWebhookRepository_CreateAndList(t *testing.T) {
+	mock := mockdb.NewWebhookDBTX(newWebhookRow(t), nil, nil, nil, 0).WithList([]*generated.Webhook{newWebhookRow(t)}, nil, nil, nil)
+	repo := &WebhookRepository{queries: generated.New(mock)}
+
+	created, err := repo.Create(context.Background(), webhook.Webhook{OwnerID: testOwnerID, URL: "https://example.com/hook", Secret: "whsec_x", Events: []webhook.EventType{webhook.EventNotePublished}, Active: true})
+	if err != nil || created.ID != testWebhookID {
+		t.Fatalf("unexpected create result %+v, err %v", created, err)
+	}
+	if events, ok := mock.Args[0][3].([]string); !ok || len(events) != 1 || events[0] != "note.published" {
+		t.Fatalf("unexpected create args: %+v", mock.Args[0])
+	}
+
+	list, err := repo.List(context.Background(), testOwnerID)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("unexpected list %+v, err %v", list, err)
+	}
+	if _, err := repo.List(context.Background(), "nope"); err == nil {
+		t.Fatal("expected error for malformed owner id")
+	}
+}
+
+func TestWebhookRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		execErr  error
+		wantErr  error
+	}{
+		{name: "[Success] delete webhook", affected: 1},
+		{name: "[Fail] not found", wantErr: domainerr.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WebhookRepository{queries: generated.New(mockdb.NewWebhookDBTX(nil, nil, nil, tt.execErr, tt.affected))}
+			if err := repo.Delete(context.Background(), testWebhookID); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWebhookRepository_Deliveries(t *testing.T) {
+	mock := mockdb.NewWebhookDBTX(nil, newDeliveryRow(t), nil, nil, 0).WithList(nil, []*generated.WebhookDelivery{newDeliveryRow(t)}, nil, nil)
+	repo := &WebhookRepository{queries: generated.New(mock)}
+
+	created, err := repo.CreateDelivery(context.Background(), webhook.Delivery{WebhookID: testWebhookID, EventID: testEventID, EventType: webhook.EventNotePublished, Payload: []byte(`{}`), RedeliveryOf: testDeliveryID})
+	if err != nil || created.ID != testDeliveryID || created.Status != webhook.DeliverySucceeded || created.DeliveredAt == nil {
+		t.Fatalf("unexpected delivery %+v, err %v", created, err)
+	}
+	if redeliveryOf, ok := mock.Args[0][4].(pgtype.UUID); !ok || !redeliveryOf.Valid {
+		t.Fatalf("redelivery_of not passed: %+v", mock.Args[0])
+	}
+
+	got, err := repo.GetDelivery(context.Background(), testDeliveryID)
+	if err != nil || got.EventID != testEventID || got.Attempts != 2 {
+		t.Fatalf("unexpected delivery %+v, err %v", got, err)
+	}
+
+	after := &page.Cursor{UpdatedAt: time.Now(), ID: testDeliveryID}
+	list, err := repo.ListDeliveries(context.Background(), testWebhookID, after, 21)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("unexpected list %+v, err %v", list, err)
+	}
+	if _, err := repo.ListDeliveries(context.Background(), testWebhookID, &page.Cursor{ID: "bad"}, 21); !errors.Is(err, domainerr.ErrInvalidCursor) {
+		t.Fatalf("want invalid cursor, got %v", err)
+	}
+}
+
+func TestWebhookRepository_ClaimAndSave(t *testing.T) {
+	d := newDeliveryRow(t)
+	claimed := &generated.ClaimDueWebhookDeliveriesRow{
+		ID: d.ID, WebhookID: d.WebhookID, EventID: d.EventID, EventType: d.EventType, Payload: d.Payload,
+		Status: "pending", Attempts: 1, NextAttemptAt: d.NextAttemptAt, CreatedAt: d.CreatedAt,
+		Url: "https://example.com/hook", Secret: "whsec_x",
+	}
+	mock := mockdb.NewWebhookDBTX(nil, nil, nil, nil, 0).WithList(nil, nil, []*generated.ClaimDueWebhookDeliveriesRow{claimed}, nil)
+	repo := &WebhookRepository{queries: generated.New(mock)}
+
+	due, err := repo.ClaimDue(context.Background(), 10, time.Now().Add(time.Minute))
+	if err != nil || len(due) != 1 || due[0].URL != "https://example.com/hook" || due[0].Secret != "whsec_x" || due[0].Delivery.Status != webhook.DeliveryPending {
+		t.Fatalf("unexpected due deliveries %+v, err %v", due, err)
+	}
+
+	now := time.Now()
+	if err := repo.SaveAttempt(context.Background(), due[0].Delivery.RecordAttempt(now, 200, "")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	saved := mock.Args[len(mock.Args)-1]
+	if saved[1] != "succeeded" || saved[2] != int32(2) || saved[4] != int32(200) {
+		t.Fatalf("unexpected save args: %+v", saved)
+	}
+
+	failing := &WebhookRepository{queries: generated.New(mockdb.NewWebhookDBTX(nil, nil, nil, nil, 0).WithList(nil, nil, nil, errors.New("db error")))}
+	if _, err := failing.ClaimDue(context.Background(), 10, now); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
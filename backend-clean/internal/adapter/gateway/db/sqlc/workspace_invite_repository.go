@@ -0,0 +1,139 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceInviteRepository implements workspace invite persistence.
+type WorkspaceInviteRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.WorkspaceInviteRepository = (*WorkspaceInviteRepository)(nil)
+
+// NewWorkspaceInviteRepository creates WorkspaceInviteRepository.
+func NewWorkspaceInviteRepository(pool *pgxpool.Pool) *WorkspaceInviteRepository {
+	return &WorkspaceInviteRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// List returns the pending invites of a workspace, oldest first.
+func (r *WorkspaceInviteRepository) List(ctx context.Context, workspaceID string) ([]workspace.InviteWithMeta, error) {
+	pgID, err := toUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWorkspaceInvites(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	invites := make([]workspace.InviteWithMeta, 0, len(rows))
+	for _, row := range rows {
+		invites = append(invites, workspace.InviteWithMeta{
+			Invite:        toInvite(row.ID, row.WorkspaceID, row.Email, row.Role, row.InvitedBy, row.CreatedAt, row.UpdatedAt),
+			WorkspaceName: row.WorkspaceName,
+		})
+	}
+	return invites, nil
+}
+
+// ListByEmail returns the pending invites of an email address in any workspace.
+// Emails are compared case-insensitively.
+func (r *WorkspaceInviteRepository) ListByEmail(ctx context.Context, email string) ([]workspace.InviteWithMeta, error) {
+	rows, err := queriesForContext(ctx, r.queries).ListWorkspaceInvitesByEmail(ctx, strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		return nil, err
+	}
+	invites := make([]workspace.InviteWithMeta, 0, len(rows))
+	for _, row := range rows {
+		invites = append(invites, workspace.InviteWithMeta{
+			Invite:        toInvite(row.ID, row.WorkspaceID, row.Email, row.Role, row.InvitedBy, row.CreatedAt, row.UpdatedAt),
+			WorkspaceName: row.WorkspaceName,
+		})
+	}
+	return invites, nil
+}
+
+// Get returns an invite by ID.
+func (r *WorkspaceInviteRepository) Get(ctx context.Context, id string) (*workspace.InviteWithMeta, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWorkspaceInviteByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	return &workspace.InviteWithMeta{
+		Invite:        toInvite(row.ID, row.WorkspaceID, row.Email, row.Role, row.InvitedBy, row.CreatedAt, row.UpdatedAt),
+		WorkspaceName: row.WorkspaceName,
+	}, nil
+}
+
+// Upsert creates an invite, or changes the role of the pending invite of the same email.
+func (r *WorkspaceInviteRepository) Upsert(ctx context.Context, i workspace.Invite) (*workspace.Invite, error) {
+	workspaceID, err := toUUID(i.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	invitedBy, err := toUUID(i.InvitedBy)
+	if err != nil {
+		return nil, err
+	}
+	row, err := queriesForContext(ctx, r.queries).UpsertWorkspaceInvite(ctx, &generated.UpsertWorkspaceInviteParams{
+		WorkspaceID: workspaceID,
+		Email:       i.Email,
+		Role:        string(i.Role),
+		InvitedBy:   invitedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	invite := toInvite(row.ID, row.WorkspaceID, row.Email, row.Role, row.InvitedBy, row.CreatedAt, row.UpdatedAt)
+	return &invite, nil
+}
+
+// Delete removes an invite.
+func (r *WorkspaceInviteRepository) Delete(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	n, err := queriesForContext(ctx, r.queries).DeleteWorkspaceInvite(ctx, pgID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+func toInvite(id, workspaceID pgtype.UUID, email, role string, invitedBy pgtype.UUID, createdAt, updatedAt pgtype.Timestamptz) workspace.Invite {
+	return workspace.Invite{
+		ID:          uuidToString(id),
+		WorkspaceID: uuidToString(workspaceID),
+		Email:       email,
+		Role:        workspace.Role(role),
+		InvitedBy:   uuidToString(invitedBy),
+		CreatedAt:   timestamptzToTime(createdAt),
+		UpdatedAt:   timestamptzToTime(updatedAt),
+	}
+}
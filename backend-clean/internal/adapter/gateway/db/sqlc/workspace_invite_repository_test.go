@@ -0,0 +1,135 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+)
+
+func TestWorkspaceInviteRepository_ListByEmail(t *testing.T) {
+	rows := []*generated.GetWorkspaceInviteByIDRow{
+		{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, WorkspaceID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Email: "friend@example.com", Role: "member", WorkspaceName: "Team"},
+	}
+	mock := mockdb.NewWorkspaceInviteDBTX(nil, nil, nil, 0).WithList(rows, nil)
+	repo := &WorkspaceInviteRepository{queries: generated.New(mock)}
+	got, err := repo.ListByEmail(context.Background(), " Friend@Example.com ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mock.Args[0] != "friend@example.com" {
+		t.Fatalf("email should be lower-cased: %+v", mock.Args)
+	}
+	if len(got) != 1 || got[0].WorkspaceName != "Team" || got[0].Invite.Role != workspace.RoleMember {
+		t.Fatalf("unexpected invites: %+v", got)
+	}
+}
+
+func TestWorkspaceInviteRepository_Get(t *testing.T) {
+	row := &generated.GetWorkspaceInviteByIDRow{
+		ID:            pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		WorkspaceID:   pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Email:         "friend@example.com",
+		Role:          "admin",
+		InvitedBy:     pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+		WorkspaceName: "Team",
+	}
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] get invite", id: row.ID.String()},
+		{name: "[Fail] invalid uuid is not found", id: "bad-uuid", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] not found", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WorkspaceInviteRepository{queries: generated.New(mockdb.NewWorkspaceInviteDBTX(row, tt.rowErr, nil, 0))}
+			got, err := repo.Get(context.Background(), tt.id)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Invite.Role != workspace.RoleAdmin || got.Invite.InvitedBy != row.InvitedBy.String() || got.WorkspaceName != "Team" {
+				t.Fatalf("unexpected invite: %+v", got)
+			}
+		})
+	}
+}
+
+func TestWorkspaceInviteRepository_Upsert(t *testing.T) {
+	row := &generated.GetWorkspaceInviteByIDRow{
+		ID:          pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		WorkspaceID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Email:       "friend@example.com",
+		Role:        "member",
+		InvitedBy:   pgtype.UUID{Bytes: [16]byte{3}, Valid: true},
+	}
+	valid := workspace.Invite{WorkspaceID: row.WorkspaceID.String(), Email: "friend@example.com", Role: workspace.RoleMember, InvitedBy: row.InvitedBy.String()}
+	tests := []struct {
+		name    string
+		invite  workspace.Invite
+		rowErr  error
+		wantErr bool
+	}{
+		{name: "[Success] invite", invite: valid},
+		{name: "[Fail] invalid workspace uuid", invite: workspace.Invite{WorkspaceID: "bad-uuid", InvitedBy: valid.InvitedBy}, wantErr: true},
+		{name: "[Fail] query error", invite: valid, rowErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewWorkspaceInviteDBTX(row, tt.rowErr, nil, 0)
+			repo := &WorkspaceInviteRepository{queries: generated.New(mock)}
+			got, err := repo.Upsert(context.Background(), tt.invite)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mock.Args[1] != "friend@example.com" || got.ID != row.ID.String() {
+				t.Fatalf("unexpected upsert: %+v / %+v", mock.Args, got)
+			}
+		})
+	}
+}
+
+func TestWorkspaceInviteRepository_Delete(t *testing.T) {
+	id := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	tests := []struct {
+		name     string
+		affected int64
+		wantErr  error
+	}{
+		{name: "[Success] revoke invite", affected: 1},
+		{name: "[Fail] not found", wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WorkspaceInviteRepository{queries: generated.New(mockdb.NewWorkspaceInviteDBTX(nil, nil, nil, tt.affected))}
+			if err := repo.Delete(context.Background(), id); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceRepository implements workspace and membership persistence.
+type WorkspaceRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+var _ port.WorkspaceRepository = (*WorkspaceRepository)(nil)
+
+// NewWorkspaceRepository creates WorkspaceRepository.
+func NewWorkspaceRepository(pool *pgxpool.Pool) *WorkspaceRepository {
+	return &WorkspaceRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// ListByAccount returns the account's workspaces with its role, in the order they were joined.
+func (r *WorkspaceRepository) ListByAccount(ctx context.Context, accountID string) ([]workspace.WithRole, error) {
+	pgID, err := toUUID(accountID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWorkspacesByAccount(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	workspaces := make([]workspace.WithRole, 0, len(rows))
+	for _, row := range rows {
+		workspaces = append(workspaces, workspace.WithRole{
+			Workspace: toWorkspace(row.ID, row.Name, row.CreatedAt, row.UpdatedAt),
+			Role:      workspace.Role(row.Role),
+		})
+	}
+	return workspaces, nil
+}
+
+// Get returns a workspace by ID.
+func (r *WorkspaceRepository) Get(ctx context.Context, id string) (*workspace.Workspace, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWorkspaceByID(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	w := toWorkspace(row.ID, row.Name, row.CreatedAt, row.UpdatedAt)
+	return &w, nil
+}
+
+// Create inserts a workspace.
+func (r *WorkspaceRepository) Create(ctx context.Context, w workspace.Workspace) (*workspace.Workspace, error) {
+	row, err := queriesForContext(ctx, r.queries).CreateWorkspace(ctx, w.Name)
+	if err != nil {
+		return nil, err
+	}
+	created := toWorkspace(row.ID, row.Name, row.CreatedAt, row.UpdatedAt)
+	return &created, nil
+}
+
+// Update renames a workspace.
+func (r *WorkspaceRepository) Update(ctx context.Context, w workspace.Workspace) (*workspace.Workspace, error) {
+	pgID, err := toUUID(w.ID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).UpdateWorkspace(ctx, &generated.UpdateWorkspaceParams{
+		ID:   pgID,
+		Name: w.Name,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	updated := toWorkspace(row.ID, row.Name, row.CreatedAt, row.UpdatedAt)
+	return &updated, nil
+}
+
+// Delete removes a workspace with its members and invites.
+func (r *WorkspaceRepository) Delete(ctx context.Context, id string) error {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	n, err := queriesForContext(ctx, r.queries).DeleteWorkspace(ctx, pgID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// HasContents reports whether templates or notes, trashed or not, belong to the workspace.
+func (r *WorkspaceRepository) HasContents(ctx context.Context, id string) (bool, error) {
+	pgID, err := toUUID(id)
+	if err != nil {
+		return false, err
+	}
+	return queriesForContext(ctx, r.queries).WorkspaceHasContents(ctx, pgID)
+}
+
+// GetMember returns the membership of an account, or ErrNotFound when it does not belong.
+func (r *WorkspaceRepository) GetMember(ctx context.Context, workspaceID, accountID string) (*workspace.Member, error) {
+	pgWorkspaceID, err := toUUID(workspaceID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	pgAccountID, err := toUUID(accountID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).GetWorkspaceMember(ctx, &generated.GetWorkspaceMemberParams{
+		WorkspaceID: pgWorkspaceID,
+		AccountID:   pgAccountID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerr.ErrNotFound
+		}
+		return nil, err
+	}
+	m := toMember(row.WorkspaceID, row.AccountID, row.Role, row.CreatedAt, row.UpdatedAt)
+	return &m, nil
+}
+
+// ListMembers returns the members with their profiles, oldest first.
+func (r *WorkspaceRepository) ListMembers(ctx context.Context, workspaceID string) ([]workspace.MemberWithMeta, error) {
+	pgID, err := toUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queriesForContext(ctx, r.queries).ListWorkspaceMembers(ctx, pgID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]workspace.MemberWithMeta, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, workspace.MemberWithMeta{
+			Member:    toMember(row.WorkspaceID, row.AccountID, row.Role, row.CreatedAt, row.UpdatedAt),
+			Email:     row.Email,
+			FirstName: row.FirstName,
+			LastName:  row.LastName,
+			Thumbnail: textToStringPtr(row.Thumbnail),
+		})
+	}
+	return members, nil
+}
+
+// UpsertMember adds a member or changes the role of an existing one.
+func (r *WorkspaceRepository) UpsertMember(ctx context.Context, m workspace.Member) (*workspace.Member, error) {
+	workspaceID, err := toUUID(m.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := toUUID(m.AccountID)
+	if err != nil {
+		return nil, domainerr.ErrNotFound
+	}
+	row, err := queriesForContext(ctx, r.queries).UpsertWorkspaceMember(ctx, &generated.UpsertWorkspaceMemberParams{
+		WorkspaceID: workspaceID,
+		AccountID:   accountID,
+		Role:        string(m.Role),
+	})
+	if err != nil {
+		return nil, err
+	}
+	member := toMember(row.WorkspaceID, row.AccountID, row.Role, row.CreatedAt, row.UpdatedAt)
+	return &member, nil
+}
+
+// DeleteMember removes an account from the workspace.
+func (r *WorkspaceRepository) DeleteMember(ctx context.Context, workspaceID, accountID string) error {
+	pgWorkspaceID, err := toUUID(workspaceID)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	pgAccountID, err := toUUID(accountID)
+	if err != nil {
+		return domainerr.ErrNotFound
+	}
+	n, err := queriesForContext(ctx, r.queries).DeleteWorkspaceMember(ctx, &generated.DeleteWorkspaceMemberParams{
+		WorkspaceID: pgWorkspaceID,
+		AccountID:   pgAccountID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+func toWorkspace(id pgtype.UUID, name string, createdAt, updatedAt pgtype.Timestamptz) workspace.Workspace {
+	return workspace.Workspace{
+		ID:        uuidToString(id),
+		Name:      name,
+		CreatedAt: timestamptzToTime(createdAt),
+		UpdatedAt: timestamptzToTime(updatedAt),
+	}
+}
+
+func toMember(workspaceID, accountID pgtype.UUID, role string, createdAt, updatedAt pgtype.Timestamptz) workspace.Member {
+	return workspace.Member{
+		WorkspaceID: uuidToString(workspaceID),
+		AccountID:   uuidToString(accountID),
+		Role:        workspace.Role(role),
+		CreatedAt:   timestamptzToTime(createdAt),
+		UpdatedAt:   timestamptzToTime(updatedAt),
+	}
+}
@@ -0,0 +1,232 @@
+package sqlc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/generated"
+	mockdb "immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+)
+
+func TestWorkspaceRepository_ListByAccount(t *testing.T) {
+	rows := []*generated.ListWorkspacesByAccountRow{
+		{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, Name: "Personal", Role: "owner"},
+		{ID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Name: "Team", Role: "member"},
+	}
+	tests := []struct {
+		name      string
+		accountID string
+		queryErr  error
+		wantErr   bool
+	}{
+		{name: "[Success] list workspaces", accountID: pgtype.UUID{Bytes: [16]byte{9}, Valid: true}.String()},
+		{name: "[Fail] invalid uuid", accountID: "bad-uuid", wantErr: true},
+		{name: "[Fail] query error", accountID: pgtype.UUID{Bytes: [16]byte{9}, Valid: true}.String(), queryErr: errors.New("db error"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WorkspaceRepository{queries: generated.New(mockdb.NewWorkspaceDBTX(nil, nil, nil, nil, 0).WithLists(rows, nil, tt.queryErr))}
+			got, err := repo.ListByAccount(context.Background(), tt.accountID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 2 || got[0].Role != workspace.RoleOwner || got[1].Workspace.Name != "Team" {
+				t.Fatalf("unexpected workspaces: %+v", got)
+			}
+		})
+	}
+}
+
+func TestWorkspaceRepository_Get(t *testing.T) {
+	row := &generated.Workspace{ID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, Name: "Team"}
+	tests := []struct {
+		name    string
+		id      string
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] get workspace", id: row.ID.String()},
+		{name: "[Fail] invalid uuid is not found", id: "bad-uuid", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] not found", id: row.ID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] query error", id: row.ID.String(), rowErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WorkspaceRepository{queries: generated.New(mockdb.NewWorkspaceDBTX(row, nil, tt.rowErr, nil, 0))}
+			got, err := repo.Get(context.Background(), tt.id)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if errors.Is(tt.wantErr, domainerr.ErrNotFound) && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want ErrNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != row.ID.String() || got.Name != "Team" {
+				t.Fatalf("unexpected workspace: %+v", got)
+			}
+		})
+	}
+}
+
+func TestWorkspaceRepository_HasContents(t *testing.T) {
+	id := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	for _, want := range []bool{true, false} {
+		repo := &WorkspaceRepository{queries: generated.New(mockdb.NewWorkspaceDBTX(nil, nil, nil, nil, 0).WithHasContents(want))}
+		got, err := repo.HasContents(context.Background(), id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != want {
+			t.Fatalf("want %v, got %v", want, got)
+		}
+	}
+}
+
+func TestWorkspaceRepository_GetMember(t *testing.T) {
+	row := &generated.WorkspaceMember{
+		WorkspaceID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		AccountID:   pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Role:        "admin",
+	}
+	tests := []struct {
+		name      string
+		accountID string
+		rowErr    error
+		wantErr   error
+	}{
+		{name: "[Success] get member", accountID: row.AccountID.String()},
+		{name: "[Fail] invalid account uuid is not found", accountID: "bad-uuid", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] not a member", accountID: row.AccountID.String(), rowErr: pgx.ErrNoRows, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WorkspaceRepository{queries: generated.New(mockdb.NewWorkspaceDBTX(nil, row, tt.rowErr, nil, 0))}
+			got, err := repo.GetMember(context.Background(), row.WorkspaceID.String(), tt.accountID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Role != workspace.RoleAdmin || got.AccountID != row.AccountID.String() {
+				t.Fatalf("unexpected member: %+v", got)
+			}
+		})
+	}
+}
+
+func TestWorkspaceRepository_ListMembers(t *testing.T) {
+	workspaceID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	rows := []*generated.ListWorkspaceMembersRow{
+		{WorkspaceID: workspaceID, AccountID: pgtype.UUID{Bytes: [16]byte{2}, Valid: true}, Role: "owner", Email: "taro@example.com", FirstName: "Taro", Thumbnail: pgtype.Text{String: "https://example.com/t.png", Valid: true}},
+		{WorkspaceID: workspaceID, AccountID: pgtype.UUID{Bytes: [16]byte{3}, Valid: true}, Role: "member", Email: "hanako@example.com", FirstName: "Hanako"},
+	}
+	repo := &WorkspaceRepository{queries: generated.New(mockdb.NewWorkspaceDBTX(nil, nil, nil, nil, 0).WithLists(nil, rows, nil))}
+	got, err := repo.ListMembers(context.Background(), workspaceID.String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || got[0].Member.Role != workspace.RoleOwner || got[0].Thumbnail == nil || got[1].Thumbnail != nil || got[1].Email != "hanako@example.com" {
+		t.Fatalf("unexpected members: %+v", got)
+	}
+}
+
+func TestWorkspaceRepository_UpsertMember(t *testing.T) {
+	now := pgtype.Timestamptz{Time: time.Now().UTC().Truncate(time.Second), Valid: true}
+	row := &generated.WorkspaceMember{
+		WorkspaceID: pgtype.UUID{Bytes: [16]byte{1}, Valid: true},
+		AccountID:   pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		Role:        "member",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	valid := workspace.Member{WorkspaceID: row.WorkspaceID.String(), AccountID: row.AccountID.String(), Role: workspace.RoleMember}
+	tests := []struct {
+		name    string
+		member  workspace.Member
+		rowErr  error
+		wantErr error
+	}{
+		{name: "[Success] add member", member: valid},
+		{name: "[Fail] invalid account uuid is not found", member: workspace.Member{WorkspaceID: valid.WorkspaceID, AccountID: "bad-uuid"}, wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] query error", member: valid, rowErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mockdb.NewWorkspaceDBTX(nil, row, tt.rowErr, nil, 0)
+			repo := &WorkspaceRepository{queries: generated.New(mock)}
+			got, err := repo.UpsertMember(context.Background(), tt.member)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if errors.Is(tt.wantErr, domainerr.ErrNotFound) && !errors.Is(err, domainerr.ErrNotFound) {
+					t.Fatalf("want ErrNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mock.Args[2] != "member" || got.AccountID != valid.AccountID || got.CreatedAt.IsZero() {
+				t.Fatalf("unexpected upsert: %+v / %+v", mock.Args, got)
+			}
+		})
+	}
+}
+
+func TestWorkspaceRepository_DeleteMember(t *testing.T) {
+	workspaceID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}.String()
+	accountID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}.String()
+	tests := []struct {
+		name     string
+		affected int64
+		execErr  error
+		wantErr  error
+	}{
+		{name: "[Success] remove member", affected: 1},
+		{name: "[Fail] not a member", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] exec error", execErr: errors.New("exec"), wantErr: errors.New("exec")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &WorkspaceRepository{queries: generated.New(mockdb.NewWorkspaceDBTX(nil, nil, nil, tt.execErr, tt.affected))}
+			err := repo.DeleteMember(context.Background(), workspaceID, accountID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr.Error() {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Package ratelimit contains the in-process token bucket store.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	domainratelimit "immortal-architecture-clean/backend/internal/domain/ratelimit"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// sweepEvery is how many takes pass between sweeps of idle buckets.
+const sweepEvery = 1024
+
+type entry struct {
+	bucket domainratelimit.Bucket
+	window time.Duration
+}
+
+// MemoryStore keeps buckets in process memory. Each server instance has its own
+// budget, so use the Postgres store when several instances share the traffic.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]entry
+	takes   int
+}
+
+var _ port.RateLimitStore = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: map[string]entry{}}
+}
+
+// Take refills and takes a token under the store's lock.
+func (s *MemoryStore) Take(_ context.Context, key string, policy domainratelimit.Policy, now time.Time) (domainratelimit.Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.takes++
+	if s.takes%sweepEvery == 0 {
+		s.sweep(now)
+	}
+
+	e, ok := s.buckets[key]
+	if !ok {
+		e.bucket = policy.Full(now)
+	}
+	bucket, decision := policy.Take(e.bucket, now)
+	s.buckets[key] = entry{bucket: bucket, window: policy.Window}
+	return decision, nil
+}
+
+// sweep drops buckets that have been idle long enough to be full again; a missing
+// bucket starts full, so forgetting them changes nothing.
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, e := range s.buckets {
+		if now.Sub(e.bucket.UpdatedAt) >= e.window {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	domainratelimit "immortal-architecture-clean/backend/internal/domain/ratelimit"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	policy := domainratelimit.Policy{Limit: 2, Window: time.Minute}
+
+	tests := []struct {
+		name        string
+		key         string
+		at          time.Time
+		wantAllowed bool
+		wantRemain  int
+	}{
+		{name: "[Success] first request", key: "read:account:a", at: now, wantAllowed: true, wantRemain: 1},
+		{name: "[Success] second request", key: "read:account:a", at: now, wantAllowed: true, wantRemain: 0},
+		{name: "[Fail] bucket is empty", key: "read:account:a", at: now, wantAllowed: false, wantRemain: 0},
+		{name: "[Success] other keys have their own bucket", key: "read:account:b", at: now, wantAllowed: true, wantRemain: 1},
+		{name: "[Success] refilled after half the window", key: "read:account:a", at: now.Add(30 * time.Second), wantAllowed: true, wantRemain: 0},
+	}
+
+	store := NewMemoryStore()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.Take(context.Background(), tt.key, policy, tt.at)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Allowed != tt.wantAllowed || got.Remaining != tt.wantRemain {
+				t.Fatalf("want allowed=%v remaining=%d, got %+v", tt.wantAllowed, tt.wantRemain, got)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_TakeConcurrently(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	policy := domainratelimit.Policy{Limit: 50, Window: time.Hour}
+	store := NewMemoryStore()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		allowed int
+	)
+	for range 200 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d, _ := store.Take(context.Background(), "write:ip:192.0.2.1", policy, now)
+			if d.Allowed {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if allowed != policy.Limit {
+		t.Fatalf("allowed %d requests, want %d", allowed, policy.Limit)
+	}
+}
+
+func TestMemoryStore_Sweep(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	policy := domainratelimit.Policy{Limit: 1, Window: time.Minute}
+	store := NewMemoryStore()
+
+	_, _ = store.Take(context.Background(), "idle", policy, now)
+	for range sweepEvery - 1 {
+		_, _ = store.Take(context.Background(), "busy", policy, now.Add(time.Minute))
+	}
+
+	if _, ok := store.buckets["idle"]; ok {
+		t.Fatalf("idle bucket was not swept")
+	}
+	if _, ok := store.buckets["busy"]; !ok {
+		t.Fatalf("busy bucket was swept")
+	}
+}
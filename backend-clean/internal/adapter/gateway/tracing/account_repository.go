@@ -0,0 +1,55 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AccountRepository traces the calls of another AccountRepository.
+type AccountRepository struct {
+	next   port.AccountRepository
+	tracer trace.Tracer
+}
+
+var _ port.AccountRepository = (*AccountRepository)(nil)
+
+// NewAccountRepository wraps next so that each call starts a span.
+func NewAccountRepository(next port.AccountRepository, tracer trace.Tracer) *AccountRepository {
+	return &AccountRepository{next: next, tracer: tracer}
+}
+
+// UpsertOAuthAccount traces AccountRepository.UpsertOAuthAccount.
+func (r *AccountRepository) UpsertOAuthAccount(ctx context.Context, input account.OAuthAccountInput) (*account.Account, error) {
+	ctx, span := start(ctx, r.tracer, "AccountRepository.UpsertOAuthAccount", attribute.String("account.provider", input.Provider))
+	acc, err := r.next.UpsertOAuthAccount(ctx, input)
+	if acc != nil {
+		span.SetAttributes(attribute.String("account.id", acc.ID))
+	}
+	end(span, err)
+	return acc, err
+}
+
+// GetByID traces AccountRepository.GetByID.
+func (r *AccountRepository) GetByID(ctx context.Context, id string) (*account.Account, error) {
+	ctx, span := start(ctx, r.tracer, "AccountRepository.GetByID", attribute.String("account.id", id))
+	acc, err := r.next.GetByID(ctx, id)
+	end(span, err)
+	return acc, err
+}
+
+// GetByEmail traces AccountRepository.GetByEmail. The address is personal data
+// and is left out of the span.
+func (r *AccountRepository) GetByEmail(ctx context.Context, email string) (*account.Account, error) {
+	ctx, span := start(ctx, r.tracer, "AccountRepository.GetByEmail")
+	acc, err := r.next.GetByEmail(ctx, email)
+	if acc != nil {
+		span.SetAttributes(attribute.String("account.id", acc.ID))
+	}
+	end(span, err)
+	return acc, err
+}
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestAccountRepository(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		call          func(repo *AccountRepository, mock *mockusecase.MockAccountRepository) error
+		wantSpan      string
+		wantAccountID string
+		wantErr       error
+	}{
+		{
+			name: "[Success] upsert records the account",
+			call: func(repo *AccountRepository, mock *mockusecase.MockAccountRepository) error {
+				mock.EXPECT().UpsertOAuthAccount(gomock.Any(), gomock.Any()).Return(&account.Account{ID: "acc-1"}, nil)
+				_, err := repo.UpsertOAuthAccount(ctx, account.OAuthAccountInput{Email: "a@example.com", Provider: "google"})
+				return err
+			},
+			wantSpan:      "AccountRepository.UpsertOAuthAccount",
+			wantAccountID: "acc-1",
+		},
+		{
+			name: "[Fail] unknown email",
+			call: func(repo *AccountRepository, mock *mockusecase.MockAccountRepository) error {
+				mock.EXPECT().GetByEmail(gomock.Any(), "a@example.com").Return(nil, domainerr.ErrNotFound)
+				_, err := repo.GetByEmail(ctx, "a@example.com")
+				return err
+			},
+			wantSpan: "AccountRepository.GetByEmail",
+			wantErr:  domainerr.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			next := mockusecase.NewMockAccountRepository(ctrl)
+			tracer, recorder := newRecorder()
+
+			err := tt.call(NewAccountRepository(next, tracer), next)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			span := onlySpan(t, recorder)
+			if span.Name() != tt.wantSpan {
+				t.Errorf("name = %q, want %q", span.Name(), tt.wantSpan)
+			}
+			if got := attr(span, "account.id").AsString(); got != tt.wantAccountID {
+				t.Errorf("account.id = %q, want %q", got, tt.wantAccountID)
+			}
+			for _, kv := range span.Attributes() {
+				if strings.Contains(kv.Value.Emit(), "@") {
+					t.Errorf("attribute %s leaks the email address", kv.Key)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/trash"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteRepository traces the calls of another NoteRepository.
+type NoteRepository struct {
+	next   port.NoteRepository
+	tracer trace.Tracer
+}
+
+var _ port.NoteRepository = (*NoteRepository)(nil)
+
+// NewNoteRepository wraps next so that each call starts a span.
+func NewNoteRepository(next port.NoteRepository, tracer trace.Tracer) *NoteRepository {
+	return &NoteRepository{next: next, tracer: tracer}
+}
+
+func noteID(id string) attribute.KeyValue {
+	return attribute.String("note.id", id)
+}
+
+// List traces NoteRepository.List.
+func (r *NoteRepository) List(ctx context.Context, filters note.Filters) ([]note.WithMeta, error) {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.List")
+	notes, err := r.next.List(ctx, filters)
+	span.SetAttributes(attribute.Int("note.count", len(notes)))
+	end(span, err)
+	return notes, err
+}
+
+// Search traces NoteRepository.Search.
+func (r *NoteRepository) Search(ctx context.Context, filters note.Filters) ([]note.SearchResult, error) {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.Search")
+	results, err := r.next.Search(ctx, filters)
+	span.SetAttributes(attribute.Int("note.count", len(results)))
+	end(span, err)
+	return results, err
+}
+
+// Get traces NoteRepository.Get.
+func (r *NoteRepository) Get(ctx context.Context, id string) (*note.WithMeta, error) {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.Get", noteID(id))
+	n, err := r.next.Get(ctx, id)
+	end(span, err)
+	return n, err
+}
+
+// Create traces NoteRepository.Create.
+func (r *NoteRepository) Create(ctx context.Context, n note.Note) (*note.Note, error) {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.Create")
+	created, err := r.next.Create(ctx, n)
+	if created != nil {
+		span.SetAttributes(noteID(created.ID))
+	}
+	end(span, err)
+	return created, err
+}
+
+// Update traces NoteRepository.Update.
+func (r *NoteRepository) Update(ctx context.Context, n note.Note) (*note.Note, error) {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.Update", noteID(n.ID))
+	updated, err := r.next.Update(ctx, n)
+	end(span, err)
+	return updated, err
+}
+
+// UpdateStatus traces NoteRepository.UpdateStatus.
+func (r *NoteRepository) UpdateStatus(ctx context.Context, id string, status note.NoteStatus) (*note.Note, error) {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.UpdateStatus", noteID(id), attribute.String("note.status", string(status)))
+	updated, err := r.next.UpdateStatus(ctx, id, status)
+	end(span, err)
+	return updated, err
+}
+
+// UpdateTemplateVersion traces NoteRepository.UpdateTemplateVersion.
+func (r *NoteRepository) UpdateTemplateVersion(ctx context.Context, n note.Note, sections []note.Section) (*note.Note, error) {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.UpdateTemplateVersion", noteID(n.ID), attribute.Int("template.version", n.TemplateVersion))
+	updated, err := r.next.UpdateTemplateVersion(ctx, n, sections)
+	end(span, err)
+	return updated, err
+}
+
+// ListTrash traces NoteRepository.ListTrash.
+func (r *NoteRepository) ListTrash(ctx context.Context, filters trash.Filters) ([]note.WithMeta, error) {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.ListTrash")
+	notes, err := r.next.ListTrash(ctx, filters)
+	span.SetAttributes(attribute.Int("note.count", len(notes)))
+	end(span, err)
+	return notes, err
+}
+
+// GetTrashed traces NoteRepository.GetTrashed.
+func (r *NoteRepository) GetTrashed(ctx context.Context, id string) (*note.WithMeta, error) {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.GetTrashed", noteID(id))
+	n, err := r.next.GetTrashed(ctx, id)
+	end(span, err)
+	return n, err
+}
+
+// Trash traces NoteRepository.Trash.
+func (r *NoteRepository) Trash(ctx context.Context, id string) error {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.Trash", noteID(id))
+	err := r.next.Trash(ctx, id)
+	end(span, err)
+	return err
+}
+
+// Restore traces NoteRepository.Restore.
+func (r *NoteRepository) Restore(ctx context.Context, id string) error {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.Restore", noteID(id))
+	err := r.next.Restore(ctx, id)
+	end(span, err)
+	return err
+}
+
+// Delete traces NoteRepository.Delete.
+func (r *NoteRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.Delete", noteID(id))
+	err := r.next.Delete(ctx, id)
+	end(span, err)
+	return err
+}
+
+// ReplaceSections traces NoteRepository.ReplaceSections.
+func (r *NoteRepository) ReplaceSections(ctx context.Context, id string, sections []note.Section) error {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.ReplaceSections", noteID(id), attribute.Int("note.section_count", len(sections)))
+	err := r.next.ReplaceSections(ctx, id, sections)
+	end(span, err)
+	return err
+}
+
+// ReplaceTags traces NoteRepository.ReplaceTags.
+func (r *NoteRepository) ReplaceTags(ctx context.Context, id string, tags []note.Tag) error {
+	ctx, span := start(ctx, r.tracer, "NoteRepository.ReplaceTags", noteID(id), attribute.Int("note.tag_count", len(tags)))
+	err := r.next.ReplaceTags(ctx, id, tags)
+	end(span, err)
+	return err
+}
@@ -0,0 +1,112 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"go.opentelemetry.io/otel/codes"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNoteRepository(t *testing.T) {
+	ctx := context.Background()
+	dbErr := errors.New("connection reset")
+
+	tests := []struct {
+		name      string
+		call      func(repo *NoteRepository, mock *mockusecase.MockNoteRepository) error
+		wantSpan  string
+		wantNote  string
+		wantErr   error
+		wantCode  string
+		wantCount int64
+	}{
+		{
+			name: "[Success] get",
+			call: func(repo *NoteRepository, mock *mockusecase.MockNoteRepository) error {
+				mock.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1"}}, nil)
+				_, err := repo.Get(ctx, "note-1")
+				return err
+			},
+			wantSpan: "NoteRepository.Get",
+			wantNote: "note-1",
+		},
+		{
+			name: "[Success] create records the new ID",
+			call: func(repo *NoteRepository, mock *mockusecase.MockNoteRepository) error {
+				mock.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&note.Note{ID: "note-2"}, nil)
+				_, err := repo.Create(ctx, note.Note{Title: "t"})
+				return err
+			},
+			wantSpan: "NoteRepository.Create",
+			wantNote: "note-2",
+		},
+		{
+			name: "[Success] list records the count",
+			call: func(repo *NoteRepository, mock *mockusecase.MockNoteRepository) error {
+				mock.EXPECT().List(gomock.Any(), gomock.Any()).Return(make([]note.WithMeta, 3), nil)
+				_, err := repo.List(ctx, note.Filters{})
+				return err
+			},
+			wantSpan:  "NoteRepository.List",
+			wantCount: 3,
+		},
+		{
+			name: "[Fail] domain error keeps its code",
+			call: func(repo *NoteRepository, mock *mockusecase.MockNoteRepository) error {
+				mock.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, domainerr.ErrConflict)
+				_, err := repo.Update(ctx, note.Note{ID: "note-1"})
+				return err
+			},
+			wantSpan: "NoteRepository.Update",
+			wantNote: "note-1",
+			wantErr:  domainerr.ErrConflict,
+			wantCode: "CONFLICT",
+		},
+		{
+			name: "[Fail] storage error",
+			call: func(repo *NoteRepository, mock *mockusecase.MockNoteRepository) error {
+				mock.EXPECT().ReplaceSections(gomock.Any(), "note-1", gomock.Any()).Return(dbErr)
+				return repo.ReplaceSections(ctx, "note-1", nil)
+			},
+			wantSpan: "NoteRepository.ReplaceSections",
+			wantNote: "note-1",
+			wantErr:  dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			next := mockusecase.NewMockNoteRepository(ctrl)
+			tracer, recorder := newRecorder()
+
+			err := tt.call(NewNoteRepository(next, tracer), next)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			span := onlySpan(t, recorder)
+			if span.Name() != tt.wantSpan {
+				t.Errorf("name = %q, want %q", span.Name(), tt.wantSpan)
+			}
+			if got := attr(span, "note.id").AsString(); got != tt.wantNote {
+				t.Errorf("note.id = %q, want %q", got, tt.wantNote)
+			}
+			if got := attr(span, "note.count").AsInt64(); got != tt.wantCount {
+				t.Errorf("note.count = %d, want %d", got, tt.wantCount)
+			}
+			if got := attr(span, "error.type").AsString(); got != tt.wantCode {
+				t.Errorf("error.type = %q, want %q", got, tt.wantCode)
+			}
+			if wantFailed := tt.wantErr != nil; (span.Status().Code == codes.Error) != wantFailed {
+				t.Errorf("status = %v, want failed %v", span.Status().Code, wantFailed)
+			}
+		})
+	}
+}
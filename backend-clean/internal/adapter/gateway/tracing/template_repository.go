@@ -0,0 +1,130 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateRepository traces the calls of another TemplateRepository.
+type TemplateRepository struct {
+	next   port.TemplateRepository
+	tracer trace.Tracer
+}
+
+var _ port.TemplateRepository = (*TemplateRepository)(nil)
+
+// NewTemplateRepository wraps next so that each call starts a span.
+func NewTemplateRepository(next port.TemplateRepository, tracer trace.Tracer) *TemplateRepository {
+	return &TemplateRepository{next: next, tracer: tracer}
+}
+
+func templateID(id string) attribute.KeyValue {
+	return attribute.String("template.id", id)
+}
+
+// List traces TemplateRepository.List.
+func (r *TemplateRepository) List(ctx context.Context, filters template.Filters) ([]template.WithUsage, error) {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.List")
+	templates, err := r.next.List(ctx, filters)
+	span.SetAttributes(attribute.Int("template.count", len(templates)))
+	end(span, err)
+	return templates, err
+}
+
+// Get traces TemplateRepository.Get.
+func (r *TemplateRepository) Get(ctx context.Context, id string) (*template.WithUsage, error) {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.Get", templateID(id))
+	tpl, err := r.next.Get(ctx, id)
+	end(span, err)
+	return tpl, err
+}
+
+// Create traces TemplateRepository.Create.
+func (r *TemplateRepository) Create(ctx context.Context, tpl template.Template) (*template.Template, error) {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.Create")
+	created, err := r.next.Create(ctx, tpl)
+	if created != nil {
+		span.SetAttributes(templateID(created.ID))
+	}
+	end(span, err)
+	return created, err
+}
+
+// Update traces TemplateRepository.Update.
+func (r *TemplateRepository) Update(ctx context.Context, tpl template.Template) (*template.Template, error) {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.Update", templateID(tpl.ID))
+	updated, err := r.next.Update(ctx, tpl)
+	end(span, err)
+	return updated, err
+}
+
+// ListTrash traces TemplateRepository.ListTrash.
+func (r *TemplateRepository) ListTrash(ctx context.Context, filters trash.Filters) ([]template.WithUsage, error) {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.ListTrash")
+	templates, err := r.next.ListTrash(ctx, filters)
+	span.SetAttributes(attribute.Int("template.count", len(templates)))
+	end(span, err)
+	return templates, err
+}
+
+// GetTrashed traces TemplateRepository.GetTrashed.
+func (r *TemplateRepository) GetTrashed(ctx context.Context, id string) (*template.WithUsage, error) {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.GetTrashed", templateID(id))
+	tpl, err := r.next.GetTrashed(ctx, id)
+	end(span, err)
+	return tpl, err
+}
+
+// Trash traces TemplateRepository.Trash.
+func (r *TemplateRepository) Trash(ctx context.Context, id string) error {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.Trash", templateID(id))
+	err := r.next.Trash(ctx, id)
+	end(span, err)
+	return err
+}
+
+// Restore traces TemplateRepository.Restore.
+func (r *TemplateRepository) Restore(ctx context.Context, id string) error {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.Restore", templateID(id))
+	err := r.next.Restore(ctx, id)
+	end(span, err)
+	return err
+}
+
+// Delete traces TemplateRepository.Delete.
+func (r *TemplateRepository) Delete(ctx context.Context, id string) error {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.Delete", templateID(id))
+	err := r.next.Delete(ctx, id)
+	end(span, err)
+	return err
+}
+
+// ReplaceFields traces TemplateRepository.ReplaceFields.
+func (r *TemplateRepository) ReplaceFields(ctx context.Context, id string, version int, fields []template.Field) error {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.ReplaceFields", templateID(id), attribute.Int("template.version", version), attribute.Int("template.field_count", len(fields)))
+	err := r.next.ReplaceFields(ctx, id, version, fields)
+	end(span, err)
+	return err
+}
+
+// GetFields traces TemplateRepository.GetFields.
+func (r *TemplateRepository) GetFields(ctx context.Context, id string, version int) ([]template.Field, error) {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.GetFields", templateID(id), attribute.Int("template.version", version))
+	fields, err := r.next.GetFields(ctx, id, version)
+	end(span, err)
+	return fields, err
+}
+
+// PruneFieldVersions traces TemplateRepository.PruneFieldVersions.
+func (r *TemplateRepository) PruneFieldVersions(ctx context.Context, id string) error {
+	ctx, span := start(ctx, r.tracer, "TemplateRepository.PruneFieldVersions", templateID(id))
+	err := r.next.PruneFieldVersions(ctx, id)
+	end(span, err)
+	return err
+}
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"go.opentelemetry.io/otel/codes"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestTemplateRepository(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		call        func(repo *TemplateRepository, mock *mockusecase.MockTemplateRepository) error
+		wantSpan    string
+		wantVersion int64
+		wantErr     error
+	}{
+		{
+			name: "[Success] get fields of a version",
+			call: func(repo *TemplateRepository, mock *mockusecase.MockTemplateRepository) error {
+				mock.EXPECT().GetFields(gomock.Any(), "tpl-1", 2).Return([]template.Field{{ID: "f"}}, nil)
+				_, err := repo.GetFields(ctx, "tpl-1", 2)
+				return err
+			},
+			wantSpan:    "TemplateRepository.GetFields",
+			wantVersion: 2,
+		},
+		{
+			name: "[Fail] delete of a template in use",
+			call: func(repo *TemplateRepository, mock *mockusecase.MockTemplateRepository) error {
+				mock.EXPECT().Delete(gomock.Any(), "tpl-1").Return(domainerr.ErrTemplateInUse)
+				return repo.Delete(ctx, "tpl-1")
+			},
+			wantSpan: "TemplateRepository.Delete",
+			wantErr:  domainerr.ErrTemplateInUse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			next := mockusecase.NewMockTemplateRepository(ctrl)
+			tracer, recorder := newRecorder()
+
+			err := tt.call(NewTemplateRepository(next, tracer), next)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			span := onlySpan(t, recorder)
+			if span.Name() != tt.wantSpan {
+				t.Errorf("name = %q, want %q", span.Name(), tt.wantSpan)
+			}
+			if got := attr(span, "template.id").AsString(); got != "tpl-1" {
+				t.Errorf("template.id = %q, want tpl-1", got)
+			}
+			if got := attr(span, "template.version").AsInt64(); got != tt.wantVersion {
+				t.Errorf("template.version = %d, want %d", got, tt.wantVersion)
+			}
+			if wantFailed := tt.wantErr != nil; (span.Status().Code == codes.Error) != wantFailed {
+				t.Errorf("status = %v, want failed %v", span.Status().Code, wantFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Package tracing decorates repositories and the transaction manager with
+// OpenTelemetry spans, so that a trace shows every storage call a request made
+// and how long it took.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func start(ctx context.Context, tracer trace.Tracer, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// end ends the span, marking it failed when err is not nil. Domain errors also
+// record their code, so that an expected NOT_FOUND is told apart from a failure.
+func end(span trace.Span, err error) {
+	if err != nil {
+		if e, ok := domainerr.Lookup(err); ok {
+			span.SetAttributes(attribute.String("error.type", e.Code))
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
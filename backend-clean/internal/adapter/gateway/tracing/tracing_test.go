@@ -0,0 +1,32 @@
+package tracing
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newRecorder returns a tracer whose ended spans are kept by the recorder.
+func newRecorder() (trace.Tracer, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	return sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test"), recorder
+}
+
+// onlySpan returns the single ended span of the recorder.
+func onlySpan(t *testing.T, recorder *tracetest.SpanRecorder) sdktrace.ReadOnlySpan {
+	t.Helper()
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("spans = %d, want 1", len(spans))
+	}
+	return spans[0]
+}
+
+func attr(span sdktrace.ReadOnlySpan, key string) attribute.Value {
+	set := attribute.NewSet(span.Attributes()...)
+	v, _ := set.Value(attribute.Key(key))
+	return v
+}
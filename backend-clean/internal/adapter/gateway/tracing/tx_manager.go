@@ -0,0 +1,31 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TxManager traces the transactions of another TxManager. The repository calls
+// made inside a transaction become children of its span.
+type TxManager struct {
+	next   port.TxManager
+	tracer trace.Tracer
+}
+
+var _ port.TxManager = (*TxManager)(nil)
+
+// NewTxManager wraps next so that each transaction starts a span.
+func NewTxManager(next port.TxManager, tracer trace.Tracer) *TxManager {
+	return &TxManager{next: next, tracer: tracer}
+}
+
+// WithinTransaction traces TxManager.WithinTransaction, from begin to commit or rollback.
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := start(ctx, m.tracer, "TxManager.WithinTransaction")
+	err := m.next.WithinTransaction(ctx, fn)
+	end(span, err)
+	return err
+}
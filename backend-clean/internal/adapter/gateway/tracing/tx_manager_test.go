@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestTxManager(t *testing.T) {
+	fnErr := errors.New("save failed")
+
+	tests := []struct {
+		name    string
+		fnErr   error
+		wantErr error
+	}{
+		{name: "[Success] committed"},
+		{name: "[Fail] rolled back", fnErr: fnErr, wantErr: fnErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			next := mockusecase.NewMockTxManager(ctrl)
+			next.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			tracer, recorder := newRecorder()
+
+			var inner trace.SpanContext
+			err := NewTxManager(next, tracer).WithinTransaction(context.Background(), func(ctx context.Context) error {
+				_, span := tracer.Start(ctx, "inner")
+				inner = span.SpanContext()
+				span.End()
+				return tt.fnErr
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			spans := recorder.Ended()
+			if len(spans) != 2 {
+				t.Fatalf("spans = %d, want 2", len(spans))
+			}
+			tx := spans[1]
+			if tx.Name() != "TxManager.WithinTransaction" {
+				t.Errorf("name = %q, want TxManager.WithinTransaction", tx.Name())
+			}
+			if spans[0].Parent().SpanID() != tx.SpanContext().SpanID() || inner.TraceID() != tx.SpanContext().TraceID() {
+				t.Errorf("work inside the transaction is not a child of its span")
+			}
+			if wantFailed := tt.wantErr != nil; (tx.Status().Code == codes.Error) != wantFailed {
+				t.Errorf("status = %v, want failed %v", tx.Status().Code, wantFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Package webhook contains the HTTP gateway that posts webhook deliveries to their endpoints.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	domainwebhook "immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Headers sent with every delivery.
+const (
+	HeaderDeliveryID = "X-Webhook-Id"
+	HeaderEvent      = "X-Webhook-Event"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderSignature  = "X-Webhook-Signature"
+)
+
+// DefaultTimeout bounds one delivery request. It stays well below domainwebhook.DeliveryLease.
+const DefaultTimeout = 10 * time.Second
+
+// dialTimeout bounds connecting to an endpoint.
+const dialTimeout = 5 * time.Second
+
+// errNonPublicAddress is returned when an endpoint resolves to an internal address.
+var errNonPublicAddress = errors.New("webhook: endpoint resolves to a non-public address")
+
+// HTTPSender posts deliveries as signed JSON.
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+var _ port.WebhookSender = (*HTTPSender)(nil)
+
+// NewHTTPSender creates an HTTPSender using client; nil means a client with DefaultTimeout
+// that only connects to public addresses. Redirects are not followed, so the endpoint must
+// be the final URL.
+func NewHTTPSender(client *http.Client) *HTTPSender {
+	if client == nil {
+		client = newPublicClient()
+	}
+	noRedirect := *client
+	noRedirect.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return &HTTPSender{client: &noRedirect, now: time.Now}
+}
+
+// Send posts the delivery body signed with the webhook secret.
+// Any response is returned as its status code; only transport failures are errors.
+func (s *HTTPSender) Send(ctx context.Context, d domainwebhook.DueDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := s.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "mini-notion-webhook/1.0")
+	req.Header.Set(HeaderDeliveryID, d.Delivery.ID)
+	req.Header.Set(HeaderEvent, string(d.Delivery.EventType))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, domainwebhook.Sign(d.Secret, timestamp, d.Delivery.Payload))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	// The status alone decides the outcome; the response body is ignored.
+	defer res.Body.Close()
+	return res.StatusCode, nil
+}
+
+// newPublicClient returns a client that refuses to connect to loopback, private and link-local
+// addresses. The check runs on the resolved address right before connecting, so host names
+// that resolve (or are rebound) to internal addresses are refused as well. Proxies are not
+// used, as they would connect on the sender's behalf.
+func newPublicClient() *http.Client {
+	dialer := &net.Dialer{Timeout: dialTimeout, Control: dialPublicOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: DefaultTimeout, Transport: transport}
+}
+
+// dialPublicOnly is a net.Dialer Control hook rejecting addresses deliveries may not reach.
+func dialPublicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !domainwebhook.PublicAddr(addr) {
+		return errNonPublicAddress
+	}
+	return nil
+}
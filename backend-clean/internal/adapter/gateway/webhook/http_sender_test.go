@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	domainwebhook "immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+func newDueDelivery(url string) domainwebhook.DueDelivery {
+	return domainwebhook.DueDelivery{
+		Delivery: domainwebhook.Delivery{
+			ID:        "d1",
+			EventType: domainwebhook.EventNotePublished,
+			Payload:   []byte(`{"id":"e1","type":"note.published"}`),
+		},
+		URL:    url,
+		Secret: "whsec_test",
+	}
+}
+
+func TestHTTPSender_Send(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		wantStatus int
+	}{
+		{name: "[Success] 2xx response", status: http.StatusNoContent, wantStatus: http.StatusNoContent},
+		{name: "[Success] error responses are returned as status", status: http.StatusInternalServerError, wantStatus: http.StatusInternalServerError},
+		{name: "[Success] redirects are not followed", status: http.StatusFound, wantStatus: http.StatusFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received := make(chan *http.Request, 1)
+			var body []byte
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("read body: %v", err)
+				}
+				body = b
+				received <- r
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			sender := NewHTTPSender(receiver.Client())
+			sender.now = func() time.Time { return time.Unix(1700000000, 0) }
+			d := newDueDelivery(receiver.URL + "/hook")
+
+			status, err := sender.Send(context.Background(), d)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if status != tt.wantStatus {
+				t.Fatalf("want status %d, got %d", tt.wantStatus, status)
+			}
+
+			r := <-received
+			if r.Method != http.MethodPost || r.URL.Path != "/hook" || r.Header.Get("Content-Type") != "application/json" {
+				t.Fatalf("unexpected request: %s %s %v", r.Method, r.URL.Path, r.Header)
+			}
+			if r.Header.Get(HeaderDeliveryID) != "d1" || r.Header.Get(HeaderEvent) != "note.published" {
+				t.Fatalf("unexpected headers: %v", r.Header)
+			}
+			ts, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+			if err != nil || ts != 1700000000 {
+				t.Fatalf("unexpected timestamp header %q", r.Header.Get(HeaderTimestamp))
+			}
+			if !domainwebhook.Verify("whsec_test", ts, body, r.Header.Get(HeaderSignature)) {
+				t.Fatalf("signature %q does not verify", r.Header.Get(HeaderSignature))
+			}
+			if string(body) != string(d.Delivery.Payload) {
+				t.Fatalf("unexpected body: %s", body)
+			}
+		})
+	}
+}
+
+func TestHTTPSender_Send_TransportError(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := receiver.URL
+	receiver.Close()
+
+	status, err := NewHTTPSender(receiver.Client()).Send(context.Background(), newDueDelivery(url))
+	if err == nil || status != 0 {
+		t.Fatalf("want transport error, got status %d err %v", status, err)
+	}
+}
+
+func TestHTTPSender_Send_NonPublicAddress(t *testing.T) {
+	hit := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { hit = true }))
+	defer receiver.Close()
+
+	// The default client must refuse the loopback receiver before any request is sent.
+	status, err := NewHTTPSender(nil).Send(context.Background(), newDueDelivery(receiver.URL+"/hook"))
+	if !errors.Is(err, errNonPublicAddress) || status != 0 {
+		t.Fatalf("want non-public address error, got status %d err %v", status, err)
+	}
+	if hit {
+		t.Fatal("receiver on loopback was reached")
+	}
+}
+
+func TestHTTPSender_Send_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	receiver := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { <-release }))
+	defer receiver.Close()
+	defer close(release)
+
+	client := receiver.Client()
+	client.Timeout = 50 * time.Millisecond
+	if _, err := NewHTTPSender(client).Send(context.Background(), newDueDelivery(receiver.URL)); err == nil {
+		t.Fatal("want timeout error, got nil")
+	}
+}
@@ -3,43 +3,50 @@ package controller
 
 import (
 	"context"
-	"errors"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/accountpb"
 	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
 	"immortal-architecture-clean/backend/internal/domain/account"
-	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // AccountController implements accountpb.AccountServiceServer.
 type AccountController struct {
 	accountpb.UnimplementedAccountServiceServer
-	inputFactory  func(port.AccountRepository, port.AccountOutputPort) port.AccountInputPort
-	outputFactory func() *grpcpresenter.AccountPresenter
-	repoFactory   func() port.AccountRepository
+	inputFactory         func(port.AccountRepository, port.WorkspaceRepository, port.TxManager, port.EventPublisher, port.AuditRecorder, port.AccountOutputPort) port.AccountInputPort
+	outputFactory        func() *grpcpresenter.AccountPresenter
+	repoFactory          func() port.AccountRepository
+	workspaceRepoFactory func() port.WorkspaceRepository
+	txFactory            func() port.TxManager
+	eventsFactory        func() port.EventPublisher
+	auditFactory         func() port.AuditRecorder
 }
 
 // NewAccountController creates a new gRPC account controller.
 func NewAccountController(
-	inputFactory func(port.AccountRepository, port.AccountOutputPort) port.AccountInputPort,
+	inputFactory func(port.AccountRepository, port.WorkspaceRepository, port.TxManager, port.EventPublisher, port.AuditRecorder, port.AccountOutputPort) port.AccountInputPort,
 	outputFactory func() *grpcpresenter.AccountPresenter,
 	repoFactory func() port.AccountRepository,
+	workspaceRepoFactory func() port.WorkspaceRepository,
+	txFactory func() port.TxManager,
+	eventsFactory func() port.EventPublisher,
+	auditFactory func() port.AuditRecorder,
 ) *AccountController {
 	return &AccountController{
-		inputFactory:  inputFactory,
-		outputFactory: outputFactory,
-		repoFactory:   repoFactory,
+		inputFactory:         inputFactory,
+		outputFactory:        outputFactory,
+		repoFactory:          repoFactory,
+		workspaceRepoFactory: workspaceRepoFactory,
+		txFactory:            txFactory,
+		eventsFactory:        eventsFactory,
+		auditFactory:         auditFactory,
 	}
 }
 
 // GetAccountByID retrieves an account by ID.
 func (s *AccountController) GetAccountByID(ctx context.Context, req *accountpb.GetAccountByIdRequest) (*accountpb.AccountResponse, error) {
 	presenter := s.outputFactory()
-	input := s.inputFactory(s.repoFactory(), presenter)
+	input := s.inputFactory(s.repoFactory(), s.workspaceRepoFactory(), s.txFactory(), s.eventsFactory(), s.auditFactory(), presenter)
 
 	if err := input.GetByID(ctx, req.GetAccountId()); err != nil {
 		return nil, handleError(err)
@@ -51,7 +58,7 @@ func (s *AccountController) GetAccountByID(ctx context.Context, req *accountpb.G
 // GetAccountByEmail retrieves an account by email.
 func (s *AccountController) GetAccountByEmail(ctx context.Context, req *accountpb.GetAccountByEmailRequest) (*accountpb.AccountResponse, error) {
 	presenter := s.outputFactory()
-	input := s.inputFactory(s.repoFactory(), presenter)
+	input := s.inputFactory(s.repoFactory(), s.workspaceRepoFactory(), s.txFactory(), s.eventsFactory(), s.auditFactory(), presenter)
 
 	if err := input.GetByEmail(ctx, req.GetEmail()); err != nil {
 		return nil, handleError(err)
@@ -63,7 +70,7 @@ func (s *AccountController) GetAccountByEmail(ctx context.Context, req *accountp
 // CreateOrGetAccount creates or gets an OAuth account.
 func (s *AccountController) CreateOrGetAccount(ctx context.Context, req *accountpb.CreateOrGetAccountRequest) (*accountpb.AccountResponse, error) {
 	presenter := s.outputFactory()
-	input := s.inputFactory(s.repoFactory(), presenter)
+	input := s.inputFactory(s.repoFactory(), s.workspaceRepoFactory(), s.txFactory(), s.eventsFactory(), s.auditFactory(), presenter)
 
 	thumbnail := req.GetThumbnail()
 	var thumbnailPtr *string
@@ -86,20 +93,3 @@ func (s *AccountController) CreateOrGetAccount(ctx context.Context, req *account
 
 	return presenter.Response(), nil
 }
-
-// handleError converts domain errors to gRPC status codes.
-func handleError(err error) error {
-	if errors.Is(err, domainerr.ErrNotFound) {
-		return status.Error(codes.NotFound, err.Error())
-	}
-	if errors.Is(err, domainerr.ErrUnauthorized) {
-		return status.Error(codes.Unauthenticated, err.Error())
-	}
-	if errors.Is(err, account.ErrInvalidEmail) {
-		return status.Error(codes.InvalidArgument, err.Error())
-	}
-	if errors.Is(err, domainerr.ErrProviderRequired) {
-		return status.Error(codes.InvalidArgument, err.Error())
-	}
-	return status.Error(codes.Internal, "internal server error")
-}
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/interceptor"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
+)
+
+// errorDomain names the service in the ErrorInfo details of error statuses.
+const errorDomain = "mini-notion"
+
+// handleError converts domain errors to gRPC statuses. The status carries the
+// error's code as ErrorInfo details, and the request field it is about as
+// BadRequest details. Errors outside the domain catalog become Internal without
+// revealing their message.
+func handleError(err error) error {
+	e, ok := domainerr.Lookup(err)
+	if !ok {
+		return status.Error(codes.Internal, "internal server error")
+	}
+	st := status.New(statusCode(e.Kind), err.Error())
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: e.Code, Domain: errorDomain}}
+	if e.Field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: e.Field, Description: err.Error()}},
+		})
+	}
+	if withDetails, detailErr := st.WithDetails(details...); detailErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// statusCode returns the gRPC code of a domain error kind.
+func statusCode(kind domainerr.Kind) codes.Code {
+	switch kind {
+	case domainerr.KindInvalid:
+		return codes.InvalidArgument
+	case domainerr.KindUnauthenticated:
+		return codes.Unauthenticated
+	case domainerr.KindForbidden:
+		return codes.PermissionDenied
+	case domainerr.KindNotFound:
+		return codes.NotFound
+	case domainerr.KindAlreadyExists:
+		return codes.AlreadyExists
+	case domainerr.KindConflict:
+		return codes.Aborted
+	case domainerr.KindFailedPrecondition:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// currentAccountID returns the account authenticated by the auth interceptor.
+func currentAccountID(ctx context.Context) (string, error) {
+	id, ok := interceptor.AccountIDFromContext(ctx)
+	if !ok {
+		return "", domainerr.ErrUnauthenticated
+	}
+	return id, nil
+}
+
+// pageParams converts the cursor and limit fields of list requests.
+func pageParams(cursor string, limit int32) (*page.Cursor, int, error) {
+	if cursor == "" {
+		return nil, int(limit), nil
+	}
+	after, err := page.Decode(cursor)
+	if err != nil {
+		return nil, 0, err
+	}
+	return after, int(limit), nil
+}
+
+func optionalInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+func optionalString(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
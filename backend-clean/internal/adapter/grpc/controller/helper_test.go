@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestHandleError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    codes.Code
+		wantMessage string
+		wantReason  string
+		wantField   string
+	}{
+		{name: "[Success] invalid input", err: domainerr.ErrTitleRequired, wantCode: codes.InvalidArgument, wantMessage: "title is required", wantReason: "TITLE_REQUIRED", wantField: "title"},
+		{name: "[Success] wrapped error", err: fmt.Errorf("%w: %q", domainerr.ErrUnmatchedHeading, "Memo"), wantCode: codes.InvalidArgument, wantMessage: `heading does not match any template field: "Memo"`, wantReason: "UNMATCHED_HEADING"},
+		{name: "[Success] not found", err: domainerr.ErrNotFound, wantCode: codes.NotFound, wantMessage: "not found", wantReason: "NOT_FOUND"},
+		{name: "[Success] unauthenticated", err: domainerr.ErrUnauthenticated, wantCode: codes.Unauthenticated, wantMessage: "unauthenticated", wantReason: "UNAUTHORIZED"},
+		{name: "[Success] forbidden", err: domainerr.ErrUnauthorized, wantCode: codes.PermissionDenied, wantMessage: "unauthorized", wantReason: "FORBIDDEN"},
+		{name: "[Success] already exists", err: domainerr.ErrAlreadyWorkspaceMember, wantCode: codes.AlreadyExists, wantMessage: domainerr.ErrAlreadyWorkspaceMember.Error(), wantReason: "ALREADY_WORKSPACE_MEMBER", wantField: "email"},
+		{name: "[Success] version conflict", err: domainerr.ErrConflict, wantCode: codes.Aborted, wantMessage: domainerr.ErrConflict.Error(), wantReason: "CONFLICT"},
+		{name: "[Success] failed precondition", err: domainerr.ErrTemplateUsedByTrashedNotes, wantCode: codes.FailedPrecondition, wantMessage: domainerr.ErrTemplateUsedByTrashedNotes.Error(), wantReason: "TEMPLATE_IN_USE"},
+		{name: "[Fail] unexpected error is not leaked", err: fmt.Errorf("query notes: %w", context.DeadlineExceeded), wantCode: codes.Internal, wantMessage: "internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(handleError(tt.err))
+			if !ok {
+				t.Fatalf("not a status error")
+			}
+			if st.Code() != tt.wantCode || st.Message() != tt.wantMessage {
+				t.Fatalf("status = %v %q, want %v %q", st.Code(), st.Message(), tt.wantCode, tt.wantMessage)
+			}
+			var reason, field string
+			for _, d := range st.Details() {
+				switch d := d.(type) {
+				case *errdetails.ErrorInfo:
+					reason = d.GetReason()
+					if d.GetDomain() != errorDomain {
+						t.Errorf("domain = %q, want %q", d.GetDomain(), errorDomain)
+					}
+				case *errdetails.BadRequest:
+					field = d.GetFieldViolations()[0].GetField()
+				}
+			}
+			if reason != tt.wantReason || field != tt.wantField {
+				t.Errorf("reason/field = %q/%q, want %q/%q", reason, field, tt.wantReason, tt.wantField)
+			}
+		})
+	}
+}
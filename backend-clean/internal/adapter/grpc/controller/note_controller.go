@@ -0,0 +1,341 @@
+package controller
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteController implements notepb.NoteServiceServer.
+type NoteController struct {
+	notepb.UnimplementedNoteServiceServer
+	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort
+	outputFactory   func() *grpcpresenter.NotePresenter
+	noteRepoFactory func() port.NoteRepository
+	tplRepoFactory  func() port.TemplateRepository
+	revRepoFactory  func() port.NoteRevisionRepository
+	linkRepoFactory func() port.NoteLinkRepository
+	txFactory       func() port.TxManager
+	eventsFactory   func() port.EventPublisher
+	auditFactory    func() port.AuditRecorder
+}
+
+// NewNoteController creates a new gRPC note controller.
+func NewNoteController(
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort,
+	outputFactory func() *grpcpresenter.NotePresenter,
+	noteRepoFactory func() port.NoteRepository,
+	tplRepoFactory func() port.TemplateRepository,
+	revRepoFactory func() port.NoteRevisionRepository,
+	linkRepoFactory func() port.NoteLinkRepository,
+	txFactory func() port.TxManager,
+	eventsFactory func() port.EventPublisher,
+	auditFactory func() port.AuditRecorder,
+) *NoteController {
+	return &NoteController{
+		inputFactory:    inputFactory,
+		outputFactory:   outputFactory,
+		noteRepoFactory: noteRepoFactory,
+		tplRepoFactory:  tplRepoFactory,
+		revRepoFactory:  revRepoFactory,
+		linkRepoFactory: linkRepoFactory,
+		txFactory:       txFactory,
+		eventsFactory:   eventsFactory,
+		auditFactory:    auditFactory,
+	}
+}
+
+// ListNotes returns a page of notes visible to the viewer.
+func (s *NoteController) ListNotes(ctx context.Context, req *notepb.ListNotesRequest) (*notepb.ListNotesResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	after, limit, err := pageParams(req.GetCursor(), req.GetLimit())
+	if err != nil {
+		return nil, handleError(err)
+	}
+	status, err := fromNoteStatusFilter(req.Status)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	filters := note.Filters{
+		Status:     status,
+		TemplateID: optionalString(req.TemplateId),
+		OwnerID:    optionalString(req.OwnerId),
+		Query:      optionalString(req.Q),
+		Tags:       toTags(req.GetTags()),
+		TagMatch:   fromTagMatch(req.GetTagMatch()),
+		Limit:      limit,
+		After:      after,
+	}
+	input, presenter := s.newIO()
+	if err := input.List(ctx, filters, accountID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.ListResponse(), nil
+}
+
+// SearchNotes returns notes matching the keyword, ranked by relevance.
+func (s *NoteController) SearchNotes(ctx context.Context, req *notepb.SearchNotesRequest) (*notepb.SearchNotesResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	status, err := fromNoteStatusFilter(req.Status)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	var searchIn []note.SearchTarget
+	for _, t := range req.GetIn() {
+		searchIn = append(searchIn, fromSearchTarget(t))
+	}
+	query := req.GetQ()
+	filters := note.Filters{
+		Status:     status,
+		TemplateID: optionalString(req.TemplateId),
+		OwnerID:    optionalString(req.OwnerId),
+		Query:      &query,
+		SearchIn:   searchIn,
+		Tags:       toTags(req.GetTags()),
+		TagMatch:   fromTagMatch(req.GetTagMatch()),
+	}
+	input, presenter := s.newIO()
+	if err := input.Search(ctx, filters, accountID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.SearchResponse(), nil
+}
+
+// GetNote retrieves a note by ID.
+func (s *NoteController) GetNote(ctx context.Context, req *notepb.GetNoteRequest) (*notepb.NoteResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	if err := input.Get(ctx, req.GetNoteId(), accountID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// CreateNote creates a note.
+func (s *NoteController) CreateNote(ctx context.Context, req *notepb.CreateNoteRequest) (*notepb.NoteResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	sections := make([]port.SectionInput, 0, len(req.GetSections()))
+	for _, sec := range req.GetSections() {
+		sections = append(sections, port.SectionInput{
+			FieldID: sec.GetFieldId(),
+			Content: sec.GetContent(),
+		})
+	}
+	input, presenter := s.newIO()
+	err = input.Create(ctx, port.NoteCreateInput{
+		Title:      req.GetTitle(),
+		TemplateID: req.GetTemplateId(),
+		OwnerID:    accountID,
+		Sections:   sections,
+		Tags:       req.GetTags(),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// UpdateNote updates a note.
+func (s *NoteController) UpdateNote(ctx context.Context, req *notepb.UpdateNoteRequest) (*notepb.NoteResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	sections := make([]port.SectionUpdateInput, 0, len(req.GetSections()))
+	for _, sec := range req.GetSections() {
+		sections = append(sections, port.SectionUpdateInput{
+			SectionID: sec.GetSectionId(),
+			Content:   sec.GetContent(),
+		})
+	}
+	input, presenter := s.newIO()
+	err = input.Update(ctx, port.NoteUpdateInput{
+		ID:         req.GetNoteId(),
+		Title:      req.GetTitle(),
+		OwnerID:    accountID,
+		Sections:   sections,
+		AddTags:    req.GetAddTags(),
+		RemoveTags: req.GetRemoveTags(),
+		Version:    optionalInt(req.Version),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// DeleteNote deletes a note.
+func (s *NoteController) DeleteNote(ctx context.Context, req *notepb.DeleteNoteRequest) (*notepb.DeleteNoteResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	if err := input.Delete(ctx, req.GetNoteId(), accountID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.DeleteResponse(), nil
+}
+
+// PublishNote publishes a note.
+func (s *NoteController) PublishNote(ctx context.Context, req *notepb.ChangeNoteStatusRequest) (*notepb.NoteResponse, error) {
+	return s.changeStatus(ctx, req, note.StatusPublish)
+}
+
+// UnpublishNote reverts a note to draft.
+func (s *NoteController) UnpublishNote(ctx context.Context, req *notepb.ChangeNoteStatusRequest) (*notepb.NoteResponse, error) {
+	return s.changeStatus(ctx, req, note.StatusDraft)
+}
+
+// MigrateNoteTemplate moves a note to the latest version of its template.
+func (s *NoteController) MigrateNoteTemplate(ctx context.Context, req *notepb.MigrateNoteTemplateRequest) (*notepb.NoteResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	mappings := make([]note.FieldMapping, 0, len(req.GetMappings()))
+	for _, m := range req.GetMappings() {
+		mappings = append(mappings, note.FieldMapping{
+			FromFieldID: m.GetFromFieldId(),
+			ToFieldID:   m.GetToFieldId(),
+		})
+	}
+	input, presenter := s.newIO()
+	err = input.MigrateTemplate(ctx, port.NoteMigrateTemplateInput{
+		ID:       req.GetNoteId(),
+		OwnerID:  accountID,
+		Mappings: mappings,
+		Version:  optionalInt(req.Version),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// ExportNoteMarkdown renders a note as a Markdown document.
+func (s *NoteController) ExportNoteMarkdown(ctx context.Context, req *notepb.GetNoteRequest) (*notepb.ExportNoteMarkdownResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	if err := input.ExportMarkdown(ctx, req.GetNoteId(), accountID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.MarkdownResponse(), nil
+}
+
+// ImportNoteMarkdown creates a draft note from a Markdown document.
+func (s *NoteController) ImportNoteMarkdown(ctx context.Context, req *notepb.ImportNoteMarkdownRequest) (*notepb.NoteResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	err = input.ImportMarkdown(ctx, port.NoteImportMarkdownInput{
+		TemplateID: req.GetTemplateId(),
+		OwnerID:    accountID,
+		Markdown:   req.GetMarkdown(),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+func (s *NoteController) changeStatus(ctx context.Context, req *notepb.ChangeNoteStatusRequest, status note.NoteStatus) (*notepb.NoteResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	err = input.ChangeStatus(ctx, port.NoteStatusChangeInput{
+		ID:      req.GetNoteId(),
+		Status:  status,
+		OwnerID: accountID,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+func (s *NoteController) newIO() (port.NoteInputPort, *grpcpresenter.NotePresenter) {
+	output := s.outputFactory()
+	input := s.inputFactory(s.noteRepoFactory(), s.tplRepoFactory(), s.revRepoFactory(), s.linkRepoFactory(), s.txFactory(), s.eventsFactory(), s.auditFactory(), output)
+	return input, output
+}
+
+// fromNoteStatusFilter converts an optional status filter; an unspecified status is rejected.
+func fromNoteStatusFilter(s *notepb.NoteStatus) (*note.NoteStatus, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var status note.NoteStatus
+	switch *s {
+	case notepb.NoteStatus_NOTE_STATUS_DRAFT:
+		status = note.StatusDraft
+	case notepb.NoteStatus_NOTE_STATUS_PUBLISH:
+		status = note.StatusPublish
+	default:
+		return nil, domainerr.ErrInvalidStatus
+	}
+	return &status, nil
+}
+
+// fromSearchTarget converts a search target; unknown values become an invalid
+// target that the use case rejects.
+func fromSearchTarget(t notepb.SearchTarget) note.SearchTarget {
+	switch t {
+	case notepb.SearchTarget_SEARCH_TARGET_TITLE:
+		return note.SearchTitle
+	case notepb.SearchTarget_SEARCH_TARGET_CONTENT:
+		return note.SearchContent
+	case notepb.SearchTarget_SEARCH_TARGET_LABEL:
+		return note.SearchLabel
+	default:
+		return note.SearchTarget(t.String())
+	}
+}
+
+// fromTagMatch converts a tag match; unspecified means any.
+func fromTagMatch(m notepb.TagMatch) note.TagMatch {
+	switch m {
+	case notepb.TagMatch_TAG_MATCH_UNSPECIFIED:
+		return ""
+	case notepb.TagMatch_TAG_MATCH_ANY:
+		return note.TagMatchAny
+	case notepb.TagMatch_TAG_MATCH_ALL:
+		return note.TagMatchAll
+	default:
+		return note.TagMatch(m.String())
+	}
+}
+
+func toTags(raws []string) []note.Tag {
+	if len(raws) == 0 {
+		return nil
+	}
+	tags := make([]note.Tag, 0, len(raws))
+	for _, t := range raws {
+		tags = append(tags, note.Tag(t))
+	}
+	return tags
+}
@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/interceptor"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newTestNoteController(input *ctrlmock.NoteInputStub) *NoteController {
+	return NewNoteController(
+		func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+			input.Output = output
+			return input
+		},
+		grpcpresenter.NewNotePresenter,
+		func() port.NoteRepository { return nil },
+		func() port.TemplateRepository { return nil },
+		func() port.NoteRevisionRepository { return nil },
+		func() port.NoteLinkRepository { return nil },
+		func() port.TxManager { return nil },
+		func() port.EventPublisher { return nil },
+		func() port.AuditRecorder { return nil },
+	)
+}
+
+func TestNoteController_CreateNote(t *testing.T) {
+	tests := []struct {
+		name      string
+		accountID string
+		err       error
+		wantCode  codes.Code
+	}{
+		{name: "[Success] create note", accountID: "owner-1", wantCode: codes.OK},
+		{name: "[Fail] validation error", accountID: "owner-1", err: domainerr.ErrTitleRequired, wantCode: codes.InvalidArgument},
+		{name: "[Fail] template not found", accountID: "owner-1", err: domainerr.ErrNotFound, wantCode: codes.NotFound},
+		{name: "[Fail] unexpected error", accountID: "owner-1", err: context.DeadlineExceeded, wantCode: codes.Internal},
+		{name: "[Fail] unauthenticated", wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteInputStub{Err: tt.err}
+			ctrl := newTestNoteController(input)
+			ctx := context.Background()
+			if tt.accountID != "" {
+				ctx = interceptor.WithAccountID(ctx, tt.accountID)
+			}
+			res, err := ctrl.CreateNote(ctx, &notepb.CreateNoteRequest{
+				Title:      "Hello",
+				TemplateId: "tpl-1",
+				Sections:   []*notepb.CreateSectionInput{{FieldId: "f1", Content: "c1"}},
+				Tags:       []string{"auth"},
+			})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("want code %v, got %v (%v)", tt.wantCode, got, err)
+			}
+			if tt.wantCode == codes.OK && res.GetOwnerId() != "owner-1" {
+				t.Fatalf("unexpected response: %+v", res)
+			}
+			if tt.wantCode == codes.Unauthenticated {
+				return
+			}
+			if len(input.Created.Tags) != 1 || input.Created.Tags[0] != "auth" {
+				t.Fatalf("tags not mapped: %+v", input.Created.Tags)
+			}
+		})
+	}
+}
+
+func TestNoteController_ListNotes(t *testing.T) {
+	after := page.Cursor{UpdatedAt: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), ID: "00000000-0000-0000-0000-000000000001"}
+	publish := notepb.NoteStatus_NOTE_STATUS_PUBLISH
+	unspecified := notepb.NoteStatus_NOTE_STATUS_UNSPECIFIED
+	cursor := after.Encode()
+	bad := "%%%"
+	empty := ""
+	limit := int32(5)
+
+	tests := []struct {
+		name     string
+		req      *notepb.ListNotesRequest
+		wantCode codes.Code
+		check    func(t *testing.T, f note.Filters)
+	}{
+		{
+			name:     "[Success] filters and page params",
+			req:      &notepb.ListNotesRequest{Status: &publish, Cursor: &cursor, Limit: &limit, TemplateId: &empty},
+			wantCode: codes.OK,
+			check: func(t *testing.T, f note.Filters) {
+				if f.Status == nil || *f.Status != note.StatusPublish {
+					t.Fatalf("status not mapped: %+v", f.Status)
+				}
+				if f.After == nil || f.After.ID != after.ID || f.Limit != 5 {
+					t.Fatalf("page params not mapped: %+v %d", f.After, f.Limit)
+				}
+				if f.TemplateID != nil {
+					t.Fatalf("empty template id should be dropped: %q", *f.TemplateID)
+				}
+			},
+		},
+		{
+			name:     "[Success] tag filters",
+			req:      &notepb.ListNotesRequest{Tags: []string{"auth", "api"}, TagMatch: notepb.TagMatch_TAG_MATCH_ALL},
+			wantCode: codes.OK,
+			check: func(t *testing.T, f note.Filters) {
+				if len(f.Tags) != 2 || f.Tags[0] != "auth" || !f.MatchesAllTags() {
+					t.Fatalf("tag filters not mapped: %+v %q", f.Tags, f.TagMatch)
+				}
+			},
+		},
+		{
+			name:     "[Fail] unspecified status",
+			req:      &notepb.ListNotesRequest{Status: &unspecified},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "[Fail] invalid cursor",
+			req:      &notepb.ListNotesRequest{Cursor: &bad},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteInputStub{Notes: []note.WithMeta{{Note: note.Note{ID: "n1", Status: note.StatusPublish}}}}
+			ctrl := newTestNoteController(input)
+			res, err := ctrl.ListNotes(interceptor.WithAccountID(context.Background(), "viewer-1"), tt.req)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("want code %v, got %v (%v)", tt.wantCode, got, err)
+			}
+			if tt.check == nil {
+				return
+			}
+			tt.check(t, input.Filters)
+			if len(res.GetItems()) != 1 || res.GetItems()[0].GetStatus() != notepb.NoteStatus_NOTE_STATUS_PUBLISH {
+				t.Fatalf("unexpected response: %+v", res)
+			}
+		})
+	}
+}
+
+func TestNoteController_PublishNote(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantStatus notepb.NoteStatus
+	}{
+		{name: "[Success] publish", wantCode: codes.OK, wantStatus: notepb.NoteStatus_NOTE_STATUS_PUBLISH},
+		{name: "[Fail] not owner", err: domainerr.ErrUnauthorized, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newTestNoteController(&ctrlmock.NoteInputStub{Err: tt.err})
+			res, err := ctrl.PublishNote(interceptor.WithAccountID(context.Background(), "owner-1"), &notepb.ChangeNoteStatusRequest{NoteId: "n1"})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("want code %v, got %v (%v)", tt.wantCode, got, err)
+			}
+			if res.GetStatus() != tt.wantStatus {
+				t.Fatalf("want status %v, got %v", tt.wantStatus, res.GetStatus())
+			}
+		})
+	}
+}
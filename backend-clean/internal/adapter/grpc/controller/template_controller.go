@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateController implements templatepb.TemplateServiceServer.
+type TemplateController struct {
+	templatepb.UnimplementedTemplateServiceServer
+	inputFactory  func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort
+	outputFactory func() *grpcpresenter.TemplatePresenter
+	repoFactory   func() port.TemplateRepository
+	txFactory     func() port.TxManager
+	eventsFactory func() port.EventPublisher
+	auditFactory  func() port.AuditRecorder
+}
+
+// NewTemplateController creates a new gRPC template controller.
+func NewTemplateController(
+	inputFactory func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort,
+	outputFactory func() *grpcpresenter.TemplatePresenter,
+	repoFactory func() port.TemplateRepository,
+	txFactory func() port.TxManager,
+	eventsFactory func() port.EventPublisher,
+	auditFactory func() port.AuditRecorder,
+) *TemplateController {
+	return &TemplateController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+		txFactory:     txFactory,
+		eventsFactory: eventsFactory,
+		auditFactory:  auditFactory,
+	}
+}
+
+// ListTemplates returns a page of templates.
+func (s *TemplateController) ListTemplates(ctx context.Context, req *templatepb.ListTemplatesRequest) (*templatepb.ListTemplatesResponse, error) {
+	after, limit, err := pageParams(req.GetCursor(), req.GetLimit())
+	if err != nil {
+		return nil, handleError(err)
+	}
+	filters := template.Filters{
+		Query:   optionalString(req.Q),
+		OwnerID: optionalString(req.OwnerId),
+		Limit:   limit,
+		After:   after,
+	}
+	input, presenter := s.newIO()
+	if err := input.List(ctx, filters); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.ListResponse(), nil
+}
+
+// GetTemplate retrieves a template by ID.
+func (s *TemplateController) GetTemplate(ctx context.Context, req *templatepb.GetTemplateRequest) (*templatepb.TemplateResponse, error) {
+	input, presenter := s.newIO()
+	if err := input.Get(ctx, req.GetTemplateId()); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// CreateTemplate creates a template.
+func (s *TemplateController) CreateTemplate(ctx context.Context, req *templatepb.CreateTemplateRequest) (*templatepb.TemplateResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	err = input.Create(ctx, port.TemplateCreateInput{
+		Name:    req.GetName(),
+		OwnerID: accountID,
+		Fields:  fromFieldInputs(req.GetFields()),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// UpdateTemplate updates a template.
+func (s *TemplateController) UpdateTemplate(ctx context.Context, req *templatepb.UpdateTemplateRequest) (*templatepb.TemplateResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	err = input.Update(ctx, port.TemplateUpdateInput{
+		ID:      req.GetTemplateId(),
+		Name:    req.GetName(),
+		Fields:  fromFieldInputs(req.GetFields()),
+		OwnerID: accountID,
+		Version: optionalInt(req.Version),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.Response(), nil
+}
+
+// DeleteTemplate deletes a template.
+func (s *TemplateController) DeleteTemplate(ctx context.Context, req *templatepb.DeleteTemplateRequest) (*templatepb.DeleteTemplateResponse, error) {
+	accountID, err := currentAccountID(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	input, presenter := s.newIO()
+	if err := input.Delete(ctx, req.GetTemplateId(), accountID); err != nil {
+		return nil, handleError(err)
+	}
+	return presenter.DeleteResponse(), nil
+}
+
+func (s *TemplateController) newIO() (port.TemplateInputPort, *grpcpresenter.TemplatePresenter) {
+	output := s.outputFactory()
+	input := s.inputFactory(s.repoFactory(), s.txFactory(), s.eventsFactory(), s.auditFactory(), output)
+	return input, output
+}
+
+func fromFieldInputs(fields []*templatepb.FieldInput) []template.Field {
+	res := make([]template.Field, 0, len(fields))
+	for _, f := range fields {
+		res = append(res, template.Field{
+			ID:         f.GetId(),
+			Label:      f.GetLabel(),
+			Order:      int(f.GetOrder()),
+			IsRequired: f.GetIsRequired(),
+			Type:       template.FieldType(f.GetType()),
+			Options:    f.GetOptions(),
+		})
+	}
+	return res
+}
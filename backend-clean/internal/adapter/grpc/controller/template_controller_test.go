@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/interceptor"
+	grpcpresenter "immortal-architecture-clean/backend/internal/adapter/grpc/presenter"
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newTestTemplateController(input *ctrlmock.TemplateInputStub) *TemplateController {
+	return NewTemplateController(
+		func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
+			input.Output = output
+			return input
+		},
+		grpcpresenter.NewTemplatePresenter,
+		func() port.TemplateRepository { return nil },
+		func() port.TxManager { return nil },
+		func() port.EventPublisher { return nil },
+		func() port.AuditRecorder { return nil },
+	)
+}
+
+func TestTemplateController_CreateTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "[Success] create template", wantCode: codes.OK},
+		{name: "[Fail] field required", err: domainerr.ErrFieldRequired, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newTestTemplateController(&ctrlmock.TemplateInputStub{Err: tt.err})
+			res, err := ctrl.CreateTemplate(interceptor.WithAccountID(context.Background(), "owner-1"), &templatepb.CreateTemplateRequest{
+				Name:   "Daily",
+				Fields: []*templatepb.FieldInput{{Label: "Body", Order: 1, IsRequired: true}},
+			})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("want code %v, got %v (%v)", tt.wantCode, got, err)
+			}
+			if tt.wantCode == codes.OK && (res.GetName() != "Daily" || res.GetOwnerId() != "owner-1") {
+				t.Fatalf("unexpected response: %+v", res)
+			}
+		})
+	}
+}
+
+func TestTemplateController_DeleteTemplate(t *testing.T) {
+	tests := []struct {
+		name      string
+		anonymous bool
+		err       error
+		wantCode  codes.Code
+	}{
+		{name: "[Success] delete template", wantCode: codes.OK},
+		{name: "[Fail] template in use", err: domainerr.ErrTemplateInUse, wantCode: codes.FailedPrecondition},
+		{name: "[Fail] not owner", err: domainerr.ErrUnauthorized, wantCode: codes.PermissionDenied},
+		{name: "[Fail] unauthenticated", anonymous: true, wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newTestTemplateController(&ctrlmock.TemplateInputStub{Err: tt.err})
+			ctx := context.Background()
+			if !tt.anonymous {
+				ctx = interceptor.WithAccountID(ctx, "owner-1")
+			}
+			res, err := ctrl.DeleteTemplate(ctx, &templatepb.DeleteTemplateRequest{TemplateId: "tpl-1"})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("want code %v, got %v (%v)", tt.wantCode, got, err)
+			}
+			if tt.wantCode == codes.OK && !res.GetSuccess() {
+				t.Fatalf("want success")
+			}
+		})
+	}
+}
@@ -28,7 +28,9 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// AccountService provides account-related operations
+// AccountService provides account-related operations.
+// CreateOrGetAccount signs an account in and needs no token; the other RPCs carry
+// the same bearer access token as the HTTP API in the authorization metadata.
 type AccountServiceClient interface {
 	// GetAccountById retrieves an account by ID
 	GetAccountById(ctx context.Context, in *GetAccountByIdRequest, opts ...grpc.CallOption) (*AccountResponse, error)
@@ -80,7 +82,9 @@ func (c *accountServiceClient) CreateOrGetAccount(ctx context.Context, in *Creat
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility.
 //
-// AccountService provides account-related operations
+// AccountService provides account-related operations.
+// CreateOrGetAccount signs an account in and needs no token; the other RPCs carry
+// the same bearer access token as the HTTP API in the authorization metadata.
 type AccountServiceServer interface {
 	// GetAccountById retrieves an account by ID
 	GetAccountById(context.Context, *GetAccountByIdRequest) (*AccountResponse, error)
@@ -0,0 +1,1942 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v4.25.1
+// source: proto/note.proto
+
+package notepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NoteStatus int32
+
+const (
+	NoteStatus_NOTE_STATUS_UNSPECIFIED NoteStatus = 0
+	NoteStatus_NOTE_STATUS_DRAFT       NoteStatus = 1
+	NoteStatus_NOTE_STATUS_PUBLISH     NoteStatus = 2
+)
+
+// Enum value maps for NoteStatus.
+var (
+	NoteStatus_name = map[int32]string{
+		0: "NOTE_STATUS_UNSPECIFIED",
+		1: "NOTE_STATUS_DRAFT",
+		2: "NOTE_STATUS_PUBLISH",
+	}
+	NoteStatus_value = map[string]int32{
+		"NOTE_STATUS_UNSPECIFIED": 0,
+		"NOTE_STATUS_DRAFT":       1,
+		"NOTE_STATUS_PUBLISH":     2,
+	}
+)
+
+func (x NoteStatus) Enum() *NoteStatus {
+	p := new(NoteStatus)
+	*p = x
+	return p
+}
+
+func (x NoteStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NoteStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_note_proto_enumTypes[0].Descriptor()
+}
+
+func (NoteStatus) Type() protoreflect.EnumType {
+	return &file_proto_note_proto_enumTypes[0]
+}
+
+func (x NoteStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NoteStatus.Descriptor instead.
+func (NoteStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{0}
+}
+
+type TagMatch int32
+
+const (
+	TagMatch_TAG_MATCH_UNSPECIFIED TagMatch = 0
+	TagMatch_TAG_MATCH_ANY         TagMatch = 1
+	TagMatch_TAG_MATCH_ALL         TagMatch = 2
+)
+
+// Enum value maps for TagMatch.
+var (
+	TagMatch_name = map[int32]string{
+		0: "TAG_MATCH_UNSPECIFIED",
+		1: "TAG_MATCH_ANY",
+		2: "TAG_MATCH_ALL",
+	}
+	TagMatch_value = map[string]int32{
+		"TAG_MATCH_UNSPECIFIED": 0,
+		"TAG_MATCH_ANY":         1,
+		"TAG_MATCH_ALL":         2,
+	}
+)
+
+func (x TagMatch) Enum() *TagMatch {
+	p := new(TagMatch)
+	*p = x
+	return p
+}
+
+func (x TagMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_note_proto_enumTypes[1].Descriptor()
+}
+
+func (TagMatch) Type() protoreflect.EnumType {
+	return &file_proto_note_proto_enumTypes[1]
+}
+
+func (x TagMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatch.Descriptor instead.
+func (TagMatch) EnumDescriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{1}
+}
+
+type SearchTarget int32
+
+const (
+	SearchTarget_SEARCH_TARGET_UNSPECIFIED SearchTarget = 0
+	SearchTarget_SEARCH_TARGET_TITLE       SearchTarget = 1
+	SearchTarget_SEARCH_TARGET_CONTENT     SearchTarget = 2
+	SearchTarget_SEARCH_TARGET_LABEL       SearchTarget = 3
+)
+
+// Enum value maps for SearchTarget.
+var (
+	SearchTarget_name = map[int32]string{
+		0: "SEARCH_TARGET_UNSPECIFIED",
+		1: "SEARCH_TARGET_TITLE",
+		2: "SEARCH_TARGET_CONTENT",
+		3: "SEARCH_TARGET_LABEL",
+	}
+	SearchTarget_value = map[string]int32{
+		"SEARCH_TARGET_UNSPECIFIED": 0,
+		"SEARCH_TARGET_TITLE":       1,
+		"SEARCH_TARGET_CONTENT":     2,
+		"SEARCH_TARGET_LABEL":       3,
+	}
+)
+
+func (x SearchTarget) Enum() *SearchTarget {
+	p := new(SearchTarget)
+	*p = x
+	return p
+}
+
+func (x SearchTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_note_proto_enumTypes[2].Descriptor()
+}
+
+func (SearchTarget) Type() protoreflect.EnumType {
+	return &file_proto_note_proto_enumTypes[2]
+}
+
+func (x SearchTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchTarget.Descriptor instead.
+func (SearchTarget) EnumDescriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{2}
+}
+
+type ListNotesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Q          *string                `protobuf:"bytes,2,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	Status     *NoteStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=note.v1.NoteStatus,oneof" json:"status,omitempty"`
+	TemplateId *string                `protobuf:"bytes,4,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	OwnerId    *string                `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	// cursor is the next_cursor of the previous page; empty starts from the beginning
+	Cursor *string `protobuf:"bytes,6,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// limit defaults to 20 and is capped at 100
+	Limit *int32   `protobuf:"varint,7,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Tags  []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// tag_match defaults to any
+	TagMatch      TagMatch `protobuf:"varint,9,opt,name=tag_match,json=tagMatch,proto3,enum=note.v1.TagMatch" json:"tag_match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesRequest) Reset() {
+	*x = ListNotesRequest{}
+	mi := &file_proto_note_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesRequest) ProtoMessage() {}
+
+func (x *ListNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListNotesRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetStatus() NoteStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return NoteStatus_NOTE_STATUS_UNSPECIFIED
+}
+
+func (x *ListNotesRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListNotesRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_UNSPECIFIED
+}
+
+type ListNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NoteResponse        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesResponse) Reset() {
+	*x = ListNotesResponse{}
+	mi := &file_proto_note_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesResponse) ProtoMessage() {}
+
+func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListNotesResponse) GetItems() []*NoteResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListNotesResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListNotesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type SearchNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Q     string                 `protobuf:"bytes,2,opt,name=q,proto3" json:"q,omitempty"`
+	// in limits which parts of a note q matches; empty means all
+	In         []SearchTarget `protobuf:"varint,3,rep,packed,name=in,proto3,enum=note.v1.SearchTarget" json:"in,omitempty"`
+	Status     *NoteStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=note.v1.NoteStatus,oneof" json:"status,omitempty"`
+	TemplateId *string        `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	OwnerId    *string        `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	Tags       []string       `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// tag_match defaults to any
+	TagMatch      TagMatch `protobuf:"varint,8,opt,name=tag_match,json=tagMatch,proto3,enum=note.v1.TagMatch" json:"tag_match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotesRequest) Reset() {
+	*x = SearchNotesRequest{}
+	mi := &file_proto_note_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotesRequest) ProtoMessage() {}
+
+func (x *SearchNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotesRequest.ProtoReflect.Descriptor instead.
+func (*SearchNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchNotesRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchNotesRequest) GetIn() []SearchTarget {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *SearchNotesRequest) GetStatus() NoteStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return NoteStatus_NOTE_STATUS_UNSPECIFIED
+}
+
+func (x *SearchNotesRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *SearchNotesRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *SearchNotesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchNotesRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_UNSPECIFIED
+}
+
+type SearchNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*NoteSearchResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotesResponse) Reset() {
+	*x = SearchNotesResponse{}
+	mi := &file_proto_note_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotesResponse) ProtoMessage() {}
+
+func (x *SearchNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotesResponse.ProtoReflect.Descriptor instead.
+func (*SearchNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchNotesResponse) GetResults() []*NoteSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type NoteSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *NoteResponse          `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	Rank          float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet       string                 `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Highlights    []*SearchHighlight     `protobuf:"bytes,4,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteSearchResult) Reset() {
+	*x = NoteSearchResult{}
+	mi := &file_proto_note_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteSearchResult) ProtoMessage() {}
+
+func (x *NoteSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteSearchResult.ProtoReflect.Descriptor instead.
+func (*NoteSearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NoteSearchResult) GetNote() *NoteResponse {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *NoteSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *NoteSearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *NoteSearchResult) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// SearchHighlight is a matched range [start, end) of a snippet, counted in characters
+type SearchHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_proto_note_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchHighlight) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SearchHighlight) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type GetNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRequest) Reset() {
+	*x = GetNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRequest) ProtoMessage() {}
+
+func (x *GetNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRequest.ProtoReflect.Descriptor instead.
+func (*GetNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+type CreateNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Sections      []*CreateSectionInput  `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetSections() []*CreateSectionInput {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *CreateNoteRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateSectionInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       string                 `protobuf:"bytes,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSectionInput) Reset() {
+	*x = CreateSectionInput{}
+	mi := &file_proto_note_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSectionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSectionInput) ProtoMessage() {}
+
+func (x *CreateSectionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSectionInput.ProtoReflect.Descriptor instead.
+func (*CreateSectionInput) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateSectionInput) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *CreateSectionInput) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpdateNoteRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	NoteId   string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Title    string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Sections []*UpdateSectionInput  `protobuf:"bytes,4,rep,name=sections,proto3" json:"sections,omitempty"`
+	// version is the note version being edited; a mismatch fails with ABORTED
+	Version *int32 `protobuf:"varint,5,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// remove_tags is applied before add_tags
+	AddTags       []string `protobuf:"bytes,6,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`
+	RemoveTags    []string `protobuf:"bytes,7,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteRequest) Reset() {
+	*x = UpdateNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteRequest) ProtoMessage() {}
+
+func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetSections() []*UpdateSectionInput {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *UpdateNoteRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateNoteRequest) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *UpdateNoteRequest) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+type UpdateSectionInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SectionId     string                 `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSectionInput) Reset() {
+	*x = UpdateSectionInput{}
+	mi := &file_proto_note_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSectionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSectionInput) ProtoMessage() {}
+
+func (x *UpdateSectionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSectionInput.ProtoReflect.Descriptor instead.
+func (*UpdateSectionInput) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateSectionInput) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *UpdateSectionInput) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type DeleteNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteRequest) Reset() {
+	*x = DeleteNoteRequest{}
+	mi := &file_proto_note_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteRequest) ProtoMessage() {}
+
+func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+type DeleteNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteResponse) Reset() {
+	*x = DeleteNoteResponse{}
+	mi := &file_proto_note_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteResponse) ProtoMessage() {}
+
+func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ChangeNoteStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeNoteStatusRequest) Reset() {
+	*x = ChangeNoteStatusRequest{}
+	mi := &file_proto_note_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeNoteStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeNoteStatusRequest) ProtoMessage() {}
+
+func (x *ChangeNoteStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeNoteStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeNoteStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangeNoteStatusRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+type FieldMapping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// from_field_id is a field of the note's current template version
+	FromFieldId string `protobuf:"bytes,1,opt,name=from_field_id,json=fromFieldId,proto3" json:"from_field_id,omitempty"`
+	// to_field_id is a field of the latest template version
+	ToFieldId     string `protobuf:"bytes,2,opt,name=to_field_id,json=toFieldId,proto3" json:"to_field_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldMapping) Reset() {
+	*x = FieldMapping{}
+	mi := &file_proto_note_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldMapping) ProtoMessage() {}
+
+func (x *FieldMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldMapping.ProtoReflect.Descriptor instead.
+func (*FieldMapping) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FieldMapping) GetFromFieldId() string {
+	if x != nil {
+		return x.FromFieldId
+	}
+	return ""
+}
+
+func (x *FieldMapping) GetToFieldId() string {
+	if x != nil {
+		return x.ToFieldId
+	}
+	return ""
+}
+
+type ExportNoteMarkdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Markdown      string                 `protobuf:"bytes,2,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNoteMarkdownResponse) Reset() {
+	*x = ExportNoteMarkdownResponse{}
+	mi := &file_proto_note_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNoteMarkdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNoteMarkdownResponse) ProtoMessage() {}
+
+func (x *ExportNoteMarkdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNoteMarkdownResponse.ProtoReflect.Descriptor instead.
+func (*ExportNoteMarkdownResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportNoteMarkdownResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportNoteMarkdownResponse) GetMarkdown() string {
+	if x != nil {
+		return x.Markdown
+	}
+	return ""
+}
+
+type ImportNoteMarkdownRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// H2 headings are matched to the latest fields of the template by label
+	Markdown      string `protobuf:"bytes,3,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportNoteMarkdownRequest) Reset() {
+	*x = ImportNoteMarkdownRequest{}
+	mi := &file_proto_note_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportNoteMarkdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNoteMarkdownRequest) ProtoMessage() {}
+
+func (x *ImportNoteMarkdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNoteMarkdownRequest.ProtoReflect.Descriptor instead.
+func (*ImportNoteMarkdownRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportNoteMarkdownRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ImportNoteMarkdownRequest) GetMarkdown() string {
+	if x != nil {
+		return x.Markdown
+	}
+	return ""
+}
+
+type MigrateNoteTemplateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NoteId string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// fields without a mapping start empty
+	Mappings []*FieldMapping `protobuf:"bytes,3,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	// version is the note version being edited; a mismatch fails with ABORTED
+	Version       *int32 `protobuf:"varint,4,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateNoteTemplateRequest) Reset() {
+	*x = MigrateNoteTemplateRequest{}
+	mi := &file_proto_note_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateNoteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateNoteTemplateRequest) ProtoMessage() {}
+
+func (x *MigrateNoteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateNoteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*MigrateNoteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MigrateNoteTemplateRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *MigrateNoteTemplateRequest) GetMappings() []*FieldMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+func (x *MigrateNoteTemplateRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type AccountSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Thumbnail     *string                `protobuf:"bytes,4,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountSummary) Reset() {
+	*x = AccountSummary{}
+	mi := &file_proto_note_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSummary) ProtoMessage() {}
+
+func (x *AccountSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSummary.ProtoReflect.Descriptor instead.
+func (*AccountSummary) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AccountSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccountSummary) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *AccountSummary) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *AccountSummary) GetThumbnail() string {
+	if x != nil && x.Thumbnail != nil {
+		return *x.Thumbnail
+	}
+	return ""
+}
+
+type Section struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldId       string                 `protobuf:"bytes,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	FieldLabel    string                 `protobuf:"bytes,3,opt,name=field_label,json=fieldLabel,proto3" json:"field_label,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	IsRequired    bool                   `protobuf:"varint,5,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
+	FieldType     string                 `protobuf:"bytes,6,opt,name=field_type,json=fieldType,proto3" json:"field_type,omitempty"`
+	FieldOptions  []string               `protobuf:"bytes,7,rep,name=field_options,json=fieldOptions,proto3" json:"field_options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	mi := &file_proto_note_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Section) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Section) GetFieldId() string {
+	if x != nil {
+		return x.FieldId
+	}
+	return ""
+}
+
+func (x *Section) GetFieldLabel() string {
+	if x != nil {
+		return x.FieldLabel
+	}
+	return ""
+}
+
+func (x *Section) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Section) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+func (x *Section) GetFieldType() string {
+	if x != nil {
+		return x.FieldType
+	}
+	return ""
+}
+
+func (x *Section) GetFieldOptions() []string {
+	if x != nil {
+		return x.FieldOptions
+	}
+	return nil
+}
+
+type NoteLinkSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	NoteId        string                 `protobuf:"bytes,3,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Status        NoteStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=note.v1.NoteStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteLinkSummary) Reset() {
+	*x = NoteLinkSummary{}
+	mi := &file_proto_note_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteLinkSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteLinkSummary) ProtoMessage() {}
+
+func (x *NoteLinkSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteLinkSummary.ProtoReflect.Descriptor instead.
+func (*NoteLinkSummary) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NoteLinkSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NoteLinkSummary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NoteLinkSummary) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *NoteLinkSummary) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteLinkSummary) GetStatus() NoteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NoteStatus_NOTE_STATUS_UNSPECIFIED
+}
+
+type NoteResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TemplateId   string                 `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateName string                 `protobuf:"bytes,4,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	OwnerId      string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Owner        *AccountSummary        `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Status       NoteStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=note.v1.NoteStatus" json:"status,omitempty"`
+	Sections     []*Section             `protobuf:"bytes,8,rep,name=sections,proto3" json:"sections,omitempty"`
+	// links and backlinks are only returned by GetNote
+	Links     []*NoteLinkSummary     `protobuf:"bytes,9,rep,name=links,proto3" json:"links,omitempty"`
+	Backlinks []*NoteLinkSummary     `protobuf:"bytes,10,rep,name=backlinks,proto3" json:"backlinks,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// version increases on every change; pass it back in UpdateNoteRequest
+	Version int32 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	// template_version is the template fields version the sections belong to
+	TemplateVersion int32 `protobuf:"varint,14,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	// tags are sorted
+	Tags          []string `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteResponse) Reset() {
+	*x = NoteResponse{}
+	mi := &file_proto_note_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteResponse) ProtoMessage() {}
+
+func (x *NoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_note_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteResponse.ProtoReflect.Descriptor instead.
+func (*NoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_note_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NoteResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *NoteResponse) GetOwner() *AccountSummary {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetStatus() NoteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NoteStatus_NOTE_STATUS_UNSPECIFIED
+}
+
+func (x *NoteResponse) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetLinks() []*NoteLinkSummary {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetBacklinks() []*NoteLinkSummary {
+	if x != nil {
+		return x.Backlinks
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *NoteResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NoteResponse) GetTemplateVersion() int32 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *NoteResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+var File_proto_note_proto protoreflect.FileDescriptor
+
+const file_proto_note_proto_rawDesc = "" +
+	"\n" +
+	"\x10proto/note.proto\x12\anote.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xed\x02\n" +
+	"\x10ListNotesRequest\x12\x11\n" +
+	"\x01q\x18\x02 \x01(\tH\x00R\x01q\x88\x01\x01\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.note.v1.NoteStatusH\x01R\x06status\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\x04 \x01(\tH\x02R\n" +
+	"templateId\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x05 \x01(\tH\x03R\aownerId\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x06 \x01(\tH\x04R\x06cursor\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\a \x01(\x05H\x05R\x05limit\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12.\n" +
+	"\ttag_match\x18\t \x01(\x0e2\x11.note.v1.TagMatchR\btagMatchB\x04\n" +
+	"\x02_qB\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_template_idB\v\n" +
+	"\t_owner_idB\t\n" +
+	"\a_cursorB\b\n" +
+	"\x06_limitJ\x04\b\x01\x10\x02R\tviewer_id\"\x91\x01\n" +
+	"\x11ListNotesResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.note.v1.NoteResponseR\x05items\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMoreB\x0e\n" +
+	"\f_next_cursor\"\xbe\x02\n" +
+	"\x12SearchNotesRequest\x12\f\n" +
+	"\x01q\x18\x02 \x01(\tR\x01q\x12%\n" +
+	"\x02in\x18\x03 \x03(\x0e2\x15.note.v1.SearchTargetR\x02in\x120\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x13.note.v1.NoteStatusH\x00R\x06status\x88\x01\x01\x12$\n" +
+	"\vtemplate_id\x18\x05 \x01(\tH\x01R\n" +
+	"templateId\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x06 \x01(\tH\x02R\aownerId\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12.\n" +
+	"\ttag_match\x18\b \x01(\x0e2\x11.note.v1.TagMatchR\btagMatchB\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_template_idB\v\n" +
+	"\t_owner_idJ\x04\b\x01\x10\x02R\tviewer_id\"J\n" +
+	"\x13SearchNotesResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.note.v1.NoteSearchResultR\aresults\"\xa5\x01\n" +
+	"\x10NoteSearchResult\x12)\n" +
+	"\x04note\x18\x01 \x01(\v2\x15.note.v1.NoteResponseR\x04note\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\x128\n" +
+	"\n" +
+	"highlights\x18\x04 \x03(\v2\x18.note.v1.SearchHighlightR\n" +
+	"highlights\"9\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\":\n" +
+	"\x0eGetNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteIdJ\x04\b\x02\x10\x03R\tviewer_id\"\xa7\x01\n" +
+	"\x11CreateNoteRequest\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\x127\n" +
+	"\bsections\x18\x04 \x03(\v2\x1b.note.v1.CreateSectionInputR\bsections\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tagsJ\x04\b\x01\x10\x02R\bowner_id\"I\n" +
+	"\x12CreateSectionInput\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\tR\afieldId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\xf2\x01\n" +
+	"\x11UpdateNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x127\n" +
+	"\bsections\x18\x04 \x03(\v2\x1b.note.v1.UpdateSectionInputR\bsections\x12\x1d\n" +
+	"\aversion\x18\x05 \x01(\x05H\x00R\aversion\x88\x01\x01\x12\x19\n" +
+	"\badd_tags\x18\x06 \x03(\tR\aaddTags\x12\x1f\n" +
+	"\vremove_tags\x18\a \x03(\tR\n" +
+	"removeTagsB\n" +
+	"\n" +
+	"\b_versionJ\x04\b\x02\x10\x03R\bowner_id\"M\n" +
+	"\x12UpdateSectionInput\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\tR\tsectionId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"<\n" +
+	"\x11DeleteNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteIdJ\x04\b\x02\x10\x03R\bowner_id\".\n" +
+	"\x12DeleteNoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\x17ChangeNoteStatusRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteIdJ\x04\b\x02\x10\x03R\bowner_id\"R\n" +
+	"\fFieldMapping\x12\"\n" +
+	"\rfrom_field_id\x18\x01 \x01(\tR\vfromFieldId\x12\x1e\n" +
+	"\vto_field_id\x18\x02 \x01(\tR\ttoFieldId\"T\n" +
+	"\x1aExportNoteMarkdownResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x1a\n" +
+	"\bmarkdown\x18\x02 \x01(\tR\bmarkdown\"h\n" +
+	"\x19ImportNoteMarkdownRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1a\n" +
+	"\bmarkdown\x18\x03 \x01(\tR\bmarkdownJ\x04\b\x02\x10\x03R\bowner_id\"\xa3\x01\n" +
+	"\x1aMigrateNoteTemplateRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x121\n" +
+	"\bmappings\x18\x03 \x03(\v2\x15.note.v1.FieldMappingR\bmappings\x12\x1d\n" +
+	"\aversion\x18\x04 \x01(\x05H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionJ\x04\b\x02\x10\x03R\bowner_id\"\x8d\x01\n" +
+	"\x0eAccountSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12!\n" +
+	"\tthumbnail\x18\x04 \x01(\tH\x00R\tthumbnail\x88\x01\x01B\f\n" +
+	"\n" +
+	"_thumbnail\"\xd4\x01\n" +
+	"\aSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bfield_id\x18\x02 \x01(\tR\afieldId\x12\x1f\n" +
+	"\vfield_label\x18\x03 \x01(\tR\n" +
+	"fieldLabel\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1f\n" +
+	"\vis_required\x18\x05 \x01(\bR\n" +
+	"isRequired\x12\x1d\n" +
+	"\n" +
+	"field_type\x18\x06 \x01(\tR\tfieldType\x12#\n" +
+	"\rfield_options\x18\a \x03(\tR\ffieldOptions\"\x91\x01\n" +
+	"\x0fNoteLinkSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x17\n" +
+	"\anote_id\x18\x03 \x01(\tR\x06noteId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12+\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x13.note.v1.NoteStatusR\x06status\"\xd6\x04\n" +
+	"\fNoteResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\x12#\n" +
+	"\rtemplate_name\x18\x04 \x01(\tR\ftemplateName\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\tR\aownerId\x12-\n" +
+	"\x05owner\x18\x06 \x01(\v2\x17.note.v1.AccountSummaryR\x05owner\x12+\n" +
+	"\x06status\x18\a \x01(\x0e2\x13.note.v1.NoteStatusR\x06status\x12,\n" +
+	"\bsections\x18\b \x03(\v2\x10.note.v1.SectionR\bsections\x12.\n" +
+	"\x05links\x18\t \x03(\v2\x18.note.v1.NoteLinkSummaryR\x05links\x126\n" +
+	"\tbacklinks\x18\n" +
+	" \x03(\v2\x18.note.v1.NoteLinkSummaryR\tbacklinks\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\r \x01(\x05R\aversion\x12)\n" +
+	"\x10template_version\x18\x0e \x01(\x05R\x0ftemplateVersion\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags*Y\n" +
+	"\n" +
+	"NoteStatus\x12\x1b\n" +
+	"\x17NOTE_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11NOTE_STATUS_DRAFT\x10\x01\x12\x17\n" +
+	"\x13NOTE_STATUS_PUBLISH\x10\x02*K\n" +
+	"\bTagMatch\x12\x19\n" +
+	"\x15TAG_MATCH_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rTAG_MATCH_ANY\x10\x01\x12\x11\n" +
+	"\rTAG_MATCH_ALL\x10\x02*z\n" +
+	"\fSearchTarget\x12\x1d\n" +
+	"\x19SEARCH_TARGET_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SEARCH_TARGET_TITLE\x10\x01\x12\x19\n" +
+	"\x15SEARCH_TARGET_CONTENT\x10\x02\x12\x17\n" +
+	"\x13SEARCH_TARGET_LABEL\x10\x032\xa9\x06\n" +
+	"\vNoteService\x12B\n" +
+	"\tListNotes\x12\x19.note.v1.ListNotesRequest\x1a\x1a.note.v1.ListNotesResponse\x12H\n" +
+	"\vSearchNotes\x12\x1b.note.v1.SearchNotesRequest\x1a\x1c.note.v1.SearchNotesResponse\x129\n" +
+	"\aGetNote\x12\x17.note.v1.GetNoteRequest\x1a\x15.note.v1.NoteResponse\x12?\n" +
+	"\n" +
+	"CreateNote\x12\x1a.note.v1.CreateNoteRequest\x1a\x15.note.v1.NoteResponse\x12?\n" +
+	"\n" +
+	"UpdateNote\x12\x1a.note.v1.UpdateNoteRequest\x1a\x15.note.v1.NoteResponse\x12E\n" +
+	"\n" +
+	"DeleteNote\x12\x1a.note.v1.DeleteNoteRequest\x1a\x1b.note.v1.DeleteNoteResponse\x12F\n" +
+	"\vPublishNote\x12 .note.v1.ChangeNoteStatusRequest\x1a\x15.note.v1.NoteResponse\x12H\n" +
+	"\rUnpublishNote\x12 .note.v1.ChangeNoteStatusRequest\x1a\x15.note.v1.NoteResponse\x12Q\n" +
+	"\x13MigrateNoteTemplate\x12#.note.v1.MigrateNoteTemplateRequest\x1a\x15.note.v1.NoteResponse\x12R\n" +
+	"\x12ExportNoteMarkdown\x12\x17.note.v1.GetNoteRequest\x1a#.note.v1.ExportNoteMarkdownResponse\x12O\n" +
+	"\x12ImportNoteMarkdown\x12\".note.v1.ImportNoteMarkdownRequest\x1a\x15.note.v1.NoteResponseBLZJimmortal-architecture-clean/backend/internal/adapter/grpc/generated/notepbb\x06proto3"
+
+var (
+	file_proto_note_proto_rawDescOnce sync.Once
+	file_proto_note_proto_rawDescData []byte
+)
+
+func file_proto_note_proto_rawDescGZIP() []byte {
+	file_proto_note_proto_rawDescOnce.Do(func() {
+		file_proto_note_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_note_proto_rawDesc), len(file_proto_note_proto_rawDesc)))
+	})
+	return file_proto_note_proto_rawDescData
+}
+
+var file_proto_note_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_note_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_proto_note_proto_goTypes = []any{
+	(NoteStatus)(0),                    // 0: note.v1.NoteStatus
+	(TagMatch)(0),                      // 1: note.v1.TagMatch
+	(SearchTarget)(0),                  // 2: note.v1.SearchTarget
+	(*ListNotesRequest)(nil),           // 3: note.v1.ListNotesRequest
+	(*ListNotesResponse)(nil),          // 4: note.v1.ListNotesResponse
+	(*SearchNotesRequest)(nil),         // 5: note.v1.SearchNotesRequest
+	(*SearchNotesResponse)(nil),        // 6: note.v1.SearchNotesResponse
+	(*NoteSearchResult)(nil),           // 7: note.v1.NoteSearchResult
+	(*SearchHighlight)(nil),            // 8: note.v1.SearchHighlight
+	(*GetNoteRequest)(nil),             // 9: note.v1.GetNoteRequest
+	(*CreateNoteRequest)(nil),          // 10: note.v1.CreateNoteRequest
+	(*CreateSectionInput)(nil),         // 11: note.v1.CreateSectionInput
+	(*UpdateNoteRequest)(nil),          // 12: note.v1.UpdateNoteRequest
+	(*UpdateSectionInput)(nil),         // 13: note.v1.UpdateSectionInput
+	(*DeleteNoteRequest)(nil),          // 14: note.v1.DeleteNoteRequest
+	(*DeleteNoteResponse)(nil),         // 15: note.v1.DeleteNoteResponse
+	(*ChangeNoteStatusRequest)(nil),    // 16: note.v1.ChangeNoteStatusRequest
+	(*FieldMapping)(nil),               // 17: note.v1.FieldMapping
+	(*ExportNoteMarkdownResponse)(nil), // 18: note.v1.ExportNoteMarkdownResponse
+	(*ImportNoteMarkdownRequest)(nil),  // 19: note.v1.ImportNoteMarkdownRequest
+	(*MigrateNoteTemplateRequest)(nil), // 20: note.v1.MigrateNoteTemplateRequest
+	(*AccountSummary)(nil),             // 21: note.v1.AccountSummary
+	(*Section)(nil),                    // 22: note.v1.Section
+	(*NoteLinkSummary)(nil),            // 23: note.v1.NoteLinkSummary
+	(*NoteResponse)(nil),               // 24: note.v1.NoteResponse
+	(*timestamppb.Timestamp)(nil),      // 25: google.protobuf.Timestamp
+}
+var file_proto_note_proto_depIdxs = []int32{
+	0,  // 0: note.v1.ListNotesRequest.status:type_name -> note.v1.NoteStatus
+	1,  // 1: note.v1.ListNotesRequest.tag_match:type_name -> note.v1.TagMatch
+	24, // 2: note.v1.ListNotesResponse.items:type_name -> note.v1.NoteResponse
+	2,  // 3: note.v1.SearchNotesRequest.in:type_name -> note.v1.SearchTarget
+	0,  // 4: note.v1.SearchNotesRequest.status:type_name -> note.v1.NoteStatus
+	1,  // 5: note.v1.SearchNotesRequest.tag_match:type_name -> note.v1.TagMatch
+	7,  // 6: note.v1.SearchNotesResponse.results:type_name -> note.v1.NoteSearchResult
+	24, // 7: note.v1.NoteSearchResult.note:type_name -> note.v1.NoteResponse
+	8,  // 8: note.v1.NoteSearchResult.highlights:type_name -> note.v1.SearchHighlight
+	11, // 9: note.v1.CreateNoteRequest.sections:type_name -> note.v1.CreateSectionInput
+	13, // 10: note.v1.UpdateNoteRequest.sections:type_name -> note.v1.UpdateSectionInput
+	17, // 11: note.v1.MigrateNoteTemplateRequest.mappings:type_name -> note.v1.FieldMapping
+	0,  // 12: note.v1.NoteLinkSummary.status:type_name -> note.v1.NoteStatus
+	21, // 13: note.v1.NoteResponse.owner:type_name -> note.v1.AccountSummary
+	0,  // 14: note.v1.NoteResponse.status:type_name -> note.v1.NoteStatus
+	22, // 15: note.v1.NoteResponse.sections:type_name -> note.v1.Section
+	23, // 16: note.v1.NoteResponse.links:type_name -> note.v1.NoteLinkSummary
+	23, // 17: note.v1.NoteResponse.backlinks:type_name -> note.v1.NoteLinkSummary
+	25, // 18: note.v1.NoteResponse.created_at:type_name -> google.protobuf.Timestamp
+	25, // 19: note.v1.NoteResponse.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 20: note.v1.NoteService.ListNotes:input_type -> note.v1.ListNotesRequest
+	5,  // 21: note.v1.NoteService.SearchNotes:input_type -> note.v1.SearchNotesRequest
+	9,  // 22: note.v1.NoteService.GetNote:input_type -> note.v1.GetNoteRequest
+	10, // 23: note.v1.NoteService.CreateNote:input_type -> note.v1.CreateNoteRequest
+	12, // 24: note.v1.NoteService.UpdateNote:input_type -> note.v1.UpdateNoteRequest
+	14, // 25: note.v1.NoteService.DeleteNote:input_type -> note.v1.DeleteNoteRequest
+	16, // 26: note.v1.NoteService.PublishNote:input_type -> note.v1.ChangeNoteStatusRequest
+	16, // 27: note.v1.NoteService.UnpublishNote:input_type -> note.v1.ChangeNoteStatusRequest
+	20, // 28: note.v1.NoteService.MigrateNoteTemplate:input_type -> note.v1.MigrateNoteTemplateRequest
+	9,  // 29: note.v1.NoteService.ExportNoteMarkdown:input_type -> note.v1.GetNoteRequest
+	19, // 30: note.v1.NoteService.ImportNoteMarkdown:input_type -> note.v1.ImportNoteMarkdownRequest
+	4,  // 31: note.v1.NoteService.ListNotes:output_type -> note.v1.ListNotesResponse
+	6,  // 32: note.v1.NoteService.SearchNotes:output_type -> note.v1.SearchNotesResponse
+	24, // 33: note.v1.NoteService.GetNote:output_type -> note.v1.NoteResponse
+	24, // 34: note.v1.NoteService.CreateNote:output_type -> note.v1.NoteResponse
+	24, // 35: note.v1.NoteService.UpdateNote:output_type -> note.v1.NoteResponse
+	15, // 36: note.v1.NoteService.DeleteNote:output_type -> note.v1.DeleteNoteResponse
+	24, // 37: note.v1.NoteService.PublishNote:output_type -> note.v1.NoteResponse
+	24, // 38: note.v1.NoteService.UnpublishNote:output_type -> note.v1.NoteResponse
+	24, // 39: note.v1.NoteService.MigrateNoteTemplate:output_type -> note.v1.NoteResponse
+	18, // 40: note.v1.NoteService.ExportNoteMarkdown:output_type -> note.v1.ExportNoteMarkdownResponse
+	24, // 41: note.v1.NoteService.ImportNoteMarkdown:output_type -> note.v1.NoteResponse
+	31, // [31:42] is the sub-list for method output_type
+	20, // [20:31] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_proto_note_proto_init() }
+func file_proto_note_proto_init() {
+	if File_proto_note_proto != nil {
+		return
+	}
+	file_proto_note_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[17].OneofWrappers = []any{}
+	file_proto_note_proto_msgTypes[18].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_note_proto_rawDesc), len(file_proto_note_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_note_proto_goTypes,
+		DependencyIndexes: file_proto_note_proto_depIdxs,
+		EnumInfos:         file_proto_note_proto_enumTypes,
+		MessageInfos:      file_proto_note_proto_msgTypes,
+	}.Build()
+	File_proto_note_proto = out.File
+	file_proto_note_proto_goTypes = nil
+	file_proto_note_proto_depIdxs = nil
+}
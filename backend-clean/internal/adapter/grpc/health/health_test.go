@@ -0,0 +1,60 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type checkerStub struct {
+	err error
+}
+
+func (s checkerStub) Ready(context.Context) error {
+	return s.err
+}
+
+func TestServer_Check(t *testing.T) {
+	tests := []struct {
+		name         string
+		service      string
+		checkErr     error
+		shuttingDown bool
+		want         healthpb.HealthCheckResponse_ServingStatus
+		wantCode     codes.Code
+	}{
+		{name: "[Success] whole server serving", want: healthpb.HealthCheckResponse_SERVING},
+		{name: "[Success] registered service serving", service: "note.v1.NoteService", want: healthpb.HealthCheckResponse_SERVING},
+		{name: "[Fail] dependency not ready", checkErr: errors.New("connection refused"), want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "[Fail] shutting down", shuttingDown: true, want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "[Fail] unknown service", service: "billing.v1.BillingService", wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shutdown := make(chan struct{})
+			if tt.shuttingDown {
+				close(shutdown)
+			}
+			s := NewServer(checkerStub{err: tt.checkErr}, shutdown, "note.v1.NoteService")
+
+			res, err := s.Check(context.Background(), &healthpb.HealthCheckRequest{Service: tt.service})
+			if tt.wantCode != codes.OK {
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("code = %v, want %v", status.Code(err), tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.GetStatus() != tt.want {
+				t.Errorf("status = %v, want %v", res.GetStatus(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// authorizationKey is the metadata key carrying the bearer access token, the gRPC counterpart of Authorization.
+const authorizationKey = "authorization"
+
+type accountIDKey struct{}
+
+// Authenticate verifies the bearer token in the authorization metadata of each call and
+// stores the authenticated account ID in the context. Calls without a valid token fail
+// with Unauthenticated. Calls for which skipper returns true on the full method name are
+// passed through unauthenticated.
+func Authenticate(verifier port.TokenVerifier, skipper func(fullMethod string) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if skipper != nil && skipper(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		token, ok := bearerToken(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+		accountID, err := verifier.Verify(ctx, token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid access token")
+		}
+		return handler(WithAccountID(ctx, accountID), req)
+	}
+}
+
+// WithAccountID returns a copy of ctx carrying the authenticated account ID.
+func WithAccountID(ctx context.Context, accountID string) context.Context {
+	return context.WithValue(ctx, accountIDKey{}, accountID)
+}
+
+// AccountIDFromContext returns the authenticated account ID, if any.
+func AccountIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(accountIDKey{}).(string)
+	if !ok || id == "" {
+		return "", false
+	}
+	return id, true
+}
+
+// bearerToken returns the token of the "Bearer <token>" authorization metadata.
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(authorizationKey)
+	if len(values) == 0 {
+		return "", false
+	}
+	scheme, token, found := strings.Cut(strings.TrimSpace(values[0]), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,77 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+type verifierStub struct {
+	accountID string
+	err       error
+	gotToken  string
+}
+
+func (v *verifierStub) Verify(_ context.Context, token string) (string, error) {
+	v.gotToken = token
+	return v.accountID, v.err
+}
+
+func TestAuthenticate(t *testing.T) {
+	const signIn = "/account.v1.AccountService/CreateOrGetAccount"
+
+	tests := []struct {
+		name          string
+		header        string
+		method        string
+		verifyErr     error
+		wantCode      codes.Code
+		wantAccountID string
+		wantToken     string
+	}{
+		{name: "[Success] valid bearer token", header: "Bearer tok", method: "/note.v1.NoteService/ListNotes", wantCode: codes.OK, wantAccountID: "acc-1", wantToken: "tok"},
+		{name: "[Success] scheme is case-insensitive", header: "bearer tok", method: "/note.v1.NoteService/ListNotes", wantCode: codes.OK, wantAccountID: "acc-1", wantToken: "tok"},
+		{name: "[Success] skipped method", header: "", method: signIn, wantCode: codes.OK},
+		{name: "[Fail] missing metadata", header: "", method: "/note.v1.NoteService/ListNotes", wantCode: codes.Unauthenticated},
+		{name: "[Fail] non-bearer scheme", header: "Basic abc", method: "/note.v1.NoteService/ListNotes", wantCode: codes.Unauthenticated},
+		{name: "[Fail] empty token", header: "Bearer ", method: "/note.v1.NoteService/ListNotes", wantCode: codes.Unauthenticated},
+		{name: "[Fail] verification error", header: "Bearer tok", method: "/note.v1.NoteService/ListNotes", verifyErr: domainerr.ErrUnauthenticated, wantCode: codes.Unauthenticated, wantToken: "tok"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := &verifierStub{accountID: "acc-1", err: tt.verifyErr}
+			ic := Authenticate(verifier, func(fullMethod string) bool { return fullMethod == signIn })
+
+			ctx := context.Background()
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationKey, tt.header))
+			}
+			var gotAccountID string
+			called := false
+			_, err := ic(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, _ any) (any, error) {
+				called = true
+				gotAccountID, _ = AccountIDFromContext(ctx)
+				return nil, nil
+			})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("code = %v, want %v", got, tt.wantCode)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Fatalf("handler called = %v", called)
+			}
+			if gotAccountID != tt.wantAccountID {
+				t.Fatalf("account ID = %q, want %q", gotAccountID, tt.wantAccountID)
+			}
+			if verifier.gotToken != tt.wantToken {
+				t.Fatalf("verified token = %q, want %q", verifier.gotToken, tt.wantToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics counts calls by method and status code and observes their latency.
+func Metrics(reg prometheus.Registerer) grpc.UnaryServerInterceptor {
+	handled := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "gRPC calls by service, method and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "gRPC call latency by service and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method"})
+	reg.MustRegister(handled, duration)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		service, method, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+		started := time.Now()
+		res, err := handler(ctx, req)
+		handled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		duration.WithLabelValues(service, method).Observe(time.Since(started).Seconds())
+		return res, err
+	}
+}
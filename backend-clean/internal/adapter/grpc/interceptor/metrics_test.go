@@ -0,0 +1,48 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetrics(t *testing.T) {
+	tests := []struct {
+		name       string
+		handlerErr error
+		wantCode   string
+	}{
+		{name: "[Success] counted as OK", wantCode: "OK"},
+		{name: "[Fail] counted with the status code", handlerErr: status.Error(codes.NotFound, "not found"), wantCode: "NotFound"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := prometheus.NewRegistry()
+			_, err := Metrics(reg)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/note.v1.NoteService/UpdateNote"}, func(context.Context, any) (any, error) {
+				return nil, tt.handlerErr
+			})
+			if err != tt.handlerErr {
+				t.Fatalf("err = %v, want %v", err, tt.handlerErr)
+			}
+
+			want := `
+# HELP grpc_server_handled_total gRPC calls by service, method and status code.
+# TYPE grpc_server_handled_total counter
+grpc_server_handled_total{grpc_code="` + tt.wantCode + `",grpc_method="UpdateNote",grpc_service="note.v1.NoteService"} 1
+`
+			if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "grpc_server_handled_total"); err != nil {
+				t.Error(err)
+			}
+			if n, err := testutil.GatherAndCount(reg, "grpc_server_handling_seconds"); err != nil || n != 1 {
+				t.Errorf("latency series = %d (%v), want 1", n, err)
+			}
+		})
+	}
+}
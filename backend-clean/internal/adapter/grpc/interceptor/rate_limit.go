@@ -0,0 +1,157 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Rate limit metadata keys, the gRPC counterparts of the HTTP RateLimit-* and Retry-After headers.
+const (
+	rateLimitLimitKey     = "ratelimit-limit"
+	rateLimitRemainingKey = "ratelimit-remaining"
+	rateLimitResetKey     = "ratelimit-reset"
+	retryAfterKey         = "retry-after"
+)
+
+// readPrefixes name the RPCs that only read; every other RPC spends the write budget.
+var readPrefixes = []string{"Get", "List", "Search", "Export"}
+
+// rateLimitHeaderKey carries the *metadata.MD that RateLimitByIP sends once the call ends.
+type rateLimitHeaderKey struct{}
+
+// RateLimitByIP takes a token from the peer IP's pre-authentication bucket on each call.
+// It runs before Authenticate, so calls refused for a missing or bad token are limited
+// too; the budget is loose enough for many accounts behind one proxy. Refused calls fail
+// with ResourceExhausted and a retry-after header; every limited call carries the
+// ratelimit-* headers, those of RateLimit's bucket when the call gets that far.
+// Health and reflection calls are not limited, so probes never starve callers.
+func RateLimitByIP(limiter port.RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if operational(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		header, allowed, err := limit(ctx, limiter, ratelimit.Subject{IP: peerIP(ctx)}, ratelimit.ClassPreAuth)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			if err := setHeader(ctx, header); err != nil {
+				return nil, err
+			}
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		// Headers go out with the response, so they can still be set after the handler returns.
+		resp, err := handler(context.WithValue(ctx, rateLimitHeaderKey{}, &header), req)
+		if err := setHeader(ctx, header); err != nil {
+			return nil, err
+		}
+		return resp, err
+	}
+}
+
+// RateLimit takes a token from the caller's bucket on each call: the authenticated
+// account's when there is one, the peer IP's otherwise. It must run after Authenticate.
+// Health and reflection calls are not limited.
+func RateLimit(limiter port.RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if operational(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		subject := ratelimit.Subject{IP: peerIP(ctx)}
+		subject.AccountID, _ = AccountIDFromContext(ctx)
+		header, allowed, err := limit(ctx, limiter, subject, methodClass(info.FullMethod))
+		if err != nil {
+			return nil, err
+		}
+		// This budget replaces the pre-authentication one in the headers RateLimitByIP sends.
+		if pending, ok := ctx.Value(rateLimitHeaderKey{}).(*metadata.MD); ok {
+			if header != nil {
+				*pending = header
+			}
+		} else if err := setHeader(ctx, header); err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// limit spends subject's budget of the class and returns the ratelimit-* headers to
+// send, or nil when the class is not limited.
+func limit(ctx context.Context, limiter port.RateLimiter, subject ratelimit.Subject, class ratelimit.Class) (metadata.MD, bool, error) {
+	decision, err := limiter.Allow(ctx, subject, class)
+	if err != nil {
+		return nil, false, err
+	}
+	if decision.Limit == 0 {
+		return nil, decision.Allowed, nil
+	}
+	md := metadata.Pairs(
+		rateLimitLimitKey, strconv.Itoa(decision.Limit),
+		rateLimitRemainingKey, strconv.Itoa(decision.Remaining),
+		rateLimitResetKey, seconds(decision.Reset),
+	)
+	if !decision.Allowed {
+		md.Set(retryAfterKey, seconds(decision.RetryAfter))
+	}
+	return md, decision.Allowed, nil
+}
+
+// setHeader sends md as header metadata, if there is any.
+func setHeader(ctx context.Context, md metadata.MD) error {
+	// There is no stream to set headers on when the handler is called directly.
+	if md == nil || grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return nil
+	}
+	return grpc.SetHeader(ctx, md)
+}
+
+// operational reports whether fullMethod belongs to the standard health or reflection services.
+func operational(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") ||
+		strings.HasPrefix(fullMethod, "/grpc.reflection.")
+}
+
+// methodClass classifies a full method name such as "/note.v1.NoteService/ListNotes".
+func methodClass(fullMethod string) ratelimit.Class {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return ratelimit.ClassRead
+		}
+	}
+	return ratelimit.ClassWrite
+}
+
+// peerIP returns the IP of the calling peer, or empty when it is unknown.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// seconds formats d as whole seconds, rounding up.
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64((d+time.Second-1)/time.Second), 10)
+}
@@ -0,0 +1,172 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+type limiterStub struct {
+	decision   ratelimit.Decision
+	err        error
+	gotSubject ratelimit.Subject
+	gotClass   ratelimit.Class
+}
+
+func (l *limiterStub) Allow(_ context.Context, subject ratelimit.Subject, class ratelimit.Class) (ratelimit.Decision, error) {
+	l.gotSubject = subject
+	l.gotClass = class
+	return l.decision, l.err
+}
+
+func TestRateLimit(t *testing.T) {
+	limiterErr := errors.New("store down")
+	byIP := ratelimit.Subject{IP: "192.0.2.1"}
+	byAccount := ratelimit.Subject{AccountID: "acc-1", IP: "192.0.2.1"}
+
+	tests := []struct {
+		name        string
+		preAuth     bool
+		method      string
+		accountID   string
+		decision    ratelimit.Decision
+		allowErr    error
+		wantClass   ratelimit.Class
+		wantSubject ratelimit.Subject
+		wantCalled  bool
+		wantCode    codes.Code
+		wantErr     error
+	}{
+		{name: "[Success] read call by IP", method: "/note.v1.NoteService/ListNotes", decision: ratelimit.Decision{Allowed: true, Limit: 60, Remaining: 59}, wantClass: ratelimit.ClassRead, wantSubject: byIP, wantCalled: true},
+		{name: "[Success] write call by IP", method: "/note.v1.NoteService/CreateNote", decision: ratelimit.Decision{Allowed: true, Limit: 10, Remaining: 9}, wantClass: ratelimit.ClassWrite, wantSubject: byIP, wantCalled: true},
+		{name: "[Success] account lookup reads", method: "/account.v1.AccountService/GetAccountByEmail", decision: ratelimit.Decision{Allowed: true}, wantClass: ratelimit.ClassRead, wantSubject: byIP, wantCalled: true},
+		{name: "[Success] read call by account", method: "/note.v1.NoteService/GetNote", accountID: "acc-1", decision: ratelimit.Decision{Allowed: true, Limit: 60, Remaining: 59}, wantClass: ratelimit.ClassRead, wantSubject: byAccount, wantCalled: true},
+		{name: "[Success] peer IP before authentication spends its own budget", preAuth: true, method: "/note.v1.NoteService/CreateNote", decision: ratelimit.Decision{Allowed: true, Limit: 3000, Remaining: 2999}, wantClass: ratelimit.ClassPreAuth, wantSubject: byIP, wantCalled: true},
+		{name: "[Fail] bucket of the IP is empty", method: "/template.v1.TemplateService/UpdateTemplate", decision: ratelimit.Decision{Limit: 10, RetryAfter: time.Second}, wantClass: ratelimit.ClassWrite, wantSubject: byIP, wantCode: codes.ResourceExhausted},
+		{name: "[Fail] bucket of the IP is empty before authentication", preAuth: true, method: "/note.v1.NoteService/GetNote", decision: ratelimit.Decision{Limit: 3000, RetryAfter: time.Second}, wantClass: ratelimit.ClassPreAuth, wantSubject: byIP, wantCode: codes.ResourceExhausted},
+		{name: "[Fail] bucket of the account is empty", method: "/template.v1.TemplateService/UpdateTemplate", accountID: "acc-1", decision: ratelimit.Decision{Limit: 10, RetryAfter: time.Second}, wantClass: ratelimit.ClassWrite, wantSubject: byAccount, wantCode: codes.ResourceExhausted},
+		{name: "[Fail] limiter error", method: "/note.v1.NoteService/GetNote", allowErr: limiterErr, wantClass: ratelimit.ClassRead, wantSubject: byIP, wantErr: limiterErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := &limiterStub{decision: tt.decision, err: tt.allowErr}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}})
+			if tt.accountID != "" {
+				ctx = WithAccountID(ctx, tt.accountID)
+			}
+			limit := RateLimit(limiter)
+			if tt.preAuth {
+				limit = RateLimitByIP(limiter)
+			}
+			called := false
+			_, err := limit(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(context.Context, any) (any, error) {
+				called = true
+				return nil, nil
+			})
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+			case tt.wantCode != codes.OK:
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("code = %s, want %s", status.Code(err), tt.wantCode)
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+			if limiter.gotClass != tt.wantClass {
+				t.Errorf("class = %s, want %s", limiter.gotClass, tt.wantClass)
+			}
+			if limiter.gotSubject != tt.wantSubject {
+				t.Errorf("subject = %+v, want %+v", limiter.gotSubject, tt.wantSubject)
+			}
+		})
+	}
+}
+
+// headerStream records the header metadata set on a call.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header []metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = append(s.header, md)
+	return nil
+}
+
+// accountLimiter refuses the account's bucket and allows the pre-authentication one.
+type accountLimiter struct{}
+
+func (accountLimiter) Allow(_ context.Context, subject ratelimit.Subject, _ ratelimit.Class) (ratelimit.Decision, error) {
+	if subject.AccountID != "" {
+		return ratelimit.Decision{Limit: 60, Reset: time.Minute, RetryAfter: time.Second}, nil
+	}
+	return ratelimit.Decision{Allowed: true, Limit: 60, Remaining: 30, Reset: time.Second}, nil
+}
+
+func TestRateLimit_SendsAccountHeaders(t *testing.T) {
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	info := &grpc.UnaryServerInfo{FullMethod: "/note.v1.NoteService/ListNotes"}
+	_, err := RateLimitByIP(accountLimiter{})(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		return RateLimit(accountLimiter{})(WithAccountID(ctx, "acc-1"), req, info, func(context.Context, any) (any, error) {
+			t.Error("handler was called")
+			return nil, nil
+		})
+	})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("code = %s, want %s", status.Code(err), codes.ResourceExhausted)
+	}
+	if len(stream.header) != 1 {
+		t.Fatalf("headers set %d times, want 1", len(stream.header))
+	}
+	want := metadata.Pairs(rateLimitLimitKey, "60", rateLimitRemainingKey, "0", rateLimitResetKey, "60", retryAfterKey, "1")
+	if !reflect.DeepEqual(stream.header[0], want) {
+		t.Errorf("header = %v, want %v", stream.header[0], want)
+	}
+}
+
+func TestRateLimit_SkipsOperationalCalls(t *testing.T) {
+	methods := []string{"/grpc.health.v1.Health/Check", "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"}
+	interceptors := map[string]func(port.RateLimiter) grpc.UnaryServerInterceptor{"RateLimitByIP": RateLimitByIP, "RateLimit": RateLimit}
+	for name, newInterceptor := range interceptors {
+		for _, method := range methods {
+			t.Run(name+" "+method, func(t *testing.T) {
+				limiter := &limiterStub{decision: ratelimit.Decision{Limit: 10, RetryAfter: time.Second}}
+				called := false
+				_, err := newInterceptor(limiter)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+					called = true
+					return nil, nil
+				})
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !called {
+					t.Error("handler was not called")
+				}
+				if limiter.gotClass != "" {
+					t.Errorf("limiter consulted with class %s", limiter.gotClass)
+				}
+			})
+		}
+	}
+}
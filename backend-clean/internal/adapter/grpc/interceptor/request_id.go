@@ -0,0 +1,55 @@
+// Package interceptor contains gRPC server interceptors for the gRPC adapter.
+package interceptor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// requestIDKey is the metadata key carrying the request ID, the gRPC counterpart of X-Request-ID.
+const requestIDKey = "x-request-id"
+
+// maxRequestIDLength bounds a client-supplied request ID.
+const maxRequestIDLength = 128
+
+// RequestID tags each call with an ID, taken from the x-request-id metadata when the
+// client sent a usable one and generated otherwise. The ID is returned in the response
+// header and stored in the context for the audit log.
+func RequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(requestIDKey); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+		// There is no stream to set headers on when the handler is called directly.
+		if grpc.ServerTransportStreamFromContext(ctx) != nil {
+			if err := grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id)); err != nil {
+				return nil, err
+			}
+		}
+		return handler(port.WithRequestID(ctx, id), req)
+	}
+}
+
+// validRequestID accepts up to maxRequestIDLength printable ASCII characters.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
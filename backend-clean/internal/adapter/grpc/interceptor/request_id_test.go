@@ -0,0 +1,48 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming string
+		wantKeep bool
+	}{
+		{name: "[Success] keep client ID", incoming: "req-123", wantKeep: true},
+		{name: "[Success] generate when missing", incoming: ""},
+		{name: "[Success] replace ID with spaces", incoming: "bad id"},
+		{name: "[Success] replace too long ID", incoming: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.incoming != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(requestIDKey, tt.incoming))
+			}
+			var gotID string
+			_, err := RequestID()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				gotID = port.RequestIDFromContext(ctx)
+				return nil, nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotID == "" {
+				t.Fatal("request ID not set")
+			}
+			if (gotID == tt.incoming) != tt.wantKeep {
+				t.Fatalf("keep client ID = %v, want %v (got %q)", gotID == tt.incoming, tt.wantKeep, gotID)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTracing(t *testing.T) {
+	const traceparent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+	tests := []struct {
+		name        string
+		traceparent string
+		handlerErr  error
+		wantCode    codes.Code
+		wantFailed  bool
+	}{
+		{name: "[Success] new trace", wantCode: codes.OK},
+		{name: "[Success] continues the client's trace", traceparent: traceparent, wantCode: codes.OK},
+		{name: "[Fail] refused request is not a server fault", handlerErr: status.Error(codes.NotFound, "not found"), wantCode: codes.NotFound},
+		{name: "[Fail] server error", handlerErr: status.Error(codes.Internal, "internal server error"), wantCode: codes.Internal, wantFailed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+			ctx := context.Background()
+			if tt.traceparent != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("traceparent", tt.traceparent))
+			}
+			var handlerSpan trace.SpanContext
+			_, err := Tracing(tracer, propagation.TraceContext{})(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/note.v1.NoteService/UpdateNote"}, func(ctx context.Context, _ any) (any, error) {
+				handlerSpan = trace.SpanContextFromContext(ctx)
+				return nil, tt.handlerErr
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("spans = %d, want 1", len(spans))
+			}
+			span := spans[0]
+			if span.Name() != "note.v1.NoteService/UpdateNote" {
+				t.Errorf("name = %q", span.Name())
+			}
+			if handlerSpan.SpanID() != span.SpanContext().SpanID() {
+				t.Errorf("handler does not run inside the span")
+			}
+			if tt.traceparent != "" && span.SpanContext().TraceID().String() != "0af7651916cd43dd8448eb211c80319c" {
+				t.Errorf("trace ID = %s, want the client's", span.SpanContext().TraceID())
+			}
+			attrs := attribute.NewSet(span.Attributes()...)
+			if v, _ := attrs.Value("rpc.method"); v.AsString() != "UpdateNote" {
+				t.Errorf("rpc.method = %q, want UpdateNote", v.AsString())
+			}
+			if v, _ := attrs.Value("rpc.grpc.status_code"); v.AsInt64() != int64(tt.wantCode) {
+				t.Errorf("rpc.grpc.status_code = %d, want %d", v.AsInt64(), tt.wantCode)
+			}
+			if (span.Status().Code == otelcodes.Error) != tt.wantFailed {
+				t.Errorf("status = %v, want failed %v", span.Status().Code, tt.wantFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// workspaceIDKey is the metadata key selecting the workspace, the gRPC counterpart of X-Workspace-ID.
+const workspaceIDKey = "x-workspace-id"
+
+// Workspace resolves the workspace of each authenticated call from the x-workspace-id
+// metadata, falling back to the account's first workspace, and stores it in the context
+// so that repositories only see its templates and notes. The resolved ID is sent back in
+// the x-workspace-id header. It must run after Authenticate. Calls for which skipper
+// returns true on the full method name are passed through without a workspace.
+func Workspace(resolver port.WorkspaceResolver, skipper func(fullMethod string) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if skipper != nil && skipper(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		requested := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(workspaceIDKey); len(values) > 0 {
+				requested = strings.TrimSpace(values[0])
+			}
+		}
+		accountID, _ := AccountIDFromContext(ctx)
+		workspaceID, err := resolver.Resolve(ctx, accountID, requested)
+		switch {
+		case err == nil:
+		case errors.Is(err, domainerr.ErrUnauthenticated):
+			return nil, status.Error(codes.Unauthenticated, "missing account")
+		case errors.Is(err, domainerr.ErrUnauthorized):
+			return nil, status.Error(codes.PermissionDenied, "not a member of the workspace")
+		case errors.Is(err, domainerr.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "workspace not found")
+		default:
+			return nil, err
+		}
+		// There is no stream to set headers on when the handler is called directly.
+		if grpc.ServerTransportStreamFromContext(ctx) != nil {
+			if err := grpc.SetHeader(ctx, metadata.Pairs(workspaceIDKey, workspaceID)); err != nil {
+				return nil, err
+			}
+		}
+		return handler(port.WithWorkspaceID(ctx, workspaceID), req)
+	}
+}
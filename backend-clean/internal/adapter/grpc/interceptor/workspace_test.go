@@ -0,0 +1,75 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+type resolverStub struct {
+	workspaceID  string
+	err          error
+	gotAccountID string
+	gotRequested string
+}
+
+func (r *resolverStub) Resolve(_ context.Context, accountID, requestedID string) (string, error) {
+	r.gotAccountID = accountID
+	r.gotRequested = requestedID
+	return r.workspaceID, r.err
+}
+
+func TestWorkspace(t *testing.T) {
+	const accountMethod = "/account.v1.AccountService/GetAccountById"
+
+	tests := []struct {
+		name            string
+		incoming        string
+		method          string
+		resolveErr      error
+		wantCode        codes.Code
+		wantRequested   string
+		wantWorkspaceID string
+	}{
+		{name: "[Success] requested workspace", incoming: "ws-2", method: "/note.v1.NoteService/ListNotes", wantRequested: "ws-2", wantWorkspaceID: "ws-1"},
+		{name: "[Success] trim spaces", incoming: " ws-2 ", method: "/note.v1.NoteService/ListNotes", wantRequested: "ws-2", wantWorkspaceID: "ws-1"},
+		{name: "[Success] default workspace without metadata", method: "/note.v1.NoteService/ListNotes", wantWorkspaceID: "ws-1"},
+		{name: "[Success] skipped method", method: accountMethod},
+		{name: "[Fail] not a member", incoming: "ws-2", method: "/note.v1.NoteService/ListNotes", resolveErr: domainerr.ErrUnauthorized, wantCode: codes.PermissionDenied, wantRequested: "ws-2"},
+		{name: "[Fail] no workspace", method: "/note.v1.NoteService/ListNotes", resolveErr: domainerr.ErrNotFound, wantCode: codes.NotFound},
+		{name: "[Fail] missing account", method: "/note.v1.NoteService/ListNotes", resolveErr: domainerr.ErrUnauthenticated, wantCode: codes.Unauthenticated},
+		{name: "[Fail] resolver error", method: "/note.v1.NoteService/ListNotes", resolveErr: errors.New("db down"), wantCode: codes.Unknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &resolverStub{workspaceID: "ws-1", err: tt.resolveErr}
+			ctx := WithAccountID(context.Background(), "acc-1")
+			if tt.incoming != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(workspaceIDKey, tt.incoming))
+			}
+			var got string
+			_, err := Workspace(resolver, func(fullMethod string) bool { return fullMethod == accountMethod })(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, _ any) (any, error) {
+				got = port.WorkspaceIDFromContext(ctx)
+				return nil, nil
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if got != tt.wantWorkspaceID {
+				t.Fatalf("workspace ID = %q, want %q", got, tt.wantWorkspaceID)
+			}
+			if tt.method != accountMethod && (resolver.gotAccountID != "acc-1" || resolver.gotRequested != tt.wantRequested) {
+				t.Fatalf("resolved for %q in %q", resolver.gotAccountID, resolver.gotRequested)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package presenter
+
+import "immortal-architecture-clean/backend/internal/domain/page"
+
+// encodeCursor returns the opaque next_cursor token, or nil on the last page.
+func encodeCursor(c *page.Cursor) *string {
+	if c == nil {
+		return nil
+	}
+	token := c.Encode()
+	return &token
+}
@@ -0,0 +1,203 @@
+package presenter
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NotePresenter implements port.NoteOutputPort for gRPC.
+type NotePresenter struct {
+	mu       sync.RWMutex
+	note     *notepb.NoteResponse
+	list     *notepb.ListNotesResponse
+	results  *notepb.SearchNotesResponse
+	markdown *notepb.ExportNoteMarkdownResponse
+	deleted  bool
+}
+
+var _ port.NoteOutputPort = (*NotePresenter)(nil)
+
+// NewNotePresenter creates a new gRPC note presenter.
+func NewNotePresenter() *NotePresenter {
+	return &NotePresenter{}
+}
+
+// PresentNoteList converts a page of notes to gRPC response and stores it.
+func (p *NotePresenter) PresentNoteList(_ context.Context, notes page.Page[note.WithMeta]) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	items := make([]*notepb.NoteResponse, 0, len(notes.Items))
+	for _, n := range notes.Items {
+		items = append(items, toNoteResponse(n))
+	}
+	p.list = &notepb.ListNotesResponse{
+		Items:      items,
+		NextCursor: encodeCursor(notes.NextCursor),
+		HasMore:    notes.HasMore(),
+	}
+	return nil
+}
+
+// PresentSearchResults converts ranked search results to gRPC response and stores it.
+func (p *NotePresenter) PresentSearchResults(_ context.Context, results []note.SearchResult) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res := make([]*notepb.NoteSearchResult, 0, len(results))
+	for _, r := range results {
+		highlights := make([]*notepb.SearchHighlight, 0, len(r.Snippet.Highlights))
+		for _, h := range r.Snippet.Highlights {
+			highlights = append(highlights, &notepb.SearchHighlight{
+				Start: int32(h.Start), //nolint:gosec
+				End:   int32(h.End),   //nolint:gosec
+			})
+		}
+		res = append(res, &notepb.NoteSearchResult{
+			Note:       toNoteResponse(r.Note),
+			Rank:       r.Rank,
+			Snippet:    r.Snippet.Text,
+			Highlights: highlights,
+		})
+	}
+	p.results = &notepb.SearchNotesResponse{Results: res}
+	return nil
+}
+
+// PresentNote converts domain note to gRPC response and stores it.
+func (p *NotePresenter) PresentNote(_ context.Context, n *note.WithMeta) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.note = toNoteResponse(*n)
+	return nil
+}
+
+// PresentNoteMarkdown stores an exported Markdown document.
+func (p *NotePresenter) PresentNoteMarkdown(_ context.Context, n *note.WithMeta, markdown string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.markdown = &notepb.ExportNoteMarkdownResponse{Filename: n.Note.ID + ".md", Markdown: markdown}
+	return nil
+}
+
+// PresentNoteDeleted marks delete success.
+func (p *NotePresenter) PresentNoteDeleted(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.deleted = true
+	return nil
+}
+
+// Response returns the stored note response.
+func (p *NotePresenter) Response() *notepb.NoteResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.note
+}
+
+// ListResponse returns the stored note list response.
+func (p *NotePresenter) ListResponse() *notepb.ListNotesResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.list
+}
+
+// SearchResponse returns the stored search response.
+func (p *NotePresenter) SearchResponse() *notepb.SearchNotesResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.results
+}
+
+// MarkdownResponse returns the stored Markdown export response.
+func (p *NotePresenter) MarkdownResponse() *notepb.ExportNoteMarkdownResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.markdown
+}
+
+// DeleteResponse returns deletion success response.
+func (p *NotePresenter) DeleteResponse() *notepb.DeleteNoteResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return &notepb.DeleteNoteResponse{Success: p.deleted}
+}
+
+func toNoteResponse(n note.WithMeta) *notepb.NoteResponse {
+	sections := make([]*notepb.Section, 0, len(n.Sections))
+	for _, s := range n.Sections {
+		sections = append(sections, &notepb.Section{
+			Id:           s.Section.ID,
+			FieldId:      s.Section.FieldID,
+			FieldLabel:   s.FieldLabel,
+			Content:      s.Section.Content,
+			IsRequired:   s.IsRequired,
+			FieldType:    string(s.FieldType),
+			FieldOptions: s.FieldOptions,
+		})
+	}
+	tags := make([]string, 0, len(n.Tags))
+	for _, t := range n.Tags {
+		tags = append(tags, t.String())
+	}
+	return &notepb.NoteResponse{
+		Id:              n.Note.ID,
+		Title:           n.Note.Title,
+		TemplateId:      n.Note.TemplateID,
+		TemplateName:    n.TemplateName,
+		TemplateVersion: int32(n.Note.TemplateVersion), //nolint:gosec
+		OwnerId:         n.Note.OwnerID,
+		Owner: &notepb.AccountSummary{
+			Id:        n.Note.OwnerID,
+			FirstName: n.OwnerFirstName,
+			LastName:  n.OwnerLastName,
+			Thumbnail: n.OwnerThumbnail,
+		},
+		Status:    toNoteStatus(n.Note.Status),
+		Sections:  sections,
+		Links:     toNoteLinkSummaries(n.Links),
+		Backlinks: toNoteLinkSummaries(n.Backlinks),
+		CreatedAt: timestamppb.New(n.Note.CreatedAt),
+		UpdatedAt: timestamppb.New(n.Note.UpdatedAt),
+		Version:   int32(n.Note.Version), //nolint:gosec
+		Tags:      tags,
+	}
+}
+
+func toNoteLinkSummaries(links []note.LinkSummary) []*notepb.NoteLinkSummary {
+	if links == nil {
+		return nil
+	}
+	res := make([]*notepb.NoteLinkSummary, 0, len(links))
+	for _, l := range links {
+		res = append(res, &notepb.NoteLinkSummary{
+			Id:     l.LinkID,
+			Type:   string(l.Type),
+			NoteId: l.NoteID,
+			Title:  l.Title,
+			Status: toNoteStatus(l.Status),
+		})
+	}
+	return res
+}
+
+func toNoteStatus(s note.NoteStatus) notepb.NoteStatus {
+	switch s {
+	case note.StatusDraft:
+		return notepb.NoteStatus_NOTE_STATUS_DRAFT
+	case note.StatusPublish:
+		return notepb.NoteStatus_NOTE_STATUS_PUBLISH
+	default:
+		return notepb.NoteStatus_NOTE_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,114 @@
+package presenter
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplatePresenter implements port.TemplateOutputPort for gRPC.
+type TemplatePresenter struct {
+	mu       sync.RWMutex
+	template *templatepb.TemplateResponse
+	list     *templatepb.ListTemplatesResponse
+	deleted  bool
+}
+
+var _ port.TemplateOutputPort = (*TemplatePresenter)(nil)
+
+// NewTemplatePresenter creates a new gRPC template presenter.
+func NewTemplatePresenter() *TemplatePresenter {
+	return &TemplatePresenter{}
+}
+
+// PresentTemplateList converts a page of templates to gRPC response and stores it.
+func (p *TemplatePresenter) PresentTemplateList(_ context.Context, templates page.Page[template.WithUsage]) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	items := make([]*templatepb.TemplateResponse, 0, len(templates.Items))
+	for _, t := range templates.Items {
+		items = append(items, toTemplateResponse(t))
+	}
+	p.list = &templatepb.ListTemplatesResponse{
+		Items:      items,
+		NextCursor: encodeCursor(templates.NextCursor),
+		HasMore:    templates.HasMore(),
+	}
+	return nil
+}
+
+// PresentTemplate converts domain template to gRPC response and stores it.
+func (p *TemplatePresenter) PresentTemplate(_ context.Context, tpl *template.WithUsage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.template = toTemplateResponse(*tpl)
+	return nil
+}
+
+// PresentTemplateDeleted marks delete success.
+func (p *TemplatePresenter) PresentTemplateDeleted(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.deleted = true
+	return nil
+}
+
+// Response returns the stored template response.
+func (p *TemplatePresenter) Response() *templatepb.TemplateResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.template
+}
+
+// ListResponse returns the stored template list response.
+func (p *TemplatePresenter) ListResponse() *templatepb.ListTemplatesResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.list
+}
+
+// DeleteResponse returns deletion success response.
+func (p *TemplatePresenter) DeleteResponse() *templatepb.DeleteTemplateResponse {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return &templatepb.DeleteTemplateResponse{Success: p.deleted}
+}
+
+func toTemplateResponse(t template.WithUsage) *templatepb.TemplateResponse {
+	fields := make([]*templatepb.Field, 0, len(t.Template.Fields))
+	for _, f := range t.Template.Fields {
+		fields = append(fields, &templatepb.Field{
+			Id:         f.ID,
+			Label:      f.Label,
+			Order:      int32(f.Order), //nolint:gosec
+			IsRequired: f.IsRequired,
+			Type:       string(f.Type),
+			Options:    f.Options,
+		})
+	}
+	return &templatepb.TemplateResponse{
+		Id:      t.Template.ID,
+		Name:    t.Template.Name,
+		OwnerId: t.Template.OwnerID,
+		Owner: &templatepb.AccountSummary{
+			Id:        t.Owner.ID,
+			FirstName: t.Owner.FirstName,
+			LastName:  t.Owner.LastName,
+			Thumbnail: t.Owner.Thumbnail,
+		},
+		Fields:        fields,
+		IsUsed:        t.InUse(),
+		UpdatedAt:     timestamppb.New(t.Template.UpdatedAt),
+		Version:       int32(t.Template.Version),       //nolint:gosec
+		FieldsVersion: int32(t.Template.FieldsVersion), //nolint:gosec
+	}
+}
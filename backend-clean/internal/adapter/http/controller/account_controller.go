@@ -14,21 +14,33 @@ import (
 
 // AccountController handles account HTTP endpoints.
 type AccountController struct {
-	inputFactory  func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort
-	outputFactory func() *presenter.AccountPresenter
-	repoFactory   func() port.AccountRepository
+	inputFactory         func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort
+	outputFactory        func() *presenter.AccountPresenter
+	repoFactory          func() port.AccountRepository
+	workspaceRepoFactory func() port.WorkspaceRepository
+	txFactory            func() port.TxManager
+	eventsFactory        func() port.EventPublisher
+	auditFactory         func() port.AuditRecorder
 }
 
 // NewAccountController creates AccountController.
 func NewAccountController(
-	inputFactory func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort,
+	inputFactory func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort,
 	outputFactory func() *presenter.AccountPresenter,
 	repoFactory func() port.AccountRepository,
+	workspaceRepoFactory func() port.WorkspaceRepository,
+	txFactory func() port.TxManager,
+	eventsFactory func() port.EventPublisher,
+	auditFactory func() port.AuditRecorder,
 ) *AccountController {
 	return &AccountController{
-		inputFactory:  inputFactory,
-		outputFactory: outputFactory,
-		repoFactory:   repoFactory,
+		inputFactory:         inputFactory,
+		outputFactory:        outputFactory,
+		repoFactory:          repoFactory,
+		workspaceRepoFactory: workspaceRepoFactory,
+		txFactory:            txFactory,
+		eventsFactory:        eventsFactory,
+		auditFactory:         auditFactory,
 	}
 }
 
@@ -36,7 +48,7 @@ func NewAccountController(
 func (c *AccountController) CreateOrGet(ctx echo.Context) error {
 	var body openapi.ModelsCreateOrGetAccountRequest
 	if err := ctx.Bind(&body); err != nil {
-		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+		return badRequest(ctx, "invalid body")
 	}
 	input, p := c.newIO()
 	err := input.CreateOrGet(ctx.Request().Context(), account.OAuthAccountInput{
@@ -89,6 +101,6 @@ func (c *AccountController) GetAccountByEmail(ctx echo.Context, params openapi.A
 
 func (c *AccountController) newIO() (port.AccountInputPort, *presenter.AccountPresenter) {
 	output := c.outputFactory()
-	input := c.inputFactory(c.repoFactory(), output)
+	input := c.inputFactory(c.repoFactory(), c.workspaceRepoFactory(), c.txFactory(), c.eventsFactory(), c.auditFactory(), output)
 	return input, output
 }
@@ -48,12 +48,16 @@ func TestAccountController_CreateOrGet(t *testing.T) {
 			p := presenter.NewAccountPresenter()
 			input := &ctrlmock.AccountInputStub{CreateErr: tt.createErr}
 			ctrl := NewAccountController(
-				func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
+				func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.AccountPresenter { return p },
 				func() port.AccountRepository { return nil },
+				func() port.WorkspaceRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
 
 			e := echo.New()
@@ -74,14 +78,14 @@ func TestAccountController_CreateOrGet(t *testing.T) {
 func TestAccountController_GetCurrent(t *testing.T) {
 	tests := []struct {
 		name       string
-		headerID   string
+		accountID   string
 		getErr     error
 		wantStatus int
 		wantBody   string
 	}{
-		{name: "[Success] with header", headerID: "acc-1", wantStatus: http.StatusOK},
-		{name: "[Fail] missing header", headerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
-		{name: "[Fail] usecase not found", headerID: "acc-1", getErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+		{name: "[Success] authenticated", accountID: "acc-1", wantStatus: http.StatusOK},
+		{name: "[Fail] unauthenticated", accountID: "", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] usecase not found", accountID: "acc-1", getErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
 	}
 
 	for _, tt := range tests {
@@ -89,18 +93,19 @@ func TestAccountController_GetCurrent(t *testing.T) {
 			p := presenter.NewAccountPresenter()
 			input := &ctrlmock.AccountInputStub{GetErr: tt.getErr}
 			ctrl := NewAccountController(
-				func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
+				func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.AccountPresenter { return p },
 				func() port.AccountRepository { return nil },
+				func() port.WorkspaceRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodGet, "/api/accounts/me", nil)
-			if tt.headerID != "" {
-				req.Header.Set("X-Account-ID", tt.headerID)
-			}
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/accounts/me", nil), tt.accountID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
@@ -126,12 +131,16 @@ func TestAccountController_GetByID(t *testing.T) {
 			p := presenter.NewAccountPresenter()
 			input := &ctrlmock.AccountInputStub{GetErr: tt.getErr}
 			ctrl := NewAccountController(
-				func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
+				func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.AccountPresenter { return p },
 				func() port.AccountRepository { return nil },
+				func() port.WorkspaceRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
 
 			e := echo.New()
@@ -162,12 +171,16 @@ func TestAccountController_GetAccountByEmail(t *testing.T) {
 			p := presenter.NewAccountPresenter()
 			input := &ctrlmock.AccountInputStub{GetErr: tt.getErr}
 			ctrl := NewAccountController(
-				func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
+				func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.AccountPresenter { return p },
 				func() port.AccountRepository { return nil },
+				func() port.WorkspaceRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
 
 			e := echo.New()
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AuditController handles the administrators' audit log endpoints.
+type AuditController struct {
+	inputFactory  func(repo port.AuditRepository, output port.AuditOutputPort) port.AuditInputPort
+	outputFactory func() *presenter.AuditPresenter
+	repoFactory   func() port.AuditRepository
+}
+
+// NewAuditController creates AuditController.
+func NewAuditController(
+	inputFactory func(repo port.AuditRepository, output port.AuditOutputPort) port.AuditInputPort,
+	outputFactory func() *presenter.AuditPresenter,
+	repoFactory func() port.AuditRepository,
+) *AuditController {
+	return &AuditController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+	}
+}
+
+// List handles GET /admin/audit-logs.
+func (c *AuditController) List(ctx echo.Context, params openapi.AdminListAuditLogsParams) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	after, limit, err := pageParams(params.Cursor, params.Limit)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	filters := audit.Filters{
+		ActorID:     params.ActorId,
+		AggregateID: params.AggregateId,
+		From:        params.From,
+		To:          params.To,
+		Limit:       limit,
+		After:       after,
+	}
+	if params.AggregateType != nil {
+		aggType := audit.AggregateType(*params.AggregateType)
+		filters.AggregateType = &aggType
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), filters, viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.AuditLog())
+}
+
+// Verify handles GET /admin/audit-logs/verify.
+func (c *AuditController) Verify(ctx echo.Context) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Verify(ctx.Request().Context(), viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Verification())
+}
+
+func (c *AuditController) newIO() (port.AuditInputPort, *presenter.AuditPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.repoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newAuditController(input *ctrlmock.AuditInputStub) *AuditController {
+	return NewAuditController(
+		func(repo port.AuditRepository, output port.AuditOutputPort) port.AuditInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewAuditPresenter,
+		func() port.AuditRepository { return nil },
+	)
+}
+
+func TestAuditController_List(t *testing.T) {
+	cursor := page.Cursor{ID: "42"}.Encode()
+	bad := "not-a-cursor"
+	aggType := openapi.ModelsAuditAggregateTypeNote
+	limit := int32(5)
+	tests := []struct {
+		name       string
+		accountID  string
+		params     openapi.AdminListAuditLogsParams
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "[Success] list audit log",
+			accountID:  "admin",
+			params:     openapi.AdminListAuditLogsParams{AggregateType: &aggType, Cursor: &cursor, Limit: &limit},
+			wantStatus: http.StatusOK,
+			wantBody:   `"requestId":"req-1"`,
+		},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{
+			name:       "[Fail] invalid cursor",
+			accountID:  "admin",
+			params:     openapi.AdminListAuditLogsParams{Cursor: &bad},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domainerr.ErrInvalidCursor.Error(),
+		},
+		{name: "[Fail] not an admin", accountID: "user", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+		{name: "[Fail] invalid range", accountID: "admin", inErr: domainerr.ErrInvalidAuditRange, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := audit.Entry{
+				ActorID: "a1", Action: audit.ActionUpdate, AggregateType: audit.AggregateNote, AggregateID: "n1",
+				After: []byte(`{"title":"B"}`), RequestID: "req-1",
+			}.Seal("", time.Now())
+			input := &ctrlmock.AuditInputStub{Err: tt.inErr, Entries: []audit.Entry{entry}}
+			ctrl := newAuditController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/admin/audit-logs", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.List(c, tt.params)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK {
+				got := input.Listed
+				if input.ViewerID != "admin" || got.After == nil || got.After.ID != "42" || got.Limit != 5 || got.AggregateType == nil || *got.AggregateType != audit.AggregateNote {
+					t.Fatalf("unexpected input: %+v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestAuditController_Verify(t *testing.T) {
+	tests := []struct {
+		name         string
+		accountID    string
+		verification audit.Verification
+		inErr        error
+		wantStatus   int
+		wantBody     string
+	}{
+		{name: "[Success] intact chain", accountID: "admin", verification: audit.Verification{Checked: 3}, wantStatus: http.StatusOK, wantBody: `"valid":true`},
+		{name: "[Success] broken chain", accountID: "admin", verification: audit.Verification{Checked: 2, BrokenAt: 2}, wantStatus: http.StatusOK, wantBody: `"brokenAt":2`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] not an admin", accountID: "user", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newAuditController(&ctrlmock.AuditInputStub{Err: tt.inErr, Verification: tt.verification})
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/admin/audit-logs/verify", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Verify(c)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
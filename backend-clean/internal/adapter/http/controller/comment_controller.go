@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// CommentController handles comment endpoints.
+type CommentController struct {
+	inputFactory       func(noteRepo port.NoteRepository, commentRepo port.CommentRepository, output port.CommentOutputPort) port.CommentInputPort
+	outputFactory      func() *presenter.CommentPresenter
+	noteRepoFactory    func() port.NoteRepository
+	commentRepoFactory func() port.CommentRepository
+}
+
+// NewCommentController creates CommentController.
+func NewCommentController(
+	inputFactory func(noteRepo port.NoteRepository, commentRepo port.CommentRepository, output port.CommentOutputPort) port.CommentInputPort,
+	outputFactory func() *presenter.CommentPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	commentRepoFactory func() port.CommentRepository,
+) *CommentController {
+	return &CommentController{
+		inputFactory:       inputFactory,
+		outputFactory:      outputFactory,
+		noteRepoFactory:    noteRepoFactory,
+		commentRepoFactory: commentRepoFactory,
+	}
+}
+
+// List handles GET /notes/:id/comments.
+func (c *CommentController) List(ctx echo.Context, noteID string) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), noteID, viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Threads())
+}
+
+// Create handles POST /notes/:id/comments.
+func (c *CommentController) Create(ctx echo.Context, noteID string) error {
+	var body openapi.ModelsCreateCommentRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	authorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Create(ctx.Request().Context(), port.CommentCreateInput{
+		NoteID:    noteID,
+		AuthorID:  authorID,
+		SectionID: uuidOrEmpty(body.SectionId),
+		ParentID:  uuidOrEmpty(body.ParentId),
+		Body:      body.Body,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Comment())
+}
+
+// Update handles PUT /notes/:id/comments/:commentId.
+func (c *CommentController) Update(ctx echo.Context, noteID, commentID string) error {
+	var body openapi.ModelsUpdateCommentRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Update(ctx.Request().Context(), port.CommentUpdateInput{
+		NoteID:    noteID,
+		CommentID: commentID,
+		ActorID:   actorID,
+		Body:      body.Body,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Comment())
+}
+
+// Delete handles DELETE /notes/:id/comments/:commentId.
+func (c *CommentController) Delete(ctx echo.Context, noteID, commentID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Delete(ctx.Request().Context(), noteID, commentID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+// Resolve handles POST /notes/:id/comments/:commentId/resolve and /unresolve.
+func (c *CommentController) Resolve(ctx echo.Context, noteID, commentID string, resolved bool) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Resolve(ctx.Request().Context(), port.CommentResolveInput{
+		NoteID:    noteID,
+		CommentID: commentID,
+		ActorID:   actorID,
+		Resolved:  resolved,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Comment())
+}
+
+func (c *CommentController) newIO() (port.CommentInputPort, *presenter.CommentPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.noteRepoFactory(), c.commentRepoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,232 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/comment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newCommentController(input *ctrlmock.CommentInputStub) *CommentController {
+	return NewCommentController(
+		func(noteRepo port.NoteRepository, commentRepo port.CommentRepository, output port.CommentOutputPort) port.CommentInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewCommentPresenter,
+		func() port.NoteRepository { return nil },
+		func() port.CommentRepository { return nil },
+	)
+}
+
+func TestCommentController_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list threads", accountID: "viewer", wantStatus: http.StatusOK, wantBody: `"replies":[{`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not found", accountID: "viewer", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.CommentInputStub{
+				Threads: []comment.Thread{{
+					Root:    comment.WithMeta{Comment: comment.Comment{ID: "c1", NoteID: "n1"}},
+					Replies: []comment.WithMeta{{Comment: comment.Comment{ID: "c2", NoteID: "n1", ParentID: "c1"}}},
+				}},
+				Err: tt.inErr,
+			}
+			ctrl := newCommentController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/comments", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.List(c, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestCommentController_Create(t *testing.T) {
+	const section = "00000000-0000-0000-0000-000000000003"
+	tests := []struct {
+		name        string
+		accountID   string
+		body        string
+		inErr       error
+		wantStatus  int
+		wantBody    string
+		wantSection string
+	}{
+		{
+			name:        "[Success] start a thread on a section",
+			accountID:   "author",
+			body:        `{"body":"why?","sectionId":"` + section + `"}`,
+			wantStatus:  http.StatusOK,
+			wantBody:    `"sectionId":"` + section + `"`,
+			wantSection: section,
+		},
+		{
+			name:       "[Success] comment on the whole note",
+			accountID:  "author",
+			body:       `{"body":"nice"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"resolved":false`,
+		},
+		{
+			name:       "[Fail] bind error",
+			body:       `not-json`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid body",
+		},
+		{
+			name:       "[Fail] unauthenticated",
+			body:       `{"body":"why?"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   domainerr.ErrUnauthenticated.Error(),
+		},
+		{
+			name:       "[Fail] empty body",
+			accountID:  "author",
+			body:       `{"body":""}`,
+			inErr:      domainerr.ErrInvalidCommentBody,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domainerr.ErrInvalidCommentBody.Error(),
+		},
+		{
+			name:       "[Fail] reply to a reply",
+			accountID:  "author",
+			body:       `{"body":"+1","parentId":"00000000-0000-0000-0000-000000000009"}`,
+			inErr:      domainerr.ErrInvalidCommentParent,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domainerr.ErrInvalidCommentParent.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.CommentInputStub{Err: tt.inErr}
+			ctrl := newCommentController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/comments", bytes.NewBufferString(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Create(c, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && (input.Created.NoteID != "n1" || input.Created.AuthorID != tt.accountID || input.Created.SectionID != tt.wantSection || input.Created.ParentID != "") {
+				t.Fatalf("unexpected input: %+v", input.Created)
+			}
+		})
+	}
+}
+
+func TestCommentController_Update(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] edit comment", accountID: "author", body: `{"body":"edited"}`, wantStatus: http.StatusOK, wantBody: `"body":"edited"`},
+		{name: "[Fail] bind error", accountID: "author", body: `not-json`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] unauthenticated", body: `{"body":"edited"}`, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not the author", accountID: "intruder", body: `{"body":"edited"}`, inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.CommentInputStub{Err: tt.inErr}
+			ctrl := newCommentController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/notes/n1/comments/c1", bytes.NewBufferString(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Update(c, "n1", "c1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && (input.Updated.CommentID != "c1" || input.Updated.ActorID != tt.accountID) {
+				t.Fatalf("unexpected input: %+v", input.Updated)
+			}
+		})
+	}
+}
+
+func TestCommentController_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] delete comment", accountID: "author", wantStatus: http.StatusOK, wantBody: `"success":true`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not found", accountID: "author", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newCommentController(&ctrlmock.CommentInputStub{Err: tt.inErr})
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/notes/n1/comments/c1", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Delete(c, "n1", "c1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestCommentController_Resolve(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		resolved   bool
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] resolve thread", accountID: "owner", resolved: true, wantStatus: http.StatusOK, wantBody: `"resolvedBy":"owner"`},
+		{name: "[Success] reopen thread", accountID: "owner", wantStatus: http.StatusOK, wantBody: `"resolved":false`},
+		{name: "[Fail] unauthenticated", resolved: true, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] reply cannot be resolved", accountID: "owner", resolved: true, inErr: domainerr.ErrCommentNotThread, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrCommentNotThread.Error()},
+		{name: "[Fail] neither author nor owner", accountID: "viewer", resolved: true, inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.CommentInputStub{Err: tt.inErr}
+			ctrl := newCommentController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/comments/c1/resolve", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Resolve(c, "n1", "c1", tt.resolved)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && input.Resolved.Resolved != tt.resolved {
+				t.Fatalf("unexpected input: %+v", input.Resolved)
+			}
+		})
+	}
+}
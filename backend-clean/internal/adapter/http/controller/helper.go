@@ -1,36 +1,34 @@
 package controller
 
 import (
-	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 
-	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
-	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/adapter/http/middleware"
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
 )
 
+// handleError writes err as a problem response; see problem.Error.
 func handleError(ctx echo.Context, err error) error {
-	switch {
-	case errors.Is(err, domainerr.ErrNotFound):
-		return ctx.JSON(http.StatusNotFound, openapi.ModelsNotFoundError{Code: openapi.ModelsNotFoundErrorCodeNOTFOUND, Message: err.Error()})
-	case errors.Is(err, domainerr.ErrUnauthorized):
-		return ctx.JSON(http.StatusForbidden, openapi.ModelsForbiddenError{Code: openapi.ModelsForbiddenErrorCodeFORBIDDEN, Message: err.Error()})
-	case errors.Is(err, account.ErrInvalidEmail), errors.Is(err, account.ErrInvalidName):
-		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
-	case errors.Is(err, domainerr.ErrInvalidStatus) || errors.Is(err, domainerr.ErrInvalidStatusChange) || errors.Is(err, domainerr.ErrInvalidTemplateField):
-		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: err.Error()})
-	default:
-		return ctx.JSON(http.StatusInternalServerError, openapi.ModelsErrorResponse{Code: "INTERNAL_ERROR", Message: err.Error()})
-	}
+	return problem.Error(ctx, err)
+}
+
+// badRequest writes a 400 problem for a request the handler cannot read.
+func badRequest(ctx echo.Context, message string) error {
+	return problem.JSON(ctx, http.StatusBadRequest, problem.CodeBadRequest, message)
 }
 
+// currentAccountID returns the account authenticated by the auth middleware.
 func currentAccountID(ctx echo.Context) (string, error) {
-	id := ctx.Request().Header.Get("X-Account-ID")
-	if strings.TrimSpace(id) == "" {
-		return "", domainerr.ErrUnauthorized
+	id, ok := middleware.AccountIDFromContext(ctx.Request().Context())
+	if !ok {
+		return "", domainerr.ErrUnauthenticated
 	}
 	return id, nil
 }
@@ -41,3 +39,56 @@ func valueOrEmpty(s *string) string {
 	}
 	return *s
 }
+
+// uuidOrEmpty returns the optional UUID as a string, or "" when it is omitted.
+func uuidOrEmpty(id *openapi_types.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func valuesOrEmpty(s *[]string) []string {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+// pageParams converts the cursor and limit query parameters of list endpoints.
+func pageParams(cursor *string, limit *int32) (*page.Cursor, int, error) {
+	n := 0
+	if limit != nil {
+		n = int(*limit)
+	}
+	if cursor == nil || *cursor == "" {
+		return nil, n, nil
+	}
+	after, err := page.Decode(*cursor)
+	if err != nil {
+		return nil, 0, err
+	}
+	return after, n, nil
+}
+
+// expectedVersion returns the version an update is conditional on, taken from the
+// If-Match header ("3" or 3) or else the request body. "*" or neither means
+// unconditional. ok is false when the header is not a version.
+func expectedVersion(ifMatch *string, bodyVersion *int32) (version *int, ok bool) {
+	if ifMatch != nil {
+		tag := strings.TrimSpace(*ifMatch)
+		if tag == "*" {
+			return nil, true
+		}
+		v, err := strconv.Atoi(strings.Trim(tag, `"`))
+		if err != nil {
+			return nil, false
+		}
+		return &v, true
+	}
+	if bodyVersion != nil {
+		v := int(*bodyVersion)
+		return &v, true
+	}
+	return nil, true
+}
@@ -1,9 +1,12 @@
 package controller
 
 import (
+	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/middleware"
 )
 
 // assertStatusBody checks HTTP status and optional body substring.
@@ -16,3 +19,14 @@ func assertStatusBody(t *testing.T, rec *httptest.ResponseRecorder, wantStatus i
 		t.Fatalf("body = %q, want to contain %q", rec.Body.String(), wantBody)
 	}
 }
+
+// withAccount attaches an authenticated account ID to req as the auth middleware would.
+// An empty accountID leaves the request unauthenticated.
+func withAccount(req *http.Request, accountID string) *http.Request {
+	if accountID == "" {
+		return req
+	}
+	return req.WithContext(middleware.WithAccountID(req.Context(), accountID))
+}
+
+func intPtr(v int) *int { return &v }
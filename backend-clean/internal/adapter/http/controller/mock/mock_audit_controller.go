@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AuditInputStub is a lightweight stub for audit use case input.
+type AuditInputStub struct {
+	Err          error
+	Output       port.AuditOutputPort
+	Entries      []audit.Entry
+	Verification audit.Verification
+	Listed       audit.Filters
+	ViewerID     string
+}
+
+func (s *AuditInputStub) List(ctx context.Context, filters audit.Filters, viewerID string) error {
+	s.Listed = filters
+	s.ViewerID = viewerID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentAuditLog(ctx, page.Page[audit.Entry]{Items: s.Entries})
+	}
+	return s.Err
+}
+
+func (s *AuditInputStub) Verify(ctx context.Context, viewerID string) error {
+	s.ViewerID = viewerID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentAuditVerification(ctx, s.Verification)
+	}
+	return s.Err
+}
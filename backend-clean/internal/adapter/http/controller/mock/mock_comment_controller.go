@@ -0,0 +1,64 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/comment"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// CommentInputStub is a lightweight stub for comment use case input.
+type CommentInputStub struct {
+	Err      error
+	Output   port.CommentOutputPort
+	Threads  []comment.Thread
+	Created  port.CommentCreateInput
+	Updated  port.CommentUpdateInput
+	Resolved port.CommentResolveInput
+}
+
+func (s *CommentInputStub) List(ctx context.Context, noteID, viewerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentCommentThreads(ctx, s.Threads)
+	}
+	return s.Err
+}
+
+func (s *CommentInputStub) Create(ctx context.Context, input port.CommentCreateInput) error {
+	s.Created = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentComment(ctx, &comment.WithMeta{Comment: comment.Comment{
+			ID: "c-1", NoteID: input.NoteID, SectionID: input.SectionID, ParentID: input.ParentID, AuthorID: input.AuthorID, Body: input.Body,
+		}})
+	}
+	return s.Err
+}
+
+func (s *CommentInputStub) Update(ctx context.Context, input port.CommentUpdateInput) error {
+	s.Updated = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentComment(ctx, &comment.WithMeta{Comment: comment.Comment{
+			ID: input.CommentID, NoteID: input.NoteID, AuthorID: input.ActorID, Body: input.Body,
+		}})
+	}
+	return s.Err
+}
+
+func (s *CommentInputStub) Delete(ctx context.Context, noteID, commentID, actorID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentCommentDeleted(ctx)
+	}
+	return s.Err
+}
+
+func (s *CommentInputStub) Resolve(ctx context.Context, input port.CommentResolveInput) error {
+	s.Resolved = input
+	if s.Output != nil && s.Err == nil {
+		c := comment.Comment{ID: input.CommentID, NoteID: input.NoteID}
+		if input.Resolved {
+			c.ResolvedBy = input.ActorID
+		}
+		_ = s.Output.PresentComment(ctx, &comment.WithMeta{Comment: c})
+	}
+	return s.Err
+}
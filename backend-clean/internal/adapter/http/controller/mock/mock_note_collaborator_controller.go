@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCollaboratorInputStub is a lightweight stub for note collaborator use case input.
+type NoteCollaboratorInputStub struct {
+	Err           error
+	Output        port.NoteCollaboratorOutputPort
+	Collaborators []note.CollaboratorWithMeta
+	PutInput      port.NoteCollaboratorPutInput
+	Deleted       string
+}
+
+func (s *NoteCollaboratorInputStub) List(ctx context.Context, noteID, viewerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteCollaborators(ctx, s.Collaborators)
+	}
+	return s.Err
+}
+
+func (s *NoteCollaboratorInputStub) Put(ctx context.Context, input port.NoteCollaboratorPutInput) error {
+	s.PutInput = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteCollaborator(ctx, &note.CollaboratorWithMeta{Collaborator: note.Collaborator{
+			NoteID: input.NoteID, AccountID: input.AccountID, Role: input.Role,
+		}})
+	}
+	return s.Err
+}
+
+func (s *NoteCollaboratorInputStub) Delete(ctx context.Context, noteID, accountID, actorID string) error {
+	s.Deleted = accountID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteCollaboratorDeleted(ctx)
+	}
+	return s.Err
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -13,16 +15,38 @@ type NoteInputStub struct {
 	Output   port.NoteOutputPort
 	Notes    []note.WithMeta
 	NoteResp *note.WithMeta
+	Results  []note.SearchResult
+	// Filters records the filters passed to List or Search.
+	Filters note.Filters
+	// Created records the input passed to Create.
+	Created port.NoteCreateInput
+	// Updated records the input passed to Update.
+	Updated port.NoteUpdateInput
+	// Migrated records the input passed to MigrateTemplate.
+	Migrated port.NoteMigrateTemplateInput
+	// Imported records the input passed to ImportMarkdown.
+	Imported port.NoteImportMarkdownInput
+	// Trash records the filters passed to ListTrash.
+	Trash trash.Filters
 }
 
-func (s *NoteInputStub) List(ctx context.Context, filters note.Filters) error {
+func (s *NoteInputStub) List(ctx context.Context, filters note.Filters, viewerID string) error {
+	s.Filters = filters
 	if s.Output != nil && s.Err == nil {
-		_ = s.Output.PresentNoteList(ctx, s.Notes)
+		_ = s.Output.PresentNoteList(ctx, page.Page[note.WithMeta]{Items: s.Notes})
 	}
 	return s.Err
 }
 
-func (s *NoteInputStub) Get(ctx context.Context, id string) error {
+func (s *NoteInputStub) Search(ctx context.Context, filters note.Filters, viewerID string) error {
+	s.Filters = filters
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentSearchResults(ctx, s.Results)
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Get(ctx context.Context, id, viewerID string) error {
 	if s.Output != nil && s.Err == nil {
 		resp := s.NoteResp
 		if resp == nil {
@@ -34,6 +58,7 @@ func (s *NoteInputStub) Get(ctx context.Context, id string) error {
 }
 
 func (s *NoteInputStub) Create(ctx context.Context, input port.NoteCreateInput) error {
+	s.Created = input
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: input.OwnerID}})
 	}
@@ -41,6 +66,7 @@ func (s *NoteInputStub) Create(ctx context.Context, input port.NoteCreateInput)
 }
 
 func (s *NoteInputStub) Update(ctx context.Context, input port.NoteUpdateInput) error {
+	s.Updated = input
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID}})
 	}
@@ -54,9 +80,54 @@ func (s *NoteInputStub) ChangeStatus(ctx context.Context, input port.NoteStatusC
 	return s.Err
 }
 
+func (s *NoteInputStub) MigrateTemplate(ctx context.Context, input port.NoteMigrateTemplateInput) error {
+	s.Migrated = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: input.ID, OwnerID: input.OwnerID}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) ExportMarkdown(ctx context.Context, id, viewerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteMarkdown(ctx, &note.WithMeta{Note: note.Note{ID: id}}, "# "+id+"\n")
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) ImportMarkdown(ctx context.Context, input port.NoteImportMarkdownInput) error {
+	s.Imported = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: "imported", TemplateID: input.TemplateID, OwnerID: input.OwnerID}})
+	}
+	return s.Err
+}
+
 func (s *NoteInputStub) Delete(ctx context.Context, id, ownerID string) error {
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentNoteDeleted(ctx)
 	}
 	return s.Err
 }
+
+func (s *NoteInputStub) ListTrash(ctx context.Context, filters trash.Filters) error {
+	s.Trash = filters
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteList(ctx, page.Page[note.WithMeta]{Items: s.Notes})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Restore(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNote(ctx, &note.WithMeta{Note: note.Note{ID: id, OwnerID: ownerID}})
+	}
+	return s.Err
+}
+
+func (s *NoteInputStub) Purge(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteDeleted(ctx)
+	}
+	return s.Err
+}
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteLinkInputStub is a lightweight stub for note link use case input.
+type NoteLinkInputStub struct {
+	Err       error
+	Output    port.NoteLinkOutputPort
+	Outgoing  []note.LinkSummary
+	Backlinks []note.LinkSummary
+	Created   port.NoteLinkCreateInput
+}
+
+func (s *NoteLinkInputStub) List(ctx context.Context, noteID, viewerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteLinks(ctx, s.Outgoing, s.Backlinks)
+	}
+	return s.Err
+}
+
+func (s *NoteLinkInputStub) Create(ctx context.Context, input port.NoteLinkCreateInput) error {
+	s.Created = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteLink(ctx, &note.LinkSummary{LinkID: "link-1", Type: input.Type, NoteID: input.TargetID})
+	}
+	return s.Err
+}
+
+func (s *NoteLinkInputStub) Delete(ctx context.Context, noteID, linkID, actorID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentNoteLinkDeleted(ctx)
+	}
+	return s.Err
+}
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteRevisionInputStub is a lightweight stub for note revision use case input.
+type NoteRevisionInputStub struct {
+	Err        error
+	Output     port.NoteRevisionOutputPort
+	Revisions  []note.Revision
+	DiffNumber int
+	DiffBase   int
+}
+
+func (s *NoteRevisionInputStub) List(ctx context.Context, noteID, viewerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentRevisionList(ctx, s.Revisions)
+	}
+	return s.Err
+}
+
+func (s *NoteRevisionInputStub) Get(ctx context.Context, noteID string, number int, viewerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentRevision(ctx, &note.Revision{NoteID: noteID, Number: number})
+	}
+	return s.Err
+}
+
+func (s *NoteRevisionInputStub) Diff(ctx context.Context, noteID string, number, base int, viewerID string) error {
+	s.DiffNumber, s.DiffBase = number, base
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentRevisionDiff(ctx, &note.RevisionDiff{NoteID: noteID, From: base, To: number})
+	}
+	return s.Err
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TagInputStub is a lightweight stub for tag use case input.
+type TagInputStub struct {
+	Err      error
+	Output   port.TagOutputPort
+	Counts   []note.TagCount
+	Listed   note.TagFilters
+	ViewerID string
+}
+
+func (s *TagInputStub) List(ctx context.Context, filters note.TagFilters, viewerID string) error {
+	s.Listed = filters
+	s.ViewerID = viewerID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTagCounts(ctx, s.Counts)
+	}
+	return s.Err
+}
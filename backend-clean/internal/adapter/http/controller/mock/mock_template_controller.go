@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -11,6 +12,12 @@ import (
 type TemplateInputStub struct {
 	Err    error
 	Output port.TemplateOutputPort
+	// Created records the input passed to Create.
+	Created port.TemplateCreateInput
+	// Updated records the input passed to Update.
+	Updated port.TemplateUpdateInput
+	// Trash records the filters passed to ListTrash.
+	Trash trash.Filters
 }
 
 func (s *TemplateInputStub) List(ctx context.Context, filters template.Filters) error { return s.Err }
@@ -23,6 +30,7 @@ func (s *TemplateInputStub) Get(ctx context.Context, id string) error {
 }
 
 func (s *TemplateInputStub) Create(ctx context.Context, input port.TemplateCreateInput) error {
+	s.Created = input
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: input.Name, OwnerID: input.OwnerID}})
 	}
@@ -30,6 +38,7 @@ func (s *TemplateInputStub) Create(ctx context.Context, input port.TemplateCreat
 }
 
 func (s *TemplateInputStub) Update(ctx context.Context, input port.TemplateUpdateInput) error {
+	s.Updated = input
 	if s.Output != nil && s.Err == nil {
 		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: input.ID, Name: input.Name, OwnerID: input.OwnerID}})
 	}
@@ -42,3 +51,22 @@ func (s *TemplateInputStub) Delete(ctx context.Context, id, ownerID string) erro
 	}
 	return s.Err
 }
+
+func (s *TemplateInputStub) ListTrash(ctx context.Context, filters trash.Filters) error {
+	s.Trash = filters
+	return s.Err
+}
+
+func (s *TemplateInputStub) Restore(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplate(ctx, &template.WithUsage{Template: template.Template{ID: id, OwnerID: ownerID}})
+	}
+	return s.Err
+}
+
+func (s *TemplateInputStub) Purge(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentTemplateDeleted(ctx)
+	}
+	return s.Err
+}
@@ -0,0 +1,82 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookInputStub is a lightweight stub for webhook use case input.
+type WebhookInputStub struct {
+	Err        error
+	Output     port.WebhookOutputPort
+	Webhooks   []webhook.Webhook
+	Deliveries []webhook.Delivery
+	Created    port.WebhookCreateInput
+	Updated    port.WebhookUpdateInput
+	Listed     port.WebhookDeliveryListInput
+}
+
+func (s *WebhookInputStub) List(ctx context.Context, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhookList(ctx, s.Webhooks)
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Get(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhook(ctx, &webhook.Webhook{ID: id, OwnerID: ownerID, URL: "https://example.com/hook", Secret: "whsec_x", Active: true})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Create(ctx context.Context, input port.WebhookCreateInput) error {
+	s.Created = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhookCreated(ctx, &webhook.Webhook{ID: "wh-1", OwnerID: input.OwnerID, URL: input.URL, Secret: "whsec_x", Events: input.Events, Active: true})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Update(ctx context.Context, input port.WebhookUpdateInput) error {
+	s.Updated = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhook(ctx, &webhook.Webhook{ID: input.ID, OwnerID: input.OwnerID, URL: input.URL, Secret: "whsec_x", Events: input.Events, Active: input.Active})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Delete(ctx context.Context, id, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWebhookDeleted(ctx)
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) ListDeliveries(ctx context.Context, input port.WebhookDeliveryListInput) error {
+	s.Listed = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentDeliveryList(ctx, page.Page[webhook.Delivery]{Items: s.Deliveries})
+	}
+	return s.Err
+}
+
+func (s *WebhookInputStub) Redeliver(ctx context.Context, webhookID, deliveryID, ownerID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentDelivery(ctx, &webhook.Delivery{
+			ID:            "d-2",
+			WebhookID:     webhookID,
+			EventID:       "e-1",
+			EventType:     webhook.EventNotePublished,
+			Payload:       []byte(`{"id":"e-1"}`),
+			Status:        webhook.DeliveryPending,
+			NextAttemptAt: time.Now(),
+			RedeliveryOf:  deliveryID,
+		})
+	}
+	return s.Err
+}
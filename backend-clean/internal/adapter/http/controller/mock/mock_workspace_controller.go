@@ -0,0 +1,124 @@
+package mock
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceInputStub is a lightweight stub for workspace use case input.
+type WorkspaceInputStub struct {
+	Err         error
+	Output      port.WorkspaceOutputPort
+	Workspaces  []workspace.WithRole
+	Members     []workspace.MemberWithMeta
+	Invites     []workspace.InviteWithMeta
+	CreateInput port.WorkspaceCreateInput
+	UpdateInput port.WorkspaceUpdateInput
+	MemberInput port.WorkspaceMemberUpdateInput
+	InviteInput port.WorkspaceInviteInput
+	Deleted     string
+}
+
+func (s *WorkspaceInputStub) List(ctx context.Context, accountID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaces(ctx, s.Workspaces)
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) Create(ctx context.Context, input port.WorkspaceCreateInput) error {
+	s.CreateInput = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspace(ctx, &workspace.WithRole{Workspace: workspace.Workspace{ID: "ws-new", Name: input.Name}, Role: workspace.RoleOwner})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) Get(ctx context.Context, workspaceID, actorID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspace(ctx, &workspace.WithRole{Workspace: workspace.Workspace{ID: workspaceID}, Role: workspace.RoleMember})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) Update(ctx context.Context, input port.WorkspaceUpdateInput) error {
+	s.UpdateInput = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspace(ctx, &workspace.WithRole{Workspace: workspace.Workspace{ID: input.WorkspaceID, Name: input.Name}, Role: workspace.RoleAdmin})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) Delete(ctx context.Context, workspaceID, actorID string) error {
+	s.Deleted = workspaceID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaceDeleted(ctx)
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) ListMembers(ctx context.Context, workspaceID, actorID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaceMembers(ctx, s.Members)
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) UpdateMember(ctx context.Context, input port.WorkspaceMemberUpdateInput) error {
+	s.MemberInput = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaceMember(ctx, &workspace.MemberWithMeta{Member: workspace.Member{
+			WorkspaceID: input.WorkspaceID, AccountID: input.AccountID, Role: input.Role,
+		}})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) RemoveMember(ctx context.Context, workspaceID, accountID, actorID string) error {
+	s.Deleted = accountID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaceMemberRemoved(ctx)
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) ListInvites(ctx context.Context, workspaceID, actorID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaceInvites(ctx, s.Invites)
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) Invite(ctx context.Context, input port.WorkspaceInviteInput) error {
+	s.InviteInput = input
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaceInvite(ctx, &workspace.InviteWithMeta{Invite: workspace.Invite{
+			ID: "inv-1", WorkspaceID: input.WorkspaceID, Email: input.Email, Role: input.Role, InvitedBy: input.ActorID,
+		}})
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) RevokeInvite(ctx context.Context, workspaceID, inviteID, actorID string) error {
+	s.Deleted = inviteID
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaceInviteRevoked(ctx)
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) ListMyInvites(ctx context.Context, actorID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspaceInvites(ctx, s.Invites)
+	}
+	return s.Err
+}
+
+func (s *WorkspaceInputStub) AcceptInvite(ctx context.Context, inviteID, actorID string) error {
+	if s.Output != nil && s.Err == nil {
+		_ = s.Output.PresentWorkspace(ctx, &workspace.WithRole{Workspace: workspace.Workspace{ID: "ws-1"}, Role: workspace.RoleMember})
+	}
+	return s.Err
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCollaboratorController handles note collaborator endpoints.
+type NoteCollaboratorController struct {
+	inputFactory            func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, collaboratorRepo port.NoteCollaboratorRepository, workspaceRepo port.WorkspaceRepository, output port.NoteCollaboratorOutputPort) port.NoteCollaboratorInputPort
+	outputFactory           func() *presenter.NoteCollaboratorPresenter
+	noteRepoFactory         func() port.NoteRepository
+	accountRepoFactory      func() port.AccountRepository
+	collaboratorRepoFactory func() port.NoteCollaboratorRepository
+	workspaceRepoFactory    func() port.WorkspaceRepository
+}
+
+// NewNoteCollaboratorController creates NoteCollaboratorController.
+func NewNoteCollaboratorController(
+	inputFactory func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, collaboratorRepo port.NoteCollaboratorRepository, workspaceRepo port.WorkspaceRepository, output port.NoteCollaboratorOutputPort) port.NoteCollaboratorInputPort,
+	outputFactory func() *presenter.NoteCollaboratorPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	accountRepoFactory func() port.AccountRepository,
+	collaboratorRepoFactory func() port.NoteCollaboratorRepository,
+	workspaceRepoFactory func() port.WorkspaceRepository,
+) *NoteCollaboratorController {
+	return &NoteCollaboratorController{
+		inputFactory:            inputFactory,
+		outputFactory:           outputFactory,
+		noteRepoFactory:         noteRepoFactory,
+		accountRepoFactory:      accountRepoFactory,
+		collaboratorRepoFactory: collaboratorRepoFactory,
+		workspaceRepoFactory:    workspaceRepoFactory,
+	}
+}
+
+// List handles GET /notes/:id/collaborators.
+func (c *NoteCollaboratorController) List(ctx echo.Context, noteID string) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), noteID, viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Collaborators())
+}
+
+// Put handles PUT /notes/:id/collaborators/:accountId.
+func (c *NoteCollaboratorController) Put(ctx echo.Context, noteID, accountID string) error {
+	var body openapi.ModelsPutNoteCollaboratorRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Put(ctx.Request().Context(), port.NoteCollaboratorPutInput{
+		NoteID:    noteID,
+		AccountID: accountID,
+		Role:      note.Role(body.Role),
+		ActorID:   actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Collaborator())
+}
+
+// Delete handles DELETE /notes/:id/collaborators/:accountId.
+func (c *NoteCollaboratorController) Delete(ctx echo.Context, noteID, accountID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Delete(ctx.Request().Context(), noteID, accountID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+func (c *NoteCollaboratorController) newIO() (port.NoteCollaboratorInputPort, *presenter.NoteCollaboratorPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.noteRepoFactory(), c.accountRepoFactory(), c.collaboratorRepoFactory(), c.workspaceRepoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newNoteCollaboratorController(input *ctrlmock.NoteCollaboratorInputStub) *NoteCollaboratorController {
+	return NewNoteCollaboratorController(
+		func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, collaboratorRepo port.NoteCollaboratorRepository, workspaceRepo port.WorkspaceRepository, output port.NoteCollaboratorOutputPort) port.NoteCollaboratorInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewNoteCollaboratorPresenter,
+		func() port.NoteRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.NoteCollaboratorRepository { return nil },
+		func() port.WorkspaceRepository { return nil },
+	)
+}
+
+func TestNoteCollaboratorController_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list collaborators", accountID: "owner", wantStatus: http.StatusOK, wantBody: `"role":"editor"`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not found", accountID: "stranger", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteCollaboratorInputStub{
+				Collaborators: []note.CollaboratorWithMeta{{Collaborator: note.Collaborator{NoteID: "n1", AccountID: "a1", Role: note.RoleEditor}}},
+				Err:           tt.inErr,
+			}
+			ctrl := newNoteCollaboratorController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/collaborators", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.List(c, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestNoteCollaboratorController_Put(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] share as viewer", accountID: "owner", body: `{"role":"viewer"}`, wantStatus: http.StatusOK, wantBody: `"role":"viewer"`},
+		{name: "[Fail] bind error", accountID: "owner", body: `not-json`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] unauthenticated", body: `{"role":"viewer"}`, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] invalid role", accountID: "owner", body: `{"role":"admin"}`, inErr: domainerr.ErrInvalidCollaboratorRole, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidCollaboratorRole.Error()},
+		{name: "[Fail] owner as collaborator", accountID: "owner", body: `{"role":"editor"}`, inErr: domainerr.ErrInvalidCollaborator, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidCollaborator.Error()},
+		{name: "[Fail] not the owner", accountID: "editor", body: `{"role":"viewer"}`, inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteCollaboratorInputStub{Err: tt.inErr}
+			ctrl := newNoteCollaboratorController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/notes/n1/collaborators/a1", bytes.NewBufferString(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Put(c, "n1", "a1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && (input.PutInput.NoteID != "n1" || input.PutInput.AccountID != "a1" || input.PutInput.ActorID != tt.accountID || input.PutInput.Role != note.RoleViewer) {
+				t.Fatalf("unexpected input: %+v", input.PutInput)
+			}
+		})
+	}
+}
+
+func TestNoteCollaboratorController_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] remove collaborator", accountID: "owner", wantStatus: http.StatusOK, wantBody: `"success":true`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not a collaborator", accountID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteCollaboratorInputStub{Err: tt.inErr}
+			ctrl := newNoteCollaboratorController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/notes/n1/collaborators/a1", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Delete(c, "n1", "a1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && input.Deleted != "a1" {
+				t.Fatalf("unexpected delete: %s", input.Deleted)
+			}
+		})
+	}
+}
@@ -2,47 +2,68 @@
 package controller
 
 import (
+	"io"
+	"mime"
 	"net/http"
-	"strings"
 
 	"github.com/labstack/echo/v4"
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
-	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // NoteController handles note HTTP endpoints.
 type NoteController struct {
-	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort
+	inputFactory    func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort
 	outputFactory   func() *presenter.NotePresenter
 	noteRepoFactory func() port.NoteRepository
 	tplRepoFactory  func() port.TemplateRepository
+	revRepoFactory  func() port.NoteRevisionRepository
+	linkRepoFactory func() port.NoteLinkRepository
 	txFactory       func() port.TxManager
+	eventsFactory   func() port.EventPublisher
+	auditFactory    func() port.AuditRecorder
 }
 
 // NewNoteController creates NoteController.
 func NewNoteController(
-	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort,
+	inputFactory func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort,
 	outputFactory func() *presenter.NotePresenter,
 	noteRepoFactory func() port.NoteRepository,
 	tplRepoFactory func() port.TemplateRepository,
+	revRepoFactory func() port.NoteRevisionRepository,
+	linkRepoFactory func() port.NoteLinkRepository,
 	txFactory func() port.TxManager,
+	eventsFactory func() port.EventPublisher,
+	auditFactory func() port.AuditRecorder,
 ) *NoteController {
 	return &NoteController{
 		inputFactory:    inputFactory,
 		outputFactory:   outputFactory,
 		noteRepoFactory: noteRepoFactory,
 		tplRepoFactory:  tplRepoFactory,
+		revRepoFactory:  revRepoFactory,
+		linkRepoFactory: linkRepoFactory,
 		txFactory:       txFactory,
+		eventsFactory:   eventsFactory,
+		auditFactory:    auditFactory,
 	}
 }
 
 // List handles listing notes with optional filters.
 // List handles GET /notes.
 func (c *NoteController) List(ctx echo.Context, params openapi.NotesListNotesParams) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	after, limit, err := pageParams(params.Cursor, params.Limit)
+	if err != nil {
+		return handleError(ctx, err)
+	}
 	var status *note.NoteStatus
 	if params.Status != nil {
 		s := note.NoteStatus(*params.Status)
@@ -53,18 +74,59 @@ func (c *NoteController) List(ctx echo.Context, params openapi.NotesListNotesPar
 		TemplateID: params.TemplateId,
 		OwnerID:    params.OwnerId,
 		Query:      params.Q,
+		Tags:       tagFilters(params.Tags),
+		TagMatch:   tagMatch(params.TagMatch),
+		Limit:      limit,
+		After:      after,
 	}
 	input, p := c.newIO()
-	if err := input.List(ctx.Request().Context(), filters); err != nil {
+	if err := input.List(ctx.Request().Context(), filters, viewerID); err != nil {
 		return handleError(ctx, err)
 	}
 	return ctx.JSON(http.StatusOK, p.Notes())
 }
 
+// Search handles GET /notes/search.
+func (c *NoteController) Search(ctx echo.Context, params openapi.NotesSearchNotesParams) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	var status *note.NoteStatus
+	if params.Status != nil {
+		s := note.NoteStatus(*params.Status)
+		status = &s
+	}
+	var searchIn []note.SearchTarget
+	if params.In != nil {
+		for _, t := range *params.In {
+			searchIn = append(searchIn, note.SearchTarget(t))
+		}
+	}
+	filters := note.Filters{
+		Status:     status,
+		TemplateID: params.TemplateId,
+		OwnerID:    params.OwnerId,
+		Query:      &params.Q,
+		SearchIn:   searchIn,
+		Tags:       tagFilters(params.Tags),
+		TagMatch:   tagMatch(params.TagMatch),
+	}
+	input, p := c.newIO()
+	if err := input.Search(ctx.Request().Context(), filters, viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.SearchResults())
+}
+
 // GetByID handles GET /notes/:id.
 func (c *NoteController) GetByID(ctx echo.Context, noteID string) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
 	input, p := c.newIO()
-	if err := input.Get(ctx.Request().Context(), noteID); err != nil {
+	if err := input.Get(ctx.Request().Context(), noteID, viewerID); err != nil {
 		return handleError(ctx, err)
 	}
 	return ctx.JSON(http.StatusOK, p.Note())
@@ -75,9 +137,12 @@ func (c *NoteController) GetByID(ctx echo.Context, noteID string) error {
 func (c *NoteController) Create(ctx echo.Context) error {
 	var body openapi.ModelsCreateNoteRequest
 	if err := ctx.Bind(&body); err != nil {
-		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+		return badRequest(ctx, "invalid body")
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
 	}
-	ownerID := body.OwnerId.String()
 	sections := []port.SectionInput{}
 	if body.Sections != nil {
 		for _, s := range *body.Sections {
@@ -88,11 +153,55 @@ func (c *NoteController) Create(ctx echo.Context) error {
 		}
 	}
 	input, p := c.newIO()
-	err := input.Create(ctx.Request().Context(), port.NoteCreateInput{
+	err = input.Create(ctx.Request().Context(), port.NoteCreateInput{
 		Title:      body.Title,
 		TemplateID: body.TemplateId.String(),
 		OwnerID:    ownerID,
 		Sections:   sections,
+		Tags:       valuesOrEmpty(body.Tags),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// maxMarkdownBytes caps the size of an imported Markdown document.
+const maxMarkdownBytes = 1 << 20
+
+// ExportMarkdown handles GET /notes/:id/markdown.
+func (c *NoteController) ExportMarkdown(ctx echo.Context, noteID string) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.ExportMarkdown(ctx.Request().Context(), noteID, viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	filename, markdown := p.Markdown()
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	return ctx.Blob(http.StatusOK, "text/markdown; charset=utf-8", []byte(markdown))
+}
+
+// ImportMarkdown handles POST /notes/import with a text/markdown body.
+func (c *NoteController) ImportMarkdown(ctx echo.Context, params openapi.NotesImportNoteMarkdownParams) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxMarkdownBytes+1))
+	if err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	if len(body) > maxMarkdownBytes {
+		return badRequest(ctx, "markdown document is too large")
+	}
+	input, p := c.newIO()
+	err = input.ImportMarkdown(ctx.Request().Context(), port.NoteImportMarkdownInput{
+		TemplateID: params.TemplateId,
+		OwnerID:    ownerID,
+		Markdown:   string(body),
 	})
 	if err != nil {
 		return handleError(ctx, err)
@@ -105,11 +214,15 @@ func (c *NoteController) Create(ctx echo.Context) error {
 func (c *NoteController) Update(ctx echo.Context, noteID string, params openapi.NotesUpdateNoteParams) error {
 	var body openapi.ModelsUpdateNoteRequest
 	if err := ctx.Bind(&body); err != nil {
-		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+		return badRequest(ctx, "invalid body")
+	}
+	version, ok := expectedVersion(params.IfMatch, body.Version)
+	if !ok {
+		return badRequest(ctx, "invalid If-Match header")
 	}
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrUnauthorized)
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	sections := make([]port.SectionUpdateInput, 0, len(body.Sections))
 	for _, s := range body.Sections {
@@ -119,11 +232,48 @@ func (c *NoteController) Update(ctx echo.Context, noteID string, params openapi.
 		})
 	}
 	input, p := c.newIO()
-	err := input.Update(ctx.Request().Context(), port.NoteUpdateInput{
+	err = input.Update(ctx.Request().Context(), port.NoteUpdateInput{
+		ID:         noteID,
+		Title:      body.Title,
+		OwnerID:    ownerID,
+		Sections:   sections,
+		AddTags:    valuesOrEmpty(body.AddTags),
+		RemoveTags: valuesOrEmpty(body.RemoveTags),
+		Version:    version,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// MigrateTemplate handles POST /notes/:id/migrate-template.
+func (c *NoteController) MigrateTemplate(ctx echo.Context, noteID string, params openapi.NotesMigrateNoteTemplateParams) error {
+	var body openapi.ModelsMigrateNoteTemplateRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	version, ok := expectedVersion(params.IfMatch, body.Version)
+	if !ok {
+		return badRequest(ctx, "invalid If-Match header")
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	mappings := make([]note.FieldMapping, 0, len(body.Mappings))
+	for _, m := range body.Mappings {
+		mappings = append(mappings, note.FieldMapping{
+			FromFieldID: m.FromFieldId,
+			ToFieldID:   m.ToFieldId,
+		})
+	}
+	input, p := c.newIO()
+	err = input.MigrateTemplate(ctx.Request().Context(), port.NoteMigrateTemplateInput{
 		ID:       noteID,
-		Title:    body.Title,
 		OwnerID:  ownerID,
-		Sections: sections,
+		Mappings: mappings,
+		Version:  version,
 	})
 	if err != nil {
 		return handleError(ctx, err)
@@ -133,10 +283,10 @@ func (c *NoteController) Update(ctx echo.Context, noteID string, params openapi.
 
 // Delete handles deleting a note.
 // Delete handles DELETE /notes/:id.
-func (c *NoteController) Delete(ctx echo.Context, noteID string, params openapi.NotesDeleteNoteParams) error {
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrUnauthorized)
+func (c *NoteController) Delete(ctx echo.Context, noteID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	input, p := c.newIO()
 	if err := input.Delete(ctx.Request().Context(), noteID, ownerID); err != nil {
@@ -145,15 +295,63 @@ func (c *NoteController) Delete(ctx echo.Context, noteID string, params openapi.
 	return ctx.JSON(http.StatusOK, p.DeleteResponse())
 }
 
+// ListTrash handles GET /notes/trash.
+func (c *NoteController) ListTrash(ctx echo.Context, params openapi.NotesListTrashedNotesParams) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	after, limit, err := pageParams(params.Cursor, params.Limit)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.ListTrash(ctx.Request().Context(), trash.Filters{
+		OwnerID: ownerID,
+		Limit:   limit,
+		After:   after,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Notes())
+}
+
+// Restore handles POST /notes/:id/restore.
+func (c *NoteController) Restore(ctx echo.Context, noteID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Restore(ctx.Request().Context(), noteID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Note())
+}
+
+// Purge handles DELETE /notes/trash/:id.
+func (c *NoteController) Purge(ctx echo.Context, noteID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Purge(ctx.Request().Context(), noteID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
 // Publish handles publishing a note.
 // Publish handles POST /notes/:id/publish.
-func (c *NoteController) Publish(ctx echo.Context, noteID string, params openapi.NotesPublishNoteParams) error {
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrOwnerRequired)
+func (c *NoteController) Publish(ctx echo.Context, noteID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	input, p := c.newIO()
-	err := input.ChangeStatus(ctx.Request().Context(), port.NoteStatusChangeInput{
+	err = input.ChangeStatus(ctx.Request().Context(), port.NoteStatusChangeInput{
 		ID:      noteID,
 		Status:  note.StatusPublish,
 		OwnerID: ownerID,
@@ -166,13 +364,13 @@ func (c *NoteController) Publish(ctx echo.Context, noteID string, params openapi
 
 // Unpublish handles unpublishing a note.
 // Unpublish handles POST /notes/:id/unpublish.
-func (c *NoteController) Unpublish(ctx echo.Context, noteID string, params openapi.NotesUnpublishNoteParams) error {
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrOwnerRequired)
+func (c *NoteController) Unpublish(ctx echo.Context, noteID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	input, p := c.newIO()
-	err := input.ChangeStatus(ctx.Request().Context(), port.NoteStatusChangeInput{
+	err = input.ChangeStatus(ctx.Request().Context(), port.NoteStatusChangeInput{
 		ID:      noteID,
 		Status:  note.StatusDraft,
 		OwnerID: ownerID,
@@ -185,6 +383,25 @@ func (c *NoteController) Unpublish(ctx echo.Context, noteID string, params opena
 
 func (c *NoteController) newIO() (port.NoteInputPort, *presenter.NotePresenter) {
 	output := c.outputFactory()
-	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.txFactory(), output)
+	input := c.inputFactory(c.noteRepoFactory(), c.tplRepoFactory(), c.revRepoFactory(), c.linkRepoFactory(), c.txFactory(), c.eventsFactory(), c.auditFactory(), output)
 	return input, output
 }
+
+// tagFilters converts the repeated tags query parameter.
+func tagFilters(tags *[]string) []note.Tag {
+	if tags == nil {
+		return nil
+	}
+	res := make([]note.Tag, 0, len(*tags))
+	for _, t := range *tags {
+		res = append(res, note.Tag(t))
+	}
+	return res
+}
+
+func tagMatch(m *openapi.ModelsTagMatch) note.TagMatch {
+	if m == nil {
+		return ""
+	}
+	return note.TagMatch(*m)
+}
@@ -4,30 +4,44 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
+	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc"
 	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/port"
+	"immortal-architecture-clean/backend/internal/usecase"
 )
 
 func TestNoteController_Create(t *testing.T) {
 	tests := []struct {
 		name       string
+		accountID  string
 		body       string
 		wantStatus int
 		wantBody   string
 	}{
 		{
 			name:       "[Success] create note",
-			body:       `{"title":"Hello","templateId":"00000000-0000-0000-0000-000000000001","ownerId":"00000000-0000-0000-0000-000000000002","sections":[{"fieldId":"f1","content":"c1"}]}`,
+			accountID:  "00000000-0000-0000-0000-000000000002",
+			body:       `{"title":"Hello","templateId":"00000000-0000-0000-0000-000000000001","sections":[{"fieldId":"f1","content":"c1"}]}`,
 			wantStatus: http.StatusOK,
 		},
+		{
+			name:       "[Fail] unauthenticated",
+			body:       `{"title":"Hello","templateId":"00000000-0000-0000-0000-000000000001","sections":[]}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   domainerr.ErrUnauthenticated.Error(),
+		},
 		{
 			name:       "[Fail] bind error",
 			body:       `not-json`,
@@ -41,18 +55,22 @@ func TestNoteController_Create(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodPost, "/api/notes", bytes.NewBufferString(tt.body))
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes", bytes.NewBufferString(tt.body)), tt.accountID)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -64,15 +82,27 @@ func TestNoteController_Create(t *testing.T) {
 }
 
 func TestNoteController_List(t *testing.T) {
+	after := page.Cursor{UpdatedAt: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), ID: "00000000-0000-0000-0000-000000000001"}
+	cursor := after.Encode()
+	badCursor := "not-a-cursor"
+	limit := int32(10)
+	tags := []string{"auth", "api"}
+	matchAll := openapi.ModelsTagMatchAll
 	tests := []struct {
 		name       string
 		filters    openapi.NotesListNotesParams
+		accountID  string
 		inErr      error
 		wantStatus int
 		wantBody   string
 	}{
-		{name: "[Success] list notes", filters: openapi.NotesListNotesParams{}, wantStatus: http.StatusOK},
-		{name: "[Fail] repo error", filters: openapi.NotesListNotesParams{}, inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+		{name: "[Success] list notes", filters: openapi.NotesListNotesParams{}, accountID: "viewer", wantStatus: http.StatusOK, wantBody: `"hasMore":false`},
+		{name: "[Success] next page", filters: openapi.NotesListNotesParams{Cursor: &cursor, Limit: &limit}, accountID: "viewer", wantStatus: http.StatusOK},
+		{name: "[Success] tag filters", filters: openapi.NotesListNotesParams{Tags: &tags, TagMatch: &matchAll}, accountID: "viewer", wantStatus: http.StatusOK, wantBody: `"tags":[]`},
+		{name: "[Fail] invalid tag match", filters: openapi.NotesListNotesParams{Tags: &tags}, accountID: "viewer", inErr: domainerr.ErrInvalidTagMatch, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidTagMatch.Error()},
+		{name: "[Fail] invalid cursor", filters: openapi.NotesListNotesParams{Cursor: &badCursor}, accountID: "viewer", wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidCursor.Error()},
+		{name: "[Fail] unauthenticated", filters: openapi.NotesListNotesParams{}, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] repo error", filters: openapi.NotesListNotesParams{}, accountID: "viewer", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
 	}
 
 	for _, tt := range tests {
@@ -81,33 +111,97 @@ func TestNoteController_List(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Notes: []note.WithMeta{{Note: note.Note{ID: "n1"}}}, Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
-			req := httptest.NewRequest(http.MethodGet, "/api/notes", nil)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes", nil), tt.accountID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			_ = ctrl.List(c, tt.filters)
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.filters.Cursor == &cursor && (input.Filters.After == nil || *input.Filters.After != after || input.Filters.Limit != int(limit)) {
+				t.Fatalf("paging not passed: %+v", input.Filters)
+			}
+			if tt.filters.TagMatch != nil && (len(input.Filters.Tags) != 2 || !input.Filters.MatchesAllTags()) {
+				t.Fatalf("tag filters not passed: %+v", input.Filters)
+			}
 		})
 	}
 }
 
+func TestNoteController_Search(t *testing.T) {
+	in := []openapi.ModelsNoteSearchTarget{openapi.ModelsNoteSearchTargetContent, openapi.ModelsNoteSearchTargetLabel}
+	tests := []struct {
+		name       string
+		params     openapi.NotesSearchNotesParams
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] search notes", params: openapi.NotesSearchNotesParams{Q: "設計", In: &in}, accountID: "viewer", wantStatus: http.StatusOK, wantBody: `"snippet":"設計メモ"`},
+		{name: "[Fail] unauthenticated", params: openapi.NotesSearchNotesParams{Q: "設計"}, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] query missing", params: openapi.NotesSearchNotesParams{}, accountID: "viewer", inErr: domainerr.ErrSearchQueryRequired, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrSearchQueryRequired.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{
+				Results: []note.SearchResult{{
+					Note:    note.WithMeta{Note: note.Note{ID: "n1"}},
+					Rank:    2,
+					Snippet: note.Snippet{Text: "設計メモ", Highlights: []note.Highlight{{Start: 0, End: 2}}},
+				}},
+				Err: tt.inErr,
+			}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/search", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Search(c, tt.params)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.params.In != nil && len(input.Filters.SearchIn) != len(*tt.params.In) {
+				t.Fatalf("search targets not passed: %+v", input.Filters)
+			}
+		})
+	}
+}
 func TestNoteController_Get(t *testing.T) {
 	tests := []struct {
 		name       string
+		accountID  string
 		inErr      error
 		wantStatus int
 		wantBody   string
 	}{
-		{name: "[Success] get note", wantStatus: http.StatusOK},
-		{name: "[Fail] not found", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+		{name: "[Success] get note", accountID: "viewer", wantStatus: http.StatusOK},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not found", accountID: "viewer", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
 	}
 
 	for _, tt := range tests {
@@ -116,16 +210,20 @@ func TestNoteController_Get(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
-			req := httptest.NewRequest(http.MethodGet, "/api/notes/n1", nil)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1", nil), tt.accountID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			_ = ctrl.GetByID(c, "n1")
@@ -134,17 +232,212 @@ func TestNoteController_Get(t *testing.T) {
 	}
 }
 
+func TestNoteController_Get_MalformedID(t *testing.T) {
+	e := echo.New()
+	ctrl := NewNoteController(
+		func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+			return usecase.NewNoteInteractor(noteRepo, tplRepo, revRepo, linkRepo, tx, events, auditLog, output)
+		},
+		presenter.NewNotePresenter,
+		// The ID is rejected before the repository touches the database.
+		func() port.NoteRepository { return sqlc.NewNoteRepository(nil) },
+		func() port.TemplateRepository { return nil },
+		func() port.NoteRevisionRepository { return nil },
+		func() port.NoteLinkRepository { return nil },
+		func() port.TxManager { return nil },
+		func() port.EventPublisher { return nil },
+		func() port.AuditRecorder { return nil },
+	)
+	req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/not-a-uuid", nil), "viewer")
+	rec := httptest.NewRecorder()
+	_ = ctrl.GetByID(e.NewContext(req, rec), "not-a-uuid")
+	assertStatusBody(t, rec, http.StatusNotFound, domainerr.ErrNotFound.Code)
+}
+
 func TestNoteController_Update(t *testing.T) {
+	const body = `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`
+	tests := []struct {
+		name        string
+		body        string
+		ifMatch     string
+		accountID   string
+		inErr       error
+		wantStatus  int
+		wantBody    string
+		wantVersion *int
+	}{
+		{name: "[Success] update note", body: body, accountID: "owner", wantStatus: http.StatusOK},
+		{name: "[Success] version from If-Match", body: body, ifMatch: `"3"`, accountID: "owner", wantStatus: http.StatusOK, wantVersion: intPtr(3)},
+		{name: "[Success] version from body", body: `{"title":"New","sections":[],"version":4}`, accountID: "owner", wantStatus: http.StatusOK, wantVersion: intPtr(4)},
+		{name: "[Success] If-Match wins over body", body: `{"title":"New","sections":[],"version":4}`, ifMatch: "5", accountID: "owner", wantStatus: http.StatusOK, wantVersion: intPtr(5)},
+		{name: "[Success] If-Match any", body: `{"title":"New","sections":[],"version":4}`, ifMatch: "*", accountID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] invalid If-Match", body: body, ifMatch: `W/"abc"`, accountID: "owner", wantStatus: http.StatusBadRequest, wantBody: "invalid If-Match header"},
+		{name: "[Fail] version conflict", body: body, ifMatch: `"3"`, accountID: "owner", inErr: domainerr.ErrConflict, wantStatus: http.StatusConflict, wantBody: "CONFLICT"},
+		{name: "[Fail] unauthenticated", body: body, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/notes/n1", bytes.NewBufferString(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			var params openapi.NotesUpdateNoteParams
+			if tt.ifMatch != "" {
+				params.IfMatch = &tt.ifMatch
+			}
+			_ = ctrl.Update(c, "n1", params)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && !reflect.DeepEqual(input.Updated.Version, tt.wantVersion) {
+				t.Fatalf("version = %v, want %v", input.Updated.Version, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestNoteController_MigrateTemplate(t *testing.T) {
+	const body = `{"mappings":[{"fromFieldId":"f1","toFieldId":"f2"}]}`
+	tests := []struct {
+		name         string
+		body         string
+		ifMatch      string
+		accountID    string
+		inErr        error
+		wantStatus   int
+		wantBody     string
+		wantVersion  *int
+		wantMappings []note.FieldMapping
+	}{
+		{name: "[Success] migrate note", body: body, accountID: "owner", wantStatus: http.StatusOK, wantMappings: []note.FieldMapping{{FromFieldID: "f1", ToFieldID: "f2"}}},
+		{name: "[Success] If-Match wins over body", body: `{"mappings":[],"version":4}`, ifMatch: `"5"`, accountID: "owner", wantStatus: http.StatusOK, wantVersion: intPtr(5), wantMappings: []note.FieldMapping{}},
+		{name: "[Fail] invalid mapping", body: body, accountID: "owner", inErr: domainerr.ErrInvalidFieldMapping, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidFieldMapping.Error()},
+		{name: "[Fail] version conflict", body: body, ifMatch: `"3"`, accountID: "owner", inErr: domainerr.ErrConflict, wantStatus: http.StatusConflict, wantBody: "CONFLICT"},
+		{name: "[Fail] unauthenticated", body: body, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/migrate-template", bytes.NewBufferString(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			var params openapi.NotesMigrateNoteTemplateParams
+			if tt.ifMatch != "" {
+				params.IfMatch = &tt.ifMatch
+			}
+			_ = ctrl.MigrateTemplate(c, "n1", params)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if !reflect.DeepEqual(input.Migrated.Version, tt.wantVersion) {
+				t.Fatalf("version = %v, want %v", input.Migrated.Version, tt.wantVersion)
+			}
+			if !reflect.DeepEqual(input.Migrated.Mappings, tt.wantMappings) {
+				t.Fatalf("mappings = %+v, want %+v", input.Migrated.Mappings, tt.wantMappings)
+			}
+		})
+	}
+}
+
+func TestNoteController_ExportMarkdown(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] export markdown", accountID: "viewer", wantStatus: http.StatusOK, wantBody: "# n1"},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not found", accountID: "viewer", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/markdown", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.ExportMarkdown(c, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got := rec.Header().Get(echo.HeaderContentType); got != "text/markdown; charset=utf-8" {
+				t.Fatalf("content type = %q", got)
+			}
+			if got := rec.Header().Get(echo.HeaderContentDisposition); got != `attachment; filename=n1.md` {
+				t.Fatalf("content disposition = %q", got)
+			}
+		})
+	}
+}
+
+func TestNoteController_ImportMarkdown(t *testing.T) {
 	tests := []struct {
 		name       string
+		accountID  string
 		body       string
-		params     openapi.NotesUpdateNoteParams
 		inErr      error
 		wantStatus int
 		wantBody   string
 	}{
-		{name: "[Success] update note", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, params: openapi.NotesUpdateNoteParams{OwnerId: "owner"}, wantStatus: http.StatusOK},
-		{name: "[Fail] missing owner", body: `{"title":"New","sections":[{"id":"sec1","content":"c"}]}`, params: openapi.NotesUpdateNoteParams{OwnerId: ""}, wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Success] import markdown", accountID: "owner", body: "# Hello\n## Problem\nx\n", wantStatus: http.StatusOK, wantBody: `"id":"imported"`},
+		{name: "[Fail] unauthenticated", body: "# Hello\n", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] too large", accountID: "owner", body: strings.Repeat("a", maxMarkdownBytes+1), wantStatus: http.StatusBadRequest, wantBody: "markdown document is too large"},
+		{name: "[Fail] unmatched heading", accountID: "owner", body: "# Hello\n## Risks\n", inErr: domainerr.ErrUnmatchedHeading, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrUnmatchedHeading.Error()},
+		{name: "[Fail] missing heading", accountID: "owner", body: "# Hello\n", inErr: domainerr.ErrMissingHeading, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrMissingHeading.Error()},
 	}
 
 	for _, tt := range tests {
@@ -153,21 +446,31 @@ func TestNoteController_Update(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
-			req := httptest.NewRequest(http.MethodPut, "/api/notes/n1", bytes.NewBufferString(tt.body))
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/import", strings.NewReader(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, "text/markdown")
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			_ = ctrl.Update(c, "n1", tt.params)
+			_ = ctrl.ImportMarkdown(c, openapi.NotesImportNoteMarkdownParams{TemplateId: "tpl-1"})
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK {
+				want := port.NoteImportMarkdownInput{TemplateID: "tpl-1", OwnerID: "owner", Markdown: tt.body}
+				if input.Imported != want {
+					t.Fatalf("input = %+v, want %+v", input.Imported, want)
+				}
+			}
 		})
 	}
 }
@@ -181,7 +484,7 @@ func TestNoteController_Publish(t *testing.T) {
 		wantBody   string
 	}{
 		{name: "[Success] publish note", ownerID: "owner", wantStatus: http.StatusOK},
-		{name: "[Fail] publish missing owner", ownerID: "", wantStatus: http.StatusInternalServerError, wantBody: domainerr.ErrOwnerRequired.Error()},
+		{name: "[Fail] publish unauthenticated", ownerID: "", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
 		{name: "[Fail] publish forbidden", ownerID: "owner", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
 	}
 
@@ -191,19 +494,23 @@ func TestNoteController_Publish(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
-			req := httptest.NewRequest(http.MethodPost, "/api/notes/n1/publish", nil)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/publish", nil), tt.ownerID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			_ = ctrl.Publish(c, "n1", openapi.NotesPublishNoteParams{OwnerId: tt.ownerID})
+			_ = ctrl.Publish(c, "n1")
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
 		})
 	}
@@ -218,7 +525,7 @@ func TestNoteController_Unpublish(t *testing.T) {
 		wantBody   string
 	}{
 		{name: "[Success] unpublish note", ownerID: "owner", wantStatus: http.StatusOK},
-		{name: "[Fail] unpublish missing owner", ownerID: "", wantStatus: http.StatusInternalServerError, wantBody: domainerr.ErrOwnerRequired.Error()},
+		{name: "[Fail] unpublish unauthenticated", ownerID: "", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
 		{name: "[Fail] unpublish forbidden", ownerID: "owner", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
 		{name: "[Fail] unpublish not found", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
 	}
@@ -229,19 +536,23 @@ func TestNoteController_Unpublish(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
-			req := httptest.NewRequest(http.MethodPost, "/api/notes/n1/unpublish", nil)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/unpublish", nil), tt.ownerID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			_ = ctrl.Unpublish(c, "n1", openapi.NotesUnpublishNoteParams{OwnerId: tt.ownerID})
+			_ = ctrl.Unpublish(c, "n1")
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
 		})
 	}
@@ -256,7 +567,7 @@ func TestNoteController_Delete(t *testing.T) {
 		wantBody   string
 	}{
 		{name: "[Success] delete", ownerID: "owner", wantStatus: http.StatusOK},
-		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden, wantBody: domainerr.ErrUnauthorized.Error()},
+		{name: "[Fail] unauthenticated", ownerID: "", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
 		{name: "[Fail] not found", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
 	}
 
@@ -266,19 +577,159 @@ func TestNoteController_Delete(t *testing.T) {
 			p := presenter.NewNotePresenter()
 			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
 			ctrl := NewNoteController(
-				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/notes/n1", nil), tt.ownerID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Delete(c, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestNoteController_ListTrash(t *testing.T) {
+	after := page.Cursor{UpdatedAt: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), ID: "00000000-0000-0000-0000-000000000001"}
+	cursor := after.Encode()
+	badCursor := "not-a-cursor"
+	limit := int32(10)
+	tests := []struct {
+		name       string
+		params     openapi.NotesListTrashedNotesParams
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list own trash", params: openapi.NotesListTrashedNotesParams{}, accountID: "owner", wantStatus: http.StatusOK, wantBody: `"deletedAt"`},
+		{name: "[Success] next page", params: openapi.NotesListTrashedNotesParams{Cursor: &cursor, Limit: &limit}, accountID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] invalid cursor", params: openapi.NotesListTrashedNotesParams{Cursor: &badCursor}, accountID: "owner", wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidCursor.Error()},
+		{name: "[Fail] unauthenticated", params: openapi.NotesListTrashedNotesParams{}, wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			deletedAt := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+			input := &ctrlmock.NoteInputStub{Notes: []note.WithMeta{{Note: note.Note{ID: "n1", DeletedAt: &deletedAt}}}, Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/trash", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.ListTrash(c, tt.params)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && input.Trash.OwnerID != tt.accountID {
+				t.Fatalf("owner not scoped to the current account: %+v", input.Trash)
+			}
+			if tt.params.Cursor == &cursor && (input.Trash.After == nil || *input.Trash.After != after || input.Trash.Limit != int(limit)) {
+				t.Fatalf("paging not passed: %+v", input.Trash)
+			}
+		})
+	}
+}
+
+func TestNoteController_Restore(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] restore", ownerID: "owner", wantStatus: http.StatusOK, wantBody: `"id":"n1"`},
+		{name: "[Fail] unauthenticated", ownerID: "", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not in trash", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.NotePresenter { return p },
+				func() port.NoteRepository { return nil },
+				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/restore", nil), tt.ownerID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Restore(c, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestNoteController_Purge(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] purge", ownerID: "owner", wantStatus: http.StatusOK, wantBody: `"success":true`},
+		{name: "[Fail] unauthenticated", ownerID: "", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not in trash", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewNotePresenter()
+			input := &ctrlmock.NoteInputStub{Err: tt.inErr}
+			ctrl := NewNoteController(
+				func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.NotePresenter { return p },
 				func() port.NoteRepository { return nil },
 				func() port.TemplateRepository { return nil },
+				func() port.NoteRevisionRepository { return nil },
+				func() port.NoteLinkRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
-			req := httptest.NewRequest(http.MethodDelete, "/api/notes/n1", nil)
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/notes/trash/n1", nil), tt.ownerID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			_ = ctrl.Delete(c, "n1", openapi.NotesDeleteNoteParams{OwnerId: tt.ownerID})
+			_ = ctrl.Purge(c, "n1")
 			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
 		})
 	}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteLinkController handles note link endpoints.
+type NoteLinkController struct {
+	inputFactory    func(noteRepo port.NoteRepository, linkRepo port.NoteLinkRepository, output port.NoteLinkOutputPort) port.NoteLinkInputPort
+	outputFactory   func() *presenter.NoteLinkPresenter
+	noteRepoFactory func() port.NoteRepository
+	linkRepoFactory func() port.NoteLinkRepository
+}
+
+// NewNoteLinkController creates NoteLinkController.
+func NewNoteLinkController(
+	inputFactory func(noteRepo port.NoteRepository, linkRepo port.NoteLinkRepository, output port.NoteLinkOutputPort) port.NoteLinkInputPort,
+	outputFactory func() *presenter.NoteLinkPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	linkRepoFactory func() port.NoteLinkRepository,
+) *NoteLinkController {
+	return &NoteLinkController{
+		inputFactory:    inputFactory,
+		outputFactory:   outputFactory,
+		noteRepoFactory: noteRepoFactory,
+		linkRepoFactory: linkRepoFactory,
+	}
+}
+
+// List handles GET /notes/:id/links.
+func (c *NoteLinkController) List(ctx echo.Context, noteID string) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), noteID, viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Links())
+}
+
+// Create handles POST /notes/:id/links.
+func (c *NoteLinkController) Create(ctx echo.Context, noteID string) error {
+	var body openapi.ModelsCreateNoteLinkRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Create(ctx.Request().Context(), port.NoteLinkCreateInput{
+		SourceID: noteID,
+		TargetID: body.TargetNoteId.String(),
+		Type:     note.LinkType(body.Type),
+		ActorID:  actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Link())
+}
+
+// Delete handles DELETE /notes/:id/links/:linkId.
+func (c *NoteLinkController) Delete(ctx echo.Context, noteID, linkID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Delete(ctx.Request().Context(), noteID, linkID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+func (c *NoteLinkController) newIO() (port.NoteLinkInputPort, *presenter.NoteLinkPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.noteRepoFactory(), c.linkRepoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newNoteLinkController(input *ctrlmock.NoteLinkInputStub) *NoteLinkController {
+	return NewNoteLinkController(
+		func(noteRepo port.NoteRepository, linkRepo port.NoteLinkRepository, output port.NoteLinkOutputPort) port.NoteLinkInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewNoteLinkPresenter,
+		func() port.NoteRepository { return nil },
+		func() port.NoteLinkRepository { return nil },
+	)
+}
+
+func TestNoteLinkController_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list links", accountID: "viewer", wantStatus: http.StatusOK, wantBody: `"backlinks":[{"id":"l2"`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not found", accountID: "viewer", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteLinkInputStub{
+				Outgoing:  []note.LinkSummary{{LinkID: "l1", Type: note.LinkRelatesTo, NoteID: "n2"}},
+				Backlinks: []note.LinkSummary{{LinkID: "l2", Type: note.LinkSupersedes, NoteID: "n3"}},
+				Err:       tt.inErr,
+			}
+			ctrl := newNoteLinkController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/links", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.List(c, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestNoteLinkController_Create(t *testing.T) {
+	const target = "00000000-0000-0000-0000-000000000002"
+	tests := []struct {
+		name       string
+		accountID  string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "[Success] create link",
+			accountID:  "owner",
+			body:       `{"targetNoteId":"` + target + `","type":"depends-on"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"type":"depends-on"`,
+		},
+		{
+			name:       "[Fail] bind error",
+			body:       `not-json`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid body",
+		},
+		{
+			name:       "[Fail] unauthenticated",
+			body:       `{"targetNoteId":"` + target + `","type":"depends-on"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   domainerr.ErrUnauthenticated.Error(),
+		},
+		{
+			name:       "[Fail] invalid link type",
+			accountID:  "owner",
+			body:       `{"targetNoteId":"` + target + `","type":"blocks"}`,
+			inErr:      domainerr.ErrInvalidLinkType,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domainerr.ErrInvalidLinkType.Error(),
+		},
+		{
+			name:       "[Fail] not owner",
+			accountID:  "intruder",
+			body:       `{"targetNoteId":"` + target + `","type":"depends-on"}`,
+			inErr:      domainerr.ErrUnauthorized,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteLinkInputStub{Err: tt.inErr}
+			ctrl := newNoteLinkController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/notes/n1/links", bytes.NewBufferString(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Create(c, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && (input.Created.SourceID != "n1" || input.Created.TargetID != target || input.Created.ActorID != tt.accountID) {
+				t.Fatalf("unexpected input: %+v", input.Created)
+			}
+		})
+	}
+}
+
+func TestNoteLinkController_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] delete link", accountID: "owner", wantStatus: http.StatusOK, wantBody: `"success":true`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not found", accountID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newNoteLinkController(&ctrlmock.NoteLinkInputStub{Err: tt.inErr})
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/notes/n1/links/l1", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Delete(c, "n1", "l1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteRevisionController handles note revision history endpoints.
+type NoteRevisionController struct {
+	inputFactory    func(noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, output port.NoteRevisionOutputPort) port.NoteRevisionInputPort
+	outputFactory   func() *presenter.NoteRevisionPresenter
+	noteRepoFactory func() port.NoteRepository
+	revRepoFactory  func() port.NoteRevisionRepository
+}
+
+// NewNoteRevisionController creates NoteRevisionController.
+func NewNoteRevisionController(
+	inputFactory func(noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, output port.NoteRevisionOutputPort) port.NoteRevisionInputPort,
+	outputFactory func() *presenter.NoteRevisionPresenter,
+	noteRepoFactory func() port.NoteRepository,
+	revRepoFactory func() port.NoteRevisionRepository,
+) *NoteRevisionController {
+	return &NoteRevisionController{
+		inputFactory:    inputFactory,
+		outputFactory:   outputFactory,
+		noteRepoFactory: noteRepoFactory,
+		revRepoFactory:  revRepoFactory,
+	}
+}
+
+// List handles GET /notes/:id/revisions.
+func (c *NoteRevisionController) List(ctx echo.Context, noteID string) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), noteID, viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Revisions())
+}
+
+// Get handles GET /notes/:id/revisions/:revision.
+func (c *NoteRevisionController) Get(ctx echo.Context, noteID string, revision int32) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Get(ctx.Request().Context(), noteID, int(revision), viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Revision())
+}
+
+// Diff handles GET /notes/:id/revisions/:revision/diff.
+func (c *NoteRevisionController) Diff(ctx echo.Context, noteID string, revision int32, params openapi.NotesDiffNoteRevisionParams) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	base := 0
+	if params.Base != nil {
+		base = int(*params.Base)
+	}
+	input, p := c.newIO()
+	if err := input.Diff(ctx.Request().Context(), noteID, int(revision), base, viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Diff())
+}
+
+func (c *NoteRevisionController) newIO() (port.NoteRevisionInputPort, *presenter.NoteRevisionPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.noteRepoFactory(), c.revRepoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newNoteRevisionController(input *ctrlmock.NoteRevisionInputStub) *NoteRevisionController {
+	return NewNoteRevisionController(
+		func(noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, output port.NoteRevisionOutputPort) port.NoteRevisionInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewNoteRevisionPresenter,
+		func() port.NoteRepository { return nil },
+		func() port.NoteRevisionRepository { return nil },
+	)
+}
+
+func TestNoteRevisionController_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list revisions", accountID: "viewer", wantStatus: http.StatusOK, wantBody: `"revision":1`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not found", accountID: "viewer", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteRevisionInputStub{Revisions: []note.Revision{{NoteID: "n1", Number: 1}}, Err: tt.inErr}
+			ctrl := newNoteRevisionController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/revisions", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.List(c, "n1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestNoteRevisionController_Get(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] get revision", accountID: "viewer", wantStatus: http.StatusOK, wantBody: `"revision":3`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] not found", accountID: "viewer", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newNoteRevisionController(&ctrlmock.NoteRevisionInputStub{Err: tt.inErr})
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/revisions/3", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Get(c, "n1", 3)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestNoteRevisionController_Diff(t *testing.T) {
+	base := int32(1)
+	tests := []struct {
+		name       string
+		accountID  string
+		params     openapi.NotesDiffNoteRevisionParams
+		wantBase   int
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] diff against previous", accountID: "viewer", wantStatus: http.StatusOK, wantBody: `"to":3`},
+		{name: "[Success] diff against explicit base", accountID: "viewer", params: openapi.NotesDiffNoteRevisionParams{Base: &base}, wantBase: 1, wantStatus: http.StatusOK, wantBody: `"from":1`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.NoteRevisionInputStub{}
+			ctrl := newNoteRevisionController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/notes/n1/revisions/3/diff", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Diff(c, "n1", 3, tt.params)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && input.DiffBase != tt.wantBase {
+				t.Fatalf("base = %d, want %d", input.DiffBase, tt.wantBase)
+			}
+		})
+	}
+}
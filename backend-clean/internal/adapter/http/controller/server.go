@@ -8,14 +8,22 @@ import (
 
 // Server implements the OpenAPI ServerInterface by delegating to domain-specific controllers.
 type Server struct {
-	account  *AccountController
-	note     *NoteController
-	template *TemplateController
+	account      *AccountController
+	note         *NoteController
+	noteRevision *NoteRevisionController
+	noteLink     *NoteLinkController
+	template     *TemplateController
+	webhook      *WebhookController
+	audit        *AuditController
+	tag          *TagController
+	comment      *CommentController
+	collaborator *NoteCollaboratorController
+	workspace    *WorkspaceController
 }
 
 // NewServer wires controller dependencies to generated ServerInterface.
-func NewServer(ac *AccountController, nc *NoteController, tc *TemplateController) *Server {
-	return &Server{account: ac, note: nc, template: tc}
+func NewServer(ac *AccountController, nc *NoteController, nrc *NoteRevisionController, nlc *NoteLinkController, tc *TemplateController, wc *WebhookController, auc *AuditController, tgc *TagController, cc *CommentController, ncc *NoteCollaboratorController, wsc *WorkspaceController) *Server {
+	return &Server{account: ac, note: nc, noteRevision: nrc, noteLink: nlc, template: tc, webhook: wc, audit: auc, tag: tgc, comment: cc, collaborator: ncc, workspace: wsc}
 }
 
 // AccountsCreateOrGetAccount handles POST /api/accounts/auth.
@@ -43,14 +51,19 @@ func (s *Server) NotesListNotes(ctx echo.Context, params openapi.NotesListNotesP
 	return s.note.List(ctx, params)
 }
 
+// NotesSearchNotes handles GET /api/notes/search.
+func (s *Server) NotesSearchNotes(ctx echo.Context, params openapi.NotesSearchNotesParams) error {
+	return s.note.Search(ctx, params)
+}
+
 // NotesCreateNote handles POST /api/notes.
 func (s *Server) NotesCreateNote(ctx echo.Context) error {
 	return s.note.Create(ctx)
 }
 
 // NotesDeleteNote handles DELETE /api/notes/:id.
-func (s *Server) NotesDeleteNote(ctx echo.Context, noteId string, params openapi.NotesDeleteNoteParams) error { //nolint:revive
-	return s.note.Delete(ctx, noteId, params)
+func (s *Server) NotesDeleteNote(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.note.Delete(ctx, noteId)
 }
 
 // NotesGetNoteById handles GET /api/notes/:id.
@@ -64,16 +77,76 @@ func (s *Server) NotesUpdateNote(ctx echo.Context, noteId string, params openapi
 	return s.note.Update(ctx, noteId, params)
 }
 
+// NotesExportNoteMarkdown handles GET /api/notes/:noteId/markdown.
+func (s *Server) NotesExportNoteMarkdown(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.note.ExportMarkdown(ctx, noteId)
+}
+
+// NotesImportNoteMarkdown handles POST /api/notes/import.
+func (s *Server) NotesImportNoteMarkdown(ctx echo.Context, params openapi.NotesImportNoteMarkdownParams) error {
+	return s.note.ImportMarkdown(ctx, params)
+}
+
+// NotesMigrateNoteTemplate handles POST /api/notes/:noteId/migrate-template.
+func (s *Server) NotesMigrateNoteTemplate(ctx echo.Context, noteId string, params openapi.NotesMigrateNoteTemplateParams) error { //nolint:revive
+	return s.note.MigrateTemplate(ctx, noteId, params)
+}
+
 // NotesPublishNote handles POST /api/notes/:noteId/publish.
 // NotesPublishNote handles POST /api/notes/:id/publish.
-func (s *Server) NotesPublishNote(ctx echo.Context, noteId string, params openapi.NotesPublishNoteParams) error { //nolint:revive
-	return s.note.Publish(ctx, noteId, params)
+func (s *Server) NotesPublishNote(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.note.Publish(ctx, noteId)
 }
 
 // NotesUnpublishNote handles POST /api/notes/:noteId/unpublish.
 // NotesUnpublishNote handles POST /api/notes/:id/unpublish.
-func (s *Server) NotesUnpublishNote(ctx echo.Context, noteId string, params openapi.NotesUnpublishNoteParams) error { //nolint:revive
-	return s.note.Unpublish(ctx, noteId, params)
+func (s *Server) NotesUnpublishNote(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.note.Unpublish(ctx, noteId)
+}
+
+// NotesListTrashedNotes handles GET /api/notes/trash.
+func (s *Server) NotesListTrashedNotes(ctx echo.Context, params openapi.NotesListTrashedNotesParams) error {
+	return s.note.ListTrash(ctx, params)
+}
+
+// NotesRestoreNote handles POST /api/notes/:noteId/restore.
+func (s *Server) NotesRestoreNote(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.note.Restore(ctx, noteId)
+}
+
+// NotesPurgeNote handles DELETE /api/notes/trash/:noteId.
+func (s *Server) NotesPurgeNote(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.note.Purge(ctx, noteId)
+}
+
+// NotesListNoteRevisions handles GET /api/notes/:noteId/revisions.
+func (s *Server) NotesListNoteRevisions(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.noteRevision.List(ctx, noteId)
+}
+
+// NotesGetNoteRevision handles GET /api/notes/:noteId/revisions/:revision.
+func (s *Server) NotesGetNoteRevision(ctx echo.Context, noteId string, revision int32) error { //nolint:revive
+	return s.noteRevision.Get(ctx, noteId, revision)
+}
+
+// NotesDiffNoteRevision handles GET /api/notes/:noteId/revisions/:revision/diff.
+func (s *Server) NotesDiffNoteRevision(ctx echo.Context, noteId string, revision int32, params openapi.NotesDiffNoteRevisionParams) error { //nolint:revive
+	return s.noteRevision.Diff(ctx, noteId, revision, params)
+}
+
+// NotesListNoteLinks handles GET /api/notes/:noteId/links.
+func (s *Server) NotesListNoteLinks(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.noteLink.List(ctx, noteId)
+}
+
+// NotesCreateNoteLink handles POST /api/notes/:noteId/links.
+func (s *Server) NotesCreateNoteLink(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.noteLink.Create(ctx, noteId)
+}
+
+// NotesDeleteNoteLink handles DELETE /api/notes/:noteId/links/:linkId.
+func (s *Server) NotesDeleteNoteLink(ctx echo.Context, noteId string, linkId string) error { //nolint:revive
+	return s.noteLink.Delete(ctx, noteId, linkId)
 }
 
 // TemplatesListTemplates handles GET /api/templates.
@@ -87,8 +160,8 @@ func (s *Server) TemplatesCreateTemplate(ctx echo.Context) error {
 }
 
 // TemplatesDeleteTemplate handles DELETE /api/templates/:id.
-func (s *Server) TemplatesDeleteTemplate(ctx echo.Context, templateId string, params openapi.TemplatesDeleteTemplateParams) error { //nolint:revive
-	return s.template.Delete(ctx, templateId, params)
+func (s *Server) TemplatesDeleteTemplate(ctx echo.Context, templateId string) error { //nolint:revive
+	return s.template.Delete(ctx, templateId)
 }
 
 // TemplatesGetTemplateById handles GET /api/templates/:id.
@@ -101,3 +174,178 @@ func (s *Server) TemplatesGetTemplateById(ctx echo.Context, templateId string) e
 func (s *Server) TemplatesUpdateTemplate(ctx echo.Context, templateId string, params openapi.TemplatesUpdateTemplateParams) error { //nolint:revive
 	return s.template.Update(ctx, templateId, params)
 }
+
+// TemplatesListTrashedTemplates handles GET /api/templates/trash.
+func (s *Server) TemplatesListTrashedTemplates(ctx echo.Context, params openapi.TemplatesListTrashedTemplatesParams) error {
+	return s.template.ListTrash(ctx, params)
+}
+
+// TemplatesRestoreTemplate handles POST /api/templates/:templateId/restore.
+func (s *Server) TemplatesRestoreTemplate(ctx echo.Context, templateId string) error { //nolint:revive
+	return s.template.Restore(ctx, templateId)
+}
+
+// TemplatesPurgeTemplate handles DELETE /api/templates/trash/:templateId.
+func (s *Server) TemplatesPurgeTemplate(ctx echo.Context, templateId string) error { //nolint:revive
+	return s.template.Purge(ctx, templateId)
+}
+
+// WebhooksListWebhooks handles GET /api/webhooks.
+func (s *Server) WebhooksListWebhooks(ctx echo.Context) error {
+	return s.webhook.List(ctx)
+}
+
+// WebhooksCreateWebhook handles POST /api/webhooks.
+func (s *Server) WebhooksCreateWebhook(ctx echo.Context) error {
+	return s.webhook.Create(ctx)
+}
+
+// WebhooksGetWebhook handles GET /api/webhooks/:webhookId.
+func (s *Server) WebhooksGetWebhook(ctx echo.Context, webhookId string) error { //nolint:revive
+	return s.webhook.Get(ctx, webhookId)
+}
+
+// WebhooksUpdateWebhook handles PUT /api/webhooks/:webhookId.
+func (s *Server) WebhooksUpdateWebhook(ctx echo.Context, webhookId string) error { //nolint:revive
+	return s.webhook.Update(ctx, webhookId)
+}
+
+// WebhooksDeleteWebhook handles DELETE /api/webhooks/:webhookId.
+func (s *Server) WebhooksDeleteWebhook(ctx echo.Context, webhookId string) error { //nolint:revive
+	return s.webhook.Delete(ctx, webhookId)
+}
+
+// WebhooksListWebhookDeliveries handles GET /api/webhooks/:webhookId/deliveries.
+func (s *Server) WebhooksListWebhookDeliveries(ctx echo.Context, webhookId string, params openapi.WebhooksListWebhookDeliveriesParams) error { //nolint:revive
+	return s.webhook.ListDeliveries(ctx, webhookId, params)
+}
+
+// WebhooksRedeliverWebhookDelivery handles POST /api/webhooks/:webhookId/deliveries/:deliveryId/redeliver.
+func (s *Server) WebhooksRedeliverWebhookDelivery(ctx echo.Context, webhookId string, deliveryId string) error { //nolint:revive
+	return s.webhook.Redeliver(ctx, webhookId, deliveryId)
+}
+
+// AdminListAuditLogs handles GET /api/admin/audit-logs.
+func (s *Server) AdminListAuditLogs(ctx echo.Context, params openapi.AdminListAuditLogsParams) error {
+	return s.audit.List(ctx, params)
+}
+
+// AdminVerifyAuditLog handles GET /api/admin/audit-logs/verify.
+func (s *Server) AdminVerifyAuditLog(ctx echo.Context) error {
+	return s.audit.Verify(ctx)
+}
+
+// TagsListTags handles GET /api/tags.
+func (s *Server) TagsListTags(ctx echo.Context, params openapi.TagsListTagsParams) error {
+	return s.tag.List(ctx, params)
+}
+
+// CommentsListComments handles GET /api/notes/:noteId/comments.
+func (s *Server) CommentsListComments(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.comment.List(ctx, noteId)
+}
+
+// CommentsCreateComment handles POST /api/notes/:noteId/comments.
+func (s *Server) CommentsCreateComment(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.comment.Create(ctx, noteId)
+}
+
+// CommentsUpdateComment handles PUT /api/notes/:noteId/comments/:commentId.
+func (s *Server) CommentsUpdateComment(ctx echo.Context, noteId string, commentId string) error { //nolint:revive
+	return s.comment.Update(ctx, noteId, commentId)
+}
+
+// CommentsDeleteComment handles DELETE /api/notes/:noteId/comments/:commentId.
+func (s *Server) CommentsDeleteComment(ctx echo.Context, noteId string, commentId string) error { //nolint:revive
+	return s.comment.Delete(ctx, noteId, commentId)
+}
+
+// CommentsResolveComment handles POST /api/notes/:noteId/comments/:commentId/resolve.
+func (s *Server) CommentsResolveComment(ctx echo.Context, noteId string, commentId string) error { //nolint:revive
+	return s.comment.Resolve(ctx, noteId, commentId, true)
+}
+
+// CommentsUnresolveComment handles POST /api/notes/:noteId/comments/:commentId/unresolve.
+func (s *Server) CommentsUnresolveComment(ctx echo.Context, noteId string, commentId string) error { //nolint:revive
+	return s.comment.Resolve(ctx, noteId, commentId, false)
+}
+
+// CollaboratorsListCollaborators handles GET /api/notes/:noteId/collaborators.
+func (s *Server) CollaboratorsListCollaborators(ctx echo.Context, noteId string) error { //nolint:revive
+	return s.collaborator.List(ctx, noteId)
+}
+
+// CollaboratorsPutCollaborator handles PUT /api/notes/:noteId/collaborators/:accountId.
+func (s *Server) CollaboratorsPutCollaborator(ctx echo.Context, noteId string, accountId string) error { //nolint:revive
+	return s.collaborator.Put(ctx, noteId, accountId)
+}
+
+// CollaboratorsDeleteCollaborator handles DELETE /api/notes/:noteId/collaborators/:accountId.
+func (s *Server) CollaboratorsDeleteCollaborator(ctx echo.Context, noteId string, accountId string) error { //nolint:revive
+	return s.collaborator.Delete(ctx, noteId, accountId)
+}
+
+// WorkspacesListWorkspaces handles GET /api/workspaces.
+func (s *Server) WorkspacesListWorkspaces(ctx echo.Context) error {
+	return s.workspace.List(ctx)
+}
+
+// WorkspacesCreateWorkspace handles POST /api/workspaces.
+func (s *Server) WorkspacesCreateWorkspace(ctx echo.Context) error {
+	return s.workspace.Create(ctx)
+}
+
+// WorkspacesGetWorkspace handles GET /api/workspaces/:workspaceId.
+func (s *Server) WorkspacesGetWorkspace(ctx echo.Context, workspaceId string) error { //nolint:revive
+	return s.workspace.Get(ctx, workspaceId)
+}
+
+// WorkspacesUpdateWorkspace handles PUT /api/workspaces/:workspaceId.
+func (s *Server) WorkspacesUpdateWorkspace(ctx echo.Context, workspaceId string) error { //nolint:revive
+	return s.workspace.Update(ctx, workspaceId)
+}
+
+// WorkspacesDeleteWorkspace handles DELETE /api/workspaces/:workspaceId.
+func (s *Server) WorkspacesDeleteWorkspace(ctx echo.Context, workspaceId string) error { //nolint:revive
+	return s.workspace.Delete(ctx, workspaceId)
+}
+
+// WorkspacesListWorkspaceMembers handles GET /api/workspaces/:workspaceId/members.
+func (s *Server) WorkspacesListWorkspaceMembers(ctx echo.Context, workspaceId string) error { //nolint:revive
+	return s.workspace.ListMembers(ctx, workspaceId)
+}
+
+// WorkspacesUpdateWorkspaceMember handles PUT /api/workspaces/:workspaceId/members/:accountId.
+func (s *Server) WorkspacesUpdateWorkspaceMember(ctx echo.Context, workspaceId string, accountId string) error { //nolint:revive
+	return s.workspace.UpdateMember(ctx, workspaceId, accountId)
+}
+
+// WorkspacesDeleteWorkspaceMember handles DELETE /api/workspaces/:workspaceId/members/:accountId.
+func (s *Server) WorkspacesDeleteWorkspaceMember(ctx echo.Context, workspaceId string, accountId string) error { //nolint:revive
+	return s.workspace.RemoveMember(ctx, workspaceId, accountId)
+}
+
+// WorkspacesListWorkspaceInvites handles GET /api/workspaces/:workspaceId/invites.
+func (s *Server) WorkspacesListWorkspaceInvites(ctx echo.Context, workspaceId string) error { //nolint:revive
+	return s.workspace.ListInvites(ctx, workspaceId)
+}
+
+// WorkspacesCreateWorkspaceInvite handles POST /api/workspaces/:workspaceId/invites.
+func (s *Server) WorkspacesCreateWorkspaceInvite(ctx echo.Context, workspaceId string) error { //nolint:revive
+	return s.workspace.Invite(ctx, workspaceId)
+}
+
+// WorkspacesDeleteWorkspaceInvite handles DELETE /api/workspaces/:workspaceId/invites/:inviteId.
+func (s *Server) WorkspacesDeleteWorkspaceInvite(ctx echo.Context, workspaceId string, inviteId string) error { //nolint:revive
+	return s.workspace.RevokeInvite(ctx, workspaceId, inviteId)
+}
+
+// InvitesListMyInvites handles GET /api/invites.
+func (s *Server) InvitesListMyInvites(ctx echo.Context) error {
+	return s.workspace.ListMyInvites(ctx)
+}
+
+// InvitesAcceptInvite handles POST /api/invites/:inviteId/accept.
+func (s *Server) InvitesAcceptInvite(ctx echo.Context, inviteId string) error { //nolint:revive
+	return s.workspace.AcceptInvite(ctx, inviteId)
+}
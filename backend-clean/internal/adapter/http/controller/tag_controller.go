@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TagController handles tag endpoints.
+type TagController struct {
+	inputFactory  func(repo port.TagRepository, output port.TagOutputPort) port.TagInputPort
+	outputFactory func() *presenter.TagPresenter
+	repoFactory   func() port.TagRepository
+}
+
+// NewTagController creates TagController.
+func NewTagController(
+	inputFactory func(repo port.TagRepository, output port.TagOutputPort) port.TagInputPort,
+	outputFactory func() *presenter.TagPresenter,
+	repoFactory func() port.TagRepository,
+) *TagController {
+	return &TagController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+	}
+}
+
+// List handles GET /tags.
+func (c *TagController) List(ctx echo.Context, params openapi.TagsListTagsParams) error {
+	viewerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	filters := note.TagFilters{OwnerID: params.OwnerId}
+	if params.Limit != nil {
+		filters.Limit = int(*params.Limit)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), filters, viewerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Tags())
+}
+
+func (c *TagController) newIO() (port.TagInputPort, *presenter.TagPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.repoFactory(), output)
+	return input, output
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newTagController(input *ctrlmock.TagInputStub) *TagController {
+	return NewTagController(
+		func(repo port.TagRepository, output port.TagOutputPort) port.TagInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewTagPresenter,
+		func() port.TagRepository { return nil },
+	)
+}
+
+func TestTagController_List(t *testing.T) {
+	owner := "owner-1"
+	limit := int32(5)
+	tests := []struct {
+		name       string
+		accountID  string
+		params     openapi.TagsListTagsParams
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "[Success] list tags",
+			accountID:  "viewer-1",
+			params:     openapi.TagsListTagsParams{OwnerId: &owner, Limit: &limit},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"count":3,"tag":"auth"}`,
+		},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+		{name: "[Fail] unexpected error", accountID: "viewer-1", inErr: errors.New("db error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.TagInputStub{Err: tt.inErr, Counts: []note.TagCount{{Tag: "auth", Count: 3}}}
+			ctrl := newTagController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/tags", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.List(c, tt.params)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK {
+				got := input.Listed
+				if input.ViewerID != "viewer-1" || got.OwnerID == nil || *got.OwnerID != owner || got.Limit != 5 {
+					t.Fatalf("unexpected filters: %+v", got)
+				}
+			}
+		})
+	}
+}
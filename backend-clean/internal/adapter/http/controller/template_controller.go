@@ -3,45 +3,56 @@ package controller
 
 import (
 	"net/http"
-	"strings"
 
 	"github.com/labstack/echo/v4"
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
-	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // TemplateController handles template HTTP endpoints.
 type TemplateController struct {
-	inputFactory  func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort
+	inputFactory  func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort
 	outputFactory func() *presenter.TemplatePresenter
 	repoFactory   func() port.TemplateRepository
 	txFactory     func() port.TxManager
+	eventsFactory func() port.EventPublisher
+	auditFactory  func() port.AuditRecorder
 }
 
 // NewTemplateController creates TemplateController.
 func NewTemplateController(
-	inputFactory func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort,
+	inputFactory func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort,
 	outputFactory func() *presenter.TemplatePresenter,
 	repoFactory func() port.TemplateRepository,
 	txFactory func() port.TxManager,
+	eventsFactory func() port.EventPublisher,
+	auditFactory func() port.AuditRecorder,
 ) *TemplateController {
 	return &TemplateController{
 		inputFactory:  inputFactory,
 		outputFactory: outputFactory,
 		repoFactory:   repoFactory,
 		txFactory:     txFactory,
+		eventsFactory: eventsFactory,
+		auditFactory:  auditFactory,
 	}
 }
 
 // List handles GET /templates.
 func (c *TemplateController) List(ctx echo.Context, params openapi.TemplatesListTemplatesParams) error {
+	after, limit, err := pageParams(params.Cursor, params.Limit)
+	if err != nil {
+		return handleError(ctx, err)
+	}
 	filters := template.Filters{
 		Query:   params.Q,
 		OwnerID: params.OwnerId,
+		Limit:   limit,
+		After:   after,
 	}
 	input, p := c.newIO()
 	if err := input.List(ctx.Request().Context(), filters); err != nil {
@@ -63,19 +74,24 @@ func (c *TemplateController) GetByID(ctx echo.Context, templateID string) error
 func (c *TemplateController) Create(ctx echo.Context) error {
 	var body openapi.ModelsCreateTemplateRequest
 	if err := ctx.Bind(&body); err != nil {
-		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+		return badRequest(ctx, "invalid body")
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
 	}
-	ownerID := body.OwnerId.String()
 	fields := make([]template.Field, 0, len(body.Fields))
 	for _, f := range body.Fields {
 		fields = append(fields, template.Field{
 			Label:      f.Label,
 			Order:      int(f.Order),
 			IsRequired: f.IsRequired,
+			Type:       toFieldType(f.Type),
+			Options:    fieldOptions(f.Options),
 		})
 	}
 	input, p := c.newIO()
-	err := input.Create(ctx.Request().Context(), port.TemplateCreateInput{
+	err = input.Create(ctx.Request().Context(), port.TemplateCreateInput{
 		Name:    body.Name,
 		OwnerID: ownerID,
 		Fields:  fields,
@@ -90,11 +106,15 @@ func (c *TemplateController) Create(ctx echo.Context) error {
 func (c *TemplateController) Update(ctx echo.Context, templateID string, params openapi.TemplatesUpdateTemplateParams) error {
 	var body openapi.ModelsUpdateTemplateRequest
 	if err := ctx.Bind(&body); err != nil {
-		return ctx.JSON(http.StatusBadRequest, openapi.ModelsBadRequestError{Code: openapi.ModelsBadRequestErrorCodeBADREQUEST, Message: "invalid body"})
+		return badRequest(ctx, "invalid body")
 	}
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrUnauthorized)
+	version, ok := expectedVersion(params.IfMatch, body.Version)
+	if !ok {
+		return badRequest(ctx, "invalid If-Match header")
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	fields := make([]template.Field, 0, len(body.Fields))
 	for _, f := range body.Fields {
@@ -103,14 +123,17 @@ func (c *TemplateController) Update(ctx echo.Context, templateID string, params
 			Label:      f.Label,
 			Order:      int(f.Order),
 			IsRequired: f.IsRequired,
+			Type:       toFieldType(f.Type),
+			Options:    fieldOptions(f.Options),
 		})
 	}
 	input, p := c.newIO()
-	err := input.Update(ctx.Request().Context(), port.TemplateUpdateInput{
+	err = input.Update(ctx.Request().Context(), port.TemplateUpdateInput{
 		ID:      templateID,
 		Name:    body.Name,
 		Fields:  fields,
 		OwnerID: ownerID,
+		Version: version,
 	})
 	if err != nil {
 		return handleError(ctx, err)
@@ -119,10 +142,10 @@ func (c *TemplateController) Update(ctx echo.Context, templateID string, params
 }
 
 // Delete handles DELETE /templates/:id.
-func (c *TemplateController) Delete(ctx echo.Context, templateID string, params openapi.TemplatesDeleteTemplateParams) error {
-	ownerID := strings.TrimSpace(params.OwnerId)
-	if ownerID == "" {
-		return handleError(ctx, domainerr.ErrUnauthorized)
+func (c *TemplateController) Delete(ctx echo.Context, templateID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
 	}
 	input, p := c.newIO()
 	if err := input.Delete(ctx.Request().Context(), templateID, ownerID); err != nil {
@@ -131,8 +154,71 @@ func (c *TemplateController) Delete(ctx echo.Context, templateID string, params
 	return ctx.JSON(http.StatusOK, p.DeleteResponse())
 }
 
+// ListTrash handles GET /templates/trash.
+func (c *TemplateController) ListTrash(ctx echo.Context, params openapi.TemplatesListTrashedTemplatesParams) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	after, limit, err := pageParams(params.Cursor, params.Limit)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.ListTrash(ctx.Request().Context(), trash.Filters{
+		OwnerID: ownerID,
+		Limit:   limit,
+		After:   after,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Templates())
+}
+
+// Restore handles POST /templates/:id/restore.
+func (c *TemplateController) Restore(ctx echo.Context, templateID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Restore(ctx.Request().Context(), templateID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Template())
+}
+
+// Purge handles DELETE /templates/trash/:id.
+func (c *TemplateController) Purge(ctx echo.Context, templateID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Purge(ctx.Request().Context(), templateID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
 func (c *TemplateController) newIO() (port.TemplateInputPort, *presenter.TemplatePresenter) {
 	output := c.outputFactory()
-	input := c.inputFactory(c.repoFactory(), c.txFactory(), output)
+	input := c.inputFactory(c.repoFactory(), c.txFactory(), c.eventsFactory(), c.auditFactory(), output)
 	return input, output
 }
+
+// toFieldType converts an optional field type; empty lets the domain apply the default.
+func toFieldType(t *openapi.ModelsFieldType) template.FieldType {
+	if t == nil {
+		return ""
+	}
+	return template.FieldType(*t)
+}
+
+func fieldOptions(options *[]string) []string {
+	if options == nil {
+		return nil
+	}
+	return *options
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -12,19 +13,39 @@ import (
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 func TestTemplateController_Create(t *testing.T) {
 	tests := []struct {
 		name       string
+		accountID  string
 		body       string
 		wantStatus int
+		wantFields []template.Field
 	}{
 		{
 			name:       "[Success] create template",
-			body:       `{"name":"Template","ownerId":"00000000-0000-0000-0000-000000000002","fields":[{"label":"Title","order":1,"isRequired":true}]}`,
+			accountID:  "00000000-0000-0000-0000-000000000002",
+			body:       `{"name":"Template","fields":[{"label":"Title","order":1,"isRequired":true}]}`,
 			wantStatus: http.StatusOK,
+			wantFields: []template.Field{{Label: "Title", Order: 1, IsRequired: true}},
+		},
+		{
+			name:       "[Success] typed fields",
+			accountID:  "00000000-0000-0000-0000-000000000002",
+			body:       `{"name":"Template","fields":[{"label":"Priority","order":1,"isRequired":true,"type":"single-select","options":["high","low"]},{"label":"Due","order":2,"isRequired":false,"type":"date"}]}`,
+			wantStatus: http.StatusOK,
+			wantFields: []template.Field{
+				{Label: "Priority", Order: 1, IsRequired: true, Type: template.FieldSingleSelect, Options: []string{"high", "low"}},
+				{Label: "Due", Order: 2, Type: template.FieldDate},
+			},
+		},
+		{
+			name:       "[Fail] unauthenticated",
+			body:       `{"name":"Template","fields":[{"label":"Title","order":1,"isRequired":true}]}`,
+			wantStatus: http.StatusUnauthorized,
 		},
 		{
 			name:       "[Fail] bind error",
@@ -38,17 +59,19 @@ func TestTemplateController_Create(t *testing.T) {
 			p := presenter.NewTemplatePresenter()
 			input := &ctrlmock.TemplateInputStub{}
 			ctrl := NewTemplateController(
-				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+				func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.TemplatePresenter { return p },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodPost, "/api/templates", bytes.NewBufferString(tt.body))
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/templates", bytes.NewBufferString(tt.body)), tt.accountID)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -57,17 +80,23 @@ func TestTemplateController_Create(t *testing.T) {
 			if rec.Code != tt.wantStatus {
 				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
 			}
+			if tt.wantFields != nil && !reflect.DeepEqual(input.Created.Fields, tt.wantFields) {
+				t.Fatalf("fields = %+v, want %+v", input.Created.Fields, tt.wantFields)
+			}
 		})
 	}
 }
 
 func TestTemplateController_List(t *testing.T) {
+	badCursor := "not-a-cursor"
 	tests := []struct {
 		name       string
+		params     openapi.TemplatesListTemplatesParams
 		inErr      error
 		wantStatus int
 	}{
 		{name: "[Success] list templates", wantStatus: http.StatusOK},
+		{name: "[Fail] invalid cursor", params: openapi.TemplatesListTemplatesParams{Cursor: &badCursor}, wantStatus: http.StatusBadRequest},
 		{name: "[Fail] repo error", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
 	}
 
@@ -77,19 +106,21 @@ func TestTemplateController_List(t *testing.T) {
 			p := presenter.NewTemplatePresenter()
 			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
 			ctrl := NewTemplateController(
-				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+				func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.TemplatePresenter { return p },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
 			req := httptest.NewRequest(http.MethodGet, "/api/templates", nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
-			_ = ctrl.List(c, openapi.TemplatesListTemplatesParams{})
+			_ = ctrl.List(c, tt.params)
 			if rec.Code != tt.wantStatus {
 				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
 			}
@@ -113,13 +144,15 @@ func TestTemplateController_Get(t *testing.T) {
 			p := presenter.NewTemplatePresenter()
 			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
 			ctrl := NewTemplateController(
-				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+				func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.TemplatePresenter { return p },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
 			req := httptest.NewRequest(http.MethodGet, "/api/templates/t1", nil)
 			rec := httptest.NewRecorder()
@@ -138,52 +171,60 @@ func TestTemplateController_Update(t *testing.T) {
 	p := presenter.NewTemplatePresenter()
 	input := &ctrlmock.TemplateInputStub{}
 	ctrl := NewTemplateController(
-		func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+		func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
 			input.Output = output
 			return input
 		},
 		func() *presenter.TemplatePresenter { return p },
 		func() port.TemplateRepository { return nil },
 		func() port.TxManager { return nil },
+		func() port.EventPublisher { return nil },
+		func() port.AuditRecorder { return nil },
 	)
 
 	tests := []struct {
 		name       string
 		body       string
-		params     openapi.TemplatesUpdateTemplateParams
+		accountID  string
 		inErr      error
 		wantStatus int
 	}{
 		{
 			name:       "[Success] update template",
 			body:       `{"name":"updated","fields":[{"id":"f1","label":"Title","order":1,"isRequired":true}]}`,
-			params:     openapi.TemplatesUpdateTemplateParams{OwnerId: "owner"},
+			accountID:  "owner",
 			wantStatus: http.StatusOK,
 		},
 		{
-			name:       "[Fail] missing owner",
+			name:       "[Fail] unauthenticated",
 			body:       `{"name":"updated","fields":[{"id":"f1","label":"Title","order":1,"isRequired":true}]}`,
-			params:     openapi.TemplatesUpdateTemplateParams{OwnerId: ""},
-			wantStatus: http.StatusForbidden,
+			wantStatus: http.StatusUnauthorized,
 		},
 		{
 			name:       "[Fail] usecase error",
 			body:       `{"name":"updated","fields":[{"id":"f1","label":"Title","order":1,"isRequired":true}]}`,
-			params:     openapi.TemplatesUpdateTemplateParams{OwnerId: "owner"},
+			accountID:  "owner",
 			inErr:      domainerr.ErrNotFound,
 			wantStatus: http.StatusNotFound,
 		},
+		{
+			name:       "[Fail] version conflict",
+			body:       `{"name":"updated","fields":[{"id":"f1","label":"Title","order":1,"isRequired":true}],"version":2}`,
+			accountID:  "owner",
+			inErr:      domainerr.ErrConflict,
+			wantStatus: http.StatusConflict,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			input.Err = tt.inErr
-			req := httptest.NewRequest(http.MethodPut, "/api/templates/t1", bytes.NewBufferString(tt.body))
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/templates/t1", bytes.NewBufferString(tt.body)), tt.accountID)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
-			_ = ctrl.Update(c, "t1", tt.params)
+			_ = ctrl.Update(c, "t1", openapi.TemplatesUpdateTemplateParams{})
 			if rec.Code != tt.wantStatus {
 				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
 			}
@@ -199,8 +240,137 @@ func TestTemplateController_Delete(t *testing.T) {
 		wantStatus int
 	}{
 		{name: "[Success] delete template", ownerID: "owner", wantStatus: http.StatusOK},
-		{name: "[Fail] owner missing", ownerID: "", wantStatus: http.StatusForbidden},
+		{name: "[Fail] unauthenticated", ownerID: "", wantStatus: http.StatusUnauthorized},
 		{name: "[Fail] not found", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] used by trashed notes", ownerID: "owner", inErr: domainerr.ErrTemplateUsedByTrashedNotes, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewTemplatePresenter()
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := NewTemplateController(
+				func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.TemplatePresenter { return p },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/templates/t1", nil), tt.ownerID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Delete(c, "t1")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestTemplateController_ListTrash(t *testing.T) {
+	badCursor := "not-a-cursor"
+	tests := []struct {
+		name       string
+		params     openapi.TemplatesListTrashedTemplatesParams
+		ownerID    string
+		wantStatus int
+	}{
+		{name: "[Success] list own trash", params: openapi.TemplatesListTrashedTemplatesParams{}, ownerID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] invalid cursor", params: openapi.TemplatesListTrashedTemplatesParams{Cursor: &badCursor}, ownerID: "owner", wantStatus: http.StatusBadRequest},
+		{name: "[Fail] unauthenticated", params: openapi.TemplatesListTrashedTemplatesParams{}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewTemplatePresenter()
+			input := &ctrlmock.TemplateInputStub{}
+			ctrl := NewTemplateController(
+				func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.TemplatePresenter { return p },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/templates/trash", nil), tt.ownerID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.ListTrash(c, tt.params)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && input.Trash.OwnerID != tt.ownerID {
+				t.Fatalf("owner not scoped to the current account: %+v", input.Trash)
+			}
+		})
+	}
+}
+
+func TestTemplateController_Restore(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] restore template", ownerID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] unauthenticated", ownerID: "", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] not in trash", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			p := presenter.NewTemplatePresenter()
+			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
+			ctrl := NewTemplateController(
+				func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
+					input.Output = output
+					return input
+				},
+				func() *presenter.TemplatePresenter { return p },
+				func() port.TemplateRepository { return nil },
+				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
+			)
+
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/templates/t1/restore", nil), tt.ownerID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			_ = ctrl.Restore(c, "t1")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestTemplateController_Purge(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		inErr      error
+		wantStatus int
+	}{
+		{name: "[Success] purge template", ownerID: "owner", wantStatus: http.StatusOK},
+		{name: "[Fail] unauthenticated", ownerID: "", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] not in trash", ownerID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] used by trashed notes", ownerID: "owner", inErr: domainerr.ErrTemplateUsedByTrashedNotes, wantStatus: http.StatusConflict},
 	}
 
 	for _, tt := range tests {
@@ -209,20 +379,22 @@ func TestTemplateController_Delete(t *testing.T) {
 			p := presenter.NewTemplatePresenter()
 			input := &ctrlmock.TemplateInputStub{Err: tt.inErr}
 			ctrl := NewTemplateController(
-				func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
+				func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
 					input.Output = output
 					return input
 				},
 				func() *presenter.TemplatePresenter { return p },
 				func() port.TemplateRepository { return nil },
 				func() port.TxManager { return nil },
+				func() port.EventPublisher { return nil },
+				func() port.AuditRecorder { return nil },
 			)
 
-			req := httptest.NewRequest(http.MethodDelete, "/api/templates/t1", nil)
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/templates/trash/t1", nil), tt.ownerID)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
-			_ = ctrl.Delete(c, "t1", openapi.TemplatesDeleteTemplateParams{OwnerId: tt.ownerID})
+			_ = ctrl.Purge(c, "t1")
 			if rec.Code != tt.wantStatus {
 				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
 			}
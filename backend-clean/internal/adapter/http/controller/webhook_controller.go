@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookController handles webhook endpoints.
+type WebhookController struct {
+	inputFactory  func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort
+	outputFactory func() *presenter.WebhookPresenter
+	repoFactory   func() port.WebhookRepository
+}
+
+// NewWebhookController creates WebhookController.
+func NewWebhookController(
+	inputFactory func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort,
+	outputFactory func() *presenter.WebhookPresenter,
+	repoFactory func() port.WebhookRepository,
+) *WebhookController {
+	return &WebhookController{
+		inputFactory:  inputFactory,
+		outputFactory: outputFactory,
+		repoFactory:   repoFactory,
+	}
+}
+
+// List handles GET /webhooks.
+func (c *WebhookController) List(ctx echo.Context) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhooks())
+}
+
+// Create handles POST /webhooks.
+func (c *WebhookController) Create(ctx echo.Context) error {
+	var body openapi.ModelsCreateWebhookRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Create(ctx.Request().Context(), port.WebhookCreateInput{
+		OwnerID: ownerID,
+		URL:     body.Url,
+		Events:  toWebhookEventTypes(body.Events),
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhook())
+}
+
+// Get handles GET /webhooks/:id.
+func (c *WebhookController) Get(ctx echo.Context, webhookID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Get(ctx.Request().Context(), webhookID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhook())
+}
+
+// Update handles PUT /webhooks/:id.
+func (c *WebhookController) Update(ctx echo.Context, webhookID string) error {
+	var body openapi.ModelsUpdateWebhookRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Update(ctx.Request().Context(), port.WebhookUpdateInput{
+		ID:      webhookID,
+		OwnerID: ownerID,
+		URL:     body.Url,
+		Events:  toWebhookEventTypes(body.Events),
+		Active:  body.Active,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Webhook())
+}
+
+// Delete handles DELETE /webhooks/:id.
+func (c *WebhookController) Delete(ctx echo.Context, webhookID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Delete(ctx.Request().Context(), webhookID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+// ListDeliveries handles GET /webhooks/:id/deliveries.
+func (c *WebhookController) ListDeliveries(ctx echo.Context, webhookID string, params openapi.WebhooksListWebhookDeliveriesParams) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	after, limit, err := pageParams(params.Cursor, params.Limit)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.ListDeliveries(ctx.Request().Context(), port.WebhookDeliveryListInput{
+		WebhookID: webhookID,
+		OwnerID:   ownerID,
+		After:     after,
+		Limit:     limit,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Deliveries())
+}
+
+// Redeliver handles POST /webhooks/:id/deliveries/:deliveryId/redeliver.
+func (c *WebhookController) Redeliver(ctx echo.Context, webhookID, deliveryID string) error {
+	ownerID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Redeliver(ctx.Request().Context(), webhookID, deliveryID, ownerID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Delivery())
+}
+
+func (c *WebhookController) newIO() (port.WebhookInputPort, *presenter.WebhookPresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.repoFactory(), output)
+	return input, output
+}
+
+func toWebhookEventTypes(events []openapi.ModelsWebhookEventType) []webhook.EventType {
+	res := make([]webhook.EventType, 0, len(events))
+	for _, e := range events {
+		res = append(res, webhook.EventType(e))
+	}
+	return res
+}
@@ -0,0 +1,279 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newWebhookController(input *ctrlmock.WebhookInputStub) *WebhookController {
+	return NewWebhookController(
+		func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewWebhookPresenter,
+		func() port.WebhookRepository { return nil },
+	)
+}
+
+func TestWebhookController_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list webhooks", accountID: "owner", wantStatus: http.StatusOK, wantBody: `"url":"https://example.com/hook"`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newWebhookController(&ctrlmock.WebhookInputStub{
+				Webhooks: []webhook.Webhook{{ID: "wh-1", URL: "https://example.com/hook", Secret: "whsec_x", Active: true}},
+			})
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/webhooks", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.List(c)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if bytes.Contains(rec.Body.Bytes(), []byte("whsec_x")) {
+				t.Fatalf("secret must not be listed: %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebhookController_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "[Success] create webhook returns secret",
+			accountID:  "owner",
+			body:       `{"url":"https://example.com/hook","events":["note.published"]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"secret":"whsec_x"`,
+		},
+		{name: "[Fail] bind error", body: `not-json`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{
+			name:       "[Fail] unauthenticated",
+			body:       `{"url":"https://example.com/hook","events":["note.published"]}`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   domainerr.ErrUnauthenticated.Error(),
+		},
+		{
+			name:       "[Fail] invalid url",
+			accountID:  "owner",
+			body:       `{"url":"ftp://example.com","events":["note.published"]}`,
+			inErr:      domainerr.ErrInvalidWebhookURL,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domainerr.ErrInvalidWebhookURL.Error(),
+		},
+		{
+			name:       "[Fail] invalid events",
+			accountID:  "owner",
+			body:       `{"url":"https://example.com/hook","events":[]}`,
+			inErr:      domainerr.ErrInvalidWebhookEvents,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domainerr.ErrInvalidWebhookEvents.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WebhookInputStub{Err: tt.inErr}
+			ctrl := newWebhookController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/webhooks", bytes.NewBufferString(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Create(c)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && (input.Created.OwnerID != "owner" || len(input.Created.Events) != 1 || input.Created.Events[0] != webhook.EventNotePublished) {
+				t.Fatalf("unexpected input: %+v", input.Created)
+			}
+		})
+	}
+}
+
+func TestWebhookController_GetUpdateDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		call       func(*WebhookController, echo.Context) error
+		method     string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "[Success] get webhook without secret",
+			accountID:  "owner",
+			method:     http.MethodGet,
+			call:       func(c *WebhookController, ctx echo.Context) error { return c.Get(ctx, "wh-1") },
+			wantStatus: http.StatusOK,
+			wantBody:   `"id":"wh-1"`,
+		},
+		{
+			name:       "[Fail] get someone else's webhook",
+			accountID:  "intruder",
+			method:     http.MethodGet,
+			call:       func(c *WebhookController, ctx echo.Context) error { return c.Get(ctx, "wh-1") },
+			inErr:      domainerr.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "[Success] update webhook",
+			accountID:  "owner",
+			method:     http.MethodPut,
+			body:       `{"url":"https://example.com/v2","events":["note.deleted"],"active":false}`,
+			call:       func(c *WebhookController, ctx echo.Context) error { return c.Update(ctx, "wh-1") },
+			wantStatus: http.StatusOK,
+			wantBody:   `"active":false`,
+		},
+		{
+			name:       "[Fail] update bind error",
+			accountID:  "owner",
+			method:     http.MethodPut,
+			body:       `not-json`,
+			call:       func(c *WebhookController, ctx echo.Context) error { return c.Update(ctx, "wh-1") },
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid body",
+		},
+		{
+			name:       "[Success] delete webhook",
+			accountID:  "owner",
+			method:     http.MethodDelete,
+			call:       func(c *WebhookController, ctx echo.Context) error { return c.Delete(ctx, "wh-1") },
+			wantStatus: http.StatusOK,
+			wantBody:   `"success":true`,
+		},
+		{
+			name:       "[Fail] delete unauthenticated",
+			method:     http.MethodDelete,
+			call:       func(c *WebhookController, ctx echo.Context) error { return c.Delete(ctx, "wh-1") },
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newWebhookController(&ctrlmock.WebhookInputStub{Err: tt.inErr})
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(tt.method, "/api/webhooks/wh-1", bytes.NewBufferString(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = tt.call(ctrl, c)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if bytes.Contains(rec.Body.Bytes(), []byte("whsec_x")) {
+				t.Fatalf("secret must only be returned on create: %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebhookController_ListDeliveries(t *testing.T) {
+	cursor := page.Cursor{ID: "d-1"}.Encode()
+	bad := "not-a-cursor"
+	limit := int32(5)
+	tests := []struct {
+		name       string
+		accountID  string
+		params     openapi.WebhooksListWebhookDeliveriesParams
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "[Success] list deliveries",
+			accountID:  "owner",
+			params:     openapi.WebhooksListWebhookDeliveriesParams{Cursor: &cursor, Limit: &limit},
+			wantStatus: http.StatusOK,
+			wantBody:   `"status":"failed"`,
+		},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized},
+		{
+			name:       "[Fail] invalid cursor",
+			accountID:  "owner",
+			params:     openapi.WebhooksListWebhookDeliveriesParams{Cursor: &bad},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   domainerr.ErrInvalidCursor.Error(),
+		},
+		{name: "[Fail] not found", accountID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WebhookInputStub{
+				Err: tt.inErr,
+				Deliveries: []webhook.Delivery{{
+					ID: "d-1", WebhookID: "wh-1", EventID: "e-1", EventType: webhook.EventNotePublished,
+					Payload: []byte(`{"id":"e-1"}`), Status: webhook.DeliveryFailed, Attempts: webhook.MaxAttempts, LastStatusCode: 500,
+				}},
+			}
+			ctrl := newWebhookController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/webhooks/wh-1/deliveries", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.ListDeliveries(c, "wh-1", tt.params)
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.name == "[Success] list deliveries" && (input.Listed.After == nil || input.Listed.After.ID != "d-1" || input.Listed.Limit != 5 || input.Listed.OwnerID != "owner") {
+				t.Fatalf("unexpected input: %+v", input.Listed)
+			}
+		})
+	}
+}
+
+func TestWebhookController_Redeliver(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] redeliver", accountID: "owner", wantStatus: http.StatusOK, wantBody: `"redeliveryOf":"d-1"`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] not found", accountID: "owner", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := newWebhookController(&ctrlmock.WebhookInputStub{Err: tt.inErr})
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/webhooks/wh-1/deliveries/d-1/redeliver", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			_ = ctrl.Redeliver(c, "wh-1", "d-1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
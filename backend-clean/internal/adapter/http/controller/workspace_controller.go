@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceController handles workspace, member and invite endpoints.
+type WorkspaceController struct {
+	inputFactory         func(workspaceRepo port.WorkspaceRepository, inviteRepo port.WorkspaceInviteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort
+	outputFactory        func() *presenter.WorkspacePresenter
+	workspaceRepoFactory func() port.WorkspaceRepository
+	inviteRepoFactory    func() port.WorkspaceInviteRepository
+	accountRepoFactory   func() port.AccountRepository
+	txFactory            func() port.TxManager
+}
+
+// NewWorkspaceController creates WorkspaceController.
+func NewWorkspaceController(
+	inputFactory func(workspaceRepo port.WorkspaceRepository, inviteRepo port.WorkspaceInviteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort,
+	outputFactory func() *presenter.WorkspacePresenter,
+	workspaceRepoFactory func() port.WorkspaceRepository,
+	inviteRepoFactory func() port.WorkspaceInviteRepository,
+	accountRepoFactory func() port.AccountRepository,
+	txFactory func() port.TxManager,
+) *WorkspaceController {
+	return &WorkspaceController{
+		inputFactory:         inputFactory,
+		outputFactory:        outputFactory,
+		workspaceRepoFactory: workspaceRepoFactory,
+		inviteRepoFactory:    inviteRepoFactory,
+		accountRepoFactory:   accountRepoFactory,
+		txFactory:            txFactory,
+	}
+}
+
+// List handles GET /workspaces.
+func (c *WorkspaceController) List(ctx echo.Context) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.List(ctx.Request().Context(), actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Workspaces())
+}
+
+// Create handles POST /workspaces.
+func (c *WorkspaceController) Create(ctx echo.Context) error {
+	var body openapi.ModelsCreateWorkspaceRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Create(ctx.Request().Context(), port.WorkspaceCreateInput{Name: body.Name, ActorID: actorID}); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Workspace())
+}
+
+// Get handles GET /workspaces/:id.
+func (c *WorkspaceController) Get(ctx echo.Context, workspaceID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Get(ctx.Request().Context(), workspaceID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Workspace())
+}
+
+// Update handles PUT /workspaces/:id.
+func (c *WorkspaceController) Update(ctx echo.Context, workspaceID string) error {
+	var body openapi.ModelsUpdateWorkspaceRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.Update(ctx.Request().Context(), port.WorkspaceUpdateInput{
+		WorkspaceID: workspaceID,
+		Name:        body.Name,
+		ActorID:     actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Workspace())
+}
+
+// Delete handles DELETE /workspaces/:id.
+func (c *WorkspaceController) Delete(ctx echo.Context, workspaceID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.Delete(ctx.Request().Context(), workspaceID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+// ListMembers handles GET /workspaces/:id/members.
+func (c *WorkspaceController) ListMembers(ctx echo.Context, workspaceID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.ListMembers(ctx.Request().Context(), workspaceID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Members())
+}
+
+// UpdateMember handles PUT /workspaces/:id/members/:accountId.
+func (c *WorkspaceController) UpdateMember(ctx echo.Context, workspaceID, accountID string) error {
+	var body openapi.ModelsPutWorkspaceMemberRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	err = input.UpdateMember(ctx.Request().Context(), port.WorkspaceMemberUpdateInput{
+		WorkspaceID: workspaceID,
+		AccountID:   accountID,
+		Role:        workspace.Role(body.Role),
+		ActorID:     actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Member())
+}
+
+// RemoveMember handles DELETE /workspaces/:id/members/:accountId.
+func (c *WorkspaceController) RemoveMember(ctx echo.Context, workspaceID, accountID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.RemoveMember(ctx.Request().Context(), workspaceID, accountID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+// ListInvites handles GET /workspaces/:id/invites.
+func (c *WorkspaceController) ListInvites(ctx echo.Context, workspaceID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.ListInvites(ctx.Request().Context(), workspaceID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Invites())
+}
+
+// Invite handles POST /workspaces/:id/invites.
+func (c *WorkspaceController) Invite(ctx echo.Context, workspaceID string) error {
+	var body openapi.ModelsCreateWorkspaceInviteRequest
+	if err := ctx.Bind(&body); err != nil {
+		return badRequest(ctx, "invalid body")
+	}
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	var role workspace.Role
+	if body.Role != nil {
+		role = workspace.Role(*body.Role)
+	}
+	input, p := c.newIO()
+	err = input.Invite(ctx.Request().Context(), port.WorkspaceInviteInput{
+		WorkspaceID: workspaceID,
+		Email:       body.Email,
+		Role:        role,
+		ActorID:     actorID,
+	})
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Invite())
+}
+
+// RevokeInvite handles DELETE /workspaces/:id/invites/:inviteId.
+func (c *WorkspaceController) RevokeInvite(ctx echo.Context, workspaceID, inviteID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.RevokeInvite(ctx.Request().Context(), workspaceID, inviteID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.DeleteResponse())
+}
+
+// ListMyInvites handles GET /invites.
+func (c *WorkspaceController) ListMyInvites(ctx echo.Context) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.ListMyInvites(ctx.Request().Context(), actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Invites())
+}
+
+// AcceptInvite handles POST /invites/:id/accept.
+func (c *WorkspaceController) AcceptInvite(ctx echo.Context, inviteID string) error {
+	actorID, err := currentAccountID(ctx)
+	if err != nil {
+		return handleError(ctx, err)
+	}
+	input, p := c.newIO()
+	if err := input.AcceptInvite(ctx.Request().Context(), inviteID, actorID); err != nil {
+		return handleError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, p.Workspace())
+}
+
+func (c *WorkspaceController) newIO() (port.WorkspaceInputPort, *presenter.WorkspacePresenter) {
+	output := c.outputFactory()
+	input := c.inputFactory(c.workspaceRepoFactory(), c.inviteRepoFactory(), c.accountRepoFactory(), c.txFactory(), output)
+	return input, output
+}
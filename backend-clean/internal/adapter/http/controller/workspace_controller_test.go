@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	ctrlmock "immortal-architecture-clean/backend/internal/adapter/http/controller/mock"
+	"immortal-architecture-clean/backend/internal/adapter/http/presenter"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newWorkspaceController(input *ctrlmock.WorkspaceInputStub) *WorkspaceController {
+	return NewWorkspaceController(
+		func(workspaceRepo port.WorkspaceRepository, inviteRepo port.WorkspaceInviteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort {
+			input.Output = output
+			return input
+		},
+		presenter.NewWorkspacePresenter,
+		func() port.WorkspaceRepository { return nil },
+		func() port.WorkspaceInviteRepository { return nil },
+		func() port.AccountRepository { return nil },
+		func() port.TxManager { return nil },
+	)
+}
+
+func TestWorkspaceController_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] list workspaces", accountID: "acc-1", wantStatus: http.StatusOK, wantBody: `"role":"owner"`},
+		{name: "[Fail] unauthenticated", wantStatus: http.StatusUnauthorized, wantBody: domainerr.ErrUnauthenticated.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{
+				Workspaces: []workspace.WithRole{{Workspace: workspace.Workspace{ID: "ws-1"}, Role: workspace.RoleOwner}},
+			}
+			ctrl := newWorkspaceController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodGet, "/api/workspaces", nil), tt.accountID)
+			rec := httptest.NewRecorder()
+			_ = ctrl.List(e.NewContext(req, rec))
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestWorkspaceController_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] create workspace", accountID: "acc-1", body: `{"name":"Design"}`, wantStatus: http.StatusOK, wantBody: `"name":"Design"`},
+		{name: "[Fail] bind error", accountID: "acc-1", body: `not-json`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] invalid name", accountID: "acc-1", body: `{"name":""}`, inErr: domainerr.ErrInvalidWorkspaceName, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidWorkspaceName.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{Err: tt.inErr}
+			ctrl := newWorkspaceController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/workspaces", bytes.NewBufferString(tt.body)), tt.accountID)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Create(e.NewContext(req, rec))
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && input.CreateInput.ActorID != "acc-1" {
+				t.Fatalf("unexpected input: %+v", input.CreateInput)
+			}
+		})
+	}
+}
+
+func TestWorkspaceController_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] delete workspace", wantStatus: http.StatusOK, wantBody: `"success":true`},
+		{name: "[Fail] workspace not empty", inErr: domainerr.ErrWorkspaceNotEmpty, wantStatus: http.StatusConflict, wantBody: domainerr.ErrWorkspaceNotEmpty.Error()},
+		{name: "[Fail] not the owner", inErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{Err: tt.inErr}
+			ctrl := newWorkspaceController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodDelete, "/api/workspaces/ws-1", nil), "acc-1")
+			rec := httptest.NewRecorder()
+			_ = ctrl.Delete(e.NewContext(req, rec), "ws-1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
+
+func TestWorkspaceController_UpdateMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] promote to admin", body: `{"role":"admin"}`, wantStatus: http.StatusOK, wantBody: `"role":"admin"`},
+		{name: "[Fail] bind error", body: `not-json`, wantStatus: http.StatusBadRequest, wantBody: "invalid body"},
+		{name: "[Fail] owner cannot change", body: `{"role":"member"}`, inErr: domainerr.ErrInvalidWorkspaceMember, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidWorkspaceMember.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{Err: tt.inErr}
+			ctrl := newWorkspaceController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPut, "/api/workspaces/ws-1/members/acc-2", bytes.NewBufferString(tt.body)), "acc-1")
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.UpdateMember(e.NewContext(req, rec), "ws-1", "acc-2")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && (input.MemberInput.AccountID != "acc-2" || input.MemberInput.Role != workspace.RoleAdmin) {
+				t.Fatalf("unexpected input: %+v", input.MemberInput)
+			}
+		})
+	}
+}
+
+func TestWorkspaceController_Invite(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+		wantRole   workspace.Role
+	}{
+		{name: "[Success] invite with default role", body: `{"email":"new@example.com"}`, wantStatus: http.StatusOK, wantBody: `"email":"new@example.com"`},
+		{name: "[Success] invite as admin", body: `{"email":"new@example.com","role":"admin"}`, wantStatus: http.StatusOK, wantBody: `"role":"admin"`, wantRole: workspace.RoleAdmin},
+		{name: "[Fail] already a member", body: `{"email":"new@example.com"}`, inErr: domainerr.ErrAlreadyWorkspaceMember, wantStatus: http.StatusConflict, wantBody: domainerr.ErrAlreadyWorkspaceMember.Error()},
+		{name: "[Fail] owner role", body: `{"email":"new@example.com","role":"owner"}`, inErr: domainerr.ErrInvalidWorkspaceRole, wantStatus: http.StatusBadRequest, wantBody: domainerr.ErrInvalidWorkspaceRole.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{Err: tt.inErr}
+			ctrl := newWorkspaceController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/workspaces/ws-1/invites", bytes.NewBufferString(tt.body)), "acc-1")
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			_ = ctrl.Invite(e.NewContext(req, rec), "ws-1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+			if tt.wantStatus == http.StatusOK && (input.InviteInput.WorkspaceID != "ws-1" || input.InviteInput.Role != tt.wantRole) {
+				t.Fatalf("unexpected input: %+v", input.InviteInput)
+			}
+		})
+	}
+}
+
+func TestWorkspaceController_AcceptInvite(t *testing.T) {
+	tests := []struct {
+		name       string
+		inErr      error
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "[Success] accept invite", wantStatus: http.StatusOK, wantBody: `"id":"ws-1"`},
+		{name: "[Fail] invite of another email", inErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantBody: domainerr.ErrNotFound.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &ctrlmock.WorkspaceInputStub{Err: tt.inErr}
+			ctrl := newWorkspaceController(input)
+
+			e := echo.New()
+			req := withAccount(httptest.NewRequest(http.MethodPost, "/api/invites/inv-1/accept", nil), "acc-2")
+			rec := httptest.NewRecorder()
+			_ = ctrl.AcceptInvite(e.NewContext(req, rec), "inv-1")
+			assertStatusBody(t, rec, tt.wantStatus, tt.wantBody)
+		})
+	}
+}
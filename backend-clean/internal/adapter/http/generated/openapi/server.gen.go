@@ -13,19 +13,66 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// Defines values for ModelsBadRequestErrorCode.
 const (
-	ModelsBadRequestErrorCodeBADREQUEST ModelsBadRequestErrorCode = "BAD_REQUEST"
+	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
-// Defines values for ModelsForbiddenErrorCode.
+// Defines values for ModelsAuditAction.
 const (
-	ModelsForbiddenErrorCodeFORBIDDEN ModelsForbiddenErrorCode = "FORBIDDEN"
+	ModelsAuditActionCreate          ModelsAuditAction = "create"
+	ModelsAuditActionDelete          ModelsAuditAction = "delete"
+	ModelsAuditActionMigrateTemplate ModelsAuditAction = "migrate-template"
+	ModelsAuditActionPublish         ModelsAuditAction = "publish"
+	ModelsAuditActionPurge           ModelsAuditAction = "purge"
+	ModelsAuditActionRestore         ModelsAuditAction = "restore"
+	ModelsAuditActionSignIn          ModelsAuditAction = "sign-in"
+	ModelsAuditActionUnpublish       ModelsAuditAction = "unpublish"
+	ModelsAuditActionUpdate          ModelsAuditAction = "update"
 )
 
-// Defines values for ModelsNotFoundErrorCode.
+// Defines values for ModelsAuditAggregateType.
 const (
-	ModelsNotFoundErrorCodeNOTFOUND ModelsNotFoundErrorCode = "NOT_FOUND"
+	ModelsAuditAggregateTypeAccount  ModelsAuditAggregateType = "account"
+	ModelsAuditAggregateTypeNote     ModelsAuditAggregateType = "note"
+	ModelsAuditAggregateTypeTemplate ModelsAuditAggregateType = "template"
+)
+
+// Defines values for ModelsCollaboratorRole.
+const (
+	ModelsCollaboratorRoleEditor ModelsCollaboratorRole = "editor"
+	ModelsCollaboratorRoleViewer ModelsCollaboratorRole = "viewer"
+)
+
+// Defines values for ModelsDiffOp.
+const (
+	ModelsDiffOpDelete ModelsDiffOp = "delete"
+	ModelsDiffOpEqual  ModelsDiffOp = "equal"
+	ModelsDiffOpInsert ModelsDiffOp = "insert"
+)
+
+// Defines values for ModelsFieldType.
+const (
+	ModelsFieldTypeBoolean      ModelsFieldType = "boolean"
+	ModelsFieldTypeDate         ModelsFieldType = "date"
+	ModelsFieldTypeMarkdown     ModelsFieldType = "markdown"
+	ModelsFieldTypeNumber       ModelsFieldType = "number"
+	ModelsFieldTypeSingleSelect ModelsFieldType = "single-select"
+	ModelsFieldTypeText         ModelsFieldType = "text"
+	ModelsFieldTypeUrl          ModelsFieldType = "url"
+)
+
+// Defines values for ModelsNoteLinkType.
+const (
+	ModelsNoteLinkTypeDependsOn  ModelsNoteLinkType = "depends-on"
+	ModelsNoteLinkTypeRelatesTo  ModelsNoteLinkType = "relates-to"
+	ModelsNoteLinkTypeSupersedes ModelsNoteLinkType = "supersedes"
+)
+
+// Defines values for ModelsNoteSearchTarget.
+const (
+	ModelsNoteSearchTargetContent ModelsNoteSearchTarget = "content"
+	ModelsNoteSearchTargetLabel   ModelsNoteSearchTarget = "label"
+	ModelsNoteSearchTargetTitle   ModelsNoteSearchTarget = "title"
 )
 
 // Defines values for ModelsNoteStatus.
@@ -34,9 +81,34 @@ const (
 	ModelsNoteStatusPublish ModelsNoteStatus = "Publish"
 )
 
-// Defines values for ModelsUnauthorizedErrorCode.
+// Defines values for ModelsTagMatch.
+const (
+	ModelsTagMatchAll ModelsTagMatch = "all"
+	ModelsTagMatchAny ModelsTagMatch = "any"
+)
+
+// Defines values for ModelsWebhookDeliveryStatus.
+const (
+	ModelsWebhookDeliveryStatusFailed    ModelsWebhookDeliveryStatus = "failed"
+	ModelsWebhookDeliveryStatusPending   ModelsWebhookDeliveryStatus = "pending"
+	ModelsWebhookDeliveryStatusSucceeded ModelsWebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for ModelsWebhookEventType.
+const (
+	ModelsWebhookEventTypeNoteDeleted     ModelsWebhookEventType = "note.deleted"
+	ModelsWebhookEventTypeNotePublished   ModelsWebhookEventType = "note.published"
+	ModelsWebhookEventTypeNoteUnpublished ModelsWebhookEventType = "note.unpublished"
+	ModelsWebhookEventTypeNoteUpdated     ModelsWebhookEventType = "note.updated"
+	ModelsWebhookEventTypeTemplateDeleted ModelsWebhookEventType = "template.deleted"
+	ModelsWebhookEventTypeTemplateUpdated ModelsWebhookEventType = "template.updated"
+)
+
+// Defines values for ModelsWorkspaceRole.
 const (
-	ModelsUnauthorizedErrorCodeUNAUTHORIZED ModelsUnauthorizedErrorCode = "UNAUTHORIZED"
+	ModelsWorkspaceRoleAdmin  ModelsWorkspaceRole = "admin"
+	ModelsWorkspaceRoleMember ModelsWorkspaceRole = "member"
+	ModelsWorkspaceRoleOwner  ModelsWorkspaceRole = "owner"
 )
 
 // ModelsAccount アカウント情報
@@ -114,15 +186,137 @@ type ModelsAccountSummary struct {
 	Thumbnail *string `json:"thumbnail,omitempty"`
 }
 
-// ModelsBadRequestError Bad Request エラー
-type ModelsBadRequestError struct {
-	Code    ModelsBadRequestErrorCode `json:"code"`
-	Details interface{}               `json:"details,omitempty"`
-	Message string                    `json:"message"`
+// ModelsAuditAction 監査ログに記録される操作
+type ModelsAuditAction string
+
+// ModelsAuditAggregateType 監査ログの対象の種別
+type ModelsAuditAggregateType string
+
+// ModelsAuditLogEntry 監査ログのエントリ
+type ModelsAuditLogEntry struct {
+	// Action 操作
+	Action ModelsAuditAction `json:"action"`
+
+	// ActorId 操作したアカウントID（保持期間経過による自動削除では省略）
+	ActorId *string `json:"actorId,omitempty"`
+
+	// After 変更後の状態（完全削除では省略）
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// AggregateId 対象のID
+	AggregateId string `json:"aggregateId"`
+
+	// AggregateType 対象の種別
+	AggregateType ModelsAuditAggregateType `json:"aggregateType"`
+
+	// Before 変更前の状態（作成では省略）
+	Before *map[string]interface{} `json:"before,omitempty"`
+
+	// Hash このエントリのハッシュ（SHA-256）
+	Hash string `json:"hash"`
+
+	// OccurredAt 記録日時
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// PrevHash 直前のエントリのハッシュ（最初のエントリでは空文字）
+	PrevHash string `json:"prevHash"`
+
+	// RequestId 操作を行ったリクエストのID（X-Request-IDヘッダーの値）
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Sequence 連番（追記順）
+	Sequence int64 `json:"sequence"`
+}
+
+// ModelsAuditLogListResponse 監査ログ（1ページ分）
+type ModelsAuditLogListResponse struct {
+	// HasMore 次のページがあるかどうか
+	HasMore bool `json:"hasMore"`
+
+	// Items 監査ログ
+	Items []ModelsAuditLogEntry `json:"items"`
+
+	// NextCursor 次のページを取得するためのカーソル（次のページがない場合は省略）
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// ModelsAuditVerificationResponse ハッシュチェーンの検証結果
+type ModelsAuditVerificationResponse struct {
+	// BrokenAt チェーンに合致しなかった最初のエントリの連番（合致した場合は省略）
+	BrokenAt *int64 `json:"brokenAt,omitempty"`
+
+	// Checked 検証したエントリ数
+	Checked int32 `json:"checked"`
+
+	// Valid すべてのエントリがチェーンに合致したかどうか
+	Valid bool `json:"valid"`
+}
+
+// ModelsCollaboratorRole 共同編集者のロール
+type ModelsCollaboratorRole string
+
+// ModelsCommentResponse コメント
+type ModelsCommentResponse struct {
+	// Author 投稿者情報
+	Author ModelsAccountSummary `json:"author"`
+
+	// Body 本文
+	Body string `json:"body"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id コメントID
+	Id string `json:"id"`
+
+	// NoteId ノートID
+	NoteId string `json:"noteId"`
+
+	// ParentId 返信先スレッドのコメントID（スレッドの先頭では省略）
+	ParentId *string `json:"parentId,omitempty"`
+
+	// Resolved 解決済みかどうか（スレッドの先頭のみ true になりうる）
+	Resolved bool `json:"resolved"`
+
+	// ResolvedAt 解決日時
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+
+	// ResolvedBy 解決したアカウントID
+	ResolvedBy *string `json:"resolvedBy,omitempty"`
+
+	// SectionId 対象セクションID（ノート全体へのコメントでは省略）
+	SectionId *string `json:"sectionId,omitempty"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ModelsCommentThread コメントスレッド
+type ModelsCommentThread struct {
+	// Comment スレッドの先頭コメント
+	Comment ModelsCommentResponse `json:"comment"`
+
+	// Replies 返信（古い順）
+	Replies []ModelsCommentResponse `json:"replies"`
+}
+
+// ModelsCommentThreadListResponse コメントスレッド一覧レスポンス
+type ModelsCommentThreadListResponse struct {
+	// Items スレッドの作成順
+	Items []ModelsCommentThread `json:"items"`
 }
 
-// ModelsBadRequestErrorCode defines model for ModelsBadRequestError.Code.
-type ModelsBadRequestErrorCode string
+// ModelsCreateCommentRequest コメント作成リクエスト
+type ModelsCreateCommentRequest struct {
+	// Body 本文（1〜10000文字）
+	Body string `json:"body"`
+
+	// ParentId 返信先スレッドのコメントID
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+
+	// SectionId 対象セクションID（スレッドの先頭のみ指定可）
+	SectionId *openapi_types.UUID `json:"sectionId,omitempty"`
+}
 
 // ModelsCreateFieldRequest テンプレートフィールド作成リクエスト
 type ModelsCreateFieldRequest struct {
@@ -132,18 +326,33 @@ type ModelsCreateFieldRequest struct {
 	// Label ラベル
 	Label string `json:"label"`
 
+	// Options 選択肢（single-select のみ必須。それ以外の型では指定しない）
+	Options *[]string `json:"options,omitempty"`
+
 	// Order 表示順序
 	Order int32 `json:"order"`
+
+	// Type 項目の型（省略時は text）
+	Type *ModelsFieldType `json:"type,omitempty"`
+}
+
+// ModelsCreateNoteLinkRequest ノートリンク作成リクエスト
+type ModelsCreateNoteLinkRequest struct {
+	// TargetNoteId リンク先ノートID
+	TargetNoteId openapi_types.UUID `json:"targetNoteId"`
+
+	// Type リンクの種類
+	Type ModelsNoteLinkType `json:"type"`
 }
 
 // ModelsCreateNoteRequest ノート作成リクエスト
 type ModelsCreateNoteRequest struct {
-	// OwnerId 所有者ID
-	OwnerId openapi_types.UUID `json:"ownerId"`
-
 	// Sections セクション（オプション）
 	Sections *[]ModelsCreateSectionRequest `json:"sections,omitempty"`
 
+	// Tags タグ（小文字にそろえ、空白は「-」にまとめる。1ノート20個まで）
+	Tags *[]string `json:"tags,omitempty"`
+
 	// TemplateId テンプレートID
 	TemplateId openapi_types.UUID `json:"templateId"`
 
@@ -185,23 +394,44 @@ type ModelsCreateTemplateRequest struct {
 
 	// Name テンプレート名
 	Name string `json:"name"`
+}
 
-	// OwnerId 所有者ID
-	OwnerId openapi_types.UUID `json:"ownerId"`
+// ModelsCreateWebhookRequest Webhook登録リクエスト
+type ModelsCreateWebhookRequest struct {
+	// Events 購読するイベント（1つ以上）
+	Events []ModelsWebhookEventType `json:"events"`
+
+	// Url 配信先URL（http/https）
+	Url string `json:"url"`
 }
 
-// ModelsErrorResponse 共通エラーレスポンス
-type ModelsErrorResponse struct {
-	// Code エラーコード
-	Code string `json:"code"`
+// ModelsCreateWorkspaceInviteRequest 招待リクエスト
+type ModelsCreateWorkspaceInviteRequest struct {
+	// Email 招待先メールアドレス
+	Email string `json:"email"`
+
+	// Role 参加時のロール（admin か member。省略時は member）
+	Role *ModelsWorkspaceRole `json:"role,omitempty"`
+}
+
+// ModelsCreateWorkspaceRequest ワークスペース作成リクエスト
+type ModelsCreateWorkspaceRequest struct {
+	// Name 名前
+	Name string `json:"name"`
+}
 
-	// Details 詳細情報（オプション）
-	Details interface{} `json:"details,omitempty"`
+// ModelsDiffLine 差分の1行
+type ModelsDiffLine struct {
+	// Op 差分の種類
+	Op ModelsDiffOp `json:"op"`
 
-	// Message エラーメッセージ
-	Message string `json:"message"`
+	// Text 行の内容
+	Text string `json:"text"`
 }
 
+// ModelsDiffOp 差分の種類
+type ModelsDiffOp string
+
 // ModelsField テンプレートフィールド
 type ModelsField struct {
 	// Id フィールドID
@@ -213,34 +443,79 @@ type ModelsField struct {
 	// Label ラベル
 	Label string `json:"label"`
 
+	// Options 選択肢（single-select 以外は空）
+	Options []string `json:"options"`
+
 	// Order 表示順序
 	Order int32 `json:"order"`
+
+	// Type 項目の型
+	Type ModelsFieldType `json:"type"`
+}
+
+// ModelsFieldDiff フィールドごとの差分
+type ModelsFieldDiff struct {
+	// Changed 変更があるか
+	Changed bool `json:"changed"`
+
+	// FieldId フィールドID
+	FieldId string `json:"fieldId"`
+
+	// FieldLabel フィールドラベル
+	FieldLabel string `json:"fieldLabel"`
+
+	// Lines 行単位の差分
+	Lines []ModelsDiffLine `json:"lines"`
 }
 
-// ModelsForbiddenError Forbidden エラー
-type ModelsForbiddenError struct {
-	Code    ModelsForbiddenErrorCode `json:"code"`
-	Message string                   `json:"message"`
+// ModelsFieldMapping 旧バージョンのフィールドから最新バージョンのフィールドへの内容の引き継ぎ
+type ModelsFieldMapping struct {
+	// FromFieldId 引き継ぎ元（ノートの現在のバージョン）のフィールドID
+	FromFieldId string `json:"fromFieldId"`
+
+	// ToFieldId 引き継ぎ先（テンプレートの最新バージョン）のフィールドID
+	ToFieldId string `json:"toFieldId"`
 }
 
-// ModelsForbiddenErrorCode defines model for ModelsForbiddenError.Code.
-type ModelsForbiddenErrorCode string
+// ModelsFieldType テンプレート項目の型
+type ModelsFieldType string
+
+// ModelsMigrateNoteTemplateRequest ノートのテンプレート移行リクエスト
+type ModelsMigrateNoteTemplateRequest struct {
+	// Mappings フィールドの対応（対応のないフィールドは空で作成される）
+	Mappings []ModelsFieldMapping `json:"mappings"`
+
+	// Version 編集元のバージョン（If-Matchヘッダーでも指定可。一致しない場合は409）
+	Version *int32 `json:"version,omitempty"`
+}
 
-// ModelsNotFoundError Not Found エラー
-type ModelsNotFoundError struct {
-	Code    ModelsNotFoundErrorCode `json:"code"`
-	Message string                  `json:"message"`
+// ModelsNoteCollaboratorListResponse 共同編集者一覧レスポンス
+type ModelsNoteCollaboratorListResponse struct {
+	// Items 追加順
+	Items []ModelsNoteCollaboratorResponse `json:"items"`
 }
 
-// ModelsNotFoundErrorCode defines model for ModelsNotFoundError.Code.
-type ModelsNotFoundErrorCode string
+// ModelsNoteCollaboratorResponse 共同編集者
+type ModelsNoteCollaboratorResponse struct {
+	// Account アカウント情報
+	Account ModelsAccountSummary `json:"account"`
+
+	// CreatedAt 追加日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Role ロール
+	Role ModelsCollaboratorRole `json:"role"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
 // ModelsNoteFilters ノートフィルター（クエリパラメータ）
 type ModelsNoteFilters struct {
 	// OwnerId 所有者IDフィルター
 	OwnerId *string `json:"ownerId,omitempty"`
 
-	// Q タイトルキーワード検索
+	// Q キーワード検索（タイトル・本文・項目名の部分一致）
 	Q *string `json:"q,omitempty"`
 
 	// Status ステータスフィルター
@@ -250,14 +525,65 @@ type ModelsNoteFilters struct {
 	TemplateId *string `json:"templateId,omitempty"`
 }
 
+// ModelsNoteLinkSummary リンク先（またはリンク元）ノートの概要
+type ModelsNoteLinkSummary struct {
+	// Id リンクID
+	Id string `json:"id"`
+
+	// NoteId 相手側のノートID
+	NoteId string `json:"noteId"`
+
+	// Status 相手側のノートステータス
+	Status ModelsNoteStatus `json:"status"`
+
+	// Title 相手側のノートタイトル
+	Title string `json:"title"`
+
+	// Type リンクの種類
+	Type ModelsNoteLinkType `json:"type"`
+}
+
+// ModelsNoteLinkType ノート間リンクの種類
+type ModelsNoteLinkType string
+
+// ModelsNoteLinksResponse ノートのリンク一覧
+type ModelsNoteLinksResponse struct {
+	// Backlinks このノートへのリンク（バックリンク）
+	Backlinks []ModelsNoteLinkSummary `json:"backlinks"`
+
+	// Outgoing このノートからのリンク
+	Outgoing []ModelsNoteLinkSummary `json:"outgoing"`
+}
+
+// ModelsNoteListResponse ノート一覧（1ページ分）
+type ModelsNoteListResponse struct {
+	// HasMore 次のページがあるかどうか
+	HasMore bool `json:"hasMore"`
+
+	// Items ノート一覧
+	Items []ModelsNoteResponse `json:"items"`
+
+	// NextCursor 次のページを取得するためのカーソル（次のページがない場合は省略）
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
 // ModelsNoteResponse ノートレスポンス
 type ModelsNoteResponse struct {
+	// Backlinks このノートへのリンク（詳細取得時のみ）
+	Backlinks *[]ModelsNoteLinkSummary `json:"backlinks,omitempty"`
+
 	// CreatedAt 作成日時
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DeletedAt ゴミ箱へ移動した日時（ゴミ箱のノートのみ）
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
 	// Id ノートID
 	Id string `json:"id"`
 
+	// Links このノートからのリンク（詳細取得時のみ）
+	Links *[]ModelsNoteLinkSummary `json:"links,omitempty"`
+
 	// Owner 所有者情報
 	Owner ModelsAccountSummary `json:"owner"`
 
@@ -270,22 +596,182 @@ type ModelsNoteResponse struct {
 	// Status ステータス
 	Status ModelsNoteStatus `json:"status"`
 
+	// Tags タグ（昇順）
+	Tags []string `json:"tags"`
+
 	// TemplateId テンプレートID
 	TemplateId string `json:"templateId"`
 
 	// TemplateName テンプレート名
 	TemplateName string `json:"templateName"`
 
+	// TemplateVersion セクションが従うテンプレートのフィールド構成のバージョン
+	TemplateVersion int32 `json:"templateVersion"`
+
 	// Title タイトル
 	Title string `json:"title"`
 
 	// UpdatedAt 更新日時
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Version バージョン（更新のたびに増える。楽観的排他制御に使う）
+	Version int32 `json:"version"`
+}
+
+// ModelsNoteRevisionDiffResponse ノート変更履歴の差分
+type ModelsNoteRevisionDiffResponse struct {
+	// Fields フィールドごとの差分
+	Fields []ModelsFieldDiff `json:"fields"`
+
+	// From 比較元リビジョン番号（0 は空のノート）
+	From int32 `json:"from"`
+
+	// NoteId ノートID
+	NoteId string `json:"noteId"`
+
+	// StatusFrom 比較元ステータス
+	StatusFrom *ModelsNoteStatus `json:"statusFrom,omitempty"`
+
+	// StatusTo 比較先ステータス
+	StatusTo ModelsNoteStatus `json:"statusTo"`
+
+	// Title タイトルの差分
+	Title []ModelsDiffLine `json:"title"`
+
+	// To 比較先リビジョン番号
+	To int32 `json:"to"`
+}
+
+// ModelsNoteRevisionResponse ノート変更履歴詳細
+type ModelsNoteRevisionResponse struct {
+	// ActorId 変更者ID
+	ActorId string `json:"actorId"`
+
+	// CreatedAt 記録日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Revision リビジョン番号
+	Revision int32 `json:"revision"`
+
+	// Sections セクション
+	Sections []ModelsRevisionSection `json:"sections"`
+
+	// Status ステータス
+	Status ModelsNoteStatus `json:"status"`
+
+	// Title タイトル
+	Title string `json:"title"`
+}
+
+// ModelsNoteRevisionSummary ノート変更履歴サマリー
+type ModelsNoteRevisionSummary struct {
+	// ActorId 変更者ID
+	ActorId string `json:"actorId"`
+
+	// CreatedAt 記録日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Revision リビジョン番号
+	Revision int32 `json:"revision"`
+
+	// Status ステータス
+	Status ModelsNoteStatus `json:"status"`
+
+	// Title タイトル
+	Title string `json:"title"`
+}
+
+// ModelsNoteSearchHighlight スニペット内で検索キーワードに一致した範囲
+type ModelsNoteSearchHighlight struct {
+	// End 終了位置（文字単位、この位置の文字は含まない）
+	End int32 `json:"end"`
+
+	// Start 開始位置（文字単位）
+	Start int32 `json:"start"`
+}
+
+// ModelsNoteSearchResult ノート検索結果
+type ModelsNoteSearchResult struct {
+	// Highlights スニペット内の一致範囲
+	Highlights []ModelsNoteSearchHighlight `json:"highlights"`
+
+	// Note ノートレスポンス
+	Note ModelsNoteResponse `json:"note"`
+
+	// Rank 関連度（大きいほど関連が高い）
+	Rank float64 `json:"rank"`
+
+	// Snippet 一致箇所の前後を切り出した本文
+	Snippet string `json:"snippet"`
 }
 
+// ModelsNoteSearchTarget 検索対象（title=タイトル, content=本文, label=項目名）
+type ModelsNoteSearchTarget string
+
 // ModelsNoteStatus ノートのステータス
 type ModelsNoteStatus string
 
+// ModelsProblem エラーレスポンス（RFC 9457 Problem Details）
+// `code` はドメインエラーごとに固定の機械判読用コード
+type ModelsProblem struct {
+	// Code 機械判読用のエラーコード（例: TITLE_REQUIRED）
+	Code string `json:"code"`
+
+	// Detail エラーの説明
+	Detail string `json:"detail"`
+
+	// Errors 入力項目ごとのエラー
+	Errors *[]ModelsProblemFieldError `json:"errors,omitempty"`
+
+	// Instance 問題が起きたリクエストのID（X-Request-ID と同じ値）
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTPステータスコード
+	Status int32 `json:"status"`
+
+	// Title HTTPステータスの説明（例: Bad Request）
+	Title string `json:"title"`
+
+	// Type 問題の種類を表すURI（既定は about:blank）
+	Type string `json:"type"`
+}
+
+// ModelsProblemFieldError 入力項目のエラー
+type ModelsProblemFieldError struct {
+	// Detail エラーの説明
+	Detail string `json:"detail"`
+
+	// Pointer リクエストボディ内の項目を指すJSON Pointer（例: /title）
+	Pointer string `json:"pointer"`
+}
+
+// ModelsPutNoteCollaboratorRequest 共同編集者の追加・ロール変更リクエスト
+type ModelsPutNoteCollaboratorRequest struct {
+	// Role ロール
+	Role ModelsCollaboratorRole `json:"role"`
+}
+
+// ModelsPutWorkspaceMemberRequest メンバーのロール変更リクエスト
+type ModelsPutWorkspaceMemberRequest struct {
+	// Role ロール（owner は指定不可）
+	Role ModelsWorkspaceRole `json:"role"`
+}
+
+// ModelsRevisionSection 変更履歴のセクションスナップショット
+type ModelsRevisionSection struct {
+	// Content 内容
+	Content string `json:"content"`
+
+	// FieldId フィールドID
+	FieldId string `json:"fieldId"`
+
+	// FieldLabel フィールドラベル（記録時点）
+	FieldLabel string `json:"fieldLabel"`
+
+	// FieldOrder フィールド順序（記録時点）
+	FieldOrder int32 `json:"fieldOrder"`
+}
+
 // ModelsSection セクション（ノートの各項目）
 type ModelsSection struct {
 	// Content 内容
@@ -297,6 +783,12 @@ type ModelsSection struct {
 	// FieldLabel フィールドラベル
 	FieldLabel string `json:"fieldLabel"`
 
+	// FieldOptions フィールドの選択肢（single-select 以外は空）
+	FieldOptions []string `json:"fieldOptions"`
+
+	// FieldType フィールドの型
+	FieldType ModelsFieldType `json:"fieldType"`
+
 	// Id セクションID
 	Id string `json:"id"`
 
@@ -309,15 +801,51 @@ type ModelsSuccessResponse struct {
 	Success bool `json:"success"`
 }
 
+// ModelsTagCount タグと、そのタグを持つノートの件数
+type ModelsTagCount struct {
+	// Count タグを持つノートの件数
+	Count int32 `json:"count"`
+
+	// Tag タグ
+	Tag string `json:"tag"`
+}
+
+// ModelsTagListResponse タグ一覧レスポンス
+type ModelsTagListResponse struct {
+	// Items 件数の多い順
+	Items []ModelsTagCount `json:"items"`
+}
+
+// ModelsTagMatch 複数タグでの絞り込み方
+type ModelsTagMatch string
+
+// ModelsTemplateListResponse テンプレート一覧（1ページ分）
+type ModelsTemplateListResponse struct {
+	// HasMore 次のページがあるかどうか
+	HasMore bool `json:"hasMore"`
+
+	// Items テンプレート一覧
+	Items []ModelsTemplateResponse `json:"items"`
+
+	// NextCursor 次のページを取得するためのカーソル（次のページがない場合は省略）
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
 // ModelsTemplateResponse テンプレートレスポンス
 type ModelsTemplateResponse struct {
+	// DeletedAt ゴミ箱へ移動した日時（ゴミ箱のテンプレートのみ）
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
 	// Fields フィールド一覧
 	Fields []ModelsField `json:"fields"`
 
+	// FieldsVersion 現在のフィールド構成を導入したバージョン（新しいノートはこのバージョンに固定される）
+	FieldsVersion int32 `json:"fieldsVersion"`
+
 	// Id テンプレートID
 	Id string `json:"id"`
 
-	// IsUsed 使用中フラグ
+	// IsUsed 使用中フラグ（ゴミ箱のノートによる使用も含む）
 	IsUsed bool `json:"isUsed"`
 
 	// Name テンプレート名
@@ -331,16 +859,16 @@ type ModelsTemplateResponse struct {
 
 	// UpdatedAt 更新日時
 	UpdatedAt time.Time `json:"updatedAt"`
-}
 
-// ModelsUnauthorizedError Unauthorized エラー
-type ModelsUnauthorizedError struct {
-	Code    ModelsUnauthorizedErrorCode `json:"code"`
-	Message string                      `json:"message"`
+	// Version バージョン（更新のたびに増える。楽観的排他制御に使う）
+	Version int32 `json:"version"`
 }
 
-// ModelsUnauthorizedErrorCode defines model for ModelsUnauthorizedError.Code.
-type ModelsUnauthorizedErrorCode string
+// ModelsUpdateCommentRequest コメント更新リクエスト
+type ModelsUpdateCommentRequest struct {
+	// Body 本文（1〜10000文字）
+	Body string `json:"body"`
+}
 
 // ModelsUpdateFieldRequest フィールド更新リクエスト
 type ModelsUpdateFieldRequest struct {
@@ -353,20 +881,35 @@ type ModelsUpdateFieldRequest struct {
 	// Label ラベル
 	Label string `json:"label"`
 
+	// Options 選択肢（single-select のみ必須。それ以外の型では指定しない）
+	Options *[]string `json:"options,omitempty"`
+
 	// Order 表示順序
 	Order int32 `json:"order"`
+
+	// Type 項目の型（省略時は text）
+	Type *ModelsFieldType `json:"type,omitempty"`
 }
 
 // ModelsUpdateNoteRequest ノート更新リクエスト
 type ModelsUpdateNoteRequest struct {
+	// AddTags 追加するタグ
+	AddTags *[]string `json:"addTags,omitempty"`
+
 	// Id ノートID
 	Id string `json:"id"`
 
+	// RemoveTags 外すタグ（追加より先に適用される）
+	RemoveTags *[]string `json:"removeTags,omitempty"`
+
 	// Sections セクション
 	Sections []ModelsUpdateSectionRequest `json:"sections"`
 
 	// Title タイトル
 	Title string `json:"title"`
+
+	// Version 編集元のバージョン（If-Matchヘッダーでも指定可。一致しない場合は409）
+	Version *int32 `json:"version,omitempty"`
 }
 
 // ModelsUpdateSectionRequest セクション更新リクエスト
@@ -388,69 +931,362 @@ type ModelsUpdateTemplateRequest struct {
 
 	// Name テンプレート名
 	Name string `json:"name"`
-}
 
-// AccountsGetAccountByEmailParams defines parameters for AccountsGetAccountByEmail.
-type AccountsGetAccountByEmailParams struct {
-	Email string `form:"email" json:"email"`
+	// Version 編集元のバージョン（If-Matchヘッダーでも指定可。一致しない場合は409）
+	Version *int32 `json:"version,omitempty"`
 }
 
-// NotesListNotesParams defines parameters for NotesListNotes.
-type NotesListNotesParams struct {
-	// Q タイトルキーワード検索
-	Q *string `form:"q,omitempty" json:"q,omitempty"`
-
-	// Status ステータスフィルター
-	Status *ModelsNoteStatus `form:"status,omitempty" json:"status,omitempty"`
+// ModelsUpdateWebhookRequest Webhook更新リクエスト
+type ModelsUpdateWebhookRequest struct {
+	// Active 有効かどうか（無効の間はイベントを配信しない）
+	Active bool `json:"active"`
 
-	// TemplateId テンプレートIDフィルター
-	TemplateId *string `form:"templateId,omitempty" json:"templateId,omitempty"`
+	// Events 購読するイベント（1つ以上）
+	Events []ModelsWebhookEventType `json:"events"`
 
-	// OwnerId 所有者IDフィルター
-	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+	// Url 配信先URL（http/https）
+	Url string `json:"url"`
 }
 
-// NotesDeleteNoteParams defines parameters for NotesDeleteNote.
-type NotesDeleteNoteParams struct {
-	// OwnerId 所有者ID（権限チェック用）
-	OwnerId string `form:"ownerId" json:"ownerId"`
+// ModelsUpdateWorkspaceRequest ワークスペース更新リクエスト
+type ModelsUpdateWorkspaceRequest struct {
+	// Name 名前
+	Name string `json:"name"`
 }
 
-// NotesUpdateNoteParams defines parameters for NotesUpdateNote.
-type NotesUpdateNoteParams struct {
-	// OwnerId 所有者ID（権限チェック用）
-	OwnerId string `form:"ownerId" json:"ownerId"`
-}
+// ModelsWebhookDelivery Webhookの配信ログ
+type ModelsWebhookDelivery struct {
+	// Attempts 送信試行回数
+	Attempts int32 `json:"attempts"`
 
-// NotesPublishNoteParams defines parameters for NotesPublishNote.
-type NotesPublishNoteParams struct {
-	// OwnerId 所有者ID（公開権限チェック用）
-	OwnerId string `form:"ownerId" json:"ownerId"`
-}
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
 
-// NotesUnpublishNoteParams defines parameters for NotesUnpublishNote.
-type NotesUnpublishNoteParams struct {
-	// OwnerId 所有者ID（公開権限チェック用）
-	OwnerId string `form:"ownerId" json:"ownerId"`
-}
+	// DeliveredAt 配信成功日時
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
 
-// TemplatesListTemplatesParams defines parameters for TemplatesListTemplates.
-type TemplatesListTemplatesParams struct {
-	// Q テンプレート名のキーワード検索
-	Q *string `form:"q,omitempty" json:"q,omitempty"`
+	// EventId イベントID（再送でも変わらない。受信側の重複排除に使う）
+	EventId string `json:"eventId"`
 
-	// OwnerId 所有者IDフィルター
-	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
-}
+	// EventType イベント種別
+	EventType ModelsWebhookEventType `json:"eventType"`
 
-// TemplatesDeleteTemplateParams defines parameters for TemplatesDeleteTemplate.
-type TemplatesDeleteTemplateParams struct {
-	OwnerId string `form:"ownerId" json:"ownerId"`
-}
+	// Id 配信ID（X-Webhook-Idヘッダーの値）
+	Id string `json:"id"`
+
+	// LastError 最後の試行で応答が得られなかった理由
+	LastError string `json:"lastError"`
+
+	// LastStatusCode 最後の試行のHTTPステータス（応答がなければ0）
+	LastStatusCode int32 `json:"lastStatusCode"`
+
+	// NextAttemptAt 次の送信予定日時（pendingのときのみ）
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// Payload 送信する本文
+	Payload map[string]interface{} `json:"payload"`
+
+	// RedeliveryOf 再送元の配信ID（手動再送のときのみ）
+	RedeliveryOf *string `json:"redeliveryOf,omitempty"`
+
+	// Status 配信状態
+	Status ModelsWebhookDeliveryStatus `json:"status"`
+
+	// WebhookId WebhookID
+	WebhookId string `json:"webhookId"`
+}
+
+// ModelsWebhookDeliveryListResponse 配信ログ（1ページ分）
+type ModelsWebhookDeliveryListResponse struct {
+	// HasMore 次のページがあるかどうか
+	HasMore bool `json:"hasMore"`
+
+	// Items 配信ログ
+	Items []ModelsWebhookDelivery `json:"items"`
+
+	// NextCursor 次のページを取得するためのカーソル（次のページがない場合は省略）
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// ModelsWebhookDeliveryStatus 配信状態
+type ModelsWebhookDeliveryStatus string
+
+// ModelsWebhookEventType Webhookで購読できるイベント
+type ModelsWebhookEventType string
+
+// ModelsWebhookListResponse Webhook一覧
+type ModelsWebhookListResponse struct {
+	// Items Webhook一覧
+	Items []ModelsWebhookResponse `json:"items"`
+}
+
+// ModelsWebhookResponse Webhookレスポンス
+type ModelsWebhookResponse struct {
+	// Active 有効かどうか
+	Active bool `json:"active"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Events 購読するイベント
+	Events []ModelsWebhookEventType `json:"events"`
+
+	// Id WebhookID
+	Id string `json:"id"`
+
+	// Secret 署名用シークレット（登録時のみ返す）
+	Secret *string `json:"secret,omitempty"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Url 配信先URL
+	Url string `json:"url"`
+}
+
+// ModelsWorkspaceInviteListResponse 招待一覧レスポンス
+type ModelsWorkspaceInviteListResponse struct {
+	// Items 招待順
+	Items []ModelsWorkspaceInviteResponse `json:"items"`
+}
+
+// ModelsWorkspaceInviteResponse ワークスペースへの招待
+type ModelsWorkspaceInviteResponse struct {
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Email 招待先メールアドレス
+	Email string `json:"email"`
+
+	// Id 招待ID
+	Id string `json:"id"`
+
+	// InvitedBy 招待したアカウントID
+	InvitedBy string `json:"invitedBy"`
+
+	// Role 参加時のロール
+	Role ModelsWorkspaceRole `json:"role"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// WorkspaceId ワークスペースID
+	WorkspaceId string `json:"workspaceId"`
+
+	// WorkspaceName ワークスペース名
+	WorkspaceName string `json:"workspaceName"`
+}
+
+// ModelsWorkspaceListResponse ワークスペース一覧レスポンス
+type ModelsWorkspaceListResponse struct {
+	// Items 参加順。先頭が X-Workspace-ID 省略時の既定ワークスペース
+	Items []ModelsWorkspaceResponse `json:"items"`
+}
+
+// ModelsWorkspaceMemberListResponse メンバー一覧レスポンス
+type ModelsWorkspaceMemberListResponse struct {
+	// Items 参加順
+	Items []ModelsWorkspaceMemberResponse `json:"items"`
+}
+
+// ModelsWorkspaceMemberResponse ワークスペースのメンバー
+type ModelsWorkspaceMemberResponse struct {
+	// Account アカウント情報
+	Account ModelsAccountSummary `json:"account"`
+
+	// CreatedAt 参加日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Email メールアドレス
+	Email string `json:"email"`
+
+	// Role ロール
+	Role ModelsWorkspaceRole `json:"role"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ModelsWorkspaceResponse ワークスペース
+type ModelsWorkspaceResponse struct {
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id ワークスペースID
+	Id string `json:"id"`
+
+	// Name 名前
+	Name string `json:"name"`
+
+	// Role リクエストしたアカウントのロール
+	Role ModelsWorkspaceRole `json:"role"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ModelsWorkspaceRole ワークスペースでのロール
+type ModelsWorkspaceRole string
+
+// AccountsGetAccountByEmailParams defines parameters for AccountsGetAccountByEmail.
+type AccountsGetAccountByEmailParams struct {
+	Email string `form:"email" json:"email"`
+}
+
+// AdminListAuditLogsParams defines parameters for AdminListAuditLogs.
+type AdminListAuditLogsParams struct {
+	// ActorId 操作したアカウントIDフィルター
+	ActorId *string `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// AggregateType 対象の種別フィルター
+	AggregateType *ModelsAuditAggregateType `form:"aggregateType,omitempty" json:"aggregateType,omitempty"`
+
+	// AggregateId 対象のIDフィルター
+	AggregateId *string `form:"aggregateId,omitempty" json:"aggregateId,omitempty"`
+
+	// From この日時以降に記録されたもの
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To この日時より前に記録されたもの
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Cursor 前のページのnextCursor（省略時は先頭から）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（省略時20、最大100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// NotesListNotesParams defines parameters for NotesListNotes.
+type NotesListNotesParams struct {
+	// Q キーワード検索（タイトル・本文・項目名の部分一致）
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Status ステータスフィルター
+	Status *ModelsNoteStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// TemplateId テンプレートIDフィルター
+	TemplateId *string `form:"templateId,omitempty" json:"templateId,omitempty"`
+
+	// OwnerId 所有者IDフィルター
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// Tags タグフィルター（複数指定可）
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// TagMatch 複数タグの絞り込み方（省略時 any）
+	TagMatch *ModelsTagMatch `form:"tagMatch,omitempty" json:"tagMatch,omitempty"`
+
+	// Cursor 前のページのnextCursor（省略時は先頭から）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（省略時20、最大100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// NotesImportNoteMarkdownParams defines parameters for NotesImportNoteMarkdown.
+type NotesImportNoteMarkdownParams struct {
+	// TemplateId 使用するテンプレートID（最新バージョンのフィールドに対応付ける）
+	TemplateId string `form:"templateId" json:"templateId"`
+}
+
+// NotesSearchNotesParams defines parameters for NotesSearchNotes.
+type NotesSearchNotesParams struct {
+	// Q 検索キーワード（単語の区切りがない日本語も部分一致で検索できる）
+	Q string `form:"q" json:"q"`
+
+	// In 検索対象（省略時はすべて）
+	In *[]ModelsNoteSearchTarget `form:"in,omitempty" json:"in,omitempty"`
+
+	// Status ステータスフィルター
+	Status *ModelsNoteStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// TemplateId テンプレートIDフィルター
+	TemplateId *string `form:"templateId,omitempty" json:"templateId,omitempty"`
+
+	// OwnerId 所有者IDフィルター
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// Tags タグフィルター（複数指定可）
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// TagMatch 複数タグの絞り込み方（省略時 any）
+	TagMatch *ModelsTagMatch `form:"tagMatch,omitempty" json:"tagMatch,omitempty"`
+}
+
+// NotesListTrashedNotesParams defines parameters for NotesListTrashedNotes.
+type NotesListTrashedNotesParams struct {
+	// Cursor 前のページのnextCursor（省略時は先頭から）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（省略時20、最大100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// NotesUpdateNoteParams defines parameters for NotesUpdateNote.
+type NotesUpdateNoteParams struct {
+	// IfMatch 編集元のバージョン（例: "3"）。一致しない場合は409
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesMigrateNoteTemplateParams defines parameters for NotesMigrateNoteTemplate.
+type NotesMigrateNoteTemplateParams struct {
+	// IfMatch 編集元のバージョン（例: "3"）。一致しない場合は409
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// NotesDiffNoteRevisionParams defines parameters for NotesDiffNoteRevision.
+type NotesDiffNoteRevisionParams struct {
+	// Base 比較元リビジョン（省略時は直前のリビジョン）
+	Base *int32 `form:"base,omitempty" json:"base,omitempty"`
+}
+
+// TagsListTagsParams defines parameters for TagsListTags.
+type TagsListTagsParams struct {
+	// OwnerId 所有者IDフィルター
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// Limit 件数（省略時20、最大100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// TemplatesListTemplatesParams defines parameters for TemplatesListTemplates.
+type TemplatesListTemplatesParams struct {
+	// Q テンプレート名のキーワード検索
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// OwnerId 所有者IDフィルター
+	OwnerId *string `form:"ownerId,omitempty" json:"ownerId,omitempty"`
+
+	// Cursor 前のページのnextCursor（省略時は先頭から）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（省略時20、最大100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// TemplatesListTrashedTemplatesParams defines parameters for TemplatesListTrashedTemplates.
+type TemplatesListTrashedTemplatesParams struct {
+	// Cursor 前のページのnextCursor（省略時は先頭から）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（省略時20、最大100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
 
 // TemplatesUpdateTemplateParams defines parameters for TemplatesUpdateTemplate.
 type TemplatesUpdateTemplateParams struct {
-	OwnerId string `form:"ownerId" json:"ownerId"`
+	// IfMatch 編集元のバージョン（例: "3"）。一致しない場合は409
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// WebhooksListWebhookDeliveriesParams defines parameters for WebhooksListWebhookDeliveries.
+type WebhooksListWebhookDeliveriesParams struct {
+	// Cursor 前のページのnextCursor（省略時は先頭から）
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページの件数（省略時20、最大100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // AccountsCreateOrGetAccountJSONRequestBody defines body for AccountsCreateOrGetAccount for application/json ContentType.
@@ -462,12 +1298,45 @@ type NotesCreateNoteJSONRequestBody = ModelsCreateNoteRequest
 // NotesUpdateNoteJSONRequestBody defines body for NotesUpdateNote for application/json ContentType.
 type NotesUpdateNoteJSONRequestBody = ModelsUpdateNoteRequest
 
+// CollaboratorsPutCollaboratorJSONRequestBody defines body for CollaboratorsPutCollaborator for application/json ContentType.
+type CollaboratorsPutCollaboratorJSONRequestBody = ModelsPutNoteCollaboratorRequest
+
+// CommentsCreateCommentJSONRequestBody defines body for CommentsCreateComment for application/json ContentType.
+type CommentsCreateCommentJSONRequestBody = ModelsCreateCommentRequest
+
+// CommentsUpdateCommentJSONRequestBody defines body for CommentsUpdateComment for application/json ContentType.
+type CommentsUpdateCommentJSONRequestBody = ModelsUpdateCommentRequest
+
+// NotesCreateNoteLinkJSONRequestBody defines body for NotesCreateNoteLink for application/json ContentType.
+type NotesCreateNoteLinkJSONRequestBody = ModelsCreateNoteLinkRequest
+
+// NotesMigrateNoteTemplateJSONRequestBody defines body for NotesMigrateNoteTemplate for application/json ContentType.
+type NotesMigrateNoteTemplateJSONRequestBody = ModelsMigrateNoteTemplateRequest
+
 // TemplatesCreateTemplateJSONRequestBody defines body for TemplatesCreateTemplate for application/json ContentType.
 type TemplatesCreateTemplateJSONRequestBody = ModelsCreateTemplateRequest
 
 // TemplatesUpdateTemplateJSONRequestBody defines body for TemplatesUpdateTemplate for application/json ContentType.
 type TemplatesUpdateTemplateJSONRequestBody = ModelsUpdateTemplateRequest
 
+// WebhooksCreateWebhookJSONRequestBody defines body for WebhooksCreateWebhook for application/json ContentType.
+type WebhooksCreateWebhookJSONRequestBody = ModelsCreateWebhookRequest
+
+// WebhooksUpdateWebhookJSONRequestBody defines body for WebhooksUpdateWebhook for application/json ContentType.
+type WebhooksUpdateWebhookJSONRequestBody = ModelsUpdateWebhookRequest
+
+// WorkspacesCreateWorkspaceJSONRequestBody defines body for WorkspacesCreateWorkspace for application/json ContentType.
+type WorkspacesCreateWorkspaceJSONRequestBody = ModelsCreateWorkspaceRequest
+
+// WorkspacesUpdateWorkspaceJSONRequestBody defines body for WorkspacesUpdateWorkspace for application/json ContentType.
+type WorkspacesUpdateWorkspaceJSONRequestBody = ModelsUpdateWorkspaceRequest
+
+// WorkspacesCreateWorkspaceInviteJSONRequestBody defines body for WorkspacesCreateWorkspaceInvite for application/json ContentType.
+type WorkspacesCreateWorkspaceInviteJSONRequestBody = ModelsCreateWorkspaceInviteRequest
+
+// WorkspacesUpdateWorkspaceMemberJSONRequestBody defines body for WorkspacesUpdateWorkspaceMember for application/json ContentType.
+type WorkspacesUpdateWorkspaceMemberJSONRequestBody = ModelsPutWorkspaceMemberRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Create or get account via OAuth
@@ -482,42 +1351,186 @@ type ServerInterface interface {
 	// Get account by ID
 	// (GET /api/accounts/{accountId})
 	AccountsGetAccountById(ctx echo.Context, accountId string) error
+	// List audit logs
+	// (GET /api/admin/audit-logs)
+	AdminListAuditLogs(ctx echo.Context, params AdminListAuditLogsParams) error
+	// Verify audit log
+	// (GET /api/admin/audit-logs/verify)
+	AdminVerifyAuditLog(ctx echo.Context) error
+	// List my invites
+	// (GET /api/invites)
+	InvitesListMyInvites(ctx echo.Context) error
+	// Accept invite
+	// (POST /api/invites/{inviteId}/accept)
+	InvitesAcceptInvite(ctx echo.Context, inviteId string) error
 	// Get notes list
 	// (GET /api/notes)
 	NotesListNotes(ctx echo.Context, params NotesListNotesParams) error
 	// Create note
 	// (POST /api/notes)
 	NotesCreateNote(ctx echo.Context) error
+	// Import note from Markdown
+	// (POST /api/notes/import)
+	NotesImportNoteMarkdown(ctx echo.Context, params NotesImportNoteMarkdownParams) error
+	// Search notes
+	// (GET /api/notes/search)
+	NotesSearchNotes(ctx echo.Context, params NotesSearchNotesParams) error
+	// Get trashed notes
+	// (GET /api/notes/trash)
+	NotesListTrashedNotes(ctx echo.Context, params NotesListTrashedNotesParams) error
+	// Purge trashed note
+	// (DELETE /api/notes/trash/{noteId})
+	NotesPurgeNote(ctx echo.Context, noteId string) error
 	// Delete note
 	// (DELETE /api/notes/{noteId})
-	NotesDeleteNote(ctx echo.Context, noteId string, params NotesDeleteNoteParams) error
+	NotesDeleteNote(ctx echo.Context, noteId string) error
 	// Get note by ID
 	// (GET /api/notes/{noteId})
 	NotesGetNoteById(ctx echo.Context, noteId string) error
 	// Update note
 	// (PUT /api/notes/{noteId})
 	NotesUpdateNote(ctx echo.Context, noteId string, params NotesUpdateNoteParams) error
+	// List note collaborators
+	// (GET /api/notes/{noteId}/collaborators)
+	CollaboratorsListCollaborators(ctx echo.Context, noteId string) error
+	// Delete note collaborator
+	// (DELETE /api/notes/{noteId}/collaborators/{accountId})
+	CollaboratorsDeleteCollaborator(ctx echo.Context, noteId string, accountId string) error
+	// Put note collaborator
+	// (PUT /api/notes/{noteId}/collaborators/{accountId})
+	CollaboratorsPutCollaborator(ctx echo.Context, noteId string, accountId string) error
+	// List comments
+	// (GET /api/notes/{noteId}/comments)
+	CommentsListComments(ctx echo.Context, noteId string) error
+	// Create comment
+	// (POST /api/notes/{noteId}/comments)
+	CommentsCreateComment(ctx echo.Context, noteId string) error
+	// Delete comment
+	// (DELETE /api/notes/{noteId}/comments/{commentId})
+	CommentsDeleteComment(ctx echo.Context, noteId string, commentId string) error
+	// Update comment
+	// (PUT /api/notes/{noteId}/comments/{commentId})
+	CommentsUpdateComment(ctx echo.Context, noteId string, commentId string) error
+	// Resolve comment thread
+	// (POST /api/notes/{noteId}/comments/{commentId}/resolve)
+	CommentsResolveComment(ctx echo.Context, noteId string, commentId string) error
+	// Unresolve comment thread
+	// (POST /api/notes/{noteId}/comments/{commentId}/unresolve)
+	CommentsUnresolveComment(ctx echo.Context, noteId string, commentId string) error
+	// List note links
+	// (GET /api/notes/{noteId}/links)
+	NotesListNoteLinks(ctx echo.Context, noteId string) error
+	// Create note link
+	// (POST /api/notes/{noteId}/links)
+	NotesCreateNoteLink(ctx echo.Context, noteId string) error
+	// Delete note link
+	// (DELETE /api/notes/{noteId}/links/{linkId})
+	NotesDeleteNoteLink(ctx echo.Context, noteId string, linkId string) error
+	// Export note as Markdown
+	// (GET /api/notes/{noteId}/markdown)
+	NotesExportNoteMarkdown(ctx echo.Context, noteId string) error
+	// Migrate note to latest template version
+	// (POST /api/notes/{noteId}/migrate-template)
+	NotesMigrateNoteTemplate(ctx echo.Context, noteId string, params NotesMigrateNoteTemplateParams) error
 	// Publish note
 	// (POST /api/notes/{noteId}/publish)
-	NotesPublishNote(ctx echo.Context, noteId string, params NotesPublishNoteParams) error
+	NotesPublishNote(ctx echo.Context, noteId string) error
+	// Restore trashed note
+	// (POST /api/notes/{noteId}/restore)
+	NotesRestoreNote(ctx echo.Context, noteId string) error
+	// List note revisions
+	// (GET /api/notes/{noteId}/revisions)
+	NotesListNoteRevisions(ctx echo.Context, noteId string) error
+	// Get note revision
+	// (GET /api/notes/{noteId}/revisions/{revision})
+	NotesGetNoteRevision(ctx echo.Context, noteId string, revision int32) error
+	// Diff note revision
+	// (GET /api/notes/{noteId}/revisions/{revision}/diff)
+	NotesDiffNoteRevision(ctx echo.Context, noteId string, revision int32, params NotesDiffNoteRevisionParams) error
 	// Unpublish note
 	// (POST /api/notes/{noteId}/unpublish)
-	NotesUnpublishNote(ctx echo.Context, noteId string, params NotesUnpublishNoteParams) error
+	NotesUnpublishNote(ctx echo.Context, noteId string) error
+	// List tags
+	// (GET /api/tags)
+	TagsListTags(ctx echo.Context, params TagsListTagsParams) error
 	// Get templates list
 	// (GET /api/templates)
 	TemplatesListTemplates(ctx echo.Context, params TemplatesListTemplatesParams) error
 	// Create template
 	// (POST /api/templates)
 	TemplatesCreateTemplate(ctx echo.Context) error
+	// Get trashed templates
+	// (GET /api/templates/trash)
+	TemplatesListTrashedTemplates(ctx echo.Context, params TemplatesListTrashedTemplatesParams) error
+	// Purge trashed template
+	// (DELETE /api/templates/trash/{templateId})
+	TemplatesPurgeTemplate(ctx echo.Context, templateId string) error
 	// Delete template
 	// (DELETE /api/templates/{templateId})
-	TemplatesDeleteTemplate(ctx echo.Context, templateId string, params TemplatesDeleteTemplateParams) error
+	TemplatesDeleteTemplate(ctx echo.Context, templateId string) error
 	// Get template by ID
 	// (GET /api/templates/{templateId})
 	TemplatesGetTemplateById(ctx echo.Context, templateId string) error
 	// Update template
 	// (PUT /api/templates/{templateId})
 	TemplatesUpdateTemplate(ctx echo.Context, templateId string, params TemplatesUpdateTemplateParams) error
+	// Restore trashed template
+	// (POST /api/templates/{templateId}/restore)
+	TemplatesRestoreTemplate(ctx echo.Context, templateId string) error
+	// List webhooks
+	// (GET /api/webhooks)
+	WebhooksListWebhooks(ctx echo.Context) error
+	// Create webhook
+	// (POST /api/webhooks)
+	WebhooksCreateWebhook(ctx echo.Context) error
+	// Delete webhook
+	// (DELETE /api/webhooks/{webhookId})
+	WebhooksDeleteWebhook(ctx echo.Context, webhookId string) error
+	// Get webhook
+	// (GET /api/webhooks/{webhookId})
+	WebhooksGetWebhook(ctx echo.Context, webhookId string) error
+	// Update webhook
+	// (PUT /api/webhooks/{webhookId})
+	WebhooksUpdateWebhook(ctx echo.Context, webhookId string) error
+	// List webhook deliveries
+	// (GET /api/webhooks/{webhookId}/deliveries)
+	WebhooksListWebhookDeliveries(ctx echo.Context, webhookId string, params WebhooksListWebhookDeliveriesParams) error
+	// Redeliver webhook delivery
+	// (POST /api/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+	WebhooksRedeliverWebhookDelivery(ctx echo.Context, webhookId string, deliveryId string) error
+	// List workspaces
+	// (GET /api/workspaces)
+	WorkspacesListWorkspaces(ctx echo.Context) error
+	// Create workspace
+	// (POST /api/workspaces)
+	WorkspacesCreateWorkspace(ctx echo.Context) error
+	// Delete workspace
+	// (DELETE /api/workspaces/{workspaceId})
+	WorkspacesDeleteWorkspace(ctx echo.Context, workspaceId string) error
+	// Get workspace
+	// (GET /api/workspaces/{workspaceId})
+	WorkspacesGetWorkspace(ctx echo.Context, workspaceId string) error
+	// Update workspace
+	// (PUT /api/workspaces/{workspaceId})
+	WorkspacesUpdateWorkspace(ctx echo.Context, workspaceId string) error
+	// List workspace invites
+	// (GET /api/workspaces/{workspaceId}/invites)
+	WorkspacesListWorkspaceInvites(ctx echo.Context, workspaceId string) error
+	// Create workspace invite
+	// (POST /api/workspaces/{workspaceId}/invites)
+	WorkspacesCreateWorkspaceInvite(ctx echo.Context, workspaceId string) error
+	// Delete workspace invite
+	// (DELETE /api/workspaces/{workspaceId}/invites/{inviteId})
+	WorkspacesDeleteWorkspaceInvite(ctx echo.Context, workspaceId string, inviteId string) error
+	// List workspace members
+	// (GET /api/workspaces/{workspaceId}/members)
+	WorkspacesListWorkspaceMembers(ctx echo.Context, workspaceId string) error
+	// Delete workspace member
+	// (DELETE /api/workspaces/{workspaceId}/members/{accountId})
+	WorkspacesDeleteWorkspaceMember(ctx echo.Context, workspaceId string, accountId string) error
+	// Update workspace member
+	// (PUT /api/workspaces/{workspaceId}/members/{accountId})
+	WorkspacesUpdateWorkspaceMember(ctx echo.Context, workspaceId string, accountId string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -530,314 +1543,1460 @@ func (w *ServerInterfaceWrapper) AccountsCreateOrGetAccount(ctx echo.Context) er
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsCreateOrGetAccount(ctx)
+	err = w.Handler.AccountsCreateOrGetAccount(ctx)
+	return err
+}
+
+// AccountsGetAccountByEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsGetAccountByEmail(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsGetAccountByEmailParams
+	// ------------- Required query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "email", ctx.QueryParams(), &params.Email)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsGetAccountByEmail(ctx, params)
+	return err
+}
+
+// AccountsGetCurrentAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsGetCurrentAccount(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsGetCurrentAccount(ctx)
+	return err
+}
+
+// AccountsGetAccountById converts echo context to params.
+func (w *ServerInterfaceWrapper) AccountsGetAccountById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AccountsGetAccountById(ctx, accountId)
+	return err
+}
+
+// AdminListAuditLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminListAuditLogs(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListAuditLogsParams
+	// ------------- Optional query parameter "actorId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "actorId", ctx.QueryParams(), &params.ActorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actorId: %s", err))
+	}
+
+	// ------------- Optional query parameter "aggregateType" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "aggregateType", ctx.QueryParams(), &params.AggregateType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter aggregateType: %s", err))
+	}
+
+	// ------------- Optional query parameter "aggregateId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "aggregateId", ctx.QueryParams(), &params.AggregateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter aggregateId: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminListAuditLogs(ctx, params)
+	return err
+}
+
+// AdminVerifyAuditLog converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminVerifyAuditLog(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminVerifyAuditLog(ctx)
+	return err
+}
+
+// InvitesListMyInvites converts echo context to params.
+func (w *ServerInterfaceWrapper) InvitesListMyInvites(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InvitesListMyInvites(ctx)
+	return err
+}
+
+// InvitesAcceptInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) InvitesAcceptInvite(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "inviteId" -------------
+	var inviteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "inviteId", ctx.Param("inviteId"), &inviteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inviteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InvitesAcceptInvite(ctx, inviteId)
+	return err
+}
+
+// NotesListNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesListNotes(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesListNotesParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "templateId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "templateId", ctx.QueryParams(), &params.TemplateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	// ------------- Optional query parameter "tagMatch" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tagMatch", ctx.QueryParams(), &params.TagMatch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagMatch: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesListNotes(ctx, params)
+	return err
+}
+
+// NotesCreateNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesCreateNote(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesCreateNote(ctx)
+	return err
+}
+
+// NotesImportNoteMarkdown converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesImportNoteMarkdown(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesImportNoteMarkdownParams
+	// ------------- Required query parameter "templateId" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "templateId", ctx.QueryParams(), &params.TemplateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesImportNoteMarkdown(ctx, params)
+	return err
+}
+
+// NotesSearchNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesSearchNotes(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesSearchNotesParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "in" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "in", ctx.QueryParams(), &params.In)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter in: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "templateId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "templateId", ctx.QueryParams(), &params.TemplateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	// ------------- Optional query parameter "tagMatch" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tagMatch", ctx.QueryParams(), &params.TagMatch)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagMatch: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesSearchNotes(ctx, params)
+	return err
+}
+
+// NotesListTrashedNotes converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesListTrashedNotes(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesListTrashedNotesParams
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesListTrashedNotes(ctx, params)
+	return err
+}
+
+// NotesPurgeNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesPurgeNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesPurgeNote(ctx, noteId)
+	return err
+}
+
+// NotesDeleteNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesDeleteNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesDeleteNote(ctx, noteId)
+	return err
+}
+
+// NotesGetNoteById converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesGetNoteById(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesGetNoteById(ctx, noteId)
+	return err
+}
+
+// NotesUpdateNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesUpdateNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesUpdateNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesUpdateNote(ctx, noteId, params)
+	return err
+}
+
+// CollaboratorsListCollaborators converts echo context to params.
+func (w *ServerInterfaceWrapper) CollaboratorsListCollaborators(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CollaboratorsListCollaborators(ctx, noteId)
+	return err
+}
+
+// CollaboratorsDeleteCollaborator converts echo context to params.
+func (w *ServerInterfaceWrapper) CollaboratorsDeleteCollaborator(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CollaboratorsDeleteCollaborator(ctx, noteId, accountId)
+	return err
+}
+
+// CollaboratorsPutCollaborator converts echo context to params.
+func (w *ServerInterfaceWrapper) CollaboratorsPutCollaborator(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CollaboratorsPutCollaborator(ctx, noteId, accountId)
+	return err
+}
+
+// CommentsListComments converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentsListComments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommentsListComments(ctx, noteId)
+	return err
+}
+
+// CommentsCreateComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentsCreateComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommentsCreateComment(ctx, noteId)
+	return err
+}
+
+// CommentsDeleteComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentsDeleteComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", ctx.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommentsDeleteComment(ctx, noteId, commentId)
+	return err
+}
+
+// CommentsUpdateComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentsUpdateComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", ctx.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommentsUpdateComment(ctx, noteId, commentId)
+	return err
+}
+
+// CommentsResolveComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentsResolveComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", ctx.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommentsResolveComment(ctx, noteId, commentId)
+	return err
+}
+
+// CommentsUnresolveComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentsUnresolveComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", ctx.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommentsUnresolveComment(ctx, noteId, commentId)
+	return err
+}
+
+// NotesListNoteLinks converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesListNoteLinks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesListNoteLinks(ctx, noteId)
+	return err
+}
+
+// NotesCreateNoteLink converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesCreateNoteLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesCreateNoteLink(ctx, noteId)
+	return err
+}
+
+// NotesDeleteNoteLink converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesDeleteNoteLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "linkId", ctx.Param("linkId"), &linkId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter linkId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesDeleteNoteLink(ctx, noteId, linkId)
+	return err
+}
+
+// NotesExportNoteMarkdown converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesExportNoteMarkdown(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesExportNoteMarkdown(ctx, noteId)
+	return err
+}
+
+// NotesMigrateNoteTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesMigrateNoteTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesMigrateNoteTemplateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesMigrateNoteTemplate(ctx, noteId, params)
+	return err
+}
+
+// NotesPublishNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesPublishNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesPublishNote(ctx, noteId)
+	return err
+}
+
+// NotesRestoreNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesRestoreNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesRestoreNote(ctx, noteId)
+	return err
+}
+
+// NotesListNoteRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesListNoteRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesListNoteRevisions(ctx, noteId)
+	return err
+}
+
+// NotesGetNoteRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesGetNoteRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", ctx.Param("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesGetNoteRevision(ctx, noteId, revision)
+	return err
+}
+
+// NotesDiffNoteRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesDiffNoteRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", ctx.Param("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter revision: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotesDiffNoteRevisionParams
+	// ------------- Optional query parameter "base" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "base", ctx.QueryParams(), &params.Base)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter base: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesDiffNoteRevision(ctx, noteId, revision, params)
+	return err
+}
+
+// NotesUnpublishNote converts echo context to params.
+func (w *ServerInterfaceWrapper) NotesUnpublishNote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "noteId" -------------
+	var noteId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.NotesUnpublishNote(ctx, noteId)
+	return err
+}
+
+// TagsListTags converts echo context to params.
+func (w *ServerInterfaceWrapper) TagsListTags(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TagsListTagsParams
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TagsListTags(ctx, params)
+	return err
+}
+
+// TemplatesListTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesListTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesListTemplatesParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesListTemplates(ctx, params)
+	return err
+}
+
+// TemplatesCreateTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesCreateTemplate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesCreateTemplate(ctx)
 	return err
 }
 
-// AccountsGetAccountByEmail converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsGetAccountByEmail(ctx echo.Context) error {
+// TemplatesListTrashedTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesListTrashedTemplates(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AccountsGetAccountByEmailParams
-	// ------------- Required query parameter "email" -------------
+	var params TemplatesListTrashedTemplatesParams
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindQueryParameter("form", false, true, "email", ctx.QueryParams(), &params.Email)
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsGetAccountByEmail(ctx, params)
+	err = w.Handler.TemplatesListTrashedTemplates(ctx, params)
 	return err
 }
 
-// AccountsGetCurrentAccount converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsGetCurrentAccount(ctx echo.Context) error {
+// TemplatesPurgeTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesPurgeTemplate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsGetCurrentAccount(ctx)
+	err = w.Handler.TemplatesPurgeTemplate(ctx, templateId)
 	return err
 }
 
-// AccountsGetAccountById converts echo context to params.
-func (w *ServerInterfaceWrapper) AccountsGetAccountById(ctx echo.Context) error {
+// TemplatesDeleteTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesDeleteTemplate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "accountId" -------------
-	var accountId string
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AccountsGetAccountById(ctx, accountId)
+	err = w.Handler.TemplatesDeleteTemplate(ctx, templateId)
 	return err
 }
 
-// NotesListNotes converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesListNotes(ctx echo.Context) error {
+// TemplatesGetTemplateById converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesGetTemplateById(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesListNotesParams
-	// ------------- Optional query parameter "q" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", false, false, "status", ctx.QueryParams(), &params.Status)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesGetTemplateById(ctx, templateId)
+	return err
+}
 
-	// ------------- Optional query parameter "templateId" -------------
+// TemplatesUpdateTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesUpdateTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	err = runtime.BindQueryParameter("form", false, false, "templateId", ctx.QueryParams(), &params.TemplateId)
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
-	// ------------- Optional query parameter "ownerId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TemplatesUpdateTemplateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TemplatesUpdateTemplate(ctx, templateId, params)
+	return err
+}
+
+// TemplatesRestoreTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) TemplatesRestoreTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesListNotes(ctx, params)
+	err = w.Handler.TemplatesRestoreTemplate(ctx, templateId)
 	return err
 }
 
-// NotesCreateNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesCreateNote(ctx echo.Context) error {
+// WebhooksListWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksListWebhooks(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesCreateNote(ctx)
+	err = w.Handler.WebhooksListWebhooks(ctx)
 	return err
 }
 
-// NotesDeleteNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesDeleteNote(ctx echo.Context) error {
+// WebhooksCreateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksCreateWebhook(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhooksCreateWebhook(ctx)
+	return err
+}
+
+// WebhooksDeleteWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksDeleteWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesDeleteNoteParams
-	// ------------- Required query parameter "ownerId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhooksDeleteWebhook(ctx, webhookId)
+	return err
+}
+
+// WebhooksGetWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksGetWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesDeleteNote(ctx, noteId, params)
+	err = w.Handler.WebhooksGetWebhook(ctx, webhookId)
 	return err
 }
 
-// NotesGetNoteById converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesGetNoteById(ctx echo.Context) error {
+// WebhooksUpdateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksUpdateWebhook(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesGetNoteById(ctx, noteId)
+	err = w.Handler.WebhooksUpdateWebhook(ctx, webhookId)
 	return err
 }
 
-// NotesUpdateNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesUpdateNote(ctx echo.Context) error {
+// WebhooksListWebhookDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksListWebhookDeliveries(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesUpdateNoteParams
-	// ------------- Required query parameter "ownerId" -------------
+	var params WebhooksListWebhookDeliveriesParams
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesUpdateNote(ctx, noteId, params)
+	err = w.Handler.WebhooksListWebhookDeliveries(ctx, webhookId, params)
 	return err
 }
 
-// NotesPublishNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesPublishNote(ctx echo.Context) error {
+// WebhooksRedeliverWebhookDelivery converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhooksRedeliverWebhookDelivery(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesPublishNoteParams
-	// ------------- Required query parameter "ownerId" -------------
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId string
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", ctx.Param("deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deliveryId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesPublishNote(ctx, noteId, params)
+	err = w.Handler.WebhooksRedeliverWebhookDelivery(ctx, webhookId, deliveryId)
 	return err
 }
 
-// NotesUnpublishNote converts echo context to params.
-func (w *ServerInterfaceWrapper) NotesUnpublishNote(ctx echo.Context) error {
+// WorkspacesListWorkspaces converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesListWorkspaces(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "noteId" -------------
-	var noteId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "noteId", ctx.Param("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspacesListWorkspaces(ctx)
+	return err
+}
+
+// WorkspacesCreateWorkspace converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesCreateWorkspace(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspacesCreateWorkspace(ctx)
+	return err
+}
+
+// WorkspacesDeleteWorkspace converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesDeleteWorkspace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter noteId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotesUnpublishNoteParams
-	// ------------- Required query parameter "ownerId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspacesDeleteWorkspace(ctx, workspaceId)
+	return err
+}
+
+// WorkspacesGetWorkspace converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesGetWorkspace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.NotesUnpublishNote(ctx, noteId, params)
+	err = w.Handler.WorkspacesGetWorkspace(ctx, workspaceId)
 	return err
 }
 
-// TemplatesListTemplates converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesListTemplates(ctx echo.Context) error {
+// WorkspacesUpdateWorkspace converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesUpdateWorkspace(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TemplatesListTemplatesParams
-	// ------------- Optional query parameter "q" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
-	// ------------- Optional query parameter "ownerId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", false, false, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspacesUpdateWorkspace(ctx, workspaceId)
+	return err
+}
+
+// WorkspacesListWorkspaceInvites converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesListWorkspaceInvites(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesListTemplates(ctx, params)
+	err = w.Handler.WorkspacesListWorkspaceInvites(ctx, workspaceId)
 	return err
 }
 
-// TemplatesCreateTemplate converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesCreateTemplate(ctx echo.Context) error {
+// WorkspacesCreateWorkspaceInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesCreateWorkspaceInvite(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesCreateTemplate(ctx)
+	err = w.Handler.WorkspacesCreateWorkspaceInvite(ctx, workspaceId)
 	return err
 }
 
-// TemplatesDeleteTemplate converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesDeleteTemplate(ctx echo.Context) error {
+// WorkspacesDeleteWorkspaceInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesDeleteWorkspaceInvite(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "templateId" -------------
-	var templateId string
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TemplatesDeleteTemplateParams
-	// ------------- Required query parameter "ownerId" -------------
+	// ------------- Path parameter "inviteId" -------------
+	var inviteId string
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindStyledParameterWithOptions("simple", "inviteId", ctx.Param("inviteId"), &inviteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inviteId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesDeleteTemplate(ctx, templateId, params)
+	err = w.Handler.WorkspacesDeleteWorkspaceInvite(ctx, workspaceId, inviteId)
 	return err
 }
 
-// TemplatesGetTemplateById converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesGetTemplateById(ctx echo.Context) error {
+// WorkspacesListWorkspaceMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesListWorkspaceMembers(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "templateId" -------------
-	var templateId string
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesGetTemplateById(ctx, templateId)
+	err = w.Handler.WorkspacesListWorkspaceMembers(ctx, workspaceId)
 	return err
 }
 
-// TemplatesUpdateTemplate converts echo context to params.
-func (w *ServerInterfaceWrapper) TemplatesUpdateTemplate(ctx echo.Context) error {
+// WorkspacesDeleteWorkspaceMember converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesDeleteWorkspaceMember(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "templateId" -------------
-	var templateId string
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TemplatesUpdateTemplateParams
-	// ------------- Required query parameter "ownerId" -------------
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
 
-	err = runtime.BindQueryParameter("form", false, true, "ownerId", ctx.QueryParams(), &params.OwnerId)
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ownerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TemplatesUpdateTemplate(ctx, templateId, params)
+	err = w.Handler.WorkspacesDeleteWorkspaceMember(ctx, workspaceId, accountId)
+	return err
+}
+
+// WorkspacesUpdateWorkspaceMember converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspacesUpdateWorkspaceMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accountId", ctx.Param("accountId"), &accountId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspacesUpdateWorkspaceMember(ctx, workspaceId, accountId)
 	return err
 }
 
@@ -873,17 +3032,134 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/accounts/by-email", wrapper.AccountsGetAccountByEmail)
 	router.GET(baseURL+"/api/accounts/me", wrapper.AccountsGetCurrentAccount)
 	router.GET(baseURL+"/api/accounts/:accountId", wrapper.AccountsGetAccountById)
+	router.GET(baseURL+"/api/admin/audit-logs", wrapper.AdminListAuditLogs)
+	router.GET(baseURL+"/api/admin/audit-logs/verify", wrapper.AdminVerifyAuditLog)
+	router.GET(baseURL+"/api/invites", wrapper.InvitesListMyInvites)
+	router.POST(baseURL+"/api/invites/:inviteId/accept", wrapper.InvitesAcceptInvite)
 	router.GET(baseURL+"/api/notes", wrapper.NotesListNotes)
 	router.POST(baseURL+"/api/notes", wrapper.NotesCreateNote)
+	router.POST(baseURL+"/api/notes/import", wrapper.NotesImportNoteMarkdown)
+	router.GET(baseURL+"/api/notes/search", wrapper.NotesSearchNotes)
+	router.GET(baseURL+"/api/notes/trash", wrapper.NotesListTrashedNotes)
+	router.DELETE(baseURL+"/api/notes/trash/:noteId", wrapper.NotesPurgeNote)
 	router.DELETE(baseURL+"/api/notes/:noteId", wrapper.NotesDeleteNote)
 	router.GET(baseURL+"/api/notes/:noteId", wrapper.NotesGetNoteById)
 	router.PUT(baseURL+"/api/notes/:noteId", wrapper.NotesUpdateNote)
+	router.GET(baseURL+"/api/notes/:noteId/collaborators", wrapper.CollaboratorsListCollaborators)
+	router.DELETE(baseURL+"/api/notes/:noteId/collaborators/:accountId", wrapper.CollaboratorsDeleteCollaborator)
+	router.PUT(baseURL+"/api/notes/:noteId/collaborators/:accountId", wrapper.CollaboratorsPutCollaborator)
+	router.GET(baseURL+"/api/notes/:noteId/comments", wrapper.CommentsListComments)
+	router.POST(baseURL+"/api/notes/:noteId/comments", wrapper.CommentsCreateComment)
+	router.DELETE(baseURL+"/api/notes/:noteId/comments/:commentId", wrapper.CommentsDeleteComment)
+	router.PUT(baseURL+"/api/notes/:noteId/comments/:commentId", wrapper.CommentsUpdateComment)
+	router.POST(baseURL+"/api/notes/:noteId/comments/:commentId/resolve", wrapper.CommentsResolveComment)
+	router.POST(baseURL+"/api/notes/:noteId/comments/:commentId/unresolve", wrapper.CommentsUnresolveComment)
+	router.GET(baseURL+"/api/notes/:noteId/links", wrapper.NotesListNoteLinks)
+	router.POST(baseURL+"/api/notes/:noteId/links", wrapper.NotesCreateNoteLink)
+	router.DELETE(baseURL+"/api/notes/:noteId/links/:linkId", wrapper.NotesDeleteNoteLink)
+	router.GET(baseURL+"/api/notes/:noteId/markdown", wrapper.NotesExportNoteMarkdown)
+	router.POST(baseURL+"/api/notes/:noteId/migrate-template", wrapper.NotesMigrateNoteTemplate)
 	router.POST(baseURL+"/api/notes/:noteId/publish", wrapper.NotesPublishNote)
+	router.POST(baseURL+"/api/notes/:noteId/restore", wrapper.NotesRestoreNote)
+	router.GET(baseURL+"/api/notes/:noteId/revisions", wrapper.NotesListNoteRevisions)
+	router.GET(baseURL+"/api/notes/:noteId/revisions/:revision", wrapper.NotesGetNoteRevision)
+	router.GET(baseURL+"/api/notes/:noteId/revisions/:revision/diff", wrapper.NotesDiffNoteRevision)
 	router.POST(baseURL+"/api/notes/:noteId/unpublish", wrapper.NotesUnpublishNote)
+	router.GET(baseURL+"/api/tags", wrapper.TagsListTags)
 	router.GET(baseURL+"/api/templates", wrapper.TemplatesListTemplates)
 	router.POST(baseURL+"/api/templates", wrapper.TemplatesCreateTemplate)
+	router.GET(baseURL+"/api/templates/trash", wrapper.TemplatesListTrashedTemplates)
+	router.DELETE(baseURL+"/api/templates/trash/:templateId", wrapper.TemplatesPurgeTemplate)
 	router.DELETE(baseURL+"/api/templates/:templateId", wrapper.TemplatesDeleteTemplate)
 	router.GET(baseURL+"/api/templates/:templateId", wrapper.TemplatesGetTemplateById)
 	router.PUT(baseURL+"/api/templates/:templateId", wrapper.TemplatesUpdateTemplate)
+	router.POST(baseURL+"/api/templates/:templateId/restore", wrapper.TemplatesRestoreTemplate)
+	router.GET(baseURL+"/api/webhooks", wrapper.WebhooksListWebhooks)
+	router.POST(baseURL+"/api/webhooks", wrapper.WebhooksCreateWebhook)
+	router.DELETE(baseURL+"/api/webhooks/:webhookId", wrapper.WebhooksDeleteWebhook)
+	router.GET(baseURL+"/api/webhooks/:webhookId", wrapper.WebhooksGetWebhook)
+	router.PUT(baseURL+"/api/webhooks/:webhookId", wrapper.WebhooksUpdateWebhook)
+	router.GET(baseURL+"/api/webhooks/:webhookId/deliveries", wrapper.WebhooksListWebhookDeliveries)
+	router.POST(baseURL+"/api/webhooks/:webhookId/deliveries/:deliveryId/redeliver", wrapper.WebhooksRedeliverWebhookDelivery)
+	router.GET(baseURL+"/api/workspaces", wrapper.WorkspacesListWorkspaces)
+	router.POST(baseURL+"/api/workspaces", wrapper.WorkspacesCreateWorkspace)
+	router.DELETE(baseURL+"/api/workspaces/:workspaceId", wrapper.WorkspacesDeleteWorkspace)
+	router.GET(baseURL+"/api/workspaces/:workspaceId", wrapper.WorkspacesGetWorkspace)
+	router.PUT(baseURL+"/api/workspaces/:workspaceId", wrapper.WorkspacesUpdateWorkspace)
+	router.GET(baseURL+"/api/workspaces/:workspaceId/invites", wrapper.WorkspacesListWorkspaceInvites)
+	router.POST(baseURL+"/api/workspaces/:workspaceId/invites", wrapper.WorkspacesCreateWorkspaceInvite)
+	router.DELETE(baseURL+"/api/workspaces/:workspaceId/invites/:inviteId", wrapper.WorkspacesDeleteWorkspaceInvite)
+	router.GET(baseURL+"/api/workspaces/:workspaceId/members", wrapper.WorkspacesListWorkspaceMembers)
+	router.DELETE(baseURL+"/api/workspaces/:workspaceId/members/:accountId", wrapper.WorkspacesDeleteWorkspaceMember)
+	router.PUT(baseURL+"/api/workspaces/:workspaceId/members/:accountId", wrapper.WorkspacesUpdateWorkspaceMember)
+
+}
 
+// OperationIDs maps "METHOD path" of each route, with the path as registered with
+// Echo, to the operationId of the OpenAPI operation it serves.
+var OperationIDs = map[string]string{
+	"POST /api/accounts/auth":                                        "Accounts_createOrGetAccount",
+	"GET /api/accounts/by-email":                                     "Accounts_getAccountByEmail",
+	"GET /api/accounts/me":                                           "Accounts_getCurrentAccount",
+	"GET /api/accounts/:accountId":                                   "Accounts_getAccountById",
+	"GET /api/admin/audit-logs":                                      "Admin_listAuditLogs",
+	"GET /api/admin/audit-logs/verify":                               "Admin_verifyAuditLog",
+	"GET /api/invites":                                               "Invites_listMyInvites",
+	"POST /api/invites/:inviteId/accept":                             "Invites_acceptInvite",
+	"GET /api/notes":                                                 "Notes_listNotes",
+	"POST /api/notes":                                                "Notes_createNote",
+	"POST /api/notes/import":                                         "Notes_importNoteMarkdown",
+	"GET /api/notes/search":                                          "Notes_searchNotes",
+	"GET /api/notes/trash":                                           "Notes_listTrashedNotes",
+	"DELETE /api/notes/trash/:noteId":                                "Notes_purgeNote",
+	"DELETE /api/notes/:noteId":                                      "Notes_deleteNote",
+	"GET /api/notes/:noteId":                                         "Notes_getNoteById",
+	"PUT /api/notes/:noteId":                                         "Notes_updateNote",
+	"GET /api/notes/:noteId/collaborators":                           "Collaborators_listCollaborators",
+	"DELETE /api/notes/:noteId/collaborators/:accountId":             "Collaborators_deleteCollaborator",
+	"PUT /api/notes/:noteId/collaborators/:accountId":                "Collaborators_putCollaborator",
+	"GET /api/notes/:noteId/comments":                                "Comments_listComments",
+	"POST /api/notes/:noteId/comments":                               "Comments_createComment",
+	"DELETE /api/notes/:noteId/comments/:commentId":                  "Comments_deleteComment",
+	"PUT /api/notes/:noteId/comments/:commentId":                     "Comments_updateComment",
+	"POST /api/notes/:noteId/comments/:commentId/resolve":            "Comments_resolveComment",
+	"POST /api/notes/:noteId/comments/:commentId/unresolve":          "Comments_unresolveComment",
+	"GET /api/notes/:noteId/links":                                   "Notes_listNoteLinks",
+	"POST /api/notes/:noteId/links":                                  "Notes_createNoteLink",
+	"DELETE /api/notes/:noteId/links/:linkId":                        "Notes_deleteNoteLink",
+	"GET /api/notes/:noteId/markdown":                                "Notes_exportNoteMarkdown",
+	"POST /api/notes/:noteId/migrate-template":                       "Notes_migrateNoteTemplate",
+	"POST /api/notes/:noteId/publish":                                "Notes_publishNote",
+	"POST /api/notes/:noteId/restore":                                "Notes_restoreNote",
+	"GET /api/notes/:noteId/revisions":                               "Notes_listNoteRevisions",
+	"GET /api/notes/:noteId/revisions/:revision":                     "Notes_getNoteRevision",
+	"GET /api/notes/:noteId/revisions/:revision/diff":                "Notes_diffNoteRevision",
+	"POST /api/notes/:noteId/unpublish":                              "Notes_unpublishNote",
+	"GET /api/tags":                                                  "Tags_listTags",
+	"GET /api/templates":                                             "Templates_listTemplates",
+	"POST /api/templates":                                            "Templates_createTemplate",
+	"GET /api/templates/trash":                                       "Templates_listTrashedTemplates",
+	"DELETE /api/templates/trash/:templateId":                        "Templates_purgeTemplate",
+	"DELETE /api/templates/:templateId":                              "Templates_deleteTemplate",
+	"GET /api/templates/:templateId":                                 "Templates_getTemplateById",
+	"PUT /api/templates/:templateId":                                 "Templates_updateTemplate",
+	"POST /api/templates/:templateId/restore":                        "Templates_restoreTemplate",
+	"GET /api/webhooks":                                              "Webhooks_listWebhooks",
+	"POST /api/webhooks":                                             "Webhooks_createWebhook",
+	"DELETE /api/webhooks/:webhookId":                                "Webhooks_deleteWebhook",
+	"GET /api/webhooks/:webhookId":                                   "Webhooks_getWebhook",
+	"PUT /api/webhooks/:webhookId":                                   "Webhooks_updateWebhook",
+	"GET /api/webhooks/:webhookId/deliveries":                        "Webhooks_listWebhookDeliveries",
+	"POST /api/webhooks/:webhookId/deliveries/:deliveryId/redeliver": "Webhooks_redeliverWebhookDelivery",
+	"GET /api/workspaces":                                            "Workspaces_listWorkspaces",
+	"POST /api/workspaces":                                           "Workspaces_createWorkspace",
+	"DELETE /api/workspaces/:workspaceId":                            "Workspaces_deleteWorkspace",
+	"GET /api/workspaces/:workspaceId":                               "Workspaces_getWorkspace",
+	"PUT /api/workspaces/:workspaceId":                               "Workspaces_updateWorkspace",
+	"GET /api/workspaces/:workspaceId/invites":                       "Workspaces_listWorkspaceInvites",
+	"POST /api/workspaces/:workspaceId/invites":                      "Workspaces_createWorkspaceInvite",
+	"DELETE /api/workspaces/:workspaceId/invites/:inviteId":          "Workspaces_deleteWorkspaceInvite",
+	"GET /api/workspaces/:workspaceId/members":                       "Workspaces_listWorkspaceMembers",
+	"DELETE /api/workspaces/:workspaceId/members/:accountId":         "Workspaces_deleteWorkspaceMember",
+	"PUT /api/workspaces/:workspaceId/members/:accountId":            "Workspaces_updateWorkspaceMember",
 }
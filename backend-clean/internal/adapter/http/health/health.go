@@ -0,0 +1,58 @@
+// Package health answers the liveness and readiness probes of the API server.
+package health
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// checkTimeout bounds a readiness check so that a hung database fails the probe
+// instead of stalling it.
+const checkTimeout = 2 * time.Second
+
+// notReadyDetail is all callers learn about a failed check; the reason is logged.
+const notReadyDetail = "not ready to serve requests"
+
+// Status is the body of a passing probe.
+type Status struct {
+	Status string `json:"status"`
+}
+
+// Handler serves the probes. Readiness fails as soon as shutdown is closed, so that
+// load balancers stop sending new requests while those in flight drain.
+type Handler struct {
+	checker  port.ReadinessChecker
+	shutdown <-chan struct{}
+}
+
+// NewHandler creates a probe handler that checks readiness with checker until shutdown is closed.
+func NewHandler(checker port.ReadinessChecker, shutdown <-chan struct{}) *Handler {
+	return &Handler{checker: checker, shutdown: shutdown}
+}
+
+// Live answers 200 while the process can handle requests at all.
+func (h *Handler) Live(c echo.Context) error {
+	return c.JSON(http.StatusOK, Status{Status: "ok"})
+}
+
+// Ready answers 200 when the dependencies are ready and 503 otherwise.
+func (h *Handler) Ready(c echo.Context) error {
+	select {
+	case <-h.shutdown:
+		return problem.JSON(c, http.StatusServiceUnavailable, problem.CodeServiceUnavailable, "shutting down")
+	default:
+	}
+	ctx, cancel := context.WithTimeout(c.Request().Context(), checkTimeout)
+	defer cancel()
+	if err := h.checker.Ready(ctx); err != nil {
+		c.Logger().Errorf("not ready: %v", err)
+		return problem.JSON(c, http.StatusServiceUnavailable, problem.CodeServiceUnavailable, notReadyDetail)
+	}
+	return c.JSON(http.StatusOK, Status{Status: "ok"})
+}
@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
+)
+
+type checkerStub struct {
+	err error
+}
+
+func (s checkerStub) Ready(context.Context) error {
+	return s.err
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		checkErr     error
+		shuttingDown bool
+		wantStatus   int
+		wantProblem  bool
+	}{
+		{name: "[Success] live", path: "/healthz", wantStatus: http.StatusOK},
+		{name: "[Success] live while not ready", path: "/healthz", checkErr: errors.New("connection refused"), wantStatus: http.StatusOK},
+		{name: "[Success] ready", path: "/readyz", wantStatus: http.StatusOK},
+		{name: "[Fail] dependency not ready", path: "/readyz", checkErr: errors.New("database is at migration 1, want 2"), wantStatus: http.StatusServiceUnavailable, wantProblem: true},
+		{name: "[Fail] shutting down", path: "/readyz", shuttingDown: true, wantStatus: http.StatusServiceUnavailable, wantProblem: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shutdown := make(chan struct{})
+			if tt.shuttingDown {
+				close(shutdown)
+			}
+			h := NewHandler(checkerStub{err: tt.checkErr}, shutdown)
+			e := echo.New()
+			e.Logger.SetOutput(io.Discard)
+			e.GET("/healthz", h.Live)
+			e.GET("/readyz", h.Ready)
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get(echo.HeaderContentType) == problem.ContentType; got != tt.wantProblem {
+				t.Errorf("problem response = %v, want %v", got, tt.wantProblem)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Package middleware contains Echo middlewares for the HTTP adapter.
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+type accountIDKey struct{}
+
+// Authenticate verifies the bearer token on each request and stores the
+// authenticated account ID in the request context.
+// Requests for which skipper returns true are passed through unauthenticated.
+func Authenticate(verifier port.TokenVerifier, skipper func(echo.Context) bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper != nil && skipper(c) {
+				return next(c)
+			}
+			token, ok := bearerToken(c.Request().Header.Get(echo.HeaderAuthorization))
+			if !ok {
+				return unauthorized(c, "missing bearer token")
+			}
+			req := c.Request()
+			accountID, err := verifier.Verify(req.Context(), token)
+			if err != nil {
+				return unauthorized(c, "invalid access token")
+			}
+			c.SetRequest(req.WithContext(WithAccountID(req.Context(), accountID)))
+			return next(c)
+		}
+	}
+}
+
+// WithAccountID returns a copy of ctx carrying the authenticated account ID.
+func WithAccountID(ctx context.Context, accountID string) context.Context {
+	return context.WithValue(ctx, accountIDKey{}, accountID)
+}
+
+// AccountIDFromContext returns the authenticated account ID, if any.
+func AccountIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(accountIDKey{}).(string)
+	if !ok || id == "" {
+		return "", false
+	}
+	return id, true
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(header), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func unauthorized(c echo.Context, message string) error {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="api"`)
+	return problem.JSON(c, http.StatusUnauthorized, problem.CodeUnauthorized, message)
+}
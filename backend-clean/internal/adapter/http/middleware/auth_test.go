@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+type verifierStub struct {
+	accountID string
+	err       error
+	gotToken  string
+}
+
+func (v *verifierStub) Verify(_ context.Context, token string) (string, error) {
+	v.gotToken = token
+	return v.accountID, v.err
+}
+
+func TestAuthenticate(t *testing.T) {
+	tests := []struct {
+		name          string
+		header        string
+		path          string
+		verifyErr     error
+		wantStatus    int
+		wantAccountID string
+		wantToken     string
+	}{
+		{name: "[Success] valid bearer token", header: "Bearer tok", path: "/api/notes", wantStatus: http.StatusOK, wantAccountID: "acc-1", wantToken: "tok"},
+		{name: "[Success] scheme is case-insensitive", header: "bearer tok", path: "/api/notes", wantStatus: http.StatusOK, wantAccountID: "acc-1", wantToken: "tok"},
+		{name: "[Success] skipped path", header: "", path: "/public", wantStatus: http.StatusOK},
+		{name: "[Fail] missing header", header: "", path: "/api/notes", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] non-bearer scheme", header: "Basic abc", path: "/api/notes", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] empty token", header: "Bearer ", path: "/api/notes", wantStatus: http.StatusUnauthorized},
+		{name: "[Fail] verification error", header: "Bearer tok", path: "/api/notes", verifyErr: domainerr.ErrUnauthenticated, wantStatus: http.StatusUnauthorized, wantToken: "tok"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := &verifierStub{accountID: "acc-1", err: tt.verifyErr}
+			mw := Authenticate(verifier, func(c echo.Context) bool { return c.Request().URL.Path == "/public" })
+
+			var gotAccountID string
+			handler := mw(func(c echo.Context) error {
+				gotAccountID, _ = AccountIDFromContext(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			if err := handler(e.NewContext(req, rec)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if gotAccountID != tt.wantAccountID {
+				t.Errorf("account ID = %q, want %q", gotAccountID, tt.wantAccountID)
+			}
+			if verifier.gotToken != tt.wantToken {
+				t.Errorf("token = %q, want %q", verifier.gotToken, tt.wantToken)
+			}
+			if tt.wantStatus == http.StatusUnauthorized && rec.Header().Get(echo.HeaderWWWAuthenticate) == "" {
+				t.Errorf("missing %s header", echo.HeaderWWWAuthenticate)
+			}
+			if tt.wantStatus == http.StatusUnauthorized && rec.Header().Get(echo.HeaderContentType) != problem.ContentType {
+				t.Errorf("content type = %q, want %q", rec.Header().Get(echo.HeaderContentType), problem.ContentType)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unmatchedOperation labels requests that did not match a registered route.
+const unmatchedOperation = "unmatched"
+
+// Metrics counts requests and observes their latency per operation. operations maps
+// "METHOD path" of the API routes to their OpenAPI operationId ("Notes_updateNote");
+// other routes are labelled with the name they were registered with. Errors are
+// written with the Echo error handler here, so that the final status code is counted.
+func Metrics(reg prometheus.Registerer, operations map[string]string) echo.MiddlewareFunc {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_server_requests_total",
+		Help: "HTTP requests by operation and status code.",
+	}, []string{"operation", "code"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_server_request_duration_seconds",
+		Help:    "HTTP request latency by operation.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation"})
+	reg.MustRegister(requests, duration)
+
+	// Routes are registered after the middleware, so they are looked up on the first request.
+	var once sync.Once
+	var names map[string]string
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			once.Do(func() { names = operationNames(c.Echo().Routes(), operations) })
+			started := time.Now()
+
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			operation, ok := names[c.Request().Method+" "+c.Path()]
+			if !ok {
+				operation = unmatchedOperation
+			}
+			requests.WithLabelValues(operation, strconv.Itoa(c.Response().Status)).Inc()
+			duration.WithLabelValues(operation).Observe(time.Since(started).Seconds())
+			return nil
+		}
+	}
+}
+
+// operationNames maps "METHOD path" of each route to its operationId, or to the
+// route name for routes outside the OpenAPI spec.
+func operationNames(routes []*echo.Route, operations map[string]string) map[string]string {
+	names := make(map[string]string, len(routes))
+	for _, r := range routes {
+		key := r.Method + " " + r.Path
+		if id, ok := operations[key]; ok {
+			names[key] = id
+			continue
+		}
+		names[key] = r.Name
+	}
+	return names
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+type noteServer struct {
+	err error
+}
+
+// NotesUpdateNote stands in for the handler oapi-codegen generates for Notes_updateNote.
+func (s noteServer) NotesUpdateNote(echo.Context) error {
+	return s.err
+}
+
+// operations stands in for openapi.OperationIDs.
+var operations = map[string]string{"PUT /api/notes/:noteId": "Notes_updateNote"}
+
+func TestMetrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		handlerErr    error
+		wantStatus    int
+		wantOperation string
+	}{
+		{name: "[Success] labelled with the operation", path: "/api/notes/n-1", wantStatus: http.StatusOK, wantOperation: "Notes_updateNote"},
+		{name: "[Fail] domain error is counted with its status", path: "/api/notes/n-1", handlerErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantOperation: "Notes_updateNote"},
+		{name: "[Fail] server error", path: "/api/notes/n-1", handlerErr: errors.New("pool closed"), wantStatus: http.StatusInternalServerError, wantOperation: "Notes_updateNote"},
+		{name: "[Success] route outside the spec is labelled with its name", path: "/healthz", wantStatus: http.StatusOK, wantOperation: "healthz"},
+		{name: "[Fail] unknown route", path: "/api/unknown", wantStatus: http.StatusNotFound, wantOperation: unmatchedOperation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := prometheus.NewRegistry()
+			e := echo.New()
+			e.Logger.SetOutput(io.Discard)
+			e.HTTPErrorHandler = problem.HTTPErrorHandler
+			e.Use(Metrics(reg, operations))
+			e.PUT("/api/notes/:noteId", noteServer{err: tt.handlerErr}.NotesUpdateNote)
+			e.PUT("/healthz", func(c echo.Context) error { return c.NoContent(http.StatusOK) }).Name = "healthz"
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, tt.path, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			families, err := reg.Gather()
+			if err != nil {
+				t.Fatalf("gather: %v", err)
+			}
+			var got map[string]string
+			for _, f := range families {
+				if f.GetName() != "http_server_requests_total" {
+					continue
+				}
+				if len(f.GetMetric()) != 1 {
+					t.Fatalf("series = %d, want 1", len(f.GetMetric()))
+				}
+				got = map[string]string{}
+				for _, l := range f.GetMetric()[0].GetLabel() {
+					got[l.GetName()] = l.GetValue()
+				}
+			}
+			if got["operation"] != tt.wantOperation {
+				t.Errorf("operation = %q, want %q", got["operation"], tt.wantOperation)
+			}
+			if got["code"] != strconv.Itoa(tt.wantStatus) {
+				t.Errorf("code = %q, want %d", got["code"], tt.wantStatus)
+			}
+			if n, err := testutil.GatherAndCount(reg, "http_server_request_duration_seconds"); err != nil || n != 1 {
+				t.Errorf("latency series = %d (%v), want 1", n, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Rate limit headers, as in the IETF RateLimit header fields draft.
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+)
+
+// RateLimitByIP takes a token from the client IP's pre-authentication bucket on each
+// request. It runs before Authenticate, so requests refused for a missing or bad token
+// are limited too; the budget is loose enough for many accounts behind one proxy.
+// Requests for which skipper returns true are not limited.
+func RateLimitByIP(limiter port.RateLimiter, skipper func(echo.Context) bool) echo.MiddlewareFunc {
+	return rateLimit(limiter, skipper, func(c echo.Context) (ratelimit.Subject, ratelimit.Class) {
+		return ratelimit.Subject{IP: c.RealIP()}, ratelimit.ClassPreAuth
+	})
+}
+
+// RateLimit takes a token from the caller's bucket on each request: the authenticated
+// account's when there is one, the client IP's otherwise. GET, HEAD and OPTIONS spend
+// the read budget and every other method the write budget.
+// It must run after Authenticate. Requests for which skipper returns true are not limited.
+func RateLimit(limiter port.RateLimiter, skipper func(echo.Context) bool) echo.MiddlewareFunc {
+	return rateLimit(limiter, skipper, func(c echo.Context) (ratelimit.Subject, ratelimit.Class) {
+		subject := ratelimit.Subject{IP: c.RealIP()}
+		subject.AccountID, _ = AccountIDFromContext(c.Request().Context())
+		return subject, requestClass(c.Request().Method)
+	})
+}
+
+// rateLimit spends the budget limitOf picks for the request. Refused requests get 429
+// with Retry-After; every limited response carries the RateLimit-* headers, those of
+// the last bucket spent.
+func rateLimit(limiter port.RateLimiter, skipper func(echo.Context) bool, limitOf func(echo.Context) (ratelimit.Subject, ratelimit.Class)) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper != nil && skipper(c) {
+				return next(c)
+			}
+			subject, class := limitOf(c)
+			decision, err := limiter.Allow(c.Request().Context(), subject, class)
+			if err != nil {
+				return err
+			}
+			if decision.Limit > 0 {
+				header := c.Response().Header()
+				header.Set(HeaderRateLimitLimit, strconv.Itoa(decision.Limit))
+				header.Set(HeaderRateLimitRemaining, strconv.Itoa(decision.Remaining))
+				header.Set(HeaderRateLimitReset, seconds(decision.Reset))
+			}
+			if !decision.Allowed {
+				c.Response().Header().Set(echo.HeaderRetryAfter, seconds(decision.RetryAfter))
+				return problem.JSON(c, http.StatusTooManyRequests, problem.CodeTooManyRequests, "rate limit exceeded")
+			}
+			return next(c)
+		}
+	}
+}
+
+func requestClass(method string) ratelimit.Class {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ratelimit.ClassRead
+	default:
+		return ratelimit.ClassWrite
+	}
+}
+
+// seconds formats d as whole seconds, rounding up.
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64((d+time.Second-1)/time.Second), 10)
+}
@@ -0,0 +1,173 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+)
+
+type limiterStub struct {
+	decision   ratelimit.Decision
+	err        error
+	gotSubject ratelimit.Subject
+	gotClass   ratelimit.Class
+}
+
+func (l *limiterStub) Allow(_ context.Context, subject ratelimit.Subject, class ratelimit.Class) (ratelimit.Decision, error) {
+	l.gotSubject = subject
+	l.gotClass = class
+	return l.decision, l.err
+}
+
+func TestRateLimit(t *testing.T) {
+	allowed := ratelimit.Decision{Allowed: true, Limit: 60, Remaining: 59, Reset: time.Second}
+	refused := ratelimit.Decision{Limit: 60, Reset: time.Minute, RetryAfter: 2 * time.Second}
+
+	tests := []struct {
+		name          string
+		byIP          bool
+		method        string
+		path          string
+		accountID     string
+		decision      ratelimit.Decision
+		allowErr      error
+		wantStatus    int
+		wantClass     ratelimit.Class
+		wantSubject   ratelimit.Subject
+		wantHeaders   map[string]string
+		wantNoHeaders bool
+		wantErr       bool
+	}{
+		{
+			name:        "[Success] read by account",
+			method:      http.MethodGet,
+			path:        "/api/notes",
+			accountID:   "acc-1",
+			decision:    allowed,
+			wantStatus:  http.StatusOK,
+			wantClass:   ratelimit.ClassRead,
+			wantSubject: ratelimit.Subject{AccountID: "acc-1", IP: "192.0.2.1"},
+			wantHeaders: map[string]string{HeaderRateLimitLimit: "60", HeaderRateLimitRemaining: "59", HeaderRateLimitReset: "1"},
+		},
+		{
+			name:        "[Success] write by IP without an account",
+			method:      http.MethodPost,
+			path:        "/api/accounts/auth",
+			decision:    allowed,
+			wantStatus:  http.StatusOK,
+			wantClass:   ratelimit.ClassWrite,
+			wantSubject: ratelimit.Subject{IP: "192.0.2.1"},
+		},
+		{
+			name:        "[Success] IP before authentication spends its own budget",
+			byIP:        true,
+			method:      http.MethodPost,
+			path:        "/api/notes",
+			decision:    allowed,
+			wantStatus:  http.StatusOK,
+			wantClass:   ratelimit.ClassPreAuth,
+			wantSubject: ratelimit.Subject{IP: "192.0.2.1"},
+			wantHeaders: map[string]string{HeaderRateLimitLimit: "60"},
+		},
+		{
+			name:          "[Success] unlimited class has no headers",
+			method:        http.MethodDelete,
+			path:          "/api/notes/n1",
+			accountID:     "acc-1",
+			decision:      ratelimit.Decision{Allowed: true},
+			wantStatus:    http.StatusOK,
+			wantClass:     ratelimit.ClassWrite,
+			wantSubject:   ratelimit.Subject{AccountID: "acc-1", IP: "192.0.2.1"},
+			wantNoHeaders: true,
+		},
+		{
+			name:       "[Success] skipped path",
+			method:     http.MethodGet,
+			path:       "/public",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "[Fail] bucket is empty",
+			method:      http.MethodPut,
+			path:        "/api/notes/n1",
+			accountID:   "acc-1",
+			decision:    refused,
+			wantStatus:  http.StatusTooManyRequests,
+			wantClass:   ratelimit.ClassWrite,
+			wantSubject: ratelimit.Subject{AccountID: "acc-1", IP: "192.0.2.1"},
+			wantHeaders: map[string]string{echo.HeaderRetryAfter: "2", HeaderRateLimitRemaining: "0", HeaderRateLimitReset: "60"},
+		},
+		{
+			name:        "[Fail] bucket of the IP is empty before authentication",
+			byIP:        true,
+			method:      http.MethodGet,
+			path:        "/api/notes",
+			decision:    refused,
+			wantStatus:  http.StatusTooManyRequests,
+			wantClass:   ratelimit.ClassPreAuth,
+			wantSubject: ratelimit.Subject{IP: "192.0.2.1"},
+			wantHeaders: map[string]string{echo.HeaderRetryAfter: "2", HeaderRateLimitRemaining: "0"},
+		},
+		{
+			name:        "[Fail] limiter error",
+			method:      http.MethodGet,
+			path:        "/api/notes",
+			accountID:   "acc-1",
+			allowErr:    errors.New("store down"),
+			wantClass:   ratelimit.ClassRead,
+			wantSubject: ratelimit.Subject{AccountID: "acc-1", IP: "192.0.2.1"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := &limiterStub{decision: tt.decision, err: tt.allowErr}
+			skipper := func(c echo.Context) bool { return c.Request().URL.Path == "/public" }
+			mw := RateLimit(limiter, skipper)
+			if tt.byIP {
+				mw = RateLimitByIP(limiter, skipper)
+			}
+			handler := mw(func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			e := echo.New()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			if tt.accountID != "" {
+				req = req.WithContext(WithAccountID(req.Context(), tt.accountID))
+			}
+			rec := httptest.NewRecorder()
+			err := handler(e.NewContext(req, rec))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if limiter.gotClass != tt.wantClass || limiter.gotSubject != tt.wantSubject {
+				t.Errorf("limited %s %+v, want %s %+v", limiter.gotClass, limiter.gotSubject, tt.wantClass, tt.wantSubject)
+			}
+			for key, want := range tt.wantHeaders {
+				if got := rec.Header().Get(key); got != want {
+					t.Errorf("%s = %q, want %q", key, got, want)
+				}
+			}
+			if tt.wantNoHeaders && rec.Header().Get(HeaderRateLimitLimit) != "" {
+				t.Errorf("unexpected %s header", HeaderRateLimitLimit)
+			}
+		})
+	}
+}
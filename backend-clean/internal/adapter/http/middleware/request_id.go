@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// maxRequestIDLength bounds a client-supplied X-Request-ID.
+const maxRequestIDLength = 128
+
+// RequestID tags each request with an ID, taken from the X-Request-ID header when
+// the client sent a usable one and generated otherwise. The ID is echoed in the
+// response header and stored in the request context for the audit log.
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			id := req.Header.Get(echo.HeaderXRequestID)
+			if !validRequestID(id) {
+				id = uuid.NewString()
+			}
+			c.Response().Header().Set(echo.HeaderXRequestID, id)
+			c.SetRequest(req.WithContext(port.WithRequestID(req.Context(), id)))
+			return next(c)
+		}
+	}
+}
+
+// validRequestID accepts up to maxRequestIDLength printable ASCII characters, so
+// that a client cannot smuggle control characters or huge values into the log.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		wantKeep bool
+	}{
+		{name: "[Success] keep client ID", header: "req-123", wantKeep: true},
+		{name: "[Success] generate when missing", header: ""},
+		{name: "[Success] replace ID with control characters", header: "bad\tid"},
+		{name: "[Success] replace too long ID", header: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotID string
+			handler := RequestID()(func(c echo.Context) error {
+				gotID = port.RequestIDFromContext(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/notes", nil)
+			if tt.header != "" {
+				req.Header.Set(echo.HeaderXRequestID, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			if err := handler(e.NewContext(req, rec)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotID == "" || rec.Header().Get(echo.HeaderXRequestID) != gotID {
+				t.Fatalf("context ID %q and response header %q differ", gotID, rec.Header().Get(echo.HeaderXRequestID))
+			}
+			if (gotID == tt.header) != tt.wantKeep {
+				t.Fatalf("keep client ID = %v, want %v (got %q)", gotID == tt.header, tt.wantKeep, gotID)
+			}
+		})
+	}
+}
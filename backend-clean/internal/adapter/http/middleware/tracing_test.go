@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestTracing(t *testing.T) {
+	const traceparent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+	tests := []struct {
+		name        string
+		handlerErr  error
+		traceparent string
+		wantStatus  int
+		wantFailed  bool
+	}{
+		{name: "[Success] new trace", wantStatus: http.StatusOK},
+		{name: "[Success] continues the client's trace", traceparent: traceparent, wantStatus: http.StatusOK},
+		{name: "[Fail] client error is not a server fault", handlerErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] server error", handlerErr: errors.New("pool closed"), wantStatus: http.StatusInternalServerError, wantFailed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+			e := echo.New()
+			e.Logger.SetOutput(io.Discard)
+			e.HTTPErrorHandler = problem.HTTPErrorHandler
+			e.Use(RequestID(), Tracing(tracer, propagation.TraceContext{}))
+			var handlerSpan trace.SpanContext
+			e.PUT("/api/notes/:noteId", func(c echo.Context) error {
+				handlerSpan = trace.SpanContextFromContext(c.Request().Context())
+				return tt.handlerErr
+			})
+
+			req := httptest.NewRequest(http.MethodPut, "/api/notes/n-1", nil)
+			req.Header.Set(echo.HeaderXRequestID, "req-1")
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("spans = %d, want 1", len(spans))
+			}
+			span := spans[0]
+			if span.Name() != "PUT /api/notes/:noteId" {
+				t.Errorf("name = %q", span.Name())
+			}
+			if span.SpanKind() != trace.SpanKindServer {
+				t.Errorf("kind = %v, want server", span.SpanKind())
+			}
+			if handlerSpan.SpanID() != span.SpanContext().SpanID() {
+				t.Errorf("handler does not run inside the span")
+			}
+			if tt.traceparent != "" && span.SpanContext().TraceID().String() != "0af7651916cd43dd8448eb211c80319c" {
+				t.Errorf("trace ID = %s, want the client's", span.SpanContext().TraceID())
+			}
+			attrs := attribute.NewSet(span.Attributes()...)
+			if v, _ := attrs.Value("http.response.status_code"); v.AsInt64() != int64(tt.wantStatus) {
+				t.Errorf("http.response.status_code = %d, want %d", v.AsInt64(), tt.wantStatus)
+			}
+			if v, _ := attrs.Value("request.id"); v.AsString() != "req-1" {
+				t.Errorf("request.id = %q, want req-1", v.AsString())
+			}
+			if (span.Status().Code == codes.Error) != tt.wantFailed {
+				t.Errorf("status = %v, want failed %v", span.Status().Code, tt.wantFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// HeaderXWorkspaceID selects the workspace a request works in.
+const HeaderXWorkspaceID = "X-Workspace-ID"
+
+// Workspace resolves the workspace of each authenticated request from the
+// X-Workspace-ID header, falling back to the account's first workspace, and stores
+// it in the request context so that repositories only see its templates and notes.
+// The resolved ID is echoed in the response header. It must run after Authenticate.
+// Requests for which skipper returns true are passed through without a workspace.
+func Workspace(resolver port.WorkspaceResolver, skipper func(echo.Context) bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper != nil && skipper(c) {
+				return next(c)
+			}
+			req := c.Request()
+			accountID, _ := AccountIDFromContext(req.Context())
+			workspaceID, err := resolver.Resolve(req.Context(), accountID, req.Header.Get(HeaderXWorkspaceID))
+			switch {
+			case err == nil:
+			case errors.Is(err, domainerr.ErrUnauthenticated):
+				return unauthorized(c, "missing account")
+			case errors.Is(err, domainerr.ErrUnauthorized):
+				return problem.JSON(c, http.StatusForbidden, domainerr.ErrUnauthorized.Code, "not a member of the workspace")
+			case errors.Is(err, domainerr.ErrNotFound):
+				return problem.JSON(c, http.StatusNotFound, domainerr.ErrNotFound.Code, "workspace not found")
+			default:
+				return err
+			}
+			c.Response().Header().Set(HeaderXWorkspaceID, workspaceID)
+			c.SetRequest(req.WithContext(port.WithWorkspaceID(req.Context(), workspaceID)))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+type resolverStub struct {
+	workspaceID  string
+	err          error
+	gotAccountID string
+	gotRequested string
+}
+
+func (r *resolverStub) Resolve(_ context.Context, accountID, requestedID string) (string, error) {
+	r.gotAccountID = accountID
+	r.gotRequested = requestedID
+	return r.workspaceID, r.err
+}
+
+func TestWorkspace(t *testing.T) {
+	tests := []struct {
+		name            string
+		header          string
+		path            string
+		resolveErr      error
+		wantStatus      int
+		wantWorkspaceID string
+		wantErr         bool
+	}{
+		{name: "[Success] requested workspace", header: "ws-2", path: "/api/notes", wantStatus: http.StatusOK, wantWorkspaceID: "ws-1"},
+		{name: "[Success] default workspace", path: "/api/notes", wantStatus: http.StatusOK, wantWorkspaceID: "ws-1"},
+		{name: "[Success] skipped path", path: "/api/workspaces", wantStatus: http.StatusOK},
+		{name: "[Fail] not a member", header: "ws-9", path: "/api/notes", resolveErr: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden},
+		{name: "[Fail] no workspace", path: "/api/notes", resolveErr: domainerr.ErrNotFound, wantStatus: http.StatusNotFound},
+		{name: "[Fail] resolver error", path: "/api/notes", resolveErr: errors.New("db err"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &resolverStub{workspaceID: "ws-1", err: tt.resolveErr}
+			mw := Workspace(resolver, func(c echo.Context) bool { return c.Request().URL.Path == "/api/workspaces" })
+
+			var gotWorkspaceID string
+			handler := mw(func(c echo.Context) error {
+				gotWorkspaceID = port.WorkspaceIDFromContext(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			})
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req = req.WithContext(WithAccountID(req.Context(), "acc-1"))
+			if tt.header != "" {
+				req.Header.Set(HeaderXWorkspaceID, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			err := handler(e.NewContext(req, rec))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if gotWorkspaceID != tt.wantWorkspaceID {
+				t.Errorf("workspace ID = %q, want %q", gotWorkspaceID, tt.wantWorkspaceID)
+			}
+			if got := rec.Header().Get(HeaderXWorkspaceID); got != tt.wantWorkspaceID {
+				t.Errorf("response header = %q, want %q", got, tt.wantWorkspaceID)
+			}
+			if tt.path != "/api/workspaces" && (resolver.gotAccountID != "acc-1" || resolver.gotRequested != tt.header) {
+				t.Errorf("resolver got (%q, %q)", resolver.gotAccountID, resolver.gotRequested)
+			}
+		})
+	}
+}
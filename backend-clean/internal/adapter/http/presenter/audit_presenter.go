@@ -0,0 +1,104 @@
+package presenter
+
+import (
+	"context"
+	"encoding/json"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AuditPresenter converts the audit log to OpenAPI responses.
+type AuditPresenter struct {
+	log          openapi.ModelsAuditLogListResponse
+	verification openapi.ModelsAuditVerificationResponse
+}
+
+var _ port.AuditOutputPort = (*AuditPresenter)(nil)
+
+// NewAuditPresenter creates a new AuditPresenter.
+func NewAuditPresenter() *AuditPresenter {
+	return &AuditPresenter{}
+}
+
+// PresentAuditLog stores a page of the audit log response.
+func (p *AuditPresenter) PresentAuditLog(_ context.Context, entries page.Page[audit.Entry]) error {
+	res := make([]openapi.ModelsAuditLogEntry, 0, len(entries.Items))
+	for _, e := range entries.Items {
+		item, err := toAuditLogEntry(&e)
+		if err != nil {
+			return err
+		}
+		res = append(res, item)
+	}
+	p.log = openapi.ModelsAuditLogListResponse{
+		Items:      res,
+		NextCursor: encodeCursor(entries.NextCursor),
+		HasMore:    entries.HasMore(),
+	}
+	return nil
+}
+
+// PresentAuditVerification stores the chain verification response.
+func (p *AuditPresenter) PresentAuditVerification(_ context.Context, v audit.Verification) error {
+	p.verification = openapi.ModelsAuditVerificationResponse{
+		Valid:   v.Valid(),
+		Checked: int32(v.Checked), //nolint:gosec
+	}
+	if !v.Valid() {
+		brokenAt := v.BrokenAt
+		p.verification.BrokenAt = &brokenAt
+	}
+	return nil
+}
+
+// AuditLog returns the audit log response.
+func (p *AuditPresenter) AuditLog() openapi.ModelsAuditLogListResponse {
+	return p.log
+}
+
+// Verification returns the chain verification response.
+func (p *AuditPresenter) Verification() openapi.ModelsAuditVerificationResponse {
+	return p.verification
+}
+
+func toAuditLogEntry(e *audit.Entry) (openapi.ModelsAuditLogEntry, error) {
+	res := openapi.ModelsAuditLogEntry{
+		Sequence:      e.Sequence,
+		Action:        openapi.ModelsAuditAction(e.Action),
+		AggregateType: openapi.ModelsAuditAggregateType(e.AggregateType),
+		AggregateId:   e.AggregateID,
+		OccurredAt:    e.OccurredAt,
+		PrevHash:      e.PrevHash,
+		Hash:          e.Hash,
+	}
+	if e.ActorID != "" {
+		actorID := e.ActorID
+		res.ActorId = &actorID
+	}
+	if e.RequestID != "" {
+		requestID := e.RequestID
+		res.RequestId = &requestID
+	}
+	var err error
+	if res.Before, err = auditSnapshot(e.Before); err != nil {
+		return openapi.ModelsAuditLogEntry{}, err
+	}
+	if res.After, err = auditSnapshot(e.After); err != nil {
+		return openapi.ModelsAuditLogEntry{}, err
+	}
+	return res, nil
+}
+
+func auditSnapshot(raw []byte) (*map[string]interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
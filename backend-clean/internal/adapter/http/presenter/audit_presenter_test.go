@@ -0,0 +1,58 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	"immortal-architecture-clean/backend/internal/domain/page"
+)
+
+func TestAuditPresenter(t *testing.T) {
+	e := audit.Entry{
+		ActorID: "a1", Action: audit.ActionUpdate, AggregateType: audit.AggregateNote, AggregateID: "n1",
+		Before: []byte(`{"title":"A"}`), After: []byte(`{"title":"B"}`),
+	}.Seal("", time.Now())
+	e.Sequence = 7
+
+	t.Run("[Success] audit log", func(t *testing.T) {
+		p := NewAuditPresenter()
+		next := e.Cursor()
+		if err := p.PresentAuditLog(context.Background(), page.Page[audit.Entry]{Items: []audit.Entry{e}, NextCursor: &next}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.AuditLog()
+		if len(got.Items) != 1 || !got.HasMore || got.NextCursor == nil || got.Items[0].Sequence != 7 || got.Items[0].RequestId != nil {
+			t.Fatalf("unexpected audit log: %+v", got)
+		}
+		item := got.Items[0]
+		if item.ActorId == nil || *item.ActorId != "a1" || item.Before == nil || (*item.Before)["title"] != "A" || item.After == nil || (*item.After)["title"] != "B" {
+			t.Fatalf("unexpected entry: %+v", item)
+		}
+	})
+
+	t.Run("[Fail] corrupt snapshot", func(t *testing.T) {
+		broken := e
+		broken.After = []byte(`not-json`)
+		if err := NewAuditPresenter().PresentAuditLog(context.Background(), page.Page[audit.Entry]{Items: []audit.Entry{broken}}); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("[Success] verification", func(t *testing.T) {
+		p := NewAuditPresenter()
+		if err := p.PresentAuditVerification(context.Background(), audit.Verification{Checked: 3}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := p.Verification(); !got.Valid || got.Checked != 3 || got.BrokenAt != nil {
+			t.Fatalf("unexpected verification: %+v", got)
+		}
+		if err := p.PresentAuditVerification(context.Background(), audit.Verification{Checked: 2, BrokenAt: 2}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := p.Verification(); got.Valid || got.BrokenAt == nil || *got.BrokenAt != 2 {
+			t.Fatalf("unexpected verification: %+v", got)
+		}
+	})
+}
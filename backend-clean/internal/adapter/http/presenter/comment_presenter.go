@@ -0,0 +1,89 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/comment"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// CommentPresenter converts comments to OpenAPI responses.
+type CommentPresenter struct {
+	comment   *openapi.ModelsCommentResponse
+	threads   *openapi.ModelsCommentThreadListResponse
+	deletedOK bool
+}
+
+var _ port.CommentOutputPort = (*CommentPresenter)(nil)
+
+// NewCommentPresenter creates a new CommentPresenter.
+func NewCommentPresenter() *CommentPresenter {
+	return &CommentPresenter{}
+}
+
+// PresentCommentThreads stores the thread list response.
+func (p *CommentPresenter) PresentCommentThreads(_ context.Context, threads []comment.Thread) error {
+	items := make([]openapi.ModelsCommentThread, 0, len(threads))
+	for _, t := range threads {
+		replies := make([]openapi.ModelsCommentResponse, 0, len(t.Replies))
+		for _, r := range t.Replies {
+			replies = append(replies, toCommentResponse(r))
+		}
+		items = append(items, openapi.ModelsCommentThread{
+			Comment: toCommentResponse(t.Root),
+			Replies: replies,
+		})
+	}
+	p.threads = &openapi.ModelsCommentThreadListResponse{Items: items}
+	return nil
+}
+
+// PresentComment stores single comment response.
+func (p *CommentPresenter) PresentComment(_ context.Context, c *comment.WithMeta) error {
+	resp := toCommentResponse(*c)
+	p.comment = &resp
+	return nil
+}
+
+// PresentCommentDeleted marks delete success.
+func (p *CommentPresenter) PresentCommentDeleted(_ context.Context) error {
+	p.deletedOK = true
+	return nil
+}
+
+// Comment returns the last comment response.
+func (p *CommentPresenter) Comment() *openapi.ModelsCommentResponse {
+	return p.comment
+}
+
+// Threads returns the thread list response.
+func (p *CommentPresenter) Threads() *openapi.ModelsCommentThreadListResponse {
+	return p.threads
+}
+
+// DeleteResponse returns deletion success response.
+func (p *CommentPresenter) DeleteResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.deletedOK}
+}
+
+func toCommentResponse(c comment.WithMeta) openapi.ModelsCommentResponse {
+	return openapi.ModelsCommentResponse{
+		Id:        c.Comment.ID,
+		NoteId:    c.Comment.NoteID,
+		SectionId: strPtrOrNil(c.Comment.SectionID),
+		ParentId:  strPtrOrNil(c.Comment.ParentID),
+		Author: openapi.ModelsAccountSummary{
+			Id:        c.Comment.AuthorID,
+			FirstName: c.AuthorFirstName,
+			LastName:  c.AuthorLastName,
+			Thumbnail: c.AuthorThumbnail,
+		},
+		Body:       c.Comment.Body,
+		Resolved:   c.Comment.IsResolved(),
+		ResolvedBy: strPtrOrNil(c.Comment.ResolvedBy),
+		ResolvedAt: c.Comment.ResolvedAt,
+		CreatedAt:  c.Comment.CreatedAt,
+		UpdatedAt:  c.Comment.UpdatedAt,
+	}
+}
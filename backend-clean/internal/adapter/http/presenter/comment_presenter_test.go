@@ -0,0 +1,70 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/comment"
+)
+
+func TestCommentPresenter(t *testing.T) {
+	now := time.Now()
+	thumb := "https://example.com/t.png"
+	root := comment.WithMeta{
+		Comment:         comment.Comment{ID: "c1", NoteID: "n1", SectionID: "s1", AuthorID: "a1", Body: "why?", ResolvedBy: "owner", ResolvedAt: &now},
+		AuthorFirstName: "Taro",
+		AuthorLastName:  "Yamada",
+		AuthorThumbnail: &thumb,
+	}
+	reply := comment.WithMeta{Comment: comment.Comment{ID: "c2", NoteID: "n1", SectionID: "s1", ParentID: "c1", AuthorID: "a2", Body: "because"}}
+
+	t.Run("[Success] threads", func(t *testing.T) {
+		p := NewCommentPresenter()
+		threads := []comment.Thread{{Root: root, Replies: []comment.WithMeta{reply}}, {Root: reply}}
+		if err := p.PresentCommentThreads(context.Background(), threads); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Threads()
+		if got == nil || len(got.Items) != 2 || len(got.Items[0].Replies) != 1 || got.Items[0].Replies[0].Id != "c2" {
+			t.Fatalf("unexpected threads: %+v", got)
+		}
+		if got.Items[1].Replies == nil || len(got.Items[1].Replies) != 0 {
+			t.Fatalf("replies should be an empty list: %+v", got.Items[1].Replies)
+		}
+	})
+
+	t.Run("[Success] single", func(t *testing.T) {
+		p := NewCommentPresenter()
+		if err := p.PresentComment(context.Background(), &root); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Comment()
+		if got == nil || got.Author.Id != "a1" || got.Author.FirstName != "Taro" || got.Author.Thumbnail == nil {
+			t.Fatalf("unexpected author: %+v", got)
+		}
+		if !got.Resolved || got.ResolvedBy == nil || *got.ResolvedBy != "owner" || got.ResolvedAt == nil {
+			t.Fatalf("unexpected resolution: %+v", got)
+		}
+		if got.SectionId == nil || *got.SectionId != "s1" || got.ParentId != nil {
+			t.Fatalf("unexpected anchors: %+v", got)
+		}
+	})
+
+	t.Run("[Success] open reply", func(t *testing.T) {
+		p := NewCommentPresenter()
+		_ = p.PresentComment(context.Background(), &reply)
+		got := p.Comment()
+		if got.Resolved || got.ResolvedBy != nil || got.ParentId == nil || *got.ParentId != "c1" {
+			t.Fatalf("unexpected reply: %+v", got)
+		}
+	})
+
+	t.Run("[Success] deleted", func(t *testing.T) {
+		p := NewCommentPresenter()
+		_ = p.PresentCommentDeleted(context.Background())
+		if !p.DeleteResponse().Success {
+			t.Fatalf("delete flag not set")
+		}
+	})
+}
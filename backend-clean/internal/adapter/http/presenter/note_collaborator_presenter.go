@@ -0,0 +1,75 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCollaboratorPresenter converts note collaborators to OpenAPI responses.
+type NoteCollaboratorPresenter struct {
+	collaborator  *openapi.ModelsNoteCollaboratorResponse
+	collaborators *openapi.ModelsNoteCollaboratorListResponse
+	deletedOK     bool
+}
+
+var _ port.NoteCollaboratorOutputPort = (*NoteCollaboratorPresenter)(nil)
+
+// NewNoteCollaboratorPresenter creates a new NoteCollaboratorPresenter.
+func NewNoteCollaboratorPresenter() *NoteCollaboratorPresenter {
+	return &NoteCollaboratorPresenter{}
+}
+
+// PresentNoteCollaborators stores the collaborator list response.
+func (p *NoteCollaboratorPresenter) PresentNoteCollaborators(_ context.Context, collaborators []note.CollaboratorWithMeta) error {
+	items := make([]openapi.ModelsNoteCollaboratorResponse, 0, len(collaborators))
+	for _, c := range collaborators {
+		items = append(items, toNoteCollaboratorResponse(c))
+	}
+	p.collaborators = &openapi.ModelsNoteCollaboratorListResponse{Items: items}
+	return nil
+}
+
+// PresentNoteCollaborator stores single collaborator response.
+func (p *NoteCollaboratorPresenter) PresentNoteCollaborator(_ context.Context, c *note.CollaboratorWithMeta) error {
+	resp := toNoteCollaboratorResponse(*c)
+	p.collaborator = &resp
+	return nil
+}
+
+// PresentNoteCollaboratorDeleted marks delete success.
+func (p *NoteCollaboratorPresenter) PresentNoteCollaboratorDeleted(_ context.Context) error {
+	p.deletedOK = true
+	return nil
+}
+
+// Collaborator returns the last collaborator response.
+func (p *NoteCollaboratorPresenter) Collaborator() *openapi.ModelsNoteCollaboratorResponse {
+	return p.collaborator
+}
+
+// Collaborators returns the collaborator list response.
+func (p *NoteCollaboratorPresenter) Collaborators() *openapi.ModelsNoteCollaboratorListResponse {
+	return p.collaborators
+}
+
+// DeleteResponse returns deletion success response.
+func (p *NoteCollaboratorPresenter) DeleteResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.deletedOK}
+}
+
+func toNoteCollaboratorResponse(c note.CollaboratorWithMeta) openapi.ModelsNoteCollaboratorResponse {
+	return openapi.ModelsNoteCollaboratorResponse{
+		Account: openapi.ModelsAccountSummary{
+			Id:        c.Collaborator.AccountID,
+			FirstName: c.FirstName,
+			LastName:  c.LastName,
+			Thumbnail: c.Thumbnail,
+		},
+		Role:      openapi.ModelsCollaboratorRole(c.Collaborator.Role),
+		CreatedAt: c.Collaborator.CreatedAt,
+		UpdatedAt: c.Collaborator.UpdatedAt,
+	}
+}
@@ -0,0 +1,57 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestNoteCollaboratorPresenter(t *testing.T) {
+	thumb := "https://example.com/t.png"
+	editor := note.CollaboratorWithMeta{
+		Collaborator: note.Collaborator{NoteID: "n1", AccountID: "a1", Role: note.RoleEditor},
+		FirstName:    "Taro",
+		LastName:     "Yamada",
+		Thumbnail:    &thumb,
+	}
+	viewer := note.CollaboratorWithMeta{Collaborator: note.Collaborator{NoteID: "n1", AccountID: "a2", Role: note.RoleViewer}}
+
+	t.Run("[Success] list", func(t *testing.T) {
+		p := NewNoteCollaboratorPresenter()
+		if err := p.PresentNoteCollaborators(context.Background(), []note.CollaboratorWithMeta{editor, viewer}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Collaborators()
+		if got == nil || len(got.Items) != 2 || got.Items[0].Role != "editor" || got.Items[1].Account.Id != "a2" {
+			t.Fatalf("unexpected collaborators: %+v", got)
+		}
+	})
+
+	t.Run("[Success] empty list", func(t *testing.T) {
+		p := NewNoteCollaboratorPresenter()
+		_ = p.PresentNoteCollaborators(context.Background(), nil)
+		if got := p.Collaborators(); got == nil || got.Items == nil || len(got.Items) != 0 {
+			t.Fatalf("items should be an empty list: %+v", got)
+		}
+	})
+
+	t.Run("[Success] single", func(t *testing.T) {
+		p := NewNoteCollaboratorPresenter()
+		if err := p.PresentNoteCollaborator(context.Background(), &editor); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Collaborator()
+		if got == nil || got.Account.Id != "a1" || got.Account.FirstName != "Taro" || got.Account.Thumbnail == nil || got.Role != "editor" {
+			t.Fatalf("unexpected collaborator: %+v", got)
+		}
+	})
+
+	t.Run("[Success] delete", func(t *testing.T) {
+		p := NewNoteCollaboratorPresenter()
+		_ = p.PresentNoteCollaboratorDeleted(context.Background())
+		if !p.DeleteResponse().Success {
+			t.Fatalf("expected success")
+		}
+	})
+}
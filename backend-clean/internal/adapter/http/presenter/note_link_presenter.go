@@ -0,0 +1,78 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteLinkPresenter converts note links to OpenAPI responses.
+type NoteLinkPresenter struct {
+	link      *openapi.ModelsNoteLinkSummary
+	links     *openapi.ModelsNoteLinksResponse
+	deletedOK bool
+}
+
+var _ port.NoteLinkOutputPort = (*NoteLinkPresenter)(nil)
+
+// NewNoteLinkPresenter creates a new NoteLinkPresenter.
+func NewNoteLinkPresenter() *NoteLinkPresenter {
+	return &NoteLinkPresenter{}
+}
+
+// PresentNoteLinks stores outgoing links and backlinks.
+func (p *NoteLinkPresenter) PresentNoteLinks(_ context.Context, outgoing, backlinks []note.LinkSummary) error {
+	p.links = &openapi.ModelsNoteLinksResponse{
+		Outgoing:  toNoteLinkSummaries(outgoing),
+		Backlinks: toNoteLinkSummaries(backlinks),
+	}
+	return nil
+}
+
+// PresentNoteLink stores single link response.
+func (p *NoteLinkPresenter) PresentNoteLink(_ context.Context, link *note.LinkSummary) error {
+	resp := toNoteLinkSummary(*link)
+	p.link = &resp
+	return nil
+}
+
+// PresentNoteLinkDeleted marks delete success.
+func (p *NoteLinkPresenter) PresentNoteLinkDeleted(_ context.Context) error {
+	p.deletedOK = true
+	return nil
+}
+
+// Link returns the last link response.
+func (p *NoteLinkPresenter) Link() *openapi.ModelsNoteLinkSummary {
+	return p.link
+}
+
+// Links returns the link list response.
+func (p *NoteLinkPresenter) Links() *openapi.ModelsNoteLinksResponse {
+	return p.links
+}
+
+// DeleteResponse returns deletion success response.
+func (p *NoteLinkPresenter) DeleteResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.deletedOK}
+}
+
+func toNoteLinkSummaries(links []note.LinkSummary) []openapi.ModelsNoteLinkSummary {
+	res := make([]openapi.ModelsNoteLinkSummary, 0, len(links))
+	for _, l := range links {
+		res = append(res, toNoteLinkSummary(l))
+	}
+	return res
+}
+
+func toNoteLinkSummary(l note.LinkSummary) openapi.ModelsNoteLinkSummary {
+	return openapi.ModelsNoteLinkSummary{
+		Id:     l.LinkID,
+		Type:   openapi.ModelsNoteLinkType(l.Type),
+		NoteId: l.NoteID,
+		Title:  l.Title,
+		Status: openapi.ModelsNoteStatus(l.Status),
+	}
+}
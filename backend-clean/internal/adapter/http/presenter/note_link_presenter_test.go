@@ -0,0 +1,45 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestNoteLinkPresenter(t *testing.T) {
+	link := note.LinkSummary{LinkID: "l1", Type: note.LinkSupersedes, NoteID: "n2", Title: "Old", Status: note.StatusPublish, OwnerID: "owner-1"}
+
+	t.Run("[Success] list", func(t *testing.T) {
+		p := NewNoteLinkPresenter()
+		if err := p.PresentNoteLinks(context.Background(), []note.LinkSummary{link}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Links()
+		if got == nil || len(got.Outgoing) != 1 || got.Outgoing[0].Type != openapi.ModelsNoteLinkTypeSupersedes {
+			t.Fatalf("unexpected links: %+v", got)
+		}
+		if got.Backlinks == nil || len(got.Backlinks) != 0 {
+			t.Fatalf("backlinks should be an empty list: %+v", got.Backlinks)
+		}
+	})
+
+	t.Run("[Success] single", func(t *testing.T) {
+		p := NewNoteLinkPresenter()
+		if err := p.PresentNoteLink(context.Background(), &link); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := p.Link(); got == nil || got.Id != "l1" || got.NoteId != "n2" || got.Title != "Old" {
+			t.Fatalf("unexpected link: %+v", got)
+		}
+	})
+
+	t.Run("[Success] deleted", func(t *testing.T) {
+		p := NewNoteLinkPresenter()
+		_ = p.PresentNoteLinkDeleted(context.Background())
+		if !p.DeleteResponse().Success {
+			t.Fatalf("delete flag not set")
+		}
+	})
+}
@@ -6,13 +6,17 @@ import (
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // NotePresenter converts note domain models to OpenAPI responses.
 type NotePresenter struct {
 	note      *openapi.ModelsNoteResponse
-	notes     []openapi.ModelsNoteResponse
+	notes     openapi.ModelsNoteListResponse
+	results   []openapi.ModelsNoteSearchResult
+	markdown  string
+	filename  string
 	deletedOK bool
 }
 
@@ -23,13 +27,39 @@ func NewNotePresenter() *NotePresenter {
 	return &NotePresenter{}
 }
 
-// PresentNoteList stores note list response.
-func (p *NotePresenter) PresentNoteList(_ context.Context, notes []note.WithMeta) error {
-	res := make([]openapi.ModelsNoteResponse, 0, len(notes))
-	for _, n := range notes {
+// PresentNoteList stores a page of the note list response.
+func (p *NotePresenter) PresentNoteList(_ context.Context, notes page.Page[note.WithMeta]) error {
+	res := make([]openapi.ModelsNoteResponse, 0, len(notes.Items))
+	for _, n := range notes.Items {
 		res = append(res, toNoteResponse(n))
 	}
-	p.notes = res
+	p.notes = openapi.ModelsNoteListResponse{
+		Items:      res,
+		NextCursor: encodeCursor(notes.NextCursor),
+		HasMore:    notes.HasMore(),
+	}
+	return nil
+}
+
+// PresentSearchResults stores ranked search results.
+func (p *NotePresenter) PresentSearchResults(_ context.Context, results []note.SearchResult) error {
+	res := make([]openapi.ModelsNoteSearchResult, 0, len(results))
+	for _, r := range results {
+		highlights := make([]openapi.ModelsNoteSearchHighlight, 0, len(r.Snippet.Highlights))
+		for _, h := range r.Snippet.Highlights {
+			highlights = append(highlights, openapi.ModelsNoteSearchHighlight{
+				Start: int32(h.Start), //nolint:gosec
+				End:   int32(h.End),   //nolint:gosec
+			})
+		}
+		res = append(res, openapi.ModelsNoteSearchResult{
+			Note:       toNoteResponse(r.Note),
+			Rank:       r.Rank,
+			Snippet:    r.Snippet.Text,
+			Highlights: highlights,
+		})
+	}
+	p.results = res
 	return nil
 }
 
@@ -40,6 +70,13 @@ func (p *NotePresenter) PresentNote(_ context.Context, n *note.WithMeta) error {
 	return nil
 }
 
+// PresentNoteMarkdown stores an exported Markdown document and its download file name.
+func (p *NotePresenter) PresentNoteMarkdown(_ context.Context, n *note.WithMeta, markdown string) error {
+	p.markdown = markdown
+	p.filename = n.Note.ID + ".md"
+	return nil
+}
+
 // PresentNoteDeleted marks delete success.
 func (p *NotePresenter) PresentNoteDeleted(_ context.Context) error {
 	p.deletedOK = true
@@ -52,10 +89,20 @@ func (p *NotePresenter) Note() *openapi.ModelsNoteResponse {
 }
 
 // Notes returns the note list response.
-func (p *NotePresenter) Notes() []openapi.ModelsNoteResponse {
+func (p *NotePresenter) Notes() openapi.ModelsNoteListResponse {
 	return p.notes
 }
 
+// SearchResults returns the search result response.
+func (p *NotePresenter) SearchResults() []openapi.ModelsNoteSearchResult {
+	return p.results
+}
+
+// Markdown returns the exported Markdown document and its file name.
+func (p *NotePresenter) Markdown() (filename, markdown string) {
+	return p.filename, p.markdown
+}
+
 // DeleteResponse returns deletion success response.
 func (p *NotePresenter) DeleteResponse() openapi.ModelsSuccessResponse {
 	return openapi.ModelsSuccessResponse{Success: p.deletedOK}
@@ -65,19 +112,26 @@ func toNoteResponse(n note.WithMeta) openapi.ModelsNoteResponse {
 	sections := make([]openapi.ModelsSection, 0, len(n.Sections))
 	for _, s := range n.Sections {
 		sections = append(sections, openapi.ModelsSection{
-			Id:         s.Section.ID,
-			FieldId:    s.Section.FieldID,
-			FieldLabel: s.FieldLabel,
-			Content:    s.Section.Content,
-			IsRequired: s.IsRequired,
+			Id:           s.Section.ID,
+			FieldId:      s.Section.FieldID,
+			FieldLabel:   s.FieldLabel,
+			Content:      s.Section.Content,
+			IsRequired:   s.IsRequired,
+			FieldType:    openapi.ModelsFieldType(s.FieldType),
+			FieldOptions: fieldOptions(s.FieldOptions),
 		})
 	}
-	return openapi.ModelsNoteResponse{
-		Id:           n.Note.ID,
-		Title:        n.Note.Title,
-		TemplateId:   n.Note.TemplateID,
-		TemplateName: n.TemplateName,
-		OwnerId:      n.Note.OwnerID,
+	tags := make([]string, 0, len(n.Tags))
+	for _, t := range n.Tags {
+		tags = append(tags, t.String())
+	}
+	resp := openapi.ModelsNoteResponse{
+		Id:              n.Note.ID,
+		Title:           n.Note.Title,
+		TemplateId:      n.Note.TemplateID,
+		TemplateName:    n.TemplateName,
+		TemplateVersion: int32(n.Note.TemplateVersion), //nolint:gosec
+		OwnerId:         n.Note.OwnerID,
 		Owner: openapi.ModelsAccountSummary{
 			Id:        n.Note.OwnerID,
 			FirstName: n.OwnerFirstName,
@@ -86,7 +140,19 @@ func toNoteResponse(n note.WithMeta) openapi.ModelsNoteResponse {
 		},
 		Status:    openapi.ModelsNoteStatus(n.Note.Status),
 		Sections:  sections,
+		Tags:      tags,
 		CreatedAt: n.Note.CreatedAt,
 		UpdatedAt: n.Note.UpdatedAt,
+		Version:   int32(n.Note.Version), //nolint:gosec
+	}
+	if n.Links != nil {
+		links := toNoteLinkSummaries(n.Links)
+		resp.Links = &links
+	}
+	if n.Backlinks != nil {
+		backlinks := toNoteLinkSummaries(n.Backlinks)
+		resp.Backlinks = &backlinks
 	}
+	resp.DeletedAt = n.Note.DeletedAt
+	return resp
 }
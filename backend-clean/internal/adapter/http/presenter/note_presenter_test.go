@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
 )
 
 func TestNotePresenter_TableDriven(t *testing.T) {
@@ -14,7 +15,7 @@ func TestNotePresenter_TableDriven(t *testing.T) {
 		name      string
 		action    string
 		single    *note.WithMeta
-		list      []note.WithMeta
+		list      page.Page[note.WithMeta]
 		wantID    string
 		wantCount int
 	}{
@@ -44,12 +45,30 @@ func TestNotePresenter_TableDriven(t *testing.T) {
 			},
 			wantID: "note-1",
 		},
+		{
+			name:   "[Success] single note with links",
+			action: "single",
+			single: &note.WithMeta{
+				Note:  note.Note{ID: "note-2", OwnerID: "owner-1"},
+				Links: []note.LinkSummary{{LinkID: "l1", Type: note.LinkDependsOn, NoteID: "note-1", Title: "Hello", Status: note.StatusPublish}},
+			},
+			wantID: "note-2",
+		},
 		{
 			name:      "[Success] list",
 			action:    "list",
-			list:      []note.WithMeta{{Note: note.Note{ID: "n1"}}, {Note: note.Note{ID: "n2"}}},
+			list:      page.Page[note.WithMeta]{Items: []note.WithMeta{{Note: note.Note{ID: "n1"}}, {Note: note.Note{ID: "n2"}}}},
 			wantCount: 2,
 		},
+		{
+			name:   "[Success] list with next page",
+			action: "list",
+			list: page.Page[note.WithMeta]{
+				Items:      []note.WithMeta{{Note: note.Note{ID: "n1", UpdatedAt: now}}},
+				NextCursor: &page.Cursor{UpdatedAt: now, ID: "n1"},
+			},
+			wantCount: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -67,10 +86,35 @@ func TestNotePresenter_TableDriven(t *testing.T) {
 				if len(resp.Sections) != len(tt.single.Sections) {
 					t.Fatalf("sections not mapped: %+v", resp.Sections)
 				}
+				if tt.single.Links != nil && (resp.Links == nil || len(*resp.Links) != len(tt.single.Links)) {
+					t.Fatalf("links not mapped: %+v", resp.Links)
+				}
+				if tt.single.Backlinks == nil && resp.Backlinks != nil {
+					t.Fatalf("backlinks should be omitted when not loaded: %+v", resp.Backlinks)
+				}
 			case "list":
 				_ = p.PresentNoteList(context.Background(), tt.list)
-				if len(p.Notes()) != tt.wantCount {
-					t.Fatalf("want %d notes, got %d", tt.wantCount, len(p.Notes()))
+				resp := p.Notes()
+				if len(resp.Items) != tt.wantCount {
+					t.Fatalf("want %d notes, got %d", tt.wantCount, len(resp.Items))
+				}
+				if resp.HasMore != tt.list.HasMore() {
+					t.Fatalf("want hasMore %v, got %v", tt.list.HasMore(), resp.HasMore)
+				}
+				if tt.list.NextCursor != nil {
+					if resp.NextCursor == nil {
+						t.Fatalf("nextCursor missing")
+					}
+					if c, err := page.Decode(*resp.NextCursor); err != nil || c.ID != tt.list.NextCursor.ID {
+						t.Fatalf("nextCursor does not round-trip: %v %+v", err, c)
+					}
+				} else if resp.NextCursor != nil {
+					t.Fatalf("nextCursor should be omitted on the last page")
+				}
+				for _, n := range resp.Items {
+					if n.Links != nil || n.Backlinks != nil {
+						t.Fatalf("links should be omitted in list: %+v", n)
+					}
 				}
 			}
 		})
@@ -84,3 +128,19 @@ func TestNotePresenter_PresentNoteDeleted(t *testing.T) {
 		t.Fatalf("delete flag not set")
 	}
 }
+
+func TestNotePresenter_PresentSearchResults(t *testing.T) {
+	p := NewNotePresenter()
+	_ = p.PresentSearchResults(context.Background(), []note.SearchResult{{
+		Note:    note.WithMeta{Note: note.Note{ID: "n1", Title: "t"}},
+		Rank:    3.5,
+		Snippet: note.Snippet{Text: "…設計メモ", Highlights: []note.Highlight{{Start: 1, End: 3}}},
+	}})
+	res := p.SearchResults()
+	if len(res) != 1 || res[0].Note.Id != "n1" || res[0].Rank != 3.5 || res[0].Snippet != "…設計メモ" {
+		t.Fatalf("unexpected results: %+v", res)
+	}
+	if len(res[0].Highlights) != 1 || res[0].Highlights[0].Start != 1 || res[0].Highlights[0].End != 3 {
+		t.Fatalf("unexpected highlights: %+v", res[0].Highlights)
+	}
+}
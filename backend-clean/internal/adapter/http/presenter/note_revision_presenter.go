@@ -0,0 +1,112 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteRevisionPresenter converts note revisions to OpenAPI responses.
+type NoteRevisionPresenter struct {
+	revision  *openapi.ModelsNoteRevisionResponse
+	revisions []openapi.ModelsNoteRevisionSummary
+	diff      *openapi.ModelsNoteRevisionDiffResponse
+}
+
+var _ port.NoteRevisionOutputPort = (*NoteRevisionPresenter)(nil)
+
+// NewNoteRevisionPresenter creates a new NoteRevisionPresenter.
+func NewNoteRevisionPresenter() *NoteRevisionPresenter {
+	return &NoteRevisionPresenter{}
+}
+
+// PresentRevisionList stores revision list response.
+func (p *NoteRevisionPresenter) PresentRevisionList(_ context.Context, revisions []note.Revision) error {
+	res := make([]openapi.ModelsNoteRevisionSummary, 0, len(revisions))
+	for _, r := range revisions {
+		res = append(res, openapi.ModelsNoteRevisionSummary{
+			Revision:  int32(r.Number), //nolint:gosec
+			Title:     r.Title,
+			Status:    openapi.ModelsNoteStatus(r.Status),
+			ActorId:   r.ActorID,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	p.revisions = res
+	return nil
+}
+
+// PresentRevision stores single revision response.
+func (p *NoteRevisionPresenter) PresentRevision(_ context.Context, r *note.Revision) error {
+	sections := make([]openapi.ModelsRevisionSection, 0, len(r.Sections))
+	for _, s := range r.Sections {
+		sections = append(sections, openapi.ModelsRevisionSection{
+			FieldId:    s.FieldID,
+			FieldLabel: s.FieldLabel,
+			FieldOrder: int32(s.FieldOrder), //nolint:gosec
+			Content:    s.Content,
+		})
+	}
+	p.revision = &openapi.ModelsNoteRevisionResponse{
+		Revision:  int32(r.Number), //nolint:gosec
+		Title:     r.Title,
+		Status:    openapi.ModelsNoteStatus(r.Status),
+		ActorId:   r.ActorID,
+		CreatedAt: r.CreatedAt,
+		Sections:  sections,
+	}
+	return nil
+}
+
+// PresentRevisionDiff stores revision diff response.
+func (p *NoteRevisionPresenter) PresentRevisionDiff(_ context.Context, d *note.RevisionDiff) error {
+	fields := make([]openapi.ModelsFieldDiff, 0, len(d.Fields))
+	for _, f := range d.Fields {
+		fields = append(fields, openapi.ModelsFieldDiff{
+			FieldId:    f.FieldID,
+			FieldLabel: f.FieldLabel,
+			Changed:    f.Changed,
+			Lines:      toDiffLines(f.Lines),
+		})
+	}
+	var statusFrom *openapi.ModelsNoteStatus
+	if d.StatusFrom != "" {
+		s := openapi.ModelsNoteStatus(d.StatusFrom)
+		statusFrom = &s
+	}
+	p.diff = &openapi.ModelsNoteRevisionDiffResponse{
+		NoteId:     d.NoteID,
+		From:       int32(d.From), //nolint:gosec
+		To:         int32(d.To),   //nolint:gosec
+		Title:      toDiffLines(d.Title),
+		StatusFrom: statusFrom,
+		StatusTo:   openapi.ModelsNoteStatus(d.StatusTo),
+		Fields:     fields,
+	}
+	return nil
+}
+
+// Revision returns the last revision response.
+func (p *NoteRevisionPresenter) Revision() *openapi.ModelsNoteRevisionResponse {
+	return p.revision
+}
+
+// Revisions returns the revision list response.
+func (p *NoteRevisionPresenter) Revisions() []openapi.ModelsNoteRevisionSummary {
+	return p.revisions
+}
+
+// Diff returns the revision diff response.
+func (p *NoteRevisionPresenter) Diff() *openapi.ModelsNoteRevisionDiffResponse {
+	return p.diff
+}
+
+func toDiffLines(lines []note.DiffLine) []openapi.ModelsDiffLine {
+	res := make([]openapi.ModelsDiffLine, 0, len(lines))
+	for _, l := range lines {
+		res = append(res, openapi.ModelsDiffLine{Op: openapi.ModelsDiffOp(l.Op), Text: l.Text})
+	}
+	return res
+}
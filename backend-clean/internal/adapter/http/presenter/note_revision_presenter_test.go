@@ -0,0 +1,59 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestNoteRevisionPresenter(t *testing.T) {
+	now := time.Now()
+	rev := &note.Revision{
+		NoteID:    "note-1",
+		Number:    2,
+		Title:     "Hello",
+		Status:    note.StatusPublish,
+		ActorID:   "owner-1",
+		CreatedAt: now,
+		Sections:  []note.RevisionSection{{FieldID: "f1", FieldLabel: "Body", FieldOrder: 1, Content: "c"}},
+	}
+
+	t.Run("[Success] list", func(t *testing.T) {
+		p := NewNoteRevisionPresenter()
+		if err := p.PresentRevisionList(context.Background(), []note.Revision{*rev, {Number: 1}}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := p.Revisions(); len(got) != 2 || got[0].Revision != 2 {
+			t.Fatalf("unexpected revisions: %+v", got)
+		}
+	})
+
+	t.Run("[Success] single", func(t *testing.T) {
+		p := NewNoteRevisionPresenter()
+		if err := p.PresentRevision(context.Background(), rev); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Revision()
+		if got == nil || got.Revision != 2 || len(got.Sections) != 1 || got.Sections[0].FieldLabel != "Body" {
+			t.Fatalf("unexpected revision: %+v", got)
+		}
+	})
+
+	t.Run("[Success] diff from empty note", func(t *testing.T) {
+		p := NewNoteRevisionPresenter()
+		diff := note.DiffRevisions(note.Revision{NoteID: "note-1"}, *rev)
+		if err := p.PresentRevisionDiff(context.Background(), &diff); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Diff()
+		if got.StatusFrom != nil {
+			t.Fatalf("statusFrom = %v, want nil", *got.StatusFrom)
+		}
+		if got.From != 0 || got.To != 2 || len(got.Fields) != 1 || got.Fields[0].Lines[0].Op != openapi.ModelsDiffOpInsert {
+			t.Fatalf("unexpected diff: %+v", got)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TagPresenter converts tag usage to OpenAPI responses.
+type TagPresenter struct {
+	tags openapi.ModelsTagListResponse
+}
+
+var _ port.TagOutputPort = (*TagPresenter)(nil)
+
+// NewTagPresenter creates a new TagPresenter.
+func NewTagPresenter() *TagPresenter {
+	return &TagPresenter{}
+}
+
+// PresentTagCounts stores the tag list response.
+func (p *TagPresenter) PresentTagCounts(_ context.Context, counts []note.TagCount) error {
+	items := make([]openapi.ModelsTagCount, 0, len(counts))
+	for _, c := range counts {
+		items = append(items, openapi.ModelsTagCount{
+			Tag:   c.Tag.String(),
+			Count: int32(c.Count), //nolint:gosec
+		})
+	}
+	p.tags = openapi.ModelsTagListResponse{Items: items}
+	return nil
+}
+
+// Tags returns the tag list response.
+func (p *TagPresenter) Tags() openapi.ModelsTagListResponse {
+	return p.tags
+}
@@ -0,0 +1,26 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func TestTagPresenter(t *testing.T) {
+	p := NewTagPresenter()
+	if err := p.PresentTagCounts(context.Background(), []note.TagCount{{Tag: "auth", Count: 3}, {Tag: "api", Count: 1}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := p.Tags()
+	if len(got.Items) != 2 || got.Items[0].Tag != "auth" || got.Items[0].Count != 3 {
+		t.Fatalf("unexpected tags: %+v", got)
+	}
+
+	if err := p.PresentTagCounts(context.Background(), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.Tags(); got.Items == nil || len(got.Items) != 0 {
+		t.Fatalf("empty tags must be an empty list: %+v", got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/template"
 	"immortal-architecture-clean/backend/internal/port"
 )
@@ -12,7 +13,7 @@ import (
 // TemplatePresenter converts template domain models to OpenAPI responses.
 type TemplatePresenter struct {
 	template *openapi.ModelsTemplateResponse
-	list     []openapi.ModelsTemplateResponse
+	list     openapi.ModelsTemplateListResponse
 	deleted  bool
 }
 
@@ -23,13 +24,17 @@ func NewTemplatePresenter() *TemplatePresenter {
 	return &TemplatePresenter{}
 }
 
-// PresentTemplateList stores template list response.
-func (p *TemplatePresenter) PresentTemplateList(_ context.Context, templates []template.WithUsage) error {
-	res := make([]openapi.ModelsTemplateResponse, 0, len(templates))
-	for _, t := range templates {
+// PresentTemplateList stores a page of the template list response.
+func (p *TemplatePresenter) PresentTemplateList(_ context.Context, templates page.Page[template.WithUsage]) error {
+	res := make([]openapi.ModelsTemplateResponse, 0, len(templates.Items))
+	for _, t := range templates.Items {
 		res = append(res, toTemplateResponse(t))
 	}
-	p.list = res
+	p.list = openapi.ModelsTemplateListResponse{
+		Items:      res,
+		NextCursor: encodeCursor(templates.NextCursor),
+		HasMore:    templates.HasMore(),
+	}
 	return nil
 }
 
@@ -52,7 +57,7 @@ func (p *TemplatePresenter) Template() *openapi.ModelsTemplateResponse {
 }
 
 // Templates returns the template list response.
-func (p *TemplatePresenter) Templates() []openapi.ModelsTemplateResponse {
+func (p *TemplatePresenter) Templates() openapi.ModelsTemplateListResponse {
 	return p.list
 }
 
@@ -69,6 +74,8 @@ func toTemplateResponse(t template.WithUsage) openapi.ModelsTemplateResponse {
 			Label:      f.Label,
 			Order:      int32(f.Order), //nolint:gosec
 			IsRequired: f.IsRequired,
+			Type:       openapi.ModelsFieldType(f.Type),
+			Options:    fieldOptions(f.Options),
 		})
 	}
 	return openapi.ModelsTemplateResponse{
@@ -81,8 +88,19 @@ func toTemplateResponse(t template.WithUsage) openapi.ModelsTemplateResponse {
 			LastName:  t.Owner.LastName,
 			Thumbnail: t.Owner.Thumbnail,
 		},
-		Fields:    fields,
-		IsUsed:    t.IsUsed,
-		UpdatedAt: t.Template.UpdatedAt,
+		Fields:        fields,
+		IsUsed:        t.InUse(),
+		UpdatedAt:     t.Template.UpdatedAt,
+		DeletedAt:     t.Template.DeletedAt,
+		Version:       int32(t.Template.Version),       //nolint:gosec
+		FieldsVersion: int32(t.Template.FieldsVersion), //nolint:gosec
+	}
+}
+
+// fieldOptions keeps options non-nil so they are rendered as an empty array rather than null.
+func fieldOptions(options []string) []string {
+	if options == nil {
+		return []string{}
 	}
+	return options
 }
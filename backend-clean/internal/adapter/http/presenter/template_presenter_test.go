@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/template"
 )
 
@@ -14,7 +15,7 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 		name       string
 		action     string
 		single     *template.WithUsage
-		list       []template.WithUsage
+		list       page.Page[template.WithUsage]
 		wantID     string
 		wantOwner  string
 		wantCount  int
@@ -25,10 +26,10 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 			action: "single",
 			single: &template.WithUsage{
 				Template: template.Template{
-					ID:      "tpl-1",
-					Name:    "Template",
-					OwnerID: "owner-1",
-					Fields:  []template.Field{{ID: "f1", Label: "Title", Order: 2, IsRequired: true}},
+					ID:        "tpl-1",
+					Name:      "Template",
+					OwnerID:   "owner-1",
+					Fields:    []template.Field{{ID: "f1", Label: "Title", Order: 2, IsRequired: true, Type: template.FieldText}},
 					UpdatedAt: now,
 				},
 				Owner:  template.Owner{ID: "owner-1", FirstName: "Taro", LastName: "Yamada"},
@@ -38,10 +39,27 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 			wantOwner:  "owner-1",
 			expectUsed: true,
 		},
+		{
+			name:   "[Success] used only by trashed notes",
+			action: "single",
+			single: &template.WithUsage{
+				Template: template.Template{
+					ID:        "tpl-1",
+					OwnerID:   "owner-1",
+					Fields:    []template.Field{{ID: "f1", Label: "Title", Order: 2, IsRequired: true, Type: template.FieldText}},
+					UpdatedAt: now,
+					DeletedAt: &now,
+				},
+				IsUsedInTrash: true,
+			},
+			wantID:     "tpl-1",
+			wantOwner:  "owner-1",
+			expectUsed: true,
+		},
 		{
 			name:      "[Success] list",
 			action:    "list",
-			list:      []template.WithUsage{{Template: template.Template{ID: "tpl-1"}}, {Template: template.Template{ID: "tpl-2"}}},
+			list:      page.Page[template.WithUsage]{Items: []template.WithUsage{{Template: template.Template{ID: "tpl-1"}}, {Template: template.Template{ID: "tpl-2"}}}},
 			wantCount: 2,
 		},
 	}
@@ -60,7 +78,7 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 				if resp == nil || resp.Id != tt.wantID || resp.OwnerId != tt.wantOwner {
 					t.Fatalf("unexpected response: %+v", resp)
 				}
-				if len(resp.Fields) != 1 || resp.Fields[0].Order != 2 {
+				if len(resp.Fields) != 1 || resp.Fields[0].Order != 2 || resp.Fields[0].Type != "text" || resp.Fields[0].Options == nil {
 					t.Fatalf("fields not converted correctly: %+v", resp.Fields)
 				}
 				if resp.IsUsed != tt.expectUsed {
@@ -69,13 +87,19 @@ func TestTemplatePresenter_TableDriven(t *testing.T) {
 				if resp.UpdatedAt.IsZero() {
 					t.Fatalf("UpdatedAt not set")
 				}
+				if (resp.DeletedAt != nil) != (tt.single.Template.DeletedAt != nil) {
+					t.Fatalf("DeletedAt mismatch: %v", resp.DeletedAt)
+				}
 			case "list":
 				err = p.PresentTemplateList(context.Background(), tt.list)
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
 				}
-				if len(p.Templates()) != tt.wantCount {
-					t.Fatalf("want %d templates, got %d", tt.wantCount, len(p.Templates()))
+				if len(p.Templates().Items) != tt.wantCount {
+					t.Fatalf("want %d templates, got %d", tt.wantCount, len(p.Templates().Items))
+				}
+				if p.Templates().HasMore != tt.list.HasMore() || (p.Templates().NextCursor != nil) != tt.list.HasMore() {
+					t.Fatalf("unexpected paging: %+v", p.Templates())
 				}
 			}
 		})
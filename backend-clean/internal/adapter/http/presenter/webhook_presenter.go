@@ -0,0 +1,156 @@
+package presenter
+
+import (
+	"context"
+	"encoding/json"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookPresenter converts webhooks and their delivery log to OpenAPI responses.
+type WebhookPresenter struct {
+	webhook    *openapi.ModelsWebhookResponse
+	webhooks   []openapi.ModelsWebhookResponse
+	delivery   *openapi.ModelsWebhookDelivery
+	deliveries openapi.ModelsWebhookDeliveryListResponse
+	deletedOK  bool
+}
+
+var _ port.WebhookOutputPort = (*WebhookPresenter)(nil)
+
+// NewWebhookPresenter creates a new WebhookPresenter.
+func NewWebhookPresenter() *WebhookPresenter {
+	return &WebhookPresenter{}
+}
+
+// PresentWebhookList stores webhook list response.
+func (p *WebhookPresenter) PresentWebhookList(_ context.Context, webhooks []webhook.Webhook) error {
+	res := make([]openapi.ModelsWebhookResponse, 0, len(webhooks))
+	for _, w := range webhooks {
+		res = append(res, toWebhookResponse(&w))
+	}
+	p.webhooks = res
+	return nil
+}
+
+// PresentWebhook stores single webhook response without its secret.
+func (p *WebhookPresenter) PresentWebhook(_ context.Context, w *webhook.Webhook) error {
+	res := toWebhookResponse(w)
+	p.webhook = &res
+	return nil
+}
+
+// PresentWebhookCreated stores the created webhook response including its secret.
+func (p *WebhookPresenter) PresentWebhookCreated(_ context.Context, w *webhook.Webhook) error {
+	res := toWebhookResponse(w)
+	secret := w.Secret
+	res.Secret = &secret
+	p.webhook = &res
+	return nil
+}
+
+// PresentWebhookDeleted marks delete success.
+func (p *WebhookPresenter) PresentWebhookDeleted(_ context.Context) error {
+	p.deletedOK = true
+	return nil
+}
+
+// PresentDeliveryList stores a page of the delivery log response.
+func (p *WebhookPresenter) PresentDeliveryList(_ context.Context, deliveries page.Page[webhook.Delivery]) error {
+	res := make([]openapi.ModelsWebhookDelivery, 0, len(deliveries.Items))
+	for _, d := range deliveries.Items {
+		item, err := toWebhookDelivery(&d)
+		if err != nil {
+			return err
+		}
+		res = append(res, item)
+	}
+	p.deliveries = openapi.ModelsWebhookDeliveryListResponse{
+		Items:      res,
+		NextCursor: encodeCursor(deliveries.NextCursor),
+		HasMore:    deliveries.HasMore(),
+	}
+	return nil
+}
+
+// PresentDelivery stores single delivery response.
+func (p *WebhookPresenter) PresentDelivery(_ context.Context, d *webhook.Delivery) error {
+	res, err := toWebhookDelivery(d)
+	if err != nil {
+		return err
+	}
+	p.delivery = &res
+	return nil
+}
+
+// Webhook returns the last webhook response.
+func (p *WebhookPresenter) Webhook() *openapi.ModelsWebhookResponse {
+	return p.webhook
+}
+
+// Webhooks returns the webhook list response.
+func (p *WebhookPresenter) Webhooks() openapi.ModelsWebhookListResponse {
+	return openapi.ModelsWebhookListResponse{Items: p.webhooks}
+}
+
+// Delivery returns the last delivery response.
+func (p *WebhookPresenter) Delivery() *openapi.ModelsWebhookDelivery {
+	return p.delivery
+}
+
+// Deliveries returns the delivery log response.
+func (p *WebhookPresenter) Deliveries() openapi.ModelsWebhookDeliveryListResponse {
+	return p.deliveries
+}
+
+// DeleteResponse returns deletion success response.
+func (p *WebhookPresenter) DeleteResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.deletedOK}
+}
+
+func toWebhookResponse(w *webhook.Webhook) openapi.ModelsWebhookResponse {
+	events := make([]openapi.ModelsWebhookEventType, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, openapi.ModelsWebhookEventType(e))
+	}
+	return openapi.ModelsWebhookResponse{
+		Id:        w.ID,
+		Url:       w.URL,
+		Events:    events,
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func toWebhookDelivery(d *webhook.Delivery) (openapi.ModelsWebhookDelivery, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(d.Payload, &payload); err != nil {
+		return openapi.ModelsWebhookDelivery{}, err
+	}
+	res := openapi.ModelsWebhookDelivery{
+		Id:             d.ID,
+		WebhookId:      d.WebhookID,
+		EventId:        d.EventID,
+		EventType:      openapi.ModelsWebhookEventType(d.EventType),
+		Payload:        payload,
+		Status:         openapi.ModelsWebhookDeliveryStatus(d.Status),
+		Attempts:       int32(d.Attempts),       //nolint:gosec
+		LastStatusCode: int32(d.LastStatusCode), //nolint:gosec
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+	if d.Status == webhook.DeliveryPending {
+		next := d.NextAttemptAt
+		res.NextAttemptAt = &next
+	}
+	if d.RedeliveryOf != "" {
+		of := d.RedeliveryOf
+		res.RedeliveryOf = &of
+	}
+	return res, nil
+}
@@ -0,0 +1,91 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+func TestWebhookPresenter(t *testing.T) {
+	w := webhook.Webhook{ID: "wh-1", URL: "https://example.com/hook", Secret: "whsec_x", Events: []webhook.EventType{webhook.EventNoteUpdated}, Active: true}
+	now := time.Now()
+	d := webhook.Delivery{
+		ID: "d-1", WebhookID: "wh-1", EventID: "e-1", EventType: webhook.EventNoteUpdated,
+		Payload: []byte(`{"id":"e-1","data":{"title":"A"}}`), Status: webhook.DeliveryPending, Attempts: 1, NextAttemptAt: now, CreatedAt: now,
+	}
+
+	t.Run("[Success] list hides secrets", func(t *testing.T) {
+		p := NewWebhookPresenter()
+		if err := p.PresentWebhookList(context.Background(), []webhook.Webhook{w}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Webhooks()
+		if len(got.Items) != 1 || got.Items[0].Secret != nil || got.Items[0].Events[0] != openapi.ModelsWebhookEventTypeNoteUpdated {
+			t.Fatalf("unexpected webhooks: %+v", got)
+		}
+	})
+
+	t.Run("[Success] created includes secret", func(t *testing.T) {
+		p := NewWebhookPresenter()
+		if err := p.PresentWebhookCreated(context.Background(), &w); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := p.Webhook(); got == nil || got.Secret == nil || *got.Secret != "whsec_x" {
+			t.Fatalf("unexpected webhook: %+v", got)
+		}
+		if err := p.PresentWebhook(context.Background(), &w); err != nil || p.Webhook().Secret != nil {
+			t.Fatalf("secret must only be presented on create: %+v", p.Webhook())
+		}
+	})
+
+	t.Run("[Success] delivery list", func(t *testing.T) {
+		p := NewWebhookPresenter()
+		next := d.Cursor()
+		if err := p.PresentDeliveryList(context.Background(), page.Page[webhook.Delivery]{Items: []webhook.Delivery{d}, NextCursor: &next}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Deliveries()
+		if len(got.Items) != 1 || !got.HasMore || got.NextCursor == nil || got.Items[0].NextAttemptAt == nil || got.Items[0].RedeliveryOf != nil {
+			t.Fatalf("unexpected deliveries: %+v", got)
+		}
+		if data, ok := got.Items[0].Payload["data"].(map[string]interface{}); !ok || data["title"] != "A" {
+			t.Fatalf("unexpected payload: %+v", got.Items[0].Payload)
+		}
+	})
+
+	t.Run("[Success] redelivery", func(t *testing.T) {
+		p := NewWebhookPresenter()
+		done := d
+		done.Status = webhook.DeliverySucceeded
+		done.DeliveredAt = &now
+		done.RedeliveryOf = "d-0"
+		if err := p.PresentDelivery(context.Background(), &done); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Delivery()
+		if got.NextAttemptAt != nil || got.RedeliveryOf == nil || *got.RedeliveryOf != "d-0" || got.Status != openapi.ModelsWebhookDeliveryStatusSucceeded {
+			t.Fatalf("unexpected delivery: %+v", got)
+		}
+	})
+
+	t.Run("[Fail] malformed payload", func(t *testing.T) {
+		p := NewWebhookPresenter()
+		bad := d
+		bad.Payload = []byte(`not-json`)
+		if err := p.PresentDelivery(context.Background(), &bad); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("[Success] deleted", func(t *testing.T) {
+		p := NewWebhookPresenter()
+		_ = p.PresentWebhookDeleted(context.Background())
+		if !p.DeleteResponse().Success {
+			t.Fatalf("delete flag not set")
+		}
+	})
+}
@@ -0,0 +1,169 @@
+package presenter
+
+import (
+	"context"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspacePresenter converts workspaces, members and invites to OpenAPI responses.
+type WorkspacePresenter struct {
+	workspace  *openapi.ModelsWorkspaceResponse
+	workspaces *openapi.ModelsWorkspaceListResponse
+	member     *openapi.ModelsWorkspaceMemberResponse
+	members    *openapi.ModelsWorkspaceMemberListResponse
+	invite     *openapi.ModelsWorkspaceInviteResponse
+	invites    *openapi.ModelsWorkspaceInviteListResponse
+	deletedOK  bool
+}
+
+var _ port.WorkspaceOutputPort = (*WorkspacePresenter)(nil)
+
+// NewWorkspacePresenter creates a new WorkspacePresenter.
+func NewWorkspacePresenter() *WorkspacePresenter {
+	return &WorkspacePresenter{}
+}
+
+// PresentWorkspaces stores the workspace list response.
+func (p *WorkspacePresenter) PresentWorkspaces(_ context.Context, workspaces []workspace.WithRole) error {
+	items := make([]openapi.ModelsWorkspaceResponse, 0, len(workspaces))
+	for _, w := range workspaces {
+		items = append(items, toWorkspaceResponse(w))
+	}
+	p.workspaces = &openapi.ModelsWorkspaceListResponse{Items: items}
+	return nil
+}
+
+// PresentWorkspace stores single workspace response.
+func (p *WorkspacePresenter) PresentWorkspace(_ context.Context, w *workspace.WithRole) error {
+	resp := toWorkspaceResponse(*w)
+	p.workspace = &resp
+	return nil
+}
+
+// PresentWorkspaceDeleted marks delete success.
+func (p *WorkspacePresenter) PresentWorkspaceDeleted(_ context.Context) error {
+	p.deletedOK = true
+	return nil
+}
+
+// PresentWorkspaceMembers stores the member list response.
+func (p *WorkspacePresenter) PresentWorkspaceMembers(_ context.Context, members []workspace.MemberWithMeta) error {
+	items := make([]openapi.ModelsWorkspaceMemberResponse, 0, len(members))
+	for _, m := range members {
+		items = append(items, toWorkspaceMemberResponse(m))
+	}
+	p.members = &openapi.ModelsWorkspaceMemberListResponse{Items: items}
+	return nil
+}
+
+// PresentWorkspaceMember stores single member response.
+func (p *WorkspacePresenter) PresentWorkspaceMember(_ context.Context, member *workspace.MemberWithMeta) error {
+	resp := toWorkspaceMemberResponse(*member)
+	p.member = &resp
+	return nil
+}
+
+// PresentWorkspaceMemberRemoved marks delete success.
+func (p *WorkspacePresenter) PresentWorkspaceMemberRemoved(_ context.Context) error {
+	p.deletedOK = true
+	return nil
+}
+
+// PresentWorkspaceInvites stores the invite list response.
+func (p *WorkspacePresenter) PresentWorkspaceInvites(_ context.Context, invites []workspace.InviteWithMeta) error {
+	items := make([]openapi.ModelsWorkspaceInviteResponse, 0, len(invites))
+	for _, i := range invites {
+		items = append(items, toWorkspaceInviteResponse(i))
+	}
+	p.invites = &openapi.ModelsWorkspaceInviteListResponse{Items: items}
+	return nil
+}
+
+// PresentWorkspaceInvite stores single invite response.
+func (p *WorkspacePresenter) PresentWorkspaceInvite(_ context.Context, invite *workspace.InviteWithMeta) error {
+	resp := toWorkspaceInviteResponse(*invite)
+	p.invite = &resp
+	return nil
+}
+
+// PresentWorkspaceInviteRevoked marks delete success.
+func (p *WorkspacePresenter) PresentWorkspaceInviteRevoked(_ context.Context) error {
+	p.deletedOK = true
+	return nil
+}
+
+// Workspace returns the last workspace response.
+func (p *WorkspacePresenter) Workspace() *openapi.ModelsWorkspaceResponse {
+	return p.workspace
+}
+
+// Workspaces returns the workspace list response.
+func (p *WorkspacePresenter) Workspaces() *openapi.ModelsWorkspaceListResponse {
+	return p.workspaces
+}
+
+// Member returns the last member response.
+func (p *WorkspacePresenter) Member() *openapi.ModelsWorkspaceMemberResponse {
+	return p.member
+}
+
+// Members returns the member list response.
+func (p *WorkspacePresenter) Members() *openapi.ModelsWorkspaceMemberListResponse {
+	return p.members
+}
+
+// Invite returns the last invite response.
+func (p *WorkspacePresenter) Invite() *openapi.ModelsWorkspaceInviteResponse {
+	return p.invite
+}
+
+// Invites returns the invite list response.
+func (p *WorkspacePresenter) Invites() *openapi.ModelsWorkspaceInviteListResponse {
+	return p.invites
+}
+
+// DeleteResponse returns deletion success response.
+func (p *WorkspacePresenter) DeleteResponse() openapi.ModelsSuccessResponse {
+	return openapi.ModelsSuccessResponse{Success: p.deletedOK}
+}
+
+func toWorkspaceResponse(w workspace.WithRole) openapi.ModelsWorkspaceResponse {
+	return openapi.ModelsWorkspaceResponse{
+		Id:        w.Workspace.ID,
+		Name:      w.Workspace.Name,
+		Role:      openapi.ModelsWorkspaceRole(w.Role),
+		CreatedAt: w.Workspace.CreatedAt,
+		UpdatedAt: w.Workspace.UpdatedAt,
+	}
+}
+
+func toWorkspaceMemberResponse(m workspace.MemberWithMeta) openapi.ModelsWorkspaceMemberResponse {
+	return openapi.ModelsWorkspaceMemberResponse{
+		Account: openapi.ModelsAccountSummary{
+			Id:        m.Member.AccountID,
+			FirstName: m.FirstName,
+			LastName:  m.LastName,
+			Thumbnail: m.Thumbnail,
+		},
+		Email:     m.Email,
+		Role:      openapi.ModelsWorkspaceRole(m.Member.Role),
+		CreatedAt: m.Member.CreatedAt,
+		UpdatedAt: m.Member.UpdatedAt,
+	}
+}
+
+func toWorkspaceInviteResponse(i workspace.InviteWithMeta) openapi.ModelsWorkspaceInviteResponse {
+	return openapi.ModelsWorkspaceInviteResponse{
+		Id:            i.Invite.ID,
+		WorkspaceId:   i.Invite.WorkspaceID,
+		WorkspaceName: i.WorkspaceName,
+		Email:         i.Invite.Email,
+		Role:          openapi.ModelsWorkspaceRole(i.Invite.Role),
+		InvitedBy:     i.Invite.InvitedBy,
+		CreatedAt:     i.Invite.CreatedAt,
+		UpdatedAt:     i.Invite.UpdatedAt,
+	}
+}
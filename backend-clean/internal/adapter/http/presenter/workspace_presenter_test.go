@@ -0,0 +1,83 @@
+package presenter
+
+import (
+	"context"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+)
+
+func TestWorkspacePresenter(t *testing.T) {
+	owned := workspace.WithRole{Workspace: workspace.Workspace{ID: "ws-1", Name: "Design"}, Role: workspace.RoleOwner}
+	joined := workspace.WithRole{Workspace: workspace.Workspace{ID: "ws-2", Name: "Sales"}, Role: workspace.RoleMember}
+
+	t.Run("[Success] list workspaces", func(t *testing.T) {
+		p := NewWorkspacePresenter()
+		if err := p.PresentWorkspaces(context.Background(), []workspace.WithRole{owned, joined}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := p.Workspaces()
+		if got == nil || len(got.Items) != 2 || got.Items[0].Role != "owner" || got.Items[1].Name != "Sales" {
+			t.Fatalf("unexpected workspaces: %+v", got)
+		}
+	})
+
+	t.Run("[Success] empty list", func(t *testing.T) {
+		p := NewWorkspacePresenter()
+		_ = p.PresentWorkspaces(context.Background(), nil)
+		if got := p.Workspaces(); got == nil || got.Items == nil || len(got.Items) != 0 {
+			t.Fatalf("items should be an empty list: %+v", got)
+		}
+	})
+
+	t.Run("[Success] single workspace", func(t *testing.T) {
+		p := NewWorkspacePresenter()
+		_ = p.PresentWorkspace(context.Background(), &owned)
+		if got := p.Workspace(); got == nil || got.Id != "ws-1" || got.Role != "owner" {
+			t.Fatalf("unexpected workspace: %+v", got)
+		}
+	})
+
+	t.Run("[Success] members", func(t *testing.T) {
+		thumb := "https://example.com/t.png"
+		member := workspace.MemberWithMeta{
+			Member:    workspace.Member{WorkspaceID: "ws-1", AccountID: "a1", Role: workspace.RoleAdmin},
+			Email:     "taro@example.com",
+			FirstName: "Taro",
+			Thumbnail: &thumb,
+		}
+		p := NewWorkspacePresenter()
+		_ = p.PresentWorkspaceMembers(context.Background(), []workspace.MemberWithMeta{member})
+		_ = p.PresentWorkspaceMember(context.Background(), &member)
+		if got := p.Members(); got == nil || len(got.Items) != 1 || got.Items[0].Email != "taro@example.com" {
+			t.Fatalf("unexpected members: %+v", got)
+		}
+		if got := p.Member(); got == nil || got.Account.Id != "a1" || got.Account.Thumbnail == nil || got.Role != "admin" {
+			t.Fatalf("unexpected member: %+v", got)
+		}
+	})
+
+	t.Run("[Success] invites", func(t *testing.T) {
+		invite := workspace.InviteWithMeta{
+			Invite:        workspace.Invite{ID: "inv-1", WorkspaceID: "ws-1", Email: "new@example.com", Role: workspace.RoleMember, InvitedBy: "a1"},
+			WorkspaceName: "Design",
+		}
+		p := NewWorkspacePresenter()
+		_ = p.PresentWorkspaceInvites(context.Background(), []workspace.InviteWithMeta{invite})
+		_ = p.PresentWorkspaceInvite(context.Background(), &invite)
+		if got := p.Invites(); got == nil || len(got.Items) != 1 || got.Items[0].WorkspaceName != "Design" {
+			t.Fatalf("unexpected invites: %+v", got)
+		}
+		if got := p.Invite(); got == nil || got.Id != "inv-1" || got.InvitedBy != "a1" || got.Role != "member" {
+			t.Fatalf("unexpected invite: %+v", got)
+		}
+	})
+
+	t.Run("[Success] delete", func(t *testing.T) {
+		p := NewWorkspacePresenter()
+		_ = p.PresentWorkspaceMemberRemoved(context.Background())
+		if !p.DeleteResponse().Success {
+			t.Fatalf("expected success")
+		}
+	})
+}
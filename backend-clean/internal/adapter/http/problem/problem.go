@@ -0,0 +1,145 @@
+// Package problem writes HTTP error responses as RFC 9457 problem details
+// (application/problem+json).
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ContentType is the media type of problem responses.
+const ContentType = "application/problem+json"
+
+// Codes of problems that do not come from the domain error catalog.
+const (
+	CodeBadRequest         = "BAD_REQUEST"
+	CodeUnauthorized       = "UNAUTHORIZED"
+	CodeTooManyRequests    = "TOO_MANY_REQUESTS"
+	CodeInternal           = "INTERNAL_ERROR"
+	CodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+)
+
+// typeBlank is the problem type whose meaning is given by the status code alone.
+const typeBlank = "about:blank"
+
+// internalDetail replaces the message of unexpected errors so that they are not leaked.
+const internalDetail = "internal server error"
+
+// Status returns the HTTP status of a domain error kind.
+func Status(kind domainerr.Kind) int {
+	switch kind {
+	case domainerr.KindInvalid:
+		return http.StatusBadRequest
+	case domainerr.KindUnauthenticated:
+		return http.StatusUnauthorized
+	case domainerr.KindForbidden:
+		return http.StatusForbidden
+	case domainerr.KindNotFound:
+		return http.StatusNotFound
+	case domainerr.KindAlreadyExists, domainerr.KindConflict, domainerr.KindFailedPrecondition:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// JSON writes a problem with the given status, code and detail.
+func JSON(c echo.Context, status int, code, detail string) error {
+	return write(c, newProblem(c, status, code, detail))
+}
+
+// Error writes err as a problem. Domain errors keep their code and message and
+// point at the request field they are about; any other error is logged and
+// becomes a 500 whose detail does not reveal it.
+func Error(c echo.Context, err error) error {
+	e, ok := domainerr.Lookup(err)
+	if !ok {
+		c.Logger().Error(err)
+		return JSON(c, http.StatusInternalServerError, CodeInternal, internalDetail)
+	}
+	p := newProblem(c, Status(e.Kind), e.Code, err.Error())
+	if e.Field != "" {
+		p.Errors = &[]openapi.ModelsProblemFieldError{{Pointer: "/" + e.Field, Detail: err.Error()}}
+	}
+	return write(c, p)
+}
+
+// HTTPErrorHandler is an echo.HTTPErrorHandler that writes errors no handler
+// turned into a response, such as unknown routes and malformed parameters, as problems.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		status := he.Code
+		detail := internalDetail
+		if status < http.StatusInternalServerError {
+			detail = message(he)
+		} else {
+			c.Logger().Error(err)
+		}
+		err = JSON(c, status, statusCode(status), detail)
+	} else {
+		err = Error(c, err)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+func newProblem(c echo.Context, status int, code, detail string) openapi.ModelsProblem {
+	p := openapi.ModelsProblem{
+		Type:   typeBlank,
+		Title:  http.StatusText(status),
+		Status: int32(status),
+		Detail: detail,
+		Code:   code,
+	}
+	if id := port.RequestIDFromContext(c.Request().Context()); id != "" {
+		p.Instance = &id
+	}
+	return p
+}
+
+func write(c echo.Context, p openapi.ModelsProblem) error {
+	if c.Request().Method == http.MethodHead {
+		return c.NoContent(int(p.Status))
+	}
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return c.Blob(int(p.Status), ContentType, body)
+}
+
+// statusCode derives a code from the status text, e.g. 405 is METHOD_NOT_ALLOWED.
+func statusCode(status int) string {
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+	text := http.StatusText(status)
+	if text == "" {
+		return CodeBadRequest
+	}
+	return strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_").Replace(text))
+}
+
+func message(he *echo.HTTPError) string {
+	if m, ok := he.Message.(string); ok {
+		return m
+	}
+	if he.Message == nil {
+		return http.StatusText(he.Code)
+	}
+	return fmt.Sprint(he.Message)
+}
@@ -0,0 +1,133 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+func newContext(method, requestID string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	e.Logger.SetOutput(io.Discard)
+	req := httptest.NewRequest(method, "/api/notes", nil)
+	if requestID != "" {
+		req = req.WithContext(port.WithRequestID(req.Context(), requestID))
+	}
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder) openapi.ModelsProblem {
+	t.Helper()
+	if got := rec.Header().Get(echo.HeaderContentType); got != ContentType {
+		t.Fatalf("content type = %q, want %q", got, ContentType)
+	}
+	var p openapi.ModelsProblem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatalf("decode body %q: %v", rec.Body.String(), err)
+	}
+	if p.Status != int32(rec.Code) {
+		t.Errorf("status member = %d, want %d", p.Status, rec.Code)
+	}
+	if p.Type != "about:blank" || p.Title != http.StatusText(rec.Code) {
+		t.Errorf("type/title = %q/%q", p.Type, p.Title)
+	}
+	return p
+}
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantCode    string
+		wantDetail  string
+		wantPointer string
+	}{
+		{name: "[Success] invalid input points at the field", err: domainerr.ErrTitleRequired, wantStatus: http.StatusBadRequest, wantCode: "TITLE_REQUIRED", wantDetail: "title is required", wantPointer: "/title"},
+		{name: "[Success] wrapped error keeps its detail", err: fmt.Errorf("%w: %q", domainerr.ErrMissingHeading, "Memo"), wantStatus: http.StatusBadRequest, wantCode: "MISSING_HEADING", wantDetail: `required field heading is missing: "Memo"`},
+		{name: "[Success] not found", err: domainerr.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: "NOT_FOUND", wantDetail: "not found"},
+		{name: "[Success] unauthenticated", err: domainerr.ErrUnauthenticated, wantStatus: http.StatusUnauthorized, wantCode: "UNAUTHORIZED", wantDetail: "unauthenticated"},
+		{name: "[Success] forbidden", err: domainerr.ErrUnauthorized, wantStatus: http.StatusForbidden, wantCode: "FORBIDDEN", wantDetail: "unauthorized"},
+		{name: "[Success] version conflict", err: domainerr.ErrConflict, wantStatus: http.StatusConflict, wantCode: "CONFLICT", wantDetail: domainerr.ErrConflict.Error()},
+		{name: "[Success] failed precondition", err: domainerr.ErrTemplateUsedByTrashedNotes, wantStatus: http.StatusConflict, wantCode: "TEMPLATE_IN_USE", wantDetail: domainerr.ErrTemplateUsedByTrashedNotes.Error()},
+		{name: "[Success] already exists", err: domainerr.ErrAlreadyWorkspaceMember, wantStatus: http.StatusConflict, wantCode: "ALREADY_WORKSPACE_MEMBER", wantDetail: domainerr.ErrAlreadyWorkspaceMember.Error(), wantPointer: "/email"},
+		{name: "[Fail] unexpected error is not leaked", err: fmt.Errorf("query notes: %w", context.DeadlineExceeded), wantStatus: http.StatusInternalServerError, wantCode: "INTERNAL_ERROR", wantDetail: "internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := newContext(http.MethodPost, "req-1")
+			if err := Error(c, tt.err); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			p := decode(t, rec)
+			if p.Code != tt.wantCode || p.Detail != tt.wantDetail {
+				t.Errorf("code/detail = %q/%q, want %q/%q", p.Code, p.Detail, tt.wantCode, tt.wantDetail)
+			}
+			if p.Instance == nil || *p.Instance != "req-1" {
+				t.Errorf("instance = %v, want req-1", p.Instance)
+			}
+			switch {
+			case tt.wantPointer == "" && p.Errors != nil:
+				t.Errorf("errors = %+v, want none", *p.Errors)
+			case tt.wantPointer != "" && (p.Errors == nil || len(*p.Errors) != 1 || (*p.Errors)[0].Pointer != tt.wantPointer):
+				t.Errorf("errors = %+v, want pointer %q", p.Errors, tt.wantPointer)
+			}
+		})
+	}
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantDetail string
+	}{
+		{name: "[Success] unknown route", method: http.MethodGet, err: echo.ErrNotFound, wantStatus: http.StatusNotFound, wantCode: "NOT_FOUND", wantDetail: "Not Found"},
+		{name: "[Success] method not allowed", method: http.MethodPost, err: echo.ErrMethodNotAllowed, wantStatus: http.StatusMethodNotAllowed, wantCode: "METHOD_NOT_ALLOWED", wantDetail: "Method Not Allowed"},
+		{name: "[Success] malformed parameter", method: http.MethodGet, err: echo.NewHTTPError(http.StatusBadRequest, "Invalid format for parameter limit"), wantStatus: http.StatusBadRequest, wantCode: "BAD_REQUEST", wantDetail: "Invalid format for parameter limit"},
+		{name: "[Success] domain error", method: http.MethodPost, err: domainerr.ErrInvalidTag, wantStatus: http.StatusBadRequest, wantCode: "INVALID_TAG", wantDetail: domainerr.ErrInvalidTag.Error()},
+		{name: "[Fail] server error detail is hidden", method: http.MethodGet, err: echo.NewHTTPError(http.StatusServiceUnavailable, "pool exhausted"), wantStatus: http.StatusServiceUnavailable, wantCode: "INTERNAL_ERROR", wantDetail: "internal server error"},
+		{name: "[Fail] HEAD gets no body", method: http.MethodHead, err: echo.ErrNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := newContext(tt.method, "")
+			HTTPErrorHandler(tt.err, c)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantCode == "" {
+				if rec.Body.Len() != 0 {
+					t.Errorf("body = %q, want empty", rec.Body.String())
+				}
+				return
+			}
+			p := decode(t, rec)
+			if p.Code != tt.wantCode || p.Detail != tt.wantDetail {
+				t.Errorf("code/detail = %q/%q, want %q/%q", p.Code, p.Detail, tt.wantCode, tt.wantDetail)
+			}
+			if p.Instance != nil {
+				t.Errorf("instance = %q, want none outside a request ID", *p.Instance)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Package subscriber contains event subscribers the outbox relay delivers domain events to.
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// LogSubscriber writes every event as one JSON line.
+type LogSubscriber struct {
+	w io.Writer
+}
+
+var _ port.EventSubscriber = (*LogSubscriber)(nil)
+
+// NewLogSubscriber creates a LogSubscriber writing to w.
+func NewLogSubscriber(w io.Writer) *LogSubscriber {
+	return &LogSubscriber{w: w}
+}
+
+type logLine struct {
+	ID            string         `json:"id"`
+	Type          string         `json:"type"`
+	AggregateType string         `json:"aggregateType"`
+	AggregateID   string         `json:"aggregateId"`
+	ActorID       string         `json:"actorId"`
+	Payload       map[string]any `json:"payload"`
+	OccurredAt    time.Time      `json:"occurredAt"`
+}
+
+// Handle writes the event.
+func (s *LogSubscriber) Handle(_ context.Context, e event.Event) error {
+	return json.NewEncoder(s.w).Encode(logLine{
+		ID:            e.ID,
+		Type:          string(e.Type),
+		AggregateType: string(e.AggregateType),
+		AggregateID:   e.AggregateID,
+		ActorID:       e.ActorID,
+		Payload:       e.Payload,
+		OccurredAt:    e.OccurredAt,
+	})
+}
@@ -0,0 +1,25 @@
+package subscriber
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+func TestLogSubscriber_Handle(t *testing.T) {
+	var buf bytes.Buffer
+	e := event.Event{ID: "e1", Type: event.NotePublished, AggregateType: event.AggregateNote, AggregateID: "n1", Payload: map[string]any{"title": "t"}}
+	if err := NewLogSubscriber(&buf).Handle(context.Background(), e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json %q: %v", buf.String(), err)
+	}
+	if got["id"] != "e1" || got["type"] != "NotePublished" || got["aggregateId"] != "n1" {
+		t.Fatalf("unexpected line: %v", got)
+	}
+}
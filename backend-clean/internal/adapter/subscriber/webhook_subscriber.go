@@ -0,0 +1,27 @@
+package subscriber
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookSubscriber turns events into webhook deliveries. It only enqueues them,
+// using the relay's transaction, so a slow endpoint never holds up the relay;
+// the webhook worker sends them.
+type WebhookSubscriber struct {
+	dispatcher port.WebhookDispatcher
+}
+
+var _ port.EventSubscriber = (*WebhookSubscriber)(nil)
+
+// NewWebhookSubscriber creates a WebhookSubscriber.
+func NewWebhookSubscriber(dispatcher port.WebhookDispatcher) *WebhookSubscriber {
+	return &WebhookSubscriber{dispatcher: dispatcher}
+}
+
+// Handle enqueues the event for the owner's matching webhooks.
+func (s *WebhookSubscriber) Handle(ctx context.Context, e event.Event) error {
+	return s.dispatcher.Enqueue(ctx, e)
+}
@@ -0,0 +1,44 @@
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+type fakeDispatcher struct {
+	enqueued []event.Event
+	err      error
+}
+
+func (d *fakeDispatcher) Enqueue(_ context.Context, e event.Event) error {
+	d.enqueued = append(d.enqueued, e)
+	return d.err
+}
+
+func (d *fakeDispatcher) DeliverDue(context.Context, int) (int, error) { return 0, nil }
+
+func TestWebhookSubscriber_Handle(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{name: "[Success] enqueue event"},
+		{name: "[Fail] enqueue error", err: errors.New("db error"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &fakeDispatcher{err: tt.err}
+			err := NewWebhookSubscriber(d).Handle(context.Background(), event.Event{ID: "e1", Type: event.NoteDeleted})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if len(d.enqueued) != 1 || d.enqueued[0].ID != "e1" {
+				t.Fatalf("unexpected enqueued events: %+v", d.enqueued)
+			}
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package account
 
 import (
-	"errors"
 	"strings"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
@@ -9,9 +8,9 @@ import (
 
 var (
 	// ErrInvalidEmail indicates invalid email format.
-	ErrInvalidEmail = errors.New("invalid email")
+	ErrInvalidEmail = domainerr.Define("INVALID_EMAIL", domainerr.KindInvalid, "email", "invalid email")
 	// ErrInvalidName indicates both first and last name are empty.
-	ErrInvalidName = errors.New("first or last name is required")
+	ErrInvalidName = domainerr.Define("INVALID_NAME", domainerr.KindInvalid, "name", "first or last name is required")
 )
 
 // Validate checks simple business rules for account.
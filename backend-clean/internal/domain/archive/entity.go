@@ -0,0 +1,73 @@
+// Package archive holds the account archive used to move data between environments and for backups.
+package archive
+
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// FormatVersion is the archive format this build writes and reads.
+const FormatVersion = 1
+
+// Archive is a self-contained copy of an account's templates and notes.
+// IDs are the ones of the source database; an import assigns new ones.
+type Archive struct {
+	FormatVersion int
+	ExportedAt    time.Time
+	Owner         Owner
+	Templates     []Template
+	Notes         []Note
+}
+
+// Owner identifies the exported account.
+type Owner struct {
+	ID        string
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+// Template is an archived template with every field version its notes are pinned to.
+// It also covers templates of other accounts that the exported notes use.
+type Template struct {
+	ID      string
+	Name    string
+	OwnerID string
+	// FieldVersions are in ascending version order; the last one holds the current fields.
+	FieldVersions []FieldVersion
+}
+
+// FieldVersion is the field set of one template version.
+type FieldVersion struct {
+	Version int
+	Fields  []template.Field
+}
+
+// Note is an archived note with its sections and tags.
+type Note struct {
+	ID              string
+	Title           string
+	TemplateID      string
+	TemplateVersion int
+	Status          note.NoteStatus
+	Sections        []Section
+	Tags            []note.Tag
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Section is the content of one template field of an archived note.
+type Section struct {
+	FieldID string
+	Content string
+}
+
+// ImportResult reports what an import created.
+// The maps are keyed by archived IDs and hold the IDs assigned in the target database.
+type ImportResult struct {
+	OwnerID     string
+	TemplateIDs map[string]string
+	NoteIDs     map[string]string
+}
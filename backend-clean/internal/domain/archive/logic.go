@@ -0,0 +1,147 @@
+package archive
+
+import (
+	"fmt"
+	"slices"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// NewNote archives a note with its sections in field order and its tags.
+func NewNote(n note.WithMeta) Note {
+	sections := slices.Clone(n.Sections)
+	slices.SortStableFunc(sections, func(a, b note.SectionWithField) int { return a.FieldOrder - b.FieldOrder })
+	archived := make([]Section, 0, len(sections))
+	for _, s := range sections {
+		archived = append(archived, Section{FieldID: s.Section.FieldID, Content: s.Section.Content})
+	}
+	return Note{
+		ID:              n.Note.ID,
+		Title:           n.Note.Title,
+		TemplateID:      n.Note.TemplateID,
+		TemplateVersion: n.Note.TemplateVersion,
+		Status:          n.Note.Status,
+		Sections:        archived,
+		Tags:            slices.Clone(n.Tags),
+		CreatedAt:       n.Note.CreatedAt,
+		UpdatedAt:       n.Note.UpdatedAt,
+	}
+}
+
+// ParseTags validates the archived tags as a note would on creation.
+func (n Note) ParseTags() ([]note.Tag, error) {
+	raws := make([]string, 0, len(n.Tags))
+	for _, t := range n.Tags {
+		raws = append(raws, string(t))
+	}
+	return note.NewTags(raws)
+}
+
+// Fields returns the fields of an archived template version.
+func (t Template) Fields(version int) ([]template.Field, bool) {
+	for _, fv := range t.FieldVersions {
+		if fv.Version == version {
+			return fv.Fields, true
+		}
+	}
+	return nil, false
+}
+
+// NotesPinnedTo returns the notes written against a template version, in archive order.
+func (a Archive) NotesPinnedTo(templateID string, version int) []Note {
+	var notes []Note
+	for _, n := range a.Notes {
+		if n.TemplateID == templateID && n.TemplateVersion == version {
+			notes = append(notes, n)
+		}
+	}
+	return notes
+}
+
+// Validate checks that the archive can be imported as a whole:
+// the format version is supported, IDs are unique, field versions are valid
+// and every note refers to an archived template version and its fields.
+func (a Archive) Validate() error {
+	if a.FormatVersion != FormatVersion {
+		return fmt.Errorf("%w: %d", domainerr.ErrUnsupportedArchiveVersion, a.FormatVersion)
+	}
+	templates := make(map[string]Template, len(a.Templates))
+	for _, t := range a.Templates {
+		if t.ID == "" {
+			return fmt.Errorf("%w: template without id", domainerr.ErrInvalidArchive)
+		}
+		if _, dup := templates[t.ID]; dup {
+			return fmt.Errorf("%w: duplicate template %q", domainerr.ErrInvalidArchive, t.ID)
+		}
+		if err := validateFieldVersions(t); err != nil {
+			return err
+		}
+		templates[t.ID] = t
+	}
+
+	notes := make(map[string]struct{}, len(a.Notes))
+	for _, n := range a.Notes {
+		if n.ID == "" {
+			return fmt.Errorf("%w: note without id", domainerr.ErrInvalidArchive)
+		}
+		if _, dup := notes[n.ID]; dup {
+			return fmt.Errorf("%w: duplicate note %q", domainerr.ErrInvalidArchive, n.ID)
+		}
+		notes[n.ID] = struct{}{}
+		if err := n.Status.Validate(); err != nil {
+			return fmt.Errorf("%w: note %q: %w", domainerr.ErrInvalidArchive, n.ID, err)
+		}
+		fields, ok := templates[n.TemplateID].Fields(n.TemplateVersion)
+		if !ok {
+			return fmt.Errorf("%w: note %q refers to template %q version %d, which is not archived", domainerr.ErrInvalidArchive, n.ID, n.TemplateID, n.TemplateVersion)
+		}
+		for _, s := range n.Sections {
+			if !slices.ContainsFunc(fields, func(f template.Field) bool { return f.ID == s.FieldID }) {
+				return fmt.Errorf("%w: note %q has a section for unknown field %q", domainerr.ErrInvalidArchive, n.ID, s.FieldID)
+			}
+		}
+		if _, err := n.ParseTags(); err != nil {
+			return fmt.Errorf("%w: note %q: %w", domainerr.ErrInvalidArchive, n.ID, err)
+		}
+	}
+	return nil
+}
+
+func validateFieldVersions(t Template) error {
+	prev := 0
+	for _, fv := range t.FieldVersions {
+		if fv.Version <= prev {
+			return fmt.Errorf("%w: field versions of template %q must be positive and ascending", domainerr.ErrInvalidArchive, t.ID)
+		}
+		prev = fv.Version
+		ids := make(map[string]struct{}, len(fv.Fields))
+		for _, f := range fv.Fields {
+			if _, dup := ids[f.ID]; dup || f.ID == "" {
+				return fmt.Errorf("%w: template %q version %d has a missing or duplicate field id", domainerr.ErrInvalidArchive, t.ID, fv.Version)
+			}
+			ids[f.ID] = struct{}{}
+		}
+		if _, err := template.NormalizeAndValidate(slices.Clone(fv.Fields)); err != nil {
+			return fmt.Errorf("%w: template %q version %d: %w", domainerr.ErrInvalidArchive, t.ID, fv.Version, err)
+		}
+	}
+	return nil
+}
+
+// MapFieldIDs pairs archived fields with the fields created from them by field order
+// and returns the created field ID for each archived field ID.
+func MapFieldIDs(archived, created []template.Field) (map[string]string, error) {
+	if len(archived) != len(created) {
+		return nil, fmt.Errorf("%w: created %d fields for %d archived fields", domainerr.ErrInvalidArchive, len(created), len(archived))
+	}
+	byOrder := func(a, b template.Field) int { return a.Order - b.Order }
+	from := slices.SortedStableFunc(slices.Values(archived), byOrder)
+	to := slices.SortedStableFunc(slices.Values(created), byOrder)
+	ids := make(map[string]string, len(from))
+	for i := range from {
+		ids[from[i].ID] = to[i].ID
+	}
+	return ids, nil
+}
@@ -0,0 +1,92 @@
+package archive
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func validArchive() Archive {
+	return Archive{
+		FormatVersion: FormatVersion,
+		Templates: []Template{{
+			ID:   "t1",
+			Name: "ADR",
+			FieldVersions: []FieldVersion{
+				{Version: 1, Fields: []template.Field{{ID: "f1", Label: "A", Order: 1, Type: template.FieldText}}},
+				{Version: 3, Fields: []template.Field{{ID: "f2", Label: "B", Order: 1, Type: template.FieldText}}},
+			},
+		}},
+		Notes: []Note{
+			{ID: "n1", Title: "a", TemplateID: "t1", TemplateVersion: 1, Status: note.StatusDraft, Sections: []Section{{FieldID: "f1"}}},
+			{ID: "n2", Title: "b", TemplateID: "t1", TemplateVersion: 3, Status: note.StatusPublish, Sections: []Section{{FieldID: "f2"}}},
+		},
+	}
+}
+
+func TestArchive_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(a *Archive)
+		wantErr error
+	}{
+		{name: "[Success] valid archive", mutate: func(*Archive) {}},
+		{name: "[Fail] unsupported format version", mutate: func(a *Archive) { a.FormatVersion = 2 }, wantErr: domainerr.ErrUnsupportedArchiveVersion},
+		{name: "[Fail] duplicate template", mutate: func(a *Archive) { a.Templates = append(a.Templates, a.Templates[0]) }, wantErr: domainerr.ErrInvalidArchive},
+		{name: "[Fail] versions not ascending", mutate: func(a *Archive) { a.Templates[0].FieldVersions[1].Version = 1 }, wantErr: domainerr.ErrInvalidArchive},
+		{name: "[Fail] invalid fields", mutate: func(a *Archive) { a.Templates[0].FieldVersions[0].Fields[0].Label = "" }, wantErr: domainerr.ErrFieldLabelRequired},
+		{name: "[Fail] duplicate note", mutate: func(a *Archive) { a.Notes[1].ID = "n1" }, wantErr: domainerr.ErrInvalidArchive},
+		{name: "[Fail] invalid status", mutate: func(a *Archive) { a.Notes[0].Status = "Archived" }, wantErr: domainerr.ErrInvalidStatus},
+		{name: "[Fail] unknown template version", mutate: func(a *Archive) { a.Notes[0].TemplateVersion = 2 }, wantErr: domainerr.ErrInvalidArchive},
+		{name: "[Fail] unknown template", mutate: func(a *Archive) { a.Notes[0].TemplateID = "t9" }, wantErr: domainerr.ErrInvalidArchive},
+		{name: "[Fail] section of another version", mutate: func(a *Archive) { a.Notes[0].Sections[0].FieldID = "f2" }, wantErr: domainerr.ErrInvalidArchive},
+		{name: "[Fail] invalid tag", mutate: func(a *Archive) { a.Notes[0].Tags = []note.Tag{"a,b"} }, wantErr: domainerr.ErrInvalidArchive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := validArchive()
+			tt.mutate(&a)
+			err := a.Validate()
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestArchive_NotesPinnedTo(t *testing.T) {
+	a := validArchive()
+	got := a.NotesPinnedTo("t1", 3)
+	if len(got) != 1 || got[0].ID != "n2" {
+		t.Fatalf("unexpected notes: %+v", got)
+	}
+	if got := a.NotesPinnedTo("t1", 2); len(got) != 0 {
+		t.Fatalf("expected no notes, got %+v", got)
+	}
+}
+
+func TestMapFieldIDs(t *testing.T) {
+	archived := []template.Field{{ID: "old-2", Order: 2}, {ID: "old-1", Order: 1}}
+	created := []template.Field{{ID: "new-1", Order: 1}, {ID: "new-2", Order: 2}}
+
+	got, err := MapFieldIDs(archived, created)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"old-1": "new-1", "old-2": "new-2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, err := MapFieldIDs(archived, created[:1]); !errors.Is(err, domainerr.ErrInvalidArchive) {
+		t.Fatalf("want ErrInvalidArchive, got %v", err)
+	}
+}
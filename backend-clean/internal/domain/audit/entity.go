@@ -0,0 +1,81 @@
+// Package audit holds the append-only audit log of changes made to notes,
+// templates and accounts. Entries are hash-chained so edits to stored rows are detectable.
+package audit
+
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/page"
+)
+
+// Action names the change an entry records.
+type Action string
+
+// Action constants.
+const (
+	ActionCreate          Action = "create"
+	ActionUpdate          Action = "update"
+	ActionPublish         Action = "publish"
+	ActionUnpublish       Action = "unpublish"
+	ActionMigrateTemplate Action = "migrate-template"
+	ActionDelete          Action = "delete"
+	ActionRestore         Action = "restore"
+	ActionPurge           Action = "purge"
+	ActionSignIn          Action = "sign-in"
+)
+
+// AggregateType names the kind of aggregate an entry belongs to.
+type AggregateType string
+
+// Aggregate type constants.
+const (
+	AggregateNote     AggregateType = "note"
+	AggregateTemplate AggregateType = "template"
+	AggregateAccount  AggregateType = "account"
+)
+
+// Entry is one recorded change. Before is empty for a creation and After for a purge.
+// Sequence, OccurredAt, PrevHash and Hash are assigned when the entry is appended.
+type Entry struct {
+	Sequence      int64
+	ActorID       string
+	Action        Action
+	AggregateType AggregateType
+	AggregateID   string
+	// Before and After are JSON snapshots of the aggregate around the change.
+	Before    []byte
+	After     []byte
+	RequestID string
+	// OccurredAt is kept at microsecond precision, the precision it is stored with.
+	OccurredAt time.Time
+	// PrevHash is the Hash of the entry appended just before; empty for the first entry.
+	PrevHash string
+	Hash     string
+}
+
+// Filters for listing the audit log.
+type Filters struct {
+	ActorID       *string
+	AggregateType *AggregateType
+	AggregateID   *string
+	// From and To bound OccurredAt; From is inclusive and To exclusive.
+	From *time.Time
+	To   *time.Time
+	// Limit caps the number of rows.
+	Limit int
+	// After resumes the list strictly after this position in (sequence DESC) order.
+	After *page.Cursor
+}
+
+// Verification is the outcome of checking the hash chain.
+type Verification struct {
+	// Checked is the number of entries checked.
+	Checked int
+	// BrokenAt is the sequence of the first entry that does not fit the chain; 0 when the chain is intact.
+	BrokenAt int64
+}
+
+// Valid reports whether every checked entry fits the chain.
+func (v Verification) Valid() bool {
+	return v.BrokenAt == 0
+}
@@ -0,0 +1,222 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// NoteEntry records a change of a note by actorID. before is nil for a creation
+// and after is nil for a purge. actorID is empty when the retention purge made the change.
+func NoteEntry(action Action, actorID string, before, after *note.WithMeta) Entry {
+	e := Entry{Action: action, ActorID: actorID, AggregateType: AggregateNote}
+	if before != nil {
+		e.AggregateID = before.Note.ID
+		e.Before = snapshot(noteState(*before))
+	}
+	if after != nil {
+		e.AggregateID = after.Note.ID
+		e.After = snapshot(noteState(*after))
+	}
+	return e
+}
+
+// TemplateEntry records a change of a template by actorID. before is nil for a creation
+// and after is nil for a purge. actorID is empty when the retention purge made the change.
+func TemplateEntry(action Action, actorID string, before, after *template.Template) Entry {
+	e := Entry{Action: action, ActorID: actorID, AggregateType: AggregateTemplate}
+	if before != nil {
+		e.AggregateID = before.ID
+		e.Before = snapshot(templateState(*before))
+	}
+	if after != nil {
+		e.AggregateID = after.ID
+		e.After = snapshot(templateState(*after))
+	}
+	return e
+}
+
+// AccountEntry records a change of an account made by the account itself.
+// before is nil when the account was created.
+func AccountEntry(action Action, before *account.Account, after account.Account) Entry {
+	e := Entry{
+		Action:        action,
+		ActorID:       after.ID,
+		AggregateType: AggregateAccount,
+		AggregateID:   after.ID,
+		After:         snapshot(accountState(after)),
+	}
+	if before != nil {
+		e.Before = snapshot(accountState(*before))
+	}
+	return e
+}
+
+// Seal links the entry to the chain right after the entry hashed prevHash.
+func (e Entry) Seal(prevHash string, at time.Time) Entry {
+	e.PrevHash = prevHash
+	e.OccurredAt = at.UTC().Truncate(time.Microsecond)
+	e.Hash = e.ComputeHash()
+	return e
+}
+
+// ComputeHash returns the hex SHA-256 of the previous hash and everything the entry records.
+// Sequence is left out: the order of the chain is carried by PrevHash alone.
+func (e Entry) ComputeHash() string {
+	var b strings.Builder
+	for _, part := range []string{
+		e.PrevHash,
+		e.ActorID,
+		string(e.Action),
+		string(e.AggregateType),
+		e.AggregateID,
+		string(e.Before),
+		string(e.After),
+		e.RequestID,
+		e.OccurredAt.UTC().Format(time.RFC3339Nano),
+	} {
+		// Length prefixes keep different splits of the same bytes from hashing alike.
+		b.WriteString(strconv.Itoa(len(part)))
+		b.WriteByte(':')
+		b.WriteString(part)
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// Cursor returns the keyset position of the entry in list order.
+func (e Entry) Cursor() page.Cursor {
+	return page.Cursor{UpdatedAt: e.OccurredAt, ID: strconv.FormatInt(e.Sequence, 10)}
+}
+
+// VerifyChain checks entries given in sequence order, the first of which follows the
+// entry hashed prevHash. It returns the hash to continue from and the sequence of the
+// first entry that was altered, removed after or inserted out of order; 0 when all fit.
+func VerifyChain(prevHash string, entries []Entry) (string, int64) {
+	for _, e := range entries {
+		if e.PrevHash != prevHash || e.Hash != e.ComputeHash() {
+			return prevHash, e.Sequence
+		}
+		prevHash = e.Hash
+	}
+	return prevHash, 0
+}
+
+// CanRead checks that the viewer is one of the administrators allowed to read the audit log.
+func CanRead(viewerID string, admins []string) error {
+	if viewerID == "" || !slices.Contains(admins, viewerID) {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
+
+// Validate checks the aggregate type.
+func (t AggregateType) Validate() error {
+	switch t {
+	case AggregateNote, AggregateTemplate, AggregateAccount:
+		return nil
+	default:
+		return domainerr.ErrInvalidAuditAggregate
+	}
+}
+
+// Validate checks the aggregate type and the time range of the filters.
+func (f Filters) Validate() error {
+	if f.AggregateType != nil {
+		if err := f.AggregateType.Validate(); err != nil {
+			return err
+		}
+	}
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return domainerr.ErrInvalidAuditRange
+	}
+	return nil
+}
+
+func noteState(n note.WithMeta) map[string]any {
+	state := map[string]any{
+		"id":              n.Note.ID,
+		"title":           n.Note.Title,
+		"templateId":      n.Note.TemplateID,
+		"templateVersion": n.Note.TemplateVersion,
+		"ownerId":         n.Note.OwnerID,
+		"status":          string(n.Note.Status),
+		"version":         n.Note.Version,
+		"deletedAt":       n.Note.DeletedAt,
+	}
+	// Lists do not load sections; leave them out rather than record an empty note.
+	if n.Sections != nil {
+		sections := make([]map[string]any, 0, len(n.Sections))
+		for _, s := range n.Sections {
+			sections = append(sections, map[string]any{
+				"fieldId": s.Section.FieldID,
+				"label":   s.FieldLabel,
+				"content": s.Section.Content,
+			})
+		}
+		state["sections"] = sections
+	}
+	if n.Tags != nil {
+		state["tags"] = n.Tags
+	}
+	return state
+}
+
+func templateState(t template.Template) map[string]any {
+	state := map[string]any{
+		"id":            t.ID,
+		"name":          t.Name,
+		"ownerId":       t.OwnerID,
+		"version":       t.Version,
+		"fieldsVersion": t.FieldsVersion,
+		"deletedAt":     t.DeletedAt,
+	}
+	if t.Fields != nil {
+		fields := make([]map[string]any, 0, len(t.Fields))
+		for _, f := range t.Fields {
+			fields = append(fields, map[string]any{
+				"id":         f.ID,
+				"label":      f.Label,
+				"order":      f.Order,
+				"isRequired": f.IsRequired,
+				"type":       string(f.Type),
+				"options":    f.Options,
+			})
+		}
+		state["fields"] = fields
+	}
+	return state
+}
+
+func accountState(a account.Account) map[string]any {
+	return map[string]any{
+		"id":          a.ID,
+		"email":       string(a.Email),
+		"firstName":   a.FirstName,
+		"lastName":    a.LastName,
+		"isActive":    a.IsActive,
+		"provider":    a.Provider,
+		"thumbnail":   a.Thumbnail,
+		"lastLoginAt": a.LastLoginAt,
+	}
+}
+
+// snapshot encodes a state map; map keys are sorted, so equal states encode alike.
+func snapshot(state map[string]any) []byte {
+	b, err := json.Marshal(state)
+	if err != nil {
+		// The state maps hold only strings, numbers, booleans and times.
+		return nil
+	}
+	return b
+}
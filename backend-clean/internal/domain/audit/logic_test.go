@@ -0,0 +1,160 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestNoteEntry(t *testing.T) {
+	before := &note.WithMeta{
+		Note:     note.Note{ID: "n1", Title: "Old", OwnerID: "owner-1", Status: note.StatusDraft, Version: 1},
+		Sections: []note.SectionWithField{{Section: note.Section{FieldID: "f1", Content: "c1"}, FieldLabel: "Problem"}},
+		Tags:     []note.Tag{"auth"},
+	}
+	after := &note.WithMeta{Note: note.Note{ID: "n1", Title: "Old", OwnerID: "owner-1", Status: note.StatusPublish, Version: 2}}
+	tests := []struct {
+		name       string
+		before     *note.WithMeta
+		after      *note.WithMeta
+		wantBefore bool
+		wantAfter  bool
+	}{
+		{name: "[Success] create has no before", after: after, wantAfter: true},
+		{name: "[Success] change has both", before: before, after: after, wantBefore: true, wantAfter: true},
+		{name: "[Success] purge has no after", before: before, wantBefore: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NoteEntry(ActionPublish, "owner-1", tt.before, tt.after)
+			if e.AggregateType != AggregateNote || e.AggregateID != "n1" || e.ActorID != "owner-1" || e.Action != ActionPublish {
+				t.Fatalf("unexpected entry: %+v", e)
+			}
+			if (e.Before != nil) != tt.wantBefore || (e.After != nil) != tt.wantAfter {
+				t.Fatalf("unexpected snapshots: before=%s after=%s", e.Before, e.After)
+			}
+		})
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(NoteEntry(ActionUpdate, "owner-1", before, nil).Before, &state); err != nil {
+		t.Fatalf("snapshot is not JSON: %v", err)
+	}
+	if state["title"] != "Old" || state["status"] != "Draft" || len(state["sections"].([]any)) != 1 || len(state["tags"].([]any)) != 1 {
+		t.Fatalf("unexpected note state: %v", state)
+	}
+	state = nil
+	if err := json.Unmarshal(NoteEntry(ActionUpdate, "owner-1", nil, after).After, &state); err != nil {
+		t.Fatalf("snapshot is not JSON: %v", err)
+	}
+	if _, ok := state["sections"]; ok {
+		t.Fatalf("unloaded sections must be left out: %v", state)
+	}
+}
+
+func TestTemplateEntryAndAccountEntry(t *testing.T) {
+	tpl := &template.Template{ID: "t1", Name: "Design", OwnerID: "owner-1", Fields: []template.Field{{ID: "f1", Label: "Problem", Order: 1, Type: template.FieldText}}}
+	e := TemplateEntry(ActionPurge, "", tpl, nil)
+	if e.AggregateType != AggregateTemplate || e.AggregateID != "t1" || e.ActorID != "" || e.Before == nil || e.After != nil {
+		t.Fatalf("unexpected template entry: %+v", e)
+	}
+
+	acc := account.Account{ID: "acc-1", Email: "a@example.com", FirstName: "Taro"}
+	e = AccountEntry(ActionSignIn, nil, acc)
+	if e.AggregateType != AggregateAccount || e.AggregateID != "acc-1" || e.ActorID != "acc-1" || e.Before != nil || e.After == nil {
+		t.Fatalf("unexpected account entry: %+v", e)
+	}
+}
+
+func TestSealAndVerifyChain(t *testing.T) {
+	at := time.Date(2026, 10, 17, 9, 0, 0, 123456789, time.FixedZone("JST", 9*60*60))
+	first := NoteEntry(ActionCreate, "owner-1", nil, &note.WithMeta{Note: note.Note{ID: "n1"}}).Seal("", at)
+	first.Sequence = 1
+	second := NoteEntry(ActionDelete, "owner-1", &note.WithMeta{Note: note.Note{ID: "n1"}}, nil).Seal(first.Hash, at.Add(time.Second))
+	second.Sequence = 2
+	third := TemplateEntry(ActionCreate, "owner-1", nil, &template.Template{ID: "t1"}).Seal(second.Hash, at.Add(2*time.Second))
+	third.Sequence = 3
+
+	if first.OccurredAt.Location() != time.UTC || first.OccurredAt.Nanosecond() != 123456000 {
+		t.Fatalf("OccurredAt must be UTC at microsecond precision: %v", first.OccurredAt)
+	}
+	if first.Hash == "" || first.Hash == second.Hash {
+		t.Fatalf("unexpected hashes: %q %q", first.Hash, second.Hash)
+	}
+
+	tampered := second
+	tampered.ActorID = "someone-else"
+	reordered := third
+	reordered.PrevHash = first.Hash
+
+	tests := []struct {
+		name     string
+		entries  []Entry
+		wantHash string
+		wantAt   int64
+	}{
+		{name: "[Success] intact chain", entries: []Entry{first, second, third}, wantHash: third.Hash},
+		{name: "[Success] empty chain", wantHash: ""},
+		{name: "[Fail] edited entry", entries: []Entry{first, tampered, third}, wantHash: first.Hash, wantAt: 2},
+		{name: "[Fail] removed entry", entries: []Entry{first, third}, wantHash: first.Hash, wantAt: 3},
+		{name: "[Fail] relinked entry", entries: []Entry{first, reordered}, wantHash: first.Hash, wantAt: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, brokenAt := VerifyChain("", tt.entries)
+			if hash != tt.wantHash || brokenAt != tt.wantAt {
+				t.Fatalf("want (%q, %d), got (%q, %d)", tt.wantHash, tt.wantAt, hash, brokenAt)
+			}
+		})
+	}
+}
+
+func TestCanRead(t *testing.T) {
+	admins := []string{"admin-1"}
+	tests := []struct {
+		name    string
+		viewer  string
+		wantErr error
+	}{
+		{name: "[Success] admin", viewer: "admin-1"},
+		{name: "[Fail] other account", viewer: "owner-1", wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] no viewer", viewer: "", wantErr: domainerr.ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CanRead(tt.viewer, admins); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFilters_Validate(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	noteType := AggregateNote
+	unknown := AggregateType("webhook")
+	tests := []struct {
+		name    string
+		filters Filters
+		wantErr error
+	}{
+		{name: "[Success] no filters"},
+		{name: "[Success] aggregate and range", filters: Filters{AggregateType: &noteType, From: &from, To: &to}},
+		{name: "[Fail] unknown aggregate", filters: Filters{AggregateType: &unknown}, wantErr: domainerr.ErrInvalidAuditAggregate},
+		{name: "[Fail] empty range", filters: Filters{From: &to, To: &from}, wantErr: domainerr.ErrInvalidAuditRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.filters.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Package comment holds review comments on notes. Comments form threads: a top-level
+// comment starts a thread and replies answer it.
+package comment
+
+import "time"
+
+// Comment is a remark on a note, or on one of its sections.
+type Comment struct {
+	ID     string
+	NoteID string
+	// SectionID is the section the comment is about; empty for the whole note.
+	SectionID string
+	// ParentID is the thread root the comment replies to; empty for a thread root.
+	ParentID string
+	AuthorID string
+	Body     string
+	// ResolvedBy and ResolvedAt are set while the thread is resolved. Only roots are resolved.
+	ResolvedBy string
+	ResolvedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// WithMeta represents a comment with its author's profile.
+type WithMeta struct {
+	Comment         Comment
+	AuthorFirstName string
+	AuthorLastName  string
+	AuthorThumbnail *string
+}
+
+// Thread is a top-level comment with its replies, oldest first.
+type Thread struct {
+	Root    WithMeta
+	Replies []WithMeta
+}
@@ -0,0 +1,152 @@
+package comment
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// MaxBodyLength is the longest comment in characters (runes).
+const MaxBodyLength = 10000
+
+// NormalizeBody trims surrounding whitespace and checks the length.
+func NormalizeBody(raw string) (string, error) {
+	body := strings.TrimSpace(raw)
+	if body == "" || utf8.RuneCountInString(body) > MaxBodyLength {
+		return "", domainerr.ErrInvalidCommentBody
+	}
+	return body, nil
+}
+
+// NewThread validates and builds a top-level comment on n by authorID.
+// ルール: 閲覧できるノートにだけコメントできる（公開済みのノート、または自分のノート）。
+// sectionID を指定する場合は、そのノートのセクションでなければならない。
+func NewThread(n note.WithMeta, authorID, sectionID, body string) (Comment, error) {
+	if err := CanComment(n.Note, authorID); err != nil {
+		return Comment{}, err
+	}
+	normalized, err := NormalizeBody(body)
+	if err != nil {
+		return Comment{}, err
+	}
+	if sectionID != "" && !hasSection(n.Sections, sectionID) {
+		return Comment{}, domainerr.ErrInvalidCommentSection
+	}
+	return Comment{NoteID: n.Note.ID, SectionID: sectionID, AuthorID: authorID, Body: normalized}, nil
+}
+
+// NewReply validates and builds a reply to the thread root parent on n by authorID.
+// The reply is about the same section as its thread.
+func NewReply(n note.Note, parent Comment, authorID, body string) (Comment, error) {
+	if err := CanComment(n, authorID); err != nil {
+		return Comment{}, err
+	}
+	if parent.NoteID != n.ID || !parent.IsRoot() {
+		return Comment{}, domainerr.ErrInvalidCommentParent
+	}
+	normalized, err := NormalizeBody(body)
+	if err != nil {
+		return Comment{}, err
+	}
+	return Comment{NoteID: n.ID, SectionID: parent.SectionID, ParentID: parent.ID, AuthorID: authorID, Body: normalized}, nil
+}
+
+// CanComment applies the note visibility rule to commenting: an account may comment
+// on exactly the notes it may read. Notes it may not read are reported as missing.
+func CanComment(n note.Note, actorID string) error {
+	if strings.TrimSpace(actorID) == "" {
+		return domainerr.ErrUnauthenticated
+	}
+	if !note.CanView(n, actorID) {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// ValidateAuthorship ensures only the author edits or deletes a comment.
+func ValidateAuthorship(authorID, actorID string) error {
+	if strings.TrimSpace(actorID) == "" {
+		return domainerr.ErrUnauthenticated
+	}
+	if authorID != actorID {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
+
+// IsRoot reports whether the comment starts a thread.
+func (c Comment) IsRoot() bool {
+	return c.ParentID == ""
+}
+
+// IsResolved reports whether the thread is resolved.
+func (c Comment) IsResolved() bool {
+	return c.ResolvedAt != nil
+}
+
+// Edit replaces the body. Only the author may edit a comment.
+func (c *Comment) Edit(actorID, body string) error {
+	if err := ValidateAuthorship(c.AuthorID, actorID); err != nil {
+		return err
+	}
+	normalized, err := NormalizeBody(body)
+	if err != nil {
+		return err
+	}
+	c.Body = normalized
+	return nil
+}
+
+// Resolve marks the thread as resolved by actorID at the given time, or reopens it
+// when resolved is false. Resolving a resolved thread keeps who resolved it first.
+// ルール: スレッドを解決・再開できるのは、スレッドの投稿者とノートの所有者のみ。
+func (c *Comment) Resolve(actorID, noteOwnerID string, resolved bool, at time.Time) error {
+	if !c.IsRoot() {
+		return domainerr.ErrCommentNotThread
+	}
+	if actorID == "" || actorID != noteOwnerID {
+		if err := ValidateAuthorship(c.AuthorID, actorID); err != nil {
+			return err
+		}
+	}
+	switch {
+	case !resolved:
+		c.ResolvedBy = ""
+		c.ResolvedAt = nil
+	case !c.IsResolved():
+		c.ResolvedBy = actorID
+		c.ResolvedAt = &at
+	}
+	return nil
+}
+
+// BuildThreads groups comments, oldest first, into threads. Threads keep the order of
+// their roots and replies the order they were written in.
+func BuildThreads(comments []WithMeta) []Thread {
+	threads := make([]Thread, 0, len(comments))
+	index := make(map[string]int, len(comments))
+	for _, c := range comments {
+		if c.Comment.IsRoot() {
+			index[c.Comment.ID] = len(threads)
+			threads = append(threads, Thread{Root: c, Replies: []WithMeta{}})
+		}
+	}
+	for _, c := range comments {
+		if i, ok := index[c.Comment.ParentID]; ok && !c.Comment.IsRoot() {
+			threads[i].Replies = append(threads[i].Replies, c)
+		}
+	}
+	return threads
+}
+
+func hasSection(sections []note.SectionWithField, sectionID string) bool {
+	for _, s := range sections {
+		if s.Section.ID == sectionID {
+			return true
+		}
+	}
+	return false
+}
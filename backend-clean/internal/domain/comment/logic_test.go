@@ -0,0 +1,186 @@
+package comment
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+func publishedNote() note.WithMeta {
+	return note.WithMeta{
+		Note:     note.Note{ID: "n1", OwnerID: "owner", Status: note.StatusPublish},
+		Sections: []note.SectionWithField{{Section: note.Section{ID: "s1", NoteID: "n1"}}},
+	}
+}
+
+func TestNewThread(t *testing.T) {
+	draft := publishedNote()
+	draft.Note.Status = note.StatusDraft
+	tests := []struct {
+		name      string
+		note      note.WithMeta
+		authorID  string
+		sectionID string
+		body      string
+		want      Comment
+		wantErr   error
+	}{
+		{name: "[Success] comment on a published note", note: publishedNote(), authorID: "reviewer", body: "  Looks good  ", want: Comment{NoteID: "n1", AuthorID: "reviewer", Body: "Looks good"}},
+		{name: "[Success] comment on a section", note: publishedNote(), authorID: "reviewer", sectionID: "s1", body: "why?", want: Comment{NoteID: "n1", SectionID: "s1", AuthorID: "reviewer", Body: "why?"}},
+		{name: "[Success] owner comments on own draft", note: draft, authorID: "owner", body: "todo", want: Comment{NoteID: "n1", AuthorID: "owner", Body: "todo"}},
+		{name: "[Fail] another account's draft", note: draft, authorID: "reviewer", body: "hi", wantErr: domainerr.ErrNotFound},
+		{name: "[Fail] unauthenticated", note: publishedNote(), body: "hi", wantErr: domainerr.ErrUnauthenticated},
+		{name: "[Fail] empty body", note: publishedNote(), authorID: "reviewer", body: " \n ", wantErr: domainerr.ErrInvalidCommentBody},
+		{name: "[Fail] too long", note: publishedNote(), authorID: "reviewer", body: strings.Repeat("あ", MaxBodyLength+1), wantErr: domainerr.ErrInvalidCommentBody},
+		{name: "[Fail] unknown section", note: publishedNote(), authorID: "reviewer", sectionID: "s9", body: "hi", wantErr: domainerr.ErrInvalidCommentSection},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewThread(tt.note, tt.authorID, tt.sectionID, tt.body)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("want %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNewReply(t *testing.T) {
+	root := Comment{ID: "c1", NoteID: "n1", SectionID: "s1", AuthorID: "reviewer"}
+	tests := []struct {
+		name     string
+		parent   Comment
+		authorID string
+		body     string
+		wantErr  error
+	}{
+		{name: "[Success] reply to a thread", parent: root, authorID: "owner", body: "fixed"},
+		{name: "[Fail] reply to a reply", parent: Comment{ID: "c2", NoteID: "n1", ParentID: "c1"}, authorID: "owner", body: "fixed", wantErr: domainerr.ErrInvalidCommentParent},
+		{name: "[Fail] thread on another note", parent: Comment{ID: "c3", NoteID: "n2"}, authorID: "owner", body: "fixed", wantErr: domainerr.ErrInvalidCommentParent},
+		{name: "[Fail] empty body", parent: root, authorID: "owner", body: "", wantErr: domainerr.ErrInvalidCommentBody},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewReply(publishedNote().Note, tt.parent, tt.authorID, tt.body)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ParentID != "c1" || got.SectionID != "s1" || got.AuthorID != tt.authorID {
+				t.Fatalf("unexpected reply: %+v", got)
+			}
+		})
+	}
+}
+
+func TestComment_Edit(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID string
+		body    string
+		wantErr error
+	}{
+		{name: "[Success] author edits", actorID: "author", body: " edited "},
+		{name: "[Fail] another account", actorID: "owner", body: "edited", wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] empty body", actorID: "author", body: "", wantErr: domainerr.ErrInvalidCommentBody},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Comment{ID: "c1", AuthorID: "author", Body: "original"}
+			err := c.Edit(tt.actorID, tt.body)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) || c.Body != "original" {
+					t.Fatalf("want %v and the body kept, got %v and %q", tt.wantErr, err, c.Body)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.Body != "edited" {
+				t.Fatalf("unexpected body: %q", c.Body)
+			}
+		})
+	}
+}
+
+func TestComment_Resolve(t *testing.T) {
+	at := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	earlier := at.Add(-time.Hour)
+	tests := []struct {
+		name     string
+		comment  Comment
+		actorID  string
+		resolved bool
+		wantBy   string
+		wantAt   *time.Time
+		wantErr  error
+	}{
+		{name: "[Success] author resolves", comment: Comment{AuthorID: "author"}, actorID: "author", resolved: true, wantBy: "author", wantAt: &at},
+		{name: "[Success] note owner resolves", comment: Comment{AuthorID: "author"}, actorID: "owner", resolved: true, wantBy: "owner", wantAt: &at},
+		{name: "[Success] resolving again keeps the first resolution", comment: Comment{AuthorID: "author", ResolvedBy: "owner", ResolvedAt: &earlier}, actorID: "author", resolved: true, wantBy: "owner", wantAt: &earlier},
+		{name: "[Success] reopen", comment: Comment{AuthorID: "author", ResolvedBy: "owner", ResolvedAt: &earlier}, actorID: "owner", resolved: false},
+		{name: "[Fail] another account", comment: Comment{AuthorID: "author"}, actorID: "reviewer", resolved: true, wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] unauthenticated", comment: Comment{AuthorID: "author"}, resolved: true, wantErr: domainerr.ErrUnauthenticated},
+		{name: "[Fail] reply", comment: Comment{AuthorID: "author", ParentID: "c0"}, actorID: "author", resolved: true, wantErr: domainerr.ErrCommentNotThread},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.comment
+			err := c.Resolve(tt.actorID, "owner", tt.resolved, at)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.ResolvedBy != tt.wantBy || (c.ResolvedAt == nil) != (tt.wantAt == nil) || (c.ResolvedAt != nil && !c.ResolvedAt.Equal(*tt.wantAt)) {
+				t.Fatalf("unexpected resolution: %q %v", c.ResolvedBy, c.ResolvedAt)
+			}
+		})
+	}
+}
+
+func TestBuildThreads(t *testing.T) {
+	comments := []WithMeta{
+		{Comment: Comment{ID: "c1"}},
+		{Comment: Comment{ID: "c2"}},
+		{Comment: Comment{ID: "r1", ParentID: "c1"}},
+		{Comment: Comment{ID: "r2", ParentID: "c2"}},
+		{Comment: Comment{ID: "r3", ParentID: "c1"}},
+	}
+	got := BuildThreads(comments)
+	if len(got) != 2 || got[0].Root.Comment.ID != "c1" || got[1].Root.Comment.ID != "c2" {
+		t.Fatalf("unexpected threads: %+v", got)
+	}
+	if len(got[0].Replies) != 2 || got[0].Replies[0].Comment.ID != "r1" || got[0].Replies[1].Comment.ID != "r3" {
+		t.Fatalf("unexpected replies: %+v", got[0].Replies)
+	}
+	if empty := BuildThreads(nil); empty == nil || len(empty) != 0 {
+		t.Fatalf("want an empty list, got %+v", empty)
+	}
+}
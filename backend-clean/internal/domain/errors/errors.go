@@ -1,41 +1,209 @@
 // Package errors defines domain-level error values.
+//
+// Every domain error is an *Error with a stable, machine-readable code and a Kind
+// that adapters map to their own status codes (HTTP status, gRPC code). Errors may
+// be wrapped with fmt.Errorf("%w: ...") to add detail; the code stays the same.
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Kind classifies a domain error by what the client can do about it.
+type Kind int
+
+// Kind constants.
+const (
+	// KindInternal is a failure the client cannot fix.
+	KindInternal Kind = iota
+	// KindInvalid is a request with invalid input.
+	KindInvalid
+	// KindUnauthenticated is a request without valid credentials.
+	KindUnauthenticated
+	// KindForbidden is a request the caller is not allowed to make.
+	KindForbidden
+	// KindNotFound is a request for a resource that does not exist.
+	KindNotFound
+	// KindAlreadyExists is a request to create something that already exists.
+	KindAlreadyExists
+	// KindConflict is a request made against a stale version of the resource.
+	KindConflict
+	// KindFailedPrecondition is a request the resource's current state does not allow.
+	KindFailedPrecondition
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case KindInvalid:
+		return "invalid"
+	case KindUnauthenticated:
+		return "unauthenticated"
+	case KindForbidden:
+		return "forbidden"
+	case KindNotFound:
+		return "not found"
+	case KindAlreadyExists:
+		return "already exists"
+	case KindConflict:
+		return "conflict"
+	case KindFailedPrecondition:
+		return "failed precondition"
+	default:
+		return "internal"
+	}
+}
+
+// Error is a domain error listed in the catalog.
+type Error struct {
+	// Code is the stable, machine-readable code, e.g. "TITLE_REQUIRED".
+	Code string
+	// Kind decides the status code adapters return.
+	Kind Kind
+	// Field is the request field the error is about ("title", "sections"), or empty.
+	Field   string
+	message string
+}
+
+// Error returns the message of the error.
+func (e *Error) Error() string {
+	return e.message
+}
+
+var catalog []*Error
+
+// Define adds an error to the catalog. It is meant for package-level variables;
+// the code must be unique.
+func Define(code string, kind Kind, field, message string) *Error {
+	for _, e := range catalog {
+		if e.Code == code {
+			panic(fmt.Sprintf("errors: duplicate error code %q", code))
+		}
+	}
+	e := &Error{Code: code, Kind: kind, Field: field, message: message}
+	catalog = append(catalog, e)
+	return e
+}
+
+// Catalog returns every defined error in definition order.
+func Catalog() []*Error {
+	return slices.Clone(catalog)
+}
+
+// Lookup returns the catalog error that err is or wraps. ok is false for errors
+// outside the catalog, which clients must see as internal errors.
+func Lookup(err error) (e *Error, ok bool) {
+	ok = errors.As(err, &e)
+	return e, ok
+}
 
 var (
 	// ErrNotFound indicates resource not found.
-	ErrNotFound = errors.New("not found")
+	ErrNotFound = Define("NOT_FOUND", KindNotFound, "", "not found")
 	// ErrTemplateInUse indicates template is referenced by notes.
-	ErrTemplateInUse = errors.New("template is used by notes")
+	ErrTemplateInUse = Define("TEMPLATE_IN_USE", KindFailedPrecondition, "", "template is used by notes")
 	// ErrUnauthorized indicates authorization failure.
-	ErrUnauthorized = errors.New("unauthorized")
+	ErrUnauthorized = Define("FORBIDDEN", KindForbidden, "", "unauthorized")
+	// ErrUnauthenticated indicates missing or invalid credentials.
+	ErrUnauthenticated = Define("UNAUTHORIZED", KindUnauthenticated, "", "unauthenticated")
 	// ErrInvalidStatus indicates invalid status value.
-	ErrInvalidStatus = errors.New("invalid status")
+	ErrInvalidStatus = Define("INVALID_STATUS", KindInvalid, "status", "invalid status")
 	// ErrInvalidStatusChange indicates invalid status transition.
-	ErrInvalidStatusChange = errors.New("invalid status change")
+	ErrInvalidStatusChange = Define("INVALID_STATUS_CHANGE", KindInvalid, "", "invalid status change")
 	// ErrInvalidTemplateField indicates invalid template field definition.
-	ErrInvalidTemplateField = errors.New("invalid template field")
+	ErrInvalidTemplateField = Define("INVALID_TEMPLATE_FIELD", KindInvalid, "fields", "invalid template field")
 	// ErrTemplateNameRequired indicates template name missing.
-	ErrTemplateNameRequired = errors.New("template name is required")
+	ErrTemplateNameRequired = Define("TEMPLATE_NAME_REQUIRED", KindInvalid, "name", "template name is required")
 	// ErrTemplateOwnerRequired indicates template owner missing.
-	ErrTemplateOwnerRequired = errors.New("template owner is required")
+	ErrTemplateOwnerRequired = Define("TEMPLATE_OWNER_REQUIRED", KindInvalid, "", "template owner is required")
 	// ErrFieldRequired indicates at least one field is required.
-	ErrFieldRequired = errors.New("template requires at least one field")
+	ErrFieldRequired = Define("TEMPLATE_FIELDS_REQUIRED", KindInvalid, "fields", "template requires at least one field")
 	// ErrFieldOrderInvalid indicates invalid field order.
-	ErrFieldOrderInvalid = errors.New("field order must be greater than zero and unique")
+	ErrFieldOrderInvalid = Define("FIELD_ORDER_INVALID", KindInvalid, "fields", "field order must be greater than zero and unique")
 	// ErrFieldLabelRequired indicates field label missing.
-	ErrFieldLabelRequired = errors.New("field label is required")
+	ErrFieldLabelRequired = Define("FIELD_LABEL_REQUIRED", KindInvalid, "fields", "field label is required")
+	// ErrInvalidFieldType indicates an unknown template field type.
+	ErrInvalidFieldType = Define("INVALID_FIELD_TYPE", KindInvalid, "fields", "invalid field type")
+	// ErrInvalidFieldOptions indicates options that do not fit the field type.
+	ErrInvalidFieldOptions = Define("INVALID_FIELD_OPTIONS", KindInvalid, "fields", "single-select fields need unique, non-empty options and other types take none")
 	// ErrSectionsMissing indicates sections don't match template.
-	ErrSectionsMissing = errors.New("sections do not match template fields")
+	ErrSectionsMissing = Define("SECTIONS_MISMATCH", KindInvalid, "sections", "sections do not match template fields")
 	// ErrRequiredFieldEmpty indicates required field content missing.
-	ErrRequiredFieldEmpty = errors.New("required field content is empty")
+	ErrRequiredFieldEmpty = Define("REQUIRED_FIELD_EMPTY", KindInvalid, "sections", "required field content is empty")
+	// ErrInvalidSectionContent indicates section content that does not match its field type.
+	ErrInvalidSectionContent = Define("INVALID_SECTION_CONTENT", KindInvalid, "sections", "section content does not match field type")
 	// ErrProviderRequired indicates provider missing.
-	ErrProviderRequired = errors.New("provider is required")
+	ErrProviderRequired = Define("PROVIDER_REQUIRED", KindInvalid, "provider", "provider is required")
 	// ErrProviderAccountRequired indicates provider account id missing.
-	ErrProviderAccountRequired = errors.New("provider account id is required")
+	ErrProviderAccountRequired = Define("PROVIDER_ACCOUNT_REQUIRED", KindInvalid, "providerAccountId", "provider account id is required")
 	// ErrTitleRequired indicates title missing.
-	ErrTitleRequired = errors.New("title is required")
+	ErrTitleRequired = Define("TITLE_REQUIRED", KindInvalid, "title", "title is required")
 	// ErrOwnerRequired indicates owner missing.
-	ErrOwnerRequired = errors.New("owner is required")
+	ErrOwnerRequired = Define("OWNER_REQUIRED", KindInvalid, "", "owner is required")
+	// ErrInvalidLinkType indicates invalid note link type.
+	ErrInvalidLinkType = Define("INVALID_LINK_TYPE", KindInvalid, "type", "invalid link type")
+	// ErrSelfLink indicates a note linking to itself.
+	ErrSelfLink = Define("SELF_LINK", KindInvalid, "targetNoteId", "a note cannot link to itself")
+	// ErrSearchQueryRequired indicates a search without keywords.
+	ErrSearchQueryRequired = Define("SEARCH_QUERY_REQUIRED", KindInvalid, "", "search query is required")
+	// ErrInvalidSearchTarget indicates an unknown search target.
+	ErrInvalidSearchTarget = Define("INVALID_SEARCH_TARGET", KindInvalid, "", "invalid search target")
+	// ErrInvalidCursor indicates a malformed pagination cursor.
+	ErrInvalidCursor = Define("INVALID_CURSOR", KindInvalid, "", "invalid cursor")
+	// ErrInvalidFieldMapping indicates a field mapping that does not match the note and template versions.
+	ErrInvalidFieldMapping = Define("INVALID_FIELD_MAPPING", KindInvalid, "mappings", "invalid field mapping")
+	// ErrInvalidMarkdown indicates a Markdown document that cannot be read as a note.
+	ErrInvalidMarkdown = Define("INVALID_MARKDOWN", KindInvalid, "", "invalid markdown document")
+	// ErrUnmatchedHeading indicates a Markdown heading that matches no template field.
+	ErrUnmatchedHeading = Define("UNMATCHED_HEADING", KindInvalid, "", "heading does not match any template field")
+	// ErrMissingHeading indicates a required template field without a Markdown heading.
+	ErrMissingHeading = Define("MISSING_HEADING", KindInvalid, "", "required field heading is missing")
+	// ErrInvalidArchive indicates an archive whose contents do not fit together.
+	ErrInvalidArchive = Define("INVALID_ARCHIVE", KindInvalid, "", "invalid archive")
+	// ErrUnsupportedArchiveVersion indicates an archive written in a format this build cannot read.
+	ErrUnsupportedArchiveVersion = Define("UNSUPPORTED_ARCHIVE_VERSION", KindInvalid, "", "unsupported archive format version")
+	// ErrInvalidWebhookURL indicates a webhook endpoint that is not an absolute http or https URL of a public host.
+	ErrInvalidWebhookURL = Define("INVALID_WEBHOOK_URL", KindInvalid, "url", "webhook url must be an absolute http or https URL of a public host")
+	// ErrInvalidWebhookEvents indicates a webhook without events or with an unknown event.
+	ErrInvalidWebhookEvents = Define("INVALID_WEBHOOK_EVENTS", KindInvalid, "events", "webhook events must be a non-empty list of supported events")
+	// ErrTemplateUsedByTrashedNotes indicates template is referenced only by notes in the trash.
+	ErrTemplateUsedByTrashedNotes = fmt.Errorf("%w: purge or restore the notes in the trash first", ErrTemplateInUse)
+	// ErrInvalidAuditAggregate indicates an audit log filter on an unknown aggregate type.
+	ErrInvalidAuditAggregate = Define("INVALID_AUDIT_AGGREGATE", KindInvalid, "", "aggregate type must be note, template or account")
+	// ErrInvalidAuditRange indicates an audit log time range whose start is not before its end.
+	ErrInvalidAuditRange = Define("INVALID_AUDIT_RANGE", KindInvalid, "", "audit log range must start before it ends")
+	// ErrInvalidTag indicates an empty or too long tag, or one with a comma or control character.
+	ErrInvalidTag = Define("INVALID_TAG", KindInvalid, "tags", "tag must be 1 to 32 characters without commas")
+	// ErrTooManyTags indicates a note with more tags than allowed.
+	ErrTooManyTags = Define("TOO_MANY_TAGS", KindInvalid, "tags", "a note can have at most 20 tags")
+	// ErrInvalidTagMatch indicates an unknown tag match mode.
+	ErrInvalidTagMatch = Define("INVALID_TAG_MATCH", KindInvalid, "", "tag match must be any or all")
+	// ErrInvalidCommentBody indicates an empty or too long comment.
+	ErrInvalidCommentBody = Define("INVALID_COMMENT_BODY", KindInvalid, "body", "comment must be 1 to 10000 characters")
+	// ErrInvalidCommentParent indicates a reply to a reply or to a comment on another note.
+	ErrInvalidCommentParent = Define("INVALID_COMMENT_PARENT", KindInvalid, "parentId", "replies must answer a top-level comment on the same note")
+	// ErrInvalidCommentSection indicates a comment on a section the note does not have.
+	ErrInvalidCommentSection = Define("INVALID_COMMENT_SECTION", KindInvalid, "sectionId", "section does not belong to the note")
+	// ErrCommentNotThread indicates resolving a reply instead of a thread.
+	ErrCommentNotThread = Define("COMMENT_NOT_THREAD", KindInvalid, "", "only top-level comments can be resolved")
+	// ErrInvalidCollaboratorRole indicates an unknown collaborator role.
+	ErrInvalidCollaboratorRole = Define("INVALID_COLLABORATOR_ROLE", KindInvalid, "role", "collaborator role must be editor or viewer")
+	// ErrInvalidCollaborator indicates adding the note's owner as its collaborator.
+	ErrInvalidCollaborator = Define("INVALID_COLLABORATOR", KindInvalid, "", "the owner cannot be a collaborator of the note")
+	// ErrCollaboratorNotWorkspaceMember indicates sharing a note with an account outside the note's workspace.
+	ErrCollaboratorNotWorkspaceMember = Define("COLLABORATOR_NOT_WORKSPACE_MEMBER", KindInvalid, "", "collaborator must be a member of the note's workspace")
+	// ErrInvalidWorkspaceName indicates an empty or too long workspace name.
+	ErrInvalidWorkspaceName = Define("INVALID_WORKSPACE_NAME", KindInvalid, "name", "workspace name must be 1 to 100 characters")
+	// ErrInvalidWorkspaceRole indicates an unknown workspace role, or one that cannot be given.
+	ErrInvalidWorkspaceRole = Define("INVALID_WORKSPACE_ROLE", KindInvalid, "role", "workspace role must be admin or member")
+	// ErrInvalidWorkspaceMember indicates changing or removing the workspace owner.
+	ErrInvalidWorkspaceMember = Define("INVALID_WORKSPACE_MEMBER", KindInvalid, "", "the workspace owner cannot be changed or removed")
+	// ErrAlreadyWorkspaceMember indicates inviting an account that already belongs to the workspace.
+	ErrAlreadyWorkspaceMember = Define("ALREADY_WORKSPACE_MEMBER", KindAlreadyExists, "email", "account is already a member of the workspace")
+	// ErrWorkspaceNotEmpty indicates deleting a workspace that still has templates or notes.
+	ErrWorkspaceNotEmpty = Define("WORKSPACE_NOT_EMPTY", KindFailedPrecondition, "", "workspace still has templates or notes")
+	// ErrConflict indicates the resource was changed since the version the client edited.
+	ErrConflict = Define("CONFLICT", KindConflict, "", "resource was modified by another request")
 )
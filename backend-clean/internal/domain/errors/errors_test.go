@@ -0,0 +1,75 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+func TestCatalog(t *testing.T) {
+	code := regexp.MustCompile(`^[A-Z][A-Z_]*[A-Z]$`)
+	seen := map[string]bool{}
+	for _, e := range Catalog() {
+		if !code.MatchString(e.Code) {
+			t.Errorf("code %q is not UPPER_SNAKE_CASE", e.Code)
+		}
+		if seen[e.Code] {
+			t.Errorf("duplicate code %q", e.Code)
+		}
+		seen[e.Code] = true
+		if e.Kind == KindInternal {
+			t.Errorf("%s: catalog errors must not be internal", e.Code)
+		}
+		if e.Error() == "" {
+			t.Errorf("%s: empty message", e.Code)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    string
+		wantMessage string
+	}{
+		{name: "[Success] catalog error", err: ErrTitleRequired, wantCode: "TITLE_REQUIRED", wantMessage: "title is required"},
+		{name: "[Success] wrapped with detail", err: fmt.Errorf("%w: %q", ErrUnmatchedHeading, "Memo"), wantCode: "UNMATCHED_HEADING", wantMessage: `heading does not match any template field: "Memo"`},
+		{name: "[Success] variant keeps the code of the error it wraps", err: ErrTemplateUsedByTrashedNotes, wantCode: "TEMPLATE_IN_USE", wantMessage: "template is used by notes: purge or restore the notes in the trash first"},
+		{name: "[Success] first catalog error wins", err: fmt.Errorf("%w: %w", ErrInvalidArchive, ErrInvalidTag), wantCode: "INVALID_ARCHIVE"},
+		{name: "[Fail] error outside the catalog", err: context.DeadlineExceeded},
+		{name: "[Fail] nil", err: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok := Lookup(tt.err)
+			if ok != (tt.wantCode != "") {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantCode != "")
+			}
+			if !ok {
+				return
+			}
+			if e.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", e.Code, tt.wantCode)
+			}
+			if tt.wantMessage != "" && tt.err.Error() != tt.wantMessage {
+				t.Errorf("message = %q, want %q", tt.err.Error(), tt.wantMessage)
+			}
+			if !errors.Is(tt.err, e) {
+				t.Errorf("errors.Is(err, %s) = false", e.Code)
+			}
+		})
+	}
+}
+
+func TestDefine_DuplicateCode(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("want panic for a duplicate code")
+		}
+	}()
+	Define("NOT_FOUND", KindNotFound, "", "again")
+}
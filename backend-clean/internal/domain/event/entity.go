@@ -0,0 +1,62 @@
+// Package event holds domain events raised by aggregates.
+package event
+
+import "time"
+
+// Type names what happened.
+type Type string
+
+// Event type constants.
+const (
+	NoteCreated     Type = "NoteCreated"
+	NoteUpdated     Type = "NoteUpdated"
+	NotePublished   Type = "NotePublished"
+	NoteUnpublished Type = "NoteUnpublished"
+	NoteDeleted     Type = "NoteDeleted"
+	NoteRestored    Type = "NoteRestored"
+	NotePurged      Type = "NotePurged"
+	TemplateChanged Type = "TemplateChanged"
+	AccountSignedIn Type = "AccountSignedIn"
+)
+
+// AggregateType names the kind of aggregate an event belongs to.
+type AggregateType string
+
+// Aggregate type constants.
+const (
+	AggregateNote     AggregateType = "note"
+	AggregateTemplate AggregateType = "template"
+	AggregateAccount  AggregateType = "account"
+)
+
+// TemplateChange tells what happened to a template in a TemplateChanged event.
+type TemplateChange string
+
+// Template change constants.
+const (
+	TemplateCreated  TemplateChange = "created"
+	TemplateUpdated  TemplateChange = "updated"
+	TemplateDeleted  TemplateChange = "deleted"
+	TemplateRestored TemplateChange = "restored"
+	TemplatePurged   TemplateChange = "purged"
+)
+
+// Event is a fact about an aggregate recorded in the same transaction as the change.
+// ID and OccurredAt are assigned when the event is stored.
+type Event struct {
+	ID            string
+	Type          Type
+	AggregateType AggregateType
+	AggregateID   string
+	ActorID       string
+	Payload       map[string]any
+	OccurredAt    time.Time
+}
+
+// Stored is an event waiting in the outbox for delivery.
+// Sequence orders events; events of one aggregate are delivered in Sequence order.
+type Stored struct {
+	Event    Event
+	Sequence int64
+	Attempts int
+}
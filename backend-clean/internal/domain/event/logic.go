@@ -0,0 +1,104 @@
+package event
+
+import (
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+// NewNoteCreated records that actorID created the note.
+func NewNoteCreated(n note.Note, actorID string) Event {
+	return noteEvent(NoteCreated, n, actorID, map[string]any{
+		"title":      n.Title,
+		"templateId": n.TemplateID,
+		"status":     string(n.Status),
+		"version":    n.Version,
+	})
+}
+
+// NewNoteUpdated records that actorID changed the note's title or sections.
+func NewNoteUpdated(n note.Note, actorID string) Event {
+	return noteEvent(NoteUpdated, n, actorID, map[string]any{
+		"title":           n.Title,
+		"version":         n.Version,
+		"templateVersion": n.TemplateVersion,
+	})
+}
+
+// NewNoteStatusChanged records a publish or unpublish of the note.
+func NewNoteStatusChanged(n note.Note, actorID string) Event {
+	t := NoteUnpublished
+	if n.Status == note.StatusPublish {
+		t = NotePublished
+	}
+	return noteEvent(t, n, actorID, map[string]any{
+		"title":   n.Title,
+		"status":  string(n.Status),
+		"version": n.Version,
+	})
+}
+
+// NewNoteDeleted records that actorID moved the note to the trash.
+func NewNoteDeleted(n note.Note, actorID string) Event {
+	return noteEvent(NoteDeleted, n, actorID, map[string]any{
+		"title": n.Title,
+	})
+}
+
+// NewNoteRestored records that actorID restored the note from the trash.
+func NewNoteRestored(n note.Note, actorID string) Event {
+	return noteEvent(NoteRestored, n, actorID, map[string]any{
+		"title": n.Title,
+	})
+}
+
+// NewNotePurged records that the note was removed for good. actorID is empty when
+// the retention purge removed it.
+func NewNotePurged(n note.Note, actorID string) Event {
+	return noteEvent(NotePurged, n, actorID, map[string]any{
+		"title": n.Title,
+	})
+}
+
+// NewTemplateChanged records that actorID created, updated, trashed, restored or purged the template.
+func NewTemplateChanged(t template.Template, change TemplateChange, actorID string) Event {
+	return Event{
+		Type:          TemplateChanged,
+		AggregateType: AggregateTemplate,
+		AggregateID:   t.ID,
+		ActorID:       actorID,
+		Payload: map[string]any{
+			"ownerId": t.OwnerID,
+			"change":  string(change),
+			"name":    t.Name,
+			"version": t.Version,
+		},
+	}
+}
+
+// NewAccountSignedIn records a sign-in of the account through its OAuth provider.
+func NewAccountSignedIn(a account.Account) Event {
+	return Event{
+		Type:          AccountSignedIn,
+		AggregateType: AggregateAccount,
+		AggregateID:   a.ID,
+		ActorID:       a.ID,
+		Payload: map[string]any{
+			"email":    a.Email.String(),
+			"provider": a.Provider,
+		},
+	}
+}
+
+// noteEvent adds the owner to the payload so subscribers can route the event to the
+// account that owns the note.
+func noteEvent(t Type, n note.Note, actorID string, payload map[string]any) Event {
+	payload["ownerId"] = n.OwnerID
+	return Event{
+		Type:          t,
+		AggregateType: AggregateNote,
+		AggregateID:   n.ID,
+		ActorID:       actorID,
+		Payload:       payload,
+	}
+}
@@ -0,0 +1,76 @@
+package event
+
+import (
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestNewNoteStatusChanged(t *testing.T) {
+	tests := []struct {
+		name   string
+		status note.NoteStatus
+		want   Type
+	}{
+		{name: "[Success] publish", status: note.StatusPublish, want: NotePublished},
+		{name: "[Success] unpublish", status: note.StatusDraft, want: NoteUnpublished},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewNoteStatusChanged(note.Note{ID: "n1", OwnerID: "owner-1", Status: tt.status, Version: 3}, "actor-1")
+			if e.Type != tt.want || e.AggregateType != AggregateNote || e.AggregateID != "n1" || e.ActorID != "actor-1" {
+				t.Fatalf("unexpected event: %+v", e)
+			}
+			if e.Payload["status"] != string(tt.status) || e.Payload["ownerId"] != "owner-1" || e.Payload["version"] != 3 {
+				t.Fatalf("unexpected payload: %+v", e.Payload)
+			}
+		})
+	}
+}
+
+func TestNewTemplateChanged(t *testing.T) {
+	e := NewTemplateChanged(template.Template{ID: "t1", Name: "ADR", OwnerID: "owner-1", Version: 2}, TemplateUpdated, "owner-1")
+	if e.Type != TemplateChanged || e.AggregateType != AggregateTemplate || e.AggregateID != "t1" {
+		t.Fatalf("unexpected event: %+v", e)
+	}
+	if e.Payload["change"] != "updated" || e.Payload["ownerId"] != "owner-1" || e.Payload["name"] != "ADR" || e.Payload["version"] != 2 {
+		t.Fatalf("unexpected payload: %+v", e.Payload)
+	}
+}
+
+func TestNewAccountSignedIn(t *testing.T) {
+	e := NewAccountSignedIn(account.Account{ID: "a1", Email: account.Email("user@example.com"), Provider: "google"})
+	if e.Type != AccountSignedIn || e.AggregateID != "a1" || e.ActorID != "a1" {
+		t.Fatalf("unexpected event: %+v", e)
+	}
+	if e.Payload["email"] != "user@example.com" || e.Payload["provider"] != "google" {
+		t.Fatalf("unexpected payload: %+v", e.Payload)
+	}
+}
+
+func TestNewNoteTrashEvents(t *testing.T) {
+	n := note.Note{ID: "n1", Title: "ADR", OwnerID: "owner-1"}
+	tests := []struct {
+		name    string
+		event   Event
+		want    Type
+		actorID string
+	}{
+		{name: "[Success] deleted", event: NewNoteDeleted(n, "owner-1"), want: NoteDeleted, actorID: "owner-1"},
+		{name: "[Success] restored", event: NewNoteRestored(n, "owner-1"), want: NoteRestored, actorID: "owner-1"},
+		{name: "[Success] purged by retention", event: NewNotePurged(n, ""), want: NotePurged, actorID: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := tt.event
+			if e.Type != tt.want || e.AggregateID != "n1" || e.ActorID != tt.actorID {
+				t.Fatalf("unexpected event: %+v", e)
+			}
+			if e.Payload["title"] != "ADR" || e.Payload["ownerId"] != "owner-1" {
+				t.Fatalf("unexpected payload: %+v", e.Payload)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package note
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// Role is what a collaborator may do with a note.
+type Role string
+
+// Role constants.
+const (
+	// RoleEditor may change the title and sections and read the note as a draft.
+	RoleEditor Role = "editor"
+	// RoleViewer may read the note as a draft.
+	RoleViewer Role = "viewer"
+)
+
+// Validate checks if role is valid.
+func (r Role) Validate() error {
+	switch r {
+	case RoleEditor, RoleViewer:
+		return nil
+	default:
+		return domainerr.ErrInvalidCollaboratorRole
+	}
+}
+
+// Collaborator is an account other than the owner that was given a role on a note.
+type Collaborator struct {
+	NoteID    string
+	AccountID string
+	Role      Role
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CollaboratorWithMeta represents a collaborator with account profile.
+type CollaboratorWithMeta struct {
+	Collaborator Collaborator
+	FirstName    string
+	LastName     string
+	Thumbnail    *string
+}
+
+// RoleOf returns the role of the account on the note, if it collaborates on it.
+func (n Note) RoleOf(accountID string) (Role, bool) {
+	for _, c := range n.Collaborators {
+		if c.AccountID == accountID {
+			return c.Role, true
+		}
+	}
+	return "", false
+}
+
+// ValidateNoteEditor ensures the actor may change the note's title and sections.
+// ルール: 所有者と editor の共同編集者のみ編集可能。公開・削除・共同編集者の管理は所有者のみ。
+func ValidateNoteEditor(n Note, actorID string) error {
+	err := ValidateNoteOwnership(n.OwnerID, actorID)
+	if !errors.Is(err, domainerr.ErrUnauthorized) {
+		return err
+	}
+	if role, ok := n.RoleOf(actorID); ok && role == RoleEditor {
+		return nil
+	}
+	return err
+}
+
+// NewCollaborator validates and builds the collaborator the actor gives a role on n.
+// ルール: 共同編集者を管理できるのは所有者のみ。所有者自身は共同編集者にできない。
+func NewCollaborator(n Note, actorID, accountID string, role Role) (Collaborator, error) {
+	if err := ValidateNoteOwnership(n.OwnerID, actorID); err != nil {
+		return Collaborator{}, err
+	}
+	if err := role.Validate(); err != nil {
+		return Collaborator{}, err
+	}
+	if strings.TrimSpace(accountID) == "" || accountID == n.OwnerID {
+		return Collaborator{}, domainerr.ErrInvalidCollaborator
+	}
+	return Collaborator{NoteID: n.ID, AccountID: accountID, Role: role}, nil
+}
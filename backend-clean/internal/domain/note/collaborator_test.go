@@ -0,0 +1,87 @@
+package note
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestValidateNoteEditor(t *testing.T) {
+	n := Note{ID: "n1", OwnerID: "owner", Collaborators: []Collaborator{
+		{AccountID: "editor", Role: RoleEditor},
+		{AccountID: "viewer", Role: RoleViewer},
+	}}
+	tests := []struct {
+		name    string
+		actorID string
+		wantErr error
+	}{
+		{name: "[Success] owner", actorID: "owner"},
+		{name: "[Success] editor", actorID: "editor"},
+		{name: "[Fail] viewer", actorID: "viewer", wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] stranger", actorID: "stranger", wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] missing actor", actorID: " ", wantErr: domainerr.ErrOwnerRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNoteEditor(n, tt.actorID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewCollaborator(t *testing.T) {
+	n := Note{ID: "n1", OwnerID: "owner", Collaborators: []Collaborator{{AccountID: "editor", Role: RoleEditor}}}
+	tests := []struct {
+		name      string
+		actorID   string
+		accountID string
+		role      Role
+		wantErr   error
+	}{
+		{name: "[Success] add viewer", actorID: "owner", accountID: "friend", role: RoleViewer},
+		{name: "[Success] change role", actorID: "owner", accountID: "editor", role: RoleViewer},
+		{name: "[Fail] editor cannot manage collaborators", actorID: "editor", accountID: "friend", role: RoleViewer, wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] unknown role", actorID: "owner", accountID: "friend", role: "admin", wantErr: domainerr.ErrInvalidCollaboratorRole},
+		{name: "[Fail] owner as collaborator", actorID: "owner", accountID: "owner", role: RoleEditor, wantErr: domainerr.ErrInvalidCollaborator},
+		{name: "[Fail] empty account", actorID: "owner", accountID: "", role: RoleEditor, wantErr: domainerr.ErrInvalidCollaborator},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewCollaborator(n, tt.actorID, tt.accountID, tt.role)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.NoteID != "n1" || c.AccountID != tt.accountID || c.Role != tt.role {
+				t.Fatalf("unexpected collaborator: %+v", c)
+			}
+		})
+	}
+}
+
+func TestNote_RoleOf(t *testing.T) {
+	n := Note{Collaborators: []Collaborator{{AccountID: "a", Role: RoleViewer}}}
+	if role, ok := n.RoleOf("a"); !ok || role != RoleViewer {
+		t.Fatalf("unexpected role: %q %v", role, ok)
+	}
+	if _, ok := n.RoleOf("b"); ok {
+		t.Fatalf("b does not collaborate")
+	}
+}
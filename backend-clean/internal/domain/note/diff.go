@@ -0,0 +1,194 @@
+package note
+
+import (
+	"slices"
+	"strings"
+)
+
+// DiffOp is the kind of change for a diff line.
+type DiffOp string
+
+// Diff operations.
+const (
+	DiffEqual  DiffOp = "equal"
+	DiffInsert DiffOp = "insert"
+	DiffDelete DiffOp = "delete"
+)
+
+// DiffLine is a single line in a line-level diff.
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// FieldDiff is the line-level diff of one template field between two revisions.
+type FieldDiff struct {
+	FieldID    string
+	FieldLabel string
+	Changed    bool
+	Lines      []DiffLine
+}
+
+// RevisionDiff describes the changes between two revisions of a note.
+type RevisionDiff struct {
+	NoteID     string
+	From       int
+	To         int
+	Title      []DiffLine
+	StatusFrom NoteStatus
+	StatusTo   NoteStatus
+	Fields     []FieldDiff
+}
+
+// DiffRevisions compares two revisions field by field.
+// Fields are listed in the order of the newer revision; fields that only exist
+// in the older revision are appended at the end.
+func DiffRevisions(from, to Revision) RevisionDiff {
+	before := make(map[string]RevisionSection, len(from.Sections))
+	for _, s := range from.Sections {
+		before[s.FieldID] = s
+	}
+
+	fields := make([]FieldDiff, 0, len(to.Sections))
+	seen := make(map[string]bool, len(to.Sections))
+	for _, s := range to.Sections {
+		seen[s.FieldID] = true
+		fields = append(fields, newFieldDiff(s.FieldID, s.FieldLabel, before[s.FieldID].Content, s.Content))
+	}
+	for _, s := range from.Sections {
+		if !seen[s.FieldID] {
+			fields = append(fields, newFieldDiff(s.FieldID, s.FieldLabel, s.Content, ""))
+		}
+	}
+
+	return RevisionDiff{
+		NoteID:     to.NoteID,
+		From:       from.Number,
+		To:         to.Number,
+		Title:      DiffLines(from.Title, to.Title),
+		StatusFrom: from.Status,
+		StatusTo:   to.Status,
+		Fields:     fields,
+	}
+}
+
+func newFieldDiff(fieldID, label, before, after string) FieldDiff {
+	return FieldDiff{
+		FieldID:    fieldID,
+		FieldLabel: label,
+		Changed:    before != after,
+		Lines:      DiffLines(before, after),
+	}
+}
+
+// maxDiffEdits bounds the edit distance DiffLines searches for. Texts further apart barely
+// share any lines, so the rest is shown as replaced instead of spending quadratic time and
+// memory on a minimal diff.
+const maxDiffEdits = 1000
+
+// DiffLines returns a line-level diff of two texts: the shortest edit script found with
+// Myers' O(ND) algorithm, where D is the number of changed lines. Replaced lines are listed
+// as deletions followed by insertions.
+func DiffLines(before, after string) []DiffLine {
+	a, b := splitLines(before), splitLines(after)
+	lines := make([]DiffLine, 0, max(len(a), len(b)))
+
+	// Lines shared at both ends are equal in any minimal diff; trimming them keeps D small.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	for _, l := range a[:prefix] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: l})
+	}
+	lines = appendEdits(lines, a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	for _, l := range a[len(a)-suffix:] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: l})
+	}
+	return lines
+}
+
+// appendEdits appends the edit script turning a into b.
+func appendEdits(lines []DiffLine, a, b []string) []DiffLine {
+	n, m := len(a), len(b)
+	limit := min(n+m, maxDiffEdits)
+	// v[offset+k] is the furthest x reached on diagonal k = x - y; trace[d] keeps the
+	// diagonals -d..d of v as they were before step d, to walk the path back.
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	trace := make([][]int, 0, limit+1)
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1] // insert b[y-1]
+			} else {
+				x = v[offset+k-1] + 1 // delete a[x-1]
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return append(lines, backtrack(a, b, trace)...)
+			}
+		}
+	}
+
+	for _, l := range a {
+		lines = append(lines, DiffLine{Op: DiffDelete, Text: l})
+	}
+	for _, l := range b {
+		lines = append(lines, DiffLine{Op: DiffInsert, Text: l})
+	}
+	return lines
+}
+
+// backtrack walks the path found by appendEdits from the end of both texts back to the start.
+func backtrack(a, b []string, trace [][]int) []DiffLine {
+	x, y := len(a), len(b)
+	edits := make([]DiffLine, 0, x+y)
+	for d := len(trace) - 1; d >= 0; d-- {
+		k := x - y
+		prevX, prevY := 0, 0
+		if d > 0 {
+			// trace[d] holds diagonals -d..d, so diagonal k is at index k+d.
+			prev := trace[d]
+			prevK := k - 1
+			if k == -d || (k != d && prev[k-1+d] < prev[k+1+d]) {
+				prevK = k + 1
+			}
+			prevX = prev[prevK+d]
+			prevY = prevX - prevK
+		}
+		for x > prevX && y > prevY {
+			edits = append(edits, DiffLine{Op: DiffEqual, Text: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				edits = append(edits, DiffLine{Op: DiffInsert, Text: b[y-1]})
+			} else {
+				edits = append(edits, DiffLine{Op: DiffDelete, Text: a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	slices.Reverse(edits)
+	return edits
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
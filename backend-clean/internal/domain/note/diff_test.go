@@ -0,0 +1,158 @@
+package note
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		want   []DiffLine
+	}{
+		{
+			name:   "[Success] identical text",
+			before: "a\nb",
+			after:  "a\nb",
+			want:   []DiffLine{{Op: DiffEqual, Text: "a"}, {Op: DiffEqual, Text: "b"}},
+		},
+		{
+			name:   "[Success] line replaced",
+			before: "a\nb\nc",
+			after:  "a\nx\nc",
+			want: []DiffLine{
+				{Op: DiffEqual, Text: "a"},
+				{Op: DiffDelete, Text: "b"},
+				{Op: DiffInsert, Text: "x"},
+				{Op: DiffEqual, Text: "c"},
+			},
+		},
+		{
+			name:   "[Success] from empty",
+			before: "",
+			after:  "a\nb",
+			want:   []DiffLine{{Op: DiffInsert, Text: "a"}, {Op: DiffInsert, Text: "b"}},
+		},
+		{
+			name:   "[Success] to empty",
+			before: "a",
+			after:  "",
+			want:   []DiffLine{{Op: DiffDelete, Text: "a"}},
+		},
+		{
+			name:   "[Success] CRLF normalized",
+			before: "a\r\nb",
+			after:  "a\nb",
+			want:   []DiffLine{{Op: DiffEqual, Text: "a"}, {Op: DiffEqual, Text: "b"}},
+		},
+		{
+			name:   "[Success] insertions and deletions interleaved",
+			before: "a\nb\nc\na\nb\nb\na",
+			after:  "c\nb\na\nb\na\nc",
+			want: []DiffLine{
+				{Op: DiffDelete, Text: "a"},
+				{Op: DiffDelete, Text: "b"},
+				{Op: DiffEqual, Text: "c"},
+				{Op: DiffInsert, Text: "b"},
+				{Op: DiffEqual, Text: "a"},
+				{Op: DiffEqual, Text: "b"},
+				{Op: DiffDelete, Text: "b"},
+				{Op: DiffEqual, Text: "a"},
+				{Op: DiffInsert, Text: "c"},
+			},
+		},
+		{
+			name:   "[Success] both empty",
+			before: "",
+			after:  "",
+			want:   []DiffLine{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffLines(tt.before, tt.after)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("DiffLines() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffLines_Large(t *testing.T) {
+	numbered := func(prefix string, n int) []string {
+		lines := make([]string, n)
+		for i := range lines {
+			lines[i] = prefix + strconv.Itoa(i)
+		}
+		return lines
+	}
+
+	t.Run("[Success] one change in a long text", func(t *testing.T) {
+		before := numbered("line", 50000)
+		after := slices.Clone(before)
+		after[25000] = "changed"
+		got := DiffLines(strings.Join(before, "\n"), strings.Join(after, "\n"))
+		if len(got) != 50001 || got[25000] != (DiffLine{Op: DiffDelete, Text: "line25000"}) || got[25001] != (DiffLine{Op: DiffInsert, Text: "changed"}) {
+			t.Fatalf("unexpected diff around the change: %+v", got[24999:25003])
+		}
+	})
+
+	t.Run("[Success] texts too far apart are shown as replaced", func(t *testing.T) {
+		before := numbered("old", maxDiffEdits)
+		after := numbered("new", maxDiffEdits)
+		got := DiffLines(strings.Join(before, "\n"), strings.Join(after, "\n"))
+		if len(got) != 2*maxDiffEdits || got[0].Op != DiffDelete || got[maxDiffEdits].Op != DiffInsert {
+			t.Fatalf("want every line deleted then inserted, got %d lines", len(got))
+		}
+	})
+}
+
+func TestDiffRevisions(t *testing.T) {
+	from := Revision{
+		NoteID: "n1",
+		Number: 1,
+		Title:  "old",
+		Status: StatusDraft,
+		Sections: []RevisionSection{
+			{FieldID: "f1", FieldLabel: "Summary", FieldOrder: 1, Content: "same"},
+			{FieldID: "f2", FieldLabel: "Detail", FieldOrder: 2, Content: "before"},
+			{FieldID: "f3", FieldLabel: "Removed", FieldOrder: 3, Content: "gone"},
+		},
+	}
+	to := Revision{
+		NoteID: "n1",
+		Number: 2,
+		Title:  "new",
+		Status: StatusPublish,
+		Sections: []RevisionSection{
+			{FieldID: "f1", FieldLabel: "Summary", FieldOrder: 1, Content: "same"},
+			{FieldID: "f2", FieldLabel: "Detail", FieldOrder: 2, Content: "after"},
+		},
+	}
+
+	diff := DiffRevisions(from, to)
+	if diff.From != 1 || diff.To != 2 || diff.NoteID != "n1" {
+		t.Fatalf("unexpected header: %+v", diff)
+	}
+	if diff.StatusFrom != StatusDraft || diff.StatusTo != StatusPublish {
+		t.Fatalf("unexpected status: %s -> %s", diff.StatusFrom, diff.StatusTo)
+	}
+	wantChanged := map[string]bool{"f1": false, "f2": true, "f3": true}
+	if len(diff.Fields) != len(wantChanged) {
+		t.Fatalf("len(Fields) = %d, want %d", len(diff.Fields), len(wantChanged))
+	}
+	for _, f := range diff.Fields {
+		if f.Changed != wantChanged[f.FieldID] {
+			t.Errorf("field %s changed = %v, want %v", f.FieldID, f.Changed, wantChanged[f.FieldID])
+		}
+	}
+	if diff.Fields[2].FieldID != "f3" || diff.Fields[2].Lines[0].Op != DiffDelete {
+		t.Fatalf("removed field should be last and deleted: %+v", diff.Fields[2])
+	}
+}
@@ -19,10 +19,21 @@ type Note struct {
 	Title      string
 	TemplateID string
 	OwnerID    string
-	Status     NoteStatus
-	Sections   []Section
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// WorkspaceID is the workspace of the note's template.
+	WorkspaceID string
+	Status      NoteStatus
+	// TemplateVersion is the template fields version the sections belong to.
+	TemplateVersion int
+	Sections        []Section
+	// Collaborators are the accounts the owner shared the note with. Only a single
+	// loaded note carries them.
+	Collaborators []Collaborator
+	// Version increases on every change and guards updates against lost writes.
+	Version   int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// DeletedAt is set while the note is in the trash.
+	DeletedAt *time.Time
 }
 
 // Section represents note content for a field.
@@ -0,0 +1,87 @@
+package note
+
+import (
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// LinkType is the relation a link from one note to another expresses.
+type LinkType string
+
+// Link type constants.
+const (
+	LinkRelatesTo  LinkType = "relates-to"
+	LinkSupersedes LinkType = "supersedes"
+	LinkDependsOn  LinkType = "depends-on"
+)
+
+// Validate checks if link type is valid.
+func (t LinkType) Validate() error {
+	switch t {
+	case LinkRelatesTo, LinkSupersedes, LinkDependsOn:
+		return nil
+	default:
+		return domainerr.ErrInvalidLinkType
+	}
+}
+
+// Link is a typed, directed reference from a source note to a target note.
+type Link struct {
+	ID        string
+	SourceID  string
+	TargetID  string
+	Type      LinkType
+	CreatedAt time.Time
+}
+
+// NewLink validates and builds a link from sourceID to targetID.
+// ルール: ノートは自分自身にリンクできない。
+func NewLink(sourceID, targetID string, linkType LinkType) (Link, error) {
+	if err := linkType.Validate(); err != nil {
+		return Link{}, err
+	}
+	if strings.TrimSpace(targetID) == "" || sourceID == targetID {
+		return Link{}, domainerr.ErrSelfLink
+	}
+	return Link{SourceID: sourceID, TargetID: targetID, Type: linkType}, nil
+}
+
+// LinkSummary describes the note at the other end of a link:
+// the target for outgoing links, the source for backlinks.
+type LinkSummary struct {
+	LinkID  string
+	Type    LinkType
+	NoteID  string
+	Title   string
+	Status  NoteStatus
+	OwnerID string
+	// CollaboratorIDs are the accounts the note is shared with; they may see the link
+	// even while the note is a draft.
+	CollaboratorIDs []string
+}
+
+// VisibleLinks drops links whose other end the viewer may not read,
+// so links never reveal another account's drafts.
+func VisibleLinks(links []LinkSummary, viewerID string) []LinkSummary {
+	if links == nil {
+		return nil
+	}
+	visible := make([]LinkSummary, 0, len(links))
+	for _, l := range links {
+		if CanView(l.note(), viewerID) {
+			visible = append(visible, l)
+		}
+	}
+	return visible
+}
+
+// note returns the part of the linked note that decides who may read it.
+func (l LinkSummary) note() Note {
+	n := Note{ID: l.NoteID, OwnerID: l.OwnerID, Status: l.Status}
+	for _, id := range l.CollaboratorIDs {
+		n.Collaborators = append(n.Collaborators, Collaborator{NoteID: l.NoteID, AccountID: id})
+	}
+	return n
+}
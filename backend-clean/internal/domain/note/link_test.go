@@ -0,0 +1,64 @@
+package note
+
+import (
+	"errors"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestNewLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		target   string
+		linkType LinkType
+		wantErr  error
+	}{
+		{name: "[Success] relates-to", source: "n1", target: "n2", linkType: LinkRelatesTo},
+		{name: "[Success] supersedes", source: "n1", target: "n2", linkType: LinkSupersedes},
+		{name: "[Success] depends-on", source: "n1", target: "n2", linkType: LinkDependsOn},
+		{name: "[Fail] invalid type", source: "n1", target: "n2", linkType: "blocks", wantErr: domainerr.ErrInvalidLinkType},
+		{name: "[Fail] self link", source: "n1", target: "n1", linkType: LinkRelatesTo, wantErr: domainerr.ErrSelfLink},
+		{name: "[Fail] empty target", source: "n1", target: " ", linkType: LinkRelatesTo, wantErr: domainerr.ErrSelfLink},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link, err := NewLink(tt.source, tt.target, tt.linkType)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if link.SourceID != tt.source || link.TargetID != tt.target || link.Type != tt.linkType {
+				t.Fatalf("unexpected link: %+v", link)
+			}
+		})
+	}
+}
+
+func TestVisibleLinks(t *testing.T) {
+	links := []LinkSummary{
+		{LinkID: "l1", NoteID: "published", OwnerID: "other", Status: StatusPublish},
+		{LinkID: "l2", NoteID: "others-draft", OwnerID: "other", Status: StatusDraft},
+		{LinkID: "l3", NoteID: "own-draft", OwnerID: "viewer", Status: StatusDraft},
+		{LinkID: "l4", NoteID: "shared-draft", OwnerID: "other", Status: StatusDraft, CollaboratorIDs: []string{"someone", "viewer"}},
+		{LinkID: "l5", NoteID: "draft-shared-with-others", OwnerID: "other", Status: StatusDraft, CollaboratorIDs: []string{"someone"}},
+	}
+
+	got := VisibleLinks(links, "viewer")
+	if len(got) != 3 || got[0].LinkID != "l1" || got[1].LinkID != "l3" || got[2].LinkID != "l4" {
+		t.Fatalf("unexpected visible links: %+v", got)
+	}
+	if VisibleLinks(nil, "viewer") != nil {
+		t.Fatalf("nil links should stay nil")
+	}
+	if got := VisibleLinks([]LinkSummary{}, "viewer"); got == nil {
+		t.Fatalf("empty links should stay non-nil")
+	}
+}
@@ -28,7 +28,8 @@ func CanChangeStatus(from, to NoteStatus) error {
 	return domainerr.ErrInvalidStatusChange
 }
 
-// ValidateSections checks that sections match template fields and required fields are filled.
+// ValidateSections checks that sections match template fields, required fields are filled
+// and content fits each field type.
 func ValidateSections(tplFields []template.Field, sections []Section) error {
 	if len(sections) == 0 {
 		return domainerr.ErrSectionsMissing
@@ -50,6 +51,9 @@ func ValidateSections(tplFields []template.Field, sections []Section) error {
 		if f.IsRequired && s.Content == "" {
 			return domainerr.ErrRequiredFieldEmpty
 		}
+		if err := f.ValidateContent(s.Content); err != nil {
+			return err
+		}
 	}
 	// ensure all template fields are covered
 	if len(seen) != len(lookup) {
@@ -58,6 +62,45 @@ func ValidateSections(tplFields []template.Field, sections []Section) error {
 	return nil
 }
 
+// MigrateSections builds the sections of a note for another set of template fields.
+// Each mapping copies the content of a current section into a target field; fields
+// without a mapping start empty, so required ones must be mapped.
+func MigrateSections(current []SectionWithField, fields []template.Field, mappings []FieldMapping) ([]Section, error) {
+	contents := make(map[string]string, len(current))
+	for _, s := range current {
+		contents[s.Section.FieldID] = s.Section.Content
+	}
+	targets := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		targets[f.ID] = true
+	}
+	sourceOf := make(map[string]string, len(mappings))
+	for _, m := range mappings {
+		if _, ok := contents[m.FromFieldID]; !ok {
+			return nil, domainerr.ErrInvalidFieldMapping
+		}
+		if !targets[m.ToFieldID] {
+			return nil, domainerr.ErrInvalidFieldMapping
+		}
+		if _, dup := sourceOf[m.ToFieldID]; dup {
+			return nil, domainerr.ErrInvalidFieldMapping
+		}
+		sourceOf[m.ToFieldID] = m.FromFieldID
+	}
+	sections := make([]Section, 0, len(fields))
+	for _, f := range fields {
+		var content string
+		if from, ok := sourceOf[f.ID]; ok {
+			content = contents[from]
+		}
+		sections = append(sections, Section{FieldID: f.ID, Content: content})
+	}
+	if err := ValidateSections(fields, sections); err != nil {
+		return nil, err
+	}
+	return sections, nil
+}
+
 // ValidateNoteForCreate validates a note creation attempt against template and required fields.
 func ValidateNoteForCreate(title string, tpl template.Template, sections []Section) error {
 	if strings.TrimSpace(title) == "" {
@@ -78,6 +121,31 @@ func ValidateNoteForCreate(title string, tpl template.Template, sections []Secti
 	return nil
 }
 
+// CanView reports whether the viewer may read the note.
+// ルール: 公開済みのノート、自分のノート、または共同編集者として追加されたノートのみ閲覧可能。
+func CanView(n Note, viewerID string) bool {
+	if n.Status == StatusPublish {
+		return true
+	}
+	if strings.TrimSpace(viewerID) == "" {
+		return false
+	}
+	if n.OwnerID == viewerID {
+		return true
+	}
+	_, ok := n.RoleOf(viewerID)
+	return ok
+}
+
+// CheckVersion ensures the note has not changed since the version the client edited.
+// A nil expected version skips the check.
+func CheckVersion(current int, expected *int) error {
+	if expected != nil && *expected != current {
+		return domainerr.ErrConflict
+	}
+	return nil
+}
+
 // ValidateNoteOwnership ensures only owner can mutate a note.
 func ValidateNoteOwnership(noteOwnerID, actorID string) error {
 	if strings.TrimSpace(noteOwnerID) == "" || strings.TrimSpace(actorID) == "" {
@@ -2,6 +2,7 @@ package note
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
@@ -53,6 +54,31 @@ func TestValidateNoteOwnership(t *testing.T) {
 	}
 }
 
+func TestCanView(t *testing.T) {
+	tests := []struct {
+		name     string
+		note     Note
+		viewerID string
+		want     bool
+	}{
+		{name: "[Success] published note is visible to others", note: Note{OwnerID: "owner-1", Status: StatusPublish}, viewerID: "other", want: true},
+		{name: "[Success] own draft is visible", note: Note{OwnerID: "owner-1", Status: StatusDraft}, viewerID: "owner-1", want: true},
+		{name: "[Fail] others' draft is hidden", note: Note{OwnerID: "owner-1", Status: StatusDraft}, viewerID: "other", want: false},
+		{name: "[Fail] draft is hidden from anonymous viewer", note: Note{OwnerID: "", Status: StatusDraft}, viewerID: "", want: false},
+		{name: "[Success] shared draft is visible to a viewer", note: Note{OwnerID: "owner-1", Status: StatusDraft, Collaborators: []Collaborator{{AccountID: "other", Role: RoleViewer}}}, viewerID: "other", want: true},
+		{name: "[Success] shared draft is visible to an editor", note: Note{OwnerID: "owner-1", Status: StatusDraft, Collaborators: []Collaborator{{AccountID: "other", Role: RoleEditor}}}, viewerID: "other", want: true},
+		{name: "[Fail] draft shared with someone else is hidden", note: Note{OwnerID: "owner-1", Status: StatusDraft, Collaborators: []Collaborator{{AccountID: "friend", Role: RoleViewer}}}, viewerID: "other", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanView(tt.note, tt.viewerID); got != tt.want {
+				t.Fatalf("CanView() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCanChangeStatus(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -140,6 +166,20 @@ func TestValidateSections(t *testing.T) {
 			t.Fatalf("expected ErrSectionsMissing, got %v", err)
 		}
 	})
+
+	t.Run("[Fail] content does not match field type", func(t *testing.T) {
+		typed := []template.Field{
+			{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
+			{ID: "f2", Label: "Estimate", Order: 2, Type: template.FieldNumber},
+		}
+		sections := []Section{
+			{FieldID: "f1", Content: "a"},
+			{FieldID: "f2", Content: "three"},
+		}
+		if err := ValidateSections(typed, sections); !errors.Is(err, domainerr.ErrInvalidSectionContent) {
+			t.Fatalf("expected ErrInvalidSectionContent, got %v", err)
+		}
+	})
 }
 
 func TestValidateNoteForCreate(t *testing.T) {
@@ -201,3 +241,104 @@ func TestValidateNoteForCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckVersion(t *testing.T) {
+	v := func(n int) *int { return &n }
+	tests := []struct {
+		name      string
+		current   int
+		expected  *int
+		wantError error
+	}{
+		{name: "[Success] version matches", current: 3, expected: v(3)},
+		{name: "[Success] no expected version", current: 3},
+		{name: "[Fail] stale version", current: 4, expected: v(3), wantError: domainerr.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckVersion(tt.current, tt.expected)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestMigrateSections(t *testing.T) {
+	current := []SectionWithField{
+		{Section: Section{ID: "s1", FieldID: "old-summary", Content: "summary"}},
+		{Section: Section{ID: "s2", FieldID: "old-detail", Content: "detail"}},
+	}
+	fields := []template.Field{
+		{ID: "new-summary", Label: "Summary", Order: 1, IsRequired: true},
+		{ID: "new-detail", Label: "Detail", Order: 2},
+		{ID: "new-extra", Label: "Extra", Order: 3},
+	}
+	tests := []struct {
+		name      string
+		mappings  []FieldMapping
+		want      []Section
+		wantError error
+	}{
+		{
+			name: "[Success] mapped contents carried over and others empty",
+			mappings: []FieldMapping{
+				{FromFieldID: "old-summary", ToFieldID: "new-summary"},
+				{FromFieldID: "old-detail", ToFieldID: "new-detail"},
+			},
+			want: []Section{
+				{FieldID: "new-summary", Content: "summary"},
+				{FieldID: "new-detail", Content: "detail"},
+				{FieldID: "new-extra", Content: ""},
+			},
+		},
+		{
+			name: "[Success] one source feeds two fields",
+			mappings: []FieldMapping{
+				{FromFieldID: "old-summary", ToFieldID: "new-summary"},
+				{FromFieldID: "old-summary", ToFieldID: "new-extra"},
+			},
+			want: []Section{
+				{FieldID: "new-summary", Content: "summary"},
+				{FieldID: "new-detail", Content: ""},
+				{FieldID: "new-extra", Content: "summary"},
+			},
+		},
+		{
+			name:      "[Fail] required field left unmapped",
+			mappings:  []FieldMapping{{FromFieldID: "old-detail", ToFieldID: "new-detail"}},
+			wantError: domainerr.ErrRequiredFieldEmpty,
+		},
+		{
+			name:      "[Fail] unknown source field",
+			mappings:  []FieldMapping{{FromFieldID: "missing", ToFieldID: "new-summary"}},
+			wantError: domainerr.ErrInvalidFieldMapping,
+		},
+		{
+			name:      "[Fail] unknown target field",
+			mappings:  []FieldMapping{{FromFieldID: "old-summary", ToFieldID: "missing"}},
+			wantError: domainerr.ErrInvalidFieldMapping,
+		},
+		{
+			name: "[Fail] target mapped twice",
+			mappings: []FieldMapping{
+				{FromFieldID: "old-summary", ToFieldID: "new-summary"},
+				{FromFieldID: "old-detail", ToFieldID: "new-summary"},
+			},
+			wantError: domainerr.ErrInvalidFieldMapping,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MigrateSections(current, fields, tt.mappings)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+			if tt.wantError == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("MigrateSections() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package note
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+const frontMatterDelimiter = "---"
+
+// RenderMarkdown renders a note as a Markdown document.
+// Front matter carries the template, status, owner and dates, the title becomes an H1
+// and every section an H2 named after its field label, in field order. Content lines that
+// would be read back as an H2 are escaped with a backslash, which ParseMarkdown removes,
+// and code fences left open by a section are closed at its end.
+func RenderMarkdown(n WithMeta) string {
+	var b strings.Builder
+	b.WriteString(frontMatterDelimiter + "\n")
+	writeFrontMatter(&b, "template", yamlString(n.TemplateName))
+	writeFrontMatter(&b, "templateId", yamlString(n.Note.TemplateID))
+	writeFrontMatter(&b, "templateVersion", strconv.Itoa(n.Note.TemplateVersion))
+	writeFrontMatter(&b, "status", yamlString(string(n.Note.Status)))
+	writeFrontMatter(&b, "owner", yamlString(strings.TrimSpace(n.OwnerFirstName+" "+n.OwnerLastName)))
+	writeFrontMatter(&b, "ownerId", yamlString(n.Note.OwnerID))
+	writeFrontMatter(&b, "createdAt", n.Note.CreatedAt.UTC().Format(time.RFC3339))
+	writeFrontMatter(&b, "updatedAt", n.Note.UpdatedAt.UTC().Format(time.RFC3339))
+	b.WriteString(frontMatterDelimiter + "\n\n")
+
+	b.WriteString("# " + n.Note.Title + "\n")
+
+	sections := slices.Clone(n.Sections)
+	slices.SortStableFunc(sections, func(a, b SectionWithField) int { return a.FieldOrder - b.FieldOrder })
+	for _, s := range sections {
+		b.WriteString("\n## " + s.FieldLabel + "\n")
+		if s.Section.Content != "" {
+			b.WriteString("\n" + escapeHeadings(strings.TrimRight(s.Section.Content, "\n")) + "\n")
+		}
+	}
+	return b.String()
+}
+
+func writeFrontMatter(b *strings.Builder, key, value string) {
+	b.WriteString(key + ": " + value + "\n")
+}
+
+// yamlString double-quotes s; Go escape sequences are also valid in YAML double-quoted scalars.
+func yamlString(s string) string {
+	return strconv.Quote(s)
+}
+
+// ParseMarkdown reads a Markdown document into a title and sections for the given fields.
+// Front matter is skipped, the first H1 is the title and each H2 is matched to the field
+// with the same label; headings inside fenced code blocks are content, and so are H2 lines
+// escaped by RenderMarkdown, which are unescaped.
+// ルール: 対応する項目のない見出しと、必須項目の見出しの欠落はエラー。見出しのない任意項目は空になる。
+func ParseMarkdown(src string, fields []template.Field) (string, []Section, error) {
+	lines, err := markdownBody(src)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Fields sharing a label are matched by the order their headings appear in.
+	byLabel := make(map[string][]template.Field, len(fields))
+	for _, f := range sortedFields(fields) {
+		byLabel[f.Label] = append(byLabel[f.Label], f)
+	}
+
+	var (
+		title    string
+		hasTitle bool
+		current  *template.Field
+		body     []string
+		fence    string
+		contents = make(map[string]string, len(fields))
+	)
+	flush := func() {
+		if current != nil {
+			contents[current.ID] = trimBlankLines(body)
+		}
+		body = nil
+	}
+	for _, line := range lines {
+		if fence == "" {
+			if level, text := atxHeading(line); level == 1 && !hasTitle {
+				title, hasTitle = text, true
+				continue
+			} else if level == 2 && hasTitle {
+				flush()
+				candidates := byLabel[text]
+				if len(candidates) == 0 {
+					return "", nil, fmt.Errorf("%w: %q", domainerr.ErrUnmatchedHeading, text)
+				}
+				current, byLabel[text] = &candidates[0], candidates[1:]
+				continue
+			}
+		}
+		inFence := fence != ""
+		fence = nextFence(fence, line)
+		if current == nil {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			if !hasTitle {
+				return "", nil, domainerr.ErrTitleRequired
+			}
+			return "", nil, fmt.Errorf("%w: content must follow a field heading", domainerr.ErrInvalidMarkdown)
+		}
+		if !inFence {
+			line = unescapeHeading(line)
+		}
+		body = append(body, line)
+	}
+	flush()
+
+	if strings.TrimSpace(title) == "" {
+		return "", nil, domainerr.ErrTitleRequired
+	}
+	sections := make([]Section, 0, len(fields))
+	for _, f := range sortedFields(fields) {
+		content, ok := contents[f.ID]
+		if !ok && f.IsRequired {
+			return "", nil, fmt.Errorf("%w: %q", domainerr.ErrMissingHeading, f.Label)
+		}
+		sections = append(sections, Section{FieldID: f.ID, Content: content})
+	}
+	return title, sections, nil
+}
+
+// markdownBody splits the document into lines without its front matter.
+func markdownBody(src string) ([]string, error) {
+	src = strings.TrimPrefix(src, "\ufeff")
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	lines := strings.Split(src, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return lines, nil
+	}
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == frontMatterDelimiter {
+			return lines[i+1:], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: front matter is not closed", domainerr.ErrInvalidMarkdown)
+}
+
+// atxHeading returns the level and text of an ATX heading line, or level 0.
+func atxHeading(line string) (int, string) {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return 0, ""
+	}
+	level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+	if level == 0 || level > 6 {
+		return 0, ""
+	}
+	rest := trimmed[level:]
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+		return 0, ""
+	}
+	text := strings.TrimSpace(rest)
+	// An optional closing sequence of #s is not part of the heading text.
+	if closed := strings.TrimRight(text, "#"); closed != text && (closed == "" || strings.HasSuffix(closed, " ")) {
+		text = strings.TrimSpace(closed)
+	}
+	return level, text
+}
+
+// escapeHeadings prefixes a backslash to content lines outside code fences that ParseMarkdown
+// would take for an H2. Lines that are such a heading behind backslashes get one more, so
+// unescapeHeading restores every line exactly. A code fence the content leaves open is
+// closed, or the headings of the following sections would be read as code.
+func escapeHeadings(content string) string {
+	lines := strings.Split(content, "\n")
+	fence := ""
+	for i, line := range lines {
+		if fence == "" {
+			if indent, rest, ok := headingIndent(line); ok && escapedLevel(rest) == 2 {
+				lines[i] = indent + `\` + rest
+			}
+		}
+		fence = nextFence(fence, line)
+	}
+	if fence != "" {
+		lines = append(lines, fence)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// unescapeHeading removes the backslash escapeHeadings added to line, if any.
+func unescapeHeading(line string) string {
+	indent, rest, ok := headingIndent(line)
+	if !ok || !strings.HasPrefix(rest, `\`) || escapedLevel(rest) != 2 {
+		return line
+	}
+	return indent + rest[1:]
+}
+
+// headingIndent splits the indentation a heading may have off line; ok is false for
+// indented code.
+func headingIndent(line string) (indent, rest string, ok bool) {
+	rest = strings.TrimLeft(line, " ")
+	indent = line[:len(line)-len(rest)]
+	return indent, rest, len(indent) <= 3
+}
+
+// escapedLevel returns the heading level of s once leading backslashes are removed.
+func escapedLevel(s string) int {
+	level, _ := atxHeading(strings.TrimLeft(s, `\`))
+	return level
+}
+
+// nextFence tracks fenced code blocks: it returns the open fence after line, or "" outside one.
+func nextFence(open, line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return open
+	}
+	for _, c := range []string{"`", "~"} {
+		n := len(trimmed) - len(strings.TrimLeft(trimmed, c))
+		if n < 3 {
+			continue
+		}
+		marker := strings.Repeat(c, n)
+		if open == "" {
+			return marker
+		}
+		if strings.HasPrefix(marker, open) && strings.TrimSpace(trimmed[n:]) == "" {
+			return ""
+		}
+	}
+	return open
+}
+
+func trimBlankLines(lines []string) string {
+	start, end := 0, len(lines)
+	for start < end && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+	for end > start && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	return strings.Join(lines[start:end], "\n")
+}
+
+func sortedFields(fields []template.Field) []template.Field {
+	sorted := slices.Clone(fields)
+	slices.SortStableFunc(sorted, func(a, b template.Field) int { return a.Order - b.Order })
+	return sorted
+}
@@ -0,0 +1,210 @@
+package note
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	created := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	n := WithMeta{
+		Note: Note{
+			ID:              "n1",
+			Title:           "Cache design",
+			TemplateID:      "tpl-1",
+			TemplateVersion: 2,
+			OwnerID:         "owner-1",
+			Status:          StatusPublish,
+			CreatedAt:       created,
+			UpdatedAt:       created.Add(time.Hour),
+		},
+		TemplateName:   `Design "ADR"`,
+		OwnerFirstName: "Taro",
+		OwnerLastName:  "Yamada",
+		Sections: []SectionWithField{
+			{Section: Section{FieldID: "f2", Content: "Use Redis.\n"}, FieldLabel: "Decision", FieldOrder: 2},
+			{Section: Section{FieldID: "f1", Content: "Slow reads"}, FieldLabel: "Problem", FieldOrder: 1},
+			{Section: Section{FieldID: "f3"}, FieldLabel: "Notes", FieldOrder: 3},
+		},
+	}
+
+	want := `---
+template: "Design \"ADR\""
+templateId: "tpl-1"
+templateVersion: 2
+status: "Publish"
+owner: "Taro Yamada"
+ownerId: "owner-1"
+createdAt: 2026-10-01T09:00:00Z
+updatedAt: 2026-10-01T10:00:00Z
+---
+
+# Cache design
+
+## Problem
+
+Slow reads
+
+## Decision
+
+Use Redis.
+
+## Notes
+`
+	if got := RenderMarkdown(n); got != want {
+		t.Fatalf("unexpected markdown:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestParseMarkdown(t *testing.T) {
+	fields := []template.Field{
+		{ID: "f2", Label: "Decision", Order: 2},
+		{ID: "f1", Label: "Problem", Order: 1, IsRequired: true},
+		{ID: "f3", Label: "Notes", Order: 3},
+	}
+	tests := []struct {
+		name         string
+		src          string
+		wantTitle    string
+		wantSections []Section
+		wantErr      error
+	}{
+		{
+			name:      "[Success] headings in any order with front matter",
+			src:       "---\ntemplate: \"x\"\n---\n\n# Cache design\n\n## Decision\n\nUse Redis.\n\n## Problem\n\nSlow reads\non startup\n",
+			wantTitle: "Cache design",
+			wantSections: []Section{
+				{FieldID: "f1", Content: "Slow reads\non startup"},
+				{FieldID: "f2", Content: "Use Redis."},
+				{FieldID: "f3", Content: ""},
+			},
+		},
+		{
+			name:      "[Success] headings inside code fences and deeper headings are content",
+			src:       "# T\r\n## Problem\r\n```md\r\n## Decision\r\n```\r\n### Detail\r\n",
+			wantTitle: "T",
+			wantSections: []Section{
+				{FieldID: "f1", Content: "```md\n## Decision\n```\n### Detail"},
+				{FieldID: "f2", Content: ""},
+				{FieldID: "f3", Content: ""},
+			},
+		},
+		{
+			name:      "[Success] closing hashes are not part of the heading",
+			src:       "# T #\n## Problem ##\nx\n",
+			wantTitle: "T",
+			wantSections: []Section{
+				{FieldID: "f1", Content: "x"},
+				{FieldID: "f2", Content: ""},
+				{FieldID: "f3", Content: ""},
+			},
+		},
+		{name: "[Fail] unmatched heading", src: "# T\n## Problem\nx\n## Risks\ny\n", wantErr: domainerr.ErrUnmatchedHeading},
+		{name: "[Fail] duplicate heading", src: "# T\n## Problem\nx\n## Problem\ny\n", wantErr: domainerr.ErrUnmatchedHeading},
+		{name: "[Fail] missing required heading", src: "# T\n## Decision\nx\n", wantErr: domainerr.ErrMissingHeading},
+		{name: "[Fail] missing title", src: "## Problem\nx\n", wantErr: domainerr.ErrTitleRequired},
+		{name: "[Fail] content before first heading", src: "# T\nintro\n## Problem\nx\n", wantErr: domainerr.ErrInvalidMarkdown},
+		{name: "[Fail] unclosed front matter", src: "---\ntemplate: x\n# T\n", wantErr: domainerr.ErrInvalidMarkdown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			title, sections, err := ParseMarkdown(tt.src, fields)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if title != tt.wantTitle {
+				t.Fatalf("title = %q, want %q", title, tt.wantTitle)
+			}
+			if !reflect.DeepEqual(sections, tt.wantSections) {
+				t.Fatalf("sections = %+v, want %+v", sections, tt.wantSections)
+			}
+		})
+	}
+}
+
+func TestParseMarkdown_NamesHeadingInError(t *testing.T) {
+	fields := []template.Field{{ID: "f1", Label: "Problem", Order: 1, IsRequired: true}}
+	_, _, err := ParseMarkdown("# T\n## Risks\n", fields)
+	if err == nil || !strings.Contains(err.Error(), `"Risks"`) {
+		t.Fatalf("error should name the heading, got %v", err)
+	}
+}
+
+func TestRenderMarkdown_EscapesHeadingsInContent(t *testing.T) {
+	n := WithMeta{
+		Note: Note{Title: "T"},
+		Sections: []SectionWithField{
+			{Section: Section{FieldID: "f1", Content: "## Decision\n\\## x\n```\n## kept\n```"}, FieldLabel: "Problem", FieldOrder: 1},
+		},
+	}
+	want := "## Problem\n\n\\## Decision\n\\\\## x\n```\n## kept\n```\n"
+	if got := RenderMarkdown(n); !strings.HasSuffix(got, want) {
+		t.Fatalf("unexpected markdown:\n%s\nwant suffix:\n%s", got, want)
+	}
+}
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	fields := []template.Field{
+		{ID: "f1", Label: "Problem", Order: 1, IsRequired: true},
+		{ID: "f2", Label: "Decision", Order: 2, Type: template.FieldMarkdown},
+	}
+	n := WithMeta{
+		Note: Note{Title: "Round trip", Status: StatusDraft},
+		Sections: []SectionWithField{
+			{Section: Section{FieldID: "f1", Content: "line 1\n\nline 2"}, FieldLabel: "Problem", FieldOrder: 1},
+			{Section: Section{FieldID: "f2", Content: "```go\n## not a heading\n```\n## Decision\n  ## Problem ##\n\\## already escaped\n### deeper\n    ## indented code"}, FieldLabel: "Decision", FieldOrder: 2},
+		},
+	}
+	title, sections, err := ParseMarkdown(RenderMarkdown(n), fields)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if title != n.Note.Title {
+		t.Fatalf("title = %q, want %q", title, n.Note.Title)
+	}
+	for i, s := range sections {
+		if s.Content != n.Sections[i].Section.Content {
+			t.Fatalf("section %d = %q, want %q", i, s.Content, n.Sections[i].Section.Content)
+		}
+	}
+}
+
+func TestMarkdownRoundTrip_UnterminatedFence(t *testing.T) {
+	fields := []template.Field{
+		{ID: "f1", Label: "Problem", Order: 1, IsRequired: true, Type: template.FieldMarkdown},
+		{ID: "f2", Label: "Decision", Order: 2, IsRequired: true},
+		{ID: "f3", Label: "Notes", Order: 3, Type: template.FieldMarkdown},
+	}
+	n := WithMeta{
+		Note: Note{Title: "Open fence", Status: StatusDraft},
+		Sections: []SectionWithField{
+			{Section: Section{FieldID: "f1", Content: "before\n~~~~sh\n## inside the fence"}, FieldLabel: "Problem", FieldOrder: 1},
+			{Section: Section{FieldID: "f2", Content: "use Postgres"}, FieldLabel: "Decision", FieldOrder: 2},
+			{Section: Section{FieldID: "f3", Content: "```\nnever closed either"}, FieldLabel: "Notes", FieldOrder: 3},
+		},
+	}
+	_, sections, err := ParseMarkdown(RenderMarkdown(n), fields)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Every section comes back under its own heading, with its fence closed.
+	want := []string{"before\n~~~~sh\n## inside the fence\n~~~~", "use Postgres", "```\nnever closed either\n```"}
+	for i, s := range sections {
+		if s.Content != want[i] {
+			t.Errorf("section %d = %q, want %q", i, s.Content, want[i])
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package note
+
+import (
+	"sort"
+	"time"
+)
+
+// Revision is an immutable snapshot of a note taken after each change.
+type Revision struct {
+	ID        string
+	NoteID    string
+	Number    int
+	Title     string
+	Status    NoteStatus
+	ActorID   string
+	Sections  []RevisionSection
+	CreatedAt time.Time
+}
+
+// RevisionSection is the content of one template field at the time of a revision.
+// Field label and order are copied so the snapshot survives later template edits.
+type RevisionSection struct {
+	FieldID    string
+	FieldLabel string
+	FieldOrder int
+	Content    string
+}
+
+// NewRevision snapshots the current state of a note on behalf of actorID.
+// The revision number and timestamp are assigned by the repository.
+func NewRevision(n WithMeta, actorID string) Revision {
+	sections := make([]RevisionSection, 0, len(n.Sections))
+	for _, s := range n.Sections {
+		sections = append(sections, RevisionSection{
+			FieldID:    s.Section.FieldID,
+			FieldLabel: s.FieldLabel,
+			FieldOrder: s.FieldOrder,
+			Content:    s.Section.Content,
+		})
+	}
+	sort.SliceStable(sections, func(i, j int) bool { return sections[i].FieldOrder < sections[j].FieldOrder })
+	return Revision{
+		NoteID:   n.Note.ID,
+		Title:    n.Note.Title,
+		Status:   n.Note.Status,
+		ActorID:  actorID,
+		Sections: sections,
+	}
+}
@@ -0,0 +1,22 @@
+package note
+
+import "testing"
+
+func TestNewRevision(t *testing.T) {
+	n := WithMeta{
+		Note: Note{ID: "n1", Title: "title", Status: StatusPublish},
+		Sections: []SectionWithField{
+			{Section: Section{ID: "s2", FieldID: "f2", Content: "second"}, FieldLabel: "B", FieldOrder: 2},
+			{Section: Section{ID: "s1", FieldID: "f1", Content: "first"}, FieldLabel: "A", FieldOrder: 1},
+		},
+	}
+
+	rev := NewRevision(n, "actor-1")
+
+	if rev.NoteID != "n1" || rev.Title != "title" || rev.Status != StatusPublish || rev.ActorID != "actor-1" {
+		t.Fatalf("unexpected revision: %+v", rev)
+	}
+	if len(rev.Sections) != 2 || rev.Sections[0].FieldID != "f1" || rev.Sections[1].Content != "second" {
+		t.Fatalf("sections not snapshotted in field order: %+v", rev.Sections)
+	}
+}
@@ -0,0 +1,155 @@
+package note
+
+import (
+	"strings"
+	"unicode"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// SearchTarget is a part of a note that a keyword query is matched against.
+type SearchTarget string
+
+// Search target constants.
+const (
+	SearchTitle   SearchTarget = "title"
+	SearchContent SearchTarget = "content"
+	SearchLabel   SearchTarget = "label"
+)
+
+// Validate checks if search target is valid.
+func (t SearchTarget) Validate() error {
+	switch t {
+	case SearchTitle, SearchContent, SearchLabel:
+		return nil
+	default:
+		return domainerr.ErrInvalidSearchTarget
+	}
+}
+
+// Searches reports whether Query should be matched against target.
+func (f Filters) Searches(target SearchTarget) bool {
+	if len(f.SearchIn) == 0 {
+		return true
+	}
+	for _, t := range f.SearchIn {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchResult is a note matched by a keyword search.
+type SearchResult struct {
+	Note WithMeta
+	// Rank orders results; higher is more relevant.
+	Rank    float64
+	Snippet Snippet
+}
+
+// snippetRadius is how many characters of context a snippet keeps around the first match.
+const snippetRadius = 40
+
+const snippetEllipsis = "…"
+
+// Highlight is a matched range [Start, End) of a snippet, counted in characters (runes).
+type Highlight struct {
+	Start int
+	End   int
+}
+
+// Snippet is an excerpt of note text around a keyword match.
+type Snippet struct {
+	Text       string
+	Highlights []Highlight
+}
+
+// BestSnippet builds a snippet from the first candidate that contains query.
+// When none does, the first non-empty candidate is excerpted without highlights.
+func BestSnippet(query string, candidates ...string) Snippet {
+	fallback := ""
+	for _, c := range candidates {
+		if c == "" {
+			continue
+		}
+		s := NewSnippet(c, query)
+		if len(s.Highlights) > 0 {
+			return s
+		}
+		if fallback == "" {
+			fallback = c
+		}
+	}
+	return NewSnippet(fallback, query)
+}
+
+// NewSnippet excerpts text around the first case-insensitive match of query and
+// highlights every match inside the excerpt.
+// Matching is done per character rather than per word so that Japanese text,
+// which has no spaces between words, is handled the same as other languages.
+func NewSnippet(text, query string) Snippet {
+	runes := []rune(text)
+	for i, r := range runes {
+		if unicode.IsSpace(r) {
+			runes[i] = ' '
+		}
+	}
+	folded := foldRunes(runes)
+	needle := foldRunes([]rune(strings.TrimSpace(query)))
+
+	first := indexRunes(folded, needle, 0)
+	start, end := 0, min(len(runes), 2*snippetRadius)
+	if first >= 0 {
+		start = max(0, first-snippetRadius)
+		end = min(len(runes), first+len(needle)+snippetRadius)
+	}
+
+	var b strings.Builder
+	offset := 0
+	if start > 0 {
+		b.WriteString(snippetEllipsis)
+		offset = len([]rune(snippetEllipsis))
+	}
+	b.WriteString(string(runes[start:end]))
+	if end < len(runes) {
+		b.WriteString(snippetEllipsis)
+	}
+
+	var highlights []Highlight
+	for i := first; i >= 0; i = indexRunes(folded[:end], needle, i+len(needle)) {
+		highlights = append(highlights, Highlight{
+			Start: i - start + offset,
+			End:   i - start + offset + len(needle),
+		})
+	}
+	return Snippet{Text: b.String(), Highlights: highlights}
+}
+
+func foldRunes(runes []rune) []rune {
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		folded[i] = unicode.ToLower(r)
+	}
+	return folded
+}
+
+// indexRunes returns the index of needle in haystack at or after from, or -1.
+func indexRunes(haystack, needle []rune, from int) int {
+	if len(needle) == 0 {
+		return -1
+	}
+	for i := from; i+len(needle) <= len(haystack); i++ {
+		match := true
+		for j, r := range needle {
+			if haystack[i+j] != r {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,109 @@
+package note
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestSearchTarget_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  SearchTarget
+		wantErr error
+	}{
+		{name: "[Success] title", target: SearchTitle},
+		{name: "[Success] content", target: SearchContent},
+		{name: "[Success] label", target: SearchLabel},
+		{name: "[Fail] unknown", target: "body", wantErr: domainerr.ErrInvalidSearchTarget},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.target.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFilters_Searches(t *testing.T) {
+	all := Filters{}
+	if !all.Searches(SearchTitle) || !all.Searches(SearchContent) || !all.Searches(SearchLabel) {
+		t.Fatalf("empty SearchIn should search every target")
+	}
+	only := Filters{SearchIn: []SearchTarget{SearchContent}}
+	if only.Searches(SearchTitle) || !only.Searches(SearchContent) || only.Searches(SearchLabel) {
+		t.Fatalf("SearchIn should limit targets: %+v", only)
+	}
+}
+
+func TestNewSnippet(t *testing.T) {
+	long := strings.Repeat("あ", 50) + "設計メモ" + strings.Repeat("い", 50)
+	tests := []struct {
+		name  string
+		text  string
+		query string
+		want  Snippet
+	}{
+		{
+			name:  "[Success] japanese match without word boundaries",
+			text:  "今日は設計メモを書いた",
+			query: "設計",
+			want:  Snippet{Text: "今日は設計メモを書いた", Highlights: []Highlight{{Start: 3, End: 5}}},
+		},
+		{
+			name:  "[Success] case-insensitive and every match highlighted",
+			text:  "Go and GO\nand go",
+			query: "go",
+			want: Snippet{
+				Text:       "Go and GO and go",
+				Highlights: []Highlight{{Start: 0, End: 2}, {Start: 7, End: 9}, {Start: 14, End: 16}},
+			},
+		},
+		{
+			name:  "[Success] long text is trimmed around the match",
+			text:  long,
+			query: "メモ",
+			want: Snippet{
+				Text:       "…" + strings.Repeat("あ", 38) + "設計メモ" + strings.Repeat("い", 40) + "…",
+				Highlights: []Highlight{{Start: 41, End: 43}},
+			},
+		},
+		{
+			name:  "[Success] no match keeps the head of the text",
+			text:  long,
+			query: "無い",
+			want:  Snippet{Text: strings.Repeat("あ", 50) + "設計メモ" + strings.Repeat("い", 26) + "…"},
+		},
+		{
+			name:  "[Success] empty query",
+			text:  "memo",
+			query: " ",
+			want:  Snippet{Text: "memo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSnippet(tt.text, tt.query)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("want %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBestSnippet(t *testing.T) {
+	got := BestSnippet("原因", "", "障害の振り返り", "原因")
+	if got.Text != "原因" || len(got.Highlights) != 1 {
+		t.Fatalf("want the matching candidate, got %+v", got)
+	}
+	got = BestSnippet("原因", "", "障害の振り返り")
+	if got.Text != "障害の振り返り" || len(got.Highlights) != 0 {
+		t.Fatalf("want the first non-empty candidate, got %+v", got)
+	}
+}
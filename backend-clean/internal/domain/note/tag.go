@@ -0,0 +1,156 @@
+package note
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// MaxTagLength is the longest tag in characters (runes), after normalization.
+const MaxTagLength = 32
+
+// MaxTagsPerNote caps how many tags a note can carry.
+const MaxTagsPerNote = 20
+
+// Tag is a value object for a user-defined label that groups notes across templates.
+type Tag string
+
+// ParseTag normalizes and validates a tag.
+// ルール: 前後の空白を除き小文字にそろえ、途中の空白の並びは "-" 1つにまとめる。
+// カンマと制御文字は使えない（一覧の絞り込みで区切りと紛れないようにする）。
+func ParseTag(raw string) (Tag, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(raw)), "-")
+	if normalized == "" || utf8.RuneCountInString(normalized) > MaxTagLength {
+		return "", domainerr.ErrInvalidTag
+	}
+	for _, r := range normalized {
+		if r == ',' || unicode.IsControl(r) {
+			return "", domainerr.ErrInvalidTag
+		}
+	}
+	return Tag(normalized), nil
+}
+
+func (t Tag) String() string {
+	return string(t)
+}
+
+// ParseTags normalizes tags, drops duplicates and returns them sorted.
+func ParseTags(raws []string) ([]Tag, error) {
+	tags := make([]Tag, 0, len(raws))
+	for _, raw := range raws {
+		t, err := ParseTag(raw)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	slices.Sort(tags)
+	return slices.Compact(tags), nil
+}
+
+// NewTags builds the tags of a new note.
+func NewTags(raws []string) ([]Tag, error) {
+	tags, err := ParseTags(raws)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) > MaxTagsPerNote {
+		return nil, domainerr.ErrTooManyTags
+	}
+	return tags, nil
+}
+
+// ChangeTags removes and then adds tags to current and returns the result sorted.
+// Removing a tag the note does not have is not an error.
+func ChangeTags(current []Tag, add, remove []string) ([]Tag, error) {
+	added, err := ParseTags(add)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := ParseTags(remove)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]Tag, 0, len(current)+len(added))
+	for _, t := range current {
+		if !slices.Contains(removed, t) {
+			tags = append(tags, t)
+		}
+	}
+	tags = append(tags, added...)
+	slices.Sort(tags)
+	tags = slices.Compact(tags)
+	if len(tags) > MaxTagsPerNote {
+		return nil, domainerr.ErrTooManyTags
+	}
+	return tags, nil
+}
+
+// TagMatch selects how a list of tags in Filters is matched.
+type TagMatch string
+
+// Tag match constants.
+const (
+	// TagMatchAny lists notes with at least one of the tags.
+	TagMatchAny TagMatch = "any"
+	// TagMatchAll lists notes with every one of the tags.
+	TagMatchAll TagMatch = "all"
+)
+
+// Validate checks if tag match is valid. Empty means TagMatchAny.
+func (m TagMatch) Validate() error {
+	switch m {
+	case "", TagMatchAny, TagMatchAll:
+		return nil
+	default:
+		return domainerr.ErrInvalidTagMatch
+	}
+}
+
+// TagCount is a tag with the number of notes carrying it.
+type TagCount struct {
+	Tag   Tag
+	Count int
+}
+
+// TagFilters for counting tag usage.
+type TagFilters struct {
+	OwnerID *string
+	// ViewerID limits the counted notes to those the account may read (published, owned or shared).
+	// Empty means an anonymous viewer, who may only read published notes.
+	ViewerID string
+	// WorkspaceID limits the counted notes to one workspace. It is required; reading without one fails.
+	WorkspaceID string
+	// Limit caps the number of tags, most used first.
+	Limit int
+}
+
+// NormalizeTags checks TagMatch and normalizes Tags as ParseTags does, so that
+// filtering by "Auth" finds notes tagged "auth".
+func (f Filters) NormalizeTags() (Filters, error) {
+	if err := f.TagMatch.Validate(); err != nil {
+		return f, err
+	}
+	if len(f.Tags) == 0 {
+		return f, nil
+	}
+	raws := make([]string, 0, len(f.Tags))
+	for _, t := range f.Tags {
+		raws = append(raws, string(t))
+	}
+	tags, err := ParseTags(raws)
+	if err != nil {
+		return f, err
+	}
+	f.Tags = tags
+	return f, nil
+}
+
+// MatchesAllTags reports whether notes must carry every tag rather than any of them.
+func (f Filters) MatchesAllTags() bool {
+	return f.TagMatch == TagMatchAll
+}
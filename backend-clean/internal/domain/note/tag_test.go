@@ -0,0 +1,159 @@
+package note
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Tag
+		wantErr error
+	}{
+		{name: "[Success] lowercases and trims", raw: "  Auth ", want: "auth"},
+		{name: "[Success] joins inner spaces", raw: "Billing  Flow\tv2", want: "billing-flow-v2"},
+		{name: "[Success] japanese", raw: "認証", want: "認証"},
+		{name: "[Success] max length in runes", raw: strings.Repeat("あ", MaxTagLength), want: Tag(strings.Repeat("あ", MaxTagLength))},
+		{name: "[Fail] empty", raw: "  ", wantErr: domainerr.ErrInvalidTag},
+		{name: "[Fail] too long", raw: strings.Repeat("a", MaxTagLength+1), wantErr: domainerr.ErrInvalidTag},
+		{name: "[Fail] comma", raw: "a,b", wantErr: domainerr.ErrInvalidTag},
+		{name: "[Fail] control character", raw: "a\x00b", wantErr: domainerr.ErrInvalidTag},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTag(tt.raw)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNewTags(t *testing.T) {
+	many := make([]string, 0, MaxTagsPerNote+1)
+	for i := range MaxTagsPerNote + 1 {
+		many = append(many, fmt.Sprintf("t%d", i))
+	}
+	tests := []struct {
+		name    string
+		raws    []string
+		want    []Tag
+		wantErr error
+	}{
+		{name: "[Success] sorted without duplicates", raws: []string{"b", "A", "a"}, want: []Tag{"a", "b"}},
+		{name: "[Success] none", raws: nil, want: []Tag{}},
+		{name: "[Success] duplicates do not count toward the limit", raws: append(many[:MaxTagsPerNote:MaxTagsPerNote], "T0"), want: nil},
+		{name: "[Fail] invalid tag", raws: []string{"ok", ""}, wantErr: domainerr.ErrInvalidTag},
+		{name: "[Fail] too many", raws: many, wantErr: domainerr.ErrTooManyTags},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTags(tt.raws)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.want != nil && !slices.Equal(got, tt.want) {
+				t.Fatalf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestChangeTags(t *testing.T) {
+	full := make([]Tag, 0, MaxTagsPerNote)
+	for i := range MaxTagsPerNote {
+		full = append(full, Tag(fmt.Sprintf("t%02d", i)))
+	}
+	tests := []struct {
+		name    string
+		current []Tag
+		add     []string
+		remove  []string
+		want    []Tag
+		wantErr error
+	}{
+		{name: "[Success] add and remove", current: []Tag{"auth", "billing"}, add: []string{"API"}, remove: []string{"Billing"}, want: []Tag{"api", "auth"}},
+		{name: "[Success] add existing tag", current: []Tag{"auth"}, add: []string{"auth"}, want: []Tag{"auth"}},
+		{name: "[Success] remove missing tag", current: []Tag{"auth"}, remove: []string{"api"}, want: []Tag{"auth"}},
+		{name: "[Success] re-add removed tag", current: []Tag{"auth"}, add: []string{"auth"}, remove: []string{"auth"}, want: []Tag{"auth"}},
+		{name: "[Success] swap at the limit", current: full, add: []string{"new"}, remove: []string{"t00"}, want: append([]Tag{"new"}, full[1:]...)},
+		{name: "[Fail] invalid tag", current: []Tag{"auth"}, add: []string{"a,b"}, wantErr: domainerr.ErrInvalidTag},
+		{name: "[Fail] too many", current: full, add: []string{"new"}, wantErr: domainerr.ErrTooManyTags},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ChangeTags(tt.current, tt.add, tt.remove)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFilters_NormalizeTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters Filters
+		want    []Tag
+		wantAll bool
+		wantErr error
+	}{
+		{name: "[Success] normalizes tags", filters: Filters{Tags: []Tag{"Auth", "auth", " API "}}, want: []Tag{"api", "auth"}},
+		{name: "[Success] match all", filters: Filters{Tags: []Tag{"auth"}, TagMatch: TagMatchAll}, want: []Tag{"auth"}, wantAll: true},
+		{name: "[Success] no tags", filters: Filters{TagMatch: TagMatchAny}, want: []Tag{}},
+		{name: "[Fail] invalid match", filters: Filters{TagMatch: "some"}, wantErr: domainerr.ErrInvalidTagMatch},
+		{name: "[Fail] invalid tag", filters: Filters{Tags: []Tag{""}}, wantErr: domainerr.ErrInvalidTag},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filters.NormalizeTags()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("want %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got.Tags, tt.want) || got.MatchesAllTags() != tt.wantAll {
+				t.Fatalf("unexpected filters: %+v", got)
+			}
+		})
+	}
+}
@@ -1,20 +1,52 @@
 // Package note holds note domain models.
 package note
 
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/template"
+)
+
 // Filters for listing notes.
 type Filters struct {
 	Status     *NoteStatus
 	TemplateID *string
 	OwnerID    *string
-	Query      *string
+	// Query is a keyword matched as a case-insensitive substring, which also works
+	// for Japanese text without word boundaries.
+	Query *string
+	// SearchIn limits which parts of a note Query matches. Empty means all targets.
+	SearchIn []SearchTarget
+	// Tags limits results to notes carrying the tags, matched as TagMatch says.
+	Tags     []Tag
+	TagMatch TagMatch
+	// ViewerID limits results to notes the account may read (published, owned or shared).
+	// Empty means an anonymous viewer, who may only read published notes.
+	ViewerID string
+	// WorkspaceID limits results to one workspace. It is required; reading without one fails.
+	WorkspaceID string
+	// Limit caps the number of rows; zero means no limit.
+	Limit int
+	// After resumes the list strictly after this position in (updated_at DESC, id DESC) order.
+	After *page.Cursor
 }
 
 // SectionWithField represents a section with template field metadata.
 type SectionWithField struct {
-	Section    Section
-	FieldLabel string
-	FieldOrder int
-	IsRequired bool
+	Section      Section
+	FieldLabel   string
+	FieldOrder   int
+	IsRequired   bool
+	FieldType    template.FieldType
+	FieldOptions []string
+}
+
+// FieldMapping carries the content of a field of the note's current template version
+// over to a field of the latest version.
+type FieldMapping struct {
+	FromFieldID string
+	ToFieldID   string
 }
 
 // WithMeta represents a note with template metadata.
@@ -25,4 +57,24 @@ type WithMeta struct {
 	OwnerLastName  string
 	OwnerThumbnail *string
 	Sections       []SectionWithField
+	// Tags are sorted.
+	Tags []Tag
+	// Links and Backlinks are only loaded for a single note; nil means not loaded.
+	Links     []LinkSummary
+	Backlinks []LinkSummary
+}
+
+// Cursor returns the keyset position of the note in list order.
+func (n WithMeta) Cursor() page.Cursor {
+	return page.Cursor{UpdatedAt: n.Note.UpdatedAt, ID: n.Note.ID}
+}
+
+// TrashCursor returns the keyset position of a trashed note in trash order,
+// which is (deleted_at DESC, id DESC).
+func (n WithMeta) TrashCursor() page.Cursor {
+	var at time.Time
+	if n.Note.DeletedAt != nil {
+		at = *n.Note.DeletedAt
+	}
+	return page.Cursor{UpdatedAt: at, ID: n.Note.ID}
 }
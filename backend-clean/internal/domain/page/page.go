@@ -0,0 +1,93 @@
+// Package page holds keyset pagination shared by list use cases.
+package page
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// Page size bounds.
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// Cursor is the keyset position of the last item of a page.
+// Lists are ordered by (updated_at DESC, id DESC), so the next page starts strictly after it.
+type Cursor struct {
+	UpdatedAt time.Time
+	ID        string
+}
+
+// Encode returns the cursor as an opaque token for clients.
+func (c Cursor) Encode() string {
+	raw := c.UpdatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode parses a token produced by Encode.
+func Decode(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domainerr.ErrInvalidCursor
+	}
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, domainerr.ErrInvalidCursor
+	}
+	updatedAt, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, domainerr.ErrInvalidCursor
+	}
+	return &Cursor{UpdatedAt: updatedAt, ID: id}, nil
+}
+
+// NormalizeLimit applies the default to unset sizes and caps oversized ones.
+func NormalizeLimit(limit int) int {
+	switch {
+	case limit <= 0:
+		return DefaultLimit
+	case limit > MaxLimit:
+		return MaxLimit
+	default:
+		return limit
+	}
+}
+
+// Page is one slice of a keyset-paginated list.
+type Page[T any] struct {
+	Items []T
+	// NextCursor points after the last item; nil when there are no more items.
+	NextCursor *Cursor
+}
+
+// HasMore reports whether another page follows.
+func (p Page[T]) HasMore() bool {
+	return p.NextCursor != nil
+}
+
+// Build cuts items fetched with limit+1 rows down to limit and derives the next cursor.
+// The extra row only signals that another page exists.
+func Build[T any](items []T, limit int, cursorOf func(T) Cursor) Page[T] {
+	if len(items) <= limit {
+		return Page[T]{Items: items}
+	}
+	items = items[:limit]
+	next := cursorOf(items[limit-1])
+	return Page[T]{Items: items, NextCursor: &next}
+}
+
+// Fetch loads one page of at most limit items, normalized by NormalizeLimit. It asks
+// fetch for one row more than that: the extra row tells whether another page follows
+// without a COUNT query.
+func Fetch[T any](limit int, fetch func(limit int) ([]T, error), cursorOf func(T) Cursor) (Page[T], error) {
+	limit = NormalizeLimit(limit)
+	items, err := fetch(limit + 1)
+	if err != nil {
+		return Page[T]{}, err
+	}
+	return Build(items, limit, cursorOf), nil
+}
@@ -0,0 +1,116 @@
+package page
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	c := Cursor{UpdatedAt: time.Date(2026, 10, 17, 9, 30, 0, 123456000, time.FixedZone("JST", 9*60*60)), ID: "00000000-0000-0000-0000-000000000001"}
+	got, err := Decode(c.Encode())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.UpdatedAt.Equal(c.UpdatedAt) || got.ID != c.ID {
+		t.Fatalf("want %+v, got %+v", c, got)
+	}
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "[Fail] not base64", token: "***"},
+		{name: "[Fail] missing separator", token: "bm8tc2VwYXJhdG9y"},
+		{name: "[Fail] empty id", token: Cursor{UpdatedAt: time.Now()}.Encode()},
+		{name: "[Fail] bad timestamp", token: "eWVzdGVyZGF5fGlk"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.token); !errors.Is(err, domainerr.ErrInvalidCursor) {
+				t.Fatalf("want ErrInvalidCursor, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNormalizeLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{name: "[Success] unset uses default", limit: 0, want: DefaultLimit},
+		{name: "[Success] negative uses default", limit: -1, want: DefaultLimit},
+		{name: "[Success] within bounds", limit: 5, want: 5},
+		{name: "[Success] capped", limit: MaxLimit + 1, want: MaxLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeLimit(tt.limit); got != tt.want {
+				t.Fatalf("want %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	now := time.Now()
+	cursorOf := func(id string) Cursor { return Cursor{UpdatedAt: now, ID: id} }
+
+	p := Build([]string{"a", "b", "c"}, 2, cursorOf)
+	if len(p.Items) != 2 || !p.HasMore() || p.NextCursor.ID != "b" {
+		t.Fatalf("unexpected page: %+v", p)
+	}
+
+	p = Build([]string{"a", "b"}, 2, cursorOf)
+	if len(p.Items) != 2 || p.HasMore() {
+		t.Fatalf("unexpected last page: %+v", p)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	now := time.Now()
+	cursorOf := func(id string) Cursor { return Cursor{UpdatedAt: now, ID: id} }
+	items := []string{"a", "b", "c", "d"}
+	fetchErr := errors.New("db down")
+
+	tests := []struct {
+		name      string
+		limit     int
+		err       error
+		wantAsked int
+		wantItems int
+		wantMore  bool
+	}{
+		{name: "[Success] one row past the page tells another page follows", limit: 2, wantAsked: 3, wantItems: 2, wantMore: true},
+		{name: "[Success] last page", limit: 4, wantAsked: 5, wantItems: 4},
+		{name: "[Success] unset limit uses the default", limit: 0, wantAsked: DefaultLimit + 1, wantItems: 4},
+		{name: "[Fail] fetch error", limit: 2, err: fetchErr, wantAsked: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asked := 0
+			p, err := Fetch(tt.limit, func(limit int) ([]string, error) {
+				asked = limit
+				return items[:min(limit, len(items))], tt.err
+			}, cursorOf)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("want %v, got %v", tt.err, err)
+			}
+			if asked != tt.wantAsked {
+				t.Errorf("asked for %d rows, want %d", asked, tt.wantAsked)
+			}
+			if len(p.Items) != tt.wantItems || p.HasMore() != tt.wantMore {
+				t.Errorf("unexpected page: %+v", p)
+			}
+		})
+	}
+}
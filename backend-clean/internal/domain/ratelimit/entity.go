@@ -0,0 +1,57 @@
+// Package ratelimit holds the token buckets that limit how often a client may call the API.
+package ratelimit
+
+import "time"
+
+// Class separates the budgets of requests that only read from those that change data.
+type Class string
+
+// Class constants.
+const (
+	ClassRead  Class = "read"
+	ClassWrite Class = "write"
+	// ClassPreAuth is spent by every request of a client IP before its token is checked.
+	// Its budget is much looser than the others: it only stops floods such as token
+	// guessing, so clients behind one proxy do not share the budget of a single account.
+	ClassPreAuth Class = "preauth"
+)
+
+// Default budgets of one account or client IP.
+var (
+	DefaultReadPolicy    = Policy{Limit: 300, Window: time.Minute}
+	DefaultWritePolicy   = Policy{Limit: 60, Window: time.Minute}
+	DefaultPreAuthPolicy = Policy{Limit: 3000, Window: time.Minute}
+)
+
+// Policy allows Limit requests per Window. The bucket holds at most Limit tokens
+// and refills steadily, so a full bucket absorbs a burst of Limit requests.
+type Policy struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Subject identifies who is limited: the authenticated account, or the client IP
+// when the request carries no account.
+type Subject struct {
+	AccountID string
+	IP        string
+}
+
+// Bucket is the stored state of one subject's budget.
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Decision is the outcome of taking a token.
+type Decision struct {
+	Allowed bool
+	// Limit is the bucket capacity; zero means the class is not limited.
+	Limit int
+	// Remaining is the number of whole tokens left after this request.
+	Remaining int
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is how long until the next token when the request was refused.
+	RetryAfter time.Duration
+}
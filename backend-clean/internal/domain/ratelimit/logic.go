@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// Enabled reports whether the policy limits anything; a zero limit or window turns it off.
+func (p Policy) Enabled() bool {
+	return p.Limit > 0 && p.Window > 0
+}
+
+// Full returns a bucket with every token available.
+func (p Policy) Full(now time.Time) Bucket {
+	return Bucket{Tokens: float64(p.Limit), UpdatedAt: now}
+}
+
+// Take refills the bucket for the time elapsed since it was last updated and takes
+// one token when there is one. It returns the bucket to store and the decision.
+// ルール: トークンは Window ごとに Limit 個のペースで補充され、Limit 個を超えて貯まらない。
+func (p Policy) Take(b Bucket, now time.Time) (Bucket, Decision) {
+	if !p.Enabled() {
+		return b, Decision{Allowed: true}
+	}
+	perToken := p.Window / time.Duration(p.Limit)
+	capacity := float64(p.Limit)
+	tokens := b.Tokens
+	if elapsed := now.Sub(b.UpdatedAt); elapsed > 0 {
+		tokens = math.Min(capacity, tokens+float64(elapsed)/float64(perToken))
+	}
+
+	d := Decision{Limit: p.Limit}
+	if tokens >= 1 {
+		tokens--
+		d.Allowed = true
+	} else {
+		d.RetryAfter = ceilDuration((1-tokens)*float64(perToken), time.Second)
+	}
+	d.Remaining = int(math.Floor(tokens))
+	d.Reset = ceilDuration((capacity-tokens)*float64(perToken), time.Second)
+	return Bucket{Tokens: tokens, UpdatedAt: now}, d
+}
+
+// Key returns the storage key of the subject's bucket for the class.
+func Key(class Class, s Subject) string {
+	if s.AccountID != "" {
+		return string(class) + ":account:" + s.AccountID
+	}
+	return string(class) + ":ip:" + s.IP
+}
+
+// ceilDuration rounds d (in nanoseconds) up to a whole multiple of unit.
+func ceilDuration(d float64, unit time.Duration) time.Duration {
+	return time.Duration(math.Ceil(d/float64(unit))) * unit
+}
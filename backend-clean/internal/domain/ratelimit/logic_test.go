@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicy_Take(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	policy := Policy{Limit: 60, Window: time.Minute}
+
+	tests := []struct {
+		name       string
+		policy     Policy
+		bucket     Bucket
+		want       Decision
+		wantTokens float64
+	}{
+		{
+			name:       "[Success] full bucket",
+			policy:     policy,
+			bucket:     policy.Full(now),
+			want:       Decision{Allowed: true, Limit: 60, Remaining: 59, Reset: time.Second},
+			wantTokens: 59,
+		},
+		{
+			name:       "[Success] refills for the elapsed time",
+			policy:     policy,
+			bucket:     Bucket{Tokens: 0, UpdatedAt: now.Add(-10 * time.Second)},
+			want:       Decision{Allowed: true, Limit: 60, Remaining: 9, Reset: 51 * time.Second},
+			wantTokens: 9,
+		},
+		{
+			name:       "[Success] refill stops at the limit",
+			policy:     policy,
+			bucket:     Bucket{Tokens: 30, UpdatedAt: now.Add(-time.Hour)},
+			want:       Decision{Allowed: true, Limit: 60, Remaining: 59, Reset: time.Second},
+			wantTokens: 59,
+		},
+		{
+			name:       "[Success] disabled policy allows everything",
+			policy:     Policy{},
+			bucket:     Bucket{},
+			want:       Decision{Allowed: true},
+			wantTokens: 0,
+		},
+		{
+			name:       "[Fail] empty bucket",
+			policy:     policy,
+			bucket:     Bucket{Tokens: 0.5, UpdatedAt: now},
+			want:       Decision{Limit: 60, Remaining: 0, Reset: 60 * time.Second, RetryAfter: time.Second},
+			wantTokens: 0.5,
+		},
+		{
+			name:       "[Fail] retry after rounds up to whole seconds",
+			policy:     Policy{Limit: 2, Window: time.Minute},
+			bucket:     Bucket{Tokens: 0, UpdatedAt: now.Add(-time.Second)},
+			want:       Decision{Limit: 2, Remaining: 0, Reset: 59 * time.Second, RetryAfter: 29 * time.Second},
+			wantTokens: 1.0 / 30,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bucket, got := tt.policy.Take(tt.bucket, now)
+			if got != tt.want {
+				t.Fatalf("decision = %+v, want %+v", got, tt.want)
+			}
+			if diff := bucket.Tokens - tt.wantTokens; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("tokens = %v, want %v", bucket.Tokens, tt.wantTokens)
+			}
+			if tt.policy.Enabled() && !bucket.UpdatedAt.Equal(now) {
+				t.Errorf("updatedAt = %v, want %v", bucket.UpdatedAt, now)
+			}
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		class   Class
+		subject Subject
+		want    string
+	}{
+		{name: "[Success] account", class: ClassRead, subject: Subject{AccountID: "acc-1", IP: "192.0.2.1"}, want: "read:account:acc-1"},
+		{name: "[Success] falls back to IP", class: ClassWrite, subject: Subject{IP: "192.0.2.1"}, want: "write:ip:192.0.2.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Key(tt.class, tt.subject); got != tt.want {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -5,17 +5,43 @@ import "time"
 
 // Template represents a note template aggregate.
 type Template struct {
-	ID        string
-	Name      string
-	OwnerID   string
-	Fields    []Field
-	UpdatedAt time.Time
+	ID      string
+	Name    string
+	OwnerID string
+	// WorkspaceID is the workspace the template and the notes written with it belong to.
+	WorkspaceID string
+	Fields      []Field
+	// Version increases on every change and guards updates against lost writes.
+	Version int
+	// FieldsVersion is the template version that introduced the current fields.
+	// Notes are pinned to the fields version they were written against.
+	FieldsVersion int
+	UpdatedAt     time.Time
+	// DeletedAt is set while the template is in the trash.
+	DeletedAt *time.Time
 }
 
+// FieldType decides what kind of content a note section holds for the field.
+type FieldType string
+
+// Field type constants.
+const (
+	FieldText         FieldType = "text"
+	FieldMarkdown     FieldType = "markdown"
+	FieldURL          FieldType = "url"
+	FieldNumber       FieldType = "number"
+	FieldDate         FieldType = "date"
+	FieldSingleSelect FieldType = "single-select"
+	FieldBoolean      FieldType = "boolean"
+)
+
 // Field represents a template field definition.
 type Field struct {
 	ID         string
 	Label      string
 	Order      int
 	IsRequired bool
+	Type       FieldType
+	// Options lists the choices of a single-select field; other types have none.
+	Options []string
 }
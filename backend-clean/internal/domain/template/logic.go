@@ -1,17 +1,35 @@
 package template
 
 import (
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
 )
 
-// NormalizeAndValidate sets missing order and validates fields.
+// Validate checks if field type is valid.
+func (t FieldType) Validate() error {
+	switch t {
+	case FieldText, FieldMarkdown, FieldURL, FieldNumber, FieldDate, FieldSingleSelect, FieldBoolean:
+		return nil
+	default:
+		return domainerr.ErrInvalidFieldType
+	}
+}
+
+// NormalizeAndValidate sets missing order and type and validates fields.
 func NormalizeAndValidate(fields []Field) ([]Field, error) {
 	for i := range fields {
 		if fields[i].Order == 0 {
 			fields[i].Order = i + 1
 		}
+		if fields[i].Type == "" {
+			fields[i].Type = FieldText
+		}
 	}
 	if err := validateFields(fields); err != nil {
 		return nil, err
@@ -36,6 +54,63 @@ func validateFields(fields []Field) error {
 			return domainerr.ErrFieldOrderInvalid
 		}
 		seen[order] = true
+		if err := validateFieldType(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ルール: 単一選択の項目は空でない重複なしの選択肢を1つ以上持ち、それ以外の型は選択肢を持たない。
+func validateFieldType(f Field) error {
+	if err := f.Type.Validate(); err != nil {
+		return err
+	}
+	if f.Type != FieldSingleSelect {
+		if len(f.Options) > 0 {
+			return domainerr.ErrInvalidFieldOptions
+		}
+		return nil
+	}
+	if len(f.Options) == 0 {
+		return domainerr.ErrInvalidFieldOptions
+	}
+	seen := make(map[string]bool, len(f.Options))
+	for _, o := range f.Options {
+		if strings.TrimSpace(o) == "" || seen[o] {
+			return domainerr.ErrInvalidFieldOptions
+		}
+		seen[o] = true
+	}
+	return nil
+}
+
+// ValidateContent checks that non-empty section content fits the field type.
+// Empty content is left to the required check; fields without a type are free text.
+func (f Field) ValidateContent(content string) error {
+	if content == "" {
+		return nil
+	}
+	var ok bool
+	switch f.Type {
+	case FieldURL:
+		u, err := url.ParseRequestURI(content)
+		ok = err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	case FieldNumber:
+		n, err := strconv.ParseFloat(content, 64)
+		ok = err == nil && !math.IsInf(n, 0) && !math.IsNaN(n)
+	case FieldDate:
+		_, err := time.Parse(time.DateOnly, content)
+		ok = err == nil
+	case FieldSingleSelect:
+		ok = slices.Contains(f.Options, content)
+	case FieldBoolean:
+		ok = content == "true" || content == "false"
+	default:
+		ok = true
+	}
+	if !ok {
+		return domainerr.ErrInvalidSectionContent
 	}
 	return nil
 }
@@ -54,11 +129,15 @@ func ValidateTemplate(t Template) error {
 	return nil
 }
 
-// CanDeleteTemplate returns error if template is in use.
-func CanDeleteTemplate(isUsed bool) error {
+// CanDeleteTemplate returns error if template is in use. Notes in the trash count
+// as uses, so restoring a note never finds its template gone.
+func CanDeleteTemplate(isUsed, isUsedInTrash bool) error {
 	if isUsed {
 		return domainerr.ErrTemplateInUse
 	}
+	if isUsedInTrash {
+		return domainerr.ErrTemplateUsedByTrashedNotes
+	}
 	return nil
 }
 
@@ -72,3 +151,49 @@ func ValidateTemplateOwnership(templateOwnerID, actorID string) error {
 	}
 	return nil
 }
+
+// CheckVersion ensures the template has not changed since the version the client edited.
+// A nil expected version skips the check.
+func CheckVersion(current int, expected *int) error {
+	if expected != nil && *expected != current {
+		return domainerr.ErrConflict
+	}
+	return nil
+}
+
+// FieldsChanged reports whether next differs from the current fields. Fields are matched
+// by ID; a field without an ID is new. Missing order and type count as their defaults,
+// as NormalizeAndValidate sets them.
+// ルール: 項目が変わらない更新は新しい項目バージョンを作らず、ノートを古いバージョンに残さない。
+func FieldsChanged(current, next []Field) bool {
+	if len(current) != len(next) {
+		return true
+	}
+	byID := make(map[string]Field, len(current))
+	for i, f := range current {
+		byID[f.ID] = normalizeField(f, i)
+	}
+	for i, f := range next {
+		prev, ok := byID[f.ID]
+		if f.ID == "" || !ok {
+			return true
+		}
+		delete(byID, f.ID)
+		f = normalizeField(f, i)
+		if f.Label != prev.Label || f.Order != prev.Order || f.IsRequired != prev.IsRequired ||
+			f.Type != prev.Type || !slices.Equal(f.Options, prev.Options) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeField(f Field, idx int) Field {
+	if f.Order == 0 {
+		f.Order = idx + 1
+	}
+	if f.Type == "" {
+		f.Type = FieldText
+	}
+	return f
+}
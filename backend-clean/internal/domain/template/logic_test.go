@@ -2,6 +2,7 @@ package template
 
 import (
 	"errors"
+	"slices"
 	"testing"
 
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
@@ -42,6 +43,41 @@ func TestNormalizeAndValidate(t *testing.T) {
 			},
 			wantError: domainerr.ErrFieldOrderInvalid,
 		},
+		{
+			name: "[Success] single-select with options",
+			fields: []Field{
+				{ID: "f1", Label: "Priority", Order: 1, Type: FieldSingleSelect, Options: []string{"high", "low"}},
+			},
+			wantOrder: []int{1},
+		},
+		{
+			name: "[Fail] unknown type",
+			fields: []Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: "image"},
+			},
+			wantError: domainerr.ErrInvalidFieldType,
+		},
+		{
+			name: "[Fail] single-select without options",
+			fields: []Field{
+				{ID: "f1", Label: "Priority", Order: 1, Type: FieldSingleSelect},
+			},
+			wantError: domainerr.ErrInvalidFieldOptions,
+		},
+		{
+			name: "[Fail] duplicate options",
+			fields: []Field{
+				{ID: "f1", Label: "Priority", Order: 1, Type: FieldSingleSelect, Options: []string{"high", "high"}},
+			},
+			wantError: domainerr.ErrInvalidFieldOptions,
+		},
+		{
+			name: "[Fail] options on text field",
+			fields: []Field{
+				{ID: "f1", Label: "Title", Order: 1, Type: FieldText, Options: []string{"a"}},
+			},
+			wantError: domainerr.ErrInvalidFieldOptions,
+		},
 	}
 
 	for _, tt := range tests {
@@ -67,6 +103,53 @@ func TestNormalizeAndValidate(t *testing.T) {
 	}
 }
 
+func TestNormalizeAndValidate_DefaultsType(t *testing.T) {
+	out, err := NormalizeAndValidate([]Field{{ID: "f1", Label: "Title", Order: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out[0].Type != FieldText {
+		t.Fatalf("type = %q, want %q", out[0].Type, FieldText)
+	}
+}
+
+func TestField_ValidateContent(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     Field
+		content   string
+		wantError error
+	}{
+		{name: "[Success] empty content skips type check", field: Field{Type: FieldNumber}, content: ""},
+		{name: "[Success] untyped field is free text", field: Field{}, content: "anything"},
+		{name: "[Success] markdown", field: Field{Type: FieldMarkdown}, content: "# heading"},
+		{name: "[Success] url", field: Field{Type: FieldURL}, content: "https://example.com/a?b=c"},
+		{name: "[Fail] url without scheme", field: Field{Type: FieldURL}, content: "example.com", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] url with other scheme", field: Field{Type: FieldURL}, content: "javascript:alert(1)", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] number", field: Field{Type: FieldNumber}, content: "-12.5"},
+		{name: "[Fail] not a number", field: Field{Type: FieldNumber}, content: "twelve", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Fail] infinite number", field: Field{Type: FieldNumber}, content: "Inf", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] date", field: Field{Type: FieldDate}, content: "2026-10-17"},
+		{name: "[Fail] invalid date", field: Field{Type: FieldDate}, content: "2026-02-30", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] selected option", field: Field{Type: FieldSingleSelect, Options: []string{"high", "low"}}, content: "low"},
+		{name: "[Fail] unknown option", field: Field{Type: FieldSingleSelect, Options: []string{"high", "low"}}, content: "mid", wantError: domainerr.ErrInvalidSectionContent},
+		{name: "[Success] boolean", field: Field{Type: FieldBoolean}, content: "false"},
+		{name: "[Fail] invalid boolean", field: Field{Type: FieldBoolean}, content: "yes", wantError: domainerr.ErrInvalidSectionContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.field.ValidateContent(tt.content)
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
 func TestValidateTemplate(t *testing.T) {
 	valid := Template{
 		ID:      "tpl-1",
@@ -125,16 +208,19 @@ func TestValidateTemplate(t *testing.T) {
 
 func TestCanDeleteTemplate(t *testing.T) {
 	tests := []struct {
-		name      string
-		isUsed    bool
-		wantError error
+		name          string
+		isUsed        bool
+		isUsedInTrash bool
+		wantError     error
 	}{
 		{name: "[Success] not used", isUsed: false},
 		{name: "[Fail] used", isUsed: true, wantError: domainerr.ErrTemplateInUse},
+		{name: "[Fail] used by trashed notes", isUsedInTrash: true, wantError: domainerr.ErrTemplateUsedByTrashedNotes},
+		{name: "[Fail] trashed notes still count as in use", isUsedInTrash: true, wantError: domainerr.ErrTemplateInUse},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := CanDeleteTemplate(tt.isUsed)
+			err := CanDeleteTemplate(tt.isUsed, tt.isUsedInTrash)
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
@@ -194,3 +280,65 @@ func TestValidateTemplateOwnership(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckVersion(t *testing.T) {
+	v := func(n int) *int { return &n }
+	tests := []struct {
+		name      string
+		current   int
+		expected  *int
+		wantError error
+	}{
+		{name: "[Success] version matches", current: 3, expected: v(3)},
+		{name: "[Success] no expected version", current: 3},
+		{name: "[Fail] stale version", current: 4, expected: v(3), wantError: domainerr.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckVersion(tt.current, tt.expected)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestFieldsChanged(t *testing.T) {
+	current := []Field{
+		{ID: "f1", Label: "Title", Order: 1, IsRequired: true, Type: FieldText},
+		{ID: "f2", Label: "Status", Order: 2, Type: FieldSingleSelect, Options: []string{"open", "closed"}},
+	}
+	with := func(idx int, change func(*Field)) []Field {
+		next := slices.Clone(current)
+		next[idx].Options = slices.Clone(next[idx].Options)
+		change(&next[idx])
+		return next
+	}
+
+	tests := []struct {
+		name string
+		next []Field
+		want bool
+	}{
+		{name: "[Success] same fields", next: slices.Clone(current), want: false},
+		{name: "[Success] defaults match the stored order and type", next: with(0, func(f *Field) { f.Order, f.Type = 0, "" }), want: false},
+		{name: "[Success] label changed", next: with(0, func(f *Field) { f.Label = "Name" }), want: true},
+		{name: "[Success] order changed", next: with(1, func(f *Field) { f.Order = 3 }), want: true},
+		{name: "[Success] required changed", next: with(0, func(f *Field) { f.IsRequired = false }), want: true},
+		{name: "[Success] type changed", next: with(0, func(f *Field) { f.Type = FieldMarkdown }), want: true},
+		{name: "[Success] options changed", next: with(1, func(f *Field) { f.Options = []string{"open"} }), want: true},
+		{name: "[Success] new field without ID", next: with(1, func(f *Field) { f.ID = "" }), want: true},
+		{name: "[Success] unknown ID", next: with(1, func(f *Field) { f.ID = "f3" }), want: true},
+		{name: "[Success] same ID twice", next: with(1, func(f *Field) { *f = current[0] }), want: true},
+		{name: "[Success] field removed", next: current[:1], want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FieldsChanged(current, tt.next); got != tt.want {
+				t.Errorf("FieldsChanged = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
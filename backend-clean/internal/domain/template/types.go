@@ -1,10 +1,22 @@
 // Package template holds template domain models.
 package template
 
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/page"
+)
+
 // Filters for listing templates.
 type Filters struct {
 	Query   *string
 	OwnerID *string
+	// WorkspaceID limits results to one workspace. It is required; reading without one fails.
+	WorkspaceID string
+	// Limit caps the number of rows; zero means no limit.
+	Limit int
+	// After resumes the list strictly after this position in (updated_at DESC, id DESC) order.
+	After *page.Cursor
 }
 
 // Owner holds minimal owner info for embedding.
@@ -18,6 +30,29 @@ type Owner struct {
 // WithUsage is a template with usage metadata.
 type WithUsage struct {
 	Template Template
-	IsUsed   bool
-	Owner    Owner
+	// IsUsed reports notes outside the trash using the template.
+	IsUsed bool
+	// IsUsedInTrash reports trashed notes using the template; they still need it to be restored.
+	IsUsedInTrash bool
+	Owner         Owner
+}
+
+// InUse reports whether any note, trashed or not, uses the template.
+func (t WithUsage) InUse() bool {
+	return t.IsUsed || t.IsUsedInTrash
+}
+
+// Cursor returns the keyset position of the template in list order.
+func (t WithUsage) Cursor() page.Cursor {
+	return page.Cursor{UpdatedAt: t.Template.UpdatedAt, ID: t.Template.ID}
+}
+
+// TrashCursor returns the keyset position of a trashed template in trash order,
+// which is (deleted_at DESC, id DESC).
+func (t WithUsage) TrashCursor() page.Cursor {
+	var at time.Time
+	if t.Template.DeletedAt != nil {
+		at = *t.Template.DeletedAt
+	}
+	return page.Cursor{UpdatedAt: at, ID: t.Template.ID}
 }
@@ -0,0 +1,26 @@
+// Package trash holds the rules for soft-deleted notes and templates.
+package trash
+
+import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/page"
+)
+
+// DefaultRetention is how long trashed items are kept before they are purged for good.
+const DefaultRetention = 30 * 24 * time.Hour
+
+// Filters for listing trashed notes or templates.
+type Filters struct {
+	// OwnerID limits the list to one account's trash; empty means every account.
+	OwnerID string
+	// WorkspaceID limits the list to one workspace; empty is only allowed for system jobs
+	// that work across every workspace.
+	WorkspaceID string
+	// TrashedBefore limits the list to items trashed strictly before this time.
+	TrashedBefore *time.Time
+	// Limit caps the number of rows.
+	Limit int
+	// After resumes the list strictly after this position in (deleted_at DESC, id DESC) order.
+	After *page.Cursor
+}
@@ -0,0 +1,12 @@
+package trash
+
+import "time"
+
+// Cutoff returns the time before which trashed items have outlived the retention.
+// ok is false when retention is not positive, which turns purging off.
+func Cutoff(now time.Time, retention time.Duration) (cutoff time.Time, ok bool) {
+	if retention <= 0 {
+		return time.Time{}, false
+	}
+	return now.Add(-retention), true
+}
@@ -0,0 +1,29 @@
+package trash
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCutoff(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		retention time.Duration
+		want      time.Time
+		wantOK    bool
+	}{
+		{name: "[Success] default retention", retention: DefaultRetention, want: time.Date(2026, 9, 17, 9, 0, 0, 0, time.UTC), wantOK: true},
+		{name: "[Success] one hour", retention: time.Hour, want: now.Add(-time.Hour), wantOK: true},
+		{name: "[Success] zero disables purging", retention: 0},
+		{name: "[Success] negative disables purging", retention: -time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Cutoff(now, tt.retention)
+			if ok != tt.wantOK || !got.Equal(tt.want) {
+				t.Fatalf("want (%v, %v), got (%v, %v)", tt.want, tt.wantOK, got, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Package webhook holds outbound webhooks that push note and template lifecycle
+// events to endpoints registered by an account.
+package webhook
+
+import "time"
+
+// EventType names an event a webhook can subscribe to.
+type EventType string
+
+// Webhook event type constants.
+const (
+	EventNotePublished   EventType = "note.published"
+	EventNoteUnpublished EventType = "note.unpublished"
+	EventNoteUpdated     EventType = "note.updated"
+	EventNoteDeleted     EventType = "note.deleted"
+	EventTemplateUpdated EventType = "template.updated"
+	EventTemplateDeleted EventType = "template.deleted"
+)
+
+// Webhook is an endpoint that receives the owner's note and template events.
+// Secret signs every delivery so the receiver can verify it came from us.
+type Webhook struct {
+	ID        string
+	OwnerID   string
+	URL       string
+	Secret    string
+	Events    []EventType
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DeliveryStatus is the state of a delivery.
+type DeliveryStatus string
+
+// Delivery status constants.
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Delivery is one event sent (or to be sent) to one webhook. It doubles as the
+// delivery log: every attempt updates Attempts and the last response.
+type Delivery struct {
+	ID        string
+	WebhookID string
+	EventID   string
+	EventType EventType
+	// Payload is the JSON request body, fixed when the delivery is created.
+	Payload       []byte
+	Status        DeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	// LastStatusCode is the HTTP status of the last attempt; 0 when no response was received.
+	LastStatusCode int
+	LastError      string
+	// RedeliveryOf is the delivery this one manually resends; empty for the original delivery.
+	RedeliveryOf string
+	CreatedAt    time.Time
+	DeliveredAt  *time.Time
+}
+
+// DueDelivery is a claimed delivery together with the endpoint to send it to.
+type DueDelivery struct {
+	Delivery Delivery
+	URL      string
+	Secret   string
+}
@@ -0,0 +1,273 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/page"
+)
+
+// Delivery retry policy.
+const (
+	// MaxAttempts is how often a delivery is tried before it is marked failed.
+	MaxAttempts = 8
+	// BaseRetryDelay is the wait after the first failed attempt; it doubles per attempt.
+	BaseRetryDelay = 30 * time.Second
+	// MaxRetryDelay caps the wait between attempts.
+	MaxRetryDelay = time.Hour
+	// DeliveryLease is how long a claimed delivery is hidden from other workers while
+	// it is being sent. It must outlast the sender's request timeout.
+	DeliveryLease = 2 * time.Minute
+)
+
+// SignaturePrefix is prepended to the hex digest in the signature header.
+const SignaturePrefix = "sha256="
+
+// Validate checks if event type is one a webhook can subscribe to.
+func (t EventType) Validate() error {
+	switch t {
+	case EventNotePublished, EventNoteUnpublished, EventNoteUpdated, EventNoteDeleted,
+		EventTemplateUpdated, EventTemplateDeleted:
+		return nil
+	default:
+		return domainerr.ErrInvalidWebhookEvents
+	}
+}
+
+// ValidateURL checks that the endpoint is an absolute http or https URL of a public host.
+// Host names are only resolved when a delivery is sent, where the sender re-checks the address.
+// ルール: Webhookは社内ネットワーク（ループバック・プライベート・リンクローカル）へは送らない。
+func ValidateURL(raw string) error {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return domainerr.ErrInvalidWebhookURL
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return domainerr.ErrInvalidWebhookURL
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !PublicAddr(addr) {
+		return domainerr.ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+// PublicAddr reports whether deliveries may be sent to addr: loopback, private, link-local,
+// shared (CGNAT), multicast and unspecified addresses are internal.
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!sharedAddrSpace.Contains(addr)
+}
+
+// sharedAddrSpace is the carrier-grade NAT range (RFC 6598), reachable only inside a provider network.
+var sharedAddrSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// NormalizeEvents validates the subscribed events and drops duplicates, keeping their order.
+// ルール: Webhookは1つ以上のイベントを購読する。
+func NormalizeEvents(events []EventType) ([]EventType, error) {
+	if len(events) == 0 {
+		return nil, domainerr.ErrInvalidWebhookEvents
+	}
+	seen := make(map[EventType]bool, len(events))
+	normalized := make([]EventType, 0, len(events))
+	for _, e := range events {
+		if err := e.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[e] {
+			continue
+		}
+		seen[e] = true
+		normalized = append(normalized, e)
+	}
+	return normalized, nil
+}
+
+// NewWebhook validates and builds an active webhook for ownerID with a fresh secret.
+func NewWebhook(ownerID, endpoint string, events []EventType) (Webhook, error) {
+	if strings.TrimSpace(ownerID) == "" {
+		return Webhook{}, domainerr.ErrOwnerRequired
+	}
+	if err := ValidateURL(endpoint); err != nil {
+		return Webhook{}, err
+	}
+	normalized, err := NormalizeEvents(events)
+	if err != nil {
+		return Webhook{}, err
+	}
+	secret, err := NewSecret()
+	if err != nil {
+		return Webhook{}, err
+	}
+	return Webhook{
+		OwnerID: ownerID,
+		URL:     strings.TrimSpace(endpoint),
+		Secret:  secret,
+		Events:  normalized,
+		Active:  true,
+	}, nil
+}
+
+// Subscribes reports whether the webhook should receive events of type t.
+func (w Webhook) Subscribes(t EventType) bool {
+	if !w.Active {
+		return false
+	}
+	for _, e := range w.Events {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// NewSecret returns a random signing secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature header value for a delivery body sent at timestamp
+// (Unix seconds): the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with secret.
+// Signing the timestamp lets receivers reject replayed requests.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature produced by Sign in constant time.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// EventTypeOf maps a domain event to the webhook event it is published as.
+// ok is false for events webhooks cannot subscribe to, such as sign-ins and template creation.
+func EventTypeOf(e event.Event) (t EventType, ok bool) {
+	switch e.Type {
+	case event.NotePublished:
+		return EventNotePublished, true
+	case event.NoteUnpublished:
+		return EventNoteUnpublished, true
+	case event.NoteUpdated:
+		return EventNoteUpdated, true
+	case event.NoteDeleted:
+		return EventNoteDeleted, true
+	case event.TemplateChanged:
+		switch e.Payload["change"] {
+		case string(event.TemplateUpdated):
+			return EventTemplateUpdated, true
+		case string(event.TemplateDeleted):
+			return EventTemplateDeleted, true
+		}
+	}
+	return "", false
+}
+
+// OwnerOf returns the account whose webhooks receive the event.
+func OwnerOf(e event.Event) string {
+	owner, _ := e.Payload["ownerId"].(string)
+	return owner
+}
+
+type body struct {
+	ID           string         `json:"id"`
+	Type         EventType      `json:"type"`
+	ResourceType string         `json:"resourceType"`
+	ResourceID   string         `json:"resourceId"`
+	OccurredAt   time.Time      `json:"occurredAt"`
+	Data         map[string]any `json:"data"`
+}
+
+// NewDelivery builds a pending delivery of the event to the webhook. The body carries
+// the event ID so receivers can ignore duplicates of at-least-once delivery.
+func NewDelivery(webhookID string, e event.Event, t EventType) (Delivery, error) {
+	payload, err := json.Marshal(body{
+		ID:           e.ID,
+		Type:         t,
+		ResourceType: string(e.AggregateType),
+		ResourceID:   e.AggregateID,
+		OccurredAt:   e.OccurredAt.UTC(),
+		Data:         e.Payload,
+	})
+	if err != nil {
+		return Delivery{}, err
+	}
+	return Delivery{
+		WebhookID: webhookID,
+		EventID:   e.ID,
+		EventType: t,
+		Payload:   payload,
+		Status:    DeliveryPending,
+	}, nil
+}
+
+// Redeliver builds a new pending delivery that resends d with the same body.
+func (d Delivery) Redeliver() Delivery {
+	return Delivery{
+		WebhookID:    d.WebhookID,
+		EventID:      d.EventID,
+		EventType:    d.EventType,
+		Payload:      d.Payload,
+		Status:       DeliveryPending,
+		RedeliveryOf: d.ID,
+	}
+}
+
+// RecordAttempt returns d updated with the outcome of an attempt made at now.
+// statusCode is 0 when no response was received, in which case cause says why.
+// ルール: 2xxで成功。それ以外は指数バックオフで再試行し、MaxAttempts回失敗したら失敗とする。
+func (d Delivery) RecordAttempt(now time.Time, statusCode int, cause string) Delivery {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = cause
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		d.Status = DeliverySucceeded
+		d.LastError = ""
+		d.DeliveredAt = &now
+	case d.Attempts >= MaxAttempts:
+		d.Status = DeliveryFailed
+	default:
+		d.Status = DeliveryPending
+		d.NextAttemptAt = now.Add(RetryDelay(d.Attempts))
+	}
+	return d
+}
+
+// RetryDelay returns the wait before the next attempt of a delivery that failed attempts times.
+func RetryDelay(attempts int) time.Duration {
+	d := BaseRetryDelay
+	for i := 1; i < attempts && d < MaxRetryDelay; i++ {
+		d *= 2
+	}
+	return min(d, MaxRetryDelay)
+}
+
+// Cursor returns the keyset position of the delivery in the newest-first delivery log.
+func (d Delivery) Cursor() page.Cursor {
+	return page.Cursor{UpdatedAt: d.CreatedAt, ID: d.ID}
+}
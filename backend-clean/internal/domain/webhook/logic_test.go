@@ -0,0 +1,197 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+func TestNewWebhook(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownerID    string
+		url        string
+		events     []EventType
+		wantErr    error
+		wantEvents int
+	}{
+		{name: "[Success] https endpoint", ownerID: "o1", url: "https://example.com/hook", events: []EventType{EventNotePublished}, wantEvents: 1},
+		{name: "[Success] duplicate events dropped", ownerID: "o1", url: "http://hooks.example.com:9000", events: []EventType{EventNoteUpdated, EventNoteUpdated, EventTemplateDeleted}, wantEvents: 2},
+		{name: "[Fail] owner missing", url: "https://example.com", events: []EventType{EventNotePublished}, wantErr: domainerr.ErrOwnerRequired},
+		{name: "[Fail] relative url", ownerID: "o1", url: "/hook", events: []EventType{EventNotePublished}, wantErr: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] localhost", ownerID: "o1", url: "http://localhost:9000/hook", events: []EventType{EventNotePublished}, wantErr: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] loopback address", ownerID: "o1", url: "http://127.0.0.1/hook", events: []EventType{EventNotePublished}, wantErr: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] private address", ownerID: "o1", url: "https://10.0.0.5/hook", events: []EventType{EventNotePublished}, wantErr: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] link-local metadata address", ownerID: "o1", url: "http://169.254.169.254/latest", events: []EventType{EventNotePublished}, wantErr: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] ipv6 loopback", ownerID: "o1", url: "http://[::1]:8080/hook", events: []EventType{EventNotePublished}, wantErr: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] ipv4-mapped private address", ownerID: "o1", url: "http://[::ffff:192.168.0.1]/hook", events: []EventType{EventNotePublished}, wantErr: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] unsupported scheme", ownerID: "o1", url: "ftp://example.com", events: []EventType{EventNotePublished}, wantErr: domainerr.ErrInvalidWebhookURL},
+		{name: "[Fail] no events", ownerID: "o1", url: "https://example.com", wantErr: domainerr.ErrInvalidWebhookEvents},
+		{name: "[Fail] unknown event", ownerID: "o1", url: "https://example.com", events: []EventType{"note.created"}, wantErr: domainerr.ErrInvalidWebhookEvents},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := NewWebhook(tt.ownerID, tt.url, tt.events)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want err %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(w.Events) != tt.wantEvents || !w.Active || !strings.HasPrefix(w.Secret, "whsec_") {
+				t.Fatalf("unexpected webhook: %+v", w)
+			}
+		})
+	}
+}
+
+func TestWebhook_Subscribes(t *testing.T) {
+	w := Webhook{Active: true, Events: []EventType{EventNotePublished}}
+	if !w.Subscribes(EventNotePublished) || w.Subscribes(EventNoteDeleted) {
+		t.Fatal("unexpected subscription result")
+	}
+	w.Active = false
+	if w.Subscribes(EventNotePublished) {
+		t.Fatal("inactive webhook must not subscribe")
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"id":"e1"}`)
+	sig := Sign("secret", 1700000000, body)
+	if !strings.HasPrefix(sig, SignaturePrefix) || len(sig) != len(SignaturePrefix)+64 {
+		t.Fatalf("unexpected signature: %s", sig)
+	}
+	if !Verify("secret", 1700000000, body, sig) {
+		t.Fatal("signature should verify")
+	}
+	if Verify("other", 1700000000, body, sig) || Verify("secret", 1700000001, body, sig) || Verify("secret", 1700000000, []byte("{}"), sig) {
+		t.Fatal("signature must not verify with changed inputs")
+	}
+}
+
+func TestEventTypeOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		event  event.Event
+		want   EventType
+		wantOK bool
+	}{
+		{name: "[Success] note published", event: event.Event{Type: event.NotePublished}, want: EventNotePublished, wantOK: true},
+		{name: "[Success] note deleted", event: event.Event{Type: event.NoteDeleted}, want: EventNoteDeleted, wantOK: true},
+		{name: "[Success] template updated", event: event.Event{Type: event.TemplateChanged, Payload: map[string]any{"change": "updated"}}, want: EventTemplateUpdated, wantOK: true},
+		{name: "[Success] template deleted", event: event.Event{Type: event.TemplateChanged, Payload: map[string]any{"change": "deleted"}}, want: EventTemplateDeleted, wantOK: true},
+		{name: "[Fail] template created", event: event.Event{Type: event.TemplateChanged, Payload: map[string]any{"change": "created"}}},
+		{name: "[Fail] sign-in", event: event.Event{Type: event.AccountSignedIn}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := EventTypeOf(tt.event)
+			if got != tt.want || ok != tt.wantOK {
+				t.Fatalf("want %q/%v, got %q/%v", tt.want, tt.wantOK, got, ok)
+			}
+		})
+	}
+}
+
+func TestNewDelivery(t *testing.T) {
+	e := event.Event{
+		ID:            "e1",
+		Type:          event.NotePublished,
+		AggregateType: event.AggregateNote,
+		AggregateID:   "n1",
+		Payload:       map[string]any{"ownerId": "o1", "title": "T"},
+		OccurredAt:    time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC),
+	}
+	d, err := NewDelivery("w1", e, EventNotePublished)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.WebhookID != "w1" || d.EventID != "e1" || d.Status != DeliveryPending {
+		t.Fatalf("unexpected delivery: %+v", d)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(d.Payload, &got); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if got["id"] != "e1" || got["type"] != "note.published" || got["resourceId"] != "n1" || got["data"].(map[string]any)["title"] != "T" {
+		t.Fatalf("unexpected payload: %s", d.Payload)
+	}
+	if OwnerOf(e) != "o1" {
+		t.Fatalf("unexpected owner: %q", OwnerOf(e))
+	}
+
+	again := Delivery{ID: "d1", WebhookID: "w1", EventID: "e1", Payload: d.Payload, Status: DeliveryFailed, Attempts: MaxAttempts}.Redeliver()
+	if again.RedeliveryOf != "d1" || again.Status != DeliveryPending || again.Attempts != 0 || string(again.Payload) != string(d.Payload) {
+		t.Fatalf("unexpected redelivery: %+v", again)
+	}
+}
+
+func TestDelivery_RecordAttempt(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		attempts   int
+		statusCode int
+		cause      string
+		want       DeliveryStatus
+		wantNext   time.Time
+	}{
+		{name: "[Success] 2xx succeeds", statusCode: 204, want: DeliverySucceeded},
+		{name: "[Success] first failure retries after base delay", statusCode: 500, want: DeliveryPending, wantNext: now.Add(BaseRetryDelay)},
+		{name: "[Success] third failure doubles twice", attempts: 2, cause: "timeout", want: DeliveryPending, wantNext: now.Add(4 * BaseRetryDelay)},
+		{name: "[Fail] last attempt gives up", attempts: MaxAttempts - 1, statusCode: 410, want: DeliveryFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Delivery{Status: DeliveryPending, Attempts: tt.attempts}.RecordAttempt(now, tt.statusCode, tt.cause)
+			if d.Status != tt.want || d.Attempts != tt.attempts+1 || d.LastStatusCode != tt.statusCode {
+				t.Fatalf("unexpected delivery: %+v", d)
+			}
+			if !tt.wantNext.IsZero() && !d.NextAttemptAt.Equal(tt.wantNext) {
+				t.Fatalf("want next attempt %v, got %v", tt.wantNext, d.NextAttemptAt)
+			}
+			if tt.want == DeliverySucceeded && (d.DeliveredAt == nil || d.LastError != "") {
+				t.Fatalf("unexpected success record: %+v", d)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	if RetryDelay(1) != BaseRetryDelay || RetryDelay(2) != 2*BaseRetryDelay || RetryDelay(30) != MaxRetryDelay {
+		t.Fatalf("unexpected delays: %v %v %v", RetryDelay(1), RetryDelay(2), RetryDelay(30))
+	}
+}
+
+func TestPublicAddr(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+		want bool
+	}{
+		{name: "[Success] public ipv4", addr: "93.184.216.34", want: true},
+		{name: "[Success] public ipv6", addr: "2606:2800:220:1::1", want: true},
+		{name: "[Fail] loopback", addr: "127.0.0.1"},
+		{name: "[Fail] private", addr: "192.168.1.10"},
+		{name: "[Fail] link-local", addr: "169.254.169.254"},
+		{name: "[Fail] shared address space", addr: "100.64.0.1"},
+		{name: "[Fail] unspecified", addr: "0.0.0.0"},
+		{name: "[Fail] ipv6 unique local", addr: "fd00::1"},
+		{name: "[Fail] ipv4-mapped loopback", addr: "::ffff:127.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PublicAddr(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Fatalf("PublicAddr(%s) = %v, want %v", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
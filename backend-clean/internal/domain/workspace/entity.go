@@ -0,0 +1,68 @@
+// Package workspace holds workspaces, the tenants templates and notes belong to,
+// with their members and pending invites.
+package workspace
+
+import "time"
+
+// Role is what a member may do in a workspace.
+type Role string
+
+// Role constants.
+const (
+	// RoleOwner created the workspace; there is exactly one.
+	RoleOwner Role = "owner"
+	// RoleAdmin manages the workspace, its members and invites.
+	RoleAdmin Role = "admin"
+	// RoleMember works on the workspace's templates and notes.
+	RoleMember Role = "member"
+)
+
+// Workspace is a tenant. Every template and note belongs to exactly one workspace.
+type Workspace struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WithRole represents a workspace with the role of the account that lists it.
+type WithRole struct {
+	Workspace Workspace
+	Role      Role
+}
+
+// Member is an account that belongs to a workspace.
+type Member struct {
+	WorkspaceID string
+	AccountID   string
+	Role        Role
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// MemberWithMeta represents a member with account profile.
+type MemberWithMeta struct {
+	Member    Member
+	Email     string
+	FirstName string
+	LastName  string
+	Thumbnail *string
+}
+
+// Invite is a pending invitation of an email address into a workspace.
+type Invite struct {
+	ID          string
+	WorkspaceID string
+	// Email is lower-cased.
+	Email     string
+	Role      Role
+	InvitedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// InviteWithMeta represents an invite with the name of its workspace.
+type InviteWithMeta struct {
+	Invite        Invite
+	WorkspaceName string
+}
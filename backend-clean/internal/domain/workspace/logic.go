@@ -0,0 +1,164 @@
+package workspace
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// MaxNameLength is the longest workspace name in characters (runes).
+const MaxNameLength = 100
+
+// Validate checks if role is valid.
+func (r Role) Validate() error {
+	switch r {
+	case RoleOwner, RoleAdmin, RoleMember:
+		return nil
+	default:
+		return domainerr.ErrInvalidWorkspaceRole
+	}
+}
+
+// CanManage reports whether the role may manage the workspace, its members and invites.
+func (r Role) CanManage() bool {
+	return r == RoleOwner || r == RoleAdmin
+}
+
+// NormalizeName trims surrounding whitespace and checks the length.
+func NormalizeName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return "", domainerr.ErrInvalidWorkspaceName
+	}
+	return name, nil
+}
+
+// PersonalName is the name of the workspace created for an account on its first sign-in.
+func PersonalName(firstName string) string {
+	name := strings.TrimSpace(firstName)
+	if name == "" {
+		return "My workspace"
+	}
+	personal := name + "'s workspace"
+	if utf8.RuneCountInString(personal) > MaxNameLength {
+		return "My workspace"
+	}
+	return personal
+}
+
+// ValidateMembership ensures the actor belongs to the workspace.
+// Workspaces the actor does not belong to are reported as missing.
+func ValidateMembership(actor *Member) error {
+	if actor == nil {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
+
+// ValidateManager ensures the actor may manage the workspace.
+// ルール: ワークスペース名・メンバー・招待を管理できるのは owner と admin のみ。
+func ValidateManager(actor *Member) error {
+	if err := ValidateMembership(actor); err != nil {
+		return err
+	}
+	if !actor.Role.CanManage() {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
+
+// ValidateDeletion ensures the actor may delete the workspace.
+// ルール: 削除できるのは owner のみ。テンプレート・ノート（ゴミ箱を含む）が残っている間は削除できない。
+func ValidateDeletion(actor *Member, hasContents bool) error {
+	if err := ValidateMembership(actor); err != nil {
+		return err
+	}
+	if actor.Role != RoleOwner {
+		return domainerr.ErrUnauthorized
+	}
+	if hasContents {
+		return domainerr.ErrWorkspaceNotEmpty
+	}
+	return nil
+}
+
+// ChangeRole validates giving target another role in the workspace.
+// ルール: owner と admin のみ変更できる。owner の役割は変更できず、owner を他のメンバーに与えることもできない。
+func ChangeRole(actor, target *Member, role Role) (Member, error) {
+	if err := ValidateManager(actor); err != nil {
+		return Member{}, err
+	}
+	if target == nil {
+		return Member{}, domainerr.ErrNotFound
+	}
+	if err := role.Validate(); err != nil {
+		return Member{}, err
+	}
+	if target.Role == RoleOwner || role == RoleOwner {
+		return Member{}, domainerr.ErrInvalidWorkspaceMember
+	}
+	changed := *target
+	changed.Role = role
+	return changed, nil
+}
+
+// ValidateRemoval ensures the actor may remove target from the workspace.
+// ルール: owner と admin はメンバーを外せる。メンバーは自分で抜けられる。owner は外せない。
+func ValidateRemoval(actor, target *Member) error {
+	if err := ValidateMembership(actor); err != nil {
+		return err
+	}
+	if target == nil {
+		return domainerr.ErrNotFound
+	}
+	if target.Role == RoleOwner {
+		return domainerr.ErrInvalidWorkspaceMember
+	}
+	if actor.AccountID != target.AccountID && !actor.Role.CanManage() {
+		return domainerr.ErrUnauthorized
+	}
+	return nil
+}
+
+// NewInvite validates and builds an invite of email into the workspace by the actor.
+// ルール: 招待できるのは owner と admin のみ。招待の役割は admin か member。
+func NewInvite(actor *Member, email string, role Role) (Invite, error) {
+	if err := ValidateManager(actor); err != nil {
+		return Invite{}, err
+	}
+	parsed, err := account.ParseEmail(email)
+	if err != nil {
+		return Invite{}, err
+	}
+	if role == "" {
+		role = RoleMember
+	}
+	if role != RoleAdmin && role != RoleMember {
+		return Invite{}, domainerr.ErrInvalidWorkspaceRole
+	}
+	return Invite{
+		WorkspaceID: actor.WorkspaceID,
+		Email:       strings.ToLower(parsed.String()),
+		Role:        role,
+		InvitedBy:   actor.AccountID,
+	}, nil
+}
+
+// Accept validates accepting the invite by an account with the given email and
+// returns the membership it grants. An account that already belongs to the workspace
+// keeps its membership as it is.
+// ルール: 招待されたメールアドレスのアカウントだけが受け入れられる。それ以外には招待は存在しないものとして扱う。
+func (i Invite) Accept(accountID, email string, existing *Member) (Member, error) {
+	if strings.TrimSpace(accountID) == "" {
+		return Member{}, domainerr.ErrUnauthenticated
+	}
+	if !strings.EqualFold(strings.TrimSpace(email), i.Email) {
+		return Member{}, domainerr.ErrNotFound
+	}
+	if existing != nil {
+		return *existing, nil
+	}
+	return Member{WorkspaceID: i.WorkspaceID, AccountID: accountID, Role: i.Role}, nil
+}
@@ -0,0 +1,204 @@
+package workspace
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+var (
+	owner  = &Member{WorkspaceID: "w1", AccountID: "owner", Role: RoleOwner}
+	admin  = &Member{WorkspaceID: "w1", AccountID: "admin", Role: RoleAdmin}
+	member = &Member{WorkspaceID: "w1", AccountID: "member", Role: RoleMember}
+)
+
+func assertErr(t *testing.T, err, wantErr error) {
+	t.Helper()
+	if wantErr == nil {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("want %v, got %v", wantErr, err)
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr error
+	}{
+		{name: "[Success] trimmed", raw: "  Team  ", want: "Team"},
+		{name: "[Success] 100 multibyte characters", raw: strings.Repeat("あ", 100), want: strings.Repeat("あ", 100)},
+		{name: "[Fail] blank", raw: "   ", wantErr: domainerr.ErrInvalidWorkspaceName},
+		{name: "[Fail] too long", raw: strings.Repeat("a", 101), wantErr: domainerr.ErrInvalidWorkspaceName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeName(tt.raw)
+			assertErr(t, err, tt.wantErr)
+			if got != tt.want {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPersonalName(t *testing.T) {
+	if got := PersonalName("Taro"); got != "Taro's workspace" {
+		t.Fatalf("unexpected name: %q", got)
+	}
+	if got := PersonalName(" "); got != "My workspace" {
+		t.Fatalf("unexpected name: %q", got)
+	}
+	if got := PersonalName(strings.Repeat("a", 100)); got != "My workspace" {
+		t.Fatalf("unexpected name: %q", got)
+	}
+}
+
+func TestValidateManager(t *testing.T) {
+	tests := []struct {
+		name    string
+		actor   *Member
+		wantErr error
+	}{
+		{name: "[Success] owner", actor: owner},
+		{name: "[Success] admin", actor: admin},
+		{name: "[Fail] member", actor: member, wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] not a member", wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertErr(t, ValidateManager(tt.actor), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateDeletion(t *testing.T) {
+	tests := []struct {
+		name        string
+		actor       *Member
+		hasContents bool
+		wantErr     error
+	}{
+		{name: "[Success] owner deletes an empty workspace", actor: owner},
+		{name: "[Fail] admin", actor: admin, wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] workspace has contents", actor: owner, hasContents: true, wantErr: domainerr.ErrWorkspaceNotEmpty},
+		{name: "[Fail] not a member", wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertErr(t, ValidateDeletion(tt.actor, tt.hasContents), tt.wantErr)
+		})
+	}
+}
+
+func TestChangeRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		actor   *Member
+		target  *Member
+		role    Role
+		wantErr error
+	}{
+		{name: "[Success] admin promotes a member", actor: admin, target: member, role: RoleAdmin},
+		{name: "[Fail] member cannot change roles", actor: member, target: admin, role: RoleMember, wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] owner cannot be demoted", actor: admin, target: owner, role: RoleMember, wantErr: domainerr.ErrInvalidWorkspaceMember},
+		{name: "[Fail] ownership cannot be given", actor: owner, target: admin, role: RoleOwner, wantErr: domainerr.ErrInvalidWorkspaceMember},
+		{name: "[Fail] unknown role", actor: owner, target: member, role: "guest", wantErr: domainerr.ErrInvalidWorkspaceRole},
+		{name: "[Fail] target not a member", actor: owner, role: RoleAdmin, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ChangeRole(tt.actor, tt.target, tt.role)
+			assertErr(t, err, tt.wantErr)
+			if tt.wantErr == nil && (got.Role != tt.role || got.AccountID != tt.target.AccountID) {
+				t.Fatalf("unexpected member: %+v", got)
+			}
+		})
+	}
+}
+
+func TestValidateRemoval(t *testing.T) {
+	tests := []struct {
+		name    string
+		actor   *Member
+		target  *Member
+		wantErr error
+	}{
+		{name: "[Success] admin removes a member", actor: admin, target: member},
+		{name: "[Success] member leaves", actor: member, target: member},
+		{name: "[Fail] member removes another", actor: member, target: admin, wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] owner cannot leave", actor: owner, target: owner, wantErr: domainerr.ErrInvalidWorkspaceMember},
+		{name: "[Fail] not a member", target: member, wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertErr(t, ValidateRemoval(tt.actor, tt.target), tt.wantErr)
+		})
+	}
+}
+
+func TestNewInvite(t *testing.T) {
+	tests := []struct {
+		name     string
+		actor    *Member
+		email    string
+		role     Role
+		wantRole Role
+		wantErr  error
+	}{
+		{name: "[Success] defaults to member and lower-cases the email", actor: admin, email: " Friend@Example.com ", wantRole: RoleMember},
+		{name: "[Success] invite an admin", actor: owner, email: "friend@example.com", role: RoleAdmin, wantRole: RoleAdmin},
+		{name: "[Fail] member cannot invite", actor: member, email: "friend@example.com", wantErr: domainerr.ErrUnauthorized},
+		{name: "[Fail] invalid email", actor: owner, email: "friend", wantErr: account.ErrInvalidEmail},
+		{name: "[Fail] owner role", actor: owner, email: "friend@example.com", role: RoleOwner, wantErr: domainerr.ErrInvalidWorkspaceRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewInvite(tt.actor, tt.email, tt.role)
+			assertErr(t, err, tt.wantErr)
+			if tt.wantErr == nil && (got.Email != "friend@example.com" || got.Role != tt.wantRole || got.WorkspaceID != "w1" || got.InvitedBy != tt.actor.AccountID) {
+				t.Fatalf("unexpected invite: %+v", got)
+			}
+		})
+	}
+}
+
+func TestInvite_Accept(t *testing.T) {
+	invite := Invite{ID: "i1", WorkspaceID: "w1", Email: "friend@example.com", Role: RoleAdmin}
+	tests := []struct {
+		name     string
+		email    string
+		existing *Member
+		wantRole Role
+		wantErr  error
+	}{
+		{name: "[Success] accept", email: "Friend@example.com", wantRole: RoleAdmin},
+		{name: "[Success] already a member keeps the role", email: "friend@example.com", existing: &Member{WorkspaceID: "w1", AccountID: "friend", Role: RoleMember}, wantRole: RoleMember},
+		{name: "[Fail] another email", email: "other@example.com", wantErr: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := invite.Accept("friend", tt.email, tt.existing)
+			assertErr(t, err, tt.wantErr)
+			if tt.wantErr == nil && (got.Role != tt.wantRole || got.AccountID != "friend" || got.WorkspaceID != "w1") {
+				t.Fatalf("unexpected member: %+v", got)
+			}
+		})
+	}
+}
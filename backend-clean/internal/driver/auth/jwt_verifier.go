@@ -0,0 +1,73 @@
+// Package auth provides access token verification for the API servers.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/driver/config"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// JWTVerifier validates signed JWT access tokens.
+// The account ID is taken from the "sub" claim.
+type JWTVerifier struct {
+	key    any
+	parser *jwt.Parser
+}
+
+var _ port.TokenVerifier = (*JWTVerifier)(nil)
+
+// NewJWTVerifier builds a verifier from the authentication settings in cfg.
+func NewJWTVerifier(cfg *config.Config) (*JWTVerifier, error) {
+	var key any
+	switch cfg.JWTAlgorithm {
+	case jwt.SigningMethodHS256.Alg():
+		if cfg.JWTSecret == "" {
+			return nil, errors.New("JWT_SECRET is required for HS256")
+		}
+		key = []byte(cfg.JWTSecret)
+	case jwt.SigningMethodRS256.Alg():
+		if cfg.JWTPublicKey == "" {
+			return nil, errors.New("JWT_PUBLIC_KEY is required for RS256")
+		}
+		pub, err := jwt.ParseRSAPublicKeyFromPEM([]byte(cfg.JWTPublicKey))
+		if err != nil {
+			return nil, fmt.Errorf("parse JWT_PUBLIC_KEY: %w", err)
+		}
+		key = pub
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm: %s", cfg.JWTAlgorithm)
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{cfg.JWTAlgorithm}),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.JWTIssuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.JWTIssuer))
+	}
+	if cfg.JWTAudience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.JWTAudience))
+	}
+	return &JWTVerifier{key: key, parser: jwt.NewParser(opts...)}, nil
+}
+
+// Verify parses and validates the token and returns its subject.
+func (v *JWTVerifier) Verify(_ context.Context, token string) (string, error) {
+	claims := &jwt.RegisteredClaims{}
+	if _, err := v.parser.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return v.key, nil
+	}); err != nil {
+		return "", fmt.Errorf("%w: %w", domainerr.ErrUnauthenticated, err)
+	}
+	if strings.TrimSpace(claims.Subject) == "" {
+		return "", domainerr.ErrUnauthenticated
+	}
+	return claims.Subject, nil
+}
@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/driver/config"
+)
+
+const testSecret = "test-secret"
+
+func signHS256(t *testing.T, secret string, claims jwt.RegisteredClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+func validClaims() jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   "acc-1",
+		Issuer:    "issuer",
+		Audience:  jwt.ClaimStrings{"api"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestJWTVerifier_HS256(t *testing.T) {
+	verifier, err := NewJWTVerifier(&config.Config{JWTAlgorithm: "HS256", JWTSecret: testSecret, JWTIssuer: "issuer", JWTAudience: "api"})
+	if err != nil {
+		t.Fatalf("NewJWTVerifier: %v", err)
+	}
+
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	noSub := validClaims()
+	noSub.Subject = ""
+	noExp := validClaims()
+	noExp.ExpiresAt = nil
+	wrongIssuer := validClaims()
+	wrongIssuer.Issuer = "other"
+	wrongAudience := validClaims()
+	wrongAudience.Audience = jwt.ClaimStrings{"other"}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    string
+		wantErr bool
+	}{
+		{name: "[Success] valid token", token: signHS256(t, testSecret, validClaims()), want: "acc-1"},
+		{name: "[Fail] wrong secret", token: signHS256(t, "other-secret", validClaims()), wantErr: true},
+		{name: "[Fail] expired", token: signHS256(t, testSecret, expired), wantErr: true},
+		{name: "[Fail] missing exp", token: signHS256(t, testSecret, noExp), wantErr: true},
+		{name: "[Fail] missing sub", token: signHS256(t, testSecret, noSub), wantErr: true},
+		{name: "[Fail] wrong issuer", token: signHS256(t, testSecret, wrongIssuer), wantErr: true},
+		{name: "[Fail] wrong audience", token: signHS256(t, testSecret, wrongAudience), wantErr: true},
+		{name: "[Fail] malformed", token: "not-a-jwt", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifier.Verify(context.Background(), tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, domainerr.ErrUnauthenticated) {
+					t.Fatalf("err = %v, want ErrUnauthenticated", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("subject = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJWTVerifier_RS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	verifier, err := NewJWTVerifier(&config.Config{JWTAlgorithm: "RS256", JWTPublicKey: string(pubPEM)})
+	if err != nil {
+		t.Fatalf("NewJWTVerifier: %v", err)
+	}
+
+	rsToken, err := jwt.NewWithClaims(jwt.SigningMethodRS256, validClaims()).SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "[Success] valid RS256 token", token: rsToken},
+		{name: "[Fail] HS256 token rejected", token: signHS256(t, string(pubPEM), validClaims()), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifier.Verify(context.Background(), tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != "acc-1" {
+				t.Fatalf("subject = %q, want acc-1", got)
+			}
+		})
+	}
+}
+
+func TestNewJWTVerifier(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.Config
+	}{
+		{name: "[Fail] HS256 without secret", cfg: config.Config{JWTAlgorithm: "HS256"}},
+		{name: "[Fail] RS256 without public key", cfg: config.Config{JWTAlgorithm: "RS256"}},
+		{name: "[Fail] RS256 invalid public key", cfg: config.Config{JWTAlgorithm: "RS256", JWTPublicKey: "garbage"}},
+		{name: "[Fail] unsupported algorithm", cfg: config.Config{JWTAlgorithm: "none", JWTSecret: testSecret}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewJWTVerifier(&tt.cfg); err == nil {
+				t.Fatal("expected error but got nil")
+			}
+		})
+	}
+}
@@ -6,8 +6,15 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 )
 
+// DefaultShutdownTimeout is how long the servers wait for requests in flight on shutdown.
+const DefaultShutdownTimeout = 30 * time.Second
+
 // Config holds all application configuration.
 type Config struct {
 	// Database configuration
@@ -18,6 +25,40 @@ type Config struct {
 
 	// CORS configuration
 	AllowedOrigins []string
+
+	// Authentication configuration
+	JWTAlgorithm string
+	JWTSecret    string
+	JWTPublicKey string
+	JWTIssuer    string
+	JWTAudience  string
+
+	// Trash configuration; zero keeps trashed notes and templates until purged by hand
+	TrashRetention time.Duration
+
+	// Audit log configuration; only these accounts may read the audit log
+	AdminAccountIDs []string
+
+	// Rate limit configuration; a zero limit turns that class off
+	RateLimitStore   string // "memory" (per instance) or "postgres" (shared)
+	RateLimitRead    ratelimit.Policy
+	RateLimitWrite   ratelimit.Policy
+	RateLimitPreAuth ratelimit.Policy // per client IP before the token is checked
+
+	// Tracing configuration; "none" records no spans
+	TraceExporter     string  // "none", "stdout" or "otlp"
+	TraceFile         string  // file the stdout exporter appends to instead of stdout
+	TraceOTLPEndpoint string  // URL of the OTLP/HTTP collector; empty uses the OTEL_EXPORTER_OTLP_* variables
+	TraceSampleRatio  float64 // share of new traces to record, from 0 to 1
+
+	// Metrics configuration; /metrics is served on listeners of its own, away from the public ports
+	MetricsPort     int // port the API server serves /metrics on; 0 turns it off
+	GRPCMetricsPort int // port the gRPC server serves /metrics on; 0 turns it off
+
+	// Shutdown configuration; on SIGTERM the servers fail readiness, wait ShutdownDelay,
+	// then give requests in flight up to ShutdownTimeout to finish
+	ShutdownDelay   time.Duration
+	ShutdownTimeout time.Duration
 }
 
 // Load reads configuration from environment variables.
@@ -38,28 +79,166 @@ func Load() (*Config, error) {
 
 	origins := parseAllowedOrigins(os.Getenv("CLIENT_ORIGIN"))
 
+	alg := strings.ToUpper(strings.TrimSpace(os.Getenv("JWT_ALGORITHM")))
+	if alg == "" {
+		alg = "HS256"
+	}
+	if alg != "HS256" && alg != "RS256" {
+		return nil, errors.New("JWT_ALGORITHM must be HS256 or RS256")
+	}
+
+	retention := trash.DefaultRetention
+	if daysStr := os.Getenv("TRASH_RETENTION_DAYS"); daysStr != "" {
+		days, err := strconv.Atoi(daysStr)
+		if err != nil || days < 0 {
+			return nil, errors.New("TRASH_RETENTION_DAYS must be a non-negative integer")
+		}
+		retention = time.Duration(days) * 24 * time.Hour
+	}
+
+	store := strings.ToLower(strings.TrimSpace(os.Getenv("RATE_LIMIT_STORE")))
+	if store == "" {
+		store = "memory"
+	}
+	if store != "memory" && store != "postgres" {
+		return nil, errors.New("RATE_LIMIT_STORE must be memory or postgres")
+	}
+	read, err := parsePerMinute("RATE_LIMIT_READS_PER_MINUTE", ratelimit.DefaultReadPolicy)
+	if err != nil {
+		return nil, err
+	}
+	write, err := parsePerMinute("RATE_LIMIT_WRITES_PER_MINUTE", ratelimit.DefaultWritePolicy)
+	if err != nil {
+		return nil, err
+	}
+	preAuth, err := parsePerMinute("RATE_LIMIT_PREAUTH_PER_MINUTE", ratelimit.DefaultPreAuthPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter := strings.ToLower(strings.TrimSpace(os.Getenv("TRACE_EXPORTER")))
+	if exporter == "" {
+		exporter = "none"
+	}
+	if exporter != "none" && exporter != "stdout" && exporter != "otlp" {
+		return nil, errors.New("TRACE_EXPORTER must be none, stdout or otlp")
+	}
+	ratio := 1.0
+	if ratioStr := os.Getenv("TRACE_SAMPLE_RATIO"); ratioStr != "" {
+		parsed, err := strconv.ParseFloat(ratioStr, 64)
+		if err != nil || parsed < 0 || parsed > 1 {
+			return nil, errors.New("TRACE_SAMPLE_RATIO must be a number from 0 to 1")
+		}
+		ratio = parsed
+	}
+
+	// The gRPC server listens on port+1, so the metrics default to the ports after that.
+	grpcMetricsPort, err := parsePort("GRPC_METRICS_PORT", port+2)
+	if err != nil {
+		return nil, err
+	}
+	metricsPort, err := parsePort("METRICS_PORT", port+3)
+	if err != nil {
+		return nil, err
+	}
+
+	shutdownDelay, err := parseSeconds("SHUTDOWN_DELAY_SECONDS", 0)
+	if err != nil {
+		return nil, err
+	}
+	shutdownTimeout, err := parseSeconds("SHUTDOWN_TIMEOUT_SECONDS", DefaultShutdownTimeout)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Config{
-		DatabaseURL:    dbURL,
-		ServerPort:     port,
-		AllowedOrigins: origins,
+		DatabaseURL:      dbURL,
+		ServerPort:       port,
+		AllowedOrigins:   origins,
+		JWTAlgorithm:     alg,
+		JWTSecret:        os.Getenv("JWT_SECRET"),
+		JWTPublicKey:     os.Getenv("JWT_PUBLIC_KEY"),
+		JWTIssuer:        os.Getenv("JWT_ISSUER"),
+		JWTAudience:      os.Getenv("JWT_AUDIENCE"),
+		TrashRetention:   retention,
+		AdminAccountIDs:  parseList(os.Getenv("ADMIN_ACCOUNT_IDS")),
+		RateLimitStore:   store,
+		RateLimitRead:    read,
+		RateLimitWrite:   write,
+		RateLimitPreAuth: preAuth,
+
+		TraceExporter:     exporter,
+		TraceFile:         strings.TrimSpace(os.Getenv("TRACE_FILE")),
+		TraceOTLPEndpoint: strings.TrimSpace(os.Getenv("TRACE_OTLP_ENDPOINT")),
+		TraceSampleRatio:  ratio,
+
+		MetricsPort:     metricsPort,
+		GRPCMetricsPort: grpcMetricsPort,
+
+		ShutdownDelay:   shutdownDelay,
+		ShutdownTimeout: shutdownTimeout,
 	}, nil
 }
 
-// parseAllowedOrigins parses comma-separated allowed origins from environment variable.
-// Returns default localhost origins if empty.
-func parseAllowedOrigins(fromEnv string) []string {
-	if strings.TrimSpace(fromEnv) == "" {
-		return []string{"http://localhost:3000", "http://127.0.0.1:3000"}
+// parsePort reads a port number from the environment variable name; 0 is allowed.
+func parsePort(name string, def int) (int, error) {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def, nil
+	}
+	port, err := strconv.Atoi(raw)
+	if err != nil || port < 0 {
+		return 0, errors.New(name + " must be a non-negative integer")
 	}
+	return port, nil
+}
 
+// parseSeconds reads a duration given in whole seconds from the environment variable name.
+func parseSeconds(name string, def time.Duration) (time.Duration, error) {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def, nil
+	}
+	seconds, err := strconv.Atoi(raw)
+	if err != nil || seconds < 0 {
+		return 0, errors.New(name + " must be a non-negative integer")
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// parsePerMinute reads a per-minute request budget from the environment variable name.
+func parsePerMinute(name string, def ratelimit.Policy) (ratelimit.Policy, error) {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def, nil
+	}
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 0 {
+		return ratelimit.Policy{}, errors.New(name + " must be a non-negative integer")
+	}
+	return ratelimit.Policy{Limit: limit, Window: time.Minute}, nil
+}
+
+// parseList parses a comma-separated list, dropping empty items.
+func parseList(fromEnv string) []string {
 	parts := strings.Split(fromEnv, ",")
-	origins := make([]string, 0, len(parts))
+	items := make([]string, 0, len(parts))
 	for _, p := range parts {
 		if trimmed := strings.TrimSpace(p); trimmed != "" {
-			origins = append(origins, trimmed)
+			items = append(items, trimmed)
 		}
 	}
+	return items
+}
+
+// parseAllowedOrigins parses comma-separated allowed origins from environment variable.
+// Returns default localhost origins if empty.
+func parseAllowedOrigins(fromEnv string) []string {
+	if strings.TrimSpace(fromEnv) == "" {
+		return []string{"http://localhost:3000", "http://127.0.0.1:3000"}
+	}
 
+	origins := parseList(fromEnv)
 	if len(origins) == 0 {
 		return []string{"http://localhost:3000", "http://127.0.0.1:3000"}
 	}
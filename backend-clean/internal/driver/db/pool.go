@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+
+	"immortal-architecture-clean/backend/internal/driver/telemetry"
 )
 
 // NewPool creates a pgx connection pool with sane defaults.
@@ -19,6 +22,8 @@ func NewPool(ctx context.Context, url string) (*pgxpool.Pool, error) {
 	cfg.MinConns = 1
 	cfg.MaxConnLifetime = time.Hour
 	cfg.MaxConnIdleTime = 10 * time.Minute
+	// Spans go to the global tracer provider, which does nothing until a server sets it up.
+	cfg.ConnConfig.Tracer = NewQueryTracer(otel.Tracer(telemetry.TracerName))
 
 	return pgxpool.NewWithConfig(ctx, cfg)
 }
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// schemaVersionSQL reads the state golang-migrate records. The table belongs to the
+// migration tool rather than the schema, so the query is not generated by sqlc.
+const schemaVersionSQL = "-- name: GetSchemaMigration :one\nSELECT version, dirty FROM schema_migrations LIMIT 1"
+
+// pinger is the part of pgxpool.Pool that readiness needs.
+type pinger interface {
+	Ping(ctx context.Context) error
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// Readiness reports the database ready when it answers and has at least the
+// migrations this build was compiled with. A database that is further ahead is
+// ready too, so that old instances keep serving while a deploy rolls out.
+type Readiness struct {
+	pool pinger
+	want int64
+}
+
+// NewReadiness creates a readiness check that requires the latest migration in migrations.
+func NewReadiness(pool pinger, migrations fs.FS) (*Readiness, error) {
+	want, err := LatestMigration(migrations)
+	if err != nil {
+		return nil, err
+	}
+	return &Readiness{pool: pool, want: want}, nil
+}
+
+// Ready implements port.ReadinessChecker.
+func (r *Readiness) Ready(ctx context.Context) error {
+	if err := r.pool.Ping(ctx); err != nil {
+		return fmt.Errorf("ping database: %w", err)
+	}
+	var version int64
+	var dirty bool
+	err := r.pool.QueryRow(ctx, schemaVersionSQL).Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("no migrations applied, want %d", r.want)
+	}
+	if err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("migration %d failed halfway (dirty)", version)
+	}
+	if version < r.want {
+		return fmt.Errorf("database is at migration %d, want %d", version, r.want)
+	}
+	return nil
+}
+
+// LatestMigration returns the highest version among the up migrations in fsys.
+func LatestMigration(fsys fs.FS) (int64, error) {
+	names, err := fs.Glob(fsys, "*.up.sql")
+	if err != nil {
+		return 0, err
+	}
+	var latest int64
+	for _, name := range names {
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			return 0, fmt.Errorf("migration %q has no version prefix", name)
+		}
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("migration %q has no version prefix", name)
+		}
+		latest = max(latest, version)
+	}
+	if latest == 0 {
+		return 0, errors.New("no migrations found")
+	}
+	return latest, nil
+}
@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jackc/pgx/v5"
+
+	"immortal-architecture-clean/backend/migrations"
+)
+
+type fakeRow struct {
+	version int64
+	dirty   bool
+	err     error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	*dest[0].(*int64) = r.version
+	*dest[1].(*bool) = r.dirty
+	return nil
+}
+
+type fakePool struct {
+	pingErr error
+	row     fakeRow
+}
+
+func (p fakePool) Ping(context.Context) error {
+	return p.pingErr
+}
+
+func (p fakePool) QueryRow(context.Context, string, ...any) pgx.Row {
+	return p.row
+}
+
+func TestReadiness(t *testing.T) {
+	files := fstest.MapFS{
+		"20260101000000_init.up.sql":       {},
+		"20260101000000_init.down.sql":     {},
+		"20260201000000_add_tags.up.sql":   {},
+		"20260201000000_add_tags.down.sql": {},
+	}
+
+	tests := []struct {
+		name    string
+		pool    fakePool
+		wantErr bool
+	}{
+		{name: "[Success] migrations current", pool: fakePool{row: fakeRow{version: 20260201000000}}},
+		{name: "[Success] database ahead of this build", pool: fakePool{row: fakeRow{version: 20260301000000}}},
+		{name: "[Fail] database unreachable", pool: fakePool{pingErr: errors.New("connection refused")}, wantErr: true},
+		{name: "[Fail] migrations behind", pool: fakePool{row: fakeRow{version: 20260101000000}}, wantErr: true},
+		{name: "[Fail] dirty migration", pool: fakePool{row: fakeRow{version: 20260201000000, dirty: true}}, wantErr: true},
+		{name: "[Fail] no migrations applied", pool: fakePool{row: fakeRow{err: pgx.ErrNoRows}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReadiness(tt.pool, files)
+			if err != nil {
+				t.Fatalf("new readiness: %v", err)
+			}
+			err = r.Ready(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Ready() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLatestMigration(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		want    int64
+		wantErr bool
+	}{
+		{
+			name:  "[Success] highest up migration",
+			files: fstest.MapFS{"2_b.up.sql": {}, "10_c.up.sql": {}, "1_a.up.sql": {}, "11_d.down.sql": {}},
+			want:  10,
+		},
+		{name: "[Fail] no migrations", files: fstest.MapFS{}, wantErr: true},
+		{name: "[Fail] name without a version", files: fstest.MapFS{"init.up.sql": {}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LatestMigration(tt.files)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LatestMigration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("LatestMigration() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLatestMigration_Embedded(t *testing.T) {
+	if _, err := LatestMigration(migrations.FS); err != nil {
+		t.Fatalf("embedded migrations: %v", err)
+	}
+}
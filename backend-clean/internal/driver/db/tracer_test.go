@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestQueryTracer(t *testing.T) {
+	tests := []struct {
+		name       string
+		sql        string
+		err        error
+		wantName   string
+		wantStatus codes.Code
+	}{
+		{name: "[Success] sqlc query", sql: "-- name: GetNoteByID :one\nSELECT * FROM notes WHERE id = $1", wantName: "GetNoteByID", wantStatus: codes.Unset},
+		{name: "[Success] statement without a name", sql: "  begin", wantName: "BEGIN", wantStatus: codes.Unset},
+		{name: "[Success] no rows is not an error", sql: "-- name: GetNoteByID :one\nSELECT 1", err: pgx.ErrNoRows, wantName: "GetNoteByID", wantStatus: codes.Unset},
+		{name: "[Fail] query error", sql: "-- name: UpdateNote :one\nUPDATE notes SET title = $1", err: errors.New("deadlock detected"), wantName: "UpdateNote", wantStatus: codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			tracer := NewQueryTracer(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test"))
+
+			ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: tt.sql})
+			tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("UPDATE 1"), Err: tt.err})
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("spans = %d, want 1", len(spans))
+			}
+			span := spans[0]
+			if span.Name() != tt.wantName {
+				t.Errorf("name = %q, want %q", span.Name(), tt.wantName)
+			}
+			if span.Status().Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", span.Status().Code, tt.wantStatus)
+			}
+			attrs := attribute.NewSet(span.Attributes()...)
+			if v, _ := attrs.Value("db.operation.name"); v.AsString() != tt.wantName {
+				t.Errorf("db.operation.name = %q, want %q", v.AsString(), tt.wantName)
+			}
+		})
+	}
+}
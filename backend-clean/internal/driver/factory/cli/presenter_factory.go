@@ -0,0 +1,11 @@
+// Package cli provides factory functions for command-line adapters.
+package cli
+
+import clipresenter "immortal-architecture-clean/backend/internal/adapter/cli/presenter"
+
+// NewArchiveOutputFactory returns a factory for ArchivePresenter.
+func NewArchiveOutputFactory() func() *clipresenter.ArchivePresenter {
+	return func() *clipresenter.ArchivePresenter {
+		return clipresenter.NewArchivePresenter()
+	}
+}
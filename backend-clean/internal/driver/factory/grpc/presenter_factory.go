@@ -9,3 +9,17 @@ func NewAccountOutputFactory() func() *grpcpresenter.AccountPresenter {
 		return grpcpresenter.NewAccountPresenter()
 	}
 }
+
+// NewNoteOutputFactory returns a factory for gRPC NotePresenter.
+func NewNoteOutputFactory() func() *grpcpresenter.NotePresenter {
+	return func() *grpcpresenter.NotePresenter {
+		return grpcpresenter.NewNotePresenter()
+	}
+}
+
+// NewTemplateOutputFactory returns a factory for gRPC TemplatePresenter.
+func NewTemplateOutputFactory() func() *grpcpresenter.TemplatePresenter {
+	return func() *grpcpresenter.TemplatePresenter {
+		return grpcpresenter.NewTemplatePresenter()
+	}
+}
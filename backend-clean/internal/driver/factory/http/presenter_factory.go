@@ -23,3 +23,59 @@ func NewNoteOutputFactory() func() *httppresenter.NotePresenter {
 		return httppresenter.NewNotePresenter()
 	}
 }
+
+// NewNoteLinkOutputFactory returns a factory for HTTP NoteLinkPresenter.
+func NewNoteLinkOutputFactory() func() *httppresenter.NoteLinkPresenter {
+	return func() *httppresenter.NoteLinkPresenter {
+		return httppresenter.NewNoteLinkPresenter()
+	}
+}
+
+// NewNoteRevisionOutputFactory returns a factory for HTTP NoteRevisionPresenter.
+func NewNoteRevisionOutputFactory() func() *httppresenter.NoteRevisionPresenter {
+	return func() *httppresenter.NoteRevisionPresenter {
+		return httppresenter.NewNoteRevisionPresenter()
+	}
+}
+
+// NewWebhookOutputFactory returns a factory for HTTP WebhookPresenter.
+func NewWebhookOutputFactory() func() *httppresenter.WebhookPresenter {
+	return func() *httppresenter.WebhookPresenter {
+		return httppresenter.NewWebhookPresenter()
+	}
+}
+
+// NewAuditOutputFactory returns a factory for HTTP AuditPresenter.
+func NewAuditOutputFactory() func() *httppresenter.AuditPresenter {
+	return func() *httppresenter.AuditPresenter {
+		return httppresenter.NewAuditPresenter()
+	}
+}
+
+// NewTagOutputFactory returns a factory for HTTP TagPresenter.
+func NewTagOutputFactory() func() *httppresenter.TagPresenter {
+	return func() *httppresenter.TagPresenter {
+		return httppresenter.NewTagPresenter()
+	}
+}
+
+// NewCommentOutputFactory returns a factory for HTTP CommentPresenter.
+func NewCommentOutputFactory() func() *httppresenter.CommentPresenter {
+	return func() *httppresenter.CommentPresenter {
+		return httppresenter.NewCommentPresenter()
+	}
+}
+
+// NewNoteCollaboratorOutputFactory returns a factory for HTTP NoteCollaboratorPresenter.
+func NewNoteCollaboratorOutputFactory() func() *httppresenter.NoteCollaboratorPresenter {
+	return func() *httppresenter.NoteCollaboratorPresenter {
+		return httppresenter.NewNoteCollaboratorPresenter()
+	}
+}
+
+// NewWorkspaceOutputFactory returns a factory for HTTP WorkspacePresenter.
+func NewWorkspaceOutputFactory() func() *httppresenter.WorkspacePresenter {
+	return func() *httppresenter.WorkspacePresenter {
+		return httppresenter.NewWorkspacePresenter()
+	}
+}
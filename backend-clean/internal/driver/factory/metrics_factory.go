@@ -0,0 +1,83 @@
+package factory
+
+import (
+	"immortal-architecture-clean/backend/internal/driver/metrics"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WithAccountInputMetrics counts the use case calls of every AccountInputPort the factory creates.
+func WithAccountInputMetrics(f func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort, m *metrics.UseCase) func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort {
+	return func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort {
+		return metrics.NewAccountInputPort(f(repo, workspaces, tx, events, auditLog, output), m)
+	}
+}
+
+// WithTemplateInputMetrics counts the use case calls of every TemplateInputPort the factory creates.
+func WithTemplateInputMetrics(f func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort, m *metrics.UseCase) func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
+	return func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
+		return metrics.NewTemplateInputPort(f(repo, tx, events, auditLog, output), m)
+	}
+}
+
+// WithNoteInputMetrics counts the use case calls of every NoteInputPort the factory creates.
+func WithNoteInputMetrics(f func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort, m *metrics.UseCase) func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+		return metrics.NewNoteInputPort(f(noteRepo, tplRepo, revRepo, linkRepo, tx, events, auditLog, output), m)
+	}
+}
+
+// WithNoteRevisionInputMetrics counts the use case calls of every NoteRevisionInputPort the factory creates.
+func WithNoteRevisionInputMetrics(f func(noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, output port.NoteRevisionOutputPort) port.NoteRevisionInputPort, m *metrics.UseCase) func(noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, output port.NoteRevisionOutputPort) port.NoteRevisionInputPort {
+	return func(noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, output port.NoteRevisionOutputPort) port.NoteRevisionInputPort {
+		return metrics.NewNoteRevisionInputPort(f(noteRepo, revRepo, output), m)
+	}
+}
+
+// WithNoteLinkInputMetrics counts the use case calls of every NoteLinkInputPort the factory creates.
+func WithNoteLinkInputMetrics(f func(noteRepo port.NoteRepository, linkRepo port.NoteLinkRepository, output port.NoteLinkOutputPort) port.NoteLinkInputPort, m *metrics.UseCase) func(noteRepo port.NoteRepository, linkRepo port.NoteLinkRepository, output port.NoteLinkOutputPort) port.NoteLinkInputPort {
+	return func(noteRepo port.NoteRepository, linkRepo port.NoteLinkRepository, output port.NoteLinkOutputPort) port.NoteLinkInputPort {
+		return metrics.NewNoteLinkInputPort(f(noteRepo, linkRepo, output), m)
+	}
+}
+
+// WithWebhookInputMetrics counts the use case calls of every WebhookInputPort the factory creates.
+func WithWebhookInputMetrics(f func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort, m *metrics.UseCase) func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+	return func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+		return metrics.NewWebhookInputPort(f(repo, output), m)
+	}
+}
+
+// WithAuditInputMetrics counts the use case calls of every AuditInputPort the factory creates.
+func WithAuditInputMetrics(f func(repo port.AuditRepository, output port.AuditOutputPort) port.AuditInputPort, m *metrics.UseCase) func(repo port.AuditRepository, output port.AuditOutputPort) port.AuditInputPort {
+	return func(repo port.AuditRepository, output port.AuditOutputPort) port.AuditInputPort {
+		return metrics.NewAuditInputPort(f(repo, output), m)
+	}
+}
+
+// WithTagInputMetrics counts the use case calls of every TagInputPort the factory creates.
+func WithTagInputMetrics(f func(repo port.TagRepository, output port.TagOutputPort) port.TagInputPort, m *metrics.UseCase) func(repo port.TagRepository, output port.TagOutputPort) port.TagInputPort {
+	return func(repo port.TagRepository, output port.TagOutputPort) port.TagInputPort {
+		return metrics.NewTagInputPort(f(repo, output), m)
+	}
+}
+
+// WithCommentInputMetrics counts the use case calls of every CommentInputPort the factory creates.
+func WithCommentInputMetrics(f func(noteRepo port.NoteRepository, commentRepo port.CommentRepository, output port.CommentOutputPort) port.CommentInputPort, m *metrics.UseCase) func(noteRepo port.NoteRepository, commentRepo port.CommentRepository, output port.CommentOutputPort) port.CommentInputPort {
+	return func(noteRepo port.NoteRepository, commentRepo port.CommentRepository, output port.CommentOutputPort) port.CommentInputPort {
+		return metrics.NewCommentInputPort(f(noteRepo, commentRepo, output), m)
+	}
+}
+
+// WithNoteCollaboratorInputMetrics counts the use case calls of every NoteCollaboratorInputPort the factory creates.
+func WithNoteCollaboratorInputMetrics(f func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, collaboratorRepo port.NoteCollaboratorRepository, workspaceRepo port.WorkspaceRepository, output port.NoteCollaboratorOutputPort) port.NoteCollaboratorInputPort, m *metrics.UseCase) func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, collaboratorRepo port.NoteCollaboratorRepository, workspaceRepo port.WorkspaceRepository, output port.NoteCollaboratorOutputPort) port.NoteCollaboratorInputPort {
+	return func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, collaboratorRepo port.NoteCollaboratorRepository, workspaceRepo port.WorkspaceRepository, output port.NoteCollaboratorOutputPort) port.NoteCollaboratorInputPort {
+		return metrics.NewNoteCollaboratorInputPort(f(noteRepo, accountRepo, collaboratorRepo, workspaceRepo, output), m)
+	}
+}
+
+// WithWorkspaceInputMetrics counts the use case calls of every WorkspaceInputPort the factory creates.
+func WithWorkspaceInputMetrics(f func(workspaceRepo port.WorkspaceRepository, inviteRepo port.WorkspaceInviteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort, m *metrics.UseCase) func(workspaceRepo port.WorkspaceRepository, inviteRepo port.WorkspaceInviteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort {
+	return func(workspaceRepo port.WorkspaceRepository, inviteRepo port.WorkspaceInviteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort {
+		return metrics.NewWorkspaceInputPort(f(workspaceRepo, inviteRepo, accountRepo, tx, output), m)
+	}
+}
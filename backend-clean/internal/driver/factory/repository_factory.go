@@ -5,6 +5,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"immortal-architecture-clean/backend/internal/adapter/gateway/db/sqlc"
+	"immortal-architecture-clean/backend/internal/adapter/gateway/ratelimit"
 	// "immortal-architecture-clean/backend/internal/adapter/gateway/db/gorm"
 	"immortal-architecture-clean/backend/internal/port"
 )
@@ -41,3 +42,96 @@ func NewNoteRepoFactory(pool *pgxpool.Pool) func() port.NoteRepository {
 		return sqlc.NewNoteRepository(pool)
 	}
 }
+
+// NewNoteLinkRepoFactory returns a factory that creates NoteLinkRepository.
+func NewNoteLinkRepoFactory(pool *pgxpool.Pool) func() port.NoteLinkRepository {
+	return func() port.NoteLinkRepository {
+		return sqlc.NewNoteLinkRepository(pool)
+	}
+}
+
+// NewNoteRevisionRepoFactory returns a factory that creates NoteRevisionRepository.
+func NewNoteRevisionRepoFactory(pool *pgxpool.Pool) func() port.NoteRevisionRepository {
+	return func() port.NoteRevisionRepository {
+		return sqlc.NewNoteRevisionRepository(pool)
+	}
+}
+
+// NewOutboxRepoFactory returns a factory that creates OutboxRepository.
+func NewOutboxRepoFactory(pool *pgxpool.Pool) func() port.OutboxRepository {
+	return func() port.OutboxRepository {
+		return sqlc.NewOutboxRepository(pool)
+	}
+}
+
+// NewEventPublisherFactory returns a factory that creates an EventPublisher writing to the outbox.
+func NewEventPublisherFactory(pool *pgxpool.Pool) func() port.EventPublisher {
+	return func() port.EventPublisher {
+		return sqlc.NewOutboxRepository(pool)
+	}
+}
+
+// NewWebhookRepoFactory returns a factory that creates WebhookRepository.
+func NewWebhookRepoFactory(pool *pgxpool.Pool) func() port.WebhookRepository {
+	return func() port.WebhookRepository {
+		return sqlc.NewWebhookRepository(pool)
+	}
+}
+
+// NewAuditRecorderFactory returns a factory that creates an AuditRecorder appending to the audit log.
+func NewAuditRecorderFactory(pool *pgxpool.Pool) func() port.AuditRecorder {
+	return func() port.AuditRecorder {
+		return sqlc.NewAuditRepository(pool)
+	}
+}
+
+// NewAuditRepoFactory returns a factory that creates AuditRepository.
+func NewAuditRepoFactory(pool *pgxpool.Pool) func() port.AuditRepository {
+	return func() port.AuditRepository {
+		return sqlc.NewAuditRepository(pool)
+	}
+}
+
+// NewTagRepoFactory returns a factory that creates TagRepository.
+func NewTagRepoFactory(pool *pgxpool.Pool) func() port.TagRepository {
+	return func() port.TagRepository {
+		return sqlc.NewTagRepository(pool)
+	}
+}
+
+// NewCommentRepoFactory returns a factory that creates CommentRepository.
+func NewCommentRepoFactory(pool *pgxpool.Pool) func() port.CommentRepository {
+	return func() port.CommentRepository {
+		return sqlc.NewCommentRepository(pool)
+	}
+}
+
+// NewNoteCollaboratorRepoFactory returns a factory that creates NoteCollaboratorRepository.
+func NewNoteCollaboratorRepoFactory(pool *pgxpool.Pool) func() port.NoteCollaboratorRepository {
+	return func() port.NoteCollaboratorRepository {
+		return sqlc.NewNoteCollaboratorRepository(pool)
+	}
+}
+
+// NewWorkspaceRepoFactory returns a factory that creates WorkspaceRepository.
+func NewWorkspaceRepoFactory(pool *pgxpool.Pool) func() port.WorkspaceRepository {
+	return func() port.WorkspaceRepository {
+		return sqlc.NewWorkspaceRepository(pool)
+	}
+}
+
+// NewWorkspaceInviteRepoFactory returns a factory that creates WorkspaceInviteRepository.
+func NewWorkspaceInviteRepoFactory(pool *pgxpool.Pool) func() port.WorkspaceInviteRepository {
+	return func() port.WorkspaceInviteRepository {
+		return sqlc.NewWorkspaceInviteRepository(pool)
+	}
+}
+
+// NewRateLimitStore returns the token bucket store named by kind. The memory store is
+// shared by every request of this process, so it is created once rather than per request.
+func NewRateLimitStore(kind string, pool *pgxpool.Pool) port.RateLimitStore {
+	if kind == "postgres" {
+		return sqlc.NewRateLimitStore(pool)
+	}
+	return ratelimit.NewMemoryStore()
+}
@@ -0,0 +1,34 @@
+package factory
+
+import (
+	"go.opentelemetry.io/otel/trace"
+
+	"immortal-architecture-clean/backend/internal/adapter/gateway/tracing"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WithNoteRepoTracing wraps every NoteRepository the factory creates in a tracing decorator.
+func WithNoteRepoTracing(f func() port.NoteRepository, tracer trace.Tracer) func() port.NoteRepository {
+	return func() port.NoteRepository {
+		return tracing.NewNoteRepository(f(), tracer)
+	}
+}
+
+// WithTemplateRepoTracing wraps every TemplateRepository the factory creates in a tracing decorator.
+func WithTemplateRepoTracing(f func() port.TemplateRepository, tracer trace.Tracer) func() port.TemplateRepository {
+	return func() port.TemplateRepository {
+		return tracing.NewTemplateRepository(f(), tracer)
+	}
+}
+
+// WithAccountRepoTracing wraps every AccountRepository the factory creates in a tracing decorator.
+func WithAccountRepoTracing(f func() port.AccountRepository, tracer trace.Tracer) func() port.AccountRepository {
+	return func() port.AccountRepository {
+		return tracing.NewAccountRepository(f(), tracer)
+	}
+}
+
+// WithTxTracing wraps the transaction manager in a tracing decorator.
+func WithTxTracing(tx port.TxManager, tracer trace.Tracer) port.TxManager {
+	return tracing.NewTxManager(tx, tracer)
+}
@@ -2,27 +2,118 @@
 package factory
 
 import (
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
 	"immortal-architecture-clean/backend/internal/port"
 	"immortal-architecture-clean/backend/internal/usecase"
 )
 
 // NewAccountInputFactory returns a factory for AccountInteractor.
-func NewAccountInputFactory() func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
-	return func(repo port.AccountRepository, output port.AccountOutputPort) port.AccountInputPort {
-		return usecase.NewAccountInteractor(repo, output)
+func NewAccountInputFactory() func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort {
+	return func(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) port.AccountInputPort {
+		return usecase.NewAccountInteractor(repo, workspaces, tx, events, auditLog, output)
 	}
 }
 
 // NewTemplateInputFactory returns a factory for TemplateInteractor.
-func NewTemplateInputFactory() func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
-	return func(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) port.TemplateInputPort {
-		return usecase.NewTemplateInteractor(repo, tx, output)
+func NewTemplateInputFactory() func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
+	return func(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) port.TemplateInputPort {
+		return usecase.NewTemplateInteractor(repo, tx, events, auditLog, output)
 	}
 }
 
 // NewNoteInputFactory returns a factory for NoteInteractor.
-func NewNoteInputFactory() func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
-	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) port.NoteInputPort {
-		return usecase.NewNoteInteractor(noteRepo, tplRepo, tx, output)
+func NewNoteInputFactory() func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, revRepo port.NoteRevisionRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) port.NoteInputPort {
+		return usecase.NewNoteInteractor(noteRepo, tplRepo, revRepo, linkRepo, tx, events, auditLog, output)
+	}
+}
+
+// NewNoteLinkInputFactory returns a factory for NoteLinkInteractor.
+func NewNoteLinkInputFactory() func(noteRepo port.NoteRepository, linkRepo port.NoteLinkRepository, output port.NoteLinkOutputPort) port.NoteLinkInputPort {
+	return func(noteRepo port.NoteRepository, linkRepo port.NoteLinkRepository, output port.NoteLinkOutputPort) port.NoteLinkInputPort {
+		return usecase.NewNoteLinkInteractor(noteRepo, linkRepo, output)
+	}
+}
+
+// NewNoteRevisionInputFactory returns a factory for NoteRevisionInteractor.
+func NewNoteRevisionInputFactory() func(noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, output port.NoteRevisionOutputPort) port.NoteRevisionInputPort {
+	return func(noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, output port.NoteRevisionOutputPort) port.NoteRevisionInputPort {
+		return usecase.NewNoteRevisionInteractor(noteRepo, revRepo, output)
+	}
+}
+
+// NewArchiveInputFactory returns a factory for ArchiveInteractor.
+func NewArchiveInputFactory() func(accountRepo port.AccountRepository, workspaceRepo port.WorkspaceRepository, tplRepo port.TemplateRepository, noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort {
+	return func(accountRepo port.AccountRepository, workspaceRepo port.WorkspaceRepository, tplRepo port.TemplateRepository, noteRepo port.NoteRepository, revRepo port.NoteRevisionRepository, tx port.TxManager, output port.ArchiveOutputPort) port.ArchiveInputPort {
+		return usecase.NewArchiveInteractor(accountRepo, workspaceRepo, tplRepo, noteRepo, revRepo, tx, output)
+	}
+}
+
+// NewWebhookInputFactory returns a factory for WebhookInteractor.
+func NewWebhookInputFactory() func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+	return func(repo port.WebhookRepository, output port.WebhookOutputPort) port.WebhookInputPort {
+		return usecase.NewWebhookInteractor(repo, output)
+	}
+}
+
+// NewWebhookDispatcherFactory returns a factory for WebhookDispatchInteractor.
+func NewWebhookDispatcherFactory() func(repo port.WebhookRepository, sender port.WebhookSender) port.WebhookDispatcher {
+	return func(repo port.WebhookRepository, sender port.WebhookSender) port.WebhookDispatcher {
+		return usecase.NewWebhookDispatchInteractor(repo, sender)
+	}
+}
+
+// NewTrashPurgerFactory returns a factory for TrashPurgeInteractor.
+func NewTrashPurgerFactory(retention time.Duration) func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder) port.TrashPurger {
+	return func(noteRepo port.NoteRepository, tplRepo port.TemplateRepository, linkRepo port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder) port.TrashPurger {
+		return usecase.NewTrashPurgeInteractor(noteRepo, tplRepo, linkRepo, tx, events, auditLog, retention)
 	}
 }
+
+// NewAuditInputFactory returns a factory for AuditInteractor. admins lists the
+// account IDs allowed to read the audit log.
+func NewAuditInputFactory(admins []string) func(repo port.AuditRepository, output port.AuditOutputPort) port.AuditInputPort {
+	return func(repo port.AuditRepository, output port.AuditOutputPort) port.AuditInputPort {
+		return usecase.NewAuditInteractor(repo, admins, output)
+	}
+}
+
+// NewTagInputFactory returns a factory for TagInteractor.
+func NewTagInputFactory() func(repo port.TagRepository, output port.TagOutputPort) port.TagInputPort {
+	return func(repo port.TagRepository, output port.TagOutputPort) port.TagInputPort {
+		return usecase.NewTagInteractor(repo, output)
+	}
+}
+
+// NewCommentInputFactory returns a factory for CommentInteractor.
+func NewCommentInputFactory() func(noteRepo port.NoteRepository, commentRepo port.CommentRepository, output port.CommentOutputPort) port.CommentInputPort {
+	return func(noteRepo port.NoteRepository, commentRepo port.CommentRepository, output port.CommentOutputPort) port.CommentInputPort {
+		return usecase.NewCommentInteractor(noteRepo, commentRepo, output)
+	}
+}
+
+// NewNoteCollaboratorInputFactory returns a factory for NoteCollaboratorInteractor.
+func NewNoteCollaboratorInputFactory() func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, collaboratorRepo port.NoteCollaboratorRepository, workspaceRepo port.WorkspaceRepository, output port.NoteCollaboratorOutputPort) port.NoteCollaboratorInputPort {
+	return func(noteRepo port.NoteRepository, accountRepo port.AccountRepository, collaboratorRepo port.NoteCollaboratorRepository, workspaceRepo port.WorkspaceRepository, output port.NoteCollaboratorOutputPort) port.NoteCollaboratorInputPort {
+		return usecase.NewNoteCollaboratorInteractor(noteRepo, accountRepo, collaboratorRepo, workspaceRepo, output)
+	}
+}
+
+// NewWorkspaceInputFactory returns a factory for WorkspaceInteractor.
+func NewWorkspaceInputFactory() func(workspaceRepo port.WorkspaceRepository, inviteRepo port.WorkspaceInviteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort {
+	return func(workspaceRepo port.WorkspaceRepository, inviteRepo port.WorkspaceInviteRepository, accountRepo port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) port.WorkspaceInputPort {
+		return usecase.NewWorkspaceInteractor(workspaceRepo, inviteRepo, accountRepo, tx, output)
+	}
+}
+
+// NewWorkspaceResolver returns the resolver that picks the workspace of each request.
+func NewWorkspaceResolver(workspaceRepoFactory func() port.WorkspaceRepository) port.WorkspaceResolver {
+	return usecase.NewWorkspaceResolver(workspaceRepoFactory())
+}
+
+// NewRateLimiter returns the limiter that spends the read and write budgets of each caller.
+func NewRateLimiter(store port.RateLimitStore, read, write, preAuth ratelimit.Policy) port.RateLimiter {
+	return usecase.NewRateLimiter(store, read, write, preAuth)
+}
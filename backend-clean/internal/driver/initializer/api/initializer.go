@@ -3,49 +3,134 @@ package initializer
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
 	httpcontroller "immortal-architecture-clean/backend/internal/adapter/http/controller"
 	openapi "immortal-architecture-clean/backend/internal/adapter/http/generated/openapi"
+	httphealth "immortal-architecture-clean/backend/internal/adapter/http/health"
+	httpmiddleware "immortal-architecture-clean/backend/internal/adapter/http/middleware"
+	"immortal-architecture-clean/backend/internal/adapter/http/problem"
+	"immortal-architecture-clean/backend/internal/driver/auth"
 	"immortal-architecture-clean/backend/internal/driver/config"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	httpfactory "immortal-architecture-clean/backend/internal/driver/factory/http"
+	"immortal-architecture-clean/backend/internal/driver/metrics"
+	"immortal-architecture-clean/backend/internal/driver/telemetry"
+	"immortal-architecture-clean/backend/migrations"
+)
+
+// Operational routes. Probes do not sign in, so these are left out of
+// authentication and rate limiting. Metrics are served on a port of their own.
+const (
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
 )
 
 // BuildServer composes all dependencies and returns an Echo server, config, and cleanup function.
+// The readiness probe fails once ctx is done, so ctx should be cancelled when shutdown begins.
 func BuildServer(ctx context.Context) (*echo.Echo, *config.Config, func(), error) {
 	cfg, err := config.Load()
 	if err != nil {
 		return nil, nil, func() {}, err
 	}
 
+	verifier, err := auth.NewJWTVerifier(cfg)
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+
+	tracing, err := telemetry.SetupTracing(ctx, cfg, "mini-notion-api")
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+
 	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
 	if err != nil {
+		tracing.Close()
 		return nil, nil, func() {}, err
 	}
 	cleanup := func() {
 		pool.Close()
+		tracing.Close()
 	}
 
-	txMgr := driverdb.NewTxManager(pool)
+	readiness, err := driverdb.NewReadiness(pool, migrations.FS)
+	if err != nil {
+		cleanup()
+		return nil, nil, func() {}, err
+	}
+
+	registry := metrics.NewRegistry()
+	registry.MustRegister(metrics.NewPoolCollector(pool))
+
+	// Transactions and the note, template and account repositories get a span per call,
+	// and the pool a span per query, under the span of the request.
+	txMgr := factory.WithTxTracing(driverdb.NewTxManager(pool), tracing.Tracer)
 
-	accountRepoFactory := factory.NewAccountRepoFactory(pool)
-	templateRepoFactory := factory.NewTemplateRepoFactory(pool)
-	noteRepoFactory := factory.NewNoteRepoFactory(pool)
+	accountRepoFactory := factory.WithAccountRepoTracing(factory.NewAccountRepoFactory(pool), tracing.Tracer)
+	templateRepoFactory := factory.WithTemplateRepoTracing(factory.NewTemplateRepoFactory(pool), tracing.Tracer)
+	noteRepoFactory := factory.WithNoteRepoTracing(factory.NewNoteRepoFactory(pool), tracing.Tracer)
+	noteRevisionRepoFactory := factory.NewNoteRevisionRepoFactory(pool)
+	noteLinkRepoFactory := factory.NewNoteLinkRepoFactory(pool)
+	webhookRepoFactory := factory.NewWebhookRepoFactory(pool)
 	txFactory := factory.NewTxFactory(txMgr)
+	eventsFactory := factory.NewEventPublisherFactory(pool)
+	auditFactory := factory.NewAuditRecorderFactory(pool)
+	auditRepoFactory := factory.NewAuditRepoFactory(pool)
+	tagRepoFactory := factory.NewTagRepoFactory(pool)
+	commentRepoFactory := factory.NewCommentRepoFactory(pool)
+	collaboratorRepoFactory := factory.NewNoteCollaboratorRepoFactory(pool)
+	workspaceRepoFactory := factory.NewWorkspaceRepoFactory(pool)
+	workspaceInviteRepoFactory := factory.NewWorkspaceInviteRepoFactory(pool)
 
 	accountOutputFactory := httpfactory.NewAccountOutputFactory()
 	templateOutputFactory := httpfactory.NewTemplateOutputFactory()
 	noteOutputFactory := httpfactory.NewNoteOutputFactory()
+	noteRevisionOutputFactory := httpfactory.NewNoteRevisionOutputFactory()
+	noteLinkOutputFactory := httpfactory.NewNoteLinkOutputFactory()
+	webhookOutputFactory := httpfactory.NewWebhookOutputFactory()
+	auditOutputFactory := httpfactory.NewAuditOutputFactory()
+	tagOutputFactory := httpfactory.NewTagOutputFactory()
+	commentOutputFactory := httpfactory.NewCommentOutputFactory()
+	collaboratorOutputFactory := httpfactory.NewNoteCollaboratorOutputFactory()
+	workspaceOutputFactory := httpfactory.NewWorkspaceOutputFactory()
 
-	accountInputFactory := factory.NewAccountInputFactory()
-	templateInputFactory := factory.NewTemplateInputFactory()
-	noteInputFactory := factory.NewNoteInputFactory()
+	// Every input port counts its calls by outcome, so controllers stay unaware of metrics.
+	useCaseMetrics := metrics.NewUseCase(registry)
+	accountInputFactory := factory.WithAccountInputMetrics(factory.NewAccountInputFactory(), useCaseMetrics)
+	templateInputFactory := factory.WithTemplateInputMetrics(factory.NewTemplateInputFactory(), useCaseMetrics)
+	noteInputFactory := factory.WithNoteInputMetrics(factory.NewNoteInputFactory(), useCaseMetrics)
+	noteRevisionInputFactory := factory.WithNoteRevisionInputMetrics(factory.NewNoteRevisionInputFactory(), useCaseMetrics)
+	noteLinkInputFactory := factory.WithNoteLinkInputMetrics(factory.NewNoteLinkInputFactory(), useCaseMetrics)
+	webhookInputFactory := factory.WithWebhookInputMetrics(factory.NewWebhookInputFactory(), useCaseMetrics)
+	auditInputFactory := factory.WithAuditInputMetrics(factory.NewAuditInputFactory(cfg.AdminAccountIDs), useCaseMetrics)
+	tagInputFactory := factory.WithTagInputMetrics(factory.NewTagInputFactory(), useCaseMetrics)
+	commentInputFactory := factory.WithCommentInputMetrics(factory.NewCommentInputFactory(), useCaseMetrics)
+	collaboratorInputFactory := factory.WithNoteCollaboratorInputMetrics(factory.NewNoteCollaboratorInputFactory(), useCaseMetrics)
+	workspaceInputFactory := factory.WithWorkspaceInputMetrics(factory.NewWorkspaceInputFactory(), useCaseMetrics)
+
+	rateLimiter := factory.NewRateLimiter(factory.NewRateLimitStore(cfg.RateLimitStore, pool), cfg.RateLimitRead, cfg.RateLimitWrite, cfg.RateLimitPreAuth)
 
 	e := echo.New()
+	// Client IPs key the rate limits of unauthenticated requests; X-Forwarded-For is only
+	// trusted from loopback and private-network proxies so clients cannot pick their own.
+	e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	// Errors that reach Echo (unknown routes, malformed parameters) are answered as problem details too.
+	e.HTTPErrorHandler = problem.HTTPErrorHandler
+
+	// Tag every request first so the ID is in the context of everything that follows.
+	e.Use(httpmiddleware.RequestID())
+
+	// Trace each request from here on, so the span covers authentication and rate limiting too.
+	e.Use(httpmiddleware.Tracing(tracing.Tracer, tracing.Propagator))
+
+	// Count requests per operation, including those refused by authentication or rate limiting.
+	e.Use(httpmiddleware.Metrics(registry, openapi.OperationIDs))
 
 	// Allow frontend (localhost:3000) to call the API during development.
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
@@ -56,14 +141,100 @@ func BuildServer(ctx context.Context) (*echo.Echo, *config.Config, func(), error
 			echo.HeaderContentType,
 			echo.HeaderAccept,
 			echo.HeaderAuthorization,
+			echo.HeaderXRequestID,
+			httpmiddleware.HeaderXWorkspaceID,
+			"traceparent",
+			"tracestate",
 		},
+		ExposeHeaders: []string{
+			echo.HeaderXRequestID,
+			httpmiddleware.HeaderXWorkspaceID,
+			echo.HeaderRetryAfter,
+			httpmiddleware.HeaderRateLimitLimit,
+			httpmiddleware.HeaderRateLimitRemaining,
+			httpmiddleware.HeaderRateLimitReset,
+		},
+	}))
+
+	// Spend the client IP's loose pre-authentication budget, so requests with bad tokens are limited too.
+	e.Use(httpmiddleware.RateLimitByIP(rateLimiter, func(c echo.Context) bool {
+		return operational(c.Path())
+	}))
+
+	// Every API route requires a bearer token except the OAuth sign-in callback,
+	// which is what issues the account in the first place, and the operational routes.
+	e.Use(httpmiddleware.Authenticate(verifier, func(c echo.Context) bool {
+		if operational(c.Path()) {
+			return true
+		}
+		return c.Request().Method == echo.POST && c.Path() == "/api/accounts/auth"
 	}))
 
-	ac := httpcontroller.NewAccountController(accountInputFactory, accountOutputFactory, accountRepoFactory)
-	nc := httpcontroller.NewNoteController(noteInputFactory, noteOutputFactory, noteRepoFactory, templateRepoFactory, txFactory)
-	tc := httpcontroller.NewTemplateController(templateInputFactory, templateOutputFactory, templateRepoFactory, txFactory)
-	server := httpcontroller.NewServer(ac, nc, tc)
+	// Spend the caller's read or write budget, per account or per IP without one,
+	// before any further work is done for it.
+	e.Use(httpmiddleware.RateLimit(rateLimiter, func(c echo.Context) bool {
+		return operational(c.Path())
+	}))
+
+	// Templates, notes and tags belong to a workspace; everything else is per account.
+	e.Use(httpmiddleware.Workspace(factory.NewWorkspaceResolver(workspaceRepoFactory), func(c echo.Context) bool {
+		return !workspaceScoped(c.Path())
+	}))
+
+	ac := httpcontroller.NewAccountController(accountInputFactory, accountOutputFactory, accountRepoFactory, workspaceRepoFactory, txFactory, eventsFactory, auditFactory)
+	nc := httpcontroller.NewNoteController(noteInputFactory, noteOutputFactory, noteRepoFactory, templateRepoFactory, noteRevisionRepoFactory, noteLinkRepoFactory, txFactory, eventsFactory, auditFactory)
+	nrc := httpcontroller.NewNoteRevisionController(noteRevisionInputFactory, noteRevisionOutputFactory, noteRepoFactory, noteRevisionRepoFactory)
+	nlc := httpcontroller.NewNoteLinkController(noteLinkInputFactory, noteLinkOutputFactory, noteRepoFactory, noteLinkRepoFactory)
+	tc := httpcontroller.NewTemplateController(templateInputFactory, templateOutputFactory, templateRepoFactory, txFactory, eventsFactory, auditFactory)
+	wc := httpcontroller.NewWebhookController(webhookInputFactory, webhookOutputFactory, webhookRepoFactory)
+	auc := httpcontroller.NewAuditController(auditInputFactory, auditOutputFactory, auditRepoFactory)
+	tgc := httpcontroller.NewTagController(tagInputFactory, tagOutputFactory, tagRepoFactory)
+	cc := httpcontroller.NewCommentController(commentInputFactory, commentOutputFactory, noteRepoFactory, commentRepoFactory)
+	ncc := httpcontroller.NewNoteCollaboratorController(collaboratorInputFactory, collaboratorOutputFactory, noteRepoFactory, accountRepoFactory, collaboratorRepoFactory, workspaceRepoFactory)
+	wsc := httpcontroller.NewWorkspaceController(workspaceInputFactory, workspaceOutputFactory, workspaceRepoFactory, workspaceInviteRepoFactory, accountRepoFactory, txFactory)
+	server := httpcontroller.NewServer(ac, nc, nrc, nlc, tc, wc, auc, tgc, cc, ncc, wsc)
 	openapi.RegisterHandlers(e, server)
+	health := httphealth.NewHandler(readiness, ctx.Done())
+	e.GET(livenessPath, health.Live).Name = "healthz"
+	e.GET(readinessPath, health.Ready).Name = "readyz"
+
+	// Metrics stay off the public API port; only the scraper should reach their listener.
+	if cfg.MetricsPort > 0 {
+		stopMetrics, err := metrics.Serve("API", cfg.MetricsPort, registry)
+		if err != nil {
+			cleanup()
+			return nil, nil, func() {}, err
+		}
+		closeAll := cleanup
+		cleanup = func() {
+			stopMetrics()
+			closeAll()
+		}
+	}
 
 	return e, cfg, cleanup, nil
 }
+
+// Shutdown stops accepting connections and waits for requests in flight until ctx
+// expires, then closes the connections that are left.
+func Shutdown(ctx context.Context, e *echo.Echo) error {
+	if err := e.Shutdown(ctx); err != nil {
+		return errors.Join(err, e.Close())
+	}
+	return nil
+}
+
+// operational reports whether the route is one of the operational routes.
+func operational(path string) bool {
+	return path == livenessPath || path == readinessPath
+}
+
+// workspaceScoped reports whether the route reads or writes workspace contents.
+func workspaceScoped(path string) bool {
+	for _, prefix := range []string{"/api/notes", "/api/templates", "/api/tags"} {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -20,22 +20,84 @@ func TestNewServer_Wiring(t *testing.T) {
 		factory.NewAccountInputFactory(),
 		httpfactory.NewAccountOutputFactory(),
 		factory.NewAccountRepoFactory(pool),
+		factory.NewWorkspaceRepoFactory(pool),
+		factory.NewTxFactory(nil),
+		factory.NewEventPublisherFactory(pool),
+		factory.NewAuditRecorderFactory(pool),
 	)
 	tc := httpcontroller.NewTemplateController(
 		factory.NewTemplateInputFactory(),
 		httpfactory.NewTemplateOutputFactory(),
 		factory.NewTemplateRepoFactory(pool),
 		factory.NewTxFactory(nil),
+		factory.NewEventPublisherFactory(pool),
+		factory.NewAuditRecorderFactory(pool),
 	)
 	nc := httpcontroller.NewNoteController(
 		factory.NewNoteInputFactory(),
 		httpfactory.NewNoteOutputFactory(),
 		factory.NewNoteRepoFactory(pool),
 		factory.NewTemplateRepoFactory(pool),
+		factory.NewNoteRevisionRepoFactory(pool),
+		factory.NewNoteLinkRepoFactory(pool),
+		factory.NewTxFactory(nil),
+		factory.NewEventPublisherFactory(pool),
+		factory.NewAuditRecorderFactory(pool),
+	)
+	nrc := httpcontroller.NewNoteRevisionController(
+		factory.NewNoteRevisionInputFactory(),
+		httpfactory.NewNoteRevisionOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewNoteRevisionRepoFactory(pool),
+	)
+	nlc := httpcontroller.NewNoteLinkController(
+		factory.NewNoteLinkInputFactory(),
+		httpfactory.NewNoteLinkOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewNoteLinkRepoFactory(pool),
+	)
+	wc := httpcontroller.NewWebhookController(
+		factory.NewWebhookInputFactory(),
+		httpfactory.NewWebhookOutputFactory(),
+		factory.NewWebhookRepoFactory(pool),
+	)
+	auc := httpcontroller.NewAuditController(
+		factory.NewAuditInputFactory(nil),
+		httpfactory.NewAuditOutputFactory(),
+		factory.NewAuditRepoFactory(pool),
+	)
+	tgc := httpcontroller.NewTagController(
+		factory.NewTagInputFactory(),
+		httpfactory.NewTagOutputFactory(),
+		factory.NewTagRepoFactory(pool),
+	)
+
+	cc := httpcontroller.NewCommentController(
+		factory.NewCommentInputFactory(),
+		httpfactory.NewCommentOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewCommentRepoFactory(pool),
+	)
+
+	ncc := httpcontroller.NewNoteCollaboratorController(
+		factory.NewNoteCollaboratorInputFactory(),
+		httpfactory.NewNoteCollaboratorOutputFactory(),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewNoteCollaboratorRepoFactory(pool),
+		factory.NewWorkspaceRepoFactory(pool),
+	)
+
+	wsc := httpcontroller.NewWorkspaceController(
+		factory.NewWorkspaceInputFactory(),
+		httpfactory.NewWorkspaceOutputFactory(),
+		factory.NewWorkspaceRepoFactory(pool),
+		factory.NewWorkspaceInviteRepoFactory(pool),
+		factory.NewAccountRepoFactory(pool),
 		factory.NewTxFactory(nil),
 	)
 
-	srv := httpcontroller.NewServer(ac, nc, tc)
+	srv := httpcontroller.NewServer(ac, nc, nrc, nlc, tc, wc, auc, tgc, cc, ncc, wsc)
 	if srv == nil {
 		t.Fatalf("server is nil")
 	}
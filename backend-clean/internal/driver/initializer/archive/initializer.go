@@ -0,0 +1,40 @@
+// Package initializer wires dependencies for the archive command.
+package initializer
+
+import (
+	"context"
+
+	clicontroller "immortal-architecture-clean/backend/internal/adapter/cli/controller"
+	"immortal-architecture-clean/backend/internal/driver/config"
+	driverdb "immortal-architecture-clean/backend/internal/driver/db"
+	"immortal-architecture-clean/backend/internal/driver/factory"
+	clifactory "immortal-architecture-clean/backend/internal/driver/factory/cli"
+)
+
+// BuildController composes all dependencies and returns the archive controller and cleanup function.
+func BuildController(ctx context.Context) (*clicontroller.ArchiveController, func(), error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, func() {}, err
+	}
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return nil, func() {}, err
+	}
+	cleanup := func() {
+		pool.Close()
+	}
+
+	controller := clicontroller.NewArchiveController(
+		factory.NewArchiveInputFactory(),
+		clifactory.NewArchiveOutputFactory(),
+		factory.NewAccountRepoFactory(pool),
+		factory.NewWorkspaceRepoFactory(pool),
+		factory.NewTemplateRepoFactory(pool),
+		factory.NewNoteRepoFactory(pool),
+		factory.NewNoteRevisionRepoFactory(pool),
+		factory.NewTxFactory(driverdb.NewTxManager(pool)),
+	)
+	return controller, cleanup, nil
+}
@@ -5,50 +5,201 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	grpccontroller "immortal-architecture-clean/backend/internal/adapter/grpc/controller"
 	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/accountpb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/notepb"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/generated/templatepb"
+	grpchealth "immortal-architecture-clean/backend/internal/adapter/grpc/health"
+	"immortal-architecture-clean/backend/internal/adapter/grpc/interceptor"
+	"immortal-architecture-clean/backend/internal/driver/auth"
 	"immortal-architecture-clean/backend/internal/driver/config"
 	driverdb "immortal-architecture-clean/backend/internal/driver/db"
 	"immortal-architecture-clean/backend/internal/driver/factory"
 	grpcfactory "immortal-architecture-clean/backend/internal/driver/factory/grpc"
+	"immortal-architecture-clean/backend/internal/driver/metrics"
+	"immortal-architecture-clean/backend/internal/driver/telemetry"
+	"immortal-architecture-clean/backend/migrations"
 )
 
 // BuildServer composes all dependencies and returns a gRPC server, config, and cleanup function.
+// The health service reports NOT_SERVING once ctx is done, so ctx should be cancelled when shutdown begins.
 func BuildServer(ctx context.Context) (*grpc.Server, *config.Config, func(), error) {
 	cfg, err := config.Load()
 	if err != nil {
 		return nil, nil, func() {}, err
 	}
 
+	verifier, err := auth.NewJWTVerifier(cfg)
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+
+	tracing, err := telemetry.SetupTracing(ctx, cfg, "mini-notion-grpc")
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+
 	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
 	if err != nil {
+		tracing.Close()
 		return nil, nil, func() {}, err
 	}
 	cleanup := func() {
 		pool.Close()
+		tracing.Close()
 	}
 
-	accountRepoFactory := factory.NewAccountRepoFactory(pool)
-	accountInputFactory := factory.NewAccountInputFactory()
+	readiness, err := driverdb.NewReadiness(pool, migrations.FS)
+	if err != nil {
+		cleanup()
+		return nil, nil, func() {}, err
+	}
+
+	registry := metrics.NewRegistry()
+	registry.MustRegister(metrics.NewPoolCollector(pool))
+
+	// Transactions and the note, template and account repositories get a span per call,
+	// and the pool a span per query, under the span of the request.
+	txMgr := factory.WithTxTracing(driverdb.NewTxManager(pool), tracing.Tracer)
+
+	accountRepoFactory := factory.WithAccountRepoTracing(factory.NewAccountRepoFactory(pool), tracing.Tracer)
+	templateRepoFactory := factory.WithTemplateRepoTracing(factory.NewTemplateRepoFactory(pool), tracing.Tracer)
+	noteRepoFactory := factory.WithNoteRepoTracing(factory.NewNoteRepoFactory(pool), tracing.Tracer)
+	noteRevisionRepoFactory := factory.NewNoteRevisionRepoFactory(pool)
+	noteLinkRepoFactory := factory.NewNoteLinkRepoFactory(pool)
+	txFactory := factory.NewTxFactory(txMgr)
+	eventsFactory := factory.NewEventPublisherFactory(pool)
+	auditFactory := factory.NewAuditRecorderFactory(pool)
+	workspaceRepoFactory := factory.NewWorkspaceRepoFactory(pool)
+
+	// Every input port counts its calls by outcome, so controllers stay unaware of metrics.
+	useCaseMetrics := metrics.NewUseCase(registry)
+	accountInputFactory := factory.WithAccountInputMetrics(factory.NewAccountInputFactory(), useCaseMetrics)
+	templateInputFactory := factory.WithTemplateInputMetrics(factory.NewTemplateInputFactory(), useCaseMetrics)
+	noteInputFactory := factory.WithNoteInputMetrics(factory.NewNoteInputFactory(), useCaseMetrics)
+
 	accountOutputFactory := grpcfactory.NewAccountOutputFactory()
+	templateOutputFactory := grpcfactory.NewTemplateOutputFactory()
+	noteOutputFactory := grpcfactory.NewNoteOutputFactory()
+
+	rateLimiter := factory.NewRateLimiter(factory.NewRateLimitStore(cfg.RateLimitStore, pool), cfg.RateLimitRead, cfg.RateLimitWrite, cfg.RateLimitPreAuth)
 
 	// Create gRPC server
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		interceptor.RequestID(),
+		interceptor.Tracing(tracing.Tracer, tracing.Propagator),
+		interceptor.Metrics(registry),
+		// Spend the peer IP's loose pre-authentication budget, so calls with bad tokens are limited too.
+		interceptor.RateLimitByIP(rateLimiter),
+		// Calls act as the account of their bearer token, except signing in and health checks.
+		interceptor.Authenticate(verifier, unauthenticated),
+		// Spend the caller's read or write budget, per account or per peer IP without one.
+		interceptor.RateLimit(rateLimiter),
+		// Note and template calls work in a workspace the account belongs to, as over HTTP.
+		interceptor.Workspace(factory.NewWorkspaceResolver(workspaceRepoFactory), func(fullMethod string) bool {
+			return !workspaceScoped(fullMethod)
+		}),
+	))
 
 	// Register account service
 	accountController := grpccontroller.NewAccountController(
 		accountInputFactory,
 		accountOutputFactory,
 		accountRepoFactory,
+		workspaceRepoFactory,
+		txFactory,
+		eventsFactory,
+		auditFactory,
 	)
 	accountpb.RegisterAccountServiceServer(s, accountController)
 
+	// Register note service
+	noteController := grpccontroller.NewNoteController(
+		noteInputFactory,
+		noteOutputFactory,
+		noteRepoFactory,
+		templateRepoFactory,
+		noteRevisionRepoFactory,
+		noteLinkRepoFactory,
+		txFactory,
+		eventsFactory,
+		auditFactory,
+	)
+	notepb.RegisterNoteServiceServer(s, noteController)
+
+	// Register template service
+	templateController := grpccontroller.NewTemplateController(
+		templateInputFactory,
+		templateOutputFactory,
+		templateRepoFactory,
+		txFactory,
+		eventsFactory,
+		auditFactory,
+	)
+	templatepb.RegisterTemplateServiceServer(s, templateController)
+
+	// Register health service (grpc.health.v1)
+	healthpb.RegisterHealthServer(s, grpchealth.NewServer(readiness, ctx.Done(),
+		accountpb.AccountService_ServiceDesc.ServiceName,
+		notepb.NoteService_ServiceDesc.ServiceName,
+		templatepb.TemplateService_ServiceDesc.ServiceName,
+	))
+
+	// gRPC has no HTTP routes of its own, so the metrics get a listener of their own.
+	if cfg.GRPCMetricsPort > 0 {
+		stopMetrics, err := metrics.Serve("gRPC", cfg.GRPCMetricsPort, registry)
+		if err != nil {
+			cleanup()
+			return nil, nil, func() {}, err
+		}
+		closeAll := cleanup
+		cleanup = func() {
+			stopMetrics()
+			closeAll()
+		}
+	}
+
 	return s, cfg, cleanup, nil
 }
 
+// unauthenticated reports whether a method is served without a bearer token.
+func unauthenticated(fullMethod string) bool {
+	return fullMethod == accountpb.AccountService_CreateOrGetAccount_FullMethodName ||
+		strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+// workspaceScoped reports whether a method works on the templates and notes of a workspace.
+func workspaceScoped(fullMethod string) bool {
+	for _, service := range []string{notepb.NoteService_ServiceDesc.ServiceName, templatepb.TemplateService_ServiceDesc.ServiceName} {
+		if strings.HasPrefix(fullMethod, "/"+service+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Shutdown stops accepting calls and waits for calls in flight until ctx expires,
+// then cancels the calls that are left.
+func Shutdown(ctx context.Context, s *grpc.Server) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.Stop()
+		return ctx.Err()
+	}
+}
+
 // GetListener creates a TCP listener for the gRPC server.
 func GetListener(cfg *config.Config) (net.Listener, error) {
 	// gRPC uses a different port, add 1 to HTTP port for gRPC
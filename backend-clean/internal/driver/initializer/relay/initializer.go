@@ -0,0 +1,42 @@
+// Package initializer wires dependencies for the outbox relay worker.
+package initializer
+
+import (
+	"context"
+	"os"
+
+	webhookgateway "immortal-architecture-clean/backend/internal/adapter/gateway/webhook"
+	"immortal-architecture-clean/backend/internal/adapter/subscriber"
+	"immortal-architecture-clean/backend/internal/driver/config"
+	driverdb "immortal-architecture-clean/backend/internal/driver/db"
+	"immortal-architecture-clean/backend/internal/driver/factory"
+	"immortal-architecture-clean/backend/internal/driver/outbox"
+)
+
+// BuildRelay composes all dependencies and returns the relay and cleanup function.
+func BuildRelay(ctx context.Context) (*outbox.Relay, func(), error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, func() {}, err
+	}
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return nil, func() {}, err
+	}
+	cleanup := func() {
+		pool.Close()
+	}
+
+	// The relay only enqueues webhook deliveries; cmd/webhook sends them.
+	dispatcher := factory.NewWebhookDispatcherFactory()(factory.NewWebhookRepoFactory(pool)(), webhookgateway.NewHTTPSender(nil))
+
+	relay := outbox.NewRelay(
+		factory.NewOutboxRepoFactory(pool)(),
+		driverdb.NewTxManager(pool),
+		outbox.DefaultConfig(),
+		subscriber.NewLogSubscriber(os.Stdout),
+		subscriber.NewWebhookSubscriber(dispatcher),
+	)
+	return relay, cleanup, nil
+}
@@ -0,0 +1,37 @@
+// Package initializer wires dependencies for the trash purge worker.
+package initializer
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/driver/config"
+	driverdb "immortal-architecture-clean/backend/internal/driver/db"
+	"immortal-architecture-clean/backend/internal/driver/factory"
+	"immortal-architecture-clean/backend/internal/driver/trash"
+)
+
+// BuildWorker composes all dependencies and returns the worker and cleanup function.
+func BuildWorker(ctx context.Context) (*trash.Worker, func(), error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, func() {}, err
+	}
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return nil, func() {}, err
+	}
+	cleanup := func() {
+		pool.Close()
+	}
+
+	purger := factory.NewTrashPurgerFactory(cfg.TrashRetention)(
+		factory.NewNoteRepoFactory(pool)(),
+		factory.NewTemplateRepoFactory(pool)(),
+		factory.NewNoteLinkRepoFactory(pool)(),
+		driverdb.NewTxManager(pool),
+		factory.NewEventPublisherFactory(pool)(),
+		factory.NewAuditRecorderFactory(pool)(),
+	)
+	return trash.NewWorker(purger, trash.DefaultConfig()), cleanup, nil
+}
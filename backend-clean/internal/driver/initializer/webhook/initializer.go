@@ -0,0 +1,34 @@
+// Package initializer wires dependencies for the webhook delivery worker.
+package initializer
+
+import (
+	"context"
+
+	webhookgateway "immortal-architecture-clean/backend/internal/adapter/gateway/webhook"
+	"immortal-architecture-clean/backend/internal/driver/config"
+	driverdb "immortal-architecture-clean/backend/internal/driver/db"
+	"immortal-architecture-clean/backend/internal/driver/factory"
+	"immortal-architecture-clean/backend/internal/driver/webhook"
+)
+
+// BuildWorker composes all dependencies and returns the worker and cleanup function.
+func BuildWorker(ctx context.Context) (*webhook.Worker, func(), error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, func() {}, err
+	}
+
+	pool, err := driverdb.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return nil, func() {}, err
+	}
+	cleanup := func() {
+		pool.Close()
+	}
+
+	dispatcher := factory.NewWebhookDispatcherFactory()(
+		factory.NewWebhookRepoFactory(pool)(),
+		webhookgateway.NewHTTPSender(nil),
+	)
+	return webhook.NewWorker(dispatcher, webhook.DefaultConfig()), cleanup, nil
+}
@@ -0,0 +1,40 @@
+// Package lifecycle runs a server until the process is asked to stop and then
+// shuts it down gracefully.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// Run calls serve and returns when it fails or, once ctx is done, when the server
+// has shut down. After ctx is done it first waits delay, while readiness already
+// fails and load balancers stop routing new requests here, then calls shutdown with
+// a context that expires after timeout, so that requests in flight can finish.
+// serve must return nil once shutdown has stopped it.
+func Run(ctx context.Context, serve func() error, shutdown func(context.Context) error, delay, timeout time.Duration) error {
+	served := make(chan error, 1)
+	go func() {
+		served <- serve()
+	}()
+
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Printf("shutting down: draining after %s, waiting up to %s for requests in flight", delay, timeout)
+	select {
+	case err := <-served:
+		return err
+	case <-time.After(delay):
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := shutdown(shutdownCtx)
+	return errors.Join(err, <-served)
+}
@@ -0,0 +1,71 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeServer serves until it is shut down, like http.Server and grpc.Server do.
+type fakeServer struct {
+	serveErr    error
+	shutdownErr error
+	stopped     chan struct{}
+	gotDeadline bool
+}
+
+func newFakeServer(serveErr, shutdownErr error) *fakeServer {
+	return &fakeServer{serveErr: serveErr, shutdownErr: shutdownErr, stopped: make(chan struct{})}
+}
+
+func (s *fakeServer) serve() error {
+	if s.serveErr != nil {
+		return s.serveErr
+	}
+	<-s.stopped
+	return nil
+}
+
+func (s *fakeServer) shutdown(ctx context.Context) error {
+	_, s.gotDeadline = ctx.Deadline()
+	close(s.stopped)
+	return s.shutdownErr
+}
+
+func TestRun(t *testing.T) {
+	listenErr := errors.New("address already in use")
+	drainErr := context.DeadlineExceeded
+
+	tests := []struct {
+		name         string
+		serveErr     error
+		shutdownErr  error
+		stop         bool
+		wantErr      error
+		wantShutdown bool
+	}{
+		{name: "[Success] shuts down once asked to stop", stop: true, wantShutdown: true},
+		{name: "[Fail] server fails to start", serveErr: listenErr, wantErr: listenErr},
+		{name: "[Fail] requests in flight outlast the timeout", stop: true, shutdownErr: drainErr, wantErr: drainErr, wantShutdown: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.stop {
+				cancel()
+			}
+			s := newFakeServer(tt.serveErr, tt.shutdownErr)
+
+			err := Run(ctx, s.serve, s.shutdown, time.Millisecond, time.Second)
+			if !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Fatalf("Run() error = %v, want %v", err, tt.wantErr)
+			}
+			if s.gotDeadline != tt.wantShutdown {
+				t.Errorf("shut down with a deadline = %v, want %v", s.gotDeadline, tt.wantShutdown)
+			}
+		})
+	}
+}
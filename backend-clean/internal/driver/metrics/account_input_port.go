@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AccountInputPort counts the calls of another AccountInputPort.
+type AccountInputPort struct {
+	next    port.AccountInputPort
+	metrics *UseCase
+}
+
+var _ port.AccountInputPort = (*AccountInputPort)(nil)
+
+// NewAccountInputPort wraps next so that each call is counted by its outcome.
+func NewAccountInputPort(next port.AccountInputPort, m *UseCase) *AccountInputPort {
+	return &AccountInputPort{next: next, metrics: m}
+}
+
+// CreateOrGet counts AccountInputPort.CreateOrGet.
+func (p *AccountInputPort) CreateOrGet(ctx context.Context, input account.OAuthAccountInput) error {
+	return p.metrics.observe("account", "CreateOrGet", p.next.CreateOrGet(ctx, input))
+}
+
+// GetByID counts AccountInputPort.GetByID.
+func (p *AccountInputPort) GetByID(ctx context.Context, id string) error {
+	return p.metrics.observe("account", "GetByID", p.next.GetByID(ctx, id))
+}
+
+// GetByEmail counts AccountInputPort.GetByEmail.
+func (p *AccountInputPort) GetByEmail(ctx context.Context, email string) error {
+	return p.metrics.observe("account", "GetByEmail", p.next.GetByEmail(ctx, email))
+}
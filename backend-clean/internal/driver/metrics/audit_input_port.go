@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// AuditInputPort counts the calls of another AuditInputPort.
+type AuditInputPort struct {
+	next    port.AuditInputPort
+	metrics *UseCase
+}
+
+var _ port.AuditInputPort = (*AuditInputPort)(nil)
+
+// NewAuditInputPort wraps next so that each call is counted by its outcome.
+func NewAuditInputPort(next port.AuditInputPort, m *UseCase) *AuditInputPort {
+	return &AuditInputPort{next: next, metrics: m}
+}
+
+// List counts AuditInputPort.List.
+func (p *AuditInputPort) List(ctx context.Context, filters audit.Filters, viewerID string) error {
+	return p.metrics.observe("audit", "List", p.next.List(ctx, filters, viewerID))
+}
+
+// Verify counts AuditInputPort.Verify.
+func (p *AuditInputPort) Verify(ctx context.Context, viewerID string) error {
+	return p.metrics.observe("audit", "Verify", p.next.Verify(ctx, viewerID))
+}
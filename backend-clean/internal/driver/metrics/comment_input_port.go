@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// CommentInputPort counts the calls of another CommentInputPort.
+type CommentInputPort struct {
+	next    port.CommentInputPort
+	metrics *UseCase
+}
+
+var _ port.CommentInputPort = (*CommentInputPort)(nil)
+
+// NewCommentInputPort wraps next so that each call is counted by its outcome.
+func NewCommentInputPort(next port.CommentInputPort, m *UseCase) *CommentInputPort {
+	return &CommentInputPort{next: next, metrics: m}
+}
+
+// List counts CommentInputPort.List.
+func (p *CommentInputPort) List(ctx context.Context, noteID, viewerID string) error {
+	return p.metrics.observe("comment", "List", p.next.List(ctx, noteID, viewerID))
+}
+
+// Create counts CommentInputPort.Create.
+func (p *CommentInputPort) Create(ctx context.Context, input port.CommentCreateInput) error {
+	return p.metrics.observe("comment", "Create", p.next.Create(ctx, input))
+}
+
+// Update counts CommentInputPort.Update.
+func (p *CommentInputPort) Update(ctx context.Context, input port.CommentUpdateInput) error {
+	return p.metrics.observe("comment", "Update", p.next.Update(ctx, input))
+}
+
+// Delete counts CommentInputPort.Delete.
+func (p *CommentInputPort) Delete(ctx context.Context, noteID, commentID, actorID string) error {
+	return p.metrics.observe("comment", "Delete", p.next.Delete(ctx, noteID, commentID, actorID))
+}
+
+// Resolve counts CommentInputPort.Resolve.
+func (p *CommentInputPort) Resolve(ctx context.Context, input port.CommentResolveInput) error {
+	return p.metrics.observe("comment", "Resolve", p.next.Resolve(ctx, input))
+}
@@ -0,0 +1,62 @@
+// Package metrics collects Prometheus metrics for the servers: use case outcomes
+// through decorators on the input ports, and the state of the connection pool.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+)
+
+// uncategorizedCode labels errors outside the domain error catalog. It is the
+// code the API answers them with.
+const uncategorizedCode = "INTERNAL_ERROR"
+
+// NewRegistry returns a registry that already holds the Go runtime and process collectors.
+func NewRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return reg
+}
+
+// Handler serves the metrics of reg in the Prometheus text format.
+func Handler(reg *prometheus.Registry) http.Handler {
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg})
+}
+
+// UseCase counts input port calls by use case, method and outcome.
+type UseCase struct {
+	calls *prometheus.CounterVec
+}
+
+// NewUseCase registers the use case counters with reg.
+func NewUseCase(reg prometheus.Registerer) *UseCase {
+	calls := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "usecase_calls_total",
+		Help: "Use case calls by use case, method, result (success or error) and domain error code.",
+	}, []string{"usecase", "method", "result", "code"})
+	reg.MustRegister(calls)
+	return &UseCase{calls: calls}
+}
+
+// observe counts one call and returns err unchanged. Errors are labelled with
+// their catalog code, so that a NOT_FOUND is told apart from a failure.
+func (m *UseCase) observe(usecase, method string, err error) error {
+	if err == nil {
+		m.calls.WithLabelValues(usecase, method, "success", "").Inc()
+		return nil
+	}
+	code := uncategorizedCode
+	if e, ok := domainerr.Lookup(err); ok {
+		code = e.Code
+	}
+	m.calls.WithLabelValues(usecase, method, "error", code).Inc()
+	return err
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// tagInputPort returns the configured error from every call.
+type tagInputPort struct {
+	err error
+}
+
+func (p tagInputPort) List(context.Context, note.TagFilters, string) error {
+	return p.err
+}
+
+func TestUseCase(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantResult string
+		wantCode   string
+	}{
+		{name: "[Success] counted without a code", wantResult: "success"},
+		{name: "[Fail] domain error counted with its code", err: domainerr.ErrNotFound, wantResult: "error", wantCode: "NOT_FOUND"},
+		{name: "[Fail] wrapped domain error keeps its code", err: fmt.Errorf("load note: %w", domainerr.ErrUnauthorized), wantResult: "error", wantCode: "FORBIDDEN"},
+		{name: "[Fail] uncategorized error", err: errors.New("connection reset"), wantResult: "error", wantCode: uncategorizedCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := prometheus.NewRegistry()
+			port := NewTagInputPort(tagInputPort{err: tt.err}, NewUseCase(reg))
+
+			if err := port.List(context.Background(), note.TagFilters{}, "acc-1"); err != tt.err {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			want := `
+# HELP usecase_calls_total Use case calls by use case, method, result (success or error) and domain error code.
+# TYPE usecase_calls_total counter
+usecase_calls_total{code="` + tt.wantCode + `",method="List",result="` + tt.wantResult + `",usecase="tag"} 1
+`
+			if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "usecase_calls_total"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	reg := NewRegistry()
+	if n, err := testutil.GatherAndCount(reg, "go_goroutines"); err != nil || n != 1 {
+		t.Errorf("go_goroutines series = %d (%v), want 1", n, err)
+	}
+}
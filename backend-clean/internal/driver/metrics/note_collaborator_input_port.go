@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCollaboratorInputPort counts the calls of another NoteCollaboratorInputPort.
+type NoteCollaboratorInputPort struct {
+	next    port.NoteCollaboratorInputPort
+	metrics *UseCase
+}
+
+var _ port.NoteCollaboratorInputPort = (*NoteCollaboratorInputPort)(nil)
+
+// NewNoteCollaboratorInputPort wraps next so that each call is counted by its outcome.
+func NewNoteCollaboratorInputPort(next port.NoteCollaboratorInputPort, m *UseCase) *NoteCollaboratorInputPort {
+	return &NoteCollaboratorInputPort{next: next, metrics: m}
+}
+
+// List counts NoteCollaboratorInputPort.List.
+func (p *NoteCollaboratorInputPort) List(ctx context.Context, noteID, viewerID string) error {
+	return p.metrics.observe("note_collaborator", "List", p.next.List(ctx, noteID, viewerID))
+}
+
+// Put counts NoteCollaboratorInputPort.Put.
+func (p *NoteCollaboratorInputPort) Put(ctx context.Context, input port.NoteCollaboratorPutInput) error {
+	return p.metrics.observe("note_collaborator", "Put", p.next.Put(ctx, input))
+}
+
+// Delete counts NoteCollaboratorInputPort.Delete.
+func (p *NoteCollaboratorInputPort) Delete(ctx context.Context, noteID, accountID, actorID string) error {
+	return p.metrics.observe("note_collaborator", "Delete", p.next.Delete(ctx, noteID, accountID, actorID))
+}
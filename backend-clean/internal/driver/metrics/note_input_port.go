@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/trash"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteInputPort counts the calls of another NoteInputPort.
+type NoteInputPort struct {
+	next    port.NoteInputPort
+	metrics *UseCase
+}
+
+var _ port.NoteInputPort = (*NoteInputPort)(nil)
+
+// NewNoteInputPort wraps next so that each call is counted by its outcome.
+func NewNoteInputPort(next port.NoteInputPort, m *UseCase) *NoteInputPort {
+	return &NoteInputPort{next: next, metrics: m}
+}
+
+// List counts NoteInputPort.List.
+func (p *NoteInputPort) List(ctx context.Context, filters note.Filters, viewerID string) error {
+	return p.metrics.observe("note", "List", p.next.List(ctx, filters, viewerID))
+}
+
+// Search counts NoteInputPort.Search.
+func (p *NoteInputPort) Search(ctx context.Context, filters note.Filters, viewerID string) error {
+	return p.metrics.observe("note", "Search", p.next.Search(ctx, filters, viewerID))
+}
+
+// Get counts NoteInputPort.Get.
+func (p *NoteInputPort) Get(ctx context.Context, id, viewerID string) error {
+	return p.metrics.observe("note", "Get", p.next.Get(ctx, id, viewerID))
+}
+
+// Create counts NoteInputPort.Create.
+func (p *NoteInputPort) Create(ctx context.Context, input port.NoteCreateInput) error {
+	return p.metrics.observe("note", "Create", p.next.Create(ctx, input))
+}
+
+// Update counts NoteInputPort.Update.
+func (p *NoteInputPort) Update(ctx context.Context, input port.NoteUpdateInput) error {
+	return p.metrics.observe("note", "Update", p.next.Update(ctx, input))
+}
+
+// ChangeStatus counts NoteInputPort.ChangeStatus.
+func (p *NoteInputPort) ChangeStatus(ctx context.Context, input port.NoteStatusChangeInput) error {
+	return p.metrics.observe("note", "ChangeStatus", p.next.ChangeStatus(ctx, input))
+}
+
+// MigrateTemplate counts NoteInputPort.MigrateTemplate.
+func (p *NoteInputPort) MigrateTemplate(ctx context.Context, input port.NoteMigrateTemplateInput) error {
+	return p.metrics.observe("note", "MigrateTemplate", p.next.MigrateTemplate(ctx, input))
+}
+
+// ExportMarkdown counts NoteInputPort.ExportMarkdown.
+func (p *NoteInputPort) ExportMarkdown(ctx context.Context, id, viewerID string) error {
+	return p.metrics.observe("note", "ExportMarkdown", p.next.ExportMarkdown(ctx, id, viewerID))
+}
+
+// ImportMarkdown counts NoteInputPort.ImportMarkdown.
+func (p *NoteInputPort) ImportMarkdown(ctx context.Context, input port.NoteImportMarkdownInput) error {
+	return p.metrics.observe("note", "ImportMarkdown", p.next.ImportMarkdown(ctx, input))
+}
+
+// Delete counts NoteInputPort.Delete.
+func (p *NoteInputPort) Delete(ctx context.Context, id, ownerID string) error {
+	return p.metrics.observe("note", "Delete", p.next.Delete(ctx, id, ownerID))
+}
+
+// ListTrash counts NoteInputPort.ListTrash.
+func (p *NoteInputPort) ListTrash(ctx context.Context, filters trash.Filters) error {
+	return p.metrics.observe("note", "ListTrash", p.next.ListTrash(ctx, filters))
+}
+
+// Restore counts NoteInputPort.Restore.
+func (p *NoteInputPort) Restore(ctx context.Context, id, ownerID string) error {
+	return p.metrics.observe("note", "Restore", p.next.Restore(ctx, id, ownerID))
+}
+
+// Purge counts NoteInputPort.Purge.
+func (p *NoteInputPort) Purge(ctx context.Context, id, ownerID string) error {
+	return p.metrics.observe("note", "Purge", p.next.Purge(ctx, id, ownerID))
+}
@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteLinkInputPort counts the calls of another NoteLinkInputPort.
+type NoteLinkInputPort struct {
+	next    port.NoteLinkInputPort
+	metrics *UseCase
+}
+
+var _ port.NoteLinkInputPort = (*NoteLinkInputPort)(nil)
+
+// NewNoteLinkInputPort wraps next so that each call is counted by its outcome.
+func NewNoteLinkInputPort(next port.NoteLinkInputPort, m *UseCase) *NoteLinkInputPort {
+	return &NoteLinkInputPort{next: next, metrics: m}
+}
+
+// List counts NoteLinkInputPort.List.
+func (p *NoteLinkInputPort) List(ctx context.Context, noteID, viewerID string) error {
+	return p.metrics.observe("note_link", "List", p.next.List(ctx, noteID, viewerID))
+}
+
+// Create counts NoteLinkInputPort.Create.
+func (p *NoteLinkInputPort) Create(ctx context.Context, input port.NoteLinkCreateInput) error {
+	return p.metrics.observe("note_link", "Create", p.next.Create(ctx, input))
+}
+
+// Delete counts NoteLinkInputPort.Delete.
+func (p *NoteLinkInputPort) Delete(ctx context.Context, noteID, linkID, actorID string) error {
+	return p.metrics.observe("note_link", "Delete", p.next.Delete(ctx, noteID, linkID, actorID))
+}
@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteRevisionInputPort counts the calls of another NoteRevisionInputPort.
+type NoteRevisionInputPort struct {
+	next    port.NoteRevisionInputPort
+	metrics *UseCase
+}
+
+var _ port.NoteRevisionInputPort = (*NoteRevisionInputPort)(nil)
+
+// NewNoteRevisionInputPort wraps next so that each call is counted by its outcome.
+func NewNoteRevisionInputPort(next port.NoteRevisionInputPort, m *UseCase) *NoteRevisionInputPort {
+	return &NoteRevisionInputPort{next: next, metrics: m}
+}
+
+// List counts NoteRevisionInputPort.List.
+func (p *NoteRevisionInputPort) List(ctx context.Context, noteID, viewerID string) error {
+	return p.metrics.observe("note_revision", "List", p.next.List(ctx, noteID, viewerID))
+}
+
+// Get counts NoteRevisionInputPort.Get.
+func (p *NoteRevisionInputPort) Get(ctx context.Context, noteID string, number int, viewerID string) error {
+	return p.metrics.observe("note_revision", "Get", p.next.Get(ctx, noteID, number, viewerID))
+}
+
+// Diff counts NoteRevisionInputPort.Diff.
+func (p *NoteRevisionInputPort) Diff(ctx context.Context, noteID string, number, base int, viewerID string) error {
+	return p.metrics.observe("note_revision", "Diff", p.next.Diff(ctx, noteID, number, base, viewerID))
+}
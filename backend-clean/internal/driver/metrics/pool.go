@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	poolAcquiredDesc = prometheus.NewDesc("db_pool_acquired_connections",
+		"Connections currently in use.", nil, nil)
+	poolIdleDesc = prometheus.NewDesc("db_pool_idle_connections",
+		"Connections open but not in use.", nil, nil)
+	poolTotalDesc = prometheus.NewDesc("db_pool_total_connections",
+		"Connections open, including those being established.", nil, nil)
+	poolMaxDesc = prometheus.NewDesc("db_pool_max_connections",
+		"Largest number of connections the pool opens.", nil, nil)
+	poolAcquiresDesc = prometheus.NewDesc("db_pool_acquires_total",
+		"Connections handed out by the pool.", nil, nil)
+	poolEmptyAcquiresDesc = prometheus.NewDesc("db_pool_empty_acquires_total",
+		"Acquires that had to wait because no connection was idle.", nil, nil)
+	poolAcquireWaitDesc = prometheus.NewDesc("db_pool_acquire_wait_seconds_total",
+		"Time spent waiting for a connection when none was idle.", nil, nil)
+	poolAcquireDurationDesc = prometheus.NewDesc("db_pool_acquire_duration_seconds_total",
+		"Time spent acquiring connections, waiting or not.", nil, nil)
+)
+
+// PoolCollector reports pgxpool.Stat as gauges and counters at scrape time.
+type PoolCollector struct {
+	stat func() *pgxpool.Stat
+}
+
+var _ prometheus.Collector = (*PoolCollector)(nil)
+
+// NewPoolCollector returns a collector for the statistics of pool.
+func NewPoolCollector(pool *pgxpool.Pool) *PoolCollector {
+	return &PoolCollector{stat: pool.Stat}
+}
+
+// Describe implements prometheus.Collector.
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		poolAcquiredDesc, poolIdleDesc, poolTotalDesc, poolMaxDesc,
+		poolAcquiresDesc, poolEmptyAcquiresDesc, poolAcquireWaitDesc, poolAcquireDurationDesc,
+	} {
+		ch <- d
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.stat()
+	ch <- prometheus.MustNewConstMetric(poolAcquiredDesc, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(poolIdleDesc, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(poolTotalDesc, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(poolMaxDesc, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(poolAcquiresDesc, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolEmptyAcquiresDesc, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolAcquireWaitDesc, prometheus.CounterValue, s.EmptyAcquireWaitTime().Seconds())
+	ch <- prometheus.MustNewConstMetric(poolAcquireDurationDesc, prometheus.CounterValue, s.AcquireDuration().Seconds())
+}
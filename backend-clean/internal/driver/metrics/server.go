@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Path is where the metrics are served.
+const Path = "/metrics"
+
+// shutdownTimeout bounds how long stopping the metrics server waits for scrapes in flight.
+const shutdownTimeout = 5 * time.Second
+
+// Serve serves the metrics of reg at Path on port in the background and returns a
+// function that stops it. The metrics get a listener of their own, so they are kept
+// off the public ports and can be exposed to the scraper only.
+func Serve(server string, port int, reg *prometheus.Registry) (func(), error) {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("listen for metrics: %w", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler(reg))
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("metrics server exited: %v", err)
+		}
+	}()
+	log.Printf("serving %s metrics at :%d%s\n", server, port, Path)
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Printf("metrics server shutdown: %v", err)
+		}
+	}, nil
+}
@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TagInputPort counts the calls of another TagInputPort.
+type TagInputPort struct {
+	next    port.TagInputPort
+	metrics *UseCase
+}
+
+var _ port.TagInputPort = (*TagInputPort)(nil)
+
+// NewTagInputPort wraps next so that each call is counted by its outcome.
+func NewTagInputPort(next port.TagInputPort, m *UseCase) *TagInputPort {
+	return &TagInputPort{next: next, metrics: m}
+}
+
+// List counts TagInputPort.List.
+func (p *TagInputPort) List(ctx context.Context, filters note.TagFilters, viewerID string) error {
+	return p.metrics.observe("tag", "List", p.next.List(ctx, filters, viewerID))
+}
@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TemplateInputPort counts the calls of another TemplateInputPort.
+type TemplateInputPort struct {
+	next    port.TemplateInputPort
+	metrics *UseCase
+}
+
+var _ port.TemplateInputPort = (*TemplateInputPort)(nil)
+
+// NewTemplateInputPort wraps next so that each call is counted by its outcome.
+func NewTemplateInputPort(next port.TemplateInputPort, m *UseCase) *TemplateInputPort {
+	return &TemplateInputPort{next: next, metrics: m}
+}
+
+// List counts TemplateInputPort.List.
+func (p *TemplateInputPort) List(ctx context.Context, filters template.Filters) error {
+	return p.metrics.observe("template", "List", p.next.List(ctx, filters))
+}
+
+// Get counts TemplateInputPort.Get.
+func (p *TemplateInputPort) Get(ctx context.Context, id string) error {
+	return p.metrics.observe("template", "Get", p.next.Get(ctx, id))
+}
+
+// Create counts TemplateInputPort.Create.
+func (p *TemplateInputPort) Create(ctx context.Context, input port.TemplateCreateInput) error {
+	return p.metrics.observe("template", "Create", p.next.Create(ctx, input))
+}
+
+// Update counts TemplateInputPort.Update.
+func (p *TemplateInputPort) Update(ctx context.Context, input port.TemplateUpdateInput) error {
+	return p.metrics.observe("template", "Update", p.next.Update(ctx, input))
+}
+
+// Delete counts TemplateInputPort.Delete.
+func (p *TemplateInputPort) Delete(ctx context.Context, id, ownerID string) error {
+	return p.metrics.observe("template", "Delete", p.next.Delete(ctx, id, ownerID))
+}
+
+// ListTrash counts TemplateInputPort.ListTrash.
+func (p *TemplateInputPort) ListTrash(ctx context.Context, filters trash.Filters) error {
+	return p.metrics.observe("template", "ListTrash", p.next.ListTrash(ctx, filters))
+}
+
+// Restore counts TemplateInputPort.Restore.
+func (p *TemplateInputPort) Restore(ctx context.Context, id, ownerID string) error {
+	return p.metrics.observe("template", "Restore", p.next.Restore(ctx, id, ownerID))
+}
+
+// Purge counts TemplateInputPort.Purge.
+func (p *TemplateInputPort) Purge(ctx context.Context, id, ownerID string) error {
+	return p.metrics.observe("template", "Purge", p.next.Purge(ctx, id, ownerID))
+}
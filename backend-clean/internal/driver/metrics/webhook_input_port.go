@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookInputPort counts the calls of another WebhookInputPort.
+type WebhookInputPort struct {
+	next    port.WebhookInputPort
+	metrics *UseCase
+}
+
+var _ port.WebhookInputPort = (*WebhookInputPort)(nil)
+
+// NewWebhookInputPort wraps next so that each call is counted by its outcome.
+func NewWebhookInputPort(next port.WebhookInputPort, m *UseCase) *WebhookInputPort {
+	return &WebhookInputPort{next: next, metrics: m}
+}
+
+// List counts WebhookInputPort.List.
+func (p *WebhookInputPort) List(ctx context.Context, ownerID string) error {
+	return p.metrics.observe("webhook", "List", p.next.List(ctx, ownerID))
+}
+
+// Get counts WebhookInputPort.Get.
+func (p *WebhookInputPort) Get(ctx context.Context, id, ownerID string) error {
+	return p.metrics.observe("webhook", "Get", p.next.Get(ctx, id, ownerID))
+}
+
+// Create counts WebhookInputPort.Create.
+func (p *WebhookInputPort) Create(ctx context.Context, input port.WebhookCreateInput) error {
+	return p.metrics.observe("webhook", "Create", p.next.Create(ctx, input))
+}
+
+// Update counts WebhookInputPort.Update.
+func (p *WebhookInputPort) Update(ctx context.Context, input port.WebhookUpdateInput) error {
+	return p.metrics.observe("webhook", "Update", p.next.Update(ctx, input))
+}
+
+// Delete counts WebhookInputPort.Delete.
+func (p *WebhookInputPort) Delete(ctx context.Context, id, ownerID string) error {
+	return p.metrics.observe("webhook", "Delete", p.next.Delete(ctx, id, ownerID))
+}
+
+// ListDeliveries counts WebhookInputPort.ListDeliveries.
+func (p *WebhookInputPort) ListDeliveries(ctx context.Context, input port.WebhookDeliveryListInput) error {
+	return p.metrics.observe("webhook", "ListDeliveries", p.next.ListDeliveries(ctx, input))
+}
+
+// Redeliver counts WebhookInputPort.Redeliver.
+func (p *WebhookInputPort) Redeliver(ctx context.Context, webhookID, deliveryID, ownerID string) error {
+	return p.metrics.observe("webhook", "Redeliver", p.next.Redeliver(ctx, webhookID, deliveryID, ownerID))
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceInputPort counts the calls of another WorkspaceInputPort.
+type WorkspaceInputPort struct {
+	next    port.WorkspaceInputPort
+	metrics *UseCase
+}
+
+var _ port.WorkspaceInputPort = (*WorkspaceInputPort)(nil)
+
+// NewWorkspaceInputPort wraps next so that each call is counted by its outcome.
+func NewWorkspaceInputPort(next port.WorkspaceInputPort, m *UseCase) *WorkspaceInputPort {
+	return &WorkspaceInputPort{next: next, metrics: m}
+}
+
+// List counts WorkspaceInputPort.List.
+func (p *WorkspaceInputPort) List(ctx context.Context, accountID string) error {
+	return p.metrics.observe("workspace", "List", p.next.List(ctx, accountID))
+}
+
+// Create counts WorkspaceInputPort.Create.
+func (p *WorkspaceInputPort) Create(ctx context.Context, input port.WorkspaceCreateInput) error {
+	return p.metrics.observe("workspace", "Create", p.next.Create(ctx, input))
+}
+
+// Get counts WorkspaceInputPort.Get.
+func (p *WorkspaceInputPort) Get(ctx context.Context, workspaceID, actorID string) error {
+	return p.metrics.observe("workspace", "Get", p.next.Get(ctx, workspaceID, actorID))
+}
+
+// Update counts WorkspaceInputPort.Update.
+func (p *WorkspaceInputPort) Update(ctx context.Context, input port.WorkspaceUpdateInput) error {
+	return p.metrics.observe("workspace", "Update", p.next.Update(ctx, input))
+}
+
+// Delete counts WorkspaceInputPort.Delete.
+func (p *WorkspaceInputPort) Delete(ctx context.Context, workspaceID, actorID string) error {
+	return p.metrics.observe("workspace", "Delete", p.next.Delete(ctx, workspaceID, actorID))
+}
+
+// ListMembers counts WorkspaceInputPort.ListMembers.
+func (p *WorkspaceInputPort) ListMembers(ctx context.Context, workspaceID, actorID string) error {
+	return p.metrics.observe("workspace", "ListMembers", p.next.ListMembers(ctx, workspaceID, actorID))
+}
+
+// UpdateMember counts WorkspaceInputPort.UpdateMember.
+func (p *WorkspaceInputPort) UpdateMember(ctx context.Context, input port.WorkspaceMemberUpdateInput) error {
+	return p.metrics.observe("workspace", "UpdateMember", p.next.UpdateMember(ctx, input))
+}
+
+// RemoveMember counts WorkspaceInputPort.RemoveMember.
+func (p *WorkspaceInputPort) RemoveMember(ctx context.Context, workspaceID, accountID, actorID string) error {
+	return p.metrics.observe("workspace", "RemoveMember", p.next.RemoveMember(ctx, workspaceID, accountID, actorID))
+}
+
+// ListInvites counts WorkspaceInputPort.ListInvites.
+func (p *WorkspaceInputPort) ListInvites(ctx context.Context, workspaceID, actorID string) error {
+	return p.metrics.observe("workspace", "ListInvites", p.next.ListInvites(ctx, workspaceID, actorID))
+}
+
+// Invite counts WorkspaceInputPort.Invite.
+func (p *WorkspaceInputPort) Invite(ctx context.Context, input port.WorkspaceInviteInput) error {
+	return p.metrics.observe("workspace", "Invite", p.next.Invite(ctx, input))
+}
+
+// RevokeInvite counts WorkspaceInputPort.RevokeInvite.
+func (p *WorkspaceInputPort) RevokeInvite(ctx context.Context, workspaceID, inviteID, actorID string) error {
+	return p.metrics.observe("workspace", "RevokeInvite", p.next.RevokeInvite(ctx, workspaceID, inviteID, actorID))
+}
+
+// ListMyInvites counts WorkspaceInputPort.ListMyInvites.
+func (p *WorkspaceInputPort) ListMyInvites(ctx context.Context, actorID string) error {
+	return p.metrics.observe("workspace", "ListMyInvites", p.next.ListMyInvites(ctx, actorID))
+}
+
+// AcceptInvite counts WorkspaceInputPort.AcceptInvite.
+func (p *WorkspaceInputPort) AcceptInvite(ctx context.Context, inviteID, actorID string) error {
+	return p.metrics.observe("workspace", "AcceptInvite", p.next.AcceptInvite(ctx, inviteID, actorID))
+}
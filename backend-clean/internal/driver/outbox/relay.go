@@ -0,0 +1,128 @@
+// Package outbox delivers domain events stored in the transactional outbox to subscribers.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Config tunes the relay.
+type Config struct {
+	// BatchSize is the maximum number of events claimed per transaction.
+	BatchSize int
+	// PollInterval is the wait between polls when the outbox has been drained.
+	PollInterval time.Duration
+	// BaseBackoff is the retry delay after the first failed delivery; it doubles per attempt.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the retry delay.
+	MaxBackoff time.Duration
+}
+
+// DefaultConfig returns the relay settings used by cmd/relay.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    100,
+		PollInterval: time.Second,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   10 * time.Minute,
+	}
+}
+
+// Relay polls the outbox and hands every event to all subscribers.
+//
+// Delivery is at-least-once: an event is marked delivered only after every subscriber
+// accepted it, so a failure or crash redelivers it to all of them. Events of one
+// aggregate are delivered in the order they were stored; a failing event is retried
+// with backoff and holds back later events of its aggregate until it succeeds.
+type Relay struct {
+	repo        port.OutboxRepository
+	tx          port.TxManager
+	subscribers []port.EventSubscriber
+	cfg         Config
+	now         func() time.Time
+}
+
+// NewRelay creates a Relay.
+func NewRelay(repo port.OutboxRepository, tx port.TxManager, cfg Config, subscribers ...port.EventSubscriber) *Relay {
+	return &Relay{
+		repo:        repo,
+		tx:          tx,
+		subscribers: subscribers,
+		cfg:         cfg,
+		now:         time.Now,
+	}
+}
+
+// Run dispatches batches until ctx is canceled.
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		n, err := r.DispatchBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("outbox relay: %v", err)
+		}
+		// A full batch suggests more events are waiting, so poll again right away.
+		if err == nil && n == r.cfg.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.cfg.PollInterval):
+		}
+	}
+}
+
+// DispatchBatch claims due events and delivers them, returning how many were claimed.
+// The claim and the delivery outcome are recorded in one transaction; the row locks
+// keep other relays away from the claimed aggregates until it commits.
+func (r *Relay) DispatchBatch(ctx context.Context) (int, error) {
+	var claimed int
+	err := r.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		events, err := r.repo.ClaimPending(txCtx, r.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		claimed = len(events)
+		for _, stored := range events {
+			if err := r.deliver(txCtx, stored.Event); err != nil {
+				retryAt := r.now().Add(r.backoff(stored.Attempts))
+				if err := r.repo.MarkFailed(txCtx, stored.Sequence, err.Error(), retryAt); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := r.repo.MarkDelivered(txCtx, stored.Sequence); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return claimed, err
+}
+
+func (r *Relay) deliver(ctx context.Context, e event.Event) error {
+	var errs []error
+	for _, s := range r.subscribers {
+		if err := s.Handle(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// backoff returns the delay before the next attempt of an event that already failed attempts times.
+func (r *Relay) backoff(attempts int) time.Duration {
+	d := r.cfg.BaseBackoff
+	for i := 0; i < attempts && d < r.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > r.cfg.MaxBackoff {
+		return r.cfg.MaxBackoff
+	}
+	return d
+}
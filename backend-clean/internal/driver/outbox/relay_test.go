@@ -0,0 +1,160 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+type fakeOutbox struct {
+	pending   []event.Stored
+	claimErr  error
+	delivered []int64
+	failed    map[int64]time.Time
+}
+
+func (f *fakeOutbox) Publish(context.Context, ...event.Event) error { return nil }
+
+func (f *fakeOutbox) ClaimPending(_ context.Context, limit int) ([]event.Stored, error) {
+	if f.claimErr != nil {
+		return nil, f.claimErr
+	}
+	if len(f.pending) > limit {
+		return f.pending[:limit], nil
+	}
+	return f.pending, nil
+}
+
+func (f *fakeOutbox) MarkDelivered(_ context.Context, sequence int64) error {
+	f.delivered = append(f.delivered, sequence)
+	return nil
+}
+
+func (f *fakeOutbox) MarkFailed(_ context.Context, sequence int64, _ string, retryAt time.Time) error {
+	if f.failed == nil {
+		f.failed = map[int64]time.Time{}
+	}
+	f.failed[sequence] = retryAt
+	return nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type recordingSubscriber struct {
+	seen   []string
+	failOn string
+}
+
+func (s *recordingSubscriber) Handle(_ context.Context, e event.Event) error {
+	s.seen = append(s.seen, e.ID)
+	if e.ID == s.failOn {
+		return errors.New("subscriber down")
+	}
+	return nil
+}
+
+// stored builds a claimed event. A claim holds at most one event per aggregate, so
+// events claimed together must have distinct aggregateIDs.
+func stored(seq int64, id, aggregateID string, attempts int) event.Stored {
+	return event.Stored{Event: event.Event{ID: id, Type: event.NoteUpdated, AggregateType: event.AggregateNote, AggregateID: aggregateID}, Sequence: seq, Attempts: attempts}
+}
+
+func TestRelay_DispatchBatch(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	cfg := Config{BatchSize: 10, BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	tests := []struct {
+		name          string
+		pending       []event.Stored
+		failOn        string
+		claimErr      error
+		wantClaimed   int
+		wantDelivered []int64
+		wantRetryAt   map[int64]time.Time
+		wantErr       bool
+	}{
+		{
+			name:          "[Success] deliver to every subscriber",
+			pending:       []event.Stored{stored(1, "e1", "n1", 0), stored(2, "e2", "n2", 0)},
+			wantClaimed:   2,
+			wantDelivered: []int64{1, 2},
+		},
+		{
+			name:          "[Success] failed event is rescheduled with backoff without holding back other aggregates",
+			pending:       []event.Stored{stored(1, "e1", "n1", 2), stored(2, "e2", "n2", 0)},
+			failOn:        "e1",
+			wantClaimed:   2,
+			wantDelivered: []int64{2},
+			wantRetryAt:   map[int64]time.Time{1: now.Add(4 * time.Second)},
+		},
+		{
+			name:        "[Success] backoff is capped",
+			pending:     []event.Stored{stored(1, "e1", "n1", 30)},
+			failOn:      "e1",
+			wantClaimed: 1,
+			wantRetryAt: map[int64]time.Time{1: now.Add(5 * time.Second)},
+		},
+		{
+			name:     "[Fail] claim error",
+			claimErr: errors.New("db down"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeOutbox{pending: tt.pending, claimErr: tt.claimErr}
+			first := &recordingSubscriber{failOn: tt.failOn}
+			second := &recordingSubscriber{}
+			relay := NewRelay(repo, fakeTx{}, cfg, first, second)
+			relay.now = func() time.Time { return now }
+
+			claimed, err := relay.DispatchBatch(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got %v", tt.wantErr, err)
+			}
+			if claimed != tt.wantClaimed {
+				t.Fatalf("claimed = %d, want %d", claimed, tt.wantClaimed)
+			}
+			if len(repo.delivered) != len(tt.wantDelivered) {
+				t.Fatalf("delivered = %v, want %v", repo.delivered, tt.wantDelivered)
+			}
+			for i, seq := range tt.wantDelivered {
+				if repo.delivered[i] != seq {
+					t.Fatalf("delivered = %v, want %v", repo.delivered, tt.wantDelivered)
+				}
+			}
+			for seq, at := range tt.wantRetryAt {
+				if !repo.failed[seq].Equal(at) {
+					t.Fatalf("retry of %d at %v, want %v", seq, repo.failed[seq], at)
+				}
+			}
+			// Every subscriber sees every claimed event even when another one fails.
+			if len(second.seen) != tt.wantClaimed {
+				t.Fatalf("second subscriber saw %v", second.seen)
+			}
+		})
+	}
+}
+
+func TestRelay_RunStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := &fakeOutbox{}
+	relay := NewRelay(repo, fakeTx{}, Config{BatchSize: 10, PollInterval: time.Millisecond})
+	done := make(chan error, 1)
+	go func() { done <- relay.Run(ctx) }()
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("relay did not stop")
+	}
+}
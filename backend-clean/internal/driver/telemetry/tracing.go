@@ -0,0 +1,124 @@
+// Package telemetry sets up OpenTelemetry tracing for the servers.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"immortal-architecture-clean/backend/internal/driver/config"
+)
+
+// TracerName is the instrumentation scope of the spans the backend starts.
+const TracerName = "immortal-architecture-clean/backend"
+
+// Tracing is what the servers need to trace requests.
+type Tracing struct {
+	Tracer     trace.Tracer
+	Propagator propagation.TextMapPropagator
+	// Shutdown flushes spans that are not exported yet and releases the exporter.
+	Shutdown func(ctx context.Context) error
+}
+
+// shutdownTimeout bounds how long Close waits for the exporter to flush.
+const shutdownTimeout = 5 * time.Second
+
+// Close flushes and releases the exporter, giving up after shutdownTimeout.
+// Failures are only logged because Close runs during cleanup.
+func (t *Tracing) Close() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := t.Shutdown(ctx); err != nil {
+		log.Printf("tracing shutdown: %v", err)
+	}
+}
+
+// SetupTracing creates the tracer provider the configuration asks for and installs
+// it, with W3C trace context propagation, as the global provider so that libraries
+// such as the pgx query tracer use it too. serviceName becomes service.name.
+func SetupTracing(ctx context.Context, cfg *config.Config, serviceName string) (*Tracing, error) {
+	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	otel.SetTextMapPropagator(propagator)
+
+	if cfg.TraceExporter == "none" {
+		provider := noop.NewTracerProvider()
+		otel.SetTracerProvider(provider)
+		return &Tracing{
+			Tracer:     provider.Tracer(TracerName),
+			Propagator: propagator,
+			Shutdown:   func(context.Context) error { return nil },
+		}, nil
+	}
+
+	exporter, closeOutput, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		_ = closeOutput()
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TraceSampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return &Tracing{
+		Tracer:     provider.Tracer(TracerName),
+		Propagator: propagator,
+		Shutdown: func(ctx context.Context) error {
+			return errors.Join(provider.Shutdown(ctx), closeOutput())
+		},
+	}, nil
+}
+
+// newExporter returns the span exporter and a function that closes its output file, if any.
+func newExporter(ctx context.Context, cfg *config.Config) (sdktrace.SpanExporter, func() error, error) {
+	noClose := func() error { return nil }
+	switch cfg.TraceExporter {
+	case "stdout":
+		var out io.Writer = os.Stdout
+		closeOutput := noClose
+		if cfg.TraceFile != "" {
+			f, err := os.OpenFile(cfg.TraceFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+			if err != nil {
+				return nil, nil, fmt.Errorf("open TRACE_FILE: %w", err)
+			}
+			out, closeOutput = f, f.Close
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(out))
+		if err != nil {
+			_ = closeOutput()
+			return nil, nil, err
+		}
+		return exporter, closeOutput, nil
+	case "otlp":
+		var opts []otlptracehttp.Option
+		if cfg.TraceOTLPEndpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.TraceOTLPEndpoint))
+		}
+		exporter, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return exporter, noClose, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown trace exporter %q", cfg.TraceExporter)
+	}
+}
@@ -0,0 +1,74 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"immortal-architecture-clean/backend/internal/driver/config"
+)
+
+func TestSetupTracing(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		cfg           config.Config
+		wantRecording bool
+		wantErr       bool
+	}{
+		{name: "[Success] none records nothing", cfg: config.Config{TraceExporter: "none"}},
+		{name: "[Success] stdout exporter writes to the trace file", cfg: config.Config{TraceExporter: "stdout", TraceSampleRatio: 1}, wantRecording: true},
+		{name: "[Success] otlp exporter", cfg: config.Config{TraceExporter: "otlp", TraceOTLPEndpoint: "http://127.0.0.1:4318", TraceSampleRatio: 1}, wantRecording: true},
+		{name: "[Fail] unknown exporter", cfg: config.Config{TraceExporter: "jaeger"}, wantErr: true},
+		{name: "[Fail] trace file cannot be opened", cfg: config.Config{TraceExporter: "stdout", TraceFile: filepath.Join(t.TempDir(), "missing", "traces.jsonl")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.cfg
+			if cfg.TraceExporter == "stdout" && cfg.TraceFile == "" {
+				cfg.TraceFile = filepath.Join(t.TempDir(), "traces.jsonl")
+			}
+
+			tracing, err := SetupTracing(ctx, &cfg, "test-service")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, span := tracing.Tracer.Start(ctx, "test-span")
+			if span.IsRecording() != tt.wantRecording {
+				t.Errorf("recording = %v, want %v", span.IsRecording(), tt.wantRecording)
+			}
+			span.End()
+			if cfg.TraceExporter == "otlp" {
+				// Nothing listens on the endpoint, so the final export fails; the provider still stops.
+				cancelled, cancel := context.WithCancel(ctx)
+				cancel()
+				_ = tracing.Shutdown(cancelled)
+				return
+			}
+			if err := tracing.Shutdown(ctx); err != nil {
+				t.Fatalf("shutdown: %v", err)
+			}
+
+			if cfg.TraceExporter == "stdout" {
+				out, err := os.ReadFile(cfg.TraceFile)
+				if err != nil {
+					t.Fatalf("read trace file: %v", err)
+				}
+				if !strings.Contains(string(out), `"Name":"test-span"`) || !strings.Contains(string(out), "test-service") {
+					t.Errorf("trace file = %s, want the span and service name", out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Package trash runs the worker that purges trashed notes and templates after the retention period.
+package trash
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Config tunes the worker.
+type Config struct {
+	// BatchSize is the maximum number of items purged per pass.
+	BatchSize int
+	// Interval is the wait between passes once nothing expired is left.
+	Interval time.Duration
+}
+
+// DefaultConfig returns the worker settings used by cmd/purge.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize: 100,
+		Interval:  time.Hour,
+	}
+}
+
+// Worker purges expired trash in batches. Purging is idempotent, so several
+// workers may run against one database, though one is enough.
+type Worker struct {
+	purger port.TrashPurger
+	cfg    Config
+}
+
+// NewWorker creates a Worker.
+func NewWorker(purger port.TrashPurger, cfg Config) *Worker {
+	return &Worker{purger: purger, cfg: cfg}
+}
+
+// Run purges expired trash until ctx is canceled.
+func (w *Worker) Run(ctx context.Context) error {
+	for {
+		n, err := w.purger.PurgeExpired(ctx, w.cfg.BatchSize)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("trash purge worker: %v", err)
+		}
+		if n > 0 {
+			log.Printf("trash purge worker: purged %d items", n)
+		}
+		// A full batch suggests more items have expired, so purge again right away.
+		if err == nil && n == w.cfg.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.cfg.Interval):
+		}
+	}
+}
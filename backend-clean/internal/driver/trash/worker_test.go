@@ -0,0 +1,71 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakePurger reports the queued results of PurgeExpired in order, then zero.
+type fakePurger struct {
+	mu      sync.Mutex
+	results []int
+	errs    []error
+	calls   int
+}
+
+func (p *fakePurger) PurgeExpired(_ context.Context, _ int) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	i := p.calls
+	p.calls++
+	if i >= len(p.results) {
+		return 0, nil
+	}
+	return p.results[i], p.errs[i]
+}
+
+func (p *fakePurger) callCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.calls
+}
+
+func TestWorker_Run(t *testing.T) {
+	tests := []struct {
+		name      string
+		results   []int
+		errs      []error
+		wantCalls int
+	}{
+		{name: "[Success] full batches are purged back to back", results: []int{2, 2, 1}, errs: []error{nil, nil, nil}, wantCalls: 3},
+		{name: "[Success] nothing expired waits for the interval", results: []int{0}, errs: []error{nil}, wantCalls: 1},
+		{name: "[Fail] error waits for the interval", results: []int{2}, errs: []error{errors.New("db down")}, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purger := &fakePurger{results: tt.results, errs: tt.errs}
+			w := NewWorker(purger, Config{BatchSize: 2, Interval: time.Hour})
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error, 1)
+			go func() { done <- w.Run(ctx) }()
+
+			deadline := time.Now().Add(time.Second)
+			for purger.callCount() < tt.wantCalls && time.Now().Before(deadline) {
+				time.Sleep(time.Millisecond)
+			}
+			// The worker is now waiting for the hour-long interval.
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+			if err := <-done; err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := purger.callCount(); got != tt.wantCalls {
+				t.Fatalf("want %d calls, got %d", tt.wantCalls, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Package webhook runs the worker that sends queued webhook deliveries.
+package webhook
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// Config tunes the worker.
+type Config struct {
+	// BatchSize is the maximum number of deliveries claimed per poll.
+	BatchSize int
+	// PollInterval is the wait between polls when no delivery is due.
+	PollInterval time.Duration
+}
+
+// DefaultConfig returns the worker settings used by cmd/webhook.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    20,
+		PollInterval: time.Second,
+	}
+}
+
+// Worker polls for due deliveries and sends them. Retries are scheduled by the
+// dispatcher, so the worker only has to keep polling. Several workers may run
+// against one database; claimed deliveries are leased to one of them.
+type Worker struct {
+	dispatcher port.WebhookDispatcher
+	cfg        Config
+}
+
+// NewWorker creates a Worker.
+func NewWorker(dispatcher port.WebhookDispatcher, cfg Config) *Worker {
+	return &Worker{dispatcher: dispatcher, cfg: cfg}
+}
+
+// Run sends deliveries until ctx is canceled.
+func (w *Worker) Run(ctx context.Context) error {
+	for {
+		n, err := w.dispatcher.DeliverDue(ctx, w.cfg.BatchSize)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("webhook worker: %v", err)
+		}
+		// A full batch suggests more deliveries are due, so poll again right away.
+		if err == nil && n == w.cfg.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.cfg.PollInterval):
+		}
+	}
+}
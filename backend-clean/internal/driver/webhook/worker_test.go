@@ -0,0 +1,174 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	webhookgateway "immortal-architecture-clean/backend/internal/adapter/gateway/webhook"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	domainwebhook "immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/usecase"
+)
+
+// memoryRepo keeps webhooks and deliveries in memory. ClaimDue ignores
+// next_attempt_at, so a retry is due on the next poll instead of after the backoff.
+type memoryRepo struct {
+	mu         sync.Mutex
+	webhooks   []domainwebhook.Webhook
+	deliveries []domainwebhook.Delivery
+}
+
+func (r *memoryRepo) List(_ context.Context, ownerID string) ([]domainwebhook.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []domainwebhook.Webhook
+	for _, w := range r.webhooks {
+		if w.OwnerID == ownerID {
+			res = append(res, w)
+		}
+	}
+	return res, nil
+}
+
+func (r *memoryRepo) Get(context.Context, string) (*domainwebhook.Webhook, error) { return nil, nil }
+func (r *memoryRepo) Create(context.Context, domainwebhook.Webhook) (*domainwebhook.Webhook, error) {
+	return nil, nil
+}
+func (r *memoryRepo) Update(context.Context, domainwebhook.Webhook) (*domainwebhook.Webhook, error) {
+	return nil, nil
+}
+func (r *memoryRepo) Delete(context.Context, string) error { return nil }
+
+func (r *memoryRepo) CreateDelivery(_ context.Context, d domainwebhook.Delivery) (*domainwebhook.Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.deliveries {
+		if d.RedeliveryOf == "" && existing.WebhookID == d.WebhookID && existing.EventID == d.EventID {
+			return &existing, nil
+		}
+	}
+	d.ID = "d" + strconv.Itoa(len(r.deliveries)+1)
+	r.deliveries = append(r.deliveries, d)
+	return &d, nil
+}
+
+func (r *memoryRepo) GetDelivery(context.Context, string) (*domainwebhook.Delivery, error) {
+	return nil, nil
+}
+
+func (r *memoryRepo) ListDeliveries(context.Context, string, *page.Cursor, int) ([]domainwebhook.Delivery, error) {
+	return nil, nil
+}
+
+func (r *memoryRepo) ClaimDue(_ context.Context, limit int, _ time.Time) ([]domainwebhook.DueDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []domainwebhook.DueDelivery
+	for _, d := range r.deliveries {
+		if d.Status != domainwebhook.DeliveryPending || len(due) == limit {
+			continue
+		}
+		for _, w := range r.webhooks {
+			if w.ID == d.WebhookID {
+				due = append(due, domainwebhook.DueDelivery{Delivery: d, URL: w.URL, Secret: w.Secret})
+			}
+		}
+	}
+	return due, nil
+}
+
+func (r *memoryRepo) SaveAttempt(_ context.Context, d domainwebhook.Delivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.deliveries {
+		if r.deliveries[i].ID == d.ID {
+			r.deliveries[i] = d
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepo) delivery(i int) domainwebhook.Delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.deliveries[i]
+}
+
+func TestWorker_RetriesUntilReceiverAccepts(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests int
+		verified bool
+	)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read body: %v", err)
+		}
+		ts, err := strconv.ParseInt(r.Header.Get(webhookgateway.HeaderTimestamp), 10, 64)
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		verified = err == nil && domainwebhook.Verify("whsec_test", ts, body, r.Header.Get(webhookgateway.HeaderSignature))
+		// The endpoint is down for the first attempt and recovers afterwards.
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	repo := &memoryRepo{webhooks: []domainwebhook.Webhook{
+		{ID: "w1", OwnerID: "owner", URL: receiver.URL, Secret: "whsec_test", Active: true, Events: []domainwebhook.EventType{domainwebhook.EventNotePublished}},
+		{ID: "w2", OwnerID: "owner", URL: receiver.URL, Secret: "whsec_other", Active: true, Events: []domainwebhook.EventType{domainwebhook.EventNoteDeleted}},
+	}}
+	dispatcher := usecase.NewWebhookDispatchInteractor(repo, webhookgateway.NewHTTPSender(receiver.Client()))
+
+	e := event.Event{ID: "e1", Type: event.NotePublished, AggregateType: event.AggregateNote, AggregateID: "n1", Payload: map[string]any{"ownerId": "owner"}, OccurredAt: time.Now()}
+	// The relay may hand the same event over twice; it is still delivered once.
+	for range 2 {
+		if err := dispatcher.Enqueue(context.Background(), e); err != nil {
+			t.Fatalf("enqueue: %v", err)
+		}
+	}
+	if len(repo.deliveries) != 1 {
+		t.Fatalf("want 1 delivery, got %+v", repo.deliveries)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- NewWorker(dispatcher, Config{BatchSize: 10, PollInterval: 10 * time.Millisecond}).Run(ctx)
+	}()
+
+	deadline := time.After(5 * time.Second)
+	for repo.delivery(0).Status == domainwebhook.DeliveryPending {
+		select {
+		case <-deadline:
+			t.Fatalf("delivery not completed: %+v", repo.delivery(0))
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("worker exited with error: %v", err)
+	}
+
+	got := repo.delivery(0)
+	if got.Status != domainwebhook.DeliverySucceeded || got.Attempts != 2 || got.LastStatusCode != http.StatusOK || got.DeliveredAt == nil {
+		t.Fatalf("unexpected delivery log: %+v", got)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if requests != 2 || !verified {
+		t.Fatalf("want 2 signed requests, got %d (verified %v)", requests, verified)
+	}
+}
@@ -0,0 +1,35 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+)
+
+// ArchiveInputPort defines account archive use case inputs.
+type ArchiveInputPort interface {
+	Export(ctx context.Context, input ArchiveAccountInput) error
+	Import(ctx context.Context, input ArchiveImportInput) error
+}
+
+// ArchiveOutputPort defines account archive presenters.
+type ArchiveOutputPort interface {
+	PresentArchive(ctx context.Context, archive *archive.Archive) error
+	PresentArchiveImported(ctx context.Context, result *archive.ImportResult) error
+}
+
+// ArchiveAccountInput names an account by ID or, when ID is empty, by email, and the
+// workspace to export from or import into.
+type ArchiveAccountInput struct {
+	ID    string
+	Email string
+	// WorkspaceID must be a workspace of the account; empty means the workspace it joined first.
+	WorkspaceID string
+}
+
+// ArchiveImportInput is input for importing an archive.
+// Everything in the archive becomes owned by the target account.
+type ArchiveImportInput struct {
+	Owner   ArchiveAccountInput
+	Archive archive.Archive
+}
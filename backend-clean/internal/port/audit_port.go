@@ -0,0 +1,55 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	"immortal-architecture-clean/backend/internal/domain/page"
+)
+
+// AuditInputPort defines audit log use case inputs. Only administrators may read
+// the log; other accounts get ErrUnauthorized.
+type AuditInputPort interface {
+	List(ctx context.Context, filters audit.Filters, viewerID string) error
+	// Verify walks the whole hash chain and reports the first entry that does not fit.
+	Verify(ctx context.Context, viewerID string) error
+}
+
+// AuditOutputPort defines audit log presenters.
+type AuditOutputPort interface {
+	PresentAuditLog(ctx context.Context, entries page.Page[audit.Entry]) error
+	PresentAuditVerification(ctx context.Context, v audit.Verification) error
+}
+
+// AuditRecorder appends entries to the audit log. It must be called inside the
+// TxManager transaction that made the change, as its last write: appending
+// serializes writers on the head of the chain until the transaction ends.
+type AuditRecorder interface {
+	Record(ctx context.Context, entries ...audit.Entry) error
+}
+
+// AuditRepository abstracts audit log persistence.
+type AuditRepository interface {
+	AuditRecorder
+	// List returns entries matching the filters, newest first.
+	List(ctx context.Context, filters audit.Filters) ([]audit.Entry, error)
+	// ListChain returns up to limit entries after the given sequence, oldest first.
+	ListChain(ctx context.Context, afterSequence int64, limit int) ([]audit.Entry, error)
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the ID of the request being served,
+// which audit entries record to tie a change to its request.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID, or empty outside a request.
+func RequestIDFromContext(ctx context.Context) string {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return id
+}
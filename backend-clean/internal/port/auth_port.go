@@ -0,0 +1,8 @@
+package port
+
+import "context"
+
+// TokenVerifier validates access tokens and resolves the authenticated account ID.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (string, error)
+}
@@ -0,0 +1,68 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/comment"
+)
+
+// CommentInputPort defines comment use case inputs.
+// Comments are readable and writable on exactly the notes the account may read;
+// other notes return ErrNotFound.
+type CommentInputPort interface {
+	List(ctx context.Context, noteID, viewerID string) error
+	Create(ctx context.Context, input CommentCreateInput) error
+	Update(ctx context.Context, input CommentUpdateInput) error
+	// Delete removes the comment, and its replies when it starts a thread.
+	Delete(ctx context.Context, noteID, commentID, actorID string) error
+	Resolve(ctx context.Context, input CommentResolveInput) error
+}
+
+// CommentOutputPort defines comment presenters.
+type CommentOutputPort interface {
+	PresentCommentThreads(ctx context.Context, threads []comment.Thread) error
+	PresentComment(ctx context.Context, c *comment.WithMeta) error
+	PresentCommentDeleted(ctx context.Context) error
+}
+
+// CommentRepository abstracts comment persistence.
+type CommentRepository interface {
+	// List returns the note's comments with their authors, oldest first.
+	List(ctx context.Context, noteID string) ([]comment.WithMeta, error)
+	Get(ctx context.Context, id string) (*comment.WithMeta, error)
+	Create(ctx context.Context, c comment.Comment) (*comment.Comment, error)
+	// Update stores the body.
+	Update(ctx context.Context, c comment.Comment) (*comment.Comment, error)
+	// UpdateResolution stores ResolvedBy and ResolvedAt.
+	UpdateResolution(ctx context.Context, c comment.Comment) (*comment.Comment, error)
+	// Delete removes the comment with its replies; unknown comments return ErrNotFound.
+	Delete(ctx context.Context, id string) error
+}
+
+// CommentCreateInput is input for commenting on a note.
+type CommentCreateInput struct {
+	NoteID   string
+	AuthorID string
+	// SectionID is the section a new thread is about; empty for the whole note.
+	// Replies are about the section of their thread, so it is ignored for them.
+	SectionID string
+	// ParentID is the thread to reply to; empty starts a new thread.
+	ParentID string
+	Body     string
+}
+
+// CommentUpdateInput is input for editing a comment.
+type CommentUpdateInput struct {
+	NoteID    string
+	CommentID string
+	ActorID   string
+	Body      string
+}
+
+// CommentResolveInput is input for resolving or reopening a thread.
+type CommentResolveInput struct {
+	NoteID    string
+	CommentID string
+	ActorID   string
+	Resolved  bool
+}
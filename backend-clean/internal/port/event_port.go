@@ -0,0 +1,29 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+)
+
+// EventPublisher records domain events. Called inside a TxManager transaction,
+// events are stored atomically with the change that raised them.
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...event.Event) error
+}
+
+// OutboxRepository abstracts the transactional outbox the relay delivers from.
+type OutboxRepository interface {
+	EventPublisher
+	// ClaimPending locks up to limit due events, at most the oldest pending one per aggregate.
+	ClaimPending(ctx context.Context, limit int) ([]event.Stored, error)
+	MarkDelivered(ctx context.Context, sequence int64) error
+	MarkFailed(ctx context.Context, sequence int64, cause string, retryAt time.Time) error
+}
+
+// EventSubscriber receives relayed domain events.
+// Delivery is at-least-once, so subscribers must tolerate an event ID seen before.
+type EventSubscriber interface {
+	Handle(ctx context.Context, e event.Event) error
+}
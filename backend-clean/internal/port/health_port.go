@@ -0,0 +1,9 @@
+package port
+
+import "context"
+
+// ReadinessChecker reports whether the dependencies of the server are ready for
+// it to serve requests. A nil error means ready.
+type ReadinessChecker interface {
+	Ready(ctx context.Context) error
+}
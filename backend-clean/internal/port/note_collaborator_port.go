@@ -0,0 +1,39 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// NoteCollaboratorInputPort defines note collaborator use case inputs.
+type NoteCollaboratorInputPort interface {
+	List(ctx context.Context, noteID, viewerID string) error
+	Put(ctx context.Context, input NoteCollaboratorPutInput) error
+	Delete(ctx context.Context, noteID, accountID, actorID string) error
+}
+
+// NoteCollaboratorOutputPort defines note collaborator presenters.
+type NoteCollaboratorOutputPort interface {
+	PresentNoteCollaborators(ctx context.Context, collaborators []note.CollaboratorWithMeta) error
+	PresentNoteCollaborator(ctx context.Context, collaborator *note.CollaboratorWithMeta) error
+	PresentNoteCollaboratorDeleted(ctx context.Context) error
+}
+
+// NoteCollaboratorRepository abstracts note collaborator persistence.
+type NoteCollaboratorRepository interface {
+	// List returns the note's collaborators with their profiles, oldest first.
+	List(ctx context.Context, noteID string) ([]note.CollaboratorWithMeta, error)
+	// Upsert adds a collaborator, or changes the role of an existing one.
+	Upsert(ctx context.Context, c note.Collaborator) (*note.Collaborator, error)
+	// Delete removes a collaborator; accounts that do not collaborate return ErrNotFound.
+	Delete(ctx context.Context, noteID, accountID string) error
+}
+
+// NoteCollaboratorPutInput is input for adding a collaborator or changing its role.
+type NoteCollaboratorPutInput struct {
+	NoteID    string
+	AccountID string
+	Role      note.Role
+	ActorID   string
+}
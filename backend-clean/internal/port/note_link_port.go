@@ -0,0 +1,41 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// NoteLinkInputPort defines note link use case inputs.
+type NoteLinkInputPort interface {
+	List(ctx context.Context, noteID, viewerID string) error
+	Create(ctx context.Context, input NoteLinkCreateInput) error
+	Delete(ctx context.Context, noteID, linkID, actorID string) error
+}
+
+// NoteLinkOutputPort defines note link presenters.
+type NoteLinkOutputPort interface {
+	PresentNoteLinks(ctx context.Context, outgoing, backlinks []note.LinkSummary) error
+	PresentNoteLink(ctx context.Context, link *note.LinkSummary) error
+	PresentNoteLinkDeleted(ctx context.Context) error
+}
+
+// NoteLinkRepository abstracts note link persistence.
+type NoteLinkRepository interface {
+	// Create stores a link. Creating an existing link returns the stored one.
+	Create(ctx context.Context, link note.Link) (*note.Link, error)
+	// Delete removes a link owned by sourceID; unknown links return ErrNotFound.
+	Delete(ctx context.Context, sourceID, linkID string) error
+	// DeleteByNote removes every link from or to the note.
+	DeleteByNote(ctx context.Context, noteID string) error
+	ListOutgoing(ctx context.Context, noteID string) ([]note.LinkSummary, error)
+	ListBacklinks(ctx context.Context, noteID string) ([]note.LinkSummary, error)
+}
+
+// NoteLinkCreateInput is input for linking notes.
+type NoteLinkCreateInput struct {
+	SourceID string
+	TargetID string
+	Type     note.LinkType
+	ActorID  string
+}
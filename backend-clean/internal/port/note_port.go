@@ -5,35 +5,64 @@ import (
 	"context"
 
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 )
 
 // NoteInputPort defines note use case inputs.
 type NoteInputPort interface {
-	List(ctx context.Context, filters note.Filters) error
-	Get(ctx context.Context, id string) error
+	List(ctx context.Context, filters note.Filters, viewerID string) error
+	Search(ctx context.Context, filters note.Filters, viewerID string) error
+	Get(ctx context.Context, id, viewerID string) error
 	Create(ctx context.Context, input NoteCreateInput) error
 	Update(ctx context.Context, input NoteUpdateInput) error
 	ChangeStatus(ctx context.Context, input NoteStatusChangeInput) error
+	MigrateTemplate(ctx context.Context, input NoteMigrateTemplateInput) error
+	ExportMarkdown(ctx context.Context, id, viewerID string) error
+	ImportMarkdown(ctx context.Context, input NoteImportMarkdownInput) error
+	// Delete moves the note to the trash.
 	Delete(ctx context.Context, id, ownerID string) error
+	// ListTrash presents trashed notes of filters.OwnerID through PresentNoteList.
+	ListTrash(ctx context.Context, filters trash.Filters) error
+	Restore(ctx context.Context, id, ownerID string) error
+	// Purge deletes a trashed note for good.
+	Purge(ctx context.Context, id, ownerID string) error
 }
 
 // NoteOutputPort defines note presenters.
 type NoteOutputPort interface {
-	PresentNoteList(ctx context.Context, notes []note.WithMeta) error
+	PresentNoteList(ctx context.Context, notes page.Page[note.WithMeta]) error
+	PresentSearchResults(ctx context.Context, results []note.SearchResult) error
 	PresentNote(ctx context.Context, note *note.WithMeta) error
+	PresentNoteMarkdown(ctx context.Context, note *note.WithMeta, markdown string) error
 	PresentNoteDeleted(ctx context.Context) error
 }
 
 // NoteRepository abstracts note persistence.
 type NoteRepository interface {
 	List(ctx context.Context, filters note.Filters) ([]note.WithMeta, error)
+	Search(ctx context.Context, filters note.Filters) ([]note.SearchResult, error)
 	Get(ctx context.Context, id string) (*note.WithMeta, error)
 	Create(ctx context.Context, n note.Note) (*note.Note, error)
+	// Update changes the title only while n.Version is the stored version and
+	// returns ErrConflict otherwise.
 	Update(ctx context.Context, n note.Note) (*note.Note, error)
 	UpdateStatus(ctx context.Context, id string, status note.NoteStatus) (*note.Note, error)
+	// UpdateTemplateVersion pins the note to n.TemplateVersion and replaces its sections,
+	// under the same version check as Update.
+	UpdateTemplateVersion(ctx context.Context, n note.Note, sections []note.Section) (*note.Note, error)
+	// ListTrash, GetTrashed, Trash and Restore work on trashed notes, which every
+	// other method treats as missing.
+	ListTrash(ctx context.Context, filters trash.Filters) ([]note.WithMeta, error)
+	GetTrashed(ctx context.Context, id string) (*note.WithMeta, error)
+	Trash(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
+	// Delete removes the note for good.
 	Delete(ctx context.Context, id string) error
 	ReplaceSections(ctx context.Context, noteID string, sections []note.Section) error
+	// ReplaceTags sets the tags of a note to exactly tags.
+	ReplaceTags(ctx context.Context, noteID string, tags []note.Tag) error
 }
 
 // NoteCreateInput is input for creating notes.
@@ -42,6 +71,8 @@ type NoteCreateInput struct {
 	TemplateID string
 	OwnerID    string
 	Sections   []SectionInput
+	// Tags are normalized as note.ParseTag does.
+	Tags []string
 }
 
 // SectionInput is input for creating sections.
@@ -52,10 +83,16 @@ type SectionInput struct {
 
 // NoteUpdateInput is input for updating notes.
 type NoteUpdateInput struct {
-	ID       string
-	Title    string
+	ID    string
+	Title string
+	// OwnerID is the account making the change: the owner or an editor.
 	OwnerID  string
 	Sections []SectionUpdateInput
+	// AddTags and RemoveTags change the note's tags; removal is applied first.
+	AddTags    []string
+	RemoveTags []string
+	// Version is the note version the client edited; nil skips the check.
+	Version *int
 }
 
 // SectionUpdateInput is input for updating sections.
@@ -71,6 +108,22 @@ type NoteStatusChangeInput struct {
 	Status  note.NoteStatus
 }
 
+// NoteMigrateTemplateInput is input for moving a note to the latest template version.
+type NoteMigrateTemplateInput struct {
+	ID       string
+	OwnerID  string
+	Mappings []note.FieldMapping
+	// Version is the note version the client edited; nil skips the check.
+	Version *int
+}
+
+// NoteImportMarkdownInput is input for creating a note from a Markdown document.
+type NoteImportMarkdownInput struct {
+	TemplateID string
+	OwnerID    string
+	Markdown   string
+}
+
 // NoteFilters aliases domain note.Filters
 // NoteWithMeta aliases domain note.WithMeta
 // TemplateFields aliases template.Field slice
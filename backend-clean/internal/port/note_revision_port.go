@@ -0,0 +1,30 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// NoteRevisionInputPort defines note revision use case inputs.
+type NoteRevisionInputPort interface {
+	List(ctx context.Context, noteID, viewerID string) error
+	Get(ctx context.Context, noteID string, number int, viewerID string) error
+	// Diff compares revision number against base; a base of 0 means the previous revision.
+	Diff(ctx context.Context, noteID string, number, base int, viewerID string) error
+}
+
+// NoteRevisionOutputPort defines note revision presenters.
+type NoteRevisionOutputPort interface {
+	PresentRevisionList(ctx context.Context, revisions []note.Revision) error
+	PresentRevision(ctx context.Context, revision *note.Revision) error
+	PresentRevisionDiff(ctx context.Context, diff *note.RevisionDiff) error
+}
+
+// NoteRevisionRepository abstracts note revision persistence.
+// Revisions are append-only; Create assigns the next revision number for the note.
+type NoteRevisionRepository interface {
+	Create(ctx context.Context, revision note.Revision) (*note.Revision, error)
+	List(ctx context.Context, noteID string) ([]note.Revision, error)
+	Get(ctx context.Context, noteID string, number int) (*note.Revision, error)
+}
@@ -0,0 +1,21 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+)
+
+// RateLimitStore keeps token buckets. Implementations must refill and take a token
+// atomically so concurrent requests of one subject cannot overspend the bucket.
+type RateLimitStore interface {
+	// Take takes one token from the bucket under key, starting from a full bucket
+	// when there is none yet.
+	Take(ctx context.Context, key string, policy ratelimit.Policy, now time.Time) (ratelimit.Decision, error)
+}
+
+// RateLimiter decides whether a request may proceed.
+type RateLimiter interface {
+	Allow(ctx context.Context, subject ratelimit.Subject, class ratelimit.Class) (ratelimit.Decision, error)
+}
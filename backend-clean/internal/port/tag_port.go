@@ -0,0 +1,24 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// TagInputPort defines tag use case inputs.
+type TagInputPort interface {
+	// List presents how many notes visible to the viewer carry each tag, most used first.
+	List(ctx context.Context, filters note.TagFilters, viewerID string) error
+}
+
+// TagOutputPort defines tag presenters.
+type TagOutputPort interface {
+	PresentTagCounts(ctx context.Context, tags []note.TagCount) error
+}
+
+// TagRepository abstracts tag usage queries. Tags themselves are stored with their
+// note through NoteRepository.
+type TagRepository interface {
+	ListCounts(ctx context.Context, filters note.TagFilters) ([]note.TagCount, error)
+}
@@ -4,7 +4,9 @@ package port
 import (
 	"context"
 
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 )
 
 // TemplateInputPort defines template use case inputs.
@@ -13,12 +15,18 @@ type TemplateInputPort interface {
 	Get(ctx context.Context, id string) error
 	Create(ctx context.Context, input TemplateCreateInput) error
 	Update(ctx context.Context, input TemplateUpdateInput) error
+	// Delete moves the template to the trash.
 	Delete(ctx context.Context, id, ownerID string) error
+	// ListTrash presents trashed templates of filters.OwnerID through PresentTemplateList.
+	ListTrash(ctx context.Context, filters trash.Filters) error
+	Restore(ctx context.Context, id, ownerID string) error
+	// Purge deletes a trashed template for good.
+	Purge(ctx context.Context, id, ownerID string) error
 }
 
 // TemplateOutputPort defines template presenters.
 type TemplateOutputPort interface {
-	PresentTemplateList(ctx context.Context, templates []template.WithUsage) error
+	PresentTemplateList(ctx context.Context, templates page.Page[template.WithUsage]) error
 	PresentTemplate(ctx context.Context, template *template.WithUsage) error
 	PresentTemplateDeleted(ctx context.Context) error
 }
@@ -28,9 +36,24 @@ type TemplateRepository interface {
 	List(ctx context.Context, filters template.Filters) ([]template.WithUsage, error)
 	Get(ctx context.Context, id string) (*template.WithUsage, error)
 	Create(ctx context.Context, tpl template.Template) (*template.Template, error)
+	// Update changes the name only while tpl.Version is the stored version and
+	// returns ErrConflict otherwise.
 	Update(ctx context.Context, tpl template.Template) (*template.Template, error)
+	// ListTrash, GetTrashed, Trash and Restore work on trashed templates, which every
+	// other method treats as missing.
+	ListTrash(ctx context.Context, filters trash.Filters) ([]template.WithUsage, error)
+	GetTrashed(ctx context.Context, id string) (*template.WithUsage, error)
+	Trash(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
+	// Delete removes the template for good.
 	Delete(ctx context.Context, id string) error
-	ReplaceFields(ctx context.Context, templateID string, fields []template.Field) error
+	// ReplaceFields stores fields as the fields of the given template version.
+	// Earlier versions are kept while notes are pinned to them.
+	ReplaceFields(ctx context.Context, templateID string, version int, fields []template.Field) error
+	// GetFields returns the fields of a template version.
+	GetFields(ctx context.Context, templateID string, version int) ([]template.Field, error)
+	// PruneFieldVersions removes earlier field versions no note is pinned to.
+	PruneFieldVersions(ctx context.Context, templateID string) error
 }
 
 // TemplateCreateInput is input for creating templates.
@@ -46,4 +69,6 @@ type TemplateUpdateInput struct {
 	Name    string
 	Fields  []template.Field
 	OwnerID string
+	// Version is the template version the client edited; nil skips the check.
+	Version *int
 }
@@ -0,0 +1,9 @@
+package port
+
+import "context"
+
+// TrashPurger deletes trashed notes and templates that outlived the retention period.
+type TrashPurger interface {
+	// PurgeExpired purges up to limit expired items and returns how many were purged.
+	PurgeExpired(ctx context.Context, limit int) (int, error)
+}
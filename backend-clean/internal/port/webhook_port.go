@@ -0,0 +1,95 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+// WebhookInputPort defines webhook management use case inputs.
+// Webhooks are private to their owner; other accounts get ErrNotFound.
+type WebhookInputPort interface {
+	List(ctx context.Context, ownerID string) error
+	Get(ctx context.Context, id, ownerID string) error
+	Create(ctx context.Context, input WebhookCreateInput) error
+	Update(ctx context.Context, input WebhookUpdateInput) error
+	Delete(ctx context.Context, id, ownerID string) error
+	ListDeliveries(ctx context.Context, input WebhookDeliveryListInput) error
+	// Redeliver queues a new delivery that resends an earlier one.
+	Redeliver(ctx context.Context, webhookID, deliveryID, ownerID string) error
+}
+
+// WebhookOutputPort defines webhook presenters.
+type WebhookOutputPort interface {
+	PresentWebhookList(ctx context.Context, webhooks []webhook.Webhook) error
+	PresentWebhook(ctx context.Context, w *webhook.Webhook) error
+	// PresentWebhookCreated presents a new webhook including its secret, which is shown only once.
+	PresentWebhookCreated(ctx context.Context, w *webhook.Webhook) error
+	PresentWebhookDeleted(ctx context.Context) error
+	PresentDeliveryList(ctx context.Context, deliveries page.Page[webhook.Delivery]) error
+	PresentDelivery(ctx context.Context, d *webhook.Delivery) error
+}
+
+// WebhookDispatcher turns domain events into webhook deliveries and sends them.
+type WebhookDispatcher interface {
+	// Enqueue creates a pending delivery for every active webhook of the event's owner
+	// that subscribes to it. Enqueuing the same event twice is a no-op.
+	Enqueue(ctx context.Context, e event.Event) error
+	// DeliverDue sends up to limit due deliveries and returns how many were claimed.
+	DeliverDue(ctx context.Context, limit int) (int, error)
+}
+
+// WebhookRepository abstracts webhook and delivery log persistence.
+type WebhookRepository interface {
+	List(ctx context.Context, ownerID string) ([]webhook.Webhook, error)
+	Get(ctx context.Context, id string) (*webhook.Webhook, error)
+	Create(ctx context.Context, w webhook.Webhook) (*webhook.Webhook, error)
+	// Update stores the URL, events and active flag; the secret never changes.
+	Update(ctx context.Context, w webhook.Webhook) (*webhook.Webhook, error)
+	// Delete removes the webhook with its delivery log.
+	Delete(ctx context.Context, id string) error
+	// CreateDelivery stores a delivery. An original delivery of an event the webhook
+	// already has returns the stored one; redeliveries are always new rows.
+	CreateDelivery(ctx context.Context, d webhook.Delivery) (*webhook.Delivery, error)
+	GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error)
+	// ListDeliveries returns the webhook's deliveries newest first, after the cursor.
+	ListDeliveries(ctx context.Context, webhookID string, after *page.Cursor, limit int) ([]webhook.Delivery, error)
+	// ClaimDue leases due deliveries of active webhooks until leaseUntil,
+	// so no other worker sends them meanwhile.
+	ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]webhook.DueDelivery, error)
+	// SaveAttempt stores the outcome of a delivery attempt.
+	SaveAttempt(ctx context.Context, d webhook.Delivery) error
+}
+
+// WebhookSender posts a signed delivery to its endpoint.
+type WebhookSender interface {
+	// Send returns the response status, or an error when no response was received.
+	Send(ctx context.Context, d webhook.DueDelivery) (int, error)
+}
+
+// WebhookCreateInput is input for registering a webhook.
+type WebhookCreateInput struct {
+	OwnerID string
+	URL     string
+	Events  []webhook.EventType
+}
+
+// WebhookUpdateInput is input for changing a webhook.
+type WebhookUpdateInput struct {
+	ID      string
+	OwnerID string
+	URL     string
+	Events  []webhook.EventType
+	Active  bool
+}
+
+// WebhookDeliveryListInput is input for reading a webhook's delivery log.
+type WebhookDeliveryListInput struct {
+	WebhookID string
+	OwnerID   string
+	After     *page.Cursor
+	Limit     int
+}
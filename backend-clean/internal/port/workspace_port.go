@@ -0,0 +1,138 @@
+package port
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+)
+
+// WorkspaceInputPort defines workspace use case inputs.
+type WorkspaceInputPort interface {
+	List(ctx context.Context, accountID string) error
+	Create(ctx context.Context, input WorkspaceCreateInput) error
+	Get(ctx context.Context, workspaceID, actorID string) error
+	Update(ctx context.Context, input WorkspaceUpdateInput) error
+	Delete(ctx context.Context, workspaceID, actorID string) error
+	ListMembers(ctx context.Context, workspaceID, actorID string) error
+	UpdateMember(ctx context.Context, input WorkspaceMemberUpdateInput) error
+	RemoveMember(ctx context.Context, workspaceID, accountID, actorID string) error
+	ListInvites(ctx context.Context, workspaceID, actorID string) error
+	Invite(ctx context.Context, input WorkspaceInviteInput) error
+	RevokeInvite(ctx context.Context, workspaceID, inviteID, actorID string) error
+	ListMyInvites(ctx context.Context, actorID string) error
+	AcceptInvite(ctx context.Context, inviteID, actorID string) error
+}
+
+// WorkspaceOutputPort defines workspace presenters.
+type WorkspaceOutputPort interface {
+	PresentWorkspaces(ctx context.Context, workspaces []workspace.WithRole) error
+	PresentWorkspace(ctx context.Context, w *workspace.WithRole) error
+	PresentWorkspaceDeleted(ctx context.Context) error
+	PresentWorkspaceMembers(ctx context.Context, members []workspace.MemberWithMeta) error
+	PresentWorkspaceMember(ctx context.Context, member *workspace.MemberWithMeta) error
+	PresentWorkspaceMemberRemoved(ctx context.Context) error
+	PresentWorkspaceInvites(ctx context.Context, invites []workspace.InviteWithMeta) error
+	PresentWorkspaceInvite(ctx context.Context, invite *workspace.InviteWithMeta) error
+	PresentWorkspaceInviteRevoked(ctx context.Context) error
+}
+
+// WorkspaceRepository abstracts workspace and membership persistence.
+type WorkspaceRepository interface {
+	// ListByAccount returns the account's workspaces in the order they were joined.
+	ListByAccount(ctx context.Context, accountID string) ([]workspace.WithRole, error)
+	Get(ctx context.Context, id string) (*workspace.Workspace, error)
+	Create(ctx context.Context, w workspace.Workspace) (*workspace.Workspace, error)
+	Update(ctx context.Context, w workspace.Workspace) (*workspace.Workspace, error)
+	// Delete removes the workspace with its members and invites.
+	Delete(ctx context.Context, id string) error
+	// HasContents reports whether templates or notes, trashed or not, belong to the workspace.
+	HasContents(ctx context.Context, id string) (bool, error)
+	// GetMember returns ErrNotFound when the account does not belong to the workspace.
+	GetMember(ctx context.Context, workspaceID, accountID string) (*workspace.Member, error)
+	// ListMembers returns the members with their profiles, oldest first.
+	ListMembers(ctx context.Context, workspaceID string) ([]workspace.MemberWithMeta, error)
+	// UpsertMember adds a member, or changes the role of an existing one.
+	UpsertMember(ctx context.Context, m workspace.Member) (*workspace.Member, error)
+	// DeleteMember removes a member; accounts that do not belong return ErrNotFound.
+	DeleteMember(ctx context.Context, workspaceID, accountID string) error
+}
+
+// WorkspaceInviteRepository abstracts workspace invite persistence.
+type WorkspaceInviteRepository interface {
+	List(ctx context.Context, workspaceID string) ([]workspace.InviteWithMeta, error)
+	// ListByEmail returns the invites of an email address in any workspace.
+	ListByEmail(ctx context.Context, email string) ([]workspace.InviteWithMeta, error)
+	Get(ctx context.Context, id string) (*workspace.InviteWithMeta, error)
+	// Upsert creates an invite, or changes the role of the pending invite of the same email.
+	Upsert(ctx context.Context, i workspace.Invite) (*workspace.Invite, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// WorkspaceResolver decides the workspace a request works in.
+type WorkspaceResolver interface {
+	// Resolve returns requestedID when the account belongs to it, or the account's
+	// default workspace when nothing was requested.
+	Resolve(ctx context.Context, accountID, requestedID string) (string, error)
+}
+
+// WorkspaceCreateInput is input for creating a workspace.
+type WorkspaceCreateInput struct {
+	Name    string
+	ActorID string
+}
+
+// WorkspaceUpdateInput is input for renaming a workspace.
+type WorkspaceUpdateInput struct {
+	WorkspaceID string
+	Name        string
+	ActorID     string
+}
+
+// WorkspaceMemberUpdateInput is input for changing the role of a member.
+type WorkspaceMemberUpdateInput struct {
+	WorkspaceID string
+	AccountID   string
+	Role        workspace.Role
+	ActorID     string
+}
+
+// WorkspaceInviteInput is input for inviting an email address into a workspace.
+type WorkspaceInviteInput struct {
+	WorkspaceID string
+	Email       string
+	Role        workspace.Role
+	ActorID     string
+}
+
+type workspaceIDKey struct{}
+
+// WithWorkspaceID returns a copy of ctx carrying the workspace the request works in.
+// Repositories limit templates and notes to it.
+func WithWorkspaceID(ctx context.Context, workspaceID string) context.Context {
+	return context.WithValue(ctx, workspaceIDKey{}, workspaceID)
+}
+
+// WorkspaceIDFromContext returns the active workspace ID, or empty when the context has none.
+// Repositories refuse to read templates and notes without one unless the context was made
+// with WithAllWorkspaces.
+func WorkspaceIDFromContext(ctx context.Context) string {
+	id, ok := ctx.Value(workspaceIDKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return id
+}
+
+type allWorkspacesKey struct{}
+
+// WithAllWorkspaces returns a copy of ctx that works across every workspace. It is meant
+// for system jobs that act for no account, such as purging the trash.
+func WithAllWorkspaces(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allWorkspacesKey{}, true)
+}
+
+// AllWorkspaces reports whether ctx was made with WithAllWorkspaces.
+func AllWorkspaces(ctx context.Context) bool {
+	all, _ := ctx.Value(allWorkspacesKey{}).(bool)
+	return all
+}
@@ -3,25 +3,35 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // AccountInteractor handles account use cases.
 type AccountInteractor struct {
-	repo   port.AccountRepository
-	output port.AccountOutputPort
+	repo       port.AccountRepository
+	workspaces port.WorkspaceRepository
+	tx         port.TxManager
+	events     port.EventPublisher
+	auditLog   port.AuditRecorder
+	output     port.AccountOutputPort
 }
 
 var _ port.AccountInputPort = (*AccountInteractor)(nil)
 
 // NewAccountInteractor creates AccountInteractor.
-func NewAccountInteractor(repo port.AccountRepository, output port.AccountOutputPort) *AccountInteractor {
-	return &AccountInteractor{repo: repo, output: output}
+func NewAccountInteractor(repo port.AccountRepository, workspaces port.WorkspaceRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.AccountOutputPort) *AccountInteractor {
+	return &AccountInteractor{repo: repo, workspaces: workspaces, tx: tx, events: events, auditLog: auditLog, output: output}
 }
 
-// CreateOrGet handles upsert/get of OAuth account.
+// CreateOrGet handles upsert/get of OAuth account. Every call is a sign-in and raises AccountSignedIn.
+// An account that belongs to no workspace, as on its first sign-in, gets a personal workspace it owns.
 func (u *AccountInteractor) CreateOrGet(ctx context.Context, input account.OAuthAccountInput) error {
 	email, err := account.ParseEmail(input.Email)
 	if err != nil {
@@ -38,7 +48,31 @@ func (u *AccountInteractor) CreateOrGet(ctx context.Context, input account.OAuth
 	if err := account.Validate(acc); err != nil {
 		return err
 	}
-	a, err := u.repo.UpsertOAuthAccount(ctx, input)
+	var a *account.Account
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		// The account as it was before the sign-in, for the audit log; nil on the first sign-in.
+		before, err := u.repo.GetByEmail(txCtx, string(email))
+		if err != nil && !errors.Is(err, domainerr.ErrNotFound) {
+			return err
+		}
+		a, err = u.repo.UpsertOAuthAccount(txCtx, input)
+		if err != nil {
+			return err
+		}
+		workspaces, err := u.workspaces.ListByAccount(txCtx, a.ID)
+		if err != nil {
+			return err
+		}
+		if len(workspaces) == 0 {
+			if _, err := createOwnedWorkspace(txCtx, u.workspaces, workspace.PersonalName(a.FirstName), a.ID); err != nil {
+				return err
+			}
+		}
+		if err := u.events.Publish(txCtx, event.NewAccountSignedIn(*a)); err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.AccountEntry(audit.ActionSignIn, before, *a))
+	})
 	if err != nil {
 		return err
 	}
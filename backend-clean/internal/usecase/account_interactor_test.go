@@ -8,7 +8,10 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/audit"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
 	uc "immortal-architecture-clean/backend/internal/usecase"
 	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
 )
@@ -17,8 +20,13 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 	tests := []struct {
 		name      string
 		input     account.OAuthAccountInput
+		existing  *account.Account
 		repoAcc   *account.Account
 		repoErr   error
+		joined    []workspace.WithRole
+		createErr error
+		pubErr    error
+		auditErr  error
 		wantError error
 	}{
 		{
@@ -41,7 +49,9 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 				Provider:          "google",
 				ProviderAccountID: "pid",
 			},
-			repoAcc: &account.Account{ID: "acc-1", FirstName: "Hanako"},
+			existing: &account.Account{ID: "acc-1", FirstName: "Taro"},
+			repoAcc:  &account.Account{ID: "acc-1", FirstName: "Hanako"},
+			joined:   []workspace.WithRole{{Workspace: workspace.Workspace{ID: "ws-1"}, Role: workspace.RoleOwner}},
 		},
 		{
 			name: "[Fail] invalid email",
@@ -73,6 +83,42 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 			repoErr:   errors.New("repo err"),
 			wantError: errors.New("repo err"),
 		},
+		{
+			name: "[Fail] personal workspace error",
+			input: account.OAuthAccountInput{
+				Email:             "user@example.com",
+				FirstName:         "Taro",
+				Provider:          "google",
+				ProviderAccountID: "pid",
+			},
+			repoAcc:   &account.Account{ID: "acc-1", FirstName: "Taro"},
+			createErr: errors.New("workspace err"),
+			wantError: errors.New("workspace err"),
+		},
+		{
+			name: "[Fail] publish error",
+			input: account.OAuthAccountInput{
+				Email:             "user@example.com",
+				FirstName:         "Taro",
+				Provider:          "google",
+				ProviderAccountID: "pid",
+			},
+			repoAcc:   &account.Account{ID: "acc-1", Provider: "google"},
+			pubErr:    errors.New("outbox err"),
+			wantError: errors.New("outbox err"),
+		},
+		{
+			name: "[Fail] audit error",
+			input: account.OAuthAccountInput{
+				Email:             "user@example.com",
+				FirstName:         "Taro",
+				Provider:          "google",
+				ProviderAccountID: "pid",
+			},
+			repoAcc:   &account.Account{ID: "acc-1", Provider: "google"},
+			auditErr:  errors.New("audit err"),
+			wantError: errors.New("audit err"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -81,17 +127,45 @@ func TestAccountInteractor_CreateOrGet(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := mockusecase.NewMockAccountRepository(ctrl)
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
 			out := mockusecase.NewMockAccountOutputPort(ctrl)
 
-			shouldCallRepo := tt.wantError == nil || (tt.repoErr != nil && tt.wantError.Error() == tt.repoErr.Error())
+			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+
+			shouldCallRepo := tt.wantError == nil || tt.repoErr != nil || tt.createErr != nil || tt.pubErr != nil || tt.auditErr != nil
 			if shouldCallRepo {
+				runInTx(tx)
+				if tt.existing != nil {
+					repo.EXPECT().GetByEmail(gomock.Any(), tt.input.Email).Return(tt.existing, nil)
+				} else {
+					repo.EXPECT().GetByEmail(gomock.Any(), tt.input.Email).Return(nil, domainerr.ErrNotFound)
+				}
 				repo.EXPECT().UpsertOAuthAccount(gomock.Any(), tt.input).Return(tt.repoAcc, tt.repoErr)
 			}
-			if tt.wantError == nil && tt.repoErr == nil {
+			if tt.repoAcc != nil && tt.repoErr == nil {
+				workspaces.EXPECT().ListByAccount(gomock.Any(), tt.repoAcc.ID).Return(tt.joined, nil)
+				if len(tt.joined) == 0 {
+					// 初回サインインでは個人用ワークスペースを作り、所有者として参加させる。
+					created := &workspace.Workspace{ID: "ws-new", Name: workspace.PersonalName(tt.repoAcc.FirstName)}
+					workspaces.EXPECT().Create(gomock.Any(), workspace.Workspace{Name: created.Name}).Return(created, tt.createErr)
+					if tt.createErr == nil {
+						workspaces.EXPECT().UpsertMember(gomock.Any(), workspace.Member{WorkspaceID: "ws-new", AccountID: tt.repoAcc.ID, Role: workspace.RoleOwner}).Return(&workspace.Member{}, nil)
+					}
+				}
+			}
+			if tt.repoAcc != nil && tt.repoErr == nil && tt.createErr == nil {
+				events.EXPECT().Publish(gomock.Any(), event.NewAccountSignedIn(*tt.repoAcc)).Return(tt.pubErr)
+			}
+			if tt.repoAcc != nil && tt.repoErr == nil && tt.createErr == nil && tt.pubErr == nil {
+				auditLog.EXPECT().Record(gomock.Any(), audit.AccountEntry(audit.ActionSignIn, tt.existing, *tt.repoAcc)).Return(tt.auditErr)
+			}
+			if tt.wantError == nil {
 				out.EXPECT().PresentAccount(gomock.Any(), tt.repoAcc).Return(nil)
 			}
 
-			interactor := uc.NewAccountInteractor(repo, out)
+			interactor := uc.NewAccountInteractor(repo, workspaces, tx, events, auditLog, out)
 			err := interactor.CreateOrGet(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -138,7 +212,7 @@ func TestAccountInteractor_GetByID(t *testing.T) {
 				out.EXPECT().PresentAccount(gomock.Any(), tt.repoAcc).Return(nil)
 			}
 
-			interactor := uc.NewAccountInteractor(repo, out)
+			interactor := uc.NewAccountInteractor(repo, mockusecase.NewMockWorkspaceRepository(ctrl), mockusecase.NewMockTxManager(ctrl), mockusecase.NewMockEventPublisher(ctrl), mockusecase.NewMockAuditRecorder(ctrl), out)
 			err := interactor.GetByID(context.Background(), tt.id)
 
 			if tt.wantError == nil && err != nil {
@@ -197,7 +271,7 @@ func TestAccountInteractor_GetByEmail(t *testing.T) {
 				out.EXPECT().PresentAccount(gomock.Any(), tt.repoAcc).Return(nil)
 			}
 
-			interactor := uc.NewAccountInteractor(repo, out)
+			interactor := uc.NewAccountInteractor(repo, mockusecase.NewMockWorkspaceRepository(ctrl), mockusecase.NewMockTxManager(ctrl), mockusecase.NewMockEventPublisher(ctrl), mockusecase.NewMockAuditRecorder(ctrl), out)
 			err := interactor.GetByEmail(context.Background(), tt.email)
 
 			if tt.wantError == nil && err != nil {
@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// ArchiveInteractor exports an account's templates and notes and imports them into another database.
+// It works only through repository ports, so any gateway can serve as source or target.
+type ArchiveInteractor struct {
+	accounts   port.AccountRepository
+	workspaces port.WorkspaceRepository
+	templates  port.TemplateRepository
+	notes      port.NoteRepository
+	revisions  port.NoteRevisionRepository
+	tx         port.TxManager
+	output     port.ArchiveOutputPort
+}
+
+var _ port.ArchiveInputPort = (*ArchiveInteractor)(nil)
+
+// NewArchiveInteractor creates ArchiveInteractor.
+func NewArchiveInteractor(accounts port.AccountRepository, workspaces port.WorkspaceRepository, templates port.TemplateRepository, notes port.NoteRepository, revisions port.NoteRevisionRepository, tx port.TxManager, output port.ArchiveOutputPort) *ArchiveInteractor {
+	return &ArchiveInteractor{
+		accounts:   accounts,
+		workspaces: workspaces,
+		templates:  templates,
+		notes:      notes,
+		revisions:  revisions,
+		tx:         tx,
+		output:     output,
+	}
+}
+
+// Export archives the account's templates and notes in one workspace, drafts included, from
+// one transaction. Templates of other accounts used by the notes are archived as well so the
+// archive is self-contained.
+func (u *ArchiveInteractor) Export(ctx context.Context, input port.ArchiveAccountInput) error {
+	var archived *archive.Archive
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		owner, err := u.findAccount(txCtx, input)
+		if err != nil {
+			return err
+		}
+		workspaceID, err := NewWorkspaceResolver(u.workspaces).Resolve(txCtx, owner.ID, input.WorkspaceID)
+		if err != nil {
+			return err
+		}
+		txCtx = port.WithWorkspaceID(txCtx, workspaceID)
+		notes, err := u.notes.List(txCtx, note.Filters{OwnerID: &owner.ID, ViewerID: owner.ID, WorkspaceID: workspaceID})
+		if err != nil {
+			return err
+		}
+		templates, err := u.templates.List(txCtx, template.Filters{OwnerID: &owner.ID, WorkspaceID: workspaceID})
+		if err != nil {
+			return err
+		}
+		archived = &archive.Archive{
+			FormatVersion: archive.FormatVersion,
+			ExportedAt:    time.Now().UTC(),
+			Owner: archive.Owner{
+				ID:        owner.ID,
+				Email:     owner.Email.String(),
+				FirstName: owner.FirstName,
+				LastName:  owner.LastName,
+			},
+			Notes: make([]archive.Note, 0, len(notes)),
+		}
+		for _, n := range notes {
+			archived.Notes = append(archived.Notes, archive.NewNote(n))
+			if !slices.ContainsFunc(templates, func(t template.WithUsage) bool { return t.Template.ID == n.Note.TemplateID }) {
+				tpl, err := u.templates.Get(txCtx, n.Note.TemplateID)
+				if err != nil {
+					return err
+				}
+				templates = append(templates, *tpl)
+			}
+		}
+		archived.Templates = make([]archive.Template, 0, len(templates))
+		for _, t := range templates {
+			tpl, err := u.exportTemplate(txCtx, t.Template, archived.Notes)
+			if err != nil {
+				return err
+			}
+			archived.Templates = append(archived.Templates, tpl)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentArchive(ctx, archived)
+}
+
+// exportTemplate archives the current fields and every earlier field version a note is pinned to.
+func (u *ArchiveInteractor) exportTemplate(ctx context.Context, tpl template.Template, notes []archive.Note) (archive.Template, error) {
+	var versions []int
+	if tpl.FieldsVersion > 0 {
+		versions = append(versions, tpl.FieldsVersion)
+	}
+	for _, n := range notes {
+		if n.TemplateID == tpl.ID && !slices.Contains(versions, n.TemplateVersion) {
+			versions = append(versions, n.TemplateVersion)
+		}
+	}
+	slices.Sort(versions)
+
+	archived := archive.Template{
+		ID:            tpl.ID,
+		Name:          tpl.Name,
+		OwnerID:       tpl.OwnerID,
+		FieldVersions: make([]archive.FieldVersion, 0, len(versions)),
+	}
+	for _, v := range versions {
+		fields := tpl.Fields
+		if v != tpl.FieldsVersion {
+			var err error
+			if fields, err = u.templates.GetFields(ctx, tpl.ID, v); err != nil {
+				return archive.Template{}, err
+			}
+		}
+		archived.FieldVersions = append(archived.FieldVersions, archive.FieldVersion{Version: v, Fields: fields})
+	}
+	return archived, nil
+}
+
+// Import recreates the archive for the target account in one of its workspaces in one transaction.
+// Every template and note gets a new ID and the target account as owner; nothing is written
+// unless the whole archive imports.
+func (u *ArchiveInteractor) Import(ctx context.Context, input port.ArchiveImportInput) error {
+	if err := input.Archive.Validate(); err != nil {
+		return err
+	}
+	result := &archive.ImportResult{
+		TemplateIDs: make(map[string]string, len(input.Archive.Templates)),
+		NoteIDs:     make(map[string]string, len(input.Archive.Notes)),
+	}
+	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		owner, err := u.findAccount(txCtx, input.Owner)
+		if err != nil {
+			return err
+		}
+		workspaceID, err := NewWorkspaceResolver(u.workspaces).Resolve(txCtx, owner.ID, input.Owner.WorkspaceID)
+		if err != nil {
+			return err
+		}
+		txCtx = port.WithWorkspaceID(txCtx, workspaceID)
+		result.OwnerID = owner.ID
+		for _, t := range input.Archive.Templates {
+			if err := u.importTemplate(txCtx, input.Archive, t, owner.ID, result); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentArchiveImported(ctx, result)
+}
+
+// importTemplate recreates the field versions of a template in order.
+// Storing fields drops earlier versions no note is pinned to, so the notes of a version
+// are created before the next version is stored.
+func (u *ArchiveInteractor) importTemplate(ctx context.Context, a archive.Archive, t archive.Template, ownerID string, result *archive.ImportResult) error {
+	created, err := u.templates.Create(ctx, template.Template{Name: t.Name, OwnerID: ownerID, WorkspaceID: port.WorkspaceIDFromContext(ctx)})
+	if err != nil {
+		return err
+	}
+	result.TemplateIDs[t.ID] = created.ID
+	version := created.Version
+	for i, fv := range t.FieldVersions {
+		if i > 0 {
+			updated, err := u.templates.Update(ctx, template.Template{ID: created.ID, Name: t.Name, Version: version})
+			if err != nil {
+				return err
+			}
+			version = updated.Version
+		}
+		if err := u.templates.ReplaceFields(ctx, created.ID, version, fv.Fields); err != nil {
+			return err
+		}
+		fields, err := u.templates.GetFields(ctx, created.ID, version)
+		if err != nil {
+			return err
+		}
+		fieldIDs, err := archive.MapFieldIDs(fv.Fields, fields)
+		if err != nil {
+			return err
+		}
+		tpl := template.Template{ID: created.ID, Name: t.Name, OwnerID: ownerID, WorkspaceID: created.WorkspaceID, Fields: fields}
+		for _, n := range a.NotesPinnedTo(t.ID, fv.Version) {
+			noteID, err := u.importNote(ctx, n, tpl, version, fieldIDs)
+			if err != nil {
+				return fmt.Errorf("note %q: %w", n.ID, err)
+			}
+			result.NoteIDs[n.ID] = noteID
+		}
+	}
+	return nil
+}
+
+// importNote creates a note pinned to the imported template version and records its first revision.
+// Fields the archived note has no section for get empty content.
+func (u *ArchiveInteractor) importNote(ctx context.Context, n archive.Note, tpl template.Template, version int, fieldIDs map[string]string) (string, error) {
+	contents := make(map[string]string, len(n.Sections))
+	for _, s := range n.Sections {
+		contents[fieldIDs[s.FieldID]] = s.Content
+	}
+	sections := make([]note.Section, 0, len(tpl.Fields))
+	for _, f := range tpl.Fields {
+		sections = append(sections, note.Section{FieldID: f.ID, Content: contents[f.ID]})
+	}
+	if err := note.ValidateNoteForCreate(n.Title, tpl, sections); err != nil {
+		return "", err
+	}
+	tags, err := n.ParseTags()
+	if err != nil {
+		return "", err
+	}
+
+	created, err := u.notes.Create(ctx, note.Note{
+		Title:           n.Title,
+		TemplateID:      tpl.ID,
+		TemplateVersion: version,
+		OwnerID:         tpl.OwnerID,
+		WorkspaceID:     tpl.WorkspaceID,
+		Status:          n.Status,
+	})
+	if err != nil {
+		return "", err
+	}
+	for i := range sections {
+		sections[i].NoteID = created.ID
+	}
+	if err := u.notes.ReplaceSections(ctx, created.ID, sections); err != nil {
+		return "", err
+	}
+	if len(tags) > 0 {
+		if err := u.notes.ReplaceTags(ctx, created.ID, tags); err != nil {
+			return "", err
+		}
+	}
+	stored, err := u.notes.Get(ctx, created.ID)
+	if err != nil {
+		return "", err
+	}
+	if _, err := u.revisions.Create(ctx, note.NewRevision(*stored, tpl.OwnerID)); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+func (u *ArchiveInteractor) findAccount(ctx context.Context, input port.ArchiveAccountInput) (*account.Account, error) {
+	switch {
+	case input.ID != "":
+		return u.accounts.GetByID(ctx, input.ID)
+	case input.Email != "":
+		return u.accounts.GetByEmail(ctx, input.Email)
+	default:
+		return nil, domainerr.ErrOwnerRequired
+	}
+}
@@ -0,0 +1,226 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	"immortal-architecture-clean/backend/internal/domain/archive"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestArchiveInteractor_Export(t *testing.T) {
+	owner := &account.Account{ID: "owner-1", Email: account.Email("owner@example.com"), FirstName: "Taro", LastName: "Yamada"}
+	ownTpl := template.WithUsage{Template: template.Template{
+		ID: "tpl-1", Name: "ADR", OwnerID: "owner-1", FieldsVersion: 3,
+		Fields: []template.Field{{ID: "f3", Label: "Problem", Order: 1}},
+	}}
+	foreignTpl := &template.WithUsage{Template: template.Template{
+		ID: "tpl-2", Name: "Memo", OwnerID: "other", FieldsVersion: 1,
+		Fields: []template.Field{{ID: "g1", Label: "Body", Order: 1}},
+	}}
+	notes := []note.WithMeta{
+		{
+			Note: note.Note{ID: "n1", Title: "old", TemplateID: "tpl-1", TemplateVersion: 1, OwnerID: "owner-1", Status: note.StatusPublish},
+			Sections: []note.SectionWithField{
+				{Section: note.Section{FieldID: "f1", Content: "b"}, FieldOrder: 2},
+				{Section: note.Section{FieldID: "f0", Content: "a"}, FieldOrder: 1},
+			},
+		},
+		{Note: note.Note{ID: "n2", Title: "memo", TemplateID: "tpl-2", TemplateVersion: 1, OwnerID: "owner-1", Status: note.StatusDraft}},
+	}
+
+	tests := []struct {
+		name      string
+		input     port.ArchiveAccountInput
+		findErr   error
+		wantError error
+	}{
+		{name: "[Success] export by email", input: port.ArchiveAccountInput{Email: "owner@example.com"}},
+		{name: "[Success] export the requested workspace", input: port.ArchiveAccountInput{Email: "owner@example.com", WorkspaceID: "ws-1"}},
+		{name: "[Fail] not a member of the requested workspace", input: port.ArchiveAccountInput{Email: "owner@example.com", WorkspaceID: "ws-2"}, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] account not found", input: port.ArchiveAccountInput{ID: "missing"}, findErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] account required", wantError: domainerr.ErrOwnerRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockArchiveOutputPort(ctrl)
+
+			runInTx(tx)
+			switch {
+			case tt.input.Email != "":
+				accounts.EXPECT().GetByEmail(gomock.Any(), tt.input.Email).Return(owner, nil)
+			case tt.input.ID != "":
+				accounts.EXPECT().GetByID(gomock.Any(), tt.input.ID).Return(nil, tt.findErr)
+			}
+			switch tt.input.WorkspaceID {
+			case "ws-1":
+				workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", "owner-1").Return(&workspace.Member{WorkspaceID: "ws-1", AccountID: "owner-1"}, nil)
+			case "ws-2":
+				workspaces.EXPECT().GetMember(gomock.Any(), "ws-2", "owner-1").Return(nil, domainerr.ErrNotFound)
+			default:
+				if tt.input.Email != "" {
+					workspaces.EXPECT().ListByAccount(gomock.Any(), "owner-1").Return([]workspace.WithRole{{Workspace: workspace.Workspace{ID: "ws-1"}}}, nil)
+				}
+			}
+			if tt.wantError == nil {
+				notesRepo.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f note.Filters) ([]note.WithMeta, error) {
+					if f.OwnerID == nil || *f.OwnerID != "owner-1" || f.ViewerID != "owner-1" || f.Limit != 0 || f.WorkspaceID != "ws-1" {
+						t.Fatalf("unexpected filters: %+v", f)
+					}
+					return notes, nil
+				})
+				templates.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f template.Filters) ([]template.WithUsage, error) {
+					if f.WorkspaceID != "ws-1" {
+						t.Fatalf("unexpected filters: %+v", f)
+					}
+					return []template.WithUsage{ownTpl}, nil
+				})
+				templates.EXPECT().Get(gomock.Any(), "tpl-2").Return(foreignTpl, nil)
+				templates.EXPECT().GetFields(gomock.Any(), "tpl-1", 1).Return([]template.Field{{ID: "f0", Label: "A", Order: 1}, {ID: "f1", Label: "B", Order: 2}}, nil)
+				out.EXPECT().PresentArchive(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *archive.Archive) error {
+					if a.FormatVersion != archive.FormatVersion || a.ExportedAt.IsZero() || a.Owner.Email != "owner@example.com" {
+						t.Fatalf("unexpected header: %+v", a)
+					}
+					if len(a.Templates) != 2 || len(a.Notes) != 2 {
+						t.Fatalf("unexpected contents: %+v", a)
+					}
+					versions := []int{a.Templates[0].FieldVersions[0].Version, a.Templates[0].FieldVersions[1].Version}
+					if !reflect.DeepEqual(versions, []int{1, 3}) {
+						t.Fatalf("field versions = %v, want [1 3]", versions)
+					}
+					wantSections := []archive.Section{{FieldID: "f0", Content: "a"}, {FieldID: "f1", Content: "b"}}
+					if !reflect.DeepEqual(a.Notes[0].Sections, wantSections) {
+						t.Fatalf("sections = %+v", a.Notes[0].Sections)
+					}
+					return a.Validate()
+				})
+			}
+
+			interactor := uc.NewArchiveInteractor(accounts, workspaces, templates, notesRepo, mockusecase.NewMockNoteRevisionRepository(ctrl), tx, out)
+			err := interactor.Export(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestArchiveInteractor_Import(t *testing.T) {
+	valid := archive.Archive{
+		FormatVersion: archive.FormatVersion,
+		Templates: []archive.Template{{
+			ID:   "old-tpl",
+			Name: "ADR",
+			FieldVersions: []archive.FieldVersion{
+				{Version: 2, Fields: []template.Field{{ID: "old-a", Label: "A", Order: 1, IsRequired: true}}},
+				{Version: 5, Fields: []template.Field{{ID: "old-b", Label: "B", Order: 1}, {ID: "old-c", Label: "C", Order: 2}}},
+			},
+		}},
+		Notes: []archive.Note{
+			{ID: "old-n1", Title: "first", TemplateID: "old-tpl", TemplateVersion: 2, Status: note.StatusPublish, Sections: []archive.Section{{FieldID: "old-a", Content: "x"}}, Tags: []note.Tag{"auth"}},
+			{ID: "old-n2", Title: "second", TemplateID: "old-tpl", TemplateVersion: 5, Status: note.StatusDraft, Sections: []archive.Section{{FieldID: "old-c", Content: "y"}}},
+		},
+	}
+	emptyRequired := valid
+	emptyRequired.Notes = []archive.Note{{ID: "old-n1", Title: "first", TemplateID: "old-tpl", TemplateVersion: 2, Status: note.StatusDraft}}
+	unsupported := valid
+	unsupported.FormatVersion = 99
+
+	tests := []struct {
+		name      string
+		archive   archive.Archive
+		wantError error
+	}{
+		{name: "[Success] import with remapped ids and versions", archive: valid},
+		{name: "[Fail] unsupported format version", archive: unsupported, wantError: domainerr.ErrUnsupportedArchiveVersion},
+		{name: "[Fail] invalid note rolls back", archive: emptyRequired, wantError: domainerr.ErrRequiredFieldEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			out := mockusecase.NewMockArchiveOutputPort(ctrl)
+
+			if !errors.Is(tt.wantError, domainerr.ErrUnsupportedArchiveVersion) {
+				runInTx(tx)
+				accounts.EXPECT().GetByID(gomock.Any(), "new-owner").Return(&account.Account{ID: "new-owner"}, nil)
+				workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", "new-owner").Return(&workspace.Member{WorkspaceID: "ws-1", AccountID: "new-owner"}, nil)
+				templates.EXPECT().Create(gomock.Any(), template.Template{Name: "ADR", OwnerID: "new-owner", WorkspaceID: "ws-1"}).Return(&template.Template{ID: "new-tpl", Version: 1}, nil)
+				templates.EXPECT().ReplaceFields(gomock.Any(), "new-tpl", 1, gomock.Any()).Return(nil)
+				templates.EXPECT().GetFields(gomock.Any(), "new-tpl", 1).Return([]template.Field{{ID: "new-a", Label: "A", Order: 1, IsRequired: true}}, nil)
+			}
+			if tt.wantError == nil {
+				templates.EXPECT().Update(gomock.Any(), template.Template{ID: "new-tpl", Name: "ADR", Version: 1}).Return(&template.Template{ID: "new-tpl", Version: 2}, nil)
+				templates.EXPECT().ReplaceFields(gomock.Any(), "new-tpl", 2, gomock.Any()).Return(nil)
+				templates.EXPECT().GetFields(gomock.Any(), "new-tpl", 2).Return([]template.Field{{ID: "new-b", Label: "B", Order: 1}, {ID: "new-c", Label: "C", Order: 2}}, nil)
+
+				gomock.InOrder(
+					notesRepo.EXPECT().Create(gomock.Any(), note.Note{Title: "first", TemplateID: "new-tpl", TemplateVersion: 1, OwnerID: "new-owner", Status: note.StatusPublish}).Return(&note.Note{ID: "new-n1"}, nil),
+					notesRepo.EXPECT().Create(gomock.Any(), note.Note{Title: "second", TemplateID: "new-tpl", TemplateVersion: 2, OwnerID: "new-owner", Status: note.StatusDraft}).Return(&note.Note{ID: "new-n2"}, nil),
+				)
+				notesRepo.EXPECT().ReplaceSections(gomock.Any(), "new-n1", []note.Section{{NoteID: "new-n1", FieldID: "new-a", Content: "x"}}).Return(nil)
+				notesRepo.EXPECT().ReplaceSections(gomock.Any(), "new-n2", []note.Section{{NoteID: "new-n2", FieldID: "new-b"}, {NoteID: "new-n2", FieldID: "new-c", Content: "y"}}).Return(nil)
+				notesRepo.EXPECT().ReplaceTags(gomock.Any(), "new-n1", []note.Tag{"auth"}).Return(nil)
+				notesRepo.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id string) (*note.WithMeta, error) {
+					return &note.WithMeta{Note: note.Note{ID: id, OwnerID: "new-owner"}}, nil
+				}).Times(2)
+				revisions.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rev note.Revision) (*note.Revision, error) {
+					if rev.ActorID != "new-owner" {
+						t.Fatalf("unexpected actor: %s", rev.ActorID)
+					}
+					return &rev, nil
+				}).Times(2)
+				out.EXPECT().PresentArchiveImported(gomock.Any(), &archive.ImportResult{
+					OwnerID:     "new-owner",
+					TemplateIDs: map[string]string{"old-tpl": "new-tpl"},
+					NoteIDs:     map[string]string{"old-n1": "new-n1", "old-n2": "new-n2"},
+				}).Return(nil)
+			}
+
+			interactor := uc.NewArchiveInteractor(accounts, workspaces, templates, notesRepo, revisions, tx, out)
+			err := interactor.Import(context.Background(), port.ArchiveImportInput{
+				Owner:   port.ArchiveAccountInput{ID: "new-owner", WorkspaceID: "ws-1"},
+				Archive: tt.archive,
+			})
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// verifyBatchSize is how many entries Verify reads per query while walking the chain.
+const verifyBatchSize = 1000
+
+// AuditInteractor handles audit log use cases for administrators.
+type AuditInteractor struct {
+	repo   port.AuditRepository
+	admins []string
+	output port.AuditOutputPort
+}
+
+var _ port.AuditInputPort = (*AuditInteractor)(nil)
+
+// NewAuditInteractor creates AuditInteractor. admins lists the account IDs allowed to read the log.
+func NewAuditInteractor(repo port.AuditRepository, admins []string, output port.AuditOutputPort) *AuditInteractor {
+	return &AuditInteractor{repo: repo, admins: admins, output: output}
+}
+
+// List returns a page of the audit log, newest first.
+func (u *AuditInteractor) List(ctx context.Context, filters audit.Filters, viewerID string) error {
+	if err := audit.CanRead(viewerID, u.admins); err != nil {
+		return err
+	}
+	if err := filters.Validate(); err != nil {
+		return err
+	}
+	entries, err := page.Fetch(filters.Limit, func(limit int) ([]audit.Entry, error) {
+		filters.Limit = limit
+		return u.repo.List(ctx, filters)
+	}, audit.Entry.Cursor)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentAuditLog(ctx, entries)
+}
+
+// Verify walks the chain from the first entry and stops at the first one that does not fit.
+func (u *AuditInteractor) Verify(ctx context.Context, viewerID string) error {
+	if err := audit.CanRead(viewerID, u.admins); err != nil {
+		return err
+	}
+	var (
+		result   audit.Verification
+		prevHash string
+		after    int64
+	)
+	for {
+		entries, err := u.repo.ListChain(ctx, after, verifyBatchSize)
+		if err != nil {
+			return err
+		}
+		var brokenAt int64
+		prevHash, brokenAt = audit.VerifyChain(prevHash, entries)
+		if brokenAt != 0 {
+			// Sequences can have gaps, so count the entries up to and including the broken one.
+			for _, e := range entries {
+				result.Checked++
+				if e.Sequence == brokenAt {
+					break
+				}
+			}
+			result.BrokenAt = brokenAt
+			break
+		}
+		result.Checked += len(entries)
+		if len(entries) < verifyBatchSize {
+			break
+		}
+		after = entries[len(entries)-1].Sequence
+	}
+	return u.output.PresentAuditVerification(ctx, result)
+}
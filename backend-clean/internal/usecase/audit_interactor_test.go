@@ -0,0 +1,123 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+// auditChain returns n sealed entries with sequences 1..n.
+func auditChain(n int) []audit.Entry {
+	entries := make([]audit.Entry, 0, n)
+	prev := ""
+	at := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= n; i++ {
+		e := audit.Entry{ActorID: "a1", Action: audit.ActionUpdate, AggregateType: audit.AggregateNote, AggregateID: "n1"}.Seal(prev, at)
+		e.Sequence = int64(i)
+		entries = append(entries, e)
+		prev = e.Hash
+	}
+	return entries
+}
+
+func TestAuditInteractor_List(t *testing.T) {
+	from := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	before := from.Add(-time.Hour)
+	tests := []struct {
+		name      string
+		viewerID  string
+		filters   audit.Filters
+		repoErr   error
+		wantError error
+	}{
+		{name: "[Success] admin lists a page", viewerID: "admin", filters: audit.Filters{Limit: 2}},
+		{name: "[Fail] not an admin", viewerID: "user", filters: audit.Filters{Limit: 2}, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] empty range", viewerID: "admin", filters: audit.Filters{From: &from, To: &before}, wantError: domainerr.ErrInvalidAuditRange},
+		{name: "[Fail] repo error", viewerID: "admin", filters: audit.Filters{Limit: 2}, repoErr: errors.New("db err"), wantError: errors.New("db err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockAuditRepository(ctrl)
+			out := mockusecase.NewMockAuditOutputPort(ctrl)
+
+			callRepo := tt.wantError == nil || tt.repoErr != nil
+			if callRepo {
+				// page.Fetch asks for one row past the page size of 2.
+				want := tt.filters
+				want.Limit = 3
+				entries := auditChain(3)
+				repo.EXPECT().List(gomock.Any(), want).Return([]audit.Entry{entries[2], entries[1], entries[0]}, tt.repoErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p page.Page[audit.Entry]) error {
+					if len(p.Items) != 2 || !p.HasMore() || p.NextCursor.ID != "2" {
+						t.Fatalf("unexpected page: %+v", p)
+					}
+					return nil
+				})
+			}
+
+			err := uc.NewAuditInteractor(repo, []string{"admin"}, out).List(context.Background(), tt.filters, tt.viewerID)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestAuditInteractor_Verify(t *testing.T) {
+	tampered := auditChain(3)
+	tampered[1].After = []byte(`{"title":"edited"}`)
+	long := auditChain(1002)
+
+	tests := []struct {
+		name      string
+		viewerID  string
+		batches   [][]audit.Entry
+		repoErr   error
+		want      audit.Verification
+		wantError error
+	}{
+		{name: "[Success] empty log", viewerID: "admin", batches: [][]audit.Entry{nil}, want: audit.Verification{}},
+		{name: "[Success] intact chain", viewerID: "admin", batches: [][]audit.Entry{auditChain(3)}, want: audit.Verification{Checked: 3}},
+		{name: "[Success] chain across batches", viewerID: "admin", batches: [][]audit.Entry{long[:1000], long[1000:]}, want: audit.Verification{Checked: 1002}},
+		{name: "[Success] tampered entry", viewerID: "admin", batches: [][]audit.Entry{tampered}, want: audit.Verification{Checked: 2, BrokenAt: 2}},
+		{name: "[Fail] not an admin", viewerID: "user", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] repo error", viewerID: "admin", batches: [][]audit.Entry{nil}, repoErr: errors.New("db err"), wantError: errors.New("db err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockAuditRepository(ctrl)
+			out := mockusecase.NewMockAuditOutputPort(ctrl)
+
+			var after int64
+			for _, batch := range tt.batches {
+				repo.EXPECT().ListChain(gomock.Any(), after, 1000).Return(batch, tt.repoErr)
+				if len(batch) > 0 {
+					after = batch[len(batch)-1].Sequence
+				}
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentAuditVerification(gomock.Any(), tt.want).Return(nil)
+			}
+
+			err := uc.NewAuditInteractor(repo, []string{"admin"}, out).Verify(context.Background(), tt.viewerID)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/comment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// CommentInteractor handles comment threads on notes.
+type CommentInteractor struct {
+	notes    port.NoteRepository
+	comments port.CommentRepository
+	output   port.CommentOutputPort
+}
+
+var _ port.CommentInputPort = (*CommentInteractor)(nil)
+
+// NewCommentInteractor creates CommentInteractor.
+func NewCommentInteractor(notes port.NoteRepository, comments port.CommentRepository, output port.CommentOutputPort) *CommentInteractor {
+	return &CommentInteractor{
+		notes:    notes,
+		comments: comments,
+		output:   output,
+	}
+}
+
+// List returns the note's comments grouped into threads.
+func (u *CommentInteractor) List(ctx context.Context, noteID, viewerID string) error {
+	if _, err := u.commentableNote(ctx, noteID, viewerID); err != nil {
+		return err
+	}
+	comments, err := u.comments.List(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentCommentThreads(ctx, comment.BuildThreads(comments))
+}
+
+// Create starts a thread, or replies to one when input.ParentID is set.
+func (u *CommentInteractor) Create(ctx context.Context, input port.CommentCreateInput) error {
+	n, err := u.commentableNote(ctx, input.NoteID, input.AuthorID)
+	if err != nil {
+		return err
+	}
+	var c comment.Comment
+	if input.ParentID == "" {
+		c, err = comment.NewThread(*n, input.AuthorID, input.SectionID, input.Body)
+	} else {
+		var parent *comment.WithMeta
+		parent, err = u.comments.Get(ctx, input.ParentID)
+		if err != nil {
+			return err
+		}
+		c, err = comment.NewReply(n.Note, parent.Comment, input.AuthorID, input.Body)
+	}
+	if err != nil {
+		return err
+	}
+	created, err := u.comments.Create(ctx, c)
+	if err != nil {
+		return err
+	}
+	return u.present(ctx, created.ID)
+}
+
+// Update edits the body of a comment. Only its author may edit it.
+func (u *CommentInteractor) Update(ctx context.Context, input port.CommentUpdateInput) error {
+	if _, err := u.commentableNote(ctx, input.NoteID, input.ActorID); err != nil {
+		return err
+	}
+	current, err := u.get(ctx, input.NoteID, input.CommentID)
+	if err != nil {
+		return err
+	}
+	c := current.Comment
+	if err := c.Edit(input.ActorID, input.Body); err != nil {
+		return err
+	}
+	if _, err := u.comments.Update(ctx, c); err != nil {
+		return err
+	}
+	return u.present(ctx, c.ID)
+}
+
+// Delete removes a comment. Only its author may delete it.
+func (u *CommentInteractor) Delete(ctx context.Context, noteID, commentID, actorID string) error {
+	if _, err := u.commentableNote(ctx, noteID, actorID); err != nil {
+		return err
+	}
+	current, err := u.get(ctx, noteID, commentID)
+	if err != nil {
+		return err
+	}
+	if err := comment.ValidateAuthorship(current.Comment.AuthorID, actorID); err != nil {
+		return err
+	}
+	if err := u.comments.Delete(ctx, commentID); err != nil {
+		return err
+	}
+	return u.output.PresentCommentDeleted(ctx)
+}
+
+// Resolve resolves or reopens a thread. The thread's author and the note's owner may do so.
+func (u *CommentInteractor) Resolve(ctx context.Context, input port.CommentResolveInput) error {
+	n, err := u.commentableNote(ctx, input.NoteID, input.ActorID)
+	if err != nil {
+		return err
+	}
+	current, err := u.get(ctx, input.NoteID, input.CommentID)
+	if err != nil {
+		return err
+	}
+	c := current.Comment
+	if err := c.Resolve(input.ActorID, n.Note.OwnerID, input.Resolved, time.Now()); err != nil {
+		return err
+	}
+	if _, err := u.comments.UpdateResolution(ctx, c); err != nil {
+		return err
+	}
+	return u.present(ctx, c.ID)
+}
+
+// commentableNote loads the note under the same visibility rule as reading it.
+func (u *CommentInteractor) commentableNote(ctx context.Context, noteID, actorID string) (*note.WithMeta, error) {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if err := comment.CanComment(n.Note, actorID); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// get loads a comment of the note; comments of other notes are reported as missing.
+func (u *CommentInteractor) get(ctx context.Context, noteID, commentID string) (*comment.WithMeta, error) {
+	c, err := u.comments.Get(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if c.Comment.NoteID != noteID {
+		return nil, domainerr.ErrNotFound
+	}
+	return c, nil
+}
+
+func (u *CommentInteractor) present(ctx context.Context, id string) error {
+	c, err := u.comments.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentComment(ctx, c)
+}
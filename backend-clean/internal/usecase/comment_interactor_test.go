@@ -0,0 +1,279 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/comment"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func commentNote(status note.NoteStatus) *note.WithMeta {
+	return &note.WithMeta{
+		Note:     note.Note{ID: "n1", OwnerID: "owner", Status: status},
+		Sections: []note.SectionWithField{{Section: note.Section{ID: "s1", NoteID: "n1"}}},
+	}
+}
+
+func TestCommentInteractor_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   *note.WithMeta
+		viewerID  string
+		repoErr   error
+		wantError error
+	}{
+		{name: "[Success] published note", current: commentNote(note.StatusPublish), viewerID: "reviewer"},
+		{name: "[Success] own draft", current: commentNote(note.StatusDraft), viewerID: "owner"},
+		{name: "[Fail] others' draft is not found", current: commentNote(note.StatusDraft), viewerID: "reviewer", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] repo error", current: commentNote(note.StatusPublish), viewerID: "reviewer", repoErr: errors.New("db err"), wantError: errors.New("db err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			comments := mockusecase.NewMockCommentRepository(ctrl)
+			out := mockusecase.NewMockCommentOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(tt.current, nil)
+			if note.CanView(tt.current.Note, tt.viewerID) {
+				comments.EXPECT().List(gomock.Any(), "n1").Return([]comment.WithMeta{
+					{Comment: comment.Comment{ID: "c1", NoteID: "n1"}},
+					{Comment: comment.Comment{ID: "r1", NoteID: "n1", ParentID: "c1"}},
+				}, tt.repoErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentCommentThreads(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, threads []comment.Thread) error {
+					if len(threads) != 1 || len(threads[0].Replies) != 1 {
+						t.Fatalf("unexpected threads: %+v", threads)
+					}
+					return nil
+				})
+			}
+
+			err := uc.NewCommentInteractor(notes, comments, out).List(context.Background(), "n1", tt.viewerID)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestCommentInteractor_Create(t *testing.T) {
+	root := &comment.WithMeta{Comment: comment.Comment{ID: "c1", NoteID: "n1", SectionID: "s1", AuthorID: "reviewer"}}
+	tests := []struct {
+		name      string
+		current   *note.WithMeta
+		input     port.CommentCreateInput
+		parent    *comment.WithMeta
+		parentErr error
+		want      comment.Comment
+		createErr error
+		wantError error
+	}{
+		{
+			name:    "[Success] start a thread on a section",
+			current: commentNote(note.StatusPublish),
+			input:   port.CommentCreateInput{NoteID: "n1", AuthorID: "reviewer", SectionID: "s1", Body: " why? "},
+			want:    comment.Comment{NoteID: "n1", SectionID: "s1", AuthorID: "reviewer", Body: "why?"},
+		},
+		{
+			name:    "[Success] reply",
+			current: commentNote(note.StatusPublish),
+			input:   port.CommentCreateInput{NoteID: "n1", AuthorID: "owner", ParentID: "c1", Body: "fixed"},
+			parent:  root,
+			want:    comment.Comment{NoteID: "n1", SectionID: "s1", ParentID: "c1", AuthorID: "owner", Body: "fixed"},
+		},
+		{
+			name:      "[Fail] others' draft is not found",
+			current:   commentNote(note.StatusDraft),
+			input:     port.CommentCreateInput{NoteID: "n1", AuthorID: "reviewer", Body: "hi"},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] empty body",
+			current:   commentNote(note.StatusPublish),
+			input:     port.CommentCreateInput{NoteID: "n1", AuthorID: "reviewer", Body: " "},
+			wantError: domainerr.ErrInvalidCommentBody,
+		},
+		{
+			name:      "[Fail] unknown parent",
+			current:   commentNote(note.StatusPublish),
+			input:     port.CommentCreateInput{NoteID: "n1", AuthorID: "reviewer", ParentID: "c9", Body: "hi"},
+			parentErr: domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] parent on another note",
+			current:   commentNote(note.StatusPublish),
+			input:     port.CommentCreateInput{NoteID: "n1", AuthorID: "reviewer", ParentID: "c2", Body: "hi"},
+			parent:    &comment.WithMeta{Comment: comment.Comment{ID: "c2", NoteID: "n2"}},
+			wantError: domainerr.ErrInvalidCommentParent,
+		},
+		{
+			name:      "[Fail] repo error",
+			current:   commentNote(note.StatusPublish),
+			input:     port.CommentCreateInput{NoteID: "n1", AuthorID: "reviewer", Body: "hi"},
+			want:      comment.Comment{NoteID: "n1", AuthorID: "reviewer", Body: "hi"},
+			createErr: errors.New("db err"),
+			wantError: errors.New("db err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			comments := mockusecase.NewMockCommentRepository(ctrl)
+			out := mockusecase.NewMockCommentOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(tt.current, nil)
+			if tt.parent != nil || tt.parentErr != nil {
+				comments.EXPECT().Get(gomock.Any(), tt.input.ParentID).Return(tt.parent, tt.parentErr)
+			}
+			if tt.want.AuthorID != "" {
+				created := tt.want
+				created.ID = "new"
+				comments.EXPECT().Create(gomock.Any(), tt.want).Return(&created, tt.createErr)
+			}
+			if tt.wantError == nil {
+				comments.EXPECT().Get(gomock.Any(), "new").Return(&comment.WithMeta{Comment: tt.want}, nil)
+				out.EXPECT().PresentComment(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewCommentInteractor(notes, comments, out).Create(context.Background(), tt.input)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestCommentInteractor_Update(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     port.CommentUpdateInput
+		current   *comment.WithMeta
+		wantError error
+	}{
+		{name: "[Success] author edits", input: port.CommentUpdateInput{NoteID: "n1", CommentID: "c1", ActorID: "reviewer", Body: "edited"}, current: &comment.WithMeta{Comment: comment.Comment{ID: "c1", NoteID: "n1", AuthorID: "reviewer", Body: "original"}}},
+		{name: "[Fail] not the author", input: port.CommentUpdateInput{NoteID: "n1", CommentID: "c1", ActorID: "owner", Body: "edited"}, current: &comment.WithMeta{Comment: comment.Comment{ID: "c1", NoteID: "n1", AuthorID: "reviewer"}}, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] comment on another note", input: port.CommentUpdateInput{NoteID: "n1", CommentID: "c1", ActorID: "reviewer", Body: "edited"}, current: &comment.WithMeta{Comment: comment.Comment{ID: "c1", NoteID: "n2", AuthorID: "reviewer"}}, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			comments := mockusecase.NewMockCommentRepository(ctrl)
+			out := mockusecase.NewMockCommentOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(commentNote(note.StatusPublish), nil)
+			comments.EXPECT().Get(gomock.Any(), "c1").Return(tt.current, nil)
+			if tt.wantError == nil {
+				edited := tt.current.Comment
+				edited.Body = tt.input.Body
+				comments.EXPECT().Update(gomock.Any(), edited).Return(&edited, nil)
+				comments.EXPECT().Get(gomock.Any(), "c1").Return(&comment.WithMeta{Comment: edited}, nil)
+				out.EXPECT().PresentComment(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewCommentInteractor(notes, comments, out).Update(context.Background(), tt.input)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestCommentInteractor_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorID   string
+		deleteErr error
+		wantError error
+	}{
+		{name: "[Success] author deletes", actorID: "reviewer"},
+		{name: "[Fail] note owner is not the author", actorID: "owner", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] repo error", actorID: "reviewer", deleteErr: errors.New("db err"), wantError: errors.New("db err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			comments := mockusecase.NewMockCommentRepository(ctrl)
+			out := mockusecase.NewMockCommentOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(commentNote(note.StatusPublish), nil)
+			comments.EXPECT().Get(gomock.Any(), "c1").Return(&comment.WithMeta{Comment: comment.Comment{ID: "c1", NoteID: "n1", AuthorID: "reviewer"}}, nil)
+			if tt.actorID == "reviewer" {
+				comments.EXPECT().Delete(gomock.Any(), "c1").Return(tt.deleteErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentCommentDeleted(gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewCommentInteractor(notes, comments, out).Delete(context.Background(), "n1", "c1", tt.actorID)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestCommentInteractor_Resolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorID   string
+		resolved  bool
+		current   comment.Comment
+		wantBy    string
+		wantError error
+	}{
+		{name: "[Success] note owner resolves", actorID: "owner", resolved: true, current: comment.Comment{ID: "c1", NoteID: "n1", AuthorID: "reviewer"}, wantBy: "owner"},
+		{name: "[Success] author reopens", actorID: "reviewer", current: comment.Comment{ID: "c1", NoteID: "n1", AuthorID: "reviewer", ResolvedBy: "owner"}},
+		{name: "[Fail] another reviewer", actorID: "other", resolved: true, current: comment.Comment{ID: "c1", NoteID: "n1", AuthorID: "reviewer"}, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] reply", actorID: "owner", resolved: true, current: comment.Comment{ID: "c1", NoteID: "n1", ParentID: "c0", AuthorID: "reviewer"}, wantError: domainerr.ErrCommentNotThread},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			comments := mockusecase.NewMockCommentRepository(ctrl)
+			out := mockusecase.NewMockCommentOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(commentNote(note.StatusPublish), nil)
+			comments.EXPECT().Get(gomock.Any(), "c1").Return(&comment.WithMeta{Comment: tt.current}, nil)
+			if tt.wantError == nil {
+				comments.EXPECT().UpdateResolution(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c comment.Comment) (*comment.Comment, error) {
+					if c.ResolvedBy != tt.wantBy || c.IsResolved() != tt.resolved {
+						t.Fatalf("unexpected resolution: %+v", c)
+					}
+					return &c, nil
+				})
+				comments.EXPECT().Get(gomock.Any(), "c1").Return(&comment.WithMeta{Comment: tt.current}, nil)
+				out.EXPECT().PresentComment(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewCommentInteractor(notes, comments, out).Resolve(context.Background(), port.CommentResolveInput{
+				NoteID:    "n1",
+				CommentID: "c1",
+				ActorID:   tt.actorID,
+				Resolved:  tt.resolved,
+			})
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
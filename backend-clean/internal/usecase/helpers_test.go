@@ -1,5 +1,14 @@
 package usecase_test
 
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
 // b2i converts bool to int for gomock Times().
 func b2i(b bool) int {
 	if b {
@@ -10,3 +19,26 @@ func b2i(b bool) int {
 
 // strPtr helper for optional string pointers.
 func strPtr(s string) *string { return &s }
+
+// intPtr helper for optional int pointers.
+func intPtr(i int) *int { return &i }
+
+// runInTx expects one transaction and runs its body.
+func runInTx(tx *mockusecase.MockTxManager) {
+	tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+}
+
+// assertErr compares errors by message, so sentinels and ad-hoc repo errors both match.
+func assertErr(t *testing.T, want, got error) {
+	t.Helper()
+	if want == nil && got != nil {
+		t.Fatalf("unexpected error: %v", got)
+	}
+	if want != nil && (got == nil || want.Error() != got.Error()) {
+		t.Fatalf("want %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,57 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/archive"
+)
+
+// MockArchiveOutputPort is a mock of port.ArchiveOutputPort.
+type MockArchiveOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveOutputPortMockRecorder
+}
+
+// MockArchiveOutputPortMockRecorder records invocations.
+type MockArchiveOutputPortMockRecorder struct {
+	mock *MockArchiveOutputPort
+}
+
+// NewMockArchiveOutputPort creates a new mock.
+func NewMockArchiveOutputPort(ctrl *gomock.Controller) *MockArchiveOutputPort {
+	mock := &MockArchiveOutputPort{ctrl: ctrl}
+	mock.recorder = &MockArchiveOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockArchiveOutputPort) EXPECT() *MockArchiveOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockArchiveOutputPort) PresentArchive(ctx context.Context, a *archive.Archive) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentArchive", ctx, a)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockArchiveOutputPortMockRecorder) PresentArchive(ctx, a any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentArchive", reflect.TypeOf((*MockArchiveOutputPort)(nil).PresentArchive), ctx, a)
+}
+
+func (m *MockArchiveOutputPort) PresentArchiveImported(ctx context.Context, result *archive.ImportResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentArchiveImported", ctx, result)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockArchiveOutputPortMockRecorder) PresentArchiveImported(ctx, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentArchiveImported", reflect.TypeOf((*MockArchiveOutputPort)(nil).PresentArchiveImported), ctx, result)
+}
@@ -0,0 +1,164 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	"immortal-architecture-clean/backend/internal/domain/page"
+)
+
+// MockAuditRepository is a mock of port.AuditRepository.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+}
+
+// MockAuditRepositoryMockRecorder records invocations.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockAuditRepository) Record(ctx context.Context, entries ...audit.Entry) error {
+	m.ctrl.T.Helper()
+	args := []any{ctx}
+	for _, e := range entries {
+		args = append(args, e)
+	}
+	ret := m.ctrl.Call(m, "Record", args...)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAuditRepositoryMockRecorder) Record(ctx any, entries ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	args := append([]any{ctx}, entries...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditRepository)(nil).Record), args...)
+}
+
+func (m *MockAuditRepository) List(ctx context.Context, filters audit.Filters) ([]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filters)
+	res0, _ := ret[0].([]audit.Entry)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockAuditRepositoryMockRecorder) List(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditRepository)(nil).List), ctx, filters)
+}
+
+func (m *MockAuditRepository) ListChain(ctx context.Context, afterSequence int64, limit int) ([]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChain", ctx, afterSequence, limit)
+	res0, _ := ret[0].([]audit.Entry)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockAuditRepositoryMockRecorder) ListChain(ctx, afterSequence, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChain", reflect.TypeOf((*MockAuditRepository)(nil).ListChain), ctx, afterSequence, limit)
+}
+
+// MockAuditOutputPort is a mock of port.AuditOutputPort.
+type MockAuditOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditOutputPortMockRecorder
+}
+
+// MockAuditOutputPortMockRecorder records invocations.
+type MockAuditOutputPortMockRecorder struct {
+	mock *MockAuditOutputPort
+}
+
+// NewMockAuditOutputPort creates a new mock.
+func NewMockAuditOutputPort(ctrl *gomock.Controller) *MockAuditOutputPort {
+	mock := &MockAuditOutputPort{ctrl: ctrl}
+	mock.recorder = &MockAuditOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockAuditOutputPort) EXPECT() *MockAuditOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockAuditOutputPort) PresentAuditLog(ctx context.Context, entries page.Page[audit.Entry]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentAuditLog", ctx, entries)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAuditOutputPortMockRecorder) PresentAuditLog(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentAuditLog", reflect.TypeOf((*MockAuditOutputPort)(nil).PresentAuditLog), ctx, entries)
+}
+
+func (m *MockAuditOutputPort) PresentAuditVerification(ctx context.Context, v audit.Verification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentAuditVerification", ctx, v)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAuditOutputPortMockRecorder) PresentAuditVerification(ctx, v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentAuditVerification", reflect.TypeOf((*MockAuditOutputPort)(nil).PresentAuditVerification), ctx, v)
+}
+
+// MockAuditRecorder is a mock of port.AuditRecorder.
+type MockAuditRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRecorderMockRecorder
+}
+
+// MockAuditRecorderMockRecorder records invocations.
+type MockAuditRecorderMockRecorder struct {
+	mock *MockAuditRecorder
+}
+
+// NewMockAuditRecorder creates a new mock.
+func NewMockAuditRecorder(ctrl *gomock.Controller) *MockAuditRecorder {
+	mock := &MockAuditRecorder{ctrl: ctrl}
+	mock.recorder = &MockAuditRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockAuditRecorder) EXPECT() *MockAuditRecorderMockRecorder {
+	return m.recorder
+}
+
+func (m *MockAuditRecorder) Record(ctx context.Context, entries ...audit.Entry) error {
+	m.ctrl.T.Helper()
+	args := []any{ctx}
+	for _, e := range entries {
+		args = append(args, e)
+	}
+	ret := m.ctrl.Call(m, "Record", args...)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockAuditRecorderMockRecorder) Record(ctx any, entries ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	args := append([]any{ctx}, entries...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditRecorder)(nil).Record), args...)
+}
@@ -0,0 +1,169 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/comment"
+)
+
+// MockCommentRepository is a mock of port.CommentRepository.
+type MockCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRepositoryMockRecorder
+}
+
+// MockCommentRepositoryMockRecorder records invocations.
+type MockCommentRepositoryMockRecorder struct {
+	mock *MockCommentRepository
+}
+
+// NewMockCommentRepository creates a new mock.
+func NewMockCommentRepository(ctrl *gomock.Controller) *MockCommentRepository {
+	mock := &MockCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockCommentRepository) List(ctx context.Context, noteID string) ([]comment.WithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, noteID)
+	res0, _ := ret[0].([]comment.WithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockCommentRepositoryMockRecorder) List(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCommentRepository)(nil).List), ctx, noteID)
+}
+
+func (m *MockCommentRepository) Get(ctx context.Context, id string) (*comment.WithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(*comment.WithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockCommentRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCommentRepository)(nil).Get), ctx, id)
+}
+
+func (m *MockCommentRepository) Create(ctx context.Context, c comment.Comment) (*comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, c)
+	res0, _ := ret[0].(*comment.Comment)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockCommentRepositoryMockRecorder) Create(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentRepository)(nil).Create), ctx, c)
+}
+
+func (m *MockCommentRepository) Update(ctx context.Context, c comment.Comment) (*comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, c)
+	res0, _ := ret[0].(*comment.Comment)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockCommentRepositoryMockRecorder) Update(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentRepository)(nil).Update), ctx, c)
+}
+
+func (m *MockCommentRepository) UpdateResolution(ctx context.Context, c comment.Comment) (*comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResolution", ctx, c)
+	res0, _ := ret[0].(*comment.Comment)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockCommentRepositoryMockRecorder) UpdateResolution(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResolution", reflect.TypeOf((*MockCommentRepository)(nil).UpdateResolution), ctx, c)
+}
+
+func (m *MockCommentRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCommentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommentRepository)(nil).Delete), ctx, id)
+}
+
+// MockCommentOutputPort is a mock of port.CommentOutputPort.
+type MockCommentOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentOutputPortMockRecorder
+}
+
+// MockCommentOutputPortMockRecorder records invocations.
+type MockCommentOutputPortMockRecorder struct {
+	mock *MockCommentOutputPort
+}
+
+// NewMockCommentOutputPort creates a new mock.
+func NewMockCommentOutputPort(ctrl *gomock.Controller) *MockCommentOutputPort {
+	mock := &MockCommentOutputPort{ctrl: ctrl}
+	mock.recorder = &MockCommentOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockCommentOutputPort) EXPECT() *MockCommentOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockCommentOutputPort) PresentCommentThreads(ctx context.Context, threads []comment.Thread) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentCommentThreads", ctx, threads)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCommentOutputPortMockRecorder) PresentCommentThreads(ctx, threads any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentCommentThreads", reflect.TypeOf((*MockCommentOutputPort)(nil).PresentCommentThreads), ctx, threads)
+}
+
+func (m *MockCommentOutputPort) PresentComment(ctx context.Context, c *comment.WithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentComment", ctx, c)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCommentOutputPortMockRecorder) PresentComment(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentComment", reflect.TypeOf((*MockCommentOutputPort)(nil).PresentComment), ctx, c)
+}
+
+func (m *MockCommentOutputPort) PresentCommentDeleted(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentCommentDeleted", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockCommentOutputPortMockRecorder) PresentCommentDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentCommentDeleted", reflect.TypeOf((*MockCommentOutputPort)(nil).PresentCommentDeleted), ctx)
+}
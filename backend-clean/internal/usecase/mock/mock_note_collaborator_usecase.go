@@ -0,0 +1,130 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// MockNoteCollaboratorRepository is a mock of port.NoteCollaboratorRepository.
+type MockNoteCollaboratorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteCollaboratorRepositoryMockRecorder
+}
+
+// MockNoteCollaboratorRepositoryMockRecorder records invocations.
+type MockNoteCollaboratorRepositoryMockRecorder struct {
+	mock *MockNoteCollaboratorRepository
+}
+
+// NewMockNoteCollaboratorRepository creates a new mock.
+func NewMockNoteCollaboratorRepository(ctrl *gomock.Controller) *MockNoteCollaboratorRepository {
+	mock := &MockNoteCollaboratorRepository{ctrl: ctrl}
+	mock.recorder = &MockNoteCollaboratorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteCollaboratorRepository) EXPECT() *MockNoteCollaboratorRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteCollaboratorRepository) List(ctx context.Context, noteID string) ([]note.CollaboratorWithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, noteID)
+	res0, _ := ret[0].([]note.CollaboratorWithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteCollaboratorRepositoryMockRecorder) List(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNoteCollaboratorRepository)(nil).List), ctx, noteID)
+}
+
+func (m *MockNoteCollaboratorRepository) Upsert(ctx context.Context, c note.Collaborator) (*note.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, c)
+	res0, _ := ret[0].(*note.Collaborator)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteCollaboratorRepositoryMockRecorder) Upsert(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockNoteCollaboratorRepository)(nil).Upsert), ctx, c)
+}
+
+func (m *MockNoteCollaboratorRepository) Delete(ctx context.Context, noteID string, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, noteID, accountID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteCollaboratorRepositoryMockRecorder) Delete(ctx, noteID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNoteCollaboratorRepository)(nil).Delete), ctx, noteID, accountID)
+}
+
+// MockNoteCollaboratorOutputPort is a mock of port.NoteCollaboratorOutputPort.
+type MockNoteCollaboratorOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteCollaboratorOutputPortMockRecorder
+}
+
+// MockNoteCollaboratorOutputPortMockRecorder records invocations.
+type MockNoteCollaboratorOutputPortMockRecorder struct {
+	mock *MockNoteCollaboratorOutputPort
+}
+
+// NewMockNoteCollaboratorOutputPort creates a new mock.
+func NewMockNoteCollaboratorOutputPort(ctrl *gomock.Controller) *MockNoteCollaboratorOutputPort {
+	mock := &MockNoteCollaboratorOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteCollaboratorOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteCollaboratorOutputPort) EXPECT() *MockNoteCollaboratorOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteCollaboratorOutputPort) PresentNoteCollaborators(ctx context.Context, collaborators []note.CollaboratorWithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteCollaborators", ctx, collaborators)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteCollaboratorOutputPortMockRecorder) PresentNoteCollaborators(ctx, collaborators any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteCollaborators", reflect.TypeOf((*MockNoteCollaboratorOutputPort)(nil).PresentNoteCollaborators), ctx, collaborators)
+}
+
+func (m *MockNoteCollaboratorOutputPort) PresentNoteCollaborator(ctx context.Context, c *note.CollaboratorWithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteCollaborator", ctx, c)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteCollaboratorOutputPortMockRecorder) PresentNoteCollaborator(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteCollaborator", reflect.TypeOf((*MockNoteCollaboratorOutputPort)(nil).PresentNoteCollaborator), ctx, c)
+}
+
+func (m *MockNoteCollaboratorOutputPort) PresentNoteCollaboratorDeleted(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteCollaboratorDeleted", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteCollaboratorOutputPortMockRecorder) PresentNoteCollaboratorDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteCollaboratorDeleted", reflect.TypeOf((*MockNoteCollaboratorOutputPort)(nil).PresentNoteCollaboratorDeleted), ctx)
+}
@@ -0,0 +1,155 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// MockNoteLinkRepository is a mock of port.NoteLinkRepository.
+type MockNoteLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteLinkRepositoryMockRecorder
+}
+
+// MockNoteLinkRepositoryMockRecorder records invocations.
+type MockNoteLinkRepositoryMockRecorder struct {
+	mock *MockNoteLinkRepository
+}
+
+// NewMockNoteLinkRepository creates a new mock.
+func NewMockNoteLinkRepository(ctrl *gomock.Controller) *MockNoteLinkRepository {
+	mock := &MockNoteLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockNoteLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteLinkRepository) EXPECT() *MockNoteLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteLinkRepository) Create(ctx context.Context, link note.Link) (*note.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, link)
+	res0, _ := ret[0].(*note.Link)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteLinkRepositoryMockRecorder) Create(ctx, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNoteLinkRepository)(nil).Create), ctx, link)
+}
+
+func (m *MockNoteLinkRepository) Delete(ctx context.Context, sourceID string, linkID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, sourceID, linkID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteLinkRepositoryMockRecorder) Delete(ctx, sourceID, linkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNoteLinkRepository)(nil).Delete), ctx, sourceID, linkID)
+}
+
+func (m *MockNoteLinkRepository) DeleteByNote(ctx context.Context, noteID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByNote", ctx, noteID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteLinkRepositoryMockRecorder) DeleteByNote(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByNote", reflect.TypeOf((*MockNoteLinkRepository)(nil).DeleteByNote), ctx, noteID)
+}
+
+func (m *MockNoteLinkRepository) ListOutgoing(ctx context.Context, noteID string) ([]note.LinkSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutgoing", ctx, noteID)
+	res0, _ := ret[0].([]note.LinkSummary)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteLinkRepositoryMockRecorder) ListOutgoing(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutgoing", reflect.TypeOf((*MockNoteLinkRepository)(nil).ListOutgoing), ctx, noteID)
+}
+
+func (m *MockNoteLinkRepository) ListBacklinks(ctx context.Context, noteID string) ([]note.LinkSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBacklinks", ctx, noteID)
+	res0, _ := ret[0].([]note.LinkSummary)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteLinkRepositoryMockRecorder) ListBacklinks(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklinks", reflect.TypeOf((*MockNoteLinkRepository)(nil).ListBacklinks), ctx, noteID)
+}
+
+// MockNoteLinkOutputPort is a mock of port.NoteLinkOutputPort.
+type MockNoteLinkOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteLinkOutputPortMockRecorder
+}
+
+// MockNoteLinkOutputPortMockRecorder records invocations.
+type MockNoteLinkOutputPortMockRecorder struct {
+	mock *MockNoteLinkOutputPort
+}
+
+// NewMockNoteLinkOutputPort creates a new mock.
+func NewMockNoteLinkOutputPort(ctrl *gomock.Controller) *MockNoteLinkOutputPort {
+	mock := &MockNoteLinkOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteLinkOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteLinkOutputPort) EXPECT() *MockNoteLinkOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteLinkOutputPort) PresentNoteLinks(ctx context.Context, outgoing []note.LinkSummary, backlinks []note.LinkSummary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteLinks", ctx, outgoing, backlinks)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteLinkOutputPortMockRecorder) PresentNoteLinks(ctx, outgoing, backlinks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteLinks", reflect.TypeOf((*MockNoteLinkOutputPort)(nil).PresentNoteLinks), ctx, outgoing, backlinks)
+}
+
+func (m *MockNoteLinkOutputPort) PresentNoteLink(ctx context.Context, link *note.LinkSummary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteLink", ctx, link)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteLinkOutputPortMockRecorder) PresentNoteLink(ctx, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteLink", reflect.TypeOf((*MockNoteLinkOutputPort)(nil).PresentNoteLink), ctx, link)
+}
+
+func (m *MockNoteLinkOutputPort) PresentNoteLinkDeleted(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteLinkDeleted", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteLinkOutputPortMockRecorder) PresentNoteLinkDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteLinkDeleted", reflect.TypeOf((*MockNoteLinkOutputPort)(nil).PresentNoteLinkDeleted), ctx)
+}
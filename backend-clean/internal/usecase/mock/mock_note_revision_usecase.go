@@ -0,0 +1,131 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// MockNoteRevisionRepository is a mock of port.NoteRevisionRepository.
+type MockNoteRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteRevisionRepositoryMockRecorder
+}
+
+// MockNoteRevisionRepositoryMockRecorder records invocations.
+type MockNoteRevisionRepositoryMockRecorder struct {
+	mock *MockNoteRevisionRepository
+}
+
+// NewMockNoteRevisionRepository creates a new mock.
+func NewMockNoteRevisionRepository(ctrl *gomock.Controller) *MockNoteRevisionRepository {
+	mock := &MockNoteRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockNoteRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteRevisionRepository) EXPECT() *MockNoteRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteRevisionRepository) Create(ctx context.Context, revision note.Revision) (*note.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, revision)
+	res0, _ := ret[0].(*note.Revision)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRevisionRepositoryMockRecorder) Create(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNoteRevisionRepository)(nil).Create), ctx, revision)
+}
+
+func (m *MockNoteRevisionRepository) List(ctx context.Context, noteID string) ([]note.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, noteID)
+	res0, _ := ret[0].([]note.Revision)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRevisionRepositoryMockRecorder) List(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNoteRevisionRepository)(nil).List), ctx, noteID)
+}
+
+func (m *MockNoteRevisionRepository) Get(ctx context.Context, noteID string, number int) (*note.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, noteID, number)
+	res0, _ := ret[0].(*note.Revision)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRevisionRepositoryMockRecorder) Get(ctx, noteID, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNoteRevisionRepository)(nil).Get), ctx, noteID, number)
+}
+
+// MockNoteRevisionOutputPort is a mock of port.NoteRevisionOutputPort.
+type MockNoteRevisionOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteRevisionOutputPortMockRecorder
+}
+
+// MockNoteRevisionOutputPortMockRecorder records invocations.
+type MockNoteRevisionOutputPortMockRecorder struct {
+	mock *MockNoteRevisionOutputPort
+}
+
+// NewMockNoteRevisionOutputPort creates a new mock.
+func NewMockNoteRevisionOutputPort(ctrl *gomock.Controller) *MockNoteRevisionOutputPort {
+	mock := &MockNoteRevisionOutputPort{ctrl: ctrl}
+	mock.recorder = &MockNoteRevisionOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockNoteRevisionOutputPort) EXPECT() *MockNoteRevisionOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNoteRevisionOutputPort) PresentRevisionList(ctx context.Context, revisions []note.Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentRevisionList", ctx, revisions)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteRevisionOutputPortMockRecorder) PresentRevisionList(ctx, revisions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentRevisionList", reflect.TypeOf((*MockNoteRevisionOutputPort)(nil).PresentRevisionList), ctx, revisions)
+}
+
+func (m *MockNoteRevisionOutputPort) PresentRevision(ctx context.Context, revision *note.Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentRevision", ctx, revision)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteRevisionOutputPortMockRecorder) PresentRevision(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentRevision", reflect.TypeOf((*MockNoteRevisionOutputPort)(nil).PresentRevision), ctx, revision)
+}
+
+func (m *MockNoteRevisionOutputPort) PresentRevisionDiff(ctx context.Context, diff *note.RevisionDiff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentRevisionDiff", ctx, diff)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteRevisionOutputPortMockRecorder) PresentRevisionDiff(ctx, diff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentRevisionDiff", reflect.TypeOf((*MockNoteRevisionOutputPort)(nil).PresentRevisionDiff), ctx, diff)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 )
 
 // MockNoteRepository is a mock of port.NoteRepository.
@@ -45,6 +47,18 @@ func (mr *MockNoteRepositoryMockRecorder) List(ctx, filters any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNoteRepository)(nil).List), ctx, filters)
 }
 
+func (m *MockNoteRepository) Search(ctx context.Context, filters note.Filters) ([]note.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters)
+	res0, _ := ret[0].([]note.SearchResult)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+func (mr *MockNoteRepositoryMockRecorder) Search(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockNoteRepository)(nil).Search), ctx, filters)
+}
+
 func (m *MockNoteRepository) Get(ctx context.Context, id string) (*note.WithMeta, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", ctx, id)
@@ -97,6 +111,69 @@ func (mr *MockNoteRepositoryMockRecorder) UpdateStatus(ctx, id, status any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockNoteRepository)(nil).UpdateStatus), ctx, id, status)
 }
 
+func (m *MockNoteRepository) UpdateTemplateVersion(ctx context.Context, n note.Note, sections []note.Section) (*note.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplateVersion", ctx, n, sections)
+	res0, _ := ret[0].(*note.Note)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) UpdateTemplateVersion(ctx, n, sections any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateVersion", reflect.TypeOf((*MockNoteRepository)(nil).UpdateTemplateVersion), ctx, n, sections)
+}
+
+func (m *MockNoteRepository) ListTrash(ctx context.Context, filters trash.Filters) ([]note.WithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, filters)
+	res0, _ := ret[0].([]note.WithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) ListTrash(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockNoteRepository)(nil).ListTrash), ctx, filters)
+}
+
+func (m *MockNoteRepository) GetTrashed(ctx context.Context, id string) (*note.WithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashed", ctx, id)
+	res0, _ := ret[0].(*note.WithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockNoteRepositoryMockRecorder) GetTrashed(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashed", reflect.TypeOf((*MockNoteRepository)(nil).GetTrashed), ctx, id)
+}
+
+func (m *MockNoteRepository) Trash(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteRepositoryMockRecorder) Trash(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockNoteRepository)(nil).Trash), ctx, id)
+}
+
+func (m *MockNoteRepository) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockNoteRepository)(nil).Restore), ctx, id)
+}
+
 func (m *MockNoteRepository) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", ctx, id)
@@ -121,6 +198,18 @@ func (mr *MockNoteRepositoryMockRecorder) ReplaceSections(ctx, noteID, sections
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSections", reflect.TypeOf((*MockNoteRepository)(nil).ReplaceSections), ctx, noteID, sections)
 }
 
+func (m *MockNoteRepository) ReplaceTags(ctx context.Context, noteID string, tags []note.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTags", ctx, noteID, tags)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteRepositoryMockRecorder) ReplaceTags(ctx, noteID, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTags", reflect.TypeOf((*MockNoteRepository)(nil).ReplaceTags), ctx, noteID, tags)
+}
+
 // MockNoteOutputPort is a mock of port.NoteOutputPort.
 type MockNoteOutputPort struct {
 	ctrl     *gomock.Controller
@@ -144,7 +233,7 @@ func (m *MockNoteOutputPort) EXPECT() *MockNoteOutputPortMockRecorder {
 	return m.recorder
 }
 
-func (m *MockNoteOutputPort) PresentNoteList(ctx context.Context, notes []note.WithMeta) error {
+func (m *MockNoteOutputPort) PresentNoteList(ctx context.Context, notes page.Page[note.WithMeta]) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PresentNoteList", ctx, notes)
 	res0, _ := ret[0].(error)
@@ -156,6 +245,17 @@ func (mr *MockNoteOutputPortMockRecorder) PresentNoteList(ctx, notes any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteList", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentNoteList), ctx, notes)
 }
 
+func (m *MockNoteOutputPort) PresentSearchResults(ctx context.Context, results []note.SearchResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentSearchResults", ctx, results)
+	res0, _ := ret[0].(error)
+	return res0
+}
+func (mr *MockNoteOutputPortMockRecorder) PresentSearchResults(ctx, results any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentSearchResults", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentSearchResults), ctx, results)
+}
+
 func (m *MockNoteOutputPort) PresentNote(ctx context.Context, n *note.WithMeta) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PresentNote", ctx, n)
@@ -168,6 +268,18 @@ func (mr *MockNoteOutputPortMockRecorder) PresentNote(ctx, n any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNote", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentNote), ctx, n)
 }
 
+func (m *MockNoteOutputPort) PresentNoteMarkdown(ctx context.Context, n *note.WithMeta, markdown string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentNoteMarkdown", ctx, n, markdown)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockNoteOutputPortMockRecorder) PresentNoteMarkdown(ctx, n, markdown any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentNoteMarkdown", reflect.TypeOf((*MockNoteOutputPort)(nil).PresentNoteMarkdown), ctx, n, markdown)
+}
+
 func (m *MockNoteOutputPort) PresentNoteDeleted(ctx context.Context) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PresentNoteDeleted", ctx)
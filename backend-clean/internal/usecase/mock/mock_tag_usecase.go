@@ -0,0 +1,81 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+)
+
+// MockTagRepository is a mock of port.TagRepository.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+}
+
+// MockTagRepositoryMockRecorder records invocations.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockTagRepository) ListCounts(ctx context.Context, filters note.TagFilters) ([]note.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCounts", ctx, filters)
+	res0, _ := ret[0].([]note.TagCount)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTagRepositoryMockRecorder) ListCounts(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCounts", reflect.TypeOf((*MockTagRepository)(nil).ListCounts), ctx, filters)
+}
+
+// MockTagOutputPort is a mock of port.TagOutputPort.
+type MockTagOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagOutputPortMockRecorder
+}
+
+// MockTagOutputPortMockRecorder records invocations.
+type MockTagOutputPortMockRecorder struct {
+	mock *MockTagOutputPort
+}
+
+// NewMockTagOutputPort creates a new mock.
+func NewMockTagOutputPort(ctrl *gomock.Controller) *MockTagOutputPort {
+	mock := &MockTagOutputPort{ctrl: ctrl}
+	mock.recorder = &MockTagOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockTagOutputPort) EXPECT() *MockTagOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockTagOutputPort) PresentTagCounts(ctx context.Context, tags []note.TagCount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentTagCounts", ctx, tags)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockTagOutputPortMockRecorder) PresentTagCounts(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentTagCounts", reflect.TypeOf((*MockTagOutputPort)(nil).PresentTagCounts), ctx, tags)
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/golang/mock/gomock"
 
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 )
 
 // MockTemplateRepository is a mock of port.TemplateRepository.
@@ -85,6 +87,56 @@ func (mr *MockTemplateRepositoryMockRecorder) Update(ctx, tpl any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTemplateRepository)(nil).Update), ctx, tpl)
 }
 
+func (m *MockTemplateRepository) ListTrash(ctx context.Context, filters trash.Filters) ([]template.WithUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, filters)
+	res0, _ := ret[0].([]template.WithUsage)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) ListTrash(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockTemplateRepository)(nil).ListTrash), ctx, filters)
+}
+
+func (m *MockTemplateRepository) GetTrashed(ctx context.Context, id string) (*template.WithUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashed", ctx, id)
+	res0, _ := ret[0].(*template.WithUsage)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) GetTrashed(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashed", reflect.TypeOf((*MockTemplateRepository)(nil).GetTrashed), ctx, id)
+}
+
+func (m *MockTemplateRepository) Trash(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) Trash(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockTemplateRepository)(nil).Trash), ctx, id)
+}
+
+func (m *MockTemplateRepository) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTemplateRepository)(nil).Restore), ctx, id)
+}
+
 func (m *MockTemplateRepository) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delete", ctx, id)
@@ -97,16 +149,41 @@ func (mr *MockTemplateRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplateRepository)(nil).Delete), ctx, id)
 }
 
-func (m *MockTemplateRepository) ReplaceFields(ctx context.Context, templateID string, fields []template.Field) error {
+func (m *MockTemplateRepository) ReplaceFields(ctx context.Context, templateID string, version int, fields []template.Field) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceFields", ctx, templateID, version, fields)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) ReplaceFields(ctx, templateID, version, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFields", reflect.TypeOf((*MockTemplateRepository)(nil).ReplaceFields), ctx, templateID, version, fields)
+}
+
+func (m *MockTemplateRepository) GetFields(ctx context.Context, templateID string, version int) ([]template.Field, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFields", ctx, templateID, version)
+	res0, _ := ret[0].([]template.Field)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockTemplateRepositoryMockRecorder) GetFields(ctx, templateID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFields", reflect.TypeOf((*MockTemplateRepository)(nil).GetFields), ctx, templateID, version)
+}
+
+func (m *MockTemplateRepository) PruneFieldVersions(ctx context.Context, templateID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReplaceFields", ctx, templateID, fields)
+	ret := m.ctrl.Call(m, "PruneFieldVersions", ctx, templateID)
 	res0, _ := ret[0].(error)
 	return res0
 }
 
-func (mr *MockTemplateRepositoryMockRecorder) ReplaceFields(ctx, templateID, fields any) *gomock.Call {
+func (mr *MockTemplateRepositoryMockRecorder) PruneFieldVersions(ctx, templateID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFields", reflect.TypeOf((*MockTemplateRepository)(nil).ReplaceFields), ctx, templateID, fields)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneFieldVersions", reflect.TypeOf((*MockTemplateRepository)(nil).PruneFieldVersions), ctx, templateID)
 }
 
 // MockTxManager is a mock of port.TxManager.
@@ -167,7 +244,7 @@ func (m *MockTemplateOutputPort) EXPECT() *MockTemplateOutputPortMockRecorder {
 	return m.recorder
 }
 
-func (m *MockTemplateOutputPort) PresentTemplateList(ctx context.Context, templates []template.WithUsage) error {
+func (m *MockTemplateOutputPort) PresentTemplateList(ctx context.Context, templates page.Page[template.WithUsage]) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PresentTemplateList", ctx, templates)
 	res0, _ := ret[0].(error)
@@ -0,0 +1,294 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+)
+
+// MockWebhookRepository is a mock of port.WebhookRepository.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+}
+
+// MockWebhookRepositoryMockRecorder records invocations.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookRepository) List(ctx context.Context, ownerID string) ([]webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, ownerID)
+	res0, _ := ret[0].([]webhook.Webhook)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) List(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookRepository)(nil).List), ctx, ownerID)
+}
+
+func (m *MockWebhookRepository) Get(ctx context.Context, id string) (*webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(*webhook.Webhook)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookRepository)(nil).Get), ctx, id)
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, w webhook.Webhook) (*webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, w)
+	res0, _ := ret[0].(*webhook.Webhook)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Create(ctx, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookRepository)(nil).Create), ctx, w)
+}
+
+func (m *MockWebhookRepository) Update(ctx context.Context, w webhook.Webhook) (*webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, w)
+	res0, _ := ret[0].(*webhook.Webhook)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Update(ctx, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookRepository)(nil).Update), ctx, w)
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookRepository)(nil).Delete), ctx, id)
+}
+
+func (m *MockWebhookRepository) CreateDelivery(ctx context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, d)
+	res0, _ := ret[0].(*webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) CreateDelivery(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).CreateDelivery), ctx, d)
+}
+
+func (m *MockWebhookRepository) GetDelivery(ctx context.Context, id string) (*webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, id)
+	res0, _ := ret[0].(*webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) GetDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).GetDelivery), ctx, id)
+}
+
+func (m *MockWebhookRepository) ListDeliveries(ctx context.Context, webhookID string, after *page.Cursor, limit int) ([]webhook.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, webhookID, after, limit)
+	res0, _ := ret[0].([]webhook.Delivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ListDeliveries(ctx, webhookID, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ListDeliveries), ctx, webhookID, after, limit)
+}
+
+func (m *MockWebhookRepository) ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]webhook.DueDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, limit, leaseUntil)
+	res0, _ := ret[0].([]webhook.DueDelivery)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ClaimDue(ctx, limit, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockWebhookRepository)(nil).ClaimDue), ctx, limit, leaseUntil)
+}
+
+func (m *MockWebhookRepository) SaveAttempt(ctx context.Context, d webhook.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttempt", ctx, d)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) SaveAttempt(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttempt", reflect.TypeOf((*MockWebhookRepository)(nil).SaveAttempt), ctx, d)
+}
+
+// MockWebhookOutputPort is a mock of port.WebhookOutputPort.
+type MockWebhookOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookOutputPortMockRecorder
+}
+
+// MockWebhookOutputPortMockRecorder records invocations.
+type MockWebhookOutputPortMockRecorder struct {
+	mock *MockWebhookOutputPort
+}
+
+// NewMockWebhookOutputPort creates a new mock.
+func NewMockWebhookOutputPort(ctrl *gomock.Controller) *MockWebhookOutputPort {
+	mock := &MockWebhookOutputPort{ctrl: ctrl}
+	mock.recorder = &MockWebhookOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookOutputPort) EXPECT() *MockWebhookOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookOutputPort) PresentWebhookList(ctx context.Context, webhooks []webhook.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhookList", ctx, webhooks)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhookList(ctx, webhooks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhookList", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhookList), ctx, webhooks)
+}
+
+func (m *MockWebhookOutputPort) PresentWebhook(ctx context.Context, w *webhook.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhook", ctx, w)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhook(ctx, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhook", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhook), ctx, w)
+}
+
+func (m *MockWebhookOutputPort) PresentWebhookCreated(ctx context.Context, w *webhook.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhookCreated", ctx, w)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhookCreated(ctx, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhookCreated", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhookCreated), ctx, w)
+}
+
+func (m *MockWebhookOutputPort) PresentWebhookDeleted(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWebhookDeleted", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentWebhookDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWebhookDeleted", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentWebhookDeleted), ctx)
+}
+
+func (m *MockWebhookOutputPort) PresentDeliveryList(ctx context.Context, deliveries page.Page[webhook.Delivery]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentDeliveryList", ctx, deliveries)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentDeliveryList(ctx, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentDeliveryList", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentDeliveryList), ctx, deliveries)
+}
+
+func (m *MockWebhookOutputPort) PresentDelivery(ctx context.Context, d *webhook.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentDelivery", ctx, d)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWebhookOutputPortMockRecorder) PresentDelivery(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentDelivery", reflect.TypeOf((*MockWebhookOutputPort)(nil).PresentDelivery), ctx, d)
+}
+
+// MockWebhookSender is a mock of port.WebhookSender.
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder records invocations.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock.
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookSender) Send(ctx context.Context, d webhook.DueDelivery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, d)
+	res0, _ := ret[0].(int)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, d)
+}
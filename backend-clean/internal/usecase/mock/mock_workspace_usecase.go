@@ -0,0 +1,379 @@
+package mockusecase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+)
+
+// MockWorkspaceRepository is a mock of port.WorkspaceRepository.
+type MockWorkspaceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceRepositoryMockRecorder
+}
+
+// MockWorkspaceRepositoryMockRecorder records invocations.
+type MockWorkspaceRepositoryMockRecorder struct {
+	mock *MockWorkspaceRepository
+}
+
+// NewMockWorkspaceRepository creates a new mock.
+func NewMockWorkspaceRepository(ctrl *gomock.Controller) *MockWorkspaceRepository {
+	mock := &MockWorkspaceRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWorkspaceRepository) EXPECT() *MockWorkspaceRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWorkspaceRepository) ListByAccount(ctx context.Context, accountID string) ([]workspace.WithRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", ctx, accountID)
+	res0, _ := ret[0].([]workspace.WithRole)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) ListByAccount(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockWorkspaceRepository)(nil).ListByAccount), ctx, accountID)
+}
+
+func (m *MockWorkspaceRepository) Get(ctx context.Context, id string) (*workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(*workspace.Workspace)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWorkspaceRepository)(nil).Get), ctx, id)
+}
+
+func (m *MockWorkspaceRepository) Create(ctx context.Context, w workspace.Workspace) (*workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, w)
+	res0, _ := ret[0].(*workspace.Workspace)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) Create(ctx, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkspaceRepository)(nil).Create), ctx, w)
+}
+
+func (m *MockWorkspaceRepository) Update(ctx context.Context, w workspace.Workspace) (*workspace.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, w)
+	res0, _ := ret[0].(*workspace.Workspace)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) Update(ctx, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWorkspaceRepository)(nil).Update), ctx, w)
+}
+
+func (m *MockWorkspaceRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWorkspaceRepository)(nil).Delete), ctx, id)
+}
+
+func (m *MockWorkspaceRepository) HasContents(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasContents", ctx, id)
+	res0, _ := ret[0].(bool)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) HasContents(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasContents", reflect.TypeOf((*MockWorkspaceRepository)(nil).HasContents), ctx, id)
+}
+
+func (m *MockWorkspaceRepository) GetMember(ctx context.Context, workspaceID string, accountID string) (*workspace.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, workspaceID, accountID)
+	res0, _ := ret[0].(*workspace.Member)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) GetMember(ctx, workspaceID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).GetMember), ctx, workspaceID, accountID)
+}
+
+func (m *MockWorkspaceRepository) ListMembers(ctx context.Context, workspaceID string) ([]workspace.MemberWithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, workspaceID)
+	res0, _ := ret[0].([]workspace.MemberWithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) ListMembers(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockWorkspaceRepository)(nil).ListMembers), ctx, workspaceID)
+}
+
+func (m *MockWorkspaceRepository) UpsertMember(ctx context.Context, member workspace.Member) (*workspace.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMember", ctx, member)
+	res0, _ := ret[0].(*workspace.Member)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) UpsertMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).UpsertMember), ctx, member)
+}
+
+func (m *MockWorkspaceRepository) DeleteMember(ctx context.Context, workspaceID string, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, workspaceID, accountID)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceRepositoryMockRecorder) DeleteMember(ctx, workspaceID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).DeleteMember), ctx, workspaceID, accountID)
+}
+
+// MockWorkspaceInviteRepository is a mock of port.WorkspaceInviteRepository.
+type MockWorkspaceInviteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceInviteRepositoryMockRecorder
+}
+
+// MockWorkspaceInviteRepositoryMockRecorder records invocations.
+type MockWorkspaceInviteRepositoryMockRecorder struct {
+	mock *MockWorkspaceInviteRepository
+}
+
+// NewMockWorkspaceInviteRepository creates a new mock.
+func NewMockWorkspaceInviteRepository(ctrl *gomock.Controller) *MockWorkspaceInviteRepository {
+	mock := &MockWorkspaceInviteRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceInviteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWorkspaceInviteRepository) EXPECT() *MockWorkspaceInviteRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWorkspaceInviteRepository) List(ctx context.Context, workspaceID string) ([]workspace.InviteWithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, workspaceID)
+	res0, _ := ret[0].([]workspace.InviteWithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceInviteRepositoryMockRecorder) List(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWorkspaceInviteRepository)(nil).List), ctx, workspaceID)
+}
+
+func (m *MockWorkspaceInviteRepository) ListByEmail(ctx context.Context, email string) ([]workspace.InviteWithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEmail", ctx, email)
+	res0, _ := ret[0].([]workspace.InviteWithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceInviteRepositoryMockRecorder) ListByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmail", reflect.TypeOf((*MockWorkspaceInviteRepository)(nil).ListByEmail), ctx, email)
+}
+
+func (m *MockWorkspaceInviteRepository) Get(ctx context.Context, id string) (*workspace.InviteWithMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	res0, _ := ret[0].(*workspace.InviteWithMeta)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceInviteRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWorkspaceInviteRepository)(nil).Get), ctx, id)
+}
+
+func (m *MockWorkspaceInviteRepository) Upsert(ctx context.Context, i workspace.Invite) (*workspace.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, i)
+	res0, _ := ret[0].(*workspace.Invite)
+	res1, _ := ret[1].(error)
+	return res0, res1
+}
+
+func (mr *MockWorkspaceInviteRepositoryMockRecorder) Upsert(ctx, i any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockWorkspaceInviteRepository)(nil).Upsert), ctx, i)
+}
+
+func (m *MockWorkspaceInviteRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceInviteRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWorkspaceInviteRepository)(nil).Delete), ctx, id)
+}
+
+// MockWorkspaceOutputPort is a mock of port.WorkspaceOutputPort.
+type MockWorkspaceOutputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceOutputPortMockRecorder
+}
+
+// MockWorkspaceOutputPortMockRecorder records invocations.
+type MockWorkspaceOutputPortMockRecorder struct {
+	mock *MockWorkspaceOutputPort
+}
+
+// NewMockWorkspaceOutputPort creates a new mock.
+func NewMockWorkspaceOutputPort(ctrl *gomock.Controller) *MockWorkspaceOutputPort {
+	mock := &MockWorkspaceOutputPort{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceOutputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns recorder.
+func (m *MockWorkspaceOutputPort) EXPECT() *MockWorkspaceOutputPortMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspaces(ctx context.Context, workspaces []workspace.WithRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspaces", ctx, workspaces)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspaces(ctx, workspaces any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspaces", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspaces), ctx, workspaces)
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspace(ctx context.Context, w *workspace.WithRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspace", ctx, w)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspace(ctx, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspace", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspace), ctx, w)
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspaceDeleted(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspaceDeleted", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspaceDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspaceDeleted", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspaceDeleted), ctx)
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspaceMembers(ctx context.Context, members []workspace.MemberWithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspaceMembers", ctx, members)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspaceMembers(ctx, members any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspaceMembers", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspaceMembers), ctx, members)
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspaceMember(ctx context.Context, member *workspace.MemberWithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspaceMember", ctx, member)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspaceMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspaceMember", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspaceMember), ctx, member)
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspaceMemberRemoved(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspaceMemberRemoved", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspaceMemberRemoved(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspaceMemberRemoved", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspaceMemberRemoved), ctx)
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspaceInvites(ctx context.Context, invites []workspace.InviteWithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspaceInvites", ctx, invites)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspaceInvites(ctx, invites any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspaceInvites", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspaceInvites), ctx, invites)
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspaceInvite(ctx context.Context, invite *workspace.InviteWithMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspaceInvite", ctx, invite)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspaceInvite(ctx, invite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspaceInvite", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspaceInvite), ctx, invite)
+}
+
+func (m *MockWorkspaceOutputPort) PresentWorkspaceInviteRevoked(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresentWorkspaceInviteRevoked", ctx)
+	res0, _ := ret[0].(error)
+	return res0
+}
+
+func (mr *MockWorkspaceOutputPortMockRecorder) PresentWorkspaceInviteRevoked(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresentWorkspaceInviteRevoked", reflect.TypeOf((*MockWorkspaceOutputPort)(nil).PresentWorkspaceInviteRevoked), ctx)
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteCollaboratorInteractor handles the accounts a note is shared with.
+type NoteCollaboratorInteractor struct {
+	notes         port.NoteRepository
+	accounts      port.AccountRepository
+	collaborators port.NoteCollaboratorRepository
+	workspaces    port.WorkspaceRepository
+	output        port.NoteCollaboratorOutputPort
+}
+
+var _ port.NoteCollaboratorInputPort = (*NoteCollaboratorInteractor)(nil)
+
+// NewNoteCollaboratorInteractor creates NoteCollaboratorInteractor.
+func NewNoteCollaboratorInteractor(notes port.NoteRepository, accounts port.AccountRepository, collaborators port.NoteCollaboratorRepository, workspaces port.WorkspaceRepository, output port.NoteCollaboratorOutputPort) *NoteCollaboratorInteractor {
+	return &NoteCollaboratorInteractor{
+		notes:         notes,
+		accounts:      accounts,
+		collaborators: collaborators,
+		workspaces:    workspaces,
+		output:        output,
+	}
+}
+
+// List returns the collaborators of a note the viewer may read.
+func (u *NoteCollaboratorInteractor) List(ctx context.Context, noteID, viewerID string) error {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if !note.CanView(n.Note, viewerID) {
+		return domainerr.ErrNotFound
+	}
+	collaborators, err := u.collaborators.List(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNoteCollaborators(ctx, collaborators)
+}
+
+// Put shares the note with an account, or changes the account's role.
+// Only the note's owner may manage collaborators, and only members of the note's
+// workspace may become one.
+func (u *NoteCollaboratorInteractor) Put(ctx context.Context, input port.NoteCollaboratorPutInput) error {
+	n, err := u.notes.Get(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	c, err := note.NewCollaborator(n.Note, input.ActorID, input.AccountID, input.Role)
+	if err != nil {
+		return err
+	}
+	if _, err := u.accounts.GetByID(ctx, input.AccountID); err != nil {
+		return err
+	}
+	if n.Note.WorkspaceID == "" {
+		return domainerr.ErrCollaboratorNotWorkspaceMember
+	}
+	if _, err := u.workspaces.GetMember(ctx, n.Note.WorkspaceID, input.AccountID); err != nil {
+		if errors.Is(err, domainerr.ErrNotFound) {
+			return domainerr.ErrCollaboratorNotWorkspaceMember
+		}
+		return err
+	}
+	if _, err := u.collaborators.Upsert(ctx, c); err != nil {
+		return err
+	}
+	collaborators, err := u.collaborators.List(ctx, input.NoteID)
+	if err != nil {
+		return err
+	}
+	for i := range collaborators {
+		if collaborators[i].Collaborator.AccountID == input.AccountID {
+			return u.output.PresentNoteCollaborator(ctx, &collaborators[i])
+		}
+	}
+	return domainerr.ErrNotFound
+}
+
+// Delete stops sharing the note with an account. Only the note's owner may do so.
+func (u *NoteCollaboratorInteractor) Delete(ctx context.Context, noteID, accountID, actorID string) error {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteOwnership(n.Note.OwnerID, actorID); err != nil {
+		return err
+	}
+	if err := u.collaborators.Delete(ctx, noteID, accountID); err != nil {
+		return err
+	}
+	return u.output.PresentNoteCollaboratorDeleted(ctx)
+}
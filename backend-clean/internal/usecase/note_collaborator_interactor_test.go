@@ -0,0 +1,205 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func sharedNote(status note.NoteStatus) *note.WithMeta {
+	return &note.WithMeta{Note: note.Note{
+		ID:            "n1",
+		OwnerID:       "owner",
+		WorkspaceID:   "ws-1",
+		Status:        status,
+		Collaborators: []note.Collaborator{{NoteID: "n1", AccountID: "editor", Role: note.RoleEditor}},
+	}}
+}
+
+func TestNoteCollaboratorInteractor_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		viewerID  string
+		repoErr   error
+		wantError error
+	}{
+		{name: "[Success] owner lists collaborators", viewerID: "owner"},
+		{name: "[Success] collaborator sees the draft's collaborators", viewerID: "editor"},
+		{name: "[Fail] stranger does not see the draft", viewerID: "stranger", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] repo error", viewerID: "owner", repoErr: errors.New("db err"), wantError: errors.New("db err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			collaborators := mockusecase.NewMockNoteCollaboratorRepository(ctrl)
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			out := mockusecase.NewMockNoteCollaboratorOutputPort(ctrl)
+
+			current := sharedNote(note.StatusDraft)
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(current, nil)
+			if note.CanView(current.Note, tt.viewerID) {
+				collaborators.EXPECT().List(gomock.Any(), "n1").Return([]note.CollaboratorWithMeta{
+					{Collaborator: current.Note.Collaborators[0], FirstName: "Hanako"},
+				}, tt.repoErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteCollaborators(gomock.Any(), gomock.Len(1)).Return(nil)
+			}
+
+			err := uc.NewNoteCollaboratorInteractor(notes, accounts, collaborators, workspaces, out).List(context.Background(), "n1", tt.viewerID)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestNoteCollaboratorInteractor_Put(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      port.NoteCollaboratorPutInput
+		accountErr error
+		memberErr  error
+		upsertErr  error
+		wantError  error
+	}{
+		{
+			name:  "[Success] share with a viewer",
+			input: port.NoteCollaboratorPutInput{NoteID: "n1", AccountID: "reader", Role: note.RoleViewer, ActorID: "owner"},
+		},
+		{
+			name:  "[Success] change an editor to a viewer",
+			input: port.NoteCollaboratorPutInput{NoteID: "n1", AccountID: "editor", Role: note.RoleViewer, ActorID: "owner"},
+		},
+		{
+			name:      "[Fail] editor cannot share",
+			input:     port.NoteCollaboratorPutInput{NoteID: "n1", AccountID: "reader", Role: note.RoleViewer, ActorID: "editor"},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] invalid role",
+			input:     port.NoteCollaboratorPutInput{NoteID: "n1", AccountID: "reader", Role: "admin", ActorID: "owner"},
+			wantError: domainerr.ErrInvalidCollaboratorRole,
+		},
+		{
+			name:      "[Fail] owner cannot be a collaborator",
+			input:     port.NoteCollaboratorPutInput{NoteID: "n1", AccountID: "owner", Role: note.RoleEditor, ActorID: "owner"},
+			wantError: domainerr.ErrInvalidCollaborator,
+		},
+		{
+			name:       "[Fail] unknown account",
+			input:      port.NoteCollaboratorPutInput{NoteID: "n1", AccountID: "ghost", Role: note.RoleViewer, ActorID: "owner"},
+			accountErr: domainerr.ErrNotFound,
+			wantError:  domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] account outside the note's workspace",
+			input:     port.NoteCollaboratorPutInput{NoteID: "n1", AccountID: "outsider", Role: note.RoleViewer, ActorID: "owner"},
+			memberErr: domainerr.ErrNotFound,
+			wantError: domainerr.ErrCollaboratorNotWorkspaceMember,
+		},
+		{
+			name:      "[Fail] membership lookup error",
+			input:     port.NoteCollaboratorPutInput{NoteID: "n1", AccountID: "reader", Role: note.RoleViewer, ActorID: "owner"},
+			memberErr: errors.New("db err"),
+			wantError: errors.New("db err"),
+		},
+		{
+			name:      "[Fail] upsert error",
+			input:     port.NoteCollaboratorPutInput{NoteID: "n1", AccountID: "reader", Role: note.RoleViewer, ActorID: "owner"},
+			upsertErr: errors.New("db err"),
+			wantError: errors.New("db err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			collaborators := mockusecase.NewMockNoteCollaboratorRepository(ctrl)
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			out := mockusecase.NewMockNoteCollaboratorOutputPort(ctrl)
+
+			current := sharedNote(note.StatusDraft)
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(current, nil)
+			want, buildErr := note.NewCollaborator(current.Note, tt.input.ActorID, tt.input.AccountID, tt.input.Role)
+			if buildErr == nil {
+				accounts.EXPECT().GetByID(gomock.Any(), tt.input.AccountID).Return(&account.Account{ID: tt.input.AccountID}, tt.accountErr)
+			}
+			if buildErr == nil && tt.accountErr == nil {
+				workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", tt.input.AccountID).Return(&workspace.Member{WorkspaceID: "ws-1", AccountID: tt.input.AccountID}, tt.memberErr)
+			}
+			if buildErr == nil && tt.accountErr == nil && tt.memberErr == nil {
+				collaborators.EXPECT().Upsert(gomock.Any(), want).Return(&want, tt.upsertErr)
+			}
+			if tt.wantError == nil {
+				collaborators.EXPECT().List(gomock.Any(), "n1").Return([]note.CollaboratorWithMeta{
+					{Collaborator: note.Collaborator{NoteID: "n1", AccountID: "other", Role: note.RoleEditor}},
+					{Collaborator: want, FirstName: "Reader"},
+				}, nil)
+				out.EXPECT().PresentNoteCollaborator(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *note.CollaboratorWithMeta) error {
+					if c.Collaborator.AccountID != tt.input.AccountID || c.Collaborator.Role != tt.input.Role {
+						t.Fatalf("unexpected collaborator: %+v", c)
+					}
+					return nil
+				})
+			}
+
+			err := uc.NewNoteCollaboratorInteractor(notes, accounts, collaborators, workspaces, out).Put(context.Background(), tt.input)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestNoteCollaboratorInteractor_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorID   string
+		deleteErr error
+		wantError error
+	}{
+		{name: "[Success] owner removes a collaborator", actorID: "owner"},
+		{name: "[Fail] editor cannot remove collaborators", actorID: "editor", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] not a collaborator", actorID: "owner", deleteErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			accounts := mockusecase.NewMockAccountRepository(ctrl)
+			collaborators := mockusecase.NewMockNoteCollaboratorRepository(ctrl)
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+			out := mockusecase.NewMockNoteCollaboratorOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(sharedNote(note.StatusDraft), nil)
+			if tt.actorID == "owner" {
+				collaborators.EXPECT().Delete(gomock.Any(), "n1", "editor").Return(tt.deleteErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteCollaboratorDeleted(gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewNoteCollaboratorInteractor(notes, accounts, collaborators, workspaces, out).Delete(context.Background(), "n1", "editor", tt.actorID)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
@@ -3,12 +3,17 @@ package usecase
 
 import (
 	"context"
+	"slices"
 	"strings"
 
+	"immortal-architecture-clean/backend/internal/domain/audit"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/service"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
@@ -16,38 +21,84 @@ import (
 type NoteInteractor struct {
 	notes     port.NoteRepository
 	templates port.TemplateRepository
+	revisions port.NoteRevisionRepository
+	links     port.NoteLinkRepository
 	tx        port.TxManager
+	events    port.EventPublisher
+	auditLog  port.AuditRecorder
 	output    port.NoteOutputPort
 }
 
 var _ port.NoteInputPort = (*NoteInteractor)(nil)
 
 // NewNoteInteractor creates NoteInteractor.
-func NewNoteInteractor(notes port.NoteRepository, templates port.TemplateRepository, tx port.TxManager, output port.NoteOutputPort) *NoteInteractor {
+func NewNoteInteractor(notes port.NoteRepository, templates port.TemplateRepository, revisions port.NoteRevisionRepository, links port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.NoteOutputPort) *NoteInteractor {
 	return &NoteInteractor{
 		notes:     notes,
 		templates: templates,
+		revisions: revisions,
+		links:     links,
 		tx:        tx,
+		events:    events,
+		auditLog:  auditLog,
 		output:    output,
 	}
 }
 
-// List returns notes by filters.
-func (u *NoteInteractor) List(ctx context.Context, filters note.Filters) error {
-	notes, err := u.notes.List(ctx, filters)
+// List returns a page of notes by filters that are visible to the viewer.
+func (u *NoteInteractor) List(ctx context.Context, filters note.Filters, viewerID string) error {
+	filters, err := filters.NormalizeTags()
+	if err != nil {
+		return err
+	}
+	filters.ViewerID = viewerID
+	filters.WorkspaceID = port.WorkspaceIDFromContext(ctx)
+	notes, err := page.Fetch(filters.Limit, func(limit int) ([]note.WithMeta, error) {
+		filters.Limit = limit
+		return u.notes.List(ctx, filters)
+	}, note.WithMeta.Cursor)
 	if err != nil {
 		return err
 	}
 	return u.output.PresentNoteList(ctx, notes)
 }
 
-// Get returns note by ID.
-func (u *NoteInteractor) Get(ctx context.Context, id string) error {
+// Search returns notes matching the keyword that are visible to the viewer, ranked by relevance.
+func (u *NoteInteractor) Search(ctx context.Context, filters note.Filters, viewerID string) error {
+	if filters.Query == nil || strings.TrimSpace(*filters.Query) == "" {
+		return domainerr.ErrSearchQueryRequired
+	}
+	for _, t := range filters.SearchIn {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	filters, err := filters.NormalizeTags()
+	if err != nil {
+		return err
+	}
+	query := strings.TrimSpace(*filters.Query)
+	filters.Query = &query
+	filters.ViewerID = viewerID
+	filters.WorkspaceID = port.WorkspaceIDFromContext(ctx)
+	results, err := u.notes.Search(ctx, filters)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentSearchResults(ctx, results)
+}
+
+// Get returns note by ID if it is visible to the viewer.
+// Notes the viewer may not read are reported as not found so their existence is not leaked.
+func (u *NoteInteractor) Get(ctx context.Context, id, viewerID string) error {
 	n, err := u.notes.Get(ctx, id)
 	if err != nil {
 		return err
 	}
-	return u.output.PresentNote(ctx, n)
+	if !note.CanView(n.Note, viewerID) {
+		return domainerr.ErrNotFound
+	}
+	return u.presentNote(ctx, n, viewerID)
 }
 
 // Create creates a note.
@@ -68,87 +119,130 @@ func (u *NoteInteractor) Create(ctx context.Context, input port.NoteCreateInput)
 	if err := note.ValidateNoteForCreate(input.Title, tpl.Template, sections); err != nil {
 		return err
 	}
+	tags, err := note.NewTags(input.Tags)
+	if err != nil {
+		return err
+	}
 
-	var noteID string
+	var created *note.WithMeta
 	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
 		newNote := note.Note{
-			Title:      input.Title,
-			TemplateID: tpl.Template.ID,
-			OwnerID:    input.OwnerID,
-			Status:     note.StatusDraft,
-			Sections:   sections,
+			Title:           input.Title,
+			TemplateID:      tpl.Template.ID,
+			TemplateVersion: tpl.Template.FieldsVersion,
+			OwnerID:         input.OwnerID,
+			WorkspaceID:     tpl.Template.WorkspaceID,
+			Status:          note.StatusDraft,
+			Sections:        sections,
 		}
 		nn, err := u.notes.Create(txCtx, newNote)
 		if err != nil {
 			return err
 		}
-		noteID = nn.ID
-		sectionsWithID, err := buildSections(noteID, input.Sections)
+		sectionsWithID, err := buildSections(nn.ID, input.Sections)
 		if err != nil {
 			return err
 		}
 		if err := note.ValidateSections(tpl.Template.Fields, sectionsWithID); err != nil {
 			return err
 		}
-		return u.notes.ReplaceSections(txCtx, noteID, sectionsWithID)
+		if err := u.notes.ReplaceSections(txCtx, nn.ID, sectionsWithID); err != nil {
+			return err
+		}
+		if len(tags) > 0 {
+			if err := u.notes.ReplaceTags(txCtx, nn.ID, tags); err != nil {
+				return err
+			}
+		}
+		created, err = u.recordRevision(txCtx, nn.ID, input.OwnerID)
+		if err != nil {
+			return err
+		}
+		if err := u.events.Publish(txCtx, event.NewNoteCreated(created.Note, input.OwnerID)); err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.NoteEntry(audit.ActionCreate, input.OwnerID, nil, created))
 	})
 	if err != nil {
 		return err
 	}
-	n, err := u.notes.Get(ctx, noteID)
-	if err != nil {
-		return err
-	}
-	return u.output.PresentNote(ctx, n)
+	return u.presentNote(ctx, created, input.OwnerID)
 }
 
-// Update updates a note.
+// Update updates a note. The owner and editors may change the title and sections;
+// only the owner may change the tags.
 func (u *NoteInteractor) Update(ctx context.Context, input port.NoteUpdateInput) error {
 	current, err := u.notes.Get(ctx, input.ID)
 	if err != nil {
 		return err
 	}
-	if err := note.ValidateNoteOwnership(current.Note.OwnerID, input.OwnerID); err != nil {
+	if err := note.ValidateNoteEditor(current.Note, input.OwnerID); err != nil {
+		return err
+	}
+	if err := note.CheckVersion(current.Note.Version, input.Version); err != nil {
 		return err
 	}
 	if strings.TrimSpace(input.Title) == "" {
 		return domainerr.ErrTitleRequired
 	}
+	tags, err := note.ChangeTags(current.Tags, input.AddTags, input.RemoveTags)
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(tags, current.Tags) {
+		if err := note.ValidateNoteOwnership(current.Note.OwnerID, input.OwnerID); err != nil {
+			return err
+		}
+	}
 
+	var updated *note.WithMeta
 	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		// The update is conditional on the version read above, so a concurrent edit
+		// since then fails here, and the row stays locked until commit so nobody can
+		// interleave with the section replacement below.
 		_, err := u.notes.Update(txCtx, note.Note{
-			ID:    input.ID,
-			Title: input.Title,
+			ID:      input.ID,
+			Title:   input.Title,
+			Version: current.Note.Version,
 		})
 		if err != nil {
 			return err
 		}
 		if input.Sections != nil {
-			tpl, err := u.templates.Get(ctx, current.Note.TemplateID)
+			// Sections are validated against the fields of the version the note is pinned to.
+			fields, err := u.templates.GetFields(txCtx, current.Note.TemplateID, current.Note.TemplateVersion)
 			if err != nil {
 				return err
 			}
-			sections, err := buildSectionsForUpdate(current.Sections, tpl.Template.Fields, input.Sections, current.Note.ID)
+			sections, err := buildSectionsForUpdate(current.Sections, fields, input.Sections, current.Note.ID)
 			if err != nil {
 				return err
 			}
-			if err := note.ValidateSections(tpl.Template.Fields, sections); err != nil {
+			if err := note.ValidateSections(fields, sections); err != nil {
 				return err
 			}
 			if err := u.notes.ReplaceSections(txCtx, input.ID, sections); err != nil {
 				return err
 			}
 		}
-		return nil
+		if !slices.Equal(tags, current.Tags) {
+			if err := u.notes.ReplaceTags(txCtx, input.ID, tags); err != nil {
+				return err
+			}
+		}
+		updated, err = u.recordRevision(txCtx, input.ID, input.OwnerID)
+		if err != nil {
+			return err
+		}
+		if err := u.events.Publish(txCtx, event.NewNoteUpdated(updated.Note, input.OwnerID)); err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.NoteEntry(audit.ActionUpdate, input.OwnerID, current, updated))
 	})
 	if err != nil {
 		return err
 	}
-	n, err := u.notes.Get(ctx, input.ID)
-	if err != nil {
-		return err
-	}
-	return u.output.PresentNote(ctx, n)
+	return u.presentNote(ctx, updated, input.OwnerID)
 }
 
 // ChangeStatus changes note status.
@@ -177,17 +271,122 @@ func (u *NoteInteractor) ChangeStatus(ctx context.Context, input port.NoteStatus
 		return err
 	}
 
-	if _, err := u.notes.UpdateStatus(ctx, input.ID, input.Status); err != nil {
+	var changed *note.WithMeta
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := u.notes.UpdateStatus(txCtx, input.ID, input.Status); err != nil {
+			return err
+		}
+		n, err := u.recordRevision(txCtx, input.ID, input.OwnerID)
+		if err != nil {
+			return err
+		}
+		changed = n
+		if err := u.events.Publish(txCtx, event.NewNoteStatusChanged(n.Note, input.OwnerID)); err != nil {
+			return err
+		}
+		action := audit.ActionUnpublish
+		if input.Status == note.StatusPublish {
+			action = audit.ActionPublish
+		}
+		return u.auditLog.Record(txCtx, audit.NoteEntry(action, input.OwnerID, current, n))
+	})
+	if err != nil {
 		return err
 	}
-	n, err := u.notes.Get(ctx, input.ID)
+	return u.presentNote(ctx, changed, input.OwnerID)
+}
+
+// MigrateTemplate moves a note to the latest version of its template.
+// Section contents are carried over along input.Mappings; a note already on the
+// latest version is returned unchanged.
+func (u *NoteInteractor) MigrateTemplate(ctx context.Context, input port.NoteMigrateTemplateInput) error {
+	current, err := u.notes.Get(ctx, input.ID)
 	if err != nil {
 		return err
 	}
-	return u.output.PresentNote(ctx, n)
+	if err := note.ValidateNoteOwnership(current.Note.OwnerID, input.OwnerID); err != nil {
+		return err
+	}
+	if err := note.CheckVersion(current.Note.Version, input.Version); err != nil {
+		return err
+	}
+	tpl, err := u.templates.Get(ctx, current.Note.TemplateID)
+	if err != nil {
+		return err
+	}
+	if current.Note.TemplateVersion == tpl.Template.FieldsVersion {
+		return u.presentNote(ctx, current, input.OwnerID)
+	}
+	sections, err := note.MigrateSections(current.Sections, tpl.Template.Fields, input.Mappings)
+	if err != nil {
+		return err
+	}
+
+	var migrated *note.WithMeta
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := u.notes.UpdateTemplateVersion(txCtx, note.Note{
+			ID:              input.ID,
+			TemplateVersion: tpl.Template.FieldsVersion,
+			Version:         current.Note.Version,
+		}, sections); err != nil {
+			return err
+		}
+		// The version the note left may have no notes pinned to it any more.
+		if err := u.templates.PruneFieldVersions(txCtx, current.Note.TemplateID); err != nil {
+			return err
+		}
+		migrated, err = u.recordRevision(txCtx, input.ID, input.OwnerID)
+		if err != nil {
+			return err
+		}
+		if err := u.events.Publish(txCtx, event.NewNoteUpdated(migrated.Note, input.OwnerID)); err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.NoteEntry(audit.ActionMigrateTemplate, input.OwnerID, current, migrated))
+	})
+	if err != nil {
+		return err
+	}
+	return u.presentNote(ctx, migrated, input.OwnerID)
 }
 
-// Delete deletes a note.
+// ExportMarkdown renders a note visible to the viewer as a Markdown document.
+func (u *NoteInteractor) ExportMarkdown(ctx context.Context, id, viewerID string) error {
+	n, err := u.notes.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !note.CanView(n.Note, viewerID) {
+		return domainerr.ErrNotFound
+	}
+	return u.output.PresentNoteMarkdown(ctx, n, note.RenderMarkdown(*n))
+}
+
+// ImportMarkdown creates a draft note from a Markdown document written against the
+// latest fields of the chosen template.
+func (u *NoteInteractor) ImportMarkdown(ctx context.Context, input port.NoteImportMarkdownInput) error {
+	tpl, err := u.templates.Get(ctx, input.TemplateID)
+	if err != nil {
+		return err
+	}
+	title, sections, err := note.ParseMarkdown(input.Markdown, tpl.Template.Fields)
+	if err != nil {
+		return err
+	}
+	inputs := make([]port.SectionInput, 0, len(sections))
+	for _, s := range sections {
+		inputs = append(inputs, port.SectionInput{FieldID: s.FieldID, Content: s.Content})
+	}
+	return u.Create(ctx, port.NoteCreateInput{
+		Title:      title,
+		TemplateID: input.TemplateID,
+		OwnerID:    input.OwnerID,
+		Sections:   inputs,
+	})
+}
+
+// Delete moves a note to the trash. Links from and to it are kept for a restore;
+// while trashed the note is hidden from every list and from other notes' links.
 func (u *NoteInteractor) Delete(ctx context.Context, id, ownerID string) error {
 	current, err := u.notes.Get(ctx, id)
 	if err != nil {
@@ -196,12 +395,107 @@ func (u *NoteInteractor) Delete(ctx context.Context, id, ownerID string) error {
 	if err := note.ValidateNoteOwnership(current.Note.OwnerID, ownerID); err != nil {
 		return err
 	}
-	if err := u.notes.Delete(ctx, id); err != nil {
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.notes.Trash(txCtx, id); err != nil {
+			return err
+		}
+		if err := u.events.Publish(txCtx, event.NewNoteDeleted(current.Note, ownerID)); err != nil {
+			return err
+		}
+		trashed, err := u.notes.GetTrashed(txCtx, id)
+		if err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.NoteEntry(audit.ActionDelete, ownerID, current, trashed))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNoteDeleted(ctx)
+}
+
+// ListTrash returns a page of the owner's trashed notes, most recently trashed first.
+func (u *NoteInteractor) ListTrash(ctx context.Context, filters trash.Filters) error {
+	if filters.OwnerID == "" {
+		return domainerr.ErrOwnerRequired
+	}
+	filters.WorkspaceID = port.WorkspaceIDFromContext(ctx)
+	notes, err := page.Fetch(filters.Limit, func(limit int) ([]note.WithMeta, error) {
+		filters.Limit = limit
+		return u.notes.ListTrash(ctx, filters)
+	}, note.WithMeta.TrashCursor)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNoteList(ctx, notes)
+}
+
+// Restore takes a note out of the trash with its links.
+// Other accounts' trashed notes are reported as not found.
+func (u *NoteInteractor) Restore(ctx context.Context, id, ownerID string) error {
+	trashed, err := u.notes.GetTrashed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if trashed.Note.OwnerID != ownerID {
+		return domainerr.ErrNotFound
+	}
+	var restored *note.WithMeta
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.notes.Restore(txCtx, id); err != nil {
+			return err
+		}
+		if err := u.events.Publish(txCtx, event.NewNoteRestored(trashed.Note, ownerID)); err != nil {
+			return err
+		}
+		restored, err = u.notes.Get(txCtx, id)
+		if err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.NoteEntry(audit.ActionRestore, ownerID, trashed, restored))
+	})
+	if err != nil {
+		return err
+	}
+	return u.presentNote(ctx, restored, ownerID)
+}
+
+// Purge deletes a trashed note for good together with links from and to it.
+// Other accounts' trashed notes are reported as not found.
+func (u *NoteInteractor) Purge(ctx context.Context, id, ownerID string) error {
+	trashed, err := u.notes.GetTrashed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if trashed.Note.OwnerID != ownerID {
+		return domainerr.ErrNotFound
+	}
+	if err := purgeNote(ctx, u.notes, u.links, u.tx, u.events, u.auditLog, *trashed, ownerID); err != nil {
 		return err
 	}
 	return u.output.PresentNoteDeleted(ctx)
 }
 
+// recordRevision snapshots the note's current state as a new revision and returns that state.
+// It must run inside the transaction that made the change so history never diverges from the note.
+func (u *NoteInteractor) recordRevision(ctx context.Context, noteID, actorID string) (*note.WithMeta, error) {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.revisions.Create(ctx, note.NewRevision(*n, actorID)); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// presentNote hides link summaries pointing at notes the viewer may not read.
+func (u *NoteInteractor) presentNote(ctx context.Context, n *note.WithMeta, viewerID string) error {
+	n.Links = note.VisibleLinks(n.Links, viewerID)
+	n.Backlinks = note.VisibleLinks(n.Backlinks, viewerID)
+	return u.output.PresentNote(ctx, n)
+}
+
 func buildSections(noteID string, inputs []port.SectionInput) ([]note.Section, error) {
 	if len(inputs) == 0 {
 		return nil, domainerr.ErrSectionsMissing
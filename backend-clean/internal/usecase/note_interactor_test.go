@@ -4,33 +4,91 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
+	"immortal-architecture-clean/backend/internal/domain/audit"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
 	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 	uc "immortal-architecture-clean/backend/internal/usecase"
 	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
 )
 
 func TestNoteInteractor_List(t *testing.T) {
+	updatedAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	n1 := note.WithMeta{Note: note.Note{ID: "n1", UpdatedAt: updatedAt}}
+	n2 := note.WithMeta{Note: note.Note{ID: "n2"}}
+	after := &page.Cursor{UpdatedAt: updatedAt.Add(time.Hour), ID: "n0"}
 	tests := []struct {
 		name      string
 		filters   note.Filters
+		viewerID  string
+		repoArg   note.Filters
 		result    []note.WithMeta
+		want      page.Page[note.WithMeta]
 		repoErr   error
 		wantError error
 	}{
 		{
-			name:    "[Success] list notes",
-			filters: note.Filters{OwnerID: strPtr("owner")},
-			result:  []note.WithMeta{{Note: note.Note{ID: "n1"}}},
+			name:     "[Success] list notes",
+			filters:  note.Filters{OwnerID: strPtr("owner")},
+			viewerID: "viewer",
+			repoArg:  note.Filters{OwnerID: strPtr("owner"), ViewerID: "viewer", Limit: page.DefaultLimit + 1},
+			result:   []note.WithMeta{n1},
+			want:     page.Page[note.WithMeta]{Items: []note.WithMeta{n1}},
+		},
+		{
+			name:     "[Success] client supplied viewer is overridden",
+			filters:  note.Filters{ViewerID: "someone-else"},
+			viewerID: "viewer",
+			repoArg:  note.Filters{ViewerID: "viewer", Limit: page.DefaultLimit + 1},
+			result:   []note.WithMeta{},
+			want:     page.Page[note.WithMeta]{Items: []note.WithMeta{}},
+		},
+		{
+			name:     "[Success] next page after cursor",
+			filters:  note.Filters{Limit: 1, After: after},
+			viewerID: "viewer",
+			repoArg:  note.Filters{ViewerID: "viewer", Limit: 2, After: after},
+			result:   []note.WithMeta{n1, n2},
+			want: page.Page[note.WithMeta]{
+				Items:      []note.WithMeta{n1},
+				NextCursor: &page.Cursor{UpdatedAt: updatedAt, ID: "n1"},
+			},
+		},
+		{
+			name:     "[Success] oversized page is capped",
+			filters:  note.Filters{Limit: 1000},
+			viewerID: "viewer",
+			repoArg:  note.Filters{ViewerID: "viewer", Limit: page.MaxLimit + 1},
+			result:   []note.WithMeta{n1},
+			want:     page.Page[note.WithMeta]{Items: []note.WithMeta{n1}},
+		},
+		{
+			name:     "[Success] tags are normalized",
+			filters:  note.Filters{Tags: []note.Tag{"Billing", "auth", "AUTH"}, TagMatch: note.TagMatchAll},
+			viewerID: "viewer",
+			repoArg:  note.Filters{Tags: []note.Tag{"auth", "billing"}, TagMatch: note.TagMatchAll, ViewerID: "viewer", Limit: page.DefaultLimit + 1},
+			result:   []note.WithMeta{n1},
+			want:     page.Page[note.WithMeta]{Items: []note.WithMeta{n1}},
+		},
+		{
+			name:      "[Fail] invalid tag match",
+			filters:   note.Filters{Tags: []note.Tag{"auth"}, TagMatch: "some"},
+			viewerID:  "viewer",
+			wantError: domainerr.ErrInvalidTagMatch,
 		},
 		{
 			name:      "[Fail] repo error",
 			filters:   note.Filters{},
+			viewerID:  "viewer",
+			repoArg:   note.Filters{ViewerID: "viewer", Limit: page.DefaultLimit + 1},
 			repoErr:   errors.New("repo err"),
 			wantError: errors.New("repo err"),
 		},
@@ -44,15 +102,21 @@ func TestNoteInteractor_List(t *testing.T) {
 			notes := mockusecase.NewMockNoteRepository(ctrl)
 			templates := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
-			notes.EXPECT().List(gomock.Any(), tt.filters).Return(tt.result, tt.repoErr)
-			if tt.repoErr == nil {
-				out.EXPECT().PresentNoteList(gomock.Any(), tt.result).Return(nil)
+			if tt.wantError == nil || tt.repoErr != nil {
+				notes.EXPECT().List(gomock.Any(), tt.repoArg).Return(tt.result, tt.repoErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteList(gomock.Any(), tt.want).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notes, templates, tx, out)
-			err := interactor.List(context.Background(), tt.filters)
+			interactor := uc.NewNoteInteractor(notes, templates, revisions, links, tx, events, auditLog, out)
+			err := interactor.List(context.Background(), tt.filters, tt.viewerID)
 
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -64,22 +128,131 @@ func TestNoteInteractor_List(t *testing.T) {
 	}
 }
 
-func TestNoteInteractor_Get(t *testing.T) {
+func TestNoteInteractor_Search(t *testing.T) {
+	results := []note.SearchResult{{Note: note.WithMeta{Note: note.Note{ID: "n1"}}, Rank: 3}}
 	tests := []struct {
 		name      string
-		id        string
-		result    *note.WithMeta
+		filters   note.Filters
+		repoArg   note.Filters
 		repoErr   error
 		wantError error
 	}{
 		{
-			name:   "[Success] get note",
-			id:     "n1",
-			result: &note.WithMeta{Note: note.Note{ID: "n1"}},
+			name:    "[Success] keyword is trimmed and viewer applied",
+			filters: note.Filters{Query: strPtr(" 設計 "), SearchIn: []note.SearchTarget{note.SearchContent}},
+			repoArg: note.Filters{Query: strPtr("設計"), SearchIn: []note.SearchTarget{note.SearchContent}, ViewerID: "viewer"},
+		},
+		{
+			name:      "[Fail] keyword missing",
+			filters:   note.Filters{Query: strPtr("  ")},
+			wantError: domainerr.ErrSearchQueryRequired,
+		},
+		{
+			name:      "[Fail] invalid search target",
+			filters:   note.Filters{Query: strPtr("設計"), SearchIn: []note.SearchTarget{"body"}},
+			wantError: domainerr.ErrInvalidSearchTarget,
+		},
+		{
+			name:      "[Fail] repo error",
+			filters:   note.Filters{Query: strPtr("設計")},
+			repoArg:   note.Filters{Query: strPtr("設計"), ViewerID: "viewer"},
+			repoErr:   errors.New("repo err"),
+			wantError: errors.New("repo err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			if tt.repoArg.ViewerID != "" {
+				notes.EXPECT().Search(gomock.Any(), tt.repoArg).Return(results, tt.repoErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentSearchResults(gomock.Any(), results).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notes, templates, revisions, links, tx, events, auditLog, out)
+			err := interactor.Search(context.Background(), tt.filters, "viewer")
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_Get(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		viewerID      string
+		result        *note.WithMeta
+		repoErr       error
+		wantLinks     int
+		wantBacklinks int
+		wantError     error
+	}{
+		{
+			name:     "[Success] get own draft",
+			id:       "n1",
+			viewerID: "owner",
+			result:   &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner", Status: note.StatusDraft}},
+		},
+		{
+			name:     "[Success] get others' published note",
+			id:       "n1",
+			viewerID: "viewer",
+			result:   &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner", Status: note.StatusPublish}},
+		},
+		{
+			name:     "[Success] links to others' drafts are hidden",
+			id:       "n1",
+			viewerID: "viewer",
+			result: &note.WithMeta{
+				Note: note.Note{ID: "n1", OwnerID: "viewer", Status: note.StatusDraft},
+				Links: []note.LinkSummary{
+					{LinkID: "l1", NoteID: "n2", OwnerID: "owner", Status: note.StatusPublish},
+					{LinkID: "l2", NoteID: "n3", OwnerID: "owner", Status: note.StatusDraft},
+				},
+				Backlinks: []note.LinkSummary{{LinkID: "l3", NoteID: "n4", OwnerID: "owner", Status: note.StatusDraft}},
+			},
+			wantLinks:     1,
+			wantBacklinks: 0,
+		},
+		{
+			name:     "[Success] collaborator gets a shared draft",
+			id:       "n1",
+			viewerID: "viewer",
+			result: &note.WithMeta{Note: note.Note{
+				ID: "n1", OwnerID: "owner", Status: note.StatusDraft,
+				Collaborators: []note.Collaborator{{NoteID: "n1", AccountID: "viewer", Role: note.RoleViewer}},
+			}},
+		},
+		{
+			name:      "[Fail] others' draft is not found",
+			id:        "n1",
+			viewerID:  "viewer",
+			result:    &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner", Status: note.StatusDraft}},
+			wantError: domainerr.ErrNotFound,
 		},
 		{
 			name:      "[Fail] not found",
 			id:        "missing",
+			viewerID:  "viewer",
 			repoErr:   domainerr.ErrNotFound,
 			wantError: domainerr.ErrNotFound,
 		},
@@ -93,19 +266,26 @@ func TestNoteInteractor_Get(t *testing.T) {
 			notes := mockusecase.NewMockNoteRepository(ctrl)
 			templates := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
 			notes.EXPECT().Get(gomock.Any(), tt.id).Return(tt.result, tt.repoErr)
-			if tt.repoErr == nil {
+			if tt.wantError == nil {
 				out.EXPECT().PresentNote(gomock.Any(), tt.result).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notes, templates, tx, out)
-			err := interactor.Get(context.Background(), tt.id)
+			interactor := uc.NewNoteInteractor(notes, templates, revisions, links, tx, events, auditLog, out)
+			err := interactor.Get(context.Background(), tt.id, tt.viewerID)
 
 			if tt.wantError == nil && err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
+			if tt.wantError == nil && (len(tt.result.Links) != tt.wantLinks || len(tt.result.Backlinks) != tt.wantBacklinks) {
+				t.Fatalf("unexpected links: %+v / %+v", tt.result.Links, tt.result.Backlinks)
+			}
 			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
 				t.Fatalf("want %v, got %v", tt.wantError, err)
 			}
@@ -123,6 +303,7 @@ func TestNoteInteractor_Create(t *testing.T) {
 		getTplErr   error
 		createErr   error
 		replaceErr  error
+		wantTags    []note.Tag
 		wantError   error
 		expectTxRun bool
 	}{
@@ -135,10 +316,35 @@ func TestNoteInteractor_Create(t *testing.T) {
 				Sections:   validSections,
 			},
 			tpl: &template.WithUsage{
-				Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: templateFields},
+				Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: templateFields, FieldsVersion: 3},
 			},
 			expectTxRun: true,
 		},
+		{
+			name: "[Success] create with tags",
+			input: port.NoteCreateInput{
+				Title:      "Hello",
+				TemplateID: "tpl-1",
+				OwnerID:    "owner-1",
+				Sections:   validSections,
+				Tags:       []string{"Billing", "auth"},
+			},
+			tpl:         &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: templateFields}},
+			wantTags:    []note.Tag{"auth", "billing"},
+			expectTxRun: true,
+		},
+		{
+			name: "[Fail] invalid tag",
+			input: port.NoteCreateInput{
+				Title:      "Hello",
+				TemplateID: "tpl-1",
+				OwnerID:    "owner-1",
+				Sections:   validSections,
+				Tags:       []string{"a,b"},
+			},
+			tpl:       &template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "tpl", OwnerID: "owner-1", Fields: templateFields}},
+			wantError: domainerr.ErrInvalidTag,
+		},
 		{
 			name: "[Fail] sections missing",
 			input: port.NoteCreateInput{
@@ -210,6 +416,10 @@ func TestNoteInteractor_Create(t *testing.T) {
 			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
 			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
 			tplRepo.EXPECT().Get(gomock.Any(), tt.input.TemplateID).Return(tt.tpl, tt.getTplErr)
@@ -221,17 +431,33 @@ func TestNoteInteractor_Create(t *testing.T) {
 				)
 			}
 			if tt.getTplErr == nil && tt.expectTxRun {
-				notesRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&note.Note{ID: "note-1", TemplateID: tt.input.TemplateID, OwnerID: tt.input.OwnerID}, tt.createErr)
+				notesRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n note.Note) (*note.Note, error) {
+					if n.TemplateVersion != tt.tpl.Template.FieldsVersion {
+						t.Fatalf("template version = %d, want %d", n.TemplateVersion, tt.tpl.Template.FieldsVersion)
+					}
+					return &note.Note{ID: "note-1", TemplateID: tt.input.TemplateID, OwnerID: tt.input.OwnerID}, tt.createErr
+				})
 				if tt.createErr == nil {
 					notesRepo.EXPECT().ReplaceSections(gomock.Any(), "note-1", gomock.Any()).Return(tt.replaceErr)
 				}
+				if tt.createErr == nil && tt.replaceErr == nil && len(tt.wantTags) > 0 {
+					notesRepo.EXPECT().ReplaceTags(gomock.Any(), "note-1", tt.wantTags).Return(nil)
+				}
 			}
 			if tt.getTplErr == nil && tt.createErr == nil && tt.replaceErr == nil && tt.wantError == nil {
 				notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: tt.input.OwnerID, TemplateID: tt.input.TemplateID}}, nil)
+				revisions.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rev note.Revision) (*note.Revision, error) {
+					if rev.NoteID != "note-1" || rev.ActorID != tt.input.OwnerID {
+						t.Fatalf("unexpected revision: %+v", rev)
+					}
+					return &rev, nil
+				})
+				events.EXPECT().Publish(gomock.Any(), event.NewNoteCreated(note.Note{ID: "note-1", OwnerID: tt.input.OwnerID, TemplateID: tt.input.TemplateID}, tt.input.OwnerID)).Return(nil)
+				auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
 				out.EXPECT().PresentNote(gomock.Any(), gomock.Any()).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, revisions, links, tx, events, auditLog, out)
 			err := interactor.Create(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -262,7 +488,9 @@ func TestNoteInteractor_Update(t *testing.T) {
 		getErr       error
 		updateErr    error
 		replaceErr   error
+		revisionErr  error
 		tpl          *template.WithUsage
+		wantTags     []note.Tag
 		wantError    error
 		expectTxRun  bool
 		withSections bool
@@ -291,13 +519,51 @@ func TestNoteInteractor_Update(t *testing.T) {
 				},
 			},
 			current: &note.WithMeta{
-				Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1"},
+				Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 2},
 				Sections: existingSections,
 			},
 			tpl:          &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Fields: templateFields}},
 			expectTxRun:  true,
 			withSections: true,
 		},
+		{
+			name: "[Success] add and remove tags",
+			input: port.NoteUpdateInput{
+				ID:         "note-1",
+				Title:      "new",
+				OwnerID:    "owner-1",
+				AddTags:    []string{"API"},
+				RemoveTags: []string{"billing"},
+			},
+			current:     &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1"}, Tags: []note.Tag{"auth", "billing"}},
+			wantTags:    []note.Tag{"api", "auth"},
+			expectTxRun: true,
+		},
+		{
+			name: "[Success] unchanged tags are not rewritten",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+				AddTags: []string{"auth"},
+			},
+			current:     &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1"}, Tags: []note.Tag{"auth"}},
+			expectTxRun: true,
+		},
+		{
+			name: "[Fail] too many tags",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+				AddTags: []string{"t20"},
+			},
+			current: &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}, Tags: []note.Tag{
+				"t00", "t01", "t02", "t03", "t04", "t05", "t06", "t07", "t08", "t09",
+				"t10", "t11", "t12", "t13", "t14", "t15", "t16", "t17", "t18", "t19",
+			}},
+			wantError: domainerr.ErrTooManyTags,
+		},
 		{
 			name: "[Fail] owner mismatch",
 			input: port.NoteUpdateInput{
@@ -308,6 +574,65 @@ func TestNoteInteractor_Update(t *testing.T) {
 			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}},
 			wantError: domainerr.ErrUnauthorized,
 		},
+		{
+			name: "[Success] editor updates title",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "editor-1",
+			},
+			current: &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Collaborators: []note.Collaborator{
+				{AccountID: "editor-1", Role: note.RoleEditor},
+			}}},
+			expectTxRun: true,
+		},
+		{
+			name: "[Fail] viewer cannot update",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "viewer-1",
+			},
+			current: &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Collaborators: []note.Collaborator{
+				{AccountID: "viewer-1", Role: note.RoleViewer},
+			}}},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name: "[Fail] editor cannot change tags",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "editor-1",
+				AddTags: []string{"api"},
+			},
+			current: &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Collaborators: []note.Collaborator{
+				{AccountID: "editor-1", Role: note.RoleEditor},
+			}}},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name: "[Success] matching version",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+				Version: intPtr(2),
+			},
+			current:     &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", Version: 2}},
+			expectTxRun: true,
+		},
+		{
+			name: "[Fail] version conflict",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+				Version: intPtr(1),
+			},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Version: 2}},
+			wantError: domainerr.ErrConflict,
+		},
 		{
 			name: "[Fail] empty title",
 			input: port.NoteUpdateInput{
@@ -360,6 +685,18 @@ func TestNoteInteractor_Update(t *testing.T) {
 			expectTxRun:  true,
 			withSections: true,
 		},
+		{
+			name: "[Fail] record revision error",
+			input: port.NoteUpdateInput{
+				ID:      "note-1",
+				Title:   "new",
+				OwnerID: "owner-1",
+			},
+			current:     &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1"}},
+			revisionErr: errors.New("revision err"),
+			wantError:   errors.New("revision err"),
+			expectTxRun: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -370,6 +707,10 @@ func TestNoteInteractor_Update(t *testing.T) {
 			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
 			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
 			notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, tt.getErr)
@@ -379,18 +720,33 @@ func TestNoteInteractor_Update(t *testing.T) {
 						return fn(context.Background())
 					},
 				)
-				notesRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(&tt.current.Note, tt.updateErr)
+				notesRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, n note.Note) (*note.Note, error) {
+						if n.Version != tt.current.Note.Version {
+							t.Fatalf("update version = %d, want %d", n.Version, tt.current.Note.Version)
+						}
+						return &tt.current.Note, tt.updateErr
+					},
+				)
 				if tt.updateErr == nil && tt.withSections {
-					tplRepo.EXPECT().Get(gomock.Any(), tt.current.Note.TemplateID).Return(tt.tpl, nil)
+					tplRepo.EXPECT().GetFields(gomock.Any(), tt.current.Note.TemplateID, tt.current.Note.TemplateVersion).Return(tt.tpl.Template.Fields, nil)
 					notesRepo.EXPECT().ReplaceSections(gomock.Any(), tt.input.ID, gomock.Any()).Return(tt.replaceErr)
 				}
+				if tt.updateErr == nil && tt.wantTags != nil {
+					notesRepo.EXPECT().ReplaceTags(gomock.Any(), tt.input.ID, tt.wantTags).Return(nil)
+				}
 			}
 			if tt.getErr == nil && tt.expectTxRun && tt.updateErr == nil && (!tt.withSections || tt.replaceErr == nil) {
 				notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
-				out.EXPECT().PresentNote(gomock.Any(), tt.current).Return(nil)
+				revisions.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&note.Revision{NoteID: tt.input.ID, Number: 2}, tt.revisionErr)
+				if tt.revisionErr == nil {
+					events.EXPECT().Publish(gomock.Any(), event.NewNoteUpdated(tt.current.Note, tt.input.OwnerID)).Return(nil)
+					auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
+					out.EXPECT().PresentNote(gomock.Any(), tt.current).Return(nil)
+				}
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, revisions, links, tx, events, auditLog, out)
 			err := interactor.Update(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -403,6 +759,274 @@ func TestNoteInteractor_Update(t *testing.T) {
 	}
 }
 
+func TestNoteInteractor_MigrateTemplate(t *testing.T) {
+	currentSections := []note.SectionWithField{
+		{Section: note.Section{ID: "sec1", NoteID: "note-1", FieldID: "f1-v1", Content: "old"}, FieldLabel: "Title", FieldOrder: 1},
+	}
+	latest := &template.WithUsage{Template: template.Template{
+		ID:            "tpl-1",
+		OwnerID:       "owner-1",
+		FieldsVersion: 2,
+		Fields: []template.Field{
+			{ID: "f1-v2", Label: "Title", Order: 1, IsRequired: true},
+			{ID: "f2-v2", Label: "Memo", Order: 2},
+		},
+	}}
+	mapping := []note.FieldMapping{{FromFieldID: "f1-v1", ToFieldID: "f1-v2"}}
+
+	tests := []struct {
+		name         string
+		input        port.NoteMigrateTemplateInput
+		current      *note.WithMeta
+		migrateErr   error
+		wantError    error
+		expectTplGet bool
+		expectTxRun  bool
+	}{
+		{
+			name:  "[Success] migrate to latest version",
+			input: port.NoteMigrateTemplateInput{ID: "note-1", OwnerID: "owner-1", Mappings: mapping},
+			current: &note.WithMeta{
+				Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 1, Version: 4},
+				Sections: currentSections,
+			},
+			expectTplGet: true,
+			expectTxRun:  true,
+		},
+		{
+			name:  "[Success] already on latest version",
+			input: port.NoteMigrateTemplateInput{ID: "note-1", OwnerID: "owner-1"},
+			current: &note.WithMeta{
+				Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 2},
+			},
+			expectTplGet: true,
+		},
+		{
+			name:      "[Fail] owner mismatch",
+			input:     port.NoteMigrateTemplateInput{ID: "note-1", OwnerID: "other", Mappings: mapping},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 1}},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] version conflict",
+			input:     port.NoteMigrateTemplateInput{ID: "note-1", OwnerID: "owner-1", Mappings: mapping, Version: intPtr(3)},
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 1, Version: 4}},
+			wantError: domainerr.ErrConflict,
+		},
+		{
+			name:  "[Fail] invalid mapping",
+			input: port.NoteMigrateTemplateInput{ID: "note-1", OwnerID: "owner-1", Mappings: []note.FieldMapping{{FromFieldID: "unknown", ToFieldID: "f1-v2"}}},
+			current: &note.WithMeta{
+				Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 1},
+				Sections: currentSections,
+			},
+			expectTplGet: true,
+			wantError:    domainerr.ErrInvalidFieldMapping,
+		},
+		{
+			name:  "[Fail] concurrent update",
+			input: port.NoteMigrateTemplateInput{ID: "note-1", OwnerID: "owner-1", Mappings: mapping},
+			current: &note.WithMeta{
+				Note:     note.Note{ID: "note-1", OwnerID: "owner-1", TemplateID: "tpl-1", TemplateVersion: 1, Version: 4},
+				Sections: currentSections,
+			},
+			migrateErr:   domainerr.ErrConflict,
+			wantError:    domainerr.ErrConflict,
+			expectTplGet: true,
+			expectTxRun:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
+			if tt.expectTplGet {
+				tplRepo.EXPECT().Get(gomock.Any(), tt.current.Note.TemplateID).Return(latest, nil)
+			}
+			if tt.expectTxRun {
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, fn func(context.Context) error) error {
+						return fn(context.Background())
+					},
+				)
+				notesRepo.EXPECT().UpdateTemplateVersion(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, n note.Note, sections []note.Section) (*note.Note, error) {
+						if n.TemplateVersion != latest.Template.FieldsVersion || n.Version != tt.current.Note.Version {
+							t.Fatalf("unexpected pin: %+v", n)
+						}
+						if len(sections) != len(latest.Template.Fields) || sections[0].Content != "old" {
+							t.Fatalf("unexpected sections: %+v", sections)
+						}
+						return &n, tt.migrateErr
+					},
+				)
+				if tt.migrateErr == nil {
+					tplRepo.EXPECT().PruneFieldVersions(gomock.Any(), "tpl-1").Return(nil)
+					notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
+					revisions.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&note.Revision{NoteID: tt.input.ID, Number: 2}, nil)
+					events.EXPECT().Publish(gomock.Any(), event.NewNoteUpdated(tt.current.Note, tt.input.OwnerID)).Return(nil)
+					auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
+				}
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNote(gomock.Any(), tt.current).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, revisions, links, tx, events, auditLog, out)
+			err := interactor.MigrateTemplate(context.Background(), tt.input)
+
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_ExportMarkdown(t *testing.T) {
+	draft := &note.WithMeta{
+		Note:     note.Note{ID: "n1", Title: "Hello", OwnerID: "owner", Status: note.StatusDraft},
+		Sections: []note.SectionWithField{{Section: note.Section{FieldID: "f1", Content: "body"}, FieldLabel: "Body", FieldOrder: 1}},
+	}
+	tests := []struct {
+		name      string
+		viewerID  string
+		result    *note.WithMeta
+		repoErr   error
+		wantError error
+	}{
+		{name: "[Success] export own draft", viewerID: "owner", result: draft},
+		{name: "[Fail] others' draft is not found", viewerID: "viewer", result: draft, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] not found", viewerID: "owner", repoErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(tt.result, tt.repoErr)
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteMarkdown(gomock.Any(), tt.result, note.RenderMarkdown(*tt.result)).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notes, mockusecase.NewMockTemplateRepository(ctrl), mockusecase.NewMockNoteRevisionRepository(ctrl), mockusecase.NewMockNoteLinkRepository(ctrl), mockusecase.NewMockTxManager(ctrl), mockusecase.NewMockEventPublisher(ctrl), mockusecase.NewMockAuditRecorder(ctrl), out)
+			err := interactor.ExportMarkdown(context.Background(), "n1", tt.viewerID)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteInteractor_ImportMarkdown(t *testing.T) {
+	tpl := &template.WithUsage{Template: template.Template{
+		ID:      "tpl-1",
+		Name:    "tpl",
+		OwnerID: "owner-1",
+		Fields: []template.Field{
+			{ID: "f1", Label: "Problem", Order: 1, IsRequired: true},
+			{ID: "f2", Label: "Decision", Order: 2},
+		},
+		FieldsVersion: 2,
+	}}
+	tests := []struct {
+		name         string
+		markdown     string
+		getTplErr    error
+		wantSections []note.Section
+		wantError    error
+	}{
+		{
+			name:     "[Success] import note",
+			markdown: "# Hello\n\n## Problem\n\nslow\n",
+			wantSections: []note.Section{
+				{NoteID: "note-1", FieldID: "f1", Content: "slow"},
+				{NoteID: "note-1", FieldID: "f2", Content: ""},
+			},
+		},
+		{name: "[Fail] template get error", markdown: "# Hello\n", getTplErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] unmatched heading", markdown: "# Hello\n## Problem\nx\n## Risks\ny\n", wantError: domainerr.ErrUnmatchedHeading},
+		{name: "[Fail] missing required heading", markdown: "# Hello\n## Decision\nx\n", wantError: domainerr.ErrMissingHeading},
+		{name: "[Fail] required heading without content", markdown: "# Hello\n## Problem\n", wantError: domainerr.ErrRequiredFieldEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
+			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			if tt.getTplErr != nil {
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(nil, tt.getTplErr)
+			} else {
+				// Once to read the fields for parsing, once more by Create.
+				tplRepo.EXPECT().Get(gomock.Any(), "tpl-1").Return(tpl, nil).MinTimes(1).MaxTimes(2)
+			}
+			if tt.wantError == nil {
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, fn func(context.Context) error) error {
+						return fn(context.Background())
+					},
+				)
+				notesRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n note.Note) (*note.Note, error) {
+					if n.Title != "Hello" || n.OwnerID != "owner-1" || n.Status != note.StatusDraft {
+						t.Fatalf("unexpected note: %+v", n)
+					}
+					return &note.Note{ID: "note-1", TemplateID: "tpl-1", OwnerID: "owner-1"}, nil
+				})
+				notesRepo.EXPECT().ReplaceSections(gomock.Any(), "note-1", tt.wantSections).Return(nil)
+				notesRepo.EXPECT().Get(gomock.Any(), "note-1").Return(&note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}}, nil)
+				revisions.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rev note.Revision) (*note.Revision, error) {
+					return &rev, nil
+				})
+				events.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+				auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
+				out.EXPECT().PresentNote(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, revisions, mockusecase.NewMockNoteLinkRepository(ctrl), tx, events, auditLog, out)
+			err := interactor.ImportMarkdown(context.Background(), port.NoteImportMarkdownInput{
+				TemplateID: "tpl-1",
+				OwnerID:    "owner-1",
+				Markdown:   tt.markdown,
+			})
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
 func TestNoteInteractor_ChangeStatus(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -462,19 +1086,31 @@ func TestNoteInteractor_ChangeStatus(t *testing.T) {
 			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
 			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
 			notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, tt.getErr)
 			shouldUpdate := tt.getErr == nil && (tt.wantError == nil || (tt.updateErr != nil && tt.wantError.Error() == tt.updateErr.Error()))
 			if shouldUpdate {
+				tx.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, fn func(context.Context) error) error {
+						return fn(context.Background())
+					},
+				)
 				notesRepo.EXPECT().UpdateStatus(gomock.Any(), tt.input.ID, tt.input.Status).Return(&tt.current.Note, tt.updateErr)
 			}
 			if tt.getErr == nil && tt.wantError == nil && tt.updateErr == nil {
 				notesRepo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
+				revisions.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&note.Revision{NoteID: tt.input.ID, Number: 2}, nil)
+				events.EXPECT().Publish(gomock.Any(), event.NewNoteStatusChanged(tt.current.Note, tt.input.OwnerID)).Return(nil)
+				auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
 				out.EXPECT().PresentNote(gomock.Any(), tt.current).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, revisions, links, tx, events, auditLog, out)
 			err := interactor.ChangeStatus(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -495,11 +1131,12 @@ func TestNoteInteractor_Delete(t *testing.T) {
 		current   *note.WithMeta
 		getErr    error
 		deleteErr error
+		auditErr  error
 		wantError error
 		expectDel bool
 	}{
 		{
-			name:      "[Success] delete",
+			name:      "[Success] delete moves to trash",
 			id:        "note-1",
 			ownerID:   "owner-1",
 			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}},
@@ -528,6 +1165,15 @@ func TestNoteInteractor_Delete(t *testing.T) {
 			wantError: errors.New("delete err"),
 			expectDel: true,
 		},
+		{
+			name:      "[Fail] audit error",
+			id:        "note-1",
+			ownerID:   "owner-1",
+			current:   &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1"}},
+			auditErr:  errors.New("audit err"),
+			wantError: errors.New("audit err"),
+			expectDel: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -538,17 +1184,32 @@ func TestNoteInteractor_Delete(t *testing.T) {
 			notesRepo := mockusecase.NewMockNoteRepository(ctrl)
 			tplRepo := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
 			out := mockusecase.NewMockNoteOutputPort(ctrl)
 
 			notesRepo.EXPECT().Get(gomock.Any(), tt.id).Return(tt.current, tt.getErr)
 			if tt.getErr == nil && tt.expectDel {
-				notesRepo.EXPECT().Delete(gomock.Any(), tt.id).Return(tt.deleteErr)
+				runInTx(tx)
+				// Links are kept so a restore brings them back.
+				notesRepo.EXPECT().Trash(gomock.Any(), tt.id).Return(tt.deleteErr)
+				if tt.deleteErr == nil {
+					events.EXPECT().Publish(gomock.Any(), event.NewNoteDeleted(tt.current.Note, tt.ownerID)).Return(nil)
+					// The trashed state is read back for the audit entry.
+					trashedAt := time.Now()
+					trashed := &note.WithMeta{Note: tt.current.Note}
+					trashed.Note.DeletedAt = &trashedAt
+					notesRepo.EXPECT().GetTrashed(gomock.Any(), tt.id).Return(trashed, nil)
+					auditLog.EXPECT().Record(gomock.Any(), audit.NoteEntry(audit.ActionDelete, tt.ownerID, tt.current, trashed)).Return(tt.auditErr)
+				}
 			}
 			if tt.getErr == nil && tt.wantError == nil && tt.deleteErr == nil {
 				out.EXPECT().PresentNoteDeleted(gomock.Any()).Return(nil)
 			}
 
-			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, tx, out)
+			interactor := uc.NewNoteInteractor(notesRepo, tplRepo, revisions, links, tx, events, auditLog, out)
 			err := interactor.Delete(context.Background(), tt.id, tt.ownerID)
 
 			if tt.wantError == nil && err != nil {
@@ -562,3 +1223,179 @@ func TestNoteInteractor_Delete(t *testing.T) {
 }
 
 // b2i converts bool to int for Times() convenience.
+
+func TestNoteInteractor_ListTrash(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	n1 := note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner-1", DeletedAt: &deletedAt}}
+	n2 := note.WithMeta{Note: note.Note{ID: "n2", OwnerID: "owner-1", DeletedAt: &deletedAt}}
+	tests := []struct {
+		name      string
+		filters   trash.Filters
+		repoArg   *trash.Filters
+		result    []note.WithMeta
+		want      page.Page[note.WithMeta]
+		repoErr   error
+		wantError error
+	}{
+		{
+			name:    "[Success] list trash",
+			filters: trash.Filters{OwnerID: "owner-1"},
+			repoArg: &trash.Filters{OwnerID: "owner-1", Limit: page.DefaultLimit + 1},
+			result:  []note.WithMeta{n1},
+			want:    page.Page[note.WithMeta]{Items: []note.WithMeta{n1}},
+		},
+		{
+			name:    "[Success] next page is keyed by deleted_at",
+			filters: trash.Filters{OwnerID: "owner-1", Limit: 1},
+			repoArg: &trash.Filters{OwnerID: "owner-1", Limit: 2},
+			result:  []note.WithMeta{n1, n2},
+			want: page.Page[note.WithMeta]{
+				Items:      []note.WithMeta{n1},
+				NextCursor: &page.Cursor{UpdatedAt: deletedAt, ID: "n1"},
+			},
+		},
+		{
+			name:      "[Fail] owner required",
+			filters:   trash.Filters{},
+			wantError: domainerr.ErrOwnerRequired,
+		},
+		{
+			name:      "[Fail] repo error",
+			filters:   trash.Filters{OwnerID: "owner-1"},
+			repoArg:   &trash.Filters{OwnerID: "owner-1", Limit: page.DefaultLimit + 1},
+			repoErr:   errors.New("repo err"),
+			wantError: errors.New("repo err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			if tt.repoArg != nil {
+				notes.EXPECT().ListTrash(gomock.Any(), *tt.repoArg).Return(tt.result, tt.repoErr)
+			}
+			if tt.repoArg != nil && tt.repoErr == nil {
+				out.EXPECT().PresentNoteList(gomock.Any(), tt.want).Return(nil)
+			}
+
+			interactor := uc.NewNoteInteractor(notes, mockusecase.NewMockTemplateRepository(ctrl), mockusecase.NewMockNoteRevisionRepository(ctrl),
+				mockusecase.NewMockNoteLinkRepository(ctrl), mockusecase.NewMockTxManager(ctrl), mockusecase.NewMockEventPublisher(ctrl), mockusecase.NewMockAuditRecorder(ctrl), out)
+			assertErr(t, tt.wantError, interactor.ListTrash(context.Background(), tt.filters))
+		})
+	}
+}
+
+func TestNoteInteractor_Restore(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	trashed := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", Title: "ADR", DeletedAt: &deletedAt}}
+	restored := &note.WithMeta{
+		Note: note.Note{ID: "note-1", OwnerID: "owner-1", Title: "ADR"},
+		Backlinks: []note.LinkSummary{
+			{LinkID: "l1", NoteID: "n2", Title: "Someone's draft", OwnerID: "other", Status: note.StatusDraft},
+			{LinkID: "l2", NoteID: "n3", Title: "My draft", OwnerID: "owner-1", Status: note.StatusDraft},
+		},
+	}
+	tests := []struct {
+		name       string
+		ownerID    string
+		getErr     error
+		restoreErr error
+		wantError  error
+	}{
+		{name: "[Success] restore", ownerID: "owner-1"},
+		{name: "[Fail] not in trash", ownerID: "owner-1", getErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] other account's trash is not found", ownerID: "other", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] restore error", ownerID: "owner-1", restoreErr: errors.New("restore err"), wantError: errors.New("restore err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notes.EXPECT().GetTrashed(gomock.Any(), "note-1").Return(trashed, tt.getErr)
+			if tt.getErr == nil && tt.ownerID == "owner-1" {
+				runInTx(tx)
+				notes.EXPECT().Restore(gomock.Any(), "note-1").Return(tt.restoreErr)
+				if tt.restoreErr == nil {
+					events.EXPECT().Publish(gomock.Any(), event.NewNoteRestored(trashed.Note, "owner-1")).Return(nil)
+					notes.EXPECT().Get(gomock.Any(), "note-1").Return(restored, nil)
+					auditLog.EXPECT().Record(gomock.Any(), audit.NoteEntry(audit.ActionRestore, "owner-1", trashed, restored)).Return(nil)
+					out.EXPECT().PresentNote(gomock.Any(), restored).DoAndReturn(func(_ context.Context, n *note.WithMeta) error {
+						// Backlinks from another account's draft are hidden, as on every other read.
+						if len(n.Backlinks) != 1 || n.Backlinks[0].NoteID != "n3" {
+							t.Errorf("backlinks = %+v, want only n3", n.Backlinks)
+						}
+						return nil
+					})
+				}
+			}
+
+			interactor := uc.NewNoteInteractor(notes, mockusecase.NewMockTemplateRepository(ctrl), mockusecase.NewMockNoteRevisionRepository(ctrl),
+				mockusecase.NewMockNoteLinkRepository(ctrl), tx, events, auditLog, out)
+			assertErr(t, tt.wantError, interactor.Restore(context.Background(), "note-1", tt.ownerID))
+		})
+	}
+}
+
+func TestNoteInteractor_Purge(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	trashed := &note.WithMeta{Note: note.Note{ID: "note-1", OwnerID: "owner-1", DeletedAt: &deletedAt}}
+	tests := []struct {
+		name      string
+		ownerID   string
+		getErr    error
+		linkErr   error
+		deleteErr error
+		wantError error
+	}{
+		{name: "[Success] purge with links", ownerID: "owner-1"},
+		{name: "[Fail] not in trash", ownerID: "owner-1", getErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] other account's trash is not found", ownerID: "other", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] link cleanup error", ownerID: "owner-1", linkErr: errors.New("link err"), wantError: errors.New("link err")},
+		{name: "[Fail] delete error", ownerID: "owner-1", deleteErr: errors.New("delete err"), wantError: errors.New("delete err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			out := mockusecase.NewMockNoteOutputPort(ctrl)
+
+			notes.EXPECT().GetTrashed(gomock.Any(), "note-1").Return(trashed, tt.getErr)
+			if tt.getErr == nil && tt.ownerID == "owner-1" {
+				runInTx(tx)
+				links.EXPECT().DeleteByNote(gomock.Any(), "note-1").Return(tt.linkErr)
+				if tt.linkErr == nil {
+					notes.EXPECT().Delete(gomock.Any(), "note-1").Return(tt.deleteErr)
+				}
+				if tt.linkErr == nil && tt.deleteErr == nil {
+					events.EXPECT().Publish(gomock.Any(), event.NewNotePurged(trashed.Note, "owner-1")).Return(nil)
+					auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
+					out.EXPECT().PresentNoteDeleted(gomock.Any()).Return(nil)
+				}
+			}
+
+			interactor := uc.NewNoteInteractor(notes, mockusecase.NewMockTemplateRepository(ctrl), mockusecase.NewMockNoteRevisionRepository(ctrl),
+				links, tx, events, auditLog, out)
+			assertErr(t, tt.wantError, interactor.Purge(context.Background(), "note-1", tt.ownerID))
+		})
+	}
+}
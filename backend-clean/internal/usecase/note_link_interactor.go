@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteLinkInteractor handles links between notes.
+type NoteLinkInteractor struct {
+	notes  port.NoteRepository
+	links  port.NoteLinkRepository
+	output port.NoteLinkOutputPort
+}
+
+var _ port.NoteLinkInputPort = (*NoteLinkInteractor)(nil)
+
+// NewNoteLinkInteractor creates NoteLinkInteractor.
+func NewNoteLinkInteractor(notes port.NoteRepository, links port.NoteLinkRepository, output port.NoteLinkOutputPort) *NoteLinkInteractor {
+	return &NoteLinkInteractor{
+		notes:  notes,
+		links:  links,
+		output: output,
+	}
+}
+
+// List returns outgoing links and backlinks of a note, limited to notes the viewer may read.
+func (u *NoteLinkInteractor) List(ctx context.Context, noteID, viewerID string) error {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if !note.CanView(n.Note, viewerID) {
+		return domainerr.ErrNotFound
+	}
+	outgoing, err := u.links.ListOutgoing(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	backlinks, err := u.links.ListBacklinks(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNoteLinks(ctx, note.VisibleLinks(outgoing, viewerID), note.VisibleLinks(backlinks, viewerID))
+}
+
+// Create links the source note to the target note.
+// Only the source note's owner may add links, and only to notes they can read.
+func (u *NoteLinkInteractor) Create(ctx context.Context, input port.NoteLinkCreateInput) error {
+	source, err := u.notes.Get(ctx, input.SourceID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteOwnership(source.Note.OwnerID, input.ActorID); err != nil {
+		return err
+	}
+	link, err := note.NewLink(input.SourceID, input.TargetID, input.Type)
+	if err != nil {
+		return err
+	}
+	target, err := u.notes.Get(ctx, input.TargetID)
+	if err != nil {
+		return err
+	}
+	if !note.CanView(target.Note, input.ActorID) {
+		return domainerr.ErrNotFound
+	}
+	created, err := u.links.Create(ctx, link)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentNoteLink(ctx, &note.LinkSummary{
+		LinkID:  created.ID,
+		Type:    created.Type,
+		NoteID:  target.Note.ID,
+		Title:   target.Note.Title,
+		Status:  target.Note.Status,
+		OwnerID: target.Note.OwnerID,
+	})
+}
+
+// Delete removes a link from the note. Only the note's owner may remove links.
+func (u *NoteLinkInteractor) Delete(ctx context.Context, noteID, linkID, actorID string) error {
+	source, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if err := note.ValidateNoteOwnership(source.Note.OwnerID, actorID); err != nil {
+		return err
+	}
+	if err := u.links.Delete(ctx, noteID, linkID); err != nil {
+		return err
+	}
+	return u.output.PresentNoteLinkDeleted(ctx)
+}
@@ -0,0 +1,222 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNoteLinkInteractor_List(t *testing.T) {
+	published := note.LinkSummary{LinkID: "l1", NoteID: "n2", OwnerID: "other", Status: note.StatusPublish}
+	othersDraft := note.LinkSummary{LinkID: "l2", NoteID: "n3", OwnerID: "other", Status: note.StatusDraft}
+	tests := []struct {
+		name          string
+		current       *note.WithMeta
+		viewerID      string
+		outgoingErr   error
+		wantOutgoing  int
+		wantBacklinks int
+		wantError     error
+	}{
+		{
+			name:          "[Success] others' drafts are hidden",
+			current:       &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "viewer", Status: note.StatusDraft}},
+			viewerID:      "viewer",
+			wantOutgoing:  1,
+			wantBacklinks: 1,
+		},
+		{
+			name:      "[Fail] others' draft note is not found",
+			current:   &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "other", Status: note.StatusDraft}},
+			viewerID:  "viewer",
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:        "[Fail] repo error",
+			current:     &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "viewer"}},
+			viewerID:    "viewer",
+			outgoingErr: errors.New("repo err"),
+			wantError:   errors.New("repo err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
+			out := mockusecase.NewMockNoteLinkOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(tt.current, nil)
+			visible := note.CanView(tt.current.Note, tt.viewerID)
+			if visible {
+				links.EXPECT().ListOutgoing(gomock.Any(), "n1").Return([]note.LinkSummary{published, othersDraft}, tt.outgoingErr)
+			}
+			if visible && tt.outgoingErr == nil {
+				links.EXPECT().ListBacklinks(gomock.Any(), "n1").Return([]note.LinkSummary{othersDraft, published}, nil)
+				out.EXPECT().PresentNoteLinks(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, outgoing, backlinks []note.LinkSummary) error {
+						if len(outgoing) != tt.wantOutgoing || len(backlinks) != tt.wantBacklinks {
+							t.Fatalf("unexpected links: %+v / %+v", outgoing, backlinks)
+						}
+						return nil
+					},
+				)
+			}
+
+			interactor := uc.NewNoteLinkInteractor(notes, links, out)
+			err := interactor.List(context.Background(), "n1", tt.viewerID)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteLinkInteractor_Create(t *testing.T) {
+	source := &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner", Status: note.StatusDraft}}
+	tests := []struct {
+		name      string
+		input     port.NoteLinkCreateInput
+		target    *note.WithMeta
+		targetErr error
+		createErr error
+		wantError error
+	}{
+		{
+			name:   "[Success] link to published note",
+			input:  port.NoteLinkCreateInput{SourceID: "n1", TargetID: "n2", Type: note.LinkDependsOn, ActorID: "owner"},
+			target: &note.WithMeta{Note: note.Note{ID: "n2", Title: "dep", OwnerID: "other", Status: note.StatusPublish}},
+		},
+		{
+			name:      "[Fail] not owner",
+			input:     port.NoteLinkCreateInput{SourceID: "n1", TargetID: "n2", Type: note.LinkDependsOn, ActorID: "intruder"},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] invalid type",
+			input:     port.NoteLinkCreateInput{SourceID: "n1", TargetID: "n2", Type: "blocks", ActorID: "owner"},
+			wantError: domainerr.ErrInvalidLinkType,
+		},
+		{
+			name:      "[Fail] self link",
+			input:     port.NoteLinkCreateInput{SourceID: "n1", TargetID: "n1", Type: note.LinkRelatesTo, ActorID: "owner"},
+			wantError: domainerr.ErrSelfLink,
+		},
+		{
+			name:      "[Fail] target is others' draft",
+			input:     port.NoteLinkCreateInput{SourceID: "n1", TargetID: "n2", Type: note.LinkRelatesTo, ActorID: "owner"},
+			target:    &note.WithMeta{Note: note.Note{ID: "n2", OwnerID: "other", Status: note.StatusDraft}},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] target missing",
+			input:     port.NoteLinkCreateInput{SourceID: "n1", TargetID: "n2", Type: note.LinkRelatesTo, ActorID: "owner"},
+			targetErr: domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] repo error",
+			input:     port.NoteLinkCreateInput{SourceID: "n1", TargetID: "n2", Type: note.LinkRelatesTo, ActorID: "owner"},
+			target:    &note.WithMeta{Note: note.Note{ID: "n2", OwnerID: "owner", Status: note.StatusDraft}},
+			createErr: errors.New("repo err"),
+			wantError: errors.New("repo err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
+			out := mockusecase.NewMockNoteLinkOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(source, nil)
+			if tt.target != nil || tt.targetErr != nil {
+				notes.EXPECT().Get(gomock.Any(), "n2").Return(tt.target, tt.targetErr)
+			}
+			if tt.target != nil && note.CanView(tt.target.Note, tt.input.ActorID) {
+				links.EXPECT().Create(gomock.Any(), note.Link{SourceID: "n1", TargetID: "n2", Type: tt.input.Type}).
+					Return(&note.Link{ID: "l1", SourceID: "n1", TargetID: "n2", Type: tt.input.Type}, tt.createErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteLink(gomock.Any(), &note.LinkSummary{
+					LinkID:  "l1",
+					Type:    tt.input.Type,
+					NoteID:  "n2",
+					Title:   tt.target.Note.Title,
+					Status:  tt.target.Note.Status,
+					OwnerID: tt.target.Note.OwnerID,
+				}).Return(nil)
+			}
+
+			interactor := uc.NewNoteLinkInteractor(notes, links, out)
+			err := interactor.Create(context.Background(), tt.input)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteLinkInteractor_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorID   string
+		deleteErr error
+		wantError error
+	}{
+		{name: "[Success] delete link", actorID: "owner"},
+		{name: "[Fail] not owner", actorID: "intruder", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] link not found", actorID: "owner", deleteErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
+			out := mockusecase.NewMockNoteLinkOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(&note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner"}}, nil)
+			if tt.actorID == "owner" {
+				links.EXPECT().Delete(gomock.Any(), "n1", "l1").Return(tt.deleteErr)
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentNoteLinkDeleted(gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewNoteLinkInteractor(notes, links, out)
+			err := interactor.Delete(context.Background(), "n1", "l1", tt.actorID)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
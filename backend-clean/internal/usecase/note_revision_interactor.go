@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// NoteRevisionInteractor handles note revision history use cases.
+type NoteRevisionInteractor struct {
+	notes     port.NoteRepository
+	revisions port.NoteRevisionRepository
+	output    port.NoteRevisionOutputPort
+}
+
+var _ port.NoteRevisionInputPort = (*NoteRevisionInteractor)(nil)
+
+// NewNoteRevisionInteractor creates NoteRevisionInteractor.
+func NewNoteRevisionInteractor(notes port.NoteRepository, revisions port.NoteRevisionRepository, output port.NoteRevisionOutputPort) *NoteRevisionInteractor {
+	return &NoteRevisionInteractor{
+		notes:     notes,
+		revisions: revisions,
+		output:    output,
+	}
+}
+
+// List returns all revisions of a note, newest first.
+func (u *NoteRevisionInteractor) List(ctx context.Context, noteID, viewerID string) error {
+	if err := u.ensureVisible(ctx, noteID, viewerID); err != nil {
+		return err
+	}
+	revisions, err := u.revisions.List(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentRevisionList(ctx, revisions)
+}
+
+// Get returns a single revision of a note.
+func (u *NoteRevisionInteractor) Get(ctx context.Context, noteID string, number int, viewerID string) error {
+	if err := u.ensureVisible(ctx, noteID, viewerID); err != nil {
+		return err
+	}
+	rev, err := u.revisions.Get(ctx, noteID, number)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentRevision(ctx, rev)
+}
+
+// Diff returns per-field line diffs between revision number and base.
+// When base is 0 the previous revision is used; the first revision is compared with an empty note.
+func (u *NoteRevisionInteractor) Diff(ctx context.Context, noteID string, number, base int, viewerID string) error {
+	if err := u.ensureVisible(ctx, noteID, viewerID); err != nil {
+		return err
+	}
+	to, err := u.revisions.Get(ctx, noteID, number)
+	if err != nil {
+		return err
+	}
+	if base == 0 {
+		base = number - 1
+	}
+	from := &note.Revision{NoteID: noteID}
+	if base > 0 {
+		from, err = u.revisions.Get(ctx, noteID, base)
+		if err != nil {
+			return err
+		}
+	}
+	diff := note.DiffRevisions(*from, *to)
+	return u.output.PresentRevisionDiff(ctx, &diff)
+}
+
+// ensureVisible applies the same visibility rule as reading the note itself.
+func (u *NoteRevisionInteractor) ensureVisible(ctx context.Context, noteID, viewerID string) error {
+	n, err := u.notes.Get(ctx, noteID)
+	if err != nil {
+		return err
+	}
+	if !note.CanView(n.Note, viewerID) {
+		return domainerr.ErrNotFound
+	}
+	return nil
+}
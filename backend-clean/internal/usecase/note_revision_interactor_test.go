@@ -0,0 +1,223 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestNoteRevisionInteractor_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		viewerID  string
+		current   *note.WithMeta
+		getErr    error
+		result    []note.Revision
+		repoErr   error
+		wantError error
+	}{
+		{
+			name:     "[Success] owner lists draft revisions",
+			viewerID: "owner",
+			current:  &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner", Status: note.StatusDraft}},
+			result:   []note.Revision{{NoteID: "n1", Number: 2}, {NoteID: "n1", Number: 1}},
+		},
+		{
+			name:      "[Fail] others' draft is not found",
+			viewerID:  "viewer",
+			current:   &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner", Status: note.StatusDraft}},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] note not found",
+			viewerID:  "viewer",
+			getErr:    domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] repo error",
+			viewerID:  "viewer",
+			current:   &note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner", Status: note.StatusPublish}},
+			repoErr:   errors.New("repo err"),
+			wantError: errors.New("repo err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			out := mockusecase.NewMockNoteRevisionOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(tt.current, tt.getErr)
+			visible := tt.getErr == nil && note.CanView(tt.current.Note, tt.viewerID)
+			if visible {
+				revisions.EXPECT().List(gomock.Any(), "n1").Return(tt.result, tt.repoErr)
+			}
+			if visible && tt.repoErr == nil {
+				out.EXPECT().PresentRevisionList(gomock.Any(), tt.result).Return(nil)
+			}
+
+			interactor := uc.NewNoteRevisionInteractor(notes, revisions, out)
+			err := interactor.List(context.Background(), "n1", tt.viewerID)
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && (err == nil || tt.wantError.Error() != err.Error()) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteRevisionInteractor_Get(t *testing.T) {
+	tests := []struct {
+		name      string
+		number    int
+		result    *note.Revision
+		repoErr   error
+		wantError error
+	}{
+		{
+			name:   "[Success] get revision",
+			number: 1,
+			result: &note.Revision{NoteID: "n1", Number: 1},
+		},
+		{
+			name:      "[Fail] revision not found",
+			number:    9,
+			repoErr:   domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			out := mockusecase.NewMockNoteRevisionOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(&note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner", Status: note.StatusPublish}}, nil)
+			revisions.EXPECT().Get(gomock.Any(), "n1", tt.number).Return(tt.result, tt.repoErr)
+			if tt.repoErr == nil {
+				out.EXPECT().PresentRevision(gomock.Any(), tt.result).Return(nil)
+			}
+
+			interactor := uc.NewNoteRevisionInteractor(notes, revisions, out)
+			err := interactor.Get(context.Background(), "n1", tt.number, "viewer")
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestNoteRevisionInteractor_Diff(t *testing.T) {
+	rev := func(number int, title string) *note.Revision {
+		return &note.Revision{NoteID: "n1", Number: number, Title: title, Status: note.StatusDraft}
+	}
+	tests := []struct {
+		name      string
+		number    int
+		base      int
+		wantBase  int
+		revisions map[int]*note.Revision
+		wantTitle []note.DiffLine
+		wantError error
+	}{
+		{
+			name:      "[Success] default base is previous revision",
+			number:    2,
+			wantBase:  1,
+			revisions: map[int]*note.Revision{1: rev(1, "old"), 2: rev(2, "new")},
+			wantTitle: []note.DiffLine{{Op: note.DiffDelete, Text: "old"}, {Op: note.DiffInsert, Text: "new"}},
+		},
+		{
+			name:      "[Success] explicit base",
+			number:    3,
+			base:      1,
+			wantBase:  1,
+			revisions: map[int]*note.Revision{1: rev(1, "same"), 3: rev(3, "same")},
+			wantTitle: []note.DiffLine{{Op: note.DiffEqual, Text: "same"}},
+		},
+		{
+			name:      "[Success] first revision is compared with empty note",
+			number:    1,
+			revisions: map[int]*note.Revision{1: rev(1, "first")},
+			wantTitle: []note.DiffLine{{Op: note.DiffInsert, Text: "first"}},
+		},
+		{
+			name:      "[Fail] base revision not found",
+			number:    2,
+			base:      7,
+			wantBase:  7,
+			revisions: map[int]*note.Revision{2: rev(2, "new")},
+			wantError: domainerr.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			revisions := mockusecase.NewMockNoteRevisionRepository(ctrl)
+			out := mockusecase.NewMockNoteRevisionOutputPort(ctrl)
+
+			notes.EXPECT().Get(gomock.Any(), "n1").Return(&note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner"}}, nil)
+			revisions.EXPECT().Get(gomock.Any(), "n1", tt.number).Return(tt.revisions[tt.number], nil)
+			if tt.wantBase > 0 {
+				if r, ok := tt.revisions[tt.wantBase]; ok {
+					revisions.EXPECT().Get(gomock.Any(), "n1", tt.wantBase).Return(r, nil)
+				} else {
+					revisions.EXPECT().Get(gomock.Any(), "n1", tt.wantBase).Return(nil, domainerr.ErrNotFound)
+				}
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentRevisionDiff(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, diff *note.RevisionDiff) error {
+					if diff.To != tt.number || diff.From != tt.wantBase {
+						t.Fatalf("unexpected range: %d..%d", diff.From, diff.To)
+					}
+					if len(diff.Title) != len(tt.wantTitle) {
+						t.Fatalf("title diff = %+v, want %+v", diff.Title, tt.wantTitle)
+					}
+					for i := range diff.Title {
+						if diff.Title[i] != tt.wantTitle[i] {
+							t.Fatalf("title diff = %+v, want %+v", diff.Title, tt.wantTitle)
+						}
+					}
+					return nil
+				})
+			}
+
+			interactor := uc.NewNoteRevisionInteractor(notes, revisions, out)
+			err := interactor.Diff(context.Background(), "n1", tt.number, tt.base, "owner")
+
+			if tt.wantError == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantError != nil && !errors.Is(err, tt.wantError) {
+				t.Fatalf("want %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
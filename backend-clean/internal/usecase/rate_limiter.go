@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// RateLimiter limits requests per account, or per client IP without one, with separate
+// budgets for reads and writes, and requests per client IP before authentication.
+type RateLimiter struct {
+	store    port.RateLimitStore
+	policies map[ratelimit.Class]ratelimit.Policy
+	now      func() time.Time
+}
+
+var _ port.RateLimiter = (*RateLimiter)(nil)
+
+// NewRateLimiter creates RateLimiter with the read, write and pre-authentication policies.
+func NewRateLimiter(store port.RateLimitStore, read, write, preAuth ratelimit.Policy) *RateLimiter {
+	return &RateLimiter{
+		store: store,
+		policies: map[ratelimit.Class]ratelimit.Policy{
+			ratelimit.ClassRead:    read,
+			ratelimit.ClassWrite:   write,
+			ratelimit.ClassPreAuth: preAuth,
+		},
+		now: time.Now,
+	}
+}
+
+// Allow takes a token from the subject's bucket for the class. Classes without an
+// enabled policy are always allowed and never touch the store.
+func (l *RateLimiter) Allow(ctx context.Context, subject ratelimit.Subject, class ratelimit.Class) (ratelimit.Decision, error) {
+	policy := l.policies[class]
+	if !policy.Enabled() {
+		return ratelimit.Decision{Allowed: true}, nil
+	}
+	return l.store.Take(ctx, ratelimit.Key(class, subject), policy, l.now())
+}
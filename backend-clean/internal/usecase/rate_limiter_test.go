@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/ratelimit"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	read := ratelimit.Policy{Limit: 300, Window: time.Minute}
+	write := ratelimit.Policy{Limit: 60, Window: time.Minute}
+	preAuth := ratelimit.Policy{Limit: 3000, Window: time.Minute}
+	storeErr := errors.New("store down")
+
+	tests := []struct {
+		name      string
+		write     ratelimit.Policy
+		subject   ratelimit.Subject
+		class     ratelimit.Class
+		wantKey   string
+		wantPol   ratelimit.Policy
+		decision  ratelimit.Decision
+		storeErr  error
+		want      ratelimit.Decision
+		wantError error
+	}{
+		{
+			name:     "[Success] read by account",
+			write:    write,
+			subject:  ratelimit.Subject{AccountID: "acc-1", IP: "192.0.2.1"},
+			class:    ratelimit.ClassRead,
+			wantKey:  "read:account:acc-1",
+			wantPol:  read,
+			decision: ratelimit.Decision{Allowed: true, Limit: 300, Remaining: 299},
+			want:     ratelimit.Decision{Allowed: true, Limit: 300, Remaining: 299},
+		},
+		{
+			name:     "[Success] write by IP",
+			write:    write,
+			subject:  ratelimit.Subject{IP: "192.0.2.1"},
+			class:    ratelimit.ClassWrite,
+			wantKey:  "write:ip:192.0.2.1",
+			wantPol:  write,
+			decision: ratelimit.Decision{Limit: 60, RetryAfter: time.Second},
+			want:     ratelimit.Decision{Limit: 60, RetryAfter: time.Second},
+		},
+		{
+			name:     "[Success] IP before authentication has its own bucket",
+			write:    write,
+			subject:  ratelimit.Subject{IP: "192.0.2.1"},
+			class:    ratelimit.ClassPreAuth,
+			wantKey:  "preauth:ip:192.0.2.1",
+			wantPol:  preAuth,
+			decision: ratelimit.Decision{Allowed: true, Limit: 3000, Remaining: 2999},
+			want:     ratelimit.Decision{Allowed: true, Limit: 3000, Remaining: 2999},
+		},
+		{
+			name:    "[Success] disabled class skips the store",
+			subject: ratelimit.Subject{AccountID: "acc-1"},
+			class:   ratelimit.ClassWrite,
+			want:    ratelimit.Decision{Allowed: true},
+		},
+		{
+			name:      "[Fail] store error",
+			write:     write,
+			subject:   ratelimit.Subject{AccountID: "acc-1"},
+			class:     ratelimit.ClassWrite,
+			wantKey:   "write:account:acc-1",
+			wantPol:   write,
+			storeErr:  storeErr,
+			wantError: storeErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockusecase.NewMockRateLimitStore(ctrl)
+			if tt.wantKey != "" {
+				store.EXPECT().Take(gomock.Any(), tt.wantKey, tt.wantPol, gomock.Any()).Return(tt.decision, tt.storeErr)
+			}
+
+			got, err := uc.NewRateLimiter(store, read, tt.write, preAuth).Allow(context.Background(), tt.subject, tt.class)
+			assertErr(t, tt.wantError, err)
+			if got != tt.want {
+				t.Fatalf("want %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TagInteractor handles tag use cases.
+type TagInteractor struct {
+	repo   port.TagRepository
+	output port.TagOutputPort
+}
+
+var _ port.TagInputPort = (*TagInteractor)(nil)
+
+// NewTagInteractor creates TagInteractor.
+func NewTagInteractor(repo port.TagRepository, output port.TagOutputPort) *TagInteractor {
+	return &TagInteractor{repo: repo, output: output}
+}
+
+// List returns tags with the number of notes visible to the viewer carrying them, most used first.
+func (u *TagInteractor) List(ctx context.Context, filters note.TagFilters, viewerID string) error {
+	filters.ViewerID = viewerID
+	filters.WorkspaceID = port.WorkspaceIDFromContext(ctx)
+	filters.Limit = page.NormalizeLimit(filters.Limit)
+	counts, err := u.repo.ListCounts(ctx, filters)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentTagCounts(ctx, counts)
+}
@@ -0,0 +1,48 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestTagInteractor_List(t *testing.T) {
+	owner := "owner-1"
+	counts := []note.TagCount{{Tag: "auth", Count: 3}, {Tag: "api", Count: 1}}
+	tests := []struct {
+		name      string
+		filters   note.TagFilters
+		repoArg   note.TagFilters
+		repoErr   error
+		wantError error
+	}{
+		{name: "[Success] default limit", repoArg: note.TagFilters{ViewerID: "viewer", Limit: page.DefaultLimit}},
+		{name: "[Success] owner with capped limit", filters: note.TagFilters{OwnerID: &owner, Limit: 1000}, repoArg: note.TagFilters{OwnerID: &owner, ViewerID: "viewer", Limit: page.MaxLimit}},
+		{name: "[Fail] repo error", repoArg: note.TagFilters{ViewerID: "viewer", Limit: page.DefaultLimit}, repoErr: errors.New("db err"), wantError: errors.New("db err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTagRepository(ctrl)
+			out := mockusecase.NewMockTagOutputPort(ctrl)
+
+			repo.EXPECT().ListCounts(gomock.Any(), tt.repoArg).Return(counts, tt.repoErr)
+			if tt.wantError == nil {
+				out.EXPECT().PresentTagCounts(gomock.Any(), counts).Return(nil)
+			}
+
+			err := uc.NewTagInteractor(repo, out).List(context.Background(), tt.filters, "viewer")
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
@@ -4,28 +4,38 @@ package usecase
 import (
 	"context"
 
+	"immortal-architecture-clean/backend/internal/domain/audit"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 )
 
 // TemplateInteractor handles template use cases.
 type TemplateInteractor struct {
-	repo   port.TemplateRepository
-	tx     port.TxManager
-	output port.TemplateOutputPort
+	repo     port.TemplateRepository
+	tx       port.TxManager
+	events   port.EventPublisher
+	auditLog port.AuditRecorder
+	output   port.TemplateOutputPort
 }
 
 var _ port.TemplateInputPort = (*TemplateInteractor)(nil)
 
 // NewTemplateInteractor creates TemplateInteractor.
-func NewTemplateInteractor(repo port.TemplateRepository, tx port.TxManager, output port.TemplateOutputPort) *TemplateInteractor {
-	return &TemplateInteractor{repo: repo, tx: tx, output: output}
+func NewTemplateInteractor(repo port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, output port.TemplateOutputPort) *TemplateInteractor {
+	return &TemplateInteractor{repo: repo, tx: tx, events: events, auditLog: auditLog, output: output}
 }
 
-// List returns templates by filters.
+// List returns a page of templates by filters.
 func (u *TemplateInteractor) List(ctx context.Context, filters template.Filters) error {
-	templates, err := u.repo.List(ctx, filters)
+	filters.WorkspaceID = port.WorkspaceIDFromContext(ctx)
+	templates, err := page.Fetch(filters.Limit, func(limit int) ([]template.WithUsage, error) {
+		filters.Limit = limit
+		return u.repo.List(ctx, filters)
+	}, template.WithUsage.Cursor)
 	if err != nil {
 		return err
 	}
@@ -51,34 +61,39 @@ func (u *TemplateInteractor) Create(ctx context.Context, input port.TemplateCrea
 		return err
 	}
 
-	var createdID string
+	var created *template.WithUsage
 	err := u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
 		tpl, err := u.repo.Create(txCtx, template.Template{
-			Name:    input.Name,
-			OwnerID: input.OwnerID,
+			Name:        input.Name,
+			OwnerID:     input.OwnerID,
+			WorkspaceID: port.WorkspaceIDFromContext(txCtx),
 		})
 		if err != nil {
 			return err
 		}
-		createdID = tpl.ID
 		if len(input.Fields) > 0 {
-			if err := u.repo.ReplaceFields(txCtx, tpl.ID, input.Fields); err != nil {
+			if err := u.repo.ReplaceFields(txCtx, tpl.ID, tpl.Version, input.Fields); err != nil {
 				return err
 			}
 		}
-		return nil
+		if err := u.events.Publish(txCtx, event.NewTemplateChanged(*tpl, event.TemplateCreated, input.OwnerID)); err != nil {
+			return err
+		}
+		created, err = u.repo.Get(txCtx, tpl.ID)
+		if err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.TemplateEntry(audit.ActionCreate, input.OwnerID, nil, &created.Template))
 	})
 	if err != nil {
 		return err
 	}
-	tpl, err := u.repo.Get(ctx, createdID)
-	if err != nil {
-		return err
-	}
-	return u.output.PresentTemplate(ctx, tpl)
+	return u.output.PresentTemplate(ctx, created)
 }
 
 // Update updates a template.
+// Changed fields become a new template version; notes written against earlier
+// fields stay pinned to them until they are migrated.
 func (u *TemplateInteractor) Update(ctx context.Context, input port.TemplateUpdateInput) error {
 	current, err := u.repo.Get(ctx, input.ID)
 	if err != nil {
@@ -87,6 +102,9 @@ func (u *TemplateInteractor) Update(ctx context.Context, input port.TemplateUpda
 	if err := template.ValidateTemplateOwnership(current.Template.OwnerID, input.OwnerID); err != nil {
 		return err
 	}
+	if err := template.CheckVersion(current.Template.Version, input.Version); err != nil {
+		return err
+	}
 	if input.Fields != nil {
 		if err := template.ValidateTemplate(template.Template{
 			ID:      input.ID,
@@ -97,35 +115,44 @@ func (u *TemplateInteractor) Update(ctx context.Context, input port.TemplateUpda
 			return err
 		}
 	}
+	var updated *template.WithUsage
 	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
-		_, err := u.repo.Update(txCtx, template.Template{
-			ID:   input.ID,
-			Name: input.Name,
+		// Conditional on the version read above; the row stays locked until commit
+		// so the field replacement below cannot interleave with another edit.
+		tpl, err := u.repo.Update(txCtx, template.Template{
+			ID:      input.ID,
+			Name:    input.Name,
+			Version: current.Template.Version,
 		})
 		if err != nil {
 			return err
 		}
-		if input.Fields != nil {
+		// Unchanged fields keep their version, so notes written against it stay current.
+		if input.Fields != nil && template.FieldsChanged(current.Template.Fields, input.Fields) {
 			if len(input.Fields) == 0 {
 				return domainerr.ErrInvalidTemplateField
 			}
-			if err := u.repo.ReplaceFields(txCtx, input.ID, input.Fields); err != nil {
+			if err := u.repo.ReplaceFields(txCtx, input.ID, tpl.Version, input.Fields); err != nil {
 				return err
 			}
 		}
-		return nil
+		if err := u.events.Publish(txCtx, event.NewTemplateChanged(*tpl, event.TemplateUpdated, input.OwnerID)); err != nil {
+			return err
+		}
+		updated, err = u.repo.Get(txCtx, input.ID)
+		if err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.TemplateEntry(audit.ActionUpdate, input.OwnerID, &current.Template, &updated.Template))
 	})
 	if err != nil {
 		return err
 	}
-	tpl, err := u.repo.Get(ctx, input.ID)
-	if err != nil {
-		return err
-	}
-	return u.output.PresentTemplate(ctx, tpl)
+	return u.output.PresentTemplate(ctx, updated)
 }
 
-// Delete deletes a template.
+// Delete moves a template to the trash. Templates used by any note, including
+// notes in the trash, cannot be deleted.
 func (u *TemplateInteractor) Delete(ctx context.Context, id, ownerID string) error {
 	tpl, err := u.repo.Get(ctx, id)
 	if err != nil {
@@ -134,10 +161,85 @@ func (u *TemplateInteractor) Delete(ctx context.Context, id, ownerID string) err
 	if err := template.ValidateTemplateOwnership(tpl.Template.OwnerID, ownerID); err != nil {
 		return err
 	}
-	if err := template.CanDeleteTemplate(tpl.IsUsed); err != nil {
+	if err := template.CanDeleteTemplate(tpl.IsUsed, tpl.IsUsedInTrash); err != nil {
+		return err
+	}
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.repo.Trash(txCtx, id); err != nil {
+			return err
+		}
+		if err := u.events.Publish(txCtx, event.NewTemplateChanged(tpl.Template, event.TemplateDeleted, ownerID)); err != nil {
+			return err
+		}
+		trashed, err := u.repo.GetTrashed(txCtx, id)
+		if err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.TemplateEntry(audit.ActionDelete, ownerID, &tpl.Template, &trashed.Template))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentTemplateDeleted(ctx)
+}
+
+// ListTrash returns a page of the owner's trashed templates, most recently trashed first.
+func (u *TemplateInteractor) ListTrash(ctx context.Context, filters trash.Filters) error {
+	if filters.OwnerID == "" {
+		return domainerr.ErrTemplateOwnerRequired
+	}
+	filters.WorkspaceID = port.WorkspaceIDFromContext(ctx)
+	templates, err := page.Fetch(filters.Limit, func(limit int) ([]template.WithUsage, error) {
+		filters.Limit = limit
+		return u.repo.ListTrash(ctx, filters)
+	}, template.WithUsage.TrashCursor)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentTemplateList(ctx, templates)
+}
+
+// Restore takes a template out of the trash.
+// Other accounts' trashed templates are reported as not found.
+func (u *TemplateInteractor) Restore(ctx context.Context, id, ownerID string) error {
+	trashed, err := u.repo.GetTrashed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if trashed.Template.OwnerID != ownerID {
+		return domainerr.ErrNotFound
+	}
+	var restored *template.WithUsage
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.repo.Restore(txCtx, id); err != nil {
+			return err
+		}
+		if err := u.events.Publish(txCtx, event.NewTemplateChanged(trashed.Template, event.TemplateRestored, ownerID)); err != nil {
+			return err
+		}
+		restored, err = u.repo.Get(txCtx, id)
+		if err != nil {
+			return err
+		}
+		return u.auditLog.Record(txCtx, audit.TemplateEntry(audit.ActionRestore, ownerID, &trashed.Template, &restored.Template))
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentTemplate(ctx, restored)
+}
+
+// Purge deletes a trashed template for good.
+// Other accounts' trashed templates are reported as not found.
+func (u *TemplateInteractor) Purge(ctx context.Context, id, ownerID string) error {
+	trashed, err := u.repo.GetTrashed(ctx, id)
+	if err != nil {
 		return err
 	}
-	if err := u.repo.Delete(ctx, id); err != nil {
+	if trashed.Template.OwnerID != ownerID {
+		return domainerr.ErrNotFound
+	}
+	if err := purgeTemplate(ctx, u.repo, u.tx, u.events, u.auditLog, *trashed, ownerID); err != nil {
 		return err
 	}
 	return u.output.PresentTemplateDeleted(ctx)
@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 
+	"immortal-architecture-clean/backend/internal/domain/audit"
 	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/page"
 	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
 	"immortal-architecture-clean/backend/internal/port"
 	uc "immortal-architecture-clean/backend/internal/usecase"
 	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
@@ -32,7 +37,7 @@ func TestTemplateInteractor_Create(t *testing.T) {
 					{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
 				},
 			},
-			created: &template.Template{ID: "tpl-1", Name: "Template", OwnerID: "owner-1"},
+			created: &template.Template{ID: "tpl-1", Name: "Template", OwnerID: "owner-1", Version: 1},
 			withFields: &template.WithUsage{
 				Template: template.Template{
 					ID:      "tpl-1",
@@ -70,6 +75,8 @@ func TestTemplateInteractor_Create(t *testing.T) {
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
 			// set expectations based on test case data
@@ -85,12 +92,14 @@ func TestTemplateInteractor_Create(t *testing.T) {
 				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tt.created, tt.createErr)
 			}
 			if tt.created != nil && tt.createErr == nil {
-				repo.EXPECT().ReplaceFields(gomock.Any(), tt.created.ID, gomock.Any()).Return(nil)
+				repo.EXPECT().ReplaceFields(gomock.Any(), tt.created.ID, tt.created.Version, gomock.Any()).Return(nil)
+				events.EXPECT().Publish(gomock.Any(), event.NewTemplateChanged(*tt.created, event.TemplateCreated, tt.input.OwnerID)).Return(nil)
+				auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
 				repo.EXPECT().Get(gomock.Any(), tt.created.ID).Return(tt.withFields, nil)
 				out.EXPECT().PresentTemplate(gomock.Any(), tt.withFields).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, tx, events, auditLog, out)
 			err := interactor.Create(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -106,23 +115,39 @@ func TestTemplateInteractor_Create(t *testing.T) {
 }
 
 func TestTemplateInteractor_List(t *testing.T) {
+	updatedAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	tpl1 := template.WithUsage{Template: template.Template{ID: "tpl-1", Name: "tpl", UpdatedAt: updatedAt}}
+	tpl2 := template.WithUsage{Template: template.Template{ID: "tpl-2", Name: "tpl"}}
 	tests := []struct {
 		name      string
 		filters   template.Filters
+		repoArg   template.Filters
 		result    []template.WithUsage
+		want      page.Page[template.WithUsage]
 		repoErr   error
 		wantError error
 	}{
 		{
-			name:    "[Success] list templates",
+			name:    "[Success] list templates with default page size",
 			filters: template.Filters{OwnerID: strPtr("owner-1")},
-			result: []template.WithUsage{
-				{Template: template.Template{ID: "tpl-1", Name: "tpl"}},
+			repoArg: template.Filters{OwnerID: strPtr("owner-1"), Limit: page.DefaultLimit + 1},
+			result:  []template.WithUsage{tpl1},
+			want:    page.Page[template.WithUsage]{Items: []template.WithUsage{tpl1}},
+		},
+		{
+			name:    "[Success] extra row yields next cursor",
+			filters: template.Filters{Limit: 1},
+			repoArg: template.Filters{Limit: 2},
+			result:  []template.WithUsage{tpl1, tpl2},
+			want: page.Page[template.WithUsage]{
+				Items:      []template.WithUsage{tpl1},
+				NextCursor: &page.Cursor{UpdatedAt: updatedAt, ID: "tpl-1"},
 			},
 		},
 		{
 			name:      "[Fail] repo error",
 			filters:   template.Filters{},
+			repoArg:   template.Filters{Limit: page.DefaultLimit + 1},
 			repoErr:   errors.New("repo error"),
 			wantError: errors.New("repo error"),
 		},
@@ -135,14 +160,16 @@ func TestTemplateInteractor_List(t *testing.T) {
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
-			repo.EXPECT().List(gomock.Any(), tt.filters).Return(tt.result, tt.repoErr)
+			repo.EXPECT().List(gomock.Any(), tt.repoArg).Return(tt.result, tt.repoErr)
 			if tt.repoErr == nil {
-				out.EXPECT().PresentTemplateList(gomock.Any(), tt.result).Return(nil)
+				out.EXPECT().PresentTemplateList(gomock.Any(), tt.want).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, tx, events, auditLog, out)
 			err := interactor.List(context.Background(), tt.filters)
 
 			if tt.wantError == nil && err != nil {
@@ -183,6 +210,8 @@ func TestTemplateInteractor_Get(t *testing.T) {
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
 			repo.EXPECT().Get(gomock.Any(), tt.id).Return(tt.result, tt.repoErr)
@@ -190,7 +219,7 @@ func TestTemplateInteractor_Get(t *testing.T) {
 				out.EXPECT().PresentTemplate(gomock.Any(), tt.result).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, tx, events, auditLog, out)
 			err := interactor.Get(context.Background(), tt.id)
 
 			if tt.wantError == nil && err != nil {
@@ -211,7 +240,9 @@ func TestTemplateInteractor_Update(t *testing.T) {
 		getErr      error
 		updateErr   error
 		replaceErr  error
+		publishErr  error
 		wantError   error
+		keepsFields bool
 		expectTxRun bool
 	}{
 		{
@@ -229,6 +260,30 @@ func TestTemplateInteractor_Update(t *testing.T) {
 			},
 			expectTxRun: true,
 		},
+		{
+			name: "[Success] rename with unchanged fields keeps the fields version",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "renamed",
+				OwnerID: "owner-1",
+				Fields: []template.Field{
+					{ID: "f1", Label: "Title", Order: 1, IsRequired: true},
+				},
+			},
+			current: &template.WithUsage{
+				Template: template.Template{
+					ID:            "tpl-1",
+					Name:          "old",
+					OwnerID:       "owner-1",
+					Fields:        []template.Field{{ID: "f1", Label: "Title", Order: 1, IsRequired: true, Type: template.FieldText}},
+					Version:       3,
+					FieldsVersion: 2,
+				},
+				IsUsed: true,
+			},
+			keepsFields: true,
+			expectTxRun: true,
+		},
 		{
 			name: "[Fail] owner required",
 			input: port.TemplateUpdateInput{
@@ -250,6 +305,17 @@ func TestTemplateInteractor_Update(t *testing.T) {
 			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}},
 			wantError: domainerr.ErrFieldRequired,
 		},
+		{
+			name: "[Fail] version conflict",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+				Version: intPtr(1),
+			},
+			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Version: 3}},
+			wantError: domainerr.ErrConflict,
+		},
 		{
 			name: "[Fail] repo get error",
 			input: port.TemplateUpdateInput{
@@ -287,6 +353,18 @@ func TestTemplateInteractor_Update(t *testing.T) {
 			wantError:   errors.New("replace err"),
 			expectTxRun: true,
 		},
+		{
+			name: "[Fail] publish error",
+			input: port.TemplateUpdateInput{
+				ID:      "tpl-1",
+				Name:    "updated",
+				OwnerID: "owner-1",
+			},
+			current:     &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}},
+			publishErr:  errors.New("outbox err"),
+			wantError:   errors.New("outbox err"),
+			expectTxRun: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -296,6 +374,8 @@ func TestTemplateInteractor_Update(t *testing.T) {
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
 			repo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, tt.getErr)
@@ -307,17 +387,35 @@ func TestTemplateInteractor_Update(t *testing.T) {
 				)
 			}
 			if tt.getErr == nil && tt.expectTxRun {
-				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(&tt.current.Template, tt.updateErr)
-				if tt.updateErr == nil && tt.input.Fields != nil {
-					repo.EXPECT().ReplaceFields(gomock.Any(), tt.input.ID, tt.input.Fields).Return(tt.replaceErr)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, tpl template.Template) (*template.Template, error) {
+						if tpl.Version != tt.current.Template.Version {
+							t.Fatalf("update version = %d, want %d", tpl.Version, tt.current.Template.Version)
+						}
+						updated := tt.current.Template
+						updated.Version++
+						return &updated, tt.updateErr
+					},
+				)
+				// Unchanged fields are not stored again, so notes pinned to their version stay current;
+				// the mock fails the test if ReplaceFields is called anyway.
+				if tt.updateErr == nil && tt.input.Fields != nil && !tt.keepsFields {
+					// Changed fields are stored under the version the update produced.
+					repo.EXPECT().ReplaceFields(gomock.Any(), tt.input.ID, tt.current.Template.Version+1, tt.input.Fields).Return(tt.replaceErr)
+				}
+				if tt.updateErr == nil && tt.replaceErr == nil {
+					updated := tt.current.Template
+					updated.Version++
+					events.EXPECT().Publish(gomock.Any(), event.NewTemplateChanged(updated, event.TemplateUpdated, tt.input.OwnerID)).Return(tt.publishErr)
 				}
 			}
-			if tt.getErr == nil && tt.expectTxRun && tt.updateErr == nil && tt.replaceErr == nil {
+			if tt.getErr == nil && tt.expectTxRun && tt.updateErr == nil && tt.replaceErr == nil && tt.publishErr == nil {
 				repo.EXPECT().Get(gomock.Any(), tt.input.ID).Return(tt.current, nil)
+				auditLog.EXPECT().Record(gomock.Any(), audit.TemplateEntry(audit.ActionUpdate, tt.input.OwnerID, &tt.current.Template, &tt.current.Template)).Return(nil)
 				out.EXPECT().PresentTemplate(gomock.Any(), tt.current).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, tx, events, auditLog, out)
 			err := interactor.Update(context.Background(), tt.input)
 
 			if tt.wantError == nil && err != nil {
@@ -342,7 +440,7 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 		expectDel bool
 	}{
 		{
-			name:      "[Success] delete",
+			name:      "[Success] delete moves to trash",
 			id:        "tpl-1",
 			ownerID:   "owner-1",
 			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}, IsUsed: false},
@@ -369,6 +467,13 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}, IsUsed: true},
 			wantError: domainerr.ErrTemplateInUse,
 		},
+		{
+			name:      "[Fail] used by trashed notes",
+			id:        "tpl-1",
+			ownerID:   "owner-1",
+			current:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1"}, IsUsedInTrash: true},
+			wantError: domainerr.ErrTemplateUsedByTrashedNotes,
+		},
 		{
 			name:      "[Fail] delete error",
 			id:        "tpl-1",
@@ -387,17 +492,29 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 
 			repo := mockusecase.NewMockTemplateRepository(ctrl)
 			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
 			out := mockusecase.NewMockTemplateOutputPort(ctrl)
 
 			repo.EXPECT().Get(gomock.Any(), tt.id).Return(tt.current, tt.getErr)
 			if tt.getErr == nil && tt.expectDel {
-				repo.EXPECT().Delete(gomock.Any(), tt.id).Return(tt.deleteErr)
+				runInTx(tx)
+				repo.EXPECT().Trash(gomock.Any(), tt.id).Return(tt.deleteErr)
+				if tt.deleteErr == nil {
+					events.EXPECT().Publish(gomock.Any(), event.NewTemplateChanged(tt.current.Template, event.TemplateDeleted, tt.ownerID)).Return(nil)
+					// The trashed state is read back for the audit entry.
+					trashedAt := time.Now()
+					trashed := &template.WithUsage{Template: tt.current.Template}
+					trashed.Template.DeletedAt = &trashedAt
+					repo.EXPECT().GetTrashed(gomock.Any(), tt.id).Return(trashed, nil)
+					auditLog.EXPECT().Record(gomock.Any(), audit.TemplateEntry(audit.ActionDelete, tt.ownerID, &tt.current.Template, &trashed.Template)).Return(nil)
+				}
 			}
 			if tt.getErr == nil && tt.wantError == nil && tt.deleteErr == nil {
 				out.EXPECT().PresentTemplateDeleted(gomock.Any()).Return(nil)
 			}
 
-			interactor := uc.NewTemplateInteractor(repo, tx, out)
+			interactor := uc.NewTemplateInteractor(repo, tx, events, auditLog, out)
 			err := interactor.Delete(context.Background(), tt.id, tt.ownerID)
 
 			if tt.wantError == nil && err != nil {
@@ -409,3 +526,177 @@ func TestTemplateInteractor_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateInteractor_ListTrash(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	t1 := template.WithUsage{Template: template.Template{ID: "t1", OwnerID: "owner-1", DeletedAt: &deletedAt}}
+	t2 := template.WithUsage{Template: template.Template{ID: "t2", OwnerID: "owner-1", DeletedAt: &deletedAt}}
+	tests := []struct {
+		name      string
+		filters   trash.Filters
+		repoArg   *trash.Filters
+		result    []template.WithUsage
+		want      page.Page[template.WithUsage]
+		repoErr   error
+		wantError error
+	}{
+		{
+			name:    "[Success] next page is keyed by deleted_at",
+			filters: trash.Filters{OwnerID: "owner-1", Limit: 1},
+			repoArg: &trash.Filters{OwnerID: "owner-1", Limit: 2},
+			result:  []template.WithUsage{t1, t2},
+			want: page.Page[template.WithUsage]{
+				Items:      []template.WithUsage{t1},
+				NextCursor: &page.Cursor{UpdatedAt: deletedAt, ID: "t1"},
+			},
+		},
+		{
+			name:      "[Fail] owner required",
+			filters:   trash.Filters{},
+			wantError: domainerr.ErrTemplateOwnerRequired,
+		},
+		{
+			name:      "[Fail] repo error",
+			filters:   trash.Filters{OwnerID: "owner-1"},
+			repoArg:   &trash.Filters{OwnerID: "owner-1", Limit: page.DefaultLimit + 1},
+			repoErr:   errors.New("repo err"),
+			wantError: errors.New("repo err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+
+			if tt.repoArg != nil {
+				repo.EXPECT().ListTrash(gomock.Any(), *tt.repoArg).Return(tt.result, tt.repoErr)
+			}
+			if tt.repoArg != nil && tt.repoErr == nil {
+				out.EXPECT().PresentTemplateList(gomock.Any(), tt.want).Return(nil)
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, mockusecase.NewMockTxManager(ctrl), mockusecase.NewMockEventPublisher(ctrl), mockusecase.NewMockAuditRecorder(ctrl), out)
+			assertErr(t, tt.wantError, interactor.ListTrash(context.Background(), tt.filters))
+		})
+	}
+}
+
+func TestTemplateInteractor_Restore(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	trashed := &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Name: "ADR", DeletedAt: &deletedAt}}
+	restored := &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", Name: "ADR"}}
+	tests := []struct {
+		name       string
+		ownerID    string
+		getErr     error
+		restoreErr error
+		wantError  error
+	}{
+		{name: "[Success] restore", ownerID: "owner-1"},
+		{name: "[Fail] not in trash", ownerID: "owner-1", getErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+		{name: "[Fail] other account's trash is not found", ownerID: "other", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] restore error", ownerID: "owner-1", restoreErr: errors.New("restore err"), wantError: errors.New("restore err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+
+			repo.EXPECT().GetTrashed(gomock.Any(), "tpl-1").Return(trashed, tt.getErr)
+			if tt.getErr == nil && tt.ownerID == "owner-1" {
+				runInTx(tx)
+				repo.EXPECT().Restore(gomock.Any(), "tpl-1").Return(tt.restoreErr)
+				if tt.restoreErr == nil {
+					events.EXPECT().Publish(gomock.Any(), event.NewTemplateChanged(trashed.Template, event.TemplateRestored, "owner-1")).Return(nil)
+					auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
+					repo.EXPECT().Get(gomock.Any(), "tpl-1").Return(restored, nil)
+					out.EXPECT().PresentTemplate(gomock.Any(), restored).Return(nil)
+				}
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, tx, events, auditLog, out)
+			assertErr(t, tt.wantError, interactor.Restore(context.Background(), "tpl-1", tt.ownerID))
+		})
+	}
+}
+
+func TestTemplateInteractor_Purge(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		ownerID   string
+		trashed   *template.WithUsage
+		getErr    error
+		deleteErr error
+		wantError error
+	}{
+		{
+			name:    "[Success] purge",
+			ownerID: "owner-1",
+			trashed: &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", DeletedAt: &deletedAt}},
+		},
+		{
+			name:      "[Fail] not in trash",
+			ownerID:   "owner-1",
+			getErr:    domainerr.ErrNotFound,
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] other account's trash is not found",
+			ownerID:   "other",
+			trashed:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", DeletedAt: &deletedAt}},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] used by trashed notes",
+			ownerID:   "owner-1",
+			trashed:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", DeletedAt: &deletedAt}, IsUsedInTrash: true},
+			wantError: domainerr.ErrTemplateUsedByTrashedNotes,
+		},
+		{
+			name:      "[Fail] delete error",
+			ownerID:   "owner-1",
+			trashed:   &template.WithUsage{Template: template.Template{ID: "tpl-1", OwnerID: "owner-1", DeletedAt: &deletedAt}},
+			deleteErr: errors.New("delete err"),
+			wantError: errors.New("delete err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockTemplateRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+			out := mockusecase.NewMockTemplateOutputPort(ctrl)
+
+			repo.EXPECT().GetTrashed(gomock.Any(), "tpl-1").Return(tt.trashed, tt.getErr)
+			if tt.trashed != nil && tt.ownerID == tt.trashed.Template.OwnerID && !tt.trashed.InUse() {
+				runInTx(tx)
+				repo.EXPECT().Delete(gomock.Any(), "tpl-1").Return(tt.deleteErr)
+				if tt.deleteErr == nil {
+					events.EXPECT().Publish(gomock.Any(), event.NewTemplateChanged(tt.trashed.Template, event.TemplatePurged, "owner-1")).Return(nil)
+					auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
+					out.EXPECT().PresentTemplateDeleted(gomock.Any()).Return(nil)
+				}
+			}
+
+			interactor := uc.NewTemplateInteractor(repo, tx, events, auditLog, out)
+			assertErr(t, tt.wantError, interactor.Purge(context.Background(), "tpl-1", tt.ownerID))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/audit"
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// TrashPurgeInteractor purges trashed notes and templates after the retention period.
+type TrashPurgeInteractor struct {
+	notes     port.NoteRepository
+	templates port.TemplateRepository
+	links     port.NoteLinkRepository
+	tx        port.TxManager
+	events    port.EventPublisher
+	auditLog  port.AuditRecorder
+	retention time.Duration
+}
+
+var _ port.TrashPurger = (*TrashPurgeInteractor)(nil)
+
+// NewTrashPurgeInteractor creates TrashPurgeInteractor. A non-positive retention
+// keeps trashed items until they are purged by hand.
+func NewTrashPurgeInteractor(notes port.NoteRepository, templates port.TemplateRepository, links port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, retention time.Duration) *TrashPurgeInteractor {
+	return &TrashPurgeInteractor{
+		notes:     notes,
+		templates: templates,
+		links:     links,
+		tx:        tx,
+		events:    events,
+		auditLog:  auditLog,
+		retention: retention,
+	}
+}
+
+// PurgeExpired purges expired notes first, so templates they kept in use can go in the same run.
+// Each item is purged in its own transaction; the purge events and audit entries carry no actor.
+// The purge acts for no account, so it works across every workspace.
+func (u *TrashPurgeInteractor) PurgeExpired(ctx context.Context, limit int) (int, error) {
+	ctx = port.WithAllWorkspaces(ctx)
+	cutoff, ok := trash.Cutoff(time.Now(), u.retention)
+	if !ok {
+		return 0, nil
+	}
+	notes, err := u.notes.ListTrash(ctx, trash.Filters{TrashedBefore: &cutoff, Limit: limit})
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, n := range notes {
+		if err := purgeNote(ctx, u.notes, u.links, u.tx, u.events, u.auditLog, n, ""); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	if purged >= limit {
+		return purged, nil
+	}
+	templates, err := u.templates.ListTrash(ctx, trash.Filters{TrashedBefore: &cutoff, Limit: limit - purged})
+	if err != nil {
+		return purged, err
+	}
+	for _, tpl := range templates {
+		// A template still used by some note stays in the trash rather than failing the run.
+		if tpl.InUse() {
+			continue
+		}
+		if err := purgeTemplate(ctx, u.templates, u.tx, u.events, u.auditLog, tpl, ""); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// purgeNote deletes a trashed note for good together with links from and to it.
+func purgeNote(ctx context.Context, notes port.NoteRepository, links port.NoteLinkRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, n note.WithMeta, actorID string) error {
+	return tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		// Backlinks belong to other notes' aggregates, so they are not cascaded by the database.
+		if err := links.DeleteByNote(txCtx, n.Note.ID); err != nil {
+			return err
+		}
+		if err := notes.Delete(txCtx, n.Note.ID); err != nil {
+			return err
+		}
+		if err := events.Publish(txCtx, event.NewNotePurged(n.Note, actorID)); err != nil {
+			return err
+		}
+		return auditLog.Record(txCtx, audit.NoteEntry(audit.ActionPurge, actorID, &n, nil))
+	})
+}
+
+// purgeTemplate deletes a trashed template for good once no note uses it.
+func purgeTemplate(ctx context.Context, templates port.TemplateRepository, tx port.TxManager, events port.EventPublisher, auditLog port.AuditRecorder, tpl template.WithUsage, actorID string) error {
+	if err := template.CanDeleteTemplate(tpl.IsUsed, tpl.IsUsedInTrash); err != nil {
+		return err
+	}
+	return tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := templates.Delete(txCtx, tpl.Template.ID); err != nil {
+			return err
+		}
+		if err := events.Publish(txCtx, event.NewTemplateChanged(tpl.Template, event.TemplatePurged, actorID)); err != nil {
+			return err
+		}
+		return auditLog.Record(txCtx, audit.TemplateEntry(audit.ActionPurge, actorID, &tpl.Template, nil))
+	})
+}
@@ -0,0 +1,149 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/note"
+	"immortal-architecture-clean/backend/internal/domain/template"
+	"immortal-architecture-clean/backend/internal/domain/trash"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestTrashPurgeInteractor_PurgeExpired(t *testing.T) {
+	n1 := note.WithMeta{Note: note.Note{ID: "n1", OwnerID: "owner-1"}}
+	n2 := note.WithMeta{Note: note.Note{ID: "n2", OwnerID: "owner-1"}}
+	t1 := template.WithUsage{Template: template.Template{ID: "t1", OwnerID: "owner-1"}}
+	inUse := template.WithUsage{Template: template.Template{ID: "t2", OwnerID: "owner-1"}, IsUsed: true}
+	tests := []struct {
+		name         string
+		retention    time.Duration
+		limit        int
+		notes        []note.WithMeta
+		notesErr     error
+		templates    []template.WithUsage
+		listTemplate bool
+		deleteErr    error
+		want         int
+		wantError    error
+	}{
+		{
+			name:         "[Success] notes before templates",
+			retention:    trash.DefaultRetention,
+			limit:        10,
+			notes:        []note.WithMeta{n1},
+			templates:    []template.WithUsage{t1},
+			listTemplate: true,
+			want:         2,
+		},
+		{
+			name:      "[Success] full batch of notes leaves templates for the next run",
+			retention: trash.DefaultRetention,
+			limit:     2,
+			notes:     []note.WithMeta{n1, n2},
+			want:      2,
+		},
+		{
+			name:         "[Success] template still in use is skipped",
+			retention:    trash.DefaultRetention,
+			limit:        10,
+			templates:    []template.WithUsage{inUse},
+			listTemplate: true,
+			want:         0,
+		},
+		{
+			name:      "[Success] zero retention disables purging",
+			retention: 0,
+			limit:     10,
+			want:      0,
+		},
+		{
+			name:      "[Fail] list error",
+			retention: trash.DefaultRetention,
+			limit:     10,
+			notesErr:  errors.New("list err"),
+			wantError: errors.New("list err"),
+		},
+		{
+			name:      "[Fail] purge error",
+			retention: trash.DefaultRetention,
+			limit:     10,
+			notes:     []note.WithMeta{n1},
+			deleteErr: errors.New("delete err"),
+			wantError: errors.New("delete err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notes := mockusecase.NewMockNoteRepository(ctrl)
+			templates := mockusecase.NewMockTemplateRepository(ctrl)
+			links := mockusecase.NewMockNoteLinkRepository(ctrl)
+			tx := mockusecase.NewMockTxManager(ctrl)
+			events := mockusecase.NewMockEventPublisher(ctrl)
+			auditLog := mockusecase.NewMockAuditRecorder(ctrl)
+
+			// Only items of every account trashed before the retention cutoff are listed.
+			checkExpired := func(f trash.Filters) {
+				if f.OwnerID != "" || f.TrashedBefore == nil || time.Since(*f.TrashedBefore) < tt.retention {
+					t.Errorf("unexpected filters: %+v", f)
+				}
+			}
+			if tt.retention > 0 {
+				notes.EXPECT().ListTrash(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f trash.Filters) ([]note.WithMeta, error) {
+						if !port.AllWorkspaces(ctx) {
+							t.Errorf("purge should list the trash of every workspace")
+						}
+						checkExpired(f)
+						return tt.notes, tt.notesErr
+					},
+				)
+			}
+			for _, n := range tt.notes {
+				runInTx(tx)
+				links.EXPECT().DeleteByNote(gomock.Any(), n.Note.ID).Return(nil)
+				notes.EXPECT().Delete(gomock.Any(), n.Note.ID).Return(tt.deleteErr)
+				if tt.deleteErr != nil {
+					break
+				}
+				events.EXPECT().Publish(gomock.Any(), event.NewNotePurged(n.Note, "")).Return(nil)
+				auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
+			}
+			if tt.listTemplate {
+				templates.EXPECT().ListTrash(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, f trash.Filters) ([]template.WithUsage, error) {
+						checkExpired(f)
+						return tt.templates, nil
+					},
+				)
+			}
+			for _, tpl := range tt.templates {
+				if tpl.InUse() {
+					continue
+				}
+				runInTx(tx)
+				templates.EXPECT().Delete(gomock.Any(), tpl.Template.ID).Return(nil)
+				events.EXPECT().Publish(gomock.Any(), event.NewTemplateChanged(tpl.Template, event.TemplatePurged, "")).Return(nil)
+				auditLog.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			interactor := uc.NewTrashPurgeInteractor(notes, templates, links, tx, events, auditLog, tt.retention)
+			got, err := interactor.PurgeExpired(context.Background(), tt.limit)
+			assertErr(t, tt.wantError, err)
+			if tt.wantError == nil && got != tt.want {
+				t.Fatalf("want %d purged, got %d", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookDispatchInteractor enqueues webhook deliveries for domain events and sends them.
+type WebhookDispatchInteractor struct {
+	repo   port.WebhookRepository
+	sender port.WebhookSender
+}
+
+var _ port.WebhookDispatcher = (*WebhookDispatchInteractor)(nil)
+
+// NewWebhookDispatchInteractor creates WebhookDispatchInteractor.
+func NewWebhookDispatchInteractor(repo port.WebhookRepository, sender port.WebhookSender) *WebhookDispatchInteractor {
+	return &WebhookDispatchInteractor{repo: repo, sender: sender}
+}
+
+// Enqueue creates a delivery of the event for every matching webhook of its owner.
+// Events webhooks cannot subscribe to are ignored.
+func (u *WebhookDispatchInteractor) Enqueue(ctx context.Context, e event.Event) error {
+	t, ok := webhook.EventTypeOf(e)
+	if !ok {
+		return nil
+	}
+	ownerID := webhook.OwnerOf(e)
+	if ownerID == "" {
+		return nil
+	}
+	webhooks, err := u.repo.List(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	for _, w := range webhooks {
+		if !w.Subscribes(t) {
+			continue
+		}
+		d, err := webhook.NewDelivery(w.ID, e, t)
+		if err != nil {
+			return err
+		}
+		if _, err := u.repo.CreateDelivery(ctx, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeliverDue claims due deliveries, sends them and records each attempt.
+// A failed send is rescheduled with backoff rather than returned as an error.
+func (u *WebhookDispatchInteractor) DeliverDue(ctx context.Context, limit int) (int, error) {
+	due, err := u.repo.ClaimDue(ctx, limit, time.Now().Add(webhook.DeliveryLease))
+	if err != nil {
+		return 0, err
+	}
+	for _, d := range due {
+		status, err := u.sender.Send(ctx, d)
+		cause := ""
+		if err != nil {
+			cause = err.Error()
+		}
+		if err := u.repo.SaveAttempt(ctx, d.Delivery.RecordAttempt(time.Now(), status, cause)); err != nil {
+			return len(due), err
+		}
+	}
+	return len(due), nil
+}
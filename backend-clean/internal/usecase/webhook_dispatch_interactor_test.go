@@ -0,0 +1,123 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/event"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestWebhookDispatchInteractor_Enqueue(t *testing.T) {
+	published := event.Event{ID: "e1", Type: event.NotePublished, AggregateType: event.AggregateNote, AggregateID: "n1", Payload: map[string]any{"ownerId": "owner"}}
+	hooks := []webhook.Webhook{
+		{ID: "w1", OwnerID: "owner", Active: true, Events: []webhook.EventType{webhook.EventNotePublished}},
+		{ID: "w2", OwnerID: "owner", Active: true, Events: []webhook.EventType{webhook.EventNoteDeleted}},
+		{ID: "w3", OwnerID: "owner", Active: false, Events: []webhook.EventType{webhook.EventNotePublished}},
+	}
+	tests := []struct {
+		name       string
+		event      event.Event
+		listErr    error
+		createErr  error
+		wantList   bool
+		wantCreate int
+		wantError  error
+	}{
+		{name: "[Success] only active subscribed webhooks", event: published, wantList: true, wantCreate: 1},
+		{name: "[Success] unsupported event ignored", event: event.Event{Type: event.NoteCreated, Payload: map[string]any{"ownerId": "owner"}}},
+		{name: "[Success] event without owner ignored", event: event.Event{Type: event.NotePublished, Payload: map[string]any{}}},
+		{name: "[Fail] list error", event: published, listErr: errors.New("db error"), wantList: true, wantError: errors.New("db error")},
+		{name: "[Fail] create error", event: published, createErr: errors.New("db error"), wantList: true, wantCreate: 1, wantError: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			sender := mockusecase.NewMockWebhookSender(ctrl)
+
+			repo.EXPECT().List(gomock.Any(), "owner").Return(hooks, tt.listErr).Times(b2i(tt.wantList))
+			repo.EXPECT().CreateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+				if d.WebhookID != "w1" || d.EventID != "e1" || d.EventType != webhook.EventNotePublished {
+					t.Fatalf("unexpected delivery: %+v", d)
+				}
+				return &d, tt.createErr
+			}).Times(tt.wantCreate)
+
+			err := uc.NewWebhookDispatchInteractor(repo, sender).Enqueue(context.Background(), tt.event)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWebhookDispatchInteractor_DeliverDue(t *testing.T) {
+	due := webhook.DueDelivery{
+		Delivery: webhook.Delivery{ID: "d1", WebhookID: "w1", Status: webhook.DeliveryPending, Attempts: 1},
+		URL:      "https://example.com/hook",
+		Secret:   "s",
+	}
+	tests := []struct {
+		name       string
+		claimErr   error
+		status     int
+		sendErr    error
+		saveErr    error
+		wantStatus webhook.DeliveryStatus
+		wantCause  string
+		wantError  error
+	}{
+		{name: "[Success] 2xx marks delivery succeeded", status: 200, wantStatus: webhook.DeliverySucceeded},
+		{name: "[Success] 5xx schedules a retry", status: 503, wantStatus: webhook.DeliveryPending},
+		{name: "[Success] transport error schedules a retry", sendErr: errors.New("connection refused"), wantStatus: webhook.DeliveryPending, wantCause: "connection refused"},
+		{name: "[Fail] claim error", claimErr: errors.New("db error"), wantError: errors.New("db error")},
+		{name: "[Fail] save error", status: 200, saveErr: errors.New("db error"), wantStatus: webhook.DeliverySucceeded, wantError: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			sender := mockusecase.NewMockWebhookSender(ctrl)
+
+			before := time.Now()
+			repo.EXPECT().ClaimDue(gomock.Any(), 10, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, leaseUntil time.Time) ([]webhook.DueDelivery, error) {
+				if leaseUntil.Before(before.Add(webhook.DeliveryLease)) {
+					t.Fatalf("lease too short: %v", leaseUntil)
+				}
+				if tt.claimErr != nil {
+					return nil, tt.claimErr
+				}
+				return []webhook.DueDelivery{due}, nil
+			})
+			if tt.claimErr == nil {
+				sender.EXPECT().Send(gomock.Any(), due).Return(tt.status, tt.sendErr)
+				repo.EXPECT().SaveAttempt(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d webhook.Delivery) error {
+					if d.Status != tt.wantStatus || d.Attempts != 2 || d.LastStatusCode != tt.status || d.LastError != tt.wantCause {
+						t.Fatalf("unexpected attempt: %+v", d)
+					}
+					if d.Status == webhook.DeliveryPending && d.NextAttemptAt.Before(before.Add(webhook.RetryDelay(2))) {
+						t.Fatalf("retry scheduled too early: %v", d.NextAttemptAt)
+					}
+					return tt.saveErr
+				})
+			}
+
+			n, err := uc.NewWebhookDispatchInteractor(repo, sender).DeliverDue(context.Background(), 10)
+			assertErr(t, tt.wantError, err)
+			if tt.claimErr == nil && n != 1 {
+				t.Fatalf("want 1 claimed, got %d", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WebhookInteractor handles webhook management use cases.
+type WebhookInteractor struct {
+	repo   port.WebhookRepository
+	output port.WebhookOutputPort
+}
+
+var _ port.WebhookInputPort = (*WebhookInteractor)(nil)
+
+// NewWebhookInteractor creates WebhookInteractor.
+func NewWebhookInteractor(repo port.WebhookRepository, output port.WebhookOutputPort) *WebhookInteractor {
+	return &WebhookInteractor{repo: repo, output: output}
+}
+
+// List returns the owner's webhooks.
+func (u *WebhookInteractor) List(ctx context.Context, ownerID string) error {
+	webhooks, err := u.repo.List(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhookList(ctx, webhooks)
+}
+
+// Get returns one of the owner's webhooks.
+func (u *WebhookInteractor) Get(ctx context.Context, id, ownerID string) error {
+	w, err := u.owned(ctx, id, ownerID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhook(ctx, w)
+}
+
+// Create registers a webhook with a freshly generated secret.
+func (u *WebhookInteractor) Create(ctx context.Context, input port.WebhookCreateInput) error {
+	w, err := webhook.NewWebhook(input.OwnerID, input.URL, input.Events)
+	if err != nil {
+		return err
+	}
+	created, err := u.repo.Create(ctx, w)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhookCreated(ctx, created)
+}
+
+// Update changes the URL, events and active flag of one of the owner's webhooks.
+func (u *WebhookInteractor) Update(ctx context.Context, input port.WebhookUpdateInput) error {
+	w, err := u.owned(ctx, input.ID, input.OwnerID)
+	if err != nil {
+		return err
+	}
+	if err := webhook.ValidateURL(input.URL); err != nil {
+		return err
+	}
+	events, err := webhook.NormalizeEvents(input.Events)
+	if err != nil {
+		return err
+	}
+	w.URL = input.URL
+	w.Events = events
+	w.Active = input.Active
+	updated, err := u.repo.Update(ctx, *w)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWebhook(ctx, updated)
+}
+
+// Delete removes one of the owner's webhooks with its delivery log.
+func (u *WebhookInteractor) Delete(ctx context.Context, id, ownerID string) error {
+	if _, err := u.owned(ctx, id, ownerID); err != nil {
+		return err
+	}
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return u.output.PresentWebhookDeleted(ctx)
+}
+
+// ListDeliveries returns a page of the webhook's delivery log, newest first.
+func (u *WebhookInteractor) ListDeliveries(ctx context.Context, input port.WebhookDeliveryListInput) error {
+	if _, err := u.owned(ctx, input.WebhookID, input.OwnerID); err != nil {
+		return err
+	}
+	deliveries, err := page.Fetch(input.Limit, func(limit int) ([]webhook.Delivery, error) {
+		return u.repo.ListDeliveries(ctx, input.WebhookID, input.After, limit)
+	}, webhook.Delivery.Cursor)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentDeliveryList(ctx, deliveries)
+}
+
+// Redeliver queues a new delivery with the same body as an earlier one.
+// The earlier delivery keeps its own log entry.
+func (u *WebhookInteractor) Redeliver(ctx context.Context, webhookID, deliveryID, ownerID string) error {
+	if _, err := u.owned(ctx, webhookID, ownerID); err != nil {
+		return err
+	}
+	d, err := u.repo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return err
+	}
+	if d.WebhookID != webhookID {
+		return domainerr.ErrNotFound
+	}
+	created, err := u.repo.CreateDelivery(ctx, d.Redeliver())
+	if err != nil {
+		return err
+	}
+	return u.output.PresentDelivery(ctx, created)
+}
+
+// owned loads a webhook and hides it from everyone but its owner.
+func (u *WebhookInteractor) owned(ctx context.Context, id, ownerID string) (*webhook.Webhook, error) {
+	w, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if w.OwnerID != ownerID {
+		return nil, domainerr.ErrNotFound
+	}
+	return w, nil
+}
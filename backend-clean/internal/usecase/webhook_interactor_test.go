@@ -0,0 +1,224 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/page"
+	"immortal-architecture-clean/backend/internal/domain/webhook"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestWebhookInteractor_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     port.WebhookCreateInput
+		repoErr   error
+		wantError error
+	}{
+		{
+			name:  "[Success] create webhook",
+			input: port.WebhookCreateInput{OwnerID: "owner", URL: "https://example.com/hook", Events: []webhook.EventType{webhook.EventNotePublished}},
+		},
+		{
+			name:      "[Fail] invalid url",
+			input:     port.WebhookCreateInput{OwnerID: "owner", URL: "example.com", Events: []webhook.EventType{webhook.EventNotePublished}},
+			wantError: domainerr.ErrInvalidWebhookURL,
+		},
+		{
+			name:      "[Fail] no events",
+			input:     port.WebhookCreateInput{OwnerID: "owner", URL: "https://example.com/hook"},
+			wantError: domainerr.ErrInvalidWebhookEvents,
+		},
+		{
+			name:      "[Fail] repo error",
+			input:     port.WebhookCreateInput{OwnerID: "owner", URL: "https://example.com/hook", Events: []webhook.EventType{webhook.EventNoteDeleted}},
+			repoErr:   errors.New("db error"),
+			wantError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+			if tt.wantError == nil || tt.repoErr != nil {
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, w webhook.Webhook) (*webhook.Webhook, error) {
+					if w.OwnerID != "owner" || w.Secret == "" || !w.Active {
+						t.Fatalf("unexpected webhook: %+v", w)
+					}
+					if tt.repoErr != nil {
+						return nil, tt.repoErr
+					}
+					w.ID = "w1"
+					return &w, nil
+				})
+			}
+			if tt.wantError == nil {
+				out.EXPECT().PresentWebhookCreated(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewWebhookInteractor(repo, out).Create(context.Background(), tt.input)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWebhookInteractor_Update(t *testing.T) {
+	stored := &webhook.Webhook{ID: "w1", OwnerID: "owner", URL: "https://old.example.com", Secret: "s", Events: []webhook.EventType{webhook.EventNotePublished}, Active: true}
+	tests := []struct {
+		name      string
+		input     port.WebhookUpdateInput
+		wantError error
+	}{
+		{
+			name:  "[Success] update webhook",
+			input: port.WebhookUpdateInput{ID: "w1", OwnerID: "owner", URL: "https://new.example.com", Events: []webhook.EventType{webhook.EventNoteUpdated}},
+		},
+		{
+			name:      "[Fail] other account's webhook is not found",
+			input:     port.WebhookUpdateInput{ID: "w1", OwnerID: "other", URL: "https://new.example.com", Events: []webhook.EventType{webhook.EventNoteUpdated}},
+			wantError: domainerr.ErrNotFound,
+		},
+		{
+			name:      "[Fail] unknown event",
+			input:     port.WebhookUpdateInput{ID: "w1", OwnerID: "owner", URL: "https://new.example.com", Events: []webhook.EventType{"account.signed-in"}},
+			wantError: domainerr.ErrInvalidWebhookEvents,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+			copied := *stored
+			repo.EXPECT().Get(gomock.Any(), "w1").Return(&copied, nil)
+			if tt.wantError == nil {
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, w webhook.Webhook) (*webhook.Webhook, error) {
+					if w.URL != tt.input.URL || w.Active || w.Secret != "s" || len(w.Events) != 1 || w.Events[0] != webhook.EventNoteUpdated {
+						t.Fatalf("unexpected webhook: %+v", w)
+					}
+					return &w, nil
+				})
+				out.EXPECT().PresentWebhook(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewWebhookInteractor(repo, out).Update(context.Background(), tt.input)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWebhookInteractor_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownerID   string
+		getErr    error
+		wantError error
+	}{
+		{name: "[Success] delete webhook", ownerID: "owner"},
+		{name: "[Fail] other account's webhook is not found", ownerID: "other", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] missing webhook", ownerID: "owner", getErr: domainerr.ErrNotFound, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+			if tt.getErr != nil {
+				repo.EXPECT().Get(gomock.Any(), "w1").Return(nil, tt.getErr)
+			} else {
+				repo.EXPECT().Get(gomock.Any(), "w1").Return(&webhook.Webhook{ID: "w1", OwnerID: "owner"}, nil)
+			}
+			if tt.wantError == nil {
+				repo.EXPECT().Delete(gomock.Any(), "w1").Return(nil)
+				out.EXPECT().PresentWebhookDeleted(gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewWebhookInteractor(repo, out).Delete(context.Background(), "w1", tt.ownerID)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWebhookInteractor_ListDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockusecase.NewMockWebhookRepository(ctrl)
+	out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+	repo.EXPECT().Get(gomock.Any(), "w1").Return(&webhook.Webhook{ID: "w1", OwnerID: "owner"}, nil)
+	repo.EXPECT().ListDeliveries(gomock.Any(), "w1", nil, 3).Return([]webhook.Delivery{{ID: "d3"}, {ID: "d2"}, {ID: "d1"}}, nil)
+	out.EXPECT().PresentDeliveryList(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p page.Page[webhook.Delivery]) error {
+		if len(p.Items) != 2 || !p.HasMore() || p.NextCursor.ID != "d2" {
+			t.Fatalf("unexpected page: %+v", p)
+		}
+		return nil
+	})
+
+	err := uc.NewWebhookInteractor(repo, out).ListDeliveries(context.Background(), port.WebhookDeliveryListInput{WebhookID: "w1", OwnerID: "owner", Limit: 2})
+	assertErr(t, nil, err)
+}
+
+func TestWebhookInteractor_Redeliver(t *testing.T) {
+	tests := []struct {
+		name      string
+		delivery  *webhook.Delivery
+		wantError error
+	}{
+		{
+			name:     "[Success] redeliver failed delivery",
+			delivery: &webhook.Delivery{ID: "d1", WebhookID: "w1", EventID: "e1", Payload: []byte(`{}`), Status: webhook.DeliveryFailed, Attempts: webhook.MaxAttempts},
+		},
+		{
+			name:      "[Fail] delivery of another webhook",
+			delivery:  &webhook.Delivery{ID: "d1", WebhookID: "w2"},
+			wantError: domainerr.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mockusecase.NewMockWebhookRepository(ctrl)
+			out := mockusecase.NewMockWebhookOutputPort(ctrl)
+
+			repo.EXPECT().Get(gomock.Any(), "w1").Return(&webhook.Webhook{ID: "w1", OwnerID: "owner"}, nil)
+			repo.EXPECT().GetDelivery(gomock.Any(), "d1").Return(tt.delivery, nil)
+			if tt.wantError == nil {
+				repo.EXPECT().CreateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d webhook.Delivery) (*webhook.Delivery, error) {
+					if d.RedeliveryOf != "d1" || d.Status != webhook.DeliveryPending || d.Attempts != 0 || d.EventID != "e1" {
+						t.Fatalf("unexpected redelivery: %+v", d)
+					}
+					d.ID = "d2"
+					return &d, nil
+				})
+				out.EXPECT().PresentDelivery(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err := uc.NewWebhookInteractor(repo, out).Redeliver(context.Background(), "w1", "d1", "owner")
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
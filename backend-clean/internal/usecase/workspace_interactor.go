@@ -0,0 +1,333 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceInteractor handles workspaces, their members and invites.
+type WorkspaceInteractor struct {
+	workspaces port.WorkspaceRepository
+	invites    port.WorkspaceInviteRepository
+	accounts   port.AccountRepository
+	tx         port.TxManager
+	output     port.WorkspaceOutputPort
+}
+
+var _ port.WorkspaceInputPort = (*WorkspaceInteractor)(nil)
+
+// NewWorkspaceInteractor creates WorkspaceInteractor.
+func NewWorkspaceInteractor(workspaces port.WorkspaceRepository, invites port.WorkspaceInviteRepository, accounts port.AccountRepository, tx port.TxManager, output port.WorkspaceOutputPort) *WorkspaceInteractor {
+	return &WorkspaceInteractor{
+		workspaces: workspaces,
+		invites:    invites,
+		accounts:   accounts,
+		tx:         tx,
+		output:     output,
+	}
+}
+
+// List returns the workspaces the account belongs to, in the order they were joined.
+func (u *WorkspaceInteractor) List(ctx context.Context, accountID string) error {
+	workspaces, err := u.workspaces.ListByAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspaces(ctx, workspaces)
+}
+
+// Create creates a workspace owned by the actor.
+func (u *WorkspaceInteractor) Create(ctx context.Context, input port.WorkspaceCreateInput) error {
+	name, err := workspace.NormalizeName(input.Name)
+	if err != nil {
+		return err
+	}
+	var created *workspace.Workspace
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		created, err = createOwnedWorkspace(txCtx, u.workspaces, name, input.ActorID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspace(ctx, &workspace.WithRole{Workspace: *created, Role: workspace.RoleOwner})
+}
+
+// Get returns a workspace the actor belongs to.
+func (u *WorkspaceInteractor) Get(ctx context.Context, workspaceID, actorID string) error {
+	actor, err := u.member(ctx, workspaceID, actorID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.ValidateMembership(actor); err != nil {
+		return err
+	}
+	w, err := u.workspaces.Get(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspace(ctx, &workspace.WithRole{Workspace: *w, Role: actor.Role})
+}
+
+// Update renames a workspace. Only owners and admins may do so.
+func (u *WorkspaceInteractor) Update(ctx context.Context, input port.WorkspaceUpdateInput) error {
+	actor, err := u.member(ctx, input.WorkspaceID, input.ActorID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.ValidateManager(actor); err != nil {
+		return err
+	}
+	name, err := workspace.NormalizeName(input.Name)
+	if err != nil {
+		return err
+	}
+	updated, err := u.workspaces.Update(ctx, workspace.Workspace{ID: input.WorkspaceID, Name: name})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspace(ctx, &workspace.WithRole{Workspace: *updated, Role: actor.Role})
+}
+
+// Delete removes an empty workspace with its members and invites. Only the owner may do so.
+func (u *WorkspaceInteractor) Delete(ctx context.Context, workspaceID, actorID string) error {
+	actor, err := u.member(ctx, workspaceID, actorID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.ValidateMembership(actor); err != nil {
+		return err
+	}
+	hasContents, err := u.workspaces.HasContents(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.ValidateDeletion(actor, hasContents); err != nil {
+		return err
+	}
+	if err := u.workspaces.Delete(ctx, workspaceID); err != nil {
+		return err
+	}
+	return u.output.PresentWorkspaceDeleted(ctx)
+}
+
+// ListMembers returns the members of a workspace the actor belongs to.
+func (u *WorkspaceInteractor) ListMembers(ctx context.Context, workspaceID, actorID string) error {
+	actor, err := u.member(ctx, workspaceID, actorID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.ValidateMembership(actor); err != nil {
+		return err
+	}
+	members, err := u.workspaces.ListMembers(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspaceMembers(ctx, members)
+}
+
+// UpdateMember changes the role of a member. Only owners and admins may do so.
+func (u *WorkspaceInteractor) UpdateMember(ctx context.Context, input port.WorkspaceMemberUpdateInput) error {
+	actor, err := u.member(ctx, input.WorkspaceID, input.ActorID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.ValidateManager(actor); err != nil {
+		return err
+	}
+	target, err := u.member(ctx, input.WorkspaceID, input.AccountID)
+	if err != nil {
+		return err
+	}
+	changed, err := workspace.ChangeRole(actor, target, input.Role)
+	if err != nil {
+		return err
+	}
+	if _, err := u.workspaces.UpsertMember(ctx, changed); err != nil {
+		return err
+	}
+	members, err := u.workspaces.ListMembers(ctx, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	for i := range members {
+		if members[i].Member.AccountID == input.AccountID {
+			return u.output.PresentWorkspaceMember(ctx, &members[i])
+		}
+	}
+	return domainerr.ErrNotFound
+}
+
+// RemoveMember removes a member from the workspace. Owners and admins remove others;
+// every member but the owner may leave.
+func (u *WorkspaceInteractor) RemoveMember(ctx context.Context, workspaceID, accountID, actorID string) error {
+	actor, err := u.member(ctx, workspaceID, actorID)
+	if err != nil {
+		return err
+	}
+	target, err := u.member(ctx, workspaceID, accountID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.ValidateRemoval(actor, target); err != nil {
+		return err
+	}
+	if err := u.workspaces.DeleteMember(ctx, workspaceID, accountID); err != nil {
+		return err
+	}
+	return u.output.PresentWorkspaceMemberRemoved(ctx)
+}
+
+// ListInvites returns the pending invites of a workspace. Only owners and admins may see them.
+func (u *WorkspaceInteractor) ListInvites(ctx context.Context, workspaceID, actorID string) error {
+	actor, err := u.member(ctx, workspaceID, actorID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.ValidateManager(actor); err != nil {
+		return err
+	}
+	invites, err := u.invites.List(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspaceInvites(ctx, invites)
+}
+
+// Invite invites an email address into the workspace, or changes the role of its pending invite.
+func (u *WorkspaceInteractor) Invite(ctx context.Context, input port.WorkspaceInviteInput) error {
+	actor, err := u.member(ctx, input.WorkspaceID, input.ActorID)
+	if err != nil {
+		return err
+	}
+	invite, err := workspace.NewInvite(actor, input.Email, input.Role)
+	if err != nil {
+		return err
+	}
+	invitee, err := u.accounts.GetByEmail(ctx, invite.Email)
+	if err != nil && !errors.Is(err, domainerr.ErrNotFound) {
+		return err
+	}
+	if invitee != nil {
+		existing, err := u.member(ctx, input.WorkspaceID, invitee.ID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return domainerr.ErrAlreadyWorkspaceMember
+		}
+	}
+	stored, err := u.invites.Upsert(ctx, invite)
+	if err != nil {
+		return err
+	}
+	w, err := u.workspaces.Get(ctx, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspaceInvite(ctx, &workspace.InviteWithMeta{Invite: *stored, WorkspaceName: w.Name})
+}
+
+// RevokeInvite withdraws a pending invite. Only owners and admins may do so.
+func (u *WorkspaceInteractor) RevokeInvite(ctx context.Context, workspaceID, inviteID, actorID string) error {
+	actor, err := u.member(ctx, workspaceID, actorID)
+	if err != nil {
+		return err
+	}
+	if err := workspace.ValidateManager(actor); err != nil {
+		return err
+	}
+	invite, err := u.invites.Get(ctx, inviteID)
+	if err != nil {
+		return err
+	}
+	if invite.Invite.WorkspaceID != workspaceID {
+		return domainerr.ErrNotFound
+	}
+	if err := u.invites.Delete(ctx, inviteID); err != nil {
+		return err
+	}
+	return u.output.PresentWorkspaceInviteRevoked(ctx)
+}
+
+// ListMyInvites returns the pending invites addressed to the actor's email.
+func (u *WorkspaceInteractor) ListMyInvites(ctx context.Context, actorID string) error {
+	actor, err := u.accounts.GetByID(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	invites, err := u.invites.ListByEmail(ctx, actor.Email.String())
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspaceInvites(ctx, invites)
+}
+
+// AcceptInvite joins the workspace of an invite addressed to the actor's email and
+// consumes the invite.
+func (u *WorkspaceInteractor) AcceptInvite(ctx context.Context, inviteID, actorID string) error {
+	actor, err := u.accounts.GetByID(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	invite, err := u.invites.Get(ctx, inviteID)
+	if err != nil {
+		return err
+	}
+	var joined *workspace.WithRole
+	err = u.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+		existing, err := u.member(txCtx, invite.Invite.WorkspaceID, actorID)
+		if err != nil {
+			return err
+		}
+		member, err := invite.Invite.Accept(actorID, actor.Email.String(), existing)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			if _, err := u.workspaces.UpsertMember(txCtx, member); err != nil {
+				return err
+			}
+		}
+		if err := u.invites.Delete(txCtx, inviteID); err != nil {
+			return err
+		}
+		w, err := u.workspaces.Get(txCtx, invite.Invite.WorkspaceID)
+		if err != nil {
+			return err
+		}
+		joined = &workspace.WithRole{Workspace: *w, Role: member.Role}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return u.output.PresentWorkspace(ctx, joined)
+}
+
+// member returns the account's membership, or nil when it does not belong to the workspace.
+func (u *WorkspaceInteractor) member(ctx context.Context, workspaceID, accountID string) (*workspace.Member, error) {
+	m, err := u.workspaces.GetMember(ctx, workspaceID, accountID)
+	if errors.Is(err, domainerr.ErrNotFound) {
+		return nil, nil
+	}
+	return m, err
+}
+
+// createOwnedWorkspace stores a workspace with the account as its owner.
+// It must run inside a transaction.
+func createOwnedWorkspace(ctx context.Context, workspaces port.WorkspaceRepository, name, ownerID string) (*workspace.Workspace, error) {
+	created, err := workspaces.Create(ctx, workspace.Workspace{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := workspaces.UpsertMember(ctx, workspace.Member{WorkspaceID: created.ID, AccountID: ownerID, Role: workspace.RoleOwner}); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
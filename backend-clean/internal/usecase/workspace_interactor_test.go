@@ -0,0 +1,395 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"immortal-architecture-clean/backend/internal/domain/account"
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	"immortal-architecture-clean/backend/internal/port"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+type workspaceMocks struct {
+	workspaces *mockusecase.MockWorkspaceRepository
+	invites    *mockusecase.MockWorkspaceInviteRepository
+	accounts   *mockusecase.MockAccountRepository
+	tx         *mockusecase.MockTxManager
+	out        *mockusecase.MockWorkspaceOutputPort
+}
+
+func newWorkspaceMocks(ctrl *gomock.Controller) workspaceMocks {
+	return workspaceMocks{
+		workspaces: mockusecase.NewMockWorkspaceRepository(ctrl),
+		invites:    mockusecase.NewMockWorkspaceInviteRepository(ctrl),
+		accounts:   mockusecase.NewMockAccountRepository(ctrl),
+		tx:         mockusecase.NewMockTxManager(ctrl),
+		out:        mockusecase.NewMockWorkspaceOutputPort(ctrl),
+	}
+}
+
+func (m workspaceMocks) interactor() *uc.WorkspaceInteractor {
+	return uc.NewWorkspaceInteractor(m.workspaces, m.invites, m.accounts, m.tx, m.out)
+}
+
+// expectMember stubs GetMember; an empty role means the account does not belong to ws-1.
+func (m workspaceMocks) expectMember(accountID string, role workspace.Role) {
+	if role == "" {
+		m.workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", accountID).Return(nil, domainerr.ErrNotFound)
+		return
+	}
+	m.workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", accountID).Return(&workspace.Member{WorkspaceID: "ws-1", AccountID: accountID, Role: role}, nil)
+}
+
+func TestWorkspaceInteractor_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     port.WorkspaceCreateInput
+		createErr error
+		wantError error
+	}{
+		{name: "[Success] create owned workspace", input: port.WorkspaceCreateInput{Name: "  Design  ", ActorID: "acc-1"}},
+		{name: "[Fail] blank name", input: port.WorkspaceCreateInput{Name: " ", ActorID: "acc-1"}, wantError: domainerr.ErrInvalidWorkspaceName},
+		{name: "[Fail] repo error", input: port.WorkspaceCreateInput{Name: "Design", ActorID: "acc-1"}, createErr: errors.New("db err"), wantError: errors.New("db err")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newWorkspaceMocks(ctrl)
+
+			if tt.wantError == nil || tt.createErr != nil {
+				runInTx(m.tx)
+				m.workspaces.EXPECT().Create(gomock.Any(), workspace.Workspace{Name: "Design"}).Return(&workspace.Workspace{ID: "ws-1", Name: "Design"}, tt.createErr)
+			}
+			if tt.wantError == nil {
+				m.workspaces.EXPECT().UpsertMember(gomock.Any(), workspace.Member{WorkspaceID: "ws-1", AccountID: "acc-1", Role: workspace.RoleOwner}).Return(&workspace.Member{}, nil)
+				m.out.EXPECT().PresentWorkspace(gomock.Any(), &workspace.WithRole{Workspace: workspace.Workspace{ID: "ws-1", Name: "Design"}, Role: workspace.RoleOwner}).Return(nil)
+			}
+
+			err := m.interactor().Create(context.Background(), tt.input)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWorkspaceInteractor_Get(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorRole workspace.Role
+		wantError error
+	}{
+		{name: "[Success] member gets workspace", actorRole: workspace.RoleMember},
+		{name: "[Fail] stranger does not see workspace", wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newWorkspaceMocks(ctrl)
+
+			m.expectMember("acc-1", tt.actorRole)
+			if tt.wantError == nil {
+				m.workspaces.EXPECT().Get(gomock.Any(), "ws-1").Return(&workspace.Workspace{ID: "ws-1"}, nil)
+				m.out.EXPECT().PresentWorkspace(gomock.Any(), &workspace.WithRole{Workspace: workspace.Workspace{ID: "ws-1"}, Role: tt.actorRole}).Return(nil)
+			}
+
+			err := m.interactor().Get(context.Background(), "ws-1", "acc-1")
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWorkspaceInteractor_Update(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorRole workspace.Role
+		newName   string
+		wantError error
+	}{
+		{name: "[Success] admin renames", actorRole: workspace.RoleAdmin, newName: "Renamed"},
+		{name: "[Fail] member cannot rename", actorRole: workspace.RoleMember, newName: "Renamed", wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] blank name", actorRole: workspace.RoleOwner, newName: "", wantError: domainerr.ErrInvalidWorkspaceName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newWorkspaceMocks(ctrl)
+
+			m.expectMember("acc-1", tt.actorRole)
+			if tt.wantError == nil {
+				m.workspaces.EXPECT().Update(gomock.Any(), workspace.Workspace{ID: "ws-1", Name: tt.newName}).Return(&workspace.Workspace{ID: "ws-1", Name: tt.newName}, nil)
+				m.out.EXPECT().PresentWorkspace(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err := m.interactor().Update(context.Background(), port.WorkspaceUpdateInput{WorkspaceID: "ws-1", Name: tt.newName, ActorID: "acc-1"})
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWorkspaceInteractor_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		actorRole   workspace.Role
+		hasContents bool
+		wantError   error
+	}{
+		{name: "[Success] owner deletes empty workspace", actorRole: workspace.RoleOwner},
+		{name: "[Fail] admin cannot delete", actorRole: workspace.RoleAdmin, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] workspace still has contents", actorRole: workspace.RoleOwner, hasContents: true, wantError: domainerr.ErrWorkspaceNotEmpty},
+		{name: "[Fail] stranger", wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newWorkspaceMocks(ctrl)
+
+			m.expectMember("acc-1", tt.actorRole)
+			if tt.actorRole != "" {
+				m.workspaces.EXPECT().HasContents(gomock.Any(), "ws-1").Return(tt.hasContents, nil)
+			}
+			if tt.wantError == nil {
+				m.workspaces.EXPECT().Delete(gomock.Any(), "ws-1").Return(nil)
+				m.out.EXPECT().PresentWorkspaceDeleted(gomock.Any()).Return(nil)
+			}
+
+			err := m.interactor().Delete(context.Background(), "ws-1", "acc-1")
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWorkspaceInteractor_UpdateMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		actorRole  workspace.Role
+		targetRole workspace.Role
+		role       workspace.Role
+		wantError  error
+	}{
+		{name: "[Success] owner promotes member to admin", actorRole: workspace.RoleOwner, targetRole: workspace.RoleMember, role: workspace.RoleAdmin},
+		{name: "[Fail] member cannot change roles", actorRole: workspace.RoleMember, targetRole: workspace.RoleMember, role: workspace.RoleAdmin, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] owner role cannot be given", actorRole: workspace.RoleOwner, targetRole: workspace.RoleMember, role: workspace.RoleOwner, wantError: domainerr.ErrInvalidWorkspaceMember},
+		{name: "[Fail] target is not a member", actorRole: workspace.RoleOwner, role: workspace.RoleAdmin, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newWorkspaceMocks(ctrl)
+
+			m.expectMember("acc-1", tt.actorRole)
+			if tt.actorRole.CanManage() {
+				m.expectMember("acc-2", tt.targetRole)
+			}
+			if tt.wantError == nil {
+				changed := workspace.Member{WorkspaceID: "ws-1", AccountID: "acc-2", Role: tt.role}
+				m.workspaces.EXPECT().UpsertMember(gomock.Any(), changed).Return(&changed, nil)
+				m.workspaces.EXPECT().ListMembers(gomock.Any(), "ws-1").Return([]workspace.MemberWithMeta{
+					{Member: workspace.Member{WorkspaceID: "ws-1", AccountID: "acc-1", Role: tt.actorRole}},
+					{Member: changed, Email: "b@example.com"},
+				}, nil)
+				m.out.EXPECT().PresentWorkspaceMember(gomock.Any(), &workspace.MemberWithMeta{Member: changed, Email: "b@example.com"}).Return(nil)
+			}
+
+			err := m.interactor().UpdateMember(context.Background(), port.WorkspaceMemberUpdateInput{WorkspaceID: "ws-1", AccountID: "acc-2", Role: tt.role, ActorID: "acc-1"})
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWorkspaceInteractor_RemoveMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		actorID    string
+		actorRole  workspace.Role
+		targetRole workspace.Role
+		wantError  error
+	}{
+		{name: "[Success] admin removes member", actorID: "acc-1", actorRole: workspace.RoleAdmin, targetRole: workspace.RoleMember},
+		{name: "[Success] member leaves", actorID: "acc-2", actorRole: workspace.RoleMember, targetRole: workspace.RoleMember},
+		{name: "[Fail] member cannot remove others", actorID: "acc-1", actorRole: workspace.RoleMember, targetRole: workspace.RoleMember, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] owner cannot be removed", actorID: "acc-1", actorRole: workspace.RoleAdmin, targetRole: workspace.RoleOwner, wantError: domainerr.ErrInvalidWorkspaceMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newWorkspaceMocks(ctrl)
+
+			if tt.actorID == "acc-2" {
+				m.workspaces.EXPECT().GetMember(gomock.Any(), "ws-1", "acc-2").Return(&workspace.Member{WorkspaceID: "ws-1", AccountID: "acc-2", Role: tt.actorRole}, nil).Times(2)
+			} else {
+				m.expectMember(tt.actorID, tt.actorRole)
+				m.expectMember("acc-2", tt.targetRole)
+			}
+			if tt.wantError == nil {
+				m.workspaces.EXPECT().DeleteMember(gomock.Any(), "ws-1", "acc-2").Return(nil)
+				m.out.EXPECT().PresentWorkspaceMemberRemoved(gomock.Any()).Return(nil)
+			}
+
+			err := m.interactor().RemoveMember(context.Background(), "ws-1", "acc-2", tt.actorID)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWorkspaceInteractor_Invite(t *testing.T) {
+	tests := []struct {
+		name        string
+		actorRole   workspace.Role
+		input       port.WorkspaceInviteInput
+		inviteeRole workspace.Role
+		hasAccount  bool
+		wantError   error
+	}{
+		{
+			name:      "[Success] invite unknown email",
+			actorRole: workspace.RoleOwner,
+			input:     port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "New@Example.com", ActorID: "acc-1"},
+		},
+		{
+			name:       "[Success] invite existing account outside the workspace",
+			actorRole:  workspace.RoleAdmin,
+			input:      port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "new@example.com", Role: workspace.RoleAdmin, ActorID: "acc-1"},
+			hasAccount: true,
+		},
+		{
+			name:        "[Fail] already a member",
+			actorRole:   workspace.RoleOwner,
+			input:       port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "new@example.com", ActorID: "acc-1"},
+			hasAccount:  true,
+			inviteeRole: workspace.RoleMember,
+			wantError:   domainerr.ErrAlreadyWorkspaceMember,
+		},
+		{
+			name:      "[Fail] member cannot invite",
+			actorRole: workspace.RoleMember,
+			input:     port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "new@example.com", ActorID: "acc-1"},
+			wantError: domainerr.ErrUnauthorized,
+		},
+		{
+			name:      "[Fail] owner role cannot be invited",
+			actorRole: workspace.RoleOwner,
+			input:     port.WorkspaceInviteInput{WorkspaceID: "ws-1", Email: "new@example.com", Role: workspace.RoleOwner, ActorID: "acc-1"},
+			wantError: domainerr.ErrInvalidWorkspaceRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newWorkspaceMocks(ctrl)
+
+			m.expectMember("acc-1", tt.actorRole)
+			valid := tt.actorRole.CanManage() && tt.input.Role != workspace.RoleOwner
+			if valid {
+				if tt.hasAccount {
+					m.accounts.EXPECT().GetByEmail(gomock.Any(), "new@example.com").Return(&account.Account{ID: "acc-2"}, nil)
+					m.expectMember("acc-2", tt.inviteeRole)
+				} else {
+					m.accounts.EXPECT().GetByEmail(gomock.Any(), "new@example.com").Return(nil, domainerr.ErrNotFound)
+				}
+			}
+			if tt.wantError == nil {
+				role := tt.input.Role
+				if role == "" {
+					role = workspace.RoleMember
+				}
+				want := workspace.Invite{WorkspaceID: "ws-1", Email: "new@example.com", Role: role, InvitedBy: "acc-1"}
+				stored := want
+				stored.ID = "inv-1"
+				m.invites.EXPECT().Upsert(gomock.Any(), want).Return(&stored, nil)
+				m.workspaces.EXPECT().Get(gomock.Any(), "ws-1").Return(&workspace.Workspace{ID: "ws-1", Name: "Design"}, nil)
+				m.out.EXPECT().PresentWorkspaceInvite(gomock.Any(), &workspace.InviteWithMeta{Invite: stored, WorkspaceName: "Design"}).Return(nil)
+			}
+
+			err := m.interactor().Invite(context.Background(), tt.input)
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWorkspaceInteractor_RevokeInvite(t *testing.T) {
+	tests := []struct {
+		name        string
+		inviteSpace string
+		wantError   error
+	}{
+		{name: "[Success] revoke invite", inviteSpace: "ws-1"},
+		{name: "[Fail] invite of another workspace", inviteSpace: "ws-2", wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newWorkspaceMocks(ctrl)
+
+			m.expectMember("acc-1", workspace.RoleOwner)
+			m.invites.EXPECT().Get(gomock.Any(), "inv-1").Return(&workspace.InviteWithMeta{Invite: workspace.Invite{ID: "inv-1", WorkspaceID: tt.inviteSpace}}, nil)
+			if tt.wantError == nil {
+				m.invites.EXPECT().Delete(gomock.Any(), "inv-1").Return(nil)
+				m.out.EXPECT().PresentWorkspaceInviteRevoked(gomock.Any()).Return(nil)
+			}
+
+			err := m.interactor().RevokeInvite(context.Background(), "ws-1", "inv-1", "acc-1")
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestWorkspaceInteractor_AcceptInvite(t *testing.T) {
+	email, _ := account.ParseEmail("new@example.com")
+	other, _ := account.ParseEmail("other@example.com")
+	tests := []struct {
+		name      string
+		email     account.Email
+		existing  workspace.Role
+		wantRole  workspace.Role
+		wantError error
+	}{
+		{name: "[Success] join as invited role", email: email, wantRole: workspace.RoleAdmin},
+		{name: "[Success] existing member keeps role", email: email, existing: workspace.RoleMember, wantRole: workspace.RoleMember},
+		{name: "[Fail] invite of another email", email: other, wantError: domainerr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newWorkspaceMocks(ctrl)
+
+			m.accounts.EXPECT().GetByID(gomock.Any(), "acc-2").Return(&account.Account{ID: "acc-2", Email: tt.email}, nil)
+			m.invites.EXPECT().Get(gomock.Any(), "inv-1").Return(&workspace.InviteWithMeta{Invite: workspace.Invite{ID: "inv-1", WorkspaceID: "ws-1", Email: "new@example.com", Role: workspace.RoleAdmin}}, nil)
+			runInTx(m.tx)
+			m.expectMember("acc-2", tt.existing)
+			if tt.wantError == nil {
+				if tt.existing == "" {
+					m.workspaces.EXPECT().UpsertMember(gomock.Any(), workspace.Member{WorkspaceID: "ws-1", AccountID: "acc-2", Role: workspace.RoleAdmin}).Return(&workspace.Member{}, nil)
+				}
+				m.invites.EXPECT().Delete(gomock.Any(), "inv-1").Return(nil)
+				m.workspaces.EXPECT().Get(gomock.Any(), "ws-1").Return(&workspace.Workspace{ID: "ws-1"}, nil)
+				m.out.EXPECT().PresentWorkspace(gomock.Any(), &workspace.WithRole{Workspace: workspace.Workspace{ID: "ws-1"}, Role: tt.wantRole}).Return(nil)
+			}
+
+			err := m.interactor().AcceptInvite(context.Background(), "inv-1", "acc-2")
+			assertErr(t, tt.wantError, err)
+		})
+	}
+}
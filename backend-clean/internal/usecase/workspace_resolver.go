@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/port"
+)
+
+// WorkspaceResolver decides the workspace a request works in from the account's memberships.
+type WorkspaceResolver struct {
+	workspaces port.WorkspaceRepository
+}
+
+var _ port.WorkspaceResolver = (*WorkspaceResolver)(nil)
+
+// NewWorkspaceResolver creates WorkspaceResolver.
+func NewWorkspaceResolver(workspaces port.WorkspaceRepository) *WorkspaceResolver {
+	return &WorkspaceResolver{workspaces: workspaces}
+}
+
+// Resolve returns requestedID when the account belongs to it, or the workspace the
+// account joined first when nothing was requested. Requesting a workspace the account
+// does not belong to is ErrUnauthorized; an account without any workspace has nowhere
+// to work and gets ErrNotFound.
+func (r *WorkspaceResolver) Resolve(ctx context.Context, accountID, requestedID string) (string, error) {
+	if accountID == "" {
+		return "", domainerr.ErrUnauthenticated
+	}
+	if requestedID != "" {
+		if _, err := r.workspaces.GetMember(ctx, requestedID, accountID); err != nil {
+			if errors.Is(err, domainerr.ErrNotFound) {
+				return "", domainerr.ErrUnauthorized
+			}
+			return "", err
+		}
+		return requestedID, nil
+	}
+	workspaces, err := r.workspaces.ListByAccount(ctx, accountID)
+	if err != nil {
+		return "", err
+	}
+	if len(workspaces) == 0 {
+		return "", domainerr.ErrNotFound
+	}
+	return workspaces[0].Workspace.ID, nil
+}
@@ -0,0 +1,58 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	domainerr "immortal-architecture-clean/backend/internal/domain/errors"
+	"immortal-architecture-clean/backend/internal/domain/workspace"
+	uc "immortal-architecture-clean/backend/internal/usecase"
+	mockusecase "immortal-architecture-clean/backend/internal/usecase/mock"
+)
+
+func TestWorkspaceResolver_Resolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		accountID string
+		requested string
+		memberErr error
+		joined    []workspace.WithRole
+		want      string
+		wantError error
+	}{
+		{name: "[Success] requested workspace", accountID: "acc-1", requested: "ws-2", want: "ws-2"},
+		{
+			name:      "[Success] first joined workspace by default",
+			accountID: "acc-1",
+			joined:    []workspace.WithRole{{Workspace: workspace.Workspace{ID: "ws-1"}}, {Workspace: workspace.Workspace{ID: "ws-2"}}},
+			want:      "ws-1",
+		},
+		{name: "[Fail] not a member of requested workspace", accountID: "acc-1", requested: "ws-9", memberErr: domainerr.ErrNotFound, wantError: domainerr.ErrUnauthorized},
+		{name: "[Fail] no workspace", accountID: "acc-1", wantError: domainerr.ErrNotFound},
+		{name: "[Fail] unauthenticated", wantError: domainerr.ErrUnauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			workspaces := mockusecase.NewMockWorkspaceRepository(ctrl)
+
+			if tt.accountID != "" {
+				if tt.requested != "" {
+					workspaces.EXPECT().GetMember(gomock.Any(), tt.requested, tt.accountID).Return(&workspace.Member{}, tt.memberErr)
+				} else {
+					workspaces.EXPECT().ListByAccount(gomock.Any(), tt.accountID).Return(tt.joined, nil)
+				}
+			}
+
+			got, err := uc.NewWorkspaceResolver(workspaces).Resolve(context.Background(), tt.accountID, tt.requested)
+			assertErr(t, tt.wantError, err)
+			if got != tt.want {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_note_revisions_actor_id;
+
+DROP TABLE IF EXISTS section_revisions;
+DROP TABLE IF EXISTS note_revisions;
@@ -0,0 +1,25 @@
+-- Immutable revision history for notes.
+-- A revision is written in the same transaction as every note create/update/status change.
+CREATE TABLE note_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    revision INT NOT NULL CHECK (revision > 0),
+    title TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('Draft', 'Publish')),
+    actor_id UUID NOT NULL REFERENCES accounts(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT note_revisions_unique_revision UNIQUE (note_id, revision)
+);
+
+-- Field label/order are copied so old revisions stay readable after template edits.
+CREATE TABLE section_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    note_revision_id UUID NOT NULL REFERENCES note_revisions(id) ON DELETE CASCADE,
+    field_id UUID NOT NULL,
+    field_label TEXT NOT NULL,
+    field_order INT NOT NULL,
+    content TEXT NOT NULL DEFAULT '',
+    CONSTRAINT section_revisions_unique_field UNIQUE (note_revision_id, field_id)
+);
+
+CREATE INDEX idx_note_revisions_actor_id ON note_revisions(actor_id);
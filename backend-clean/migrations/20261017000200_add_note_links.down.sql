@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_note_links_target_note_id;
+
+DROP TABLE IF EXISTS note_links;
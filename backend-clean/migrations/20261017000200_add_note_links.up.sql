@@ -0,0 +1,14 @@
+-- Typed, directed links between notes.
+-- A link belongs to its source note's aggregate and is removed with it.
+-- The target is a reference to another aggregate, so backlinks are removed by the application.
+CREATE TABLE note_links (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    target_note_id UUID NOT NULL REFERENCES notes(id),
+    link_type TEXT NOT NULL CHECK (link_type IN ('relates-to', 'supersedes', 'depends-on')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT note_links_unique_link UNIQUE (source_note_id, target_note_id, link_type),
+    CONSTRAINT note_links_no_self_link CHECK (source_note_id <> target_note_id)
+);
+
+CREATE INDEX idx_note_links_target_note_id ON note_links(target_note_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_fields_label_trgm;
+DROP INDEX IF EXISTS idx_sections_content_trgm;
+DROP INDEX IF EXISTS idx_notes_title_trgm;
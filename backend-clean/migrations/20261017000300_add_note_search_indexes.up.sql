@@ -0,0 +1,11 @@
+-- Keyword search matches substrings of titles, section contents and field labels.
+-- Trigram GIN indexes serve ILIKE '%...%' without a word parser, so they work for
+-- Japanese text that has no spaces between words. Trigrams are only extracted from
+-- characters the database ctype treats as alphanumeric, so the database should use a
+-- UTF-8 locale other than "C" (e.g. C.UTF-8 or ja_JP.UTF-8) for multibyte text.
+-- Queries shorter than three characters still work but fall back to a scan.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_notes_title_trgm ON notes USING gin (title gin_trgm_ops);
+CREATE INDEX idx_sections_content_trgm ON sections USING gin (content gin_trgm_ops);
+CREATE INDEX idx_fields_label_trgm ON fields USING gin (label gin_trgm_ops);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_templates_updated_at_id;
+DROP INDEX IF EXISTS idx_notes_updated_at_id;
+CREATE INDEX idx_notes_updated_at ON notes(updated_at DESC);
@@ -0,0 +1,6 @@
+-- Lists are paginated by the (updated_at, id) keyset in descending order.
+-- The composite index serves both the ordering and the "after cursor" condition,
+-- so the single-column notes index it supersedes is dropped.
+DROP INDEX IF EXISTS idx_notes_updated_at;
+CREATE INDEX idx_notes_updated_at_id ON notes(updated_at DESC, id DESC);
+CREATE INDEX idx_templates_updated_at_id ON templates(updated_at DESC, id DESC);
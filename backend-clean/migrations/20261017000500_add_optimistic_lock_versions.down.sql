@@ -0,0 +1,2 @@
+ALTER TABLE templates DROP COLUMN IF EXISTS version;
+ALTER TABLE notes DROP COLUMN IF EXISTS version;
@@ -0,0 +1,5 @@
+-- Optimistic concurrency control for notes and templates.
+-- Every update increments version; conditional updates compare it with the
+-- version the client edited and report a conflict when they differ.
+ALTER TABLE notes ADD COLUMN version INT NOT NULL DEFAULT 1 CHECK (version > 0);
+ALTER TABLE templates ADD COLUMN version INT NOT NULL DEFAULT 1 CHECK (version > 0);
@@ -0,0 +1,9 @@
+-- Restoring the per-template order constraint fails while a template still has
+-- several field versions; migrate the pinned notes to the latest version first.
+DROP INDEX IF EXISTS idx_notes_template_version;
+
+ALTER TABLE notes DROP COLUMN IF EXISTS template_version;
+
+ALTER TABLE fields DROP CONSTRAINT IF EXISTS fields_unique_order;
+ALTER TABLE fields ADD CONSTRAINT fields_unique_order UNIQUE (template_id, "order");
+ALTER TABLE fields DROP COLUMN IF EXISTS version;
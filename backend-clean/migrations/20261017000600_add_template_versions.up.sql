@@ -0,0 +1,13 @@
+-- Template versioning.
+-- Each field belongs to the template version that introduced it, and a note is
+-- pinned to the field version it was written against. Editing the fields of a
+-- template adds a new field version instead of rewriting the rows sections refer to.
+ALTER TABLE fields ADD COLUMN version INT NOT NULL DEFAULT 1 CHECK (version > 0);
+ALTER TABLE fields ALTER COLUMN version DROP DEFAULT;
+ALTER TABLE fields DROP CONSTRAINT fields_unique_order;
+ALTER TABLE fields ADD CONSTRAINT fields_unique_order UNIQUE (template_id, version, "order");
+
+ALTER TABLE notes ADD COLUMN template_version INT NOT NULL DEFAULT 1 CHECK (template_version > 0);
+ALTER TABLE notes ALTER COLUMN template_version DROP DEFAULT;
+
+CREATE INDEX idx_notes_template_version ON notes(template_id, template_version);
@@ -0,0 +1,2 @@
+ALTER TABLE fields DROP COLUMN IF EXISTS options;
+ALTER TABLE fields DROP COLUMN IF EXISTS type;
@@ -0,0 +1,6 @@
+-- Typed template fields.
+-- Existing fields keep their free-text behaviour. Options lists the choices of a
+-- single-select field and stays empty for every other type.
+ALTER TABLE fields ADD COLUMN type TEXT NOT NULL DEFAULT 'text'
+    CHECK (type IN ('text', 'markdown', 'url', 'number', 'date', 'single-select', 'boolean'));
+ALTER TABLE fields ADD COLUMN options TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,21 @@
+-- Transactional outbox for domain events.
+-- Events are inserted in the same transaction as the change that raised them and
+-- delivered at least once by the relay worker, in id order per aggregate.
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    event_type TEXT NOT NULL,
+    aggregate_type TEXT NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    actor_id TEXT NOT NULL DEFAULT '',
+    payload JSONB NOT NULL DEFAULT '{}'::jsonb,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ,
+    CONSTRAINT outbox_unique_event_id UNIQUE (event_id)
+);
+
+-- Pending events are looked up per aggregate to find the head of each queue.
+CREATE INDEX idx_outbox_pending ON outbox(aggregate_type, aggregate_id, id) WHERE delivered_at IS NULL;
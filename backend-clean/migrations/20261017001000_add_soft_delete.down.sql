@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_templates_trash;
+DROP INDEX IF EXISTS idx_notes_trash;
+-- Trashed rows would reappear as live ones, so they are purged first.
+DELETE FROM note_links
+WHERE source_note_id IN (SELECT id FROM notes WHERE deleted_at IS NOT NULL)
+   OR target_note_id IN (SELECT id FROM notes WHERE deleted_at IS NOT NULL);
+DELETE FROM notes WHERE deleted_at IS NOT NULL;
+DELETE FROM templates WHERE deleted_at IS NOT NULL;
+ALTER TABLE templates DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE notes DROP COLUMN IF EXISTS deleted_at;
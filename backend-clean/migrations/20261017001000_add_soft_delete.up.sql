@@ -0,0 +1,10 @@
+-- Deleting a note or template moves it to the trash by setting deleted_at.
+-- Trashed rows are hidden from every read query until they are restored, and are
+-- removed for good when purged by hand or after the retention period.
+ALTER TABLE notes ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE templates ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- The trash is listed by the (deleted_at, id) keyset in descending order, and the
+-- retention purge looks for the oldest rows; both only touch trashed rows.
+CREATE INDEX idx_notes_trash ON notes(deleted_at DESC, id DESC) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_templates_trash ON templates(deleted_at DESC, id DESC) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,32 @@
+-- Append-only audit log of changes to notes, templates and accounts.
+-- Each row stores the hash of the previous row, so editing or removing a row breaks
+-- the chain from that point on. before/after are JSON (not JSONB) so the stored text
+-- stays byte-for-byte what was hashed.
+CREATE TABLE audit_log (
+    sequence BIGSERIAL PRIMARY KEY,
+    actor_id TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+    aggregate_type TEXT NOT NULL CHECK (aggregate_type IN ('note', 'template', 'account')),
+    aggregate_id TEXT NOT NULL,
+    before JSON,
+    after JSON,
+    request_id TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL,
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+CREATE INDEX idx_audit_log_actor ON audit_log(actor_id, sequence DESC);
+CREATE INDEX idx_audit_log_aggregate ON audit_log(aggregate_type, aggregate_id, sequence DESC);
+CREATE INDEX idx_audit_log_occurred_at ON audit_log(occurred_at);
+
+-- Rows can only be appended; the application never updates or deletes them.
+CREATE FUNCTION audit_log_reject_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_reject_change();
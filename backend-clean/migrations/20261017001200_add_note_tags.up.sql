@@ -0,0 +1,10 @@
+-- User-defined tags on notes. Tags belong to the note's aggregate and are removed with it.
+-- The application stores them normalized (lowercase, no surrounding spaces).
+CREATE TABLE note_tags (
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    tag TEXT NOT NULL CHECK (char_length(tag) BETWEEN 1 AND 32),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (note_id, tag)
+);
+
+CREATE INDEX idx_note_tags_tag ON note_tags(tag, note_id);
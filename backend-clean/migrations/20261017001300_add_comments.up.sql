@@ -0,0 +1,21 @@
+-- Review comments on notes. A comment is either a thread root or a reply to a root.
+-- Comments are removed with their note; deleting a root removes its replies.
+-- A comment about a section falls back to the whole note when the section goes away
+-- (for example on a template migration).
+CREATE TABLE comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    section_id UUID REFERENCES sections(id) ON DELETE SET NULL,
+    parent_id UUID REFERENCES comments(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES accounts(id),
+    body TEXT NOT NULL CHECK (char_length(body) BETWEEN 1 AND 10000),
+    resolved_by UUID REFERENCES accounts(id),
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT comments_resolution_pair CHECK ((resolved_by IS NULL) = (resolved_at IS NULL)),
+    CONSTRAINT comments_only_roots_resolve CHECK (parent_id IS NULL OR resolved_at IS NULL)
+);
+
+CREATE INDEX idx_comments_note_created ON comments(note_id, created_at, id);
+CREATE INDEX idx_comments_parent_id ON comments(parent_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_note_collaborators_account;
+
+DROP TABLE IF EXISTS note_collaborators;
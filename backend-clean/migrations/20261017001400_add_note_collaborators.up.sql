@@ -0,0 +1,14 @@
+-- Accounts other than the owner who may work on a note. Editors may change the title
+-- and sections; viewers may read the note while it is a draft. Collaborators belong to
+-- the note's aggregate and are removed with it.
+CREATE TABLE note_collaborators (
+    note_id UUID NOT NULL REFERENCES notes(id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    role TEXT NOT NULL CHECK (role IN ('editor', 'viewer')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (note_id, account_id)
+);
+
+-- Visibility checks look up the notes an account collaborates on.
+CREATE INDEX idx_note_collaborators_account ON note_collaborators(account_id, note_id);
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_notes_workspace;
+DROP INDEX IF EXISTS idx_templates_workspace;
+
+ALTER TABLE notes DROP COLUMN IF EXISTS workspace_id;
+ALTER TABLE templates DROP COLUMN IF EXISTS workspace_id;
+
+DROP INDEX IF EXISTS idx_workspace_invites_email;
+DROP TABLE IF EXISTS workspace_invites;
+
+DROP INDEX IF EXISTS idx_workspace_members_account;
+DROP INDEX IF EXISTS idx_workspace_members_owner;
+DROP TABLE IF EXISTS workspace_members;
+
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,64 @@
+-- Workspaces isolate tenants: templates and notes belong to exactly one workspace and
+-- are only visible to its members.
+CREATE TABLE workspaces (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL CHECK (char_length(name) BETWEEN 1 AND 100),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Owners and admins manage the workspace and its members; every member works on its
+-- templates and notes. A workspace has exactly one owner.
+CREATE TABLE workspace_members (
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    role TEXT NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (workspace_id, account_id)
+);
+
+CREATE UNIQUE INDEX idx_workspace_members_owner ON workspace_members(workspace_id) WHERE role = 'owner';
+-- An account's default workspace is the one it joined first.
+CREATE INDEX idx_workspace_members_account ON workspace_members(account_id, created_at);
+
+-- Pending invites by email. Accepting one turns it into a membership with its role.
+CREATE TABLE workspace_invites (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('admin', 'member')),
+    invited_by UUID NOT NULL REFERENCES accounts(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT workspace_invite_unique UNIQUE (workspace_id, email)
+);
+
+CREATE INDEX idx_workspace_invites_email ON workspace_invites(email);
+
+ALTER TABLE templates ADD COLUMN workspace_id UUID REFERENCES workspaces(id);
+ALTER TABLE notes ADD COLUMN workspace_id UUID REFERENCES workspaces(id);
+
+-- Everything was visible to every account before workspaces existed. Existing accounts
+-- share one workspace, owned by the earliest account, so nobody loses access.
+INSERT INTO workspaces (name)
+SELECT 'Shared workspace'
+WHERE EXISTS (SELECT 1 FROM accounts);
+
+INSERT INTO workspace_members (workspace_id, account_id, role)
+SELECT
+    w.id,
+    a.id,
+    CASE WHEN ROW_NUMBER() OVER (ORDER BY a.created_at, a.id) = 1 THEN 'owner' ELSE 'member' END
+FROM accounts a
+CROSS JOIN workspaces w;
+
+UPDATE templates SET workspace_id = (SELECT id FROM workspaces LIMIT 1);
+UPDATE notes SET workspace_id = (SELECT id FROM workspaces LIMIT 1);
+
+ALTER TABLE templates ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE notes ALTER COLUMN workspace_id SET NOT NULL;
+
+-- Lists are always scoped to a workspace.
+CREATE INDEX idx_templates_workspace ON templates(workspace_id, updated_at DESC, id DESC);
+CREATE INDEX idx_notes_workspace ON notes(workspace_id, updated_at DESC, id DESC);
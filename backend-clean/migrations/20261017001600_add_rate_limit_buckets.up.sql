@@ -0,0 +1,14 @@
+-- Token buckets shared by every API instance. A key names the request class and the
+-- account or client IP it limits (for example "write:account:<id>"). Concurrent
+-- requests update a bucket by compare-and-swap on version.
+CREATE TABLE rate_limit_buckets (
+    key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    version BIGINT NOT NULL DEFAULT 1,
+    updated_at TIMESTAMPTZ NOT NULL,
+    -- When the bucket is full again if nobody takes from it; a missing bucket starts
+    -- full, so rows past this time can be deleted.
+    full_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_rate_limit_buckets_full_at ON rate_limit_buckets(full_at);
@@ -0,0 +1,10 @@
+// Package migrations embeds the SQL migrations so that the servers can tell
+// whether the database has been migrated far enough for the code they run.
+package migrations
+
+import "embed"
+
+// FS holds the migration files, named <version>_<name>.up.sql and <version>_<name>.down.sql.
+//
+//go:embed *.sql
+var FS embed.FS